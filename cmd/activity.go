@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// NewActivityCmd creates the activity command.
+func NewActivityCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		action   string
+		since    string
+		limit    int
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "activity [<owner/repo>]",
+		Short: "Show recent project activity",
+		Long: `Show a feed of recent events in a project, newest first: pushes, merges,
+comments, and changes to issues and merge requests.
+
+Filter with --action: created, updated, closed, reopened, pushed, commented,
+merged, joined, left, or destroyed.`,
+		Example: `  $ glab activity
+  $ glab activity owner/repo --since 7d
+  $ glab activity --action merged --limit 50
+  $ glab activity --since 2024-01-01 --format json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := &gitlab.ListProjectVisibleEventsOptions{
+				ListOptions: gitlab.ListOptions{PerPage: int64(min(limit, 100))},
+			}
+			if action != "" {
+				opts.Action = gitlab.Ptr(gitlab.EventTypeValue(strings.ToLower(action)))
+			}
+			var sinceTime time.Time
+			if since != "" {
+				var err error
+				sinceTime, err = parseSince(since)
+				if err != nil {
+					return err
+				}
+				// "after" only takes a date and is exclusive, so ask for the
+				// day before and filter the rest locally.
+				opts.After = gitlab.Ptr(gitlab.ISOTime(sinceTime.AddDate(0, 0, -1)))
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			var projectPath string
+			if len(args) > 0 {
+				projectPath = args[0]
+			} else {
+				projectPath, err = f.FullProjectPath()
+				if err != nil {
+					return err
+				}
+			}
+
+			var events []*gitlab.ProjectEvent
+			for len(events) < limit {
+				page, resp, err := client.Events.ListProjectVisibleEvents(projectPath, opts, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/projects/" + projectPath + "/events"
+					return errors.NewAPIError("GET", url, statusCode, "Failed to list project activity", err)
+				}
+				for _, e := range page {
+					if !sinceTime.IsZero() {
+						created, err := time.Parse(time.RFC3339, e.CreatedAt)
+						if err == nil && created.Before(sinceTime) {
+							continue
+						}
+					}
+					events = append(events, e)
+				}
+				if resp == nil || resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+			if len(events) > limit {
+				events = events[:limit]
+			}
+
+			if len(events) == 0 {
+				_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "No recent activity in %s\n", projectPath)
+				return nil
+			}
+
+			if jsonFlag || f.ExportRequested() || (format != "" && format != "table") {
+				return f.FormatAndPrint(events, format, jsonFlag)
+			}
+
+			tp := f.NewTablePrinter()
+			for _, e := range events {
+				var age string
+				if created, err := time.Parse(time.RFC3339, e.CreatedAt); err == nil {
+					age = timeAgo(&created)
+				}
+				author := e.AuthorUsername
+				if author == "" {
+					author = e.Author.Username
+				}
+				tp.AddRow(age, author, truncate(describeProjectEvent(e), 80))
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().StringVarP(&action, "action", "a", "", "Only show events of this action, e.g. pushed, merged, or commented")
+	cmd.Flags().StringVar(&since, "since", "", "Only events since a date (YYYY-MM-DD) or duration (e.g. 24h, 7d)")
+	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
+
+	return cmd
+}
+
+// describeProjectEvent renders a project event as a one-line sentence for
+// the activity feed.
+func describeProjectEvent(e *gitlab.ProjectEvent) string {
+	switch {
+	case e.PushData.Ref != "":
+		p := e.PushData
+		switch p.Action {
+		case "created":
+			return fmt.Sprintf("created %s %s", p.RefType, p.Ref)
+		case "removed":
+			return fmt.Sprintf("deleted %s %s", p.RefType, p.Ref)
+		}
+		commits := "1 commit"
+		if p.CommitCount != 1 {
+			commits = fmt.Sprintf("%d commits", p.CommitCount)
+		}
+		desc := fmt.Sprintf("pushed %s to %s", commits, p.Ref)
+		if p.CommitTitle != "" {
+			desc += ": " + p.CommitTitle
+		}
+		return desc
+	case e.Note.Body != "":
+		return fmt.Sprintf("commented on %s: %s", eventReference(e.Note.NoteableType, e.Note.NoteableIID), e.Note.Body)
+	case e.TargetType != "":
+		desc := fmt.Sprintf("%s %s", e.ActionName, eventReference(e.TargetType, e.TargetIID))
+		if e.TargetTitle != "" {
+			desc += ": " + e.TargetTitle
+		}
+		return desc
+	default:
+		return strings.TrimSpace(e.ActionName + " " + e.Title)
+	}
+}
+
+// eventReference formats an event target such as "merge request !12".
+func eventReference(targetType string, iid int64) string {
+	switch targetType {
+	case "MergeRequest":
+		return fmt.Sprintf("merge request !%d", iid)
+	case "Issue":
+		return fmt.Sprintf("issue #%d", iid)
+	case "Milestone":
+		return fmt.Sprintf("milestone %%%d", iid)
+	}
+	name := strings.ToLower(targetType)
+	if iid > 0 {
+		return fmt.Sprintf("%s %d", name, iid)
+	}
+	return name
+}
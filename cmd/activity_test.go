@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestActivity(t *testing.T) {
+	now := time.Now().UTC()
+	var query string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v4/projects/test-owner/test-repo/events" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		query = r.URL.RawQuery
+		cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+			{
+				"action_name":     "pushed to",
+				"author_username": "jdoe",
+				"created_at":      now.Add(-time.Hour).Format(time.RFC3339),
+				"push_data":       map[string]interface{}{"action": "pushed", "ref": "main", "ref_type": "branch", "commit_count": 2, "commit_title": "Fix build"},
+			},
+			{
+				"action_name":     "accepted",
+				"author_username": "asmith",
+				"created_at":      now.Add(-2 * time.Hour).Format(time.RFC3339),
+				"target_type":     "MergeRequest",
+				"target_iid":      12,
+				"target_title":    "Add feature",
+			},
+			{
+				"action_name":     "commented on",
+				"author_username": "bob",
+				"created_at":      now.Add(-48 * time.Hour).Format(time.RFC3339),
+				"note":            map[string]interface{}{"body": "Looks good", "noteable_type": "Issue", "noteable_iid": 3},
+			},
+		})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewActivityCmd(f.Factory)
+	cmd.SetArgs([]string{"--since", "24h", "--action", "pushed"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(query, "action=pushed") || !strings.Contains(query, "after=") {
+		t.Errorf("unexpected query: %s", query)
+	}
+
+	out := strings.Join(strings.Fields(f.IO.String()), " ")
+	for _, want := range []string{"jdoe pushed 2 commits to main: Fix build", "asmith accepted merge request !12: Add feature"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q: %s", want, out)
+		}
+	}
+	if strings.Contains(out, "Looks good") {
+		t.Errorf("event older than --since should be filtered: %s", out)
+	}
+}
+
+func TestActivity_Empty(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		cmdtest.JSONResponse(w, 200, []map[string]interface{}{})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewActivityCmd(f.Factory)
+	cmd.SetArgs([]string{"owner/repo"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(f.IO.ErrString(), "No recent activity in owner/repo") {
+		t.Errorf("unexpected stderr: %s", f.IO.ErrString())
+	}
+}
+
+func TestDescribeProjectEvent(t *testing.T) {
+	tests := []struct {
+		event gitlab.ProjectEvent
+		want  string
+	}{
+		{gitlab.ProjectEvent{PushData: gitlab.ProjectEventPushData{Action: "created", Ref: "feature", RefType: "branch"}}, "created branch feature"},
+		{gitlab.ProjectEvent{PushData: gitlab.ProjectEventPushData{Action: "removed", Ref: "v1.0", RefType: "tag"}}, "deleted tag v1.0"},
+		{gitlab.ProjectEvent{PushData: gitlab.ProjectEventPushData{Action: "pushed", Ref: "main", CommitCount: 1}}, "pushed 1 commit to main"},
+		{gitlab.ProjectEvent{Note: gitlab.ProjectEventNote{Body: "LGTM", NoteableType: "MergeRequest", NoteableIID: 4}}, "commented on merge request !4: LGTM"},
+		{gitlab.ProjectEvent{ActionName: "opened", TargetType: "Issue", TargetIID: 9, TargetTitle: "Crash"}, "opened issue #9: Crash"},
+		{gitlab.ProjectEvent{ActionName: "joined"}, "joined"},
+	}
+
+	for _, tt := range tests {
+		if got := describeProjectEvent(&tt.event); got != tt.want {
+			t.Errorf("describeProjectEvent() = %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,339 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// NewAliasCmd creates the alias command group.
+func NewAliasCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "alias <command>",
+		Short: "Create command shortcuts",
+		Long: `Create shortcuts for glab commands.
+
+An alias is expanded when it is the first argument of glab. Placeholders
+$1, $2, ... in the expansion are replaced with the arguments given after
+the alias; the remaining arguments are appended.`,
+	}
+
+	cmd.AddCommand(newAliasSetCmd(f))
+	cmd.AddCommand(newAliasListCmd(f))
+	cmd.AddCommand(newAliasDeleteCmd(f))
+
+	return cmd
+}
+
+func newAliasSetCmd(f *cmdutil.Factory) *cobra.Command {
+	var shell bool
+
+	cmd := &cobra.Command{
+		Use:   "set <alias> <expansion>",
+		Short: "Create a shortcut for a glab command",
+		Long: `Create a shortcut for a glab command, or change an existing one.
+
+An expansion starting with "!", or set with --shell, is run with sh instead
+of glab, so it can use pipes and other commands. The arguments given after
+the alias are available to it as $1, $2, ... and "$@".`,
+		Example: `  $ glab alias set co 'mr checkout'
+  $ glab co 42
+
+  $ glab alias set mine 'mr list --author $1'
+  $ glab mine alice
+
+  $ glab alias set --shell bugs 'glab issue list --label bug | grep "$1"'
+  $ glab bugs login`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, expansion := args[0], args[1]
+			if shell && !strings.HasPrefix(expansion, "!") {
+				expansion = "!" + expansion
+			}
+
+			root := cmd.Root()
+			if name == "" || strings.ContainsAny(name, " \t\n") || strings.HasPrefix(name, "-") {
+				return fmt.Errorf("invalid alias name: %q", name)
+			}
+			if isCommand(root, name) {
+				return fmt.Errorf("could not create alias %s: it is already a glab command", name)
+			}
+			if !strings.HasPrefix(expansion, "!") {
+				words, err := splitAliasArgs(expansion)
+				if err != nil {
+					return fmt.Errorf("could not create alias %s: %w", name, err)
+				}
+				if len(words) == 0 || !isCommand(root, words[0]) {
+					return fmt.Errorf("could not create alias %s: %q does not start with a glab command", name, expansion)
+				}
+			}
+
+			cfg, err := f.Config()
+			if err != nil {
+				return err
+			}
+			previous, exists := cfg.Aliases[name]
+			if cfg.Aliases == nil {
+				cfg.Aliases = make(map[string]string)
+			}
+			cfg.Aliases[name] = expansion
+			if err := cfg.Save(); err != nil {
+				return err
+			}
+
+			cs := f.IOStreams.ColorScheme()
+			if exists {
+				_, _ = fmt.Fprintf(f.IOStreams.Out, "%s Changed alias %s from %s to %s\n", cs.Green("✓"), name, previous, expansion)
+			} else {
+				_, _ = fmt.Fprintf(f.IOStreams.Out, "%s Added alias %s for %s\n", cs.Green("✓"), name, expansion)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&shell, "shell", "s", false, "Run the expansion with sh, as if it started with !")
+
+	return cmd
+}
+
+func newAliasListCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List aliases",
+		Aliases: []string{"ls"},
+		Example: `  $ glab alias list`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := f.Config()
+			if err != nil {
+				return err
+			}
+			if len(cfg.Aliases) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No aliases configured")
+				return nil
+			}
+
+			names := make([]string, 0, len(cfg.Aliases))
+			for name := range cfg.Aliases {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				_, _ = fmt.Fprintf(f.IOStreams.Out, "%s: %s\n", name, cfg.Aliases[name])
+			}
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newAliasDeleteCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete <alias>",
+		Short:   "Delete an alias",
+		Aliases: []string{"remove"},
+		Example: `  $ glab alias delete co`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := f.Config()
+			if err != nil {
+				return err
+			}
+			expansion, ok := cfg.Aliases[args[0]]
+			if !ok {
+				return fmt.Errorf("no such alias: %s", args[0])
+			}
+			delete(cfg.Aliases, args[0])
+			if err := cfg.Save(); err != nil {
+				return err
+			}
+
+			cs := f.IOStreams.ColorScheme()
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "%s Deleted alias %s; was %s\n", cs.Green("✓"), args[0], expansion)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// ShellAliasError reports that a shell alias exited with a non-zero status.
+// The alias has printed its own errors, so only the status is passed on.
+type ShellAliasError struct {
+	ExitCode int
+}
+
+func (e *ShellAliasError) Error() string {
+	return fmt.Sprintf("alias exited with status %d", e.ExitCode)
+}
+
+// Execute runs root with args after expanding an alias in its first
+// argument. A shell alias is run with sh instead of root. A config file that
+// cannot be loaded leaves no aliases rather than failing, so that flags like
+// --help and commands that report the problem themselves still run.
+func Execute(ctx context.Context, root *cobra.Command, args []string) error {
+	var aliases map[string]string
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") && !isCommand(root, args[0]) {
+		if cfg, err := config.Load(); err == nil {
+			aliases = cfg.Aliases
+		}
+	}
+	expanded, isShell, err := ExpandAlias(root, aliases, args)
+	if err != nil {
+		return err
+	}
+	if !isShell {
+		root.SetArgs(expanded)
+		return root.ExecuteContext(ctx)
+	}
+
+	sh, err := exec.LookPath(expanded[0])
+	if err != nil {
+		return fmt.Errorf("could not run shell alias: %w", err)
+	}
+	c := exec.CommandContext(ctx, sh, expanded[1:]...)
+	c.Stdin = os.Stdin
+	c.Stdout = os.Stdout
+	c.Stderr = os.Stderr
+	if err := c.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && ctx.Err() == nil {
+			return &ShellAliasError{ExitCode: exitErr.ExitCode()}
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return err
+	}
+	return nil
+}
+
+var aliasPlaceholder = regexp.MustCompile(`\$(\d+)`)
+
+// ExpandAlias returns args with the alias in its first argument replaced by
+// its expansion. Commands take precedence over aliases of the same name, and
+// args are returned unchanged when they do not start with an alias. For a
+// shell alias, the returned arguments are the sh invocation to run instead
+// of glab and isShell is true.
+func ExpandAlias(root *cobra.Command, aliases map[string]string, args []string) (expanded []string, isShell bool, err error) {
+	if len(args) == 0 || isCommand(root, args[0]) {
+		return args, false, nil
+	}
+	expansion, ok := aliases[args[0]]
+	if !ok {
+		return args, false, nil
+	}
+	rest := args[1:]
+
+	if script, ok := strings.CutPrefix(expansion, "!"); ok {
+		// sh sets $0 to "glab" and $1... to the arguments after the alias.
+		return append([]string{"sh", "-c", script, "glab"}, rest...), true, nil
+	}
+
+	words, err := splitAliasArgs(expansion)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid alias %s: %w", args[0], err)
+	}
+	used := make([]bool, len(rest))
+	for i, word := range words {
+		words[i] = aliasPlaceholder.ReplaceAllStringFunc(word, func(m string) string {
+			n, _ := strconv.Atoi(m[1:])
+			if n < 1 || n > len(rest) {
+				if err == nil {
+					err = fmt.Errorf("not enough arguments for alias %s: %s", args[0], expansion)
+				}
+				return m
+			}
+			used[n-1] = true
+			return rest[n-1]
+		})
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	for i, arg := range rest {
+		if !used[i] {
+			words = append(words, arg)
+		}
+	}
+	return words, false, nil
+}
+
+// isCommand reports whether name is a command of root or one of its
+// command aliases.
+func isCommand(root *cobra.Command, name string) bool {
+	root.InitDefaultHelpCmd()
+	root.InitDefaultCompletionCmd()
+	for _, c := range root.Commands() {
+		if c.Name() == name || c.HasAlias(name) {
+			return true
+		}
+	}
+	return name == cobra.ShellCompRequestCmd || name == cobra.ShellCompNoDescRequestCmd
+}
+
+// splitAliasArgs splits an alias expansion into words like a POSIX shell,
+// honoring single quotes, double quotes, and backslash escapes.
+func splitAliasArgs(s string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	inWord := false
+	var quote rune
+	escaped := false
+
+	for _, r := range s {
+		switch {
+		case escaped:
+			word.WriteRune(r)
+			escaped = false
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\\':
+			escaped = true
+			inWord = true
+		case quote == '"':
+			if r == '"' {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inWord = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+	if escaped {
+		return nil, errors.New("trailing backslash")
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
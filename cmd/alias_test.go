@@ -0,0 +1,197 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+	"github.com/PhilipKram/gitlab-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func TestAliasCmd_HasSubcommands(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewAliasCmd(f)
+
+	expectedSubcommands := []string{"set", "list", "delete"}
+
+	subcommands := cmd.Commands()
+	if len(subcommands) != len(expectedSubcommands) {
+		t.Errorf("expected %d subcommands, got %d", len(expectedSubcommands), len(subcommands))
+	}
+
+	foundSubcommands := make(map[string]bool)
+	for _, subcmd := range subcommands {
+		foundSubcommands[subcmd.Name()] = true
+	}
+	for _, expected := range expectedSubcommands {
+		if !foundSubcommands[expected] {
+			t.Errorf("expected subcommand %q not found", expected)
+		}
+	}
+}
+
+// newAliasTestRoot returns a root command with the alias and mr commands.
+func newAliasTestRoot(f *cmdtest.TestFactory) *cobra.Command {
+	root := &cobra.Command{Use: "glab", SilenceErrors: true, SilenceUsage: true}
+	root.AddCommand(NewAliasCmd(f.Factory))
+	root.AddCommand(NewMRCmd(f.Factory))
+	return root
+}
+
+func TestAliasSetListDelete(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+
+	root := newAliasTestRoot(f)
+	root.SetArgs([]string{"alias", "set", "co", "mr checkout"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("alias set: %v", err)
+	}
+	root.SetArgs([]string{"alias", "set", "--shell", "count", "glab mr list | wc -l"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("alias set --shell: %v", err)
+	}
+
+	saved, err := config.Load()
+	if err != nil {
+		t.Fatalf("loading config: %v", err)
+	}
+	if want := map[string]string{"co": "mr checkout", "count": "!glab mr list | wc -l"}; !reflect.DeepEqual(saved.Aliases, want) {
+		t.Errorf("saved aliases = %v, want %v", saved.Aliases, want)
+	}
+
+	f.IO.Out.Reset()
+	root.SetArgs([]string{"alias", "list"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("alias list: %v", err)
+	}
+	if got, want := f.IO.String(), "co: mr checkout\ncount: !glab mr list | wc -l\n"; got != want {
+		t.Errorf("alias list output = %q, want %q", got, want)
+	}
+
+	root.SetArgs([]string{"alias", "delete", "co"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("alias delete: %v", err)
+	}
+	if _, ok := f.Config.Aliases["co"]; ok {
+		t.Error("expected alias co to be deleted")
+	}
+	root.SetArgs([]string{"alias", "delete", "co"})
+	if err := root.Execute(); err == nil || !strings.Contains(err.Error(), "no such alias") {
+		t.Errorf("expected a no such alias error, got %v", err)
+	}
+}
+
+func TestAliasSet_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"shadows a command", []string{"mr", "issue list"}, "already a glab command"},
+		{"unknown command", []string{"co", "checkout 1"}, "does not start with a glab command"},
+		{"unterminated quote", []string{"co", "mr list --search 'x"}, "unterminated"},
+		{"name with spaces", []string{"my co", "mr checkout"}, "invalid alias name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := cmdtest.NewTestFactory(t)
+			root := newAliasTestRoot(f)
+			root.SetArgs(append([]string{"alias", "set"}, tt.args...))
+			err := root.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestExpandAlias(t *testing.T) {
+	root := newAliasTestRoot(cmdtest.NewTestFactory(t))
+	aliases := map[string]string{
+		"co":    "mr checkout",
+		"mine":  "mr list --author $1 --label 'needs review'",
+		"mr":    "issue list",
+		"count": "!glab mr list | wc -l",
+	}
+
+	tests := []struct {
+		name      string
+		args      []string
+		want      []string
+		wantShell bool
+		wantErr   bool
+	}{
+		{"no args", nil, nil, false, false},
+		{"not an alias", []string{"issue", "list"}, []string{"issue", "list"}, false, false},
+		{"appends arguments", []string{"co", "42"}, []string{"mr", "checkout", "42"}, false, false},
+		{"placeholders", []string{"mine", "alice", "--state", "merged"}, []string{"mr", "list", "--author", "alice", "--label", "needs review", "--state", "merged"}, false, false},
+		{"missing placeholder argument", []string{"mine"}, nil, false, true},
+		{"commands take precedence", []string{"mr", "list"}, []string{"mr", "list"}, false, false},
+		{"shell alias", []string{"count", "x"}, []string{"sh", "-c", "glab mr list | wc -l", "glab", "x"}, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, isShell, err := ExpandAlias(root, aliases, tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExpandAlias error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) || isShell != tt.wantShell {
+				t.Errorf("ExpandAlias = %q (shell %v), want %q (shell %v)", got, isShell, tt.want, tt.wantShell)
+			}
+		})
+	}
+}
+
+func TestExecute_Alias(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	if err := (&config.Config{Aliases: map[string]string{"aliases": "alias list"}}).Save(); err != nil {
+		t.Fatalf("saving config: %v", err)
+	}
+	f.Config.Aliases = map[string]string{"aliases": "alias list"}
+
+	root := newAliasTestRoot(f)
+	if err := Execute(context.Background(), root, []string{"aliases"}); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if got := f.IO.String(); got != "aliases: alias list\n" {
+		t.Errorf("output = %q, want the alias list", got)
+	}
+}
+
+func TestExecute_CorruptConfig(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	if err := os.WriteFile(filepath.Join(config.ConfigDir(), "config.json"), []byte("{not json"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	root := newAliasTestRoot(f)
+	root.SetOut(f.IO.Out)
+	if err := Execute(context.Background(), root, []string{"--help"}); err != nil {
+		t.Fatalf("Execute --help: %v", err)
+	}
+	cmdtest.AssertContains(t, f.IO.String(), "Usage:")
+
+	err := Execute(context.Background(), root, []string{"nosuch"})
+	if err == nil || !strings.Contains(err.Error(), "unknown command") {
+		t.Errorf("expected an unknown command error, got %v", err)
+	}
+}
+
+func TestSplitAliasArgs(t *testing.T) {
+	got, err := splitAliasArgs(`mr list  --label "a b" --search 'it''s' x\ y`)
+	if err != nil {
+		t.Fatalf("splitAliasArgs: %v", err)
+	}
+	want := []string{"mr", "list", "--label", "a b", "--search", "its", "x y"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("splitAliasArgs = %q, want %q", got, want)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -15,17 +17,31 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// apiRequester sends one request and returns the response and its body.
+type apiRequester func(method, reqURL, body string) (*http.Response, []byte, error)
+
+// apiOutput holds the output options of the api command.
+type apiOutput struct {
+	include  bool
+	silent   bool
+	format   string
+	jsonFlag bool
+}
+
 // NewAPICmd creates the api command.
 func NewAPICmd(f *cmdutil.Factory) *cobra.Command {
 	var (
-		method    string
-		body      string
-		headers   []string
-		hostname  string
-		fields    []string
-		methodSet bool
-		format    string
-		jsonFlag  bool
+		method      string
+		body        string
+		headers     []string
+		hostname    string
+		fields      []string
+		typedFields []string
+		queryFile   string
+		paginate    bool
+		methodSet   bool
+		timeout     time.Duration
+		output      apiOutput
 	)
 
 	cmd := &cobra.Command{
@@ -34,169 +50,344 @@ func NewAPICmd(f *cmdutil.Factory) *cobra.Command {
 		Long: `Make authenticated requests to the GitLab API.
 
 The endpoint can be a path like "projects" which will be resolved to the full API URL.
-Or it can be a full URL starting with "http".`,
+Or it can be a full URL starting with "http".
+
+Fields are sent as a JSON body, or as query parameters with --method GET.
+--field values are strings; --typed-field converts true, false, null, and
+integers, takes "key:=<json>" as raw JSON, and reads "key=@file" from a file
+("@-" for stdin). Repeating "key[]=value" builds an array.
+
+With --paginate, GET requests follow the Link header (or X-Next-Page) until
+the last page and print the concatenated JSON arrays. --include prints the
+status line and headers of each response, --silent suppresses the body, and
+--jq filters the JSON output. Requests limited by the rate limiter (HTTP 429)
+are retried after the time given by the Retry-After or RateLimit-Reset
+header, like every other request glab makes.
+
+The "graphql" endpoint sends a GraphQL query to the host's GraphQL API. The
+query is read from --query-file ("-" for stdin) or a "query" field, and every
+other field becomes a GraphQL variable. With --paginate the query is run
+again with $endCursor set to the pageInfo.endCursor of the previous page
+until hasNextPage is false, and the pages are merged into one result. Errors
+returned by GraphQL are printed and make the command fail.`,
 		Example: `  $ glab api projects
   $ glab api projects/:id/merge_requests
   $ glab api users --method GET
   $ glab api projects/:id/issues --method POST --body '{"title":"Bug"}'
   $ glab api projects/:id/issues -X POST -f title=Bug -f description="Fix it"
   $ glab api projects/:id/merge_requests/1/notes -f body="Looks good!"
-  $ glab api graphql --method POST --body '{"query":"{ currentUser { name } }"}'`,
+  $ glab api projects/:id/issues -X GET -f 'labels[]=bug' -f 'labels[]=ui' -F per_page=50
+  $ glab api projects/:id/snippets -F title=notes -F content=@notes.md -F 'file_name=notes.md'
+  $ glab api projects/:id/issues/1 -X PUT -F confidential:=true
+  $ glab api projects/:id/issues --paginate --jq '.[].title'
+  $ glab api projects/:id --include --silent
+  $ glab api graphql -f query='{ currentUser { name } }'
+  $ glab api graphql --query-file issues.graphql -f fullPath=group/project --paginate`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			methodSet = cmd.Flags().Changed("method")
 			endpoint := args[0]
+			graphql := endpoint == "graphql"
 
-			// Build JSON body from --field flags (validate early before auth)
-			if len(fields) > 0 {
-				jsonObj := make(map[string]interface{})
-
-				// If --body was also provided, use it as the base
-				if body != "" {
-					if err := json.Unmarshal([]byte(body), &jsonObj); err != nil {
-						return fmt.Errorf("parsing --body JSON: %w", err)
-					}
-				}
+			if queryFile != "" && !graphql {
+				return fmt.Errorf("--query-file is only supported for the graphql endpoint")
+			}
 
-				// Overlay --field values
-				for _, f := range fields {
-					parts := strings.SplitN(f, "=", 2)
-					if len(parts) != 2 {
-						return fmt.Errorf("invalid field format %q, expected key=value", f)
-					}
-					jsonObj[parts[0]] = parts[1]
-				}
+			// Parse --field and --typed-field (validate early before auth)
+			params, err := parseAPIFields(f, fields, typedFields)
+			if err != nil {
+				return err
+			}
 
-				b, err := json.Marshal(jsonObj)
+			if graphql {
+				payload, err := buildGraphQLPayload(f, body, queryFile, params, paginate)
 				if err != nil {
-					return fmt.Errorf("encoding fields to JSON: %w", err)
+					return err
 				}
-				body = string(b)
+				return runAPIRequest(f, cmd, hostname, endpoint, headers, timeout, output, func(reqURL string, do apiRequester) error {
+					return runGraphQL(f, do, reqURL, payload, paginate, output)
+				})
+			}
 
-				// Default to POST when fields are provided and method wasn't explicitly set
-				if !methodSet {
+			if len(params) > 0 {
+				// Default to POST when fields are provided and method wasn't
+				// explicitly set, unless paginating
+				if !methodSet && !paginate {
 					method = "POST"
 				}
-			}
 
-			// Resolve host: --hostname flag > factory client > default
-			host := hostname
-			if host == "" {
-				client, err := f.Client()
-				if err == nil {
-					host = client.Host()
+				if strings.EqualFold(method, "GET") {
+					// GET requests send fields as query parameters
+					endpoint, err = addQueryParams(endpoint, params)
+					if err != nil {
+						return err
+					}
 				} else {
-					host = config.DefaultHost()
+					jsonObj := make(map[string]interface{})
+
+					// If --body was also provided, use it as the base
+					if body != "" {
+						if err := json.Unmarshal([]byte(body), &jsonObj); err != nil {
+							return fmt.Errorf("parsing --body JSON: %w", err)
+						}
+					}
+
+					// Overlay field values
+					for k, v := range params {
+						jsonObj[k] = v
+					}
+
+					b, err := json.Marshal(jsonObj)
+					if err != nil {
+						return fmt.Errorf("encoding fields to JSON: %w", err)
+					}
+					body = string(b)
 				}
 			}
 
-			token, tokenSource := config.TokenForHost(host)
-			if token == "" {
-				return fmt.Errorf("not authenticated with %s; run 'glab auth login --hostname %s'", host, host)
+			if paginate && !strings.EqualFold(method, "GET") {
+				return fmt.Errorf("--paginate is only supported for GET requests and the graphql endpoint")
 			}
 
-			authMethod := config.AuthMethodForHost(host)
-			// Only auto-refresh tokens from hosts.json, not env-provided tokens
-			if authMethod == "oauth" && tokenSource != "GITLAB_TOKEN" && tokenSource != "GLAB_TOKEN" {
-				refreshedToken, err := api.RefreshOAuthTokenIfNeeded(host, token)
+			return runAPIRequest(f, cmd, hostname, endpoint, headers, timeout, output, func(reqURL string, do apiRequester) error {
+				if paginate {
+					return paginateAPI(f, do, reqURL, output)
+				}
+				_, respBody, err := do(method, reqURL, body)
 				if err != nil {
 					return err
 				}
-				token = refreshedToken
-			}
+				return printAPIResponse(f, respBody, output)
+			})
+		},
+	}
 
-			// Replace :id and :fullpath with the current project's URL-encoded path
-			if strings.Contains(endpoint, ":id") || strings.Contains(endpoint, ":fullpath") {
-				project, err := f.FullProjectPath()
-				if err != nil {
-					return fmt.Errorf("resolving project for :id placeholder: %w", err)
-				}
-				encoded := url.PathEscape(project)
-				endpoint = strings.ReplaceAll(endpoint, ":id", encoded)
-				endpoint = strings.ReplaceAll(endpoint, ":fullpath", encoded)
-			}
+	cmd.Flags().StringVarP(&method, "method", "X", "GET", "HTTP method")
+	cmd.Flags().StringVar(&body, "body", "", "Request body (JSON)")
+	cmd.Flags().StringArrayVarP(&fields, "field", "f", nil, `Add a string field in "key=value" format`)
+	cmd.Flags().StringArrayVarP(&typedFields, "typed-field", "F", nil, `Add a typed field: "key=value" (true, false, null, numbers), "key:=json", or "key=@file"`)
+	cmd.Flags().StringSliceVarP(&headers, "header", "H", nil, "Additional headers (key:value)")
+	cmd.Flags().StringVar(&hostname, "hostname", "", "GitLab hostname to use")
+	cmd.Flags().StringVar(&queryFile, "query-file", "", `Read the GraphQL query from a file ("-" for stdin)`)
+	cmd.Flags().BoolVar(&paginate, "paginate", false, "Fetch all pages of results")
+	cmd.Flags().DurationVar(&timeout, "timeout", 10*time.Second, "Time limit for each request, including retries (0 for none)")
+	cmd.Flags().BoolVarP(&output.include, "include", "i", false, "Print the HTTP status line and response headers")
+	cmd.Flags().BoolVar(&output.silent, "silent", false, "Do not print the response body")
+	cmd.Flags().StringVar(&output.format, "format", "", "Output format (json|yaml|table)")
+	cmd.Flags().BoolVar(&output.jsonFlag, "json", false, "Output as JSON")
+	f.AddExportFlags(cmd)
 
-			// Build the full URL
-			var reqURL string
-			if strings.HasPrefix(endpoint, "http") {
-				reqURL = endpoint
-			} else {
-				baseURL := api.APIURL(host)
-				endpoint = strings.TrimPrefix(endpoint, "/")
-				reqURL = baseURL + "/" + endpoint
-			}
+	return cmd
+}
 
-			// Create request
-			var reqBody io.Reader
-			if body != "" {
-				reqBody = strings.NewReader(body)
-			}
+// runAPIRequest resolves the host, token, and URL for endpoint and calls run
+// with the URL and a function that sends authenticated requests, each of
+// which fails after timeout unless it is zero.
+func runAPIRequest(f *cmdutil.Factory, cmd *cobra.Command, hostname, endpoint string, headers []string, timeout time.Duration, output apiOutput, run func(string, apiRequester) error) error {
+	// Resolve host: --hostname flag > factory client > default
+	host, _ := config.NormalizeHost(hostname)
+	if host == "" {
+		client, err := f.Client()
+		if err == nil {
+			host = client.Host()
+		} else {
+			host = config.DefaultHost()
+		}
+	}
 
-			req, err := http.NewRequest(strings.ToUpper(method), reqURL, reqBody)
-			if err != nil {
-				return fmt.Errorf("creating request: %w", err)
-			}
+	token, tokenSource := config.TokenForHost(host)
+	if token == "" {
+		return fmt.Errorf("not authenticated with %s; run 'glab auth login --hostname %s'", host, host)
+	}
 
-			if authMethod == "oauth" {
-				req.Header.Set("Authorization", "Bearer "+token)
-			} else {
-				req.Header.Set("PRIVATE-TOKEN", token)
-			}
-			req.Header.Set("Content-Type", "application/json")
+	authMethod := config.AuthMethodForHost(host)
+	// Only auto-refresh tokens from hosts.json, not env-provided tokens
+	if authMethod == "oauth" && tokenSource != "GITLAB_TOKEN" && tokenSource != "GLAB_TOKEN" {
+		refreshedToken, err := api.RefreshOAuthTokenIfNeeded(host, token)
+		if err != nil {
+			return err
+		}
+		token = refreshedToken
+	}
 
-			for _, h := range headers {
-				parts := strings.SplitN(h, ":", 2)
-				if len(parts) == 2 {
-					req.Header.Set(strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]))
-				}
-			}
+	// Replace :id and :fullpath with the current project's URL-encoded path
+	if strings.Contains(endpoint, ":id") || strings.Contains(endpoint, ":fullpath") {
+		project, err := f.FullProjectPath()
+		if err != nil {
+			return fmt.Errorf("resolving project for :id placeholder: %w", err)
+		}
+		encoded := url.PathEscape(project)
+		endpoint = strings.ReplaceAll(endpoint, ":id", encoded)
+		endpoint = strings.ReplaceAll(endpoint, ":fullpath", encoded)
+	}
 
-			client := &http.Client{Timeout: 10 * time.Second}
-			resp, err := client.Do(req)
-			if err != nil {
-				return fmt.Errorf("making request: %w", err)
-			}
-			defer func() { _ = resp.Body.Close() }()
+	// Build the full URL
+	var reqURL string
+	switch {
+	case strings.HasPrefix(endpoint, "http"):
+		reqURL = endpoint
+	case endpoint == "graphql":
+		reqURL = api.GraphQLURL(host)
+	default:
+		baseURL := api.APIURL(host)
+		endpoint = strings.TrimPrefix(endpoint, "/")
+		reqURL = baseURL + "/" + endpoint
+	}
 
-			respBody, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return fmt.Errorf("reading response: %w", err)
+	client := api.NewHTTPClient(timeout)
+	return run(reqURL, func(method, reqURL, body string) (*http.Response, []byte, error) {
+		var reqBody io.Reader
+		if body != "" {
+			reqBody = strings.NewReader(body)
+		}
+
+		req, err := http.NewRequestWithContext(cmd.Context(), strings.ToUpper(method), reqURL, reqBody)
+		if err != nil {
+			return nil, nil, fmt.Errorf("creating request: %w", err)
+		}
+
+		if authMethod == "oauth" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		} else {
+			req.Header.Set("PRIVATE-TOKEN", token)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		for _, h := range headers {
+			parts := strings.SplitN(h, ":", 2)
+			if len(parts) == 2 {
+				req.Header.Set(strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]))
 			}
+		}
 
-			// Parse response if JSON
-			var data interface{}
-			if err := json.Unmarshal(respBody, &data); err == nil {
-				// Backward compatibility: --json flag sets format to json
-				if jsonFlag {
-					format = "json"
-				}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, nil, fmt.Errorf("making request: %w", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
 
-				// If format is specified, validate and use formatter
-				if format != "" {
-					return f.FormatAndPrint(data, format, false)
-				}
+		respBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading response: %w", err)
+		}
+		if output.include {
+			printAPIHeaders(f, resp)
+		}
+		return resp, respBody, nil
+	})
+}
 
-				// Default: pretty-print JSON
-				formatted, err := json.MarshalIndent(data, "", "  ")
-				if err == nil {
-					_, _ = fmt.Fprintln(f.IOStreams.Out, string(formatted))
-					return nil
-				}
+// printAPIHeaders prints the status line and headers of resp, sorted by
+// name, followed by a blank line.
+func printAPIHeaders(f *cmdutil.Factory, resp *http.Response) {
+	out := f.IOStreams.Out
+	_, _ = fmt.Fprintf(out, "%s %s\n", resp.Proto, resp.Status)
+	names := make([]string, 0, len(resp.Header))
+	for name := range resp.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, v := range resp.Header[name] {
+			_, _ = fmt.Fprintf(out, "%s: %s\n", name, v)
+		}
+	}
+	_, _ = fmt.Fprintln(out)
+}
+
+// paginateAPI requests reqURL and every following page, and prints the
+// concatenated arrays. A response that is not an array is printed as is and
+// ends pagination.
+func paginateAPI(f *cmdutil.Factory, do apiRequester, reqURL string, output apiOutput) error {
+	all := []interface{}{}
+	for reqURL != "" {
+		resp, respBody, err := do("GET", reqURL, "")
+		if err != nil {
+			return err
+		}
+		var page []interface{}
+		if err := json.Unmarshal(respBody, &page); err != nil {
+			if len(all) > 0 {
+				return fmt.Errorf("page %s did not return a JSON array", reqURL)
 			}
+			return printAPIResponse(f, respBody, output)
+		}
+		all = append(all, page...)
+		reqURL = nextPageURL(resp, reqURL)
+	}
+	return printAPIData(f, all, output)
+}
 
-			// Fall back to raw output for non-JSON responses
-			_, _ = fmt.Fprintln(f.IOStreams.Out, string(respBody))
-			return nil
-		},
+// linkNextRe matches the next page in a Link header.
+var linkNextRe = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+
+// nextPageURL returns the URL of the page after resp from its Link header,
+// or from X-Next-Page when the Link header is absent (as with keyset
+// pagination disabled on some endpoints). It returns "" on the last page.
+func nextPageURL(resp *http.Response, current string) string {
+	if m := linkNextRe.FindStringSubmatch(resp.Header.Get("Link")); m != nil {
+		return m[1]
+	}
+	next := resp.Header.Get("X-Next-Page")
+	if next == "" {
+		return ""
 	}
+	u, err := url.Parse(current)
+	if err != nil {
+		return ""
+	}
+	q := u.Query()
+	q.Set("page", next)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
 
-	cmd.Flags().StringVarP(&method, "method", "X", "GET", "HTTP method")
-	cmd.Flags().StringVar(&body, "body", "", "Request body (JSON)")
-	cmd.Flags().StringArrayVarP(&fields, "field", "f", nil, `Add a string field in "key=value" format`)
-	cmd.Flags().StringSliceVarP(&headers, "header", "H", nil, "Additional headers (key:value)")
-	cmd.Flags().StringVar(&hostname, "hostname", "", "GitLab hostname to use")
-	cmd.Flags().StringVar(&format, "format", "", "Output format (json|yaml|table)")
-	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON")
+// printAPIResponse prints a response body, pretty-printing or formatting
+// it when it is JSON.
+func printAPIResponse(f *cmdutil.Factory, respBody []byte, output apiOutput) error {
+	if output.silent {
+		return nil
+	}
 
-	return cmd
+	// Parse response if JSON
+	var data interface{}
+	if err := json.Unmarshal(respBody, &data); err == nil {
+		return printAPIData(f, data, output)
+	}
+
+	// Fall back to raw output for non-JSON responses
+	_, _ = fmt.Fprintln(f.IOStreams.Out, string(respBody))
+	return nil
+}
+
+// printAPIData prints decoded JSON in the requested format, indented JSON
+// by default.
+func printAPIData(f *cmdutil.Factory, data interface{}, output apiOutput) error {
+	if output.silent {
+		return nil
+	}
+
+	// Backward compatibility: --json flag sets format to json
+	format := output.format
+	if output.jsonFlag {
+		format = "json"
+	}
+
+	// --jq and --template filter the JSON output
+	if f.ExportRequested() {
+		return f.FormatAndPrint(data, "json", false)
+	}
+
+	// If format is specified, validate and use formatter
+	if format != "" {
+		return f.FormatAndPrint(data, format, false)
+	}
+
+	// Default: pretty-print JSON
+	formatted, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintln(f.IOStreams.Out, string(formatted))
+	return nil
 }
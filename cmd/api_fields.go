@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+)
+
+// parseAPIFields returns the parameters given with --field and
+// --typed-field. --field values are strings. --typed-field values are read
+// from a file with "key=@file" ("@-" for stdin), taken as raw JSON with
+// "key:=value", and otherwise converted to true, false, null, or a number
+// when they look like one. Keys ending in "[]" collect their values into an
+// array.
+func parseAPIFields(f *cmdutil.Factory, raw, typed []string) (map[string]interface{}, error) {
+	params := map[string]interface{}{}
+	add := func(key string, value interface{}) {
+		if name, ok := strings.CutSuffix(key, "[]"); ok {
+			list, _ := params[name].([]interface{})
+			params[name] = append(list, value)
+			return
+		}
+		params[key] = value
+	}
+
+	for _, field := range raw {
+		key, value, ok := strings.Cut(field, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid field format %q, expected key=value", field)
+		}
+		add(key, value)
+	}
+
+	for _, field := range typed {
+		if key, value, ok := strings.Cut(field, ":="); ok && !strings.Contains(key, "=") {
+			var v interface{}
+			if err := json.Unmarshal([]byte(value), &v); err != nil {
+				return nil, fmt.Errorf("invalid JSON value for %q: %w", key, err)
+			}
+			add(key, v)
+			continue
+		}
+
+		key, value, ok := strings.Cut(field, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid field format %q, expected key=value, key:=json, or key=@file", field)
+		}
+		if path, ok := strings.CutPrefix(value, "@"); ok {
+			var (
+				data []byte
+				err  error
+			)
+			if path == "-" {
+				data, err = io.ReadAll(f.IOStreams.In)
+			} else {
+				data, err = os.ReadFile(path)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("reading value of %q: %w", key, err)
+			}
+			add(key, string(data))
+			continue
+		}
+		add(key, magicFieldValue(value))
+	}
+
+	return params, nil
+}
+
+// magicFieldValue converts true, false, null, and integers to their JSON
+// types and leaves other values as strings.
+func magicFieldValue(v string) interface{} {
+	switch v {
+	case "true":
+		return true
+	case "false":
+		return false
+	case "null":
+		return nil
+	}
+	if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return n
+	}
+	return v
+}
+
+// addQueryParams appends params to endpoint as a query string. Arrays are
+// sent as repeated "key[]" parameters, as the GitLab API expects.
+func addQueryParams(endpoint string, params map[string]interface{}) (string, error) {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, k := range keys {
+		switch v := params[k].(type) {
+		case []interface{}:
+			for _, item := range v {
+				s, err := queryValue(k, item)
+				if err != nil {
+					return "", err
+				}
+				parts = append(parts, url.QueryEscape(k+"[]")+"="+url.QueryEscape(s))
+			}
+		default:
+			s, err := queryValue(k, v)
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, url.QueryEscape(k)+"="+url.QueryEscape(s))
+		}
+	}
+	if len(parts) == 0 {
+		return endpoint, nil
+	}
+
+	sep := "?"
+	if strings.Contains(endpoint, "?") {
+		sep = "&"
+	}
+	return endpoint + sep + strings.Join(parts, "&"), nil
+}
+
+// queryValue formats a parameter value for a query string.
+func queryValue(key string, v interface{}) (string, error) {
+	switch v := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case map[string]interface{}, []interface{}:
+		return "", fmt.Errorf("parameter %q cannot be sent in a query string; use a method with a request body", key)
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
@@ -0,0 +1,228 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+)
+
+// graphQLError is an entry of the errors array of a GraphQL response.
+type graphQLError struct {
+	Message   string `json:"message"`
+	Locations []struct {
+		Line   int `json:"line"`
+		Column int `json:"column"`
+	} `json:"locations"`
+	Path []interface{} `json:"path"`
+}
+
+// buildGraphQLPayload returns the request body of a GraphQL query: --body is
+// the base, --query-file or the "query" field sets the query, an
+// "operationName" field sets the operation, and all other fields become
+// variables.
+func buildGraphQLPayload(f *cmdutil.Factory, body, queryFile string, params map[string]interface{}, paginate bool) (map[string]interface{}, error) {
+	payload := map[string]interface{}{}
+	if body != "" {
+		if err := json.Unmarshal([]byte(body), &payload); err != nil {
+			return nil, fmt.Errorf("parsing --body JSON: %w", err)
+		}
+	}
+
+	if queryFile != "" {
+		var (
+			data []byte
+			err  error
+		)
+		if queryFile == "-" {
+			data, err = io.ReadAll(f.IOStreams.In)
+		} else {
+			data, err = os.ReadFile(queryFile)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading query: %w", err)
+		}
+		payload["query"] = string(data)
+	}
+
+	variables, _ := payload["variables"].(map[string]interface{})
+	if variables == nil {
+		variables = map[string]interface{}{}
+	}
+	for key, value := range params {
+		switch key {
+		case "query", "operationName":
+			payload[key] = value
+		default:
+			variables[key] = value
+		}
+	}
+	if len(variables) > 0 {
+		payload["variables"] = variables
+	}
+
+	query, _ := payload["query"].(string)
+	if strings.TrimSpace(query) == "" {
+		return nil, fmt.Errorf("a GraphQL query is required; use --query-file or --field query=...")
+	}
+	if paginate && !strings.Contains(query, "$endCursor") {
+		return nil, fmt.Errorf("--paginate requires the query to accept an $endCursor variable and select pageInfo { hasNextPage endCursor }")
+	}
+	return payload, nil
+}
+
+// runGraphQL sends a GraphQL query, following pageInfo.endCursor when
+// paginating, and prints the (merged) result.
+func runGraphQL(f *cmdutil.Factory, do apiRequester, reqURL string, payload map[string]interface{}, paginate bool, output apiOutput) error {
+	var result map[string]interface{}
+	for {
+		body, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("encoding GraphQL request: %w", err)
+		}
+		resp, respBody, err := do("POST", reqURL, string(body))
+		if err != nil {
+			return err
+		}
+
+		var page map[string]interface{}
+		if err := json.Unmarshal(respBody, &page); err != nil {
+			if !output.silent {
+				_, _ = fmt.Fprintln(f.IOStreams.Out, string(respBody))
+			}
+			if resp.StatusCode >= 400 {
+				return fmt.Errorf("GraphQL request failed: %s", resp.Status)
+			}
+			return nil
+		}
+
+		if errs := parseGraphQLErrors(page); len(errs) > 0 {
+			if page["data"] != nil {
+				_ = printAPIData(f, page, output)
+			}
+			printGraphQLErrors(f, errs)
+			return fmt.Errorf("GraphQL query returned %d error(s)", len(errs))
+		}
+
+		if result == nil {
+			result = page
+		} else {
+			mergeGraphQLPage(result, page)
+		}
+		if !paginate {
+			break
+		}
+
+		_, conn := findGraphQLConnection(page["data"], nil)
+		if conn == nil {
+			return fmt.Errorf("--paginate requires the query to select pageInfo { hasNextPage endCursor }")
+		}
+		info, _ := conn["pageInfo"].(map[string]interface{})
+		cursor, _ := info["endCursor"].(string)
+		if next, _ := info["hasNextPage"].(bool); !next || cursor == "" {
+			break
+		}
+		variables, _ := payload["variables"].(map[string]interface{})
+		if variables == nil {
+			variables = map[string]interface{}{}
+			payload["variables"] = variables
+		}
+		variables["endCursor"] = cursor
+	}
+
+	return printAPIData(f, result, output)
+}
+
+// parseGraphQLErrors returns the errors array of a GraphQL response.
+func parseGraphQLErrors(page map[string]interface{}) []graphQLError {
+	raw, ok := page["errors"]
+	if !ok {
+		return nil
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil
+	}
+	var errs []graphQLError
+	if err := json.Unmarshal(data, &errs); err != nil {
+		return []graphQLError{{Message: string(data)}}
+	}
+	return errs
+}
+
+// printGraphQLErrors prints GraphQL errors with their location and path.
+func printGraphQLErrors(f *cmdutil.Factory, errs []graphQLError) {
+	cs := f.IOStreams.ColorScheme()
+	for _, e := range errs {
+		_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "%s %s\n", cs.Red("GraphQL error:"), e.Message)
+		var where []string
+		for _, l := range e.Locations {
+			where = append(where, fmt.Sprintf("line %d, column %d", l.Line, l.Column))
+		}
+		if len(e.Path) > 0 {
+			parts := make([]string, len(e.Path))
+			for i, p := range e.Path {
+				parts[i] = fmt.Sprint(p)
+			}
+			where = append(where, "path "+strings.Join(parts, "."))
+		}
+		if len(where) > 0 {
+			_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "  at %s\n", strings.Join(where, "; "))
+		}
+	}
+}
+
+// findGraphQLConnection returns the path to and value of the first object
+// in v with a pageInfo field, searching keys in sorted order.
+func findGraphQLConnection(v interface{}, path []string) ([]string, map[string]interface{}) {
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	if _, ok := obj["pageInfo"].(map[string]interface{}); ok {
+		return path, obj
+	}
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if p, conn := findGraphQLConnection(obj[k], append(append([]string{}, path...), k)); conn != nil {
+			return p, conn
+		}
+	}
+	return nil, nil
+}
+
+// mergeGraphQLPage appends the nodes and edges of the paginated connection
+// in page to the same connection in result and takes over its pageInfo.
+func mergeGraphQLPage(result, page map[string]interface{}) {
+	path, conn := findGraphQLConnection(page["data"], nil)
+	if conn == nil {
+		return
+	}
+	var target interface{} = result["data"]
+	for _, k := range path {
+		obj, ok := target.(map[string]interface{})
+		if !ok {
+			return
+		}
+		target = obj[k]
+	}
+	dst, ok := target.(map[string]interface{})
+	if !ok {
+		return
+	}
+	for _, key := range []string{"nodes", "edges"} {
+		if items, ok := conn[key].([]interface{}); ok {
+			existing, _ := dst[key].([]interface{})
+			dst[key] = append(existing, items...)
+		}
+	}
+	dst["pageInfo"] = conn["pageInfo"]
+}
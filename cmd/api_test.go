@@ -1,9 +1,14 @@
 package cmd
 
 import (
+	"encoding/json"
+	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
 )
@@ -195,3 +200,328 @@ func TestAPI_WithData(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestAPI_GraphQLVariables(t *testing.T) {
+	var got map[string]interface{}
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/graphql" {
+			cmdtest.ErrorResponse(w, 404, "not found")
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		cmdtest.JSONResponse(w, 200, map[string]interface{}{
+			"data": map[string]interface{}{"project": map[string]interface{}{"name": "test-repo"}},
+		})
+	})
+
+	queryFile := filepath.Join(t.TempDir(), "project.graphql")
+	if err := os.WriteFile(queryFile, []byte("query($fullPath: ID!) { project(fullPath: $fullPath) { name } }"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewAPICmd(f.Factory)
+	cmd.SetArgs([]string{"graphql", "--query-file", queryFile, "-f", "fullPath=test-owner/test-repo"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(got["query"].(string), "query($fullPath") {
+		t.Errorf("query = %v", got["query"])
+	}
+	if vars, _ := got["variables"].(map[string]interface{}); vars["fullPath"] != "test-owner/test-repo" {
+		t.Errorf("variables = %v", got["variables"])
+	}
+	if !strings.Contains(f.IO.String(), "test-repo") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestAPI_GraphQLPaginate(t *testing.T) {
+	var cursors []interface{}
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		cursors = append(cursors, req.Variables["endCursor"])
+
+		page := map[string]interface{}{
+			"nodes":    []interface{}{map[string]interface{}{"iid": "1"}},
+			"pageInfo": map[string]interface{}{"hasNextPage": true, "endCursor": "c1"},
+		}
+		if req.Variables["endCursor"] == "c1" {
+			page = map[string]interface{}{
+				"nodes":    []interface{}{map[string]interface{}{"iid": "2"}},
+				"pageInfo": map[string]interface{}{"hasNextPage": false, "endCursor": "c2"},
+			}
+		}
+		cmdtest.JSONResponse(w, 200, map[string]interface{}{
+			"data": map[string]interface{}{"project": map[string]interface{}{"issues": page}},
+		})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewAPICmd(f.Factory)
+	cmd.SetArgs([]string{"graphql", "--paginate", "-f",
+		"query=query($endCursor: String) { project(fullPath: \"a/b\") { issues(after: $endCursor) { nodes { iid } pageInfo { hasNextPage endCursor } } } }"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cursors) != 2 || cursors[0] != nil || cursors[1] != "c1" {
+		t.Errorf("cursors = %v", cursors)
+	}
+
+	var out struct {
+		Data struct {
+			Project struct {
+				Issues struct {
+					Nodes []struct {
+						IID string `json:"iid"`
+					} `json:"nodes"`
+					PageInfo struct {
+						EndCursor string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"issues"`
+			} `json:"project"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(f.IO.String()), &out); err != nil {
+		t.Fatalf("output is not JSON: %v\n%s", err, f.IO.String())
+	}
+	issues := out.Data.Project.Issues
+	if len(issues.Nodes) != 2 || issues.Nodes[1].IID != "2" || issues.PageInfo.EndCursor != "c2" {
+		t.Errorf("merged pages = %+v", issues)
+	}
+}
+
+func TestAPI_GraphQLErrors(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		cmdtest.JSONResponse(w, 200, map[string]interface{}{
+			"errors": []interface{}{map[string]interface{}{
+				"message":   "Field 'nope' doesn't exist on type 'Query'",
+				"locations": []interface{}{map[string]interface{}{"line": 1, "column": 3}},
+				"path":      []interface{}{"query", "nope"},
+			}},
+		})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewAPICmd(f.Factory)
+	cmd.SetArgs([]string{"graphql", "-f", "query={ nope }"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "1 error(s)") {
+		t.Fatalf("expected a GraphQL error, got %v", err)
+	}
+	stderr := f.IO.ErrString()
+	for _, want := range []string{"GraphQL error: Field 'nope' doesn't exist", "line 1, column 3", "path query.nope"} {
+		if !strings.Contains(stderr, want) {
+			t.Errorf("stderr missing %q: %s", want, stderr)
+		}
+	}
+}
+
+func TestAPI_GraphQLRequiresQuery(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewAPICmd(f.Factory)
+	cmd.SetArgs([]string{"graphql", "-f", "fullPath=a/b"})
+
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "query is required") {
+		t.Errorf("expected a missing query error, got %v", err)
+	}
+
+	cmd = NewAPICmd(f.Factory)
+	cmd.SetArgs([]string{"graphql", "--paginate", "-f", "query={ currentUser { name } }"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "$endCursor") {
+		t.Errorf("expected an $endCursor error, got %v", err)
+	}
+}
+
+func TestAPI_Paginate(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "", "1":
+			w.Header().Set("Link", `<https://gitlab.com/api/v4/projects?page=2&per_page=1>; rel="next", <https://gitlab.com/api/v4/projects?page=3&per_page=1>; rel="last"`)
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{{"id": 1}})
+		case "2":
+			// No Link header: fall back to X-Next-Page.
+			w.Header().Set("X-Next-Page", "3")
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{{"id": 2}})
+		default:
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{{"id": 3}})
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewAPICmd(f.Factory)
+	cmd.SetArgs([]string{"projects", "--paginate", "--jq", ".[].id"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := f.IO.String(); got != "1\n2\n3\n" {
+		t.Errorf("output = %q, want the ids of all pages", got)
+	}
+}
+
+func TestAPI_PaginateRequiresGET(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewAPICmd(f.Factory)
+	cmd.SetArgs([]string{"projects", "--paginate", "-X", "POST"})
+
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "GET") {
+		t.Errorf("expected an error for --paginate with POST, got %v", err)
+	}
+}
+
+func TestAPI_IncludeSilent(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Total", "42")
+		cmdtest.JSONResponse(w, 200, map[string]interface{}{"id": 1})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewAPICmd(f.Factory)
+	cmd.SetArgs([]string{"projects/1", "--include", "--silent"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := f.IO.String()
+	if !strings.HasPrefix(out, "HTTP/1.1 200 OK\n") || !strings.Contains(out, "X-Total: 42\n") {
+		t.Errorf("expected status line and headers, got: %s", out)
+	}
+	if strings.Contains(out, `"id"`) {
+		t.Errorf("--silent printed the body: %s", out)
+	}
+}
+
+func TestAPI_RetriesRateLimited(t *testing.T) {
+	var bodies []string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) == 1 {
+			w.Header().Set("Retry-After", "1")
+			cmdtest.ErrorResponse(w, 429, "Too Many Requests")
+			return
+		}
+		cmdtest.JSONResponse(w, 201, map[string]interface{}{"created": true})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewAPICmd(f.Factory)
+	cmd.SetArgs([]string{"projects", "-f", "name=x"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(bodies) != 2 || bodies[1] != `{"name":"x"}` {
+		t.Errorf("requests = %q, want the body resent after the 429", bodies)
+	}
+	if !strings.Contains(f.IO.String(), "created") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestAPI_Timeout(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewAPICmd(f.Factory)
+	cmd.SetArgs([]string{"projects", "--timeout", "50ms"})
+
+	start := time.Now()
+	err := cmd.Execute()
+	if err == nil {
+		t.Fatal("expected a timeout error from a stalled server")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("expected the request to give up after --timeout, took %s", elapsed)
+	}
+}
+
+func TestAPI_TypedFields(t *testing.T) {
+	var got map[string]interface{}
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		cmdtest.JSONResponse(w, 201, map[string]interface{}{"id": 1})
+	})
+
+	content := filepath.Join(t.TempDir(), "notes.md")
+	if err := os.WriteFile(content, []byte("# Notes\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewAPICmd(f.Factory)
+	cmd.SetArgs([]string{"projects/:id/snippets",
+		"-f", "title=42",
+		"-F", "content=@" + content,
+		"-F", "count=3",
+		"-F", "draft=true",
+		"-F", `meta:={"a":[1,2]}`,
+		"-f", "labels[]=bug", "-f", "labels[]=ui",
+	})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]interface{}{
+		"title":   "42",
+		"content": "# Notes\n",
+		"count":   float64(3),
+		"draft":   true,
+		"meta":    map[string]interface{}{"a": []interface{}{float64(1), float64(2)}},
+		"labels":  []interface{}{"bug", "ui"},
+	}
+	gotJSON, _ := json.Marshal(got)
+	wantJSON, _ := json.Marshal(want)
+	if string(gotJSON) != string(wantJSON) {
+		t.Errorf("body = %s, want %s", gotJSON, wantJSON)
+	}
+}
+
+func TestAPI_GETFieldsAsQuery(t *testing.T) {
+	var rawQuery, method string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		rawQuery, method = r.URL.RawQuery, r.Method
+		cmdtest.JSONResponse(w, 200, []interface{}{})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewAPICmd(f.Factory)
+	cmd.SetArgs([]string{"projects?simple=true", "-X", "GET", "-f", "labels[]=bug", "-f", "labels[]=ui", "-F", "per_page=5"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if method != http.MethodGet {
+		t.Errorf("method = %s, want GET", method)
+	}
+	if rawQuery != "simple=true&labels%5B%5D=bug&labels%5B%5D=ui&per_page=5" {
+		t.Errorf("query = %s", rawQuery)
+	}
+}
+
+func TestAPI_TypedFieldErrors(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	for _, args := range [][]string{
+		{"projects", "-F", "meta:={bad"},
+		{"projects", "-F", "novalue"},
+		{"projects", "-X", "GET", "-F", `meta:={"a":1}`},
+	} {
+		cmd := NewAPICmd(f.Factory)
+		cmd.SetArgs(args)
+		if err := cmd.Execute(); err == nil {
+			t.Errorf("%v: expected an error", args)
+		}
+	}
+}
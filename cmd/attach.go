@@ -0,0 +1,266 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// attachOptions selects what "attach" does with the uploaded files' links.
+type attachOptions struct {
+	Comment  bool
+	LinkOnly bool
+}
+
+func newIssueAttachCmd(f *cmdutil.Factory) *cobra.Command {
+	var opts attachOptions
+
+	cmd := &cobra.Command{
+		Use:   "attach <id> <file>...",
+		Short: "Attach files to an issue",
+		Long: `Upload files to the project and link them from an issue.
+
+By default the Markdown links are appended to the issue description. Use
+--comment to post them as a new comment instead, or --link-only to just print
+them for use elsewhere.`,
+		Example: `  $ glab issue attach 42 ./screenshot.png
+  $ glab issue attach 42 crash.log trace.txt --comment
+  $ glab issue attach 42 ./screenshot.png --link-only`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Comment && opts.LinkOnly {
+				return fmt.Errorf("--comment and --link-only cannot be used together")
+			}
+
+			issueID, err := parseIssueArg(args)
+			if err != nil {
+				return err
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			links, err := uploadAttachments(cmd.Context(), f, client, project, args[1:])
+			if err != nil {
+				return err
+			}
+
+			out := f.IOStreams.Out
+			switch {
+			case opts.LinkOnly:
+				_, _ = fmt.Fprintln(out, links)
+				return nil
+			case opts.Comment:
+				_, resp, err := client.Notes.CreateIssueNote(project, issueID, &gitlab.CreateIssueNoteOptions{
+					Body: &links,
+				}, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/issues/%d/notes", api.APIURL(client.Host()), project, issueID)
+					return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to comment on issue #%d", issueID), err)
+				}
+			default:
+				issue, resp, err := client.Issues.GetIssue(project, issueID, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/issues/%d", api.APIURL(client.Host()), project, issueID)
+					return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get issue #%d", issueID), err)
+				}
+
+				description := appendAttachments(issue.Description, links)
+				_, resp, err = client.Issues.UpdateIssue(project, issueID, &gitlab.UpdateIssueOptions{
+					Description: &description,
+				}, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/issues/%d", api.APIURL(client.Host()), project, issueID)
+					return errors.NewAPIError("PUT", url, statusCode, fmt.Sprintf("Failed to update issue #%d", issueID), err)
+				}
+			}
+
+			_, _ = fmt.Fprintf(out, "Attached %s to issue #%d\n", filesCount(len(args)-1), issueID)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Comment, "comment", false, "Post the links as a new comment instead of editing the description")
+	cmd.Flags().BoolVar(&opts.LinkOnly, "link-only", false, "Only upload the files and print their Markdown links")
+
+	return cmd
+}
+
+func newMRAttachCmd(f *cmdutil.Factory) *cobra.Command {
+	var opts attachOptions
+
+	cmd := &cobra.Command{
+		Use:   "attach <id> <file>...",
+		Short: "Attach files to a merge request",
+		Long: `Upload files to the project and link them from a merge request.
+
+By default the Markdown links are appended to the merge request description.
+Use --comment to post them as a new comment instead, or --link-only to just
+print them for use elsewhere.`,
+		Example: `  $ glab mr attach 123 ./before.png ./after.png
+  $ glab mr attach 123 benchmark.txt --comment
+  $ glab mr attach 123 ./screenshot.png --link-only`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Comment && opts.LinkOnly {
+				return fmt.Errorf("--comment and --link-only cannot be used together")
+			}
+
+			mrID, err := parseMRArg(args)
+			if err != nil {
+				return err
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			links, err := uploadAttachments(cmd.Context(), f, client, project, args[1:])
+			if err != nil {
+				return err
+			}
+
+			out := f.IOStreams.Out
+			switch {
+			case opts.LinkOnly:
+				_, _ = fmt.Fprintln(out, links)
+				return nil
+			case opts.Comment:
+				_, resp, err := client.Notes.CreateMergeRequestNote(project, mrID, &gitlab.CreateMergeRequestNoteOptions{
+					Body: &links,
+				}, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/merge_requests/%d/notes", api.APIURL(client.Host()), project, mrID)
+					return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to comment on merge request !%d", mrID), err)
+				}
+			default:
+				mr, resp, err := client.MergeRequests.GetMergeRequest(project, mrID, nil, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/merge_requests/%d", api.APIURL(client.Host()), project, mrID)
+					return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get merge request !%d", mrID), err)
+				}
+
+				description := appendAttachments(mr.Description, links)
+				_, resp, err = client.MergeRequests.UpdateMergeRequest(project, mrID, &gitlab.UpdateMergeRequestOptions{
+					Description: &description,
+				}, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/merge_requests/%d", api.APIURL(client.Host()), project, mrID)
+					return errors.NewAPIError("PUT", url, statusCode, fmt.Sprintf("Failed to update merge request !%d", mrID), err)
+				}
+			}
+
+			_, _ = fmt.Fprintf(out, "Attached %s to merge request !%d\n", filesCount(len(args)-1), mrID)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Comment, "comment", false, "Post the links as a new comment instead of editing the description")
+	cmd.Flags().BoolVar(&opts.LinkOnly, "link-only", false, "Only upload the files and print their Markdown links")
+
+	return cmd
+}
+
+// uploadAttachments uploads files to the project and returns their Markdown
+// links, one per line. All files are checked before any is uploaded so a typo
+// in the last path does not leave orphaned uploads behind.
+func uploadAttachments(ctx context.Context, f *cmdutil.Factory, client *api.Client, project string, paths []string) (string, error) {
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return "", fmt.Errorf("cannot attach %s: %w", path, err)
+		}
+		if info.IsDir() {
+			return "", fmt.Errorf("cannot attach %s: is a directory", path)
+		}
+	}
+
+	links := make([]string, 0, len(paths))
+	for i, path := range paths {
+		_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "[%d/%d] Uploading %s\n", i+1, len(paths), path)
+
+		file, err := os.Open(path)
+		if err != nil {
+			return "", fmt.Errorf("cannot attach %s: %w", path, err)
+		}
+		upload, resp, err := client.ProjectMarkdownUploads.UploadProjectMarkdown(project, file, filepath.Base(path), gitlab.WithContext(ctx))
+		_ = file.Close()
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			url := fmt.Sprintf("%s/projects/%s/uploads", api.APIURL(client.Host()), project)
+			return "", errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to upload %s", path), err)
+		}
+
+		link := upload.Markdown
+		if link == "" {
+			link = fmt.Sprintf("[%s](%s)", upload.Alt, upload.URL)
+		}
+		links = append(links, link)
+	}
+	return strings.Join(links, "\n"), nil
+}
+
+// appendAttachments appends attachment links to a description as a new
+// paragraph.
+func appendAttachments(description, links string) string {
+	description = strings.TrimRight(description, "\n")
+	if description == "" {
+		return links
+	}
+	return description + "\n\n" + links
+}
+
+func filesCount(n int) string {
+	if n == 1 {
+		return "1 file"
+	}
+	return fmt.Sprintf("%d files", n)
+}
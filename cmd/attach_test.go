@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func writeAttachment(t *testing.T, name string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte("data"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func uploadResponse(w http.ResponseWriter, r *http.Request) {
+	_, header, err := r.FormFile("file")
+	if err != nil {
+		cmdtest.ErrorResponse(w, 400, "400 missing file")
+		return
+	}
+	cmdtest.JSONResponse(w, 201, map[string]interface{}{
+		"alt":      header.Filename,
+		"url":      "/uploads/abc/" + header.Filename,
+		"markdown": "![" + header.Filename + "](/uploads/abc/" + header.Filename + ")",
+	})
+}
+
+func TestIssueAttach_AppendsToDescription(t *testing.T) {
+	var description string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/uploads"):
+			uploadResponse(w, r)
+		case r.Method == "GET" && strings.HasSuffix(r.URL.Path, "/issues/42"):
+			cmdtest.JSONResponse(w, 200, map[string]interface{}{
+				"id": 1, "iid": 42, "title": "Crash", "description": "It crashes.\n",
+			})
+		case r.Method == "PUT" && strings.HasSuffix(r.URL.Path, "/issues/42"):
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			description, _ = body["description"].(string)
+			cmdtest.JSONResponse(w, 200, map[string]interface{}{"id": 1, "iid": 42})
+		default:
+			cmdtest.ErrorResponse(w, 404, "404 Not Found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueAttachCmd(f.Factory)
+	cmd.SetArgs([]string{"42", writeAttachment(t, "screenshot.png")})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "It crashes.\n\n![screenshot.png](/uploads/abc/screenshot.png)"
+	if description != want {
+		t.Errorf("description = %q, want %q", description, want)
+	}
+	if !strings.Contains(f.IO.String(), "Attached 1 file to issue #42") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestMRAttach_Comment(t *testing.T) {
+	var body string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/uploads"):
+			uploadResponse(w, r)
+		case r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/merge_requests/7/notes"):
+			var req map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			body, _ = req["body"].(string)
+			cmdtest.JSONResponse(w, 201, map[string]interface{}{"id": 1, "body": body})
+		default:
+			cmdtest.ErrorResponse(w, 404, "404 Not Found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRAttachCmd(f.Factory)
+	cmd.SetArgs([]string{"7", writeAttachment(t, "before.png"), writeAttachment(t, "after.png"), "--comment"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "![before.png](/uploads/abc/before.png)\n![after.png](/uploads/abc/after.png)"
+	if body != want {
+		t.Errorf("comment body = %q, want %q", body, want)
+	}
+	if !strings.Contains(f.IO.String(), "Attached 2 files to merge request !7") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+	if !strings.Contains(f.IO.ErrString(), "[2/2] Uploading") {
+		t.Errorf("expected upload progress, got: %s", f.IO.ErrString())
+	}
+}
+
+func TestIssueAttach_LinkOnly(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/uploads") {
+			uploadResponse(w, r)
+			return
+		}
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		cmdtest.ErrorResponse(w, 404, "404 Not Found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueAttachCmd(f.Factory)
+	cmd.SetArgs([]string{"42", writeAttachment(t, "trace.txt"), "--link-only"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := strings.TrimSpace(f.IO.String()); got != "![trace.txt](/uploads/abc/trace.txt)" {
+		t.Errorf("unexpected output: %q", got)
+	}
+}
+
+func TestIssueAttach_MissingFileUploadsNothing(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		cmdtest.ErrorResponse(w, 404, "404 Not Found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueAttachCmd(f.Factory)
+	cmd.SetArgs([]string{"42", writeAttachment(t, "ok.png"), filepath.Join(t.TempDir(), "missing.png")})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "missing.png") {
+		t.Errorf("expected missing file error, got %v", err)
+	}
+}
+
+func TestAppendAttachments(t *testing.T) {
+	if got := appendAttachments("", "link"); got != "link" {
+		t.Errorf("empty description: got %q", got)
+	}
+	if got := appendAttachments("text\n\n", "link"); got != "text\n\nlink" {
+		t.Errorf("got %q", got)
+	}
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestAuditList(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		wantPath string
+		wantQ    []string
+	}{
+		{"project", []string{}, "/api/v4/projects/test-owner/test-repo/audit_events", nil},
+		{"explicit project", []string{"--project", "owner/repo"}, "/api/v4/projects/owner/repo/audit_events", nil},
+		{"group", []string{"--group", "my-org"}, "/api/v4/groups/my-org/audit_events", nil},
+		{"instance with range", []string{"--instance", "--since", "2024-01-01", "--until", "2024-02-01"}, "/api/v4/audit_events", []string{"created_after=2024-01-01", "created_before=2024-02-01"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != tt.wantPath {
+					t.Errorf("path = %s, want %s", r.URL.Path, tt.wantPath)
+				}
+				for _, q := range tt.wantQ {
+					if !strings.Contains(r.URL.RawQuery, q) {
+						t.Errorf("query %q missing %s", r.URL.RawQuery, q)
+					}
+				}
+				cmdtest.JSONResponse(w, 200, []map[string]interface{}{{
+					"id":          7,
+					"entity_type": "Project",
+					"event_name":  "project_feature_updated",
+					"details": map[string]interface{}{
+						"author_name": "Jane Doe",
+						"change":      "visibility",
+						"from":        "private",
+						"to":          "public",
+						"entity_path": "owner/repo",
+						"ip_address":  "10.0.0.1",
+					},
+					"created_at": "2024-01-15T10:00:00Z",
+				}})
+			})
+
+			f := cmdtest.NewTestFactory(t)
+			cmd := newAuditListCmd(f.Factory)
+			cmd.SetArgs(tt.args)
+
+			if err := cmd.Execute(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			out := strings.Join(strings.Fields(f.IO.String()), " ")
+			if !strings.Contains(out, `7 Jane Doe owner/repo changed visibility from "private" to "public" 10.0.0.1`) {
+				t.Errorf("unexpected output: %s", out)
+			}
+		})
+	}
+}
+
+func TestAuditList_MutuallyExclusive(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newAuditListCmd(f.Factory)
+	cmd.SetArgs([]string{"--group", "my-org", "--instance"})
+
+	if err := cmd.Execute(); err == nil {
+		t.Error("expected error for --group with --instance")
+	}
+}
+
+func TestAuditEventAction(t *testing.T) {
+	tests := []struct {
+		event gitlab.AuditEvent
+		want  string
+	}{
+		{gitlab.AuditEvent{Details: gitlab.AuditEventDetails{CustomMessage: "Added SSH key"}}, "Added SSH key"},
+		{gitlab.AuditEvent{Details: gitlab.AuditEventDetails{Add: "user_access", TargetDetails: "jdoe"}}, "added user_access jdoe"},
+		{gitlab.AuditEvent{Details: gitlab.AuditEventDetails{Remove: "deploy_key"}}, "removed deploy_key"},
+		{gitlab.AuditEvent{Details: gitlab.AuditEventDetails{Change: "name"}}, "changed name"},
+		{gitlab.AuditEvent{EventName: "user_blocked"}, "user_blocked"},
+	}
+
+	for _, tt := range tests {
+		if got := auditEventAction(&tt.event); got != tt.want {
+			t.Errorf("auditEventAction() = %q, want %q", got, tt.want)
+		}
+	}
+}
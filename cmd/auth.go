@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/PhilipKram/gitlab-cli/internal/auth"
@@ -25,6 +26,9 @@ func NewAuthCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(newAuthStatusCmd(f))
 	cmd.AddCommand(newAuthTokenCmd(f))
 	cmd.AddCommand(newAuthSwitchCmd(f))
+	cmd.AddCommand(newAuthRefreshCmd(f))
+	cmd.AddCommand(newAuthGitCredentialCmd(f))
+	cmd.AddCommand(newAuthSetupGitCmd(f))
 
 	return cmd
 }
@@ -46,6 +50,8 @@ prompted for host, git protocol, and OAuth application ID. These are stored so
 subsequent logins go straight to the browser with no prompts.
 
 Alternatively, authenticate with a personal access token using --token or --stdin.
+This is required when prompts are disabled (--prompt=disabled,
+GLAB_PROMPT_DISABLED, or a CI environment).
 
 For OAuth, you must first create an OAuth application in your GitLab instance
 under Settings > Applications. Set the redirect URI to http://localhost:7171/auth/redirect
@@ -72,6 +78,9 @@ and enable the "api", "read_user", and "read_repository" scopes.`,
 
 			// If no explicit token provided, default to OAuth flow
 			if !hasToken {
+				if ios.PromptDisabled() {
+					return fmt.Errorf("the OAuth login is interactive and prompts are disabled; use --token or --stdin to log in with a personal access token")
+				}
 				return loginInteractive(f, hostname, gitProtocol, clientID)
 			}
 
@@ -79,6 +88,10 @@ and enable the "api", "read_user", and "read_repository" scopes.`,
 			if hostname == "" {
 				hostname = config.DefaultHost()
 			}
+			hostname, err := loginHost(hostname)
+			if err != nil {
+				return err
+			}
 
 			var stdinReader = ios.In
 			if !stdin {
@@ -101,7 +114,7 @@ and enable the "api", "read_user", and "read_repository" scopes.`,
 		},
 	}
 
-	cmd.Flags().StringVar(&hostname, "hostname", "", "GitLab hostname (default: gitlab.com)")
+	cmd.Flags().StringVar(&hostname, "hostname", "", "GitLab hostname, or URL such as http://gitlab.local:8080 (default: gitlab.com)")
 	cmd.Flags().StringVarP(&token, "token", "t", "", "Personal access token")
 	cmd.Flags().BoolVar(&stdin, "stdin", false, "Read token from stdin")
 	cmd.Flags().StringVar(&clientID, "client-id", "", "OAuth application ID")
@@ -151,6 +164,10 @@ func loginInteractive(f *cmdutil.Factory, presetHost, presetProto, presetClientI
 			hostname = h
 		}
 	}
+	hostname, err := loginHost(hostname)
+	if err != nil {
+		return err
+	}
 
 	// ── Step 2: Determine git protocol ──────────────────────────────
 	gitProtocol := presetProto
@@ -182,7 +199,7 @@ func loginInteractive(f *cmdutil.Factory, presetHost, presetProto, presetClientI
 	if clientID == "" {
 		var err error
 		clientID, err = prompt.Input(in, errOut,
-			fmt.Sprintf("OAuth Application ID (create one at https://%s/-/user_settings/applications):", hostname))
+			fmt.Sprintf("OAuth Application ID (create one at %s/-/user_settings/applications):", config.WebBaseURL(hostname)))
 		if err != nil {
 			return err
 		}
@@ -212,6 +229,20 @@ func loginInteractive(f *cmdutil.Factory, presetHost, presetProto, presetClientI
 	return nil
 }
 
+// loginHost returns the configuration key of a host given to login, which
+// may be a URL such as http://gitlab.local:8080. A scheme other than https
+// is remembered as the host's api_protocol.
+func loginHost(raw string) (string, error) {
+	host, scheme := config.NormalizeHost(raw)
+	if scheme == "" || scheme == "https" {
+		return host, nil
+	}
+	if err := config.SetHostValue(host, "api_protocol", scheme); err != nil {
+		return "", err
+	}
+	return host, nil
+}
+
 func saveProtocol(host, protocol string) error {
 	hosts, err := config.LoadHosts()
 	if err != nil {
@@ -258,6 +289,7 @@ func newAuthLogoutCmd(f *cmdutil.Factory) *cobra.Command {
 			if hostname == "" {
 				hostname = config.DefaultHost()
 			}
+			hostname, _ = config.NormalizeHost(hostname)
 
 			// Prompt for confirmation before logging out
 			confirmed, err := prompt.Confirm(f.IOStreams.In, f.IOStreams.ErrOut,
@@ -285,14 +317,25 @@ func newAuthLogoutCmd(f *cmdutil.Factory) *cobra.Command {
 
 func newAuthStatusCmd(f *cmdutil.Factory) *cobra.Command {
 	var (
-		format   string
-		jsonFlag bool
+		format     string
+		jsonFlag   bool
+		showScopes bool
+		validate   bool
+		activeOnly bool
 	)
 
 	cmd := &cobra.Command{
-		Use:     "status",
-		Short:   "View authentication status",
+		Use:   "status",
+		Short: "View authentication status",
+		Long: `View the authentication status of each GitLab host glab is logged in to.
+
+With --validate, the token of each host is checked with GitLab, reporting
+expired tokens with their expiry time, unreachable APIs, and rejected tokens.
+The command then exits with a non-zero status if any host fails, so it can be
+used as a health check in CI.`,
 		Example: `  $ glab auth status
+  $ glab auth status --show-scopes
+  $ glab auth status --validate --active-only
   $ glab auth status --format=json`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			statuses, err := auth.GetStatus()
@@ -300,6 +343,43 @@ func newAuthStatusCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
+			if activeOnly {
+				active := config.DefaultHost()
+				var filtered []auth.Status
+				for _, s := range statuses {
+					if s.Host == active {
+						filtered = append(filtered, s)
+					}
+				}
+				if len(filtered) == 0 {
+					return fmt.Errorf("not logged in to %s; run 'glab auth login --hostname %s'", active, active)
+				}
+				statuses = filtered
+			}
+
+			failed := 0
+			if validate {
+				for i := range statuses {
+					auth.ValidateStatus(&statuses[i])
+					if statuses[i].HasError {
+						failed++
+					}
+				}
+			}
+
+			if showScopes {
+				for i := range statuses {
+					s := &statuses[i]
+					if !s.Active {
+						continue
+					}
+					if err := auth.VerifyScopes(s); err != nil {
+						s.HasError = true
+						s.Error = err.Error()
+					}
+				}
+			}
+
 			// Backward compatibility: --json flag sets format to json
 			if jsonFlag {
 				format = "json"
@@ -307,7 +387,10 @@ func newAuthStatusCmd(f *cmdutil.Factory) *cobra.Command {
 
 			// Use formatter for JSON output
 			if format == "json" {
-				return f.FormatAndPrint(statuses, format, false)
+				if err := f.FormatAndPrint(statuses, format, false); err != nil {
+					return err
+				}
+				return authStatusResult(failed, len(statuses))
 			}
 
 			// Default plain text output
@@ -316,12 +399,21 @@ func newAuthStatusCmd(f *cmdutil.Factory) *cobra.Command {
 				_, _ = fmt.Fprintf(out, "%s\n", s.Host)
 				_, _ = fmt.Fprintf(out, "  ✓ Logged in as %s (%s)\n", s.User, s.Source)
 				_, _ = fmt.Fprintf(out, "  - Token: %s\n", s.Token)
+				if s.Validated {
+					_, _ = fmt.Fprintln(out, "  ✓ Token accepted by the API")
+				}
 				if s.AuthMethod != "" {
 					_, _ = fmt.Fprintf(out, "  - Auth method: %s\n", s.AuthMethod)
 				}
 				if s.AuthMethod == "oauth" && s.Scopes != "" {
 					_, _ = fmt.Fprintf(out, "  - Scopes: %s\n", s.Scopes)
 				}
+				if len(s.TokenScopes) > 0 {
+					_, _ = fmt.Fprintf(out, "  - Token scopes: %s\n", strings.Join(s.TokenScopes, ", "))
+				}
+				if len(s.MissingScopes) > 0 {
+					_, _ = fmt.Fprintf(out, "  ! Missing required scopes: %s\n", strings.Join(s.MissingScopes, ", "))
+				}
 				if s.GitLabVersion != "" {
 					_, _ = fmt.Fprintf(out, "  - GitLab version: %s\n", s.GitLabVersion)
 				}
@@ -338,16 +430,28 @@ func newAuthStatusCmd(f *cmdutil.Factory) *cobra.Command {
 				}
 				_, _ = fmt.Fprintln(out)
 			}
-			return nil
+			return authStatusResult(failed, len(statuses))
 		},
 	}
 
 	cmd.Flags().StringVarP(&format, "format", "F", "plain", "Output format: json, table, or plain")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	cmd.Flags().BoolVar(&showScopes, "show-scopes", false, "Verify the token's scopes with GitLab and warn about missing ones")
+	cmd.Flags().BoolVar(&validate, "validate", false, "Check each token with GitLab and exit non-zero if any host fails")
+	cmd.Flags().BoolVar(&activeOnly, "active-only", false, "Only show the active host")
 
 	return cmd
 }
 
+// authStatusResult returns the error auth status exits with when failed of
+// the total hosts failed validation.
+func authStatusResult(failed, total int) error {
+	if failed == 0 {
+		return nil
+	}
+	return fmt.Errorf("authentication check failed for %d of %d host(s)", failed, total)
+}
+
 func newAuthTokenCmd(f *cmdutil.Factory) *cobra.Command {
 	var (
 		hostname string
@@ -366,6 +470,7 @@ func newAuthTokenCmd(f *cmdutil.Factory) *cobra.Command {
 			if hostname == "" {
 				hostname = config.DefaultHost()
 			}
+			hostname, _ = config.NormalizeHost(hostname)
 			token, err := auth.GetToken(hostname)
 			if err != nil {
 				return err
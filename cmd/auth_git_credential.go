@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// gitCredentialUsername is sent with the token; GitLab accepts it for both
+// personal access tokens and OAuth tokens.
+const gitCredentialUsername = "oauth2"
+
+func newAuthGitCredentialCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "git-credential <get|store|erase>",
+		Short: "Act as a git credential helper",
+		Long: `Implement the git credential helper protocol so git uses the token glab
+is logged in with for HTTPS remotes of GitLab hosts.
+
+For "get", glab answers with the stored token of the requested host,
+refreshing an expiring OAuth token first. Requests over plain http are only
+answered for hosts whose api_protocol is http, so the token is not sent in
+cleartext by accident. Hosts glab is not logged in to get no answer, so git falls back to its other helpers. "store" and "erase" are
+accepted and ignored: the token is managed with "glab auth login" and
+"glab auth logout".
+
+Configure git to use it with "glab auth setup-git" or:
+
+  git config --global credential.helper '!glab auth git-credential'`,
+		Example: `  $ git config --global credential.https://gitlab.com.helper '!glab auth git-credential'
+  $ printf 'protocol=https\nhost=gitlab.com\n\n' | glab auth git-credential get`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch args[0] {
+			case "get":
+			case "store", "erase":
+				// Drain the request so git does not see a broken pipe.
+				_, _ = io.Copy(io.Discard, f.IOStreams.In)
+				return nil
+			default:
+				return fmt.Errorf("unsupported git credential operation %q (must be get, store, or erase)", args[0])
+			}
+
+			req, err := readGitCredential(f.IOStreams.In)
+			if err != nil {
+				return err
+			}
+			// Plain http would send the token in cleartext, so it is only
+			// answered for hosts configured to be reached over http.
+			host := req["host"]
+			switch req["protocol"] {
+			case "https":
+			case "http":
+				if config.HostScheme(host) != "http" {
+					return nil
+				}
+			default:
+				return nil
+			}
+
+			token, source := config.TokenForHost(host)
+			if token == "" {
+				return nil
+			}
+			if source == host && config.AuthMethodForHost(host) == "oauth" {
+				if token, err = api.RefreshOAuthTokenIfNeeded(host, token); err != nil {
+					return err
+				}
+			}
+
+			out := f.IOStreams.Out
+			_, _ = fmt.Fprintf(out, "protocol=%s\n", req["protocol"])
+			_, _ = fmt.Fprintf(out, "host=%s\n", host)
+			_, _ = fmt.Fprintf(out, "username=%s\n", gitCredentialUsername)
+			_, _ = fmt.Fprintf(out, "password=%s\n", token)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// readGitCredential reads the key=value lines of a git credential request
+// up to the first blank line or the end of input.
+func readGitCredential(r io.Reader) (map[string]string, error) {
+	req := map[string]string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if line == "" {
+			break
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		req[key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading git credential request: %w", err)
+	}
+	return req, nil
+}
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/auth"
+	"github.com/PhilipKram/gitlab-cli/internal/browser"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func newAuthRefreshCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		hostname     string
+		scopes       []string
+		removeScopes []string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "refresh",
+		Short: "Re-authenticate to change the scopes of the stored token",
+		Long: `Run the OAuth flow again for a host glab is logged in to, to obtain a token
+with additional scopes or without some of the current ones.
+
+The requested scopes are the host's current OAuth scopes (or the default
+scopes) plus those given with --scopes, minus those given with
+--remove-scopes. The new set is saved as the host's oauth_scopes.
+
+Scopes of a personal access token cannot be changed; create a new token with
+the scopes you need and log in with it instead.`,
+		Example: `  $ glab auth refresh --scopes read_registry
+  $ glab auth refresh --hostname gitlab.example.com --scopes read_registry,write_registry
+  $ glab auth refresh --remove-scopes write_repository`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if hostname == "" {
+				hostname = config.DefaultHost()
+			}
+			hostname, _ = config.NormalizeHost(hostname)
+
+			hosts, err := config.LoadHosts()
+			if err != nil {
+				return err
+			}
+			hc, ok := hosts[hostname]
+			if !ok || hc.Token == "" {
+				return fmt.Errorf("not logged in to %s; run 'glab auth login --hostname %s'", hostname, hostname)
+			}
+			if hc.AuthMethod != "oauth" {
+				return fmt.Errorf("%s uses a personal access token, whose scopes cannot be changed; create a token with the scopes you need at %s/-/user_settings/personal_access_tokens and run 'glab auth login --hostname %s --token <token>'",
+					hostname, config.WebBaseURL(hostname), hostname)
+			}
+			if hc.ClientID == "" {
+				return fmt.Errorf("no OAuth application ID for %s; run 'glab auth login --hostname %s'", hostname, hostname)
+			}
+
+			current := hc.OAuthScopes
+			if current == "" {
+				current = auth.DefaultScopes()
+			}
+			requested := refreshScopes(current, scopes, removeScopes)
+			if requested == "" {
+				return fmt.Errorf("no scopes left to request")
+			}
+
+			errOut := f.IOStreams.ErrOut
+			_, _ = fmt.Fprintf(errOut, "Requesting scopes: %s\n", requested)
+			status, err := auth.OAuthFlow(hostname, hc.ClientID, config.RedirectURIForHost(hostname), requested, errOut, browser.Open)
+			if err != nil {
+				return err
+			}
+			if err := config.SetHostValue(hostname, "oauth_scopes", requested); err != nil {
+				return err
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "✓ Refreshed token for %s as %s with scopes: %s\n", status.Host, status.User, requested)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&hostname, "hostname", "", "GitLab hostname")
+	cmd.Flags().StringSliceVarP(&scopes, "scopes", "s", nil, "Additional scopes to request")
+	cmd.Flags().StringSliceVarP(&removeScopes, "remove-scopes", "r", nil, "Scopes to stop requesting")
+
+	return cmd
+}
+
+// refreshScopes returns the space-separated scopes of current with add
+// appended and remove taken out, keeping the order and dropping duplicates.
+func refreshScopes(current string, add, remove []string) string {
+	removed := map[string]bool{}
+	for _, s := range remove {
+		removed[strings.TrimSpace(s)] = true
+	}
+
+	seen := map[string]bool{}
+	var out []string
+	for _, s := range append(strings.Fields(current), add...) {
+		s = strings.TrimSpace(s)
+		if s == "" || seen[s] || removed[s] {
+			continue
+		}
+		seen[s] = true
+		out = append(out, s)
+	}
+	return strings.Join(out, " ")
+}
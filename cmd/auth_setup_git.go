@@ -0,0 +1,235 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/config"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/PhilipKram/gitlab-cli/internal/git"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func newAuthSetupGitCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		hostname string
+		sshKey   bool
+		keyFile  string
+		title    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "setup-git",
+		Short: "Configure git to use glab for authentication",
+		Long: `Configure git to use "glab auth git-credential" as the credential helper for
+the GitLab hosts glab is logged in to, so HTTPS fetches and pushes use the
+stored token.
+
+With --ssh-key, also set up SSH access: an ed25519 key pair is generated with
+ssh-keygen unless --key-file already exists, and its public key is added to
+your account on each host unless it is there already.`,
+		Example: `  $ glab auth setup-git
+  $ glab auth setup-git --hostname gitlab.example.com
+  $ glab auth setup-git --ssh-key
+  $ glab auth setup-git --ssh-key --key-file ~/.ssh/id_ed25519_gitlab --title "work laptop"`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			hosts, err := setupGitHosts(hostname)
+			if err != nil {
+				return err
+			}
+
+			exe, err := os.Executable()
+			if err != nil {
+				return fmt.Errorf("locating the glab executable: %w", err)
+			}
+			if strings.ContainsAny(exe, " '\"\\$`") {
+				exe = shellQuote(exe)
+			}
+			helper := "!" + exe + " auth git-credential"
+
+			out := f.IOStreams.Out
+			for _, host := range hosts {
+				if err := git.SetCredentialHelper(config.WebBaseURL(host), helper); err != nil {
+					return err
+				}
+				_, _ = fmt.Fprintf(out, "✓ Configured git to use glab as the credential helper for %s\n", host)
+			}
+
+			if !sshKey {
+				return nil
+			}
+
+			if keyFile == "" {
+				home, err := os.UserHomeDir()
+				if err != nil {
+					return fmt.Errorf("locating the home directory: %w", err)
+				}
+				keyFile = filepath.Join(home, ".ssh", "id_ed25519")
+			}
+			if title == "" {
+				title = defaultSSHKeyTitle()
+			}
+			if err := ensureSSHKey(f, keyFile, title); err != nil {
+				return err
+			}
+			key, err := readPublicKey(f, keyFile+".pub")
+			if err != nil {
+				return err
+			}
+
+			for _, host := range hosts {
+				client, err := api.NewClient(host)
+				if err != nil {
+					return err
+				}
+				added, err := uploadSSHKey(cmd, client, key, title)
+				if err != nil {
+					return err
+				}
+				if added {
+					_, _ = fmt.Fprintf(out, "✓ Added SSH key %s.pub to %s\n", keyFile, host)
+				} else {
+					_, _ = fmt.Fprintf(out, "✓ SSH key %s.pub is already on %s\n", keyFile, host)
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&hostname, "hostname", "", "Configure only this host (default: every host glab is logged in to)")
+	cmd.Flags().BoolVar(&sshKey, "ssh-key", false, "Also generate an SSH key if needed and add it to your account")
+	cmd.Flags().StringVar(&keyFile, "key-file", "", "Private key file to use or create (default: ~/.ssh/id_ed25519)")
+	cmd.Flags().StringVarP(&title, "title", "t", "", "Title of the uploaded SSH key (default: glab on <machine>)")
+
+	return cmd
+}
+
+// setupGitHosts returns the hosts to configure: hostname, or every host
+// with a stored token plus the default host when a token is set in the
+// environment.
+func setupGitHosts(hostname string) ([]string, error) {
+	if hostname != "" {
+		host, _ := config.NormalizeHost(hostname)
+		if token, _ := config.TokenForHost(host); token == "" {
+			return nil, fmt.Errorf("not logged in to %s; run 'glab auth login --hostname %s'", host, host)
+		}
+		return []string{host}, nil
+	}
+
+	seen := map[string]bool{}
+	var hosts []string
+	stored, err := config.LoadHosts()
+	if err != nil {
+		return nil, err
+	}
+	for host, hc := range stored {
+		if hc.Token != "" {
+			seen[host] = true
+			hosts = append(hosts, host)
+		}
+	}
+	if def := config.DefaultHost(); !seen[def] {
+		if token, _ := config.TokenForHost(def); token != "" {
+			hosts = append(hosts, def)
+		}
+	}
+	if len(hosts) == 0 {
+		return nil, fmt.Errorf("not logged in to any GitLab host; run 'glab auth login' first")
+	}
+	sort.Strings(hosts)
+	return hosts, nil
+}
+
+// defaultSSHKeyTitle names an uploaded key after the machine it was made on.
+func defaultSSHKeyTitle() string {
+	if name, err := os.Hostname(); err == nil && name != "" {
+		return "glab on " + name
+	}
+	return "glab"
+}
+
+// ensureSSHKey generates an ed25519 key pair at keyFile with ssh-keygen
+// unless the public key already exists. When glab may prompt, ssh-keygen
+// asks for a passphrase; otherwise the key gets none.
+func ensureSSHKey(f *cmdutil.Factory, keyFile, comment string) error {
+	if _, err := os.Stat(keyFile + ".pub"); err == nil {
+		return nil
+	}
+	if _, err := os.Stat(keyFile); err == nil {
+		return fmt.Errorf("%s exists without %s.pub; pass --key-file to use another key", keyFile, keyFile)
+	}
+	if err := os.MkdirAll(filepath.Dir(keyFile), 0o700); err != nil {
+		return fmt.Errorf("creating %s: %w", filepath.Dir(keyFile), err)
+	}
+
+	args := []string{"-t", "ed25519", "-C", comment, "-f", keyFile}
+	if !f.IOStreams.CanPrompt() {
+		args = append(args, "-N", "")
+	}
+	keygen := exec.Command("ssh-keygen", args...)
+	keygen.Stdin = f.IOStreams.In
+	keygen.Stdout = f.IOStreams.ErrOut
+	keygen.Stderr = f.IOStreams.ErrOut
+	if err := keygen.Run(); err != nil {
+		return fmt.Errorf("generating an SSH key with ssh-keygen: %w", err)
+	}
+	return nil
+}
+
+// uploadSSHKey adds key to the account of client's user unless a key with
+// the same type and data is already there. It reports whether it was added.
+func uploadSSHKey(cmd *cobra.Command, client *api.Client, key, title string) (bool, error) {
+	opts := &gitlab.ListSSHKeysOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+	for {
+		keys, resp, err := client.Users.ListSSHKeys(opts, gitlab.WithContext(cmd.Context()))
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			url := api.APIURL(client.Host()) + "/user/keys"
+			return false, errors.NewAPIError("GET", url, statusCode, "Failed to list SSH keys", err)
+		}
+		for _, k := range keys {
+			if sshKeyBody(k.Key) == sshKeyBody(key) {
+				return false, nil
+			}
+		}
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	_, resp, err := client.Users.AddSSHKey(&gitlab.AddSSHKeyOptions{
+		Key:   &key,
+		Title: &title,
+	}, gitlab.WithContext(cmd.Context()))
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := api.APIURL(client.Host()) + "/user/keys"
+		return false, errors.NewAPIError("POST", url, statusCode, "Failed to add SSH key", err)
+	}
+	return true, nil
+}
+
+// sshKeyBody returns the type and data of an OpenSSH public key, without
+// its comment.
+func sshKeyBody(key string) string {
+	fields := strings.Fields(key)
+	if len(fields) > 2 {
+		fields = fields[:2]
+	}
+	return strings.Join(fields, " ")
+}
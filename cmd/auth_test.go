@@ -1,9 +1,16 @@
 package cmd
 
 import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+	"github.com/PhilipKram/gitlab-cli/internal/config"
 )
 
 func TestNewAuthCmd(t *testing.T) {
@@ -29,6 +36,9 @@ func TestAuthCmd_HasSubcommands(t *testing.T) {
 		"status",
 		"token",
 		"switch",
+		"git-credential",
+		"setup-git",
+		"refresh",
 	}
 
 	subcommands := cmd.Commands()
@@ -112,6 +122,9 @@ func TestAuthStatusCmd_Flags(t *testing.T) {
 	expectedFlags := []string{
 		"format",
 		"json",
+		"show-scopes",
+		"validate",
+		"active-only",
 	}
 
 	for _, flagName := range expectedFlags {
@@ -204,3 +217,404 @@ func TestSaveProtocol(t *testing.T) {
 	// Just verify it doesn't panic
 	_ = err
 }
+
+func TestLoginHost(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+	host, err := loginHost("http://gitlab.local:8080/")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if host != "gitlab.local:8080" {
+		t.Errorf("expected host gitlab.local:8080, got %q", host)
+	}
+	if got := config.HostScheme(host); got != "http" {
+		t.Errorf("expected the http scheme to be remembered, got %q", got)
+	}
+
+	if host, _ := loginHost("gitlab.example.com"); host != "gitlab.example.com" {
+		t.Errorf("expected a plain host to be unchanged, got %q", host)
+	}
+	if _, err := loginHost("ftp://gitlab.example.com"); err == nil {
+		t.Error("expected an error for an unsupported scheme")
+	}
+}
+
+func TestAuthGitCredential_Get(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	if err := config.SaveHosts(config.HostsConfig{
+		"gitlab.example.com:8443": {Token: "glpat-stored", AuthMethod: "pat"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	cmdtest.StubInput(t, f, "protocol=https\nhost=gitlab.example.com:8443\npath=group/repo.git\n\n")
+	cmd := newAuthGitCredentialCmd(f.Factory)
+	cmd.SetArgs([]string{"get"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "protocol=https\nhost=gitlab.example.com:8443\nusername=oauth2\npassword=glpat-stored\n"
+	if got := f.IO.String(); got != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestAuthGitCredential_UnknownHost(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmdtest.StubInput(t, f, "protocol=https\nhost=github.com\n\n")
+	cmd := newAuthGitCredentialCmd(f.Factory)
+	cmd.SetArgs([]string{"get"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out := f.IO.String(); out != "" {
+		t.Errorf("expected no answer for an unknown host, got %q", out)
+	}
+}
+
+func TestAuthGitCredential_HTTP(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	if err := config.SaveHosts(config.HostsConfig{
+		"gitlab.com":   {Token: "glpat-secure", AuthMethod: "pat"},
+		"gitlab.local": {Token: "glpat-local", AuthMethod: "pat", APIProtocol: "http"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("GITLAB_TOKEN", "")
+
+	tests := []struct {
+		host, want string
+	}{
+		{"gitlab.com", ""},
+		{"gitlab.local", "protocol=http\nhost=gitlab.local\nusername=oauth2\npassword=glpat-local\n"},
+	}
+	for _, tt := range tests {
+		f.IO.Out.Reset()
+		cmdtest.StubInput(t, f, "protocol=http\nhost="+tt.host+"\n\n")
+		cmd := newAuthGitCredentialCmd(f.Factory)
+		cmd.SetArgs([]string{"get"})
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.host, err)
+		}
+		if got := f.IO.String(); got != tt.want {
+			t.Errorf("%s: unexpected output %q, want %q", tt.host, got, tt.want)
+		}
+	}
+}
+
+func TestAuthGitCredential_StoreAndErase(t *testing.T) {
+	for _, op := range []string{"store", "erase"} {
+		f := cmdtest.NewTestFactory(t)
+		cmdtest.StubInput(t, f, "protocol=https\nhost=gitlab.com\nusername=oauth2\npassword=x\n\n")
+		cmd := newAuthGitCredentialCmd(f.Factory)
+		cmd.SetArgs([]string{op})
+		if err := cmd.Execute(); err != nil {
+			t.Errorf("%s: unexpected error: %v", op, err)
+		}
+		if out := f.IO.String(); out != "" {
+			t.Errorf("%s: expected no output, got %q", op, out)
+		}
+	}
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newAuthGitCredentialCmd(f.Factory)
+	cmd.SetArgs([]string{"approve"})
+	if err := cmd.Execute(); err == nil {
+		t.Error("expected error for an unknown operation")
+	}
+}
+
+func TestAuthSetupGit(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	gitConfig := filepath.Join(t.TempDir(), "gitconfig")
+	t.Setenv("GIT_CONFIG_GLOBAL", gitConfig)
+	if err := config.SaveHosts(config.HostsConfig{
+		"gitlab.example.com": {Token: "glpat-stored"},
+		"gitlab.local:8080":  {Token: "glpat-local", APIProtocol: "http"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := newAuthSetupGitCmd(f.Factory)
+	cmd.SetArgs([]string{})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := os.ReadFile(gitConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`[credential "https://gitlab.com"]`,
+		`[credential "https://gitlab.example.com"]`,
+		`[credential "http://gitlab.local:8080"]`,
+		"auth git-credential",
+	} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("expected git config to contain %q, got:\n%s", want, data)
+		}
+	}
+
+	// Running it again replaces the helper rather than adding another one.
+	cmd = newAuthSetupGitCmd(f.Factory)
+	cmd.SetArgs([]string{"--hostname", "gitlab.example.com"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out, err := exec.Command("git", "config", "--global", "--get-all", "credential.https://gitlab.example.com.helper").Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Split(strings.TrimSuffix(string(out), "\n"), "\n"); len(lines) != 2 || lines[0] != "" {
+		t.Errorf("expected an empty entry and one helper, got %q", out)
+	}
+}
+
+func TestAuthSetupGit_SSHKey(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "id_ed25519")
+	if err := os.WriteFile(keyFile+".pub", []byte("ssh-ed25519 AAAAC3NzaNEW me@laptop\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var added map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/user/keys":
+			cmdtest.JSONResponse(w, 200, []any{map[string]any{"id": 1, "key": "ssh-ed25519 AAAAC3NzaOLD old"}})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v4/user/keys":
+			_ = json.NewDecoder(r.Body).Decode(&added)
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": 2})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	t.Setenv("GIT_CONFIG_GLOBAL", filepath.Join(t.TempDir(), "gitconfig"))
+	cmd := newAuthSetupGitCmd(f.Factory)
+	cmd.SetArgs([]string{"--ssh-key", "--key-file", keyFile, "--title", "laptop"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if added["title"] != "laptop" || added["key"] != "ssh-ed25519 AAAAC3NzaNEW me@laptop" {
+		t.Errorf("unexpected key upload: %v", added)
+	}
+	cmdtest.AssertContains(t, f.IO.String(), "Added SSH key")
+}
+
+func TestAuthSetupGit_SSHKeyOnLaterPage(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "id_ed25519")
+	if err := os.WriteFile(keyFile+".pub", []byte("ssh-ed25519 AAAAC3NzaNEW me@laptop\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/user/keys":
+			if r.URL.Query().Get("page") == "2" {
+				cmdtest.JSONResponse(w, 200, []any{map[string]any{"id": 2, "key": "ssh-ed25519 AAAAC3NzaNEW other comment"}})
+				return
+			}
+			w.Header().Set("X-Next-Page", "2")
+			cmdtest.JSONResponse(w, 200, []any{map[string]any{"id": 1, "key": "ssh-ed25519 AAAAC3NzaOLD old"}})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	t.Setenv("GIT_CONFIG_GLOBAL", filepath.Join(t.TempDir(), "gitconfig"))
+	cmd := newAuthSetupGitCmd(f.Factory)
+	cmd.SetArgs([]string{"--ssh-key", "--key-file", keyFile})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cmdtest.AssertContains(t, f.IO.String(), "is already on gitlab.com")
+}
+
+func TestAuthSetupGit_NotLoggedIn(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	t.Setenv("GITLAB_TOKEN", "")
+
+	cmd := newAuthSetupGitCmd(f.Factory)
+	cmd.SetArgs([]string{})
+	if err := cmd.Execute(); err == nil {
+		t.Fatal("expected an error when not logged in")
+	}
+}
+
+func TestRefreshScopes(t *testing.T) {
+	tests := []struct {
+		current string
+		add     []string
+		remove  []string
+		want    string
+	}{
+		{"api read_user", []string{"read_registry"}, nil, "api read_user read_registry"},
+		{"api read_user", []string{"api", " read_registry "}, nil, "api read_user read_registry"},
+		{"api read_user write_repository", nil, []string{"write_repository"}, "api read_user"},
+		{"api", []string{"read_user"}, []string{"read_user"}, "api"},
+		{"api", nil, []string{"api"}, ""},
+	}
+	for _, tt := range tests {
+		if got := refreshScopes(tt.current, tt.add, tt.remove); got != tt.want {
+			t.Errorf("refreshScopes(%q, %v, %v) = %q, want %q", tt.current, tt.add, tt.remove, got, tt.want)
+		}
+	}
+}
+
+func TestAuthRefresh_PersonalAccessToken(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	if err := config.SaveHosts(config.HostsConfig{
+		"gitlab.example.com": {Token: "glpat-stored", AuthMethod: "pat"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := newAuthRefreshCmd(f.Factory)
+	cmd.SetArgs([]string{"--hostname", "gitlab.example.com", "--scopes", "read_registry"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "personal access token") {
+		t.Fatalf("expected a personal access token error, got %v", err)
+	}
+}
+
+func TestAuthRefresh_NotLoggedIn(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+
+	cmd := newAuthRefreshCmd(f.Factory)
+	cmd.SetArgs([]string{"--hostname", "gitlab.unknown.com"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "not logged in") {
+		t.Fatalf("expected a not logged in error, got %v", err)
+	}
+}
+
+func TestAuthStatus_ShowScopes(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	t.Setenv("GITLAB_TOKEN", "")
+	if err := config.SaveHosts(config.HostsConfig{
+		"gitlab.com": {Token: "glpat-stored", User: "tester", AuthMethod: "pat"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/personal_access_tokens/self" {
+			cmdtest.JSONResponse(w, http.StatusOK, map[string]interface{}{
+				"id":     1,
+				"scopes": []string{"read_api", "read_user"},
+			})
+			return
+		}
+		http.NotFound(w, r)
+	})
+
+	cmd := newAuthStatusCmd(f.Factory)
+	cmd.SetArgs([]string{"--show-scopes"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := f.IO.String()
+	for _, want := range []string{
+		"- Token scopes: read_api, read_user",
+		"! Missing required scopes: api, write_repository",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestAuthStatus_Validate(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	t.Setenv("GITLAB_TOKEN", "")
+	if err := config.SaveHosts(config.HostsConfig{
+		"gitlab.com": {Token: "glpat-stored", User: "tester", AuthMethod: "pat"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/user" {
+			cmdtest.JSONResponse(w, http.StatusOK, map[string]interface{}{"id": 1, "username": "tester"})
+			return
+		}
+		http.NotFound(w, r)
+	})
+
+	cmd := newAuthStatusCmd(f.Factory)
+	cmd.SetArgs([]string{"--validate"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out := f.IO.String(); !strings.Contains(out, "✓ Token accepted by the API") {
+		t.Errorf("expected a validated token, got:\n%s", out)
+	}
+}
+
+func TestAuthStatus_ValidateFailure(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	t.Setenv("GITLAB_TOKEN", "")
+	if err := config.SaveHosts(config.HostsConfig{
+		"gitlab.com":         {Token: "glpat-revoked", User: "tester", AuthMethod: "pat"},
+		"gitlab.example.com": {Token: "oauth-old", User: "tester", AuthMethod: "oauth", TokenExpiresAt: 1000},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		cmdtest.ErrorResponse(w, http.StatusUnauthorized, "401 Unauthorized")
+	})
+
+	cmd := newAuthStatusCmd(f.Factory)
+	cmd.SetArgs([]string{"--validate"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "2 of 2 host(s)") {
+		t.Fatalf("expected both hosts to fail, got %v", err)
+	}
+	out := f.IO.String()
+	for _, want := range []string{"token rejected by gitlab.com", "token expired at"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+
+	// --active-only limits the check to the default host.
+	f = cmdtest.NewTestFactory(t)
+	t.Setenv("GITLAB_TOKEN", "")
+	if err := config.SaveHosts(config.HostsConfig{
+		"gitlab.com":         {Token: "glpat-revoked", User: "tester", AuthMethod: "pat"},
+		"gitlab.example.com": {Token: "oauth-old", User: "tester", AuthMethod: "oauth", TokenExpiresAt: 1000},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	cmd = newAuthStatusCmd(f.Factory)
+	cmd.SetArgs([]string{"--validate", "--active-only"})
+	err = cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "1 of 1 host(s)") {
+		t.Fatalf("expected only the active host to be checked, got %v", err)
+	}
+	if strings.Contains(f.IO.String(), "gitlab.example.com") {
+		t.Errorf("expected --active-only to hide other hosts, got:\n%s", f.IO.String())
+	}
+}
+
+func TestAuthLogin_PromptDisabled(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	f.IOStreams.SetPromptDisabled(true)
+
+	cmd := newAuthLoginCmd(f.Factory)
+	cmd.SetArgs([]string{"--hostname", "gitlab.example.com"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "--token or --stdin") {
+		t.Fatalf("expected an error asking for --token or --stdin, got %v", err)
+	}
+}
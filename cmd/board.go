@@ -0,0 +1,627 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/browser"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// minBoardColumnWidth is the narrowest column board view renders side by
+// side; narrower terminals get the lists stacked vertically instead.
+const minBoardColumnWidth = 20
+
+// boardColumn is one list of an issue board together with its issues.
+type boardColumn struct {
+	Name   string          `json:"name"`
+	ListID int64           `json:"list_id,omitempty"`
+	Issues []*gitlab.Issue `json:"issues"`
+}
+
+// NewBoardCmd creates the board command group.
+func NewBoardCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "board <command>",
+		Short: "Manage issue boards",
+		Long:  "List and view issue boards, and move issues between board lists.",
+	}
+
+	cmd.AddCommand(newBoardListCmd(f))
+	cmd.AddCommand(newBoardViewCmd(f))
+	cmd.AddCommand(newBoardMoveCmd(f))
+
+	return cmd
+}
+
+func newBoardListCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		format   string
+		jsonFlag bool
+		group    string
+	)
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List issue boards",
+		Aliases: []string{"ls"},
+		Example: `  $ glab board list
+  $ glab board list --group my-group
+  $ glab board list --format json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			var project string
+			if group == "" {
+				project, err = f.FullProjectPath()
+				if err != nil {
+					return err
+				}
+			}
+
+			boards, err := listBoards(cmd, client, project, group, 100)
+			if err != nil {
+				return err
+			}
+
+			if len(boards) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No issue boards found")
+				return nil
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(boards, format, jsonFlag)
+			}
+
+			tp := f.NewTablePrinter()
+			for _, b := range boards {
+				var names []string
+				for _, l := range sortedBoardLists(b.Lists) {
+					names = append(names, boardListName(l))
+				}
+				tp.AddRow(strconv.FormatInt(b.ID, 10), b.Name, strings.Join(names, ", "))
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	cmd.Flags().StringVarP(&group, "group", "g", "", "List the boards of a group")
+
+	return cmd
+}
+
+func newBoardViewCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		limit    int
+		web      bool
+		format   string
+		jsonFlag bool
+		group    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "view [<id>]",
+		Short: "View an issue board",
+		Long: `Show the lists of an issue board and their issues as columns.
+
+Without an ID, the project's first board is shown. With --group, the board
+is one of the group's and shows issues from all of its projects. The Open and
+Closed lists are included like in the web UI. When the terminal is too narrow
+for every list to fit side by side, the lists are printed one after another.`,
+		Example: `  $ glab board view
+  $ glab board view 12 --limit 5
+  $ glab board view 4 --group my-group
+  $ glab board view --format json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			var project string
+			if group == "" {
+				project, err = f.FullProjectPath()
+				if err != nil {
+					return err
+				}
+			}
+
+			board, err := resolveBoard(cmd, client, project, group, args)
+			if err != nil {
+				return err
+			}
+
+			if web {
+				path := fmt.Sprintf("%s/-/boards/%d", project, board.ID)
+				if group != "" {
+					path = fmt.Sprintf("groups/%s/-/boards/%d", group, board.ID)
+				}
+				return browser.Open(api.WebURL(client.Host(), path))
+			}
+
+			columns, err := fetchBoardColumns(cmd, client, project, group, board, limit)
+			if err != nil {
+				return err
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(columns, format, jsonFlag)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "%s\n\n", board.Name)
+			renderBoard(f.IOStreams.Out, f.IOStreams.TerminalWidth(), columns)
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVarP(&limit, "limit", "L", 20, "Maximum number of issues per list")
+	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open the board in the browser")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	cmd.Flags().StringVarP(&group, "group", "g", "", "View a board of a group")
+
+	return cmd
+}
+
+func newBoardMoveCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		toList  string
+		boardID string
+		group   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "move <issue>",
+		Short: "Move an issue to another board list",
+		Long: `Move an issue to another list of an issue board.
+
+Moving to a label list adds the list's label and removes the labels of the
+board's other lists. Moving to "open" removes those labels, and moving to
+"closed" closes the issue. Lists are matched by ID or by label name.
+
+With --group, the lists come from a board of that group, and the issue is
+still looked up in the current project.`,
+		Example: `  $ glab board move 42 --to-list Doing
+  $ glab board move 42 --to-list closed
+  $ glab board move 42 --to-list 7 --board 12
+  $ glab board move 42 --to-list Doing --group my-group --board 4`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			issueID, err := parseIssueArg(args)
+			if err != nil {
+				return err
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			var boardArgs []string
+			if boardID != "" {
+				boardArgs = []string{boardID}
+			}
+			board, err := resolveBoard(cmd, client, project, group, boardArgs)
+			if err != nil {
+				return err
+			}
+
+			issue, resp, err := client.Issues.GetIssue(project, issueID, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/issues/%d", api.APIURL(client.Host()), project, issueID)
+				return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get issue #%d", issueID), err)
+			}
+
+			lists := sortedBoardLists(board.Lists)
+			target, targetName, err := findBoardList(lists, toList)
+			if err != nil {
+				return err
+			}
+
+			opts, from := boardMoveOptions(lists, issue, target, targetName)
+			if opts == nil {
+				_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Issue #%d is already in %s\n", issue.IID, targetName)
+				return nil
+			}
+
+			_, resp, err = client.Issues.UpdateIssue(project, issueID, opts, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/issues/%d", api.APIURL(client.Host()), project, issueID)
+				return errors.NewAPIError("PUT", url, statusCode, fmt.Sprintf("Failed to move issue #%d", issueID), err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Moved issue #%d from %s to %s\n", issue.IID, from, targetName)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&toList, "to-list", "t", "", "Target list: a list ID, a label name, open, or closed (required)")
+	cmd.Flags().StringVarP(&boardID, "board", "b", "", "Board ID (default: the project's or group's first board)")
+	cmd.Flags().StringVarP(&group, "group", "g", "", "Use a board of a group")
+	_ = cmd.MarkFlagRequired("to-list")
+
+	return cmd
+}
+
+// resolveBoard returns the board whose ID is given in args, or the first
+// board when args is empty. Boards belong to group when it is set, and to
+// project otherwise.
+func resolveBoard(cmd *cobra.Command, client *api.Client, project, group string, args []string) (*gitlab.IssueBoard, error) {
+	if len(args) == 0 {
+		boards, err := listBoards(cmd, client, project, group, 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(boards) == 0 {
+			return nil, fmt.Errorf("no issue boards found in %s", boardOwner(project, group))
+		}
+		return boards[0], nil
+	}
+
+	id, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid board ID: %s", args[0])
+	}
+
+	var (
+		board *gitlab.IssueBoard
+		resp  *gitlab.Response
+	)
+	if group != "" {
+		var groupBoard *gitlab.GroupIssueBoard
+		groupBoard, resp, err = client.GroupIssueBoards.GetGroupIssueBoard(group, id, gitlab.WithContext(cmd.Context()))
+		if err == nil {
+			board = fromGroupBoard(groupBoard)
+		}
+	} else {
+		board, resp, err = client.Boards.GetIssueBoard(project, id, gitlab.WithContext(cmd.Context()))
+	}
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := fmt.Sprintf("%s/boards/%d", boardOwnerAPIURL(client, project, group), id)
+		return nil, errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get board %d", id), err)
+	}
+	return board, nil
+}
+
+// listBoards returns the first perPage issue boards of group when it is set,
+// and of project otherwise.
+func listBoards(cmd *cobra.Command, client *api.Client, project, group string, perPage int64) ([]*gitlab.IssueBoard, error) {
+	var (
+		boards []*gitlab.IssueBoard
+		resp   *gitlab.Response
+		err    error
+	)
+	if group != "" {
+		var groupBoards []*gitlab.GroupIssueBoard
+		groupBoards, resp, err = client.GroupIssueBoards.ListGroupIssueBoards(group, &gitlab.ListGroupIssueBoardsOptions{
+			ListOptions: gitlab.ListOptions{PerPage: perPage},
+		}, gitlab.WithContext(cmd.Context()))
+		for _, b := range groupBoards {
+			boards = append(boards, fromGroupBoard(b))
+		}
+	} else {
+		boards, resp, err = client.Boards.ListIssueBoards(project, &gitlab.ListIssueBoardsOptions{
+			ListOptions: gitlab.ListOptions{PerPage: perPage},
+		}, gitlab.WithContext(cmd.Context()))
+	}
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := boardOwnerAPIURL(client, project, group) + "/boards"
+		return nil, errors.NewAPIError("GET", url, statusCode, "Failed to list issue boards", err)
+	}
+	return boards, nil
+}
+
+// fromGroupBoard converts a group board to the shape of a project board, so
+// the rest of the board commands handle both alike.
+func fromGroupBoard(b *gitlab.GroupIssueBoard) *gitlab.IssueBoard {
+	board := &gitlab.IssueBoard{ID: b.ID, Name: b.Name, Milestone: b.Milestone, Lists: b.Lists}
+	for _, l := range b.Labels {
+		board.Labels = append(board.Labels, &gitlab.LabelDetails{ID: l.ID, Name: l.Name, Color: l.Color, Description: l.Description})
+	}
+	return board
+}
+
+// boardOwner returns the path of the group or project a board belongs to.
+func boardOwner(project, group string) string {
+	if group != "" {
+		return group
+	}
+	return project
+}
+
+// boardOwnerAPIURL returns the API URL of the group or project a board belongs to.
+func boardOwnerAPIURL(client *api.Client, project, group string) string {
+	if group != "" {
+		return api.APIURL(client.Host()) + "/groups/" + group
+	}
+	return api.APIURL(client.Host()) + "/projects/" + project
+}
+
+// fetchBoardColumns loads up to limit issues for the Open list, each of the
+// board's lists, and the Closed list, honouring the board's own scope. Issues
+// come from all of group's projects when it is set.
+func fetchBoardColumns(cmd *cobra.Command, client *api.Client, project, group string, board *gitlab.IssueBoard, limit int) ([]boardColumn, error) {
+	lists := sortedBoardLists(board.Lists)
+
+	scoped := func(state string) *gitlab.ListProjectIssuesOptions {
+		opts := &gitlab.ListProjectIssuesOptions{
+			ListOptions: gitlab.ListOptions{PerPage: int64(limit)},
+			State:       &state,
+			OrderBy:     gitlab.Ptr("relative_position"),
+			Sort:        gitlab.Ptr("asc"),
+		}
+		if len(board.Labels) > 0 {
+			labels := make(gitlab.LabelOptions, 0, len(board.Labels))
+			for _, l := range board.Labels {
+				labels = append(labels, l.Name)
+			}
+			opts.Labels = &labels
+		}
+		if board.Milestone != nil {
+			opts.Milestone = &board.Milestone.Title
+		}
+		if board.Assignee != nil {
+			opts.AssigneeUsername = &board.Assignee.Username
+		}
+		return opts
+	}
+
+	var columns []boardColumn
+	load := func(name string, listID int64, opts *gitlab.ListProjectIssuesOptions) error {
+		var (
+			issues []*gitlab.Issue
+			resp   *gitlab.Response
+			err    error
+		)
+		if group != "" {
+			issues, resp, err = client.Issues.ListGroupIssues(group, &gitlab.ListGroupIssuesOptions{
+				ListOptions:      opts.ListOptions,
+				State:            opts.State,
+				Labels:           opts.Labels,
+				NotLabels:        opts.NotLabels,
+				Milestone:        opts.Milestone,
+				AssigneeUsername: opts.AssigneeUsername,
+				IterationID:      opts.IterationID,
+				OrderBy:          opts.OrderBy,
+				Sort:             opts.Sort,
+			}, gitlab.WithContext(cmd.Context()))
+		} else {
+			issues, resp, err = client.Issues.ListProjectIssues(project, opts, gitlab.WithContext(cmd.Context()))
+		}
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			url := boardOwnerAPIURL(client, project, group) + "/issues"
+			return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to list issues for %s", name), err)
+		}
+		columns = append(columns, boardColumn{Name: name, ListID: listID, Issues: issues})
+		return nil
+	}
+
+	open := scoped("opened")
+	if names := boardLabelNames(lists); len(names) > 0 {
+		notLabels := gitlab.LabelOptions(names)
+		open.NotLabels = &notLabels
+	}
+	if err := load("Open", 0, open); err != nil {
+		return nil, err
+	}
+
+	for _, l := range lists {
+		opts := scoped("opened")
+		switch {
+		case l.Label != nil:
+			labels := gitlab.LabelOptions{l.Label.Name}
+			if opts.Labels != nil {
+				labels = append(*opts.Labels, l.Label.Name)
+			}
+			opts.Labels = &labels
+		case l.Assignee != nil:
+			opts.AssigneeUsername = &l.Assignee.Username
+		case l.Milestone != nil:
+			opts.Milestone = &l.Milestone.Title
+		case l.Iteration != nil:
+			opts.IterationID = &l.Iteration.ID
+		}
+		if err := load(boardListName(l), l.ID, opts); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := load("Closed", 0, scoped("closed")); err != nil {
+		return nil, err
+	}
+	return columns, nil
+}
+
+// findBoardList resolves the --to-list value of board move. It returns a nil
+// list for the Open and Closed lists, which are identified by name.
+func findBoardList(lists []*gitlab.BoardList, name string) (*gitlab.BoardList, string, error) {
+	switch strings.ToLower(name) {
+	case "open":
+		return nil, "Open", nil
+	case "closed":
+		return nil, "Closed", nil
+	}
+
+	for _, l := range lists {
+		if strconv.FormatInt(l.ID, 10) == name || strings.EqualFold(boardListName(l), name) {
+			if l.Label == nil {
+				return nil, "", fmt.Errorf("cannot move issues to %s: only label lists, open, and closed are supported", boardListName(l))
+			}
+			return l, l.Label.Name, nil
+		}
+	}
+	return nil, "", fmt.Errorf("no list %q on this board", name)
+}
+
+// boardMoveOptions builds the issue update that moves issue to target (nil
+// for the Open and Closed lists). It also returns the name of the list the
+// issue is currently in, and nil options when the issue is already there.
+func boardMoveOptions(lists []*gitlab.BoardList, issue *gitlab.Issue, target *gitlab.BoardList, targetName string) (*gitlab.UpdateIssueOptions, string) {
+	from := "Open"
+	var remove gitlab.LabelOptions
+	for _, name := range boardLabelNames(lists) {
+		if !slices.Contains(issue.Labels, name) {
+			continue
+		}
+		if from == "Open" {
+			from = name
+		}
+		if target == nil || name != target.Label.Name {
+			remove = append(remove, name)
+		}
+	}
+	if issue.State == "closed" {
+		from = "Closed"
+	}
+	if from == targetName && len(remove) == 0 {
+		return nil, from
+	}
+
+	opts := &gitlab.UpdateIssueOptions{}
+	if len(remove) > 0 {
+		opts.RemoveLabels = &remove
+	}
+	if target != nil && !slices.Contains(issue.Labels, target.Label.Name) {
+		opts.AddLabels = &gitlab.LabelOptions{target.Label.Name}
+	}
+	switch {
+	case targetName == "Closed" && issue.State != "closed":
+		opts.StateEvent = gitlab.Ptr("close")
+	case targetName != "Closed" && issue.State == "closed":
+		opts.StateEvent = gitlab.Ptr("reopen")
+	}
+	return opts, from
+}
+
+// renderBoard prints the board columns side by side when they fit in width,
+// or one after another otherwise.
+func renderBoard(out io.Writer, width int, columns []boardColumn) {
+	const sep = " │ "
+	n := len(columns)
+	if n == 0 {
+		return
+	}
+	colWidth := (width - len([]rune(sep))*(n-1)) / n
+
+	if colWidth < minBoardColumnWidth {
+		for i, c := range columns {
+			if i > 0 {
+				_, _ = fmt.Fprintln(out)
+			}
+			_, _ = fmt.Fprintf(out, "%s (%d)\n", c.Name, len(c.Issues))
+			for _, issue := range c.Issues {
+				_, _ = fmt.Fprintf(out, "  #%d %s\n", issue.IID, issue.Title)
+			}
+		}
+		return
+	}
+
+	rows := 0
+	for _, c := range columns {
+		rows = max(rows, len(c.Issues))
+	}
+
+	line := func(cell func(c boardColumn) string) {
+		cells := make([]string, n)
+		for i, c := range columns {
+			cells[i] = fitWidth(cell(c), colWidth)
+		}
+		_, _ = fmt.Fprintln(out, strings.TrimRight(strings.Join(cells, sep), " "))
+	}
+
+	line(func(c boardColumn) string { return fmt.Sprintf("%s (%d)", c.Name, len(c.Issues)) })
+	line(func(c boardColumn) string { return strings.Repeat("─", colWidth) })
+	for r := 0; r < rows; r++ {
+		line(func(c boardColumn) string {
+			if r >= len(c.Issues) {
+				return ""
+			}
+			return fmt.Sprintf("#%d %s", c.Issues[r].IID, c.Issues[r].Title)
+		})
+	}
+}
+
+// fitWidth truncates or pads s to exactly width runes.
+func fitWidth(s string, width int) string {
+	n := utf8.RuneCountInString(s)
+	if n <= width {
+		return s + strings.Repeat(" ", width-n)
+	}
+	r := []rune(s)
+	return string(r[:width-1]) + "…"
+}
+
+// sortedBoardLists returns the lists of a board in display order.
+func sortedBoardLists(lists []*gitlab.BoardList) []*gitlab.BoardList {
+	sorted := slices.Clone(lists)
+	slices.SortStableFunc(sorted, func(a, b *gitlab.BoardList) int {
+		return int(a.Position - b.Position)
+	})
+	return sorted
+}
+
+// boardLabelNames returns the labels of a board's label lists.
+func boardLabelNames(lists []*gitlab.BoardList) []string {
+	var names []string
+	for _, l := range lists {
+		if l.Label != nil {
+			names = append(names, l.Label.Name)
+		}
+	}
+	return names
+}
+
+// boardListName returns the title GitLab shows for a board list.
+func boardListName(l *gitlab.BoardList) string {
+	switch {
+	case l.Label != nil:
+		return l.Label.Name
+	case l.Assignee != nil:
+		return "@" + l.Assignee.Username
+	case l.Milestone != nil:
+		return l.Milestone.Title
+	case l.Iteration != nil:
+		return l.Iteration.Title
+	}
+	return fmt.Sprintf("List %d", l.ID)
+}
@@ -0,0 +1,308 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestBoardCmd_HasSubcommands(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewBoardCmd(f)
+
+	expectedSubcommands := []string{
+		"list",
+		"view",
+		"move",
+	}
+
+	subcommands := cmd.Commands()
+	if len(subcommands) != len(expectedSubcommands) {
+		t.Errorf("expected %d subcommands, got %d", len(expectedSubcommands), len(subcommands))
+	}
+
+	foundSubcommands := make(map[string]bool)
+	for _, subcmd := range subcommands {
+		foundSubcommands[subcmd.Name()] = true
+	}
+
+	for _, expected := range expectedSubcommands {
+		if !foundSubcommands[expected] {
+			t.Errorf("expected subcommand %q not found", expected)
+		}
+	}
+}
+
+// testBoard is a board with To Do and Doing label lists.
+var testBoard = map[string]any{
+	"id": 3, "name": "Development",
+	"lists": []any{
+		map[string]any{"id": 11, "position": 1, "label": map[string]any{"name": "Doing"}},
+		map[string]any{"id": 10, "position": 0, "label": map[string]any{"name": "To Do"}},
+	},
+}
+
+func TestBoardList(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/projects/test-owner/test-repo/boards" {
+			cmdtest.JSONResponse(w, 200, []any{testBoard})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newBoardListCmd(f.Factory)
+	cmd.SetArgs([]string{})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(f.IO.String(), "Development") || !strings.Contains(f.IO.String(), "To Do, Doing") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestBoardView(t *testing.T) {
+	queries := map[string]string{}
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v4/projects/test-owner/test-repo/boards/3":
+			cmdtest.JSONResponse(w, 200, testBoard)
+		case "/api/v4/projects/test-owner/test-repo/issues":
+			q := r.URL.Query()
+			switch {
+			case q.Get("state") == "closed":
+				queries["closed"] = q.Encode()
+				cmdtest.JSONResponse(w, 200, []any{map[string]any{"id": 1, "iid": 1, "title": "Done thing"}})
+			case q.Get("labels") == "Doing":
+				queries["doing"] = q.Encode()
+				cmdtest.JSONResponse(w, 200, []any{map[string]any{"id": 2, "iid": 2, "title": "Busy thing"}})
+			case q.Get("labels") == "To Do":
+				cmdtest.JSONResponse(w, 200, []any{})
+			default:
+				queries["open"] = q.Encode()
+				cmdtest.JSONResponse(w, 200, []any{map[string]any{"id": 3, "iid": 3, "title": "New thing"}})
+			}
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newBoardViewCmd(f.Factory)
+	cmd.SetArgs([]string{"3"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(queries["open"], "not%5Blabels%5D=To+Do%2CDoing") || !strings.Contains(queries["open"], "state=opened") {
+		t.Errorf("unexpected Open list query: %q", queries["open"])
+	}
+	out := f.IO.String()
+	for _, want := range []string{"Development", "Open (1)", "To Do (0)", "Doing (1)", "Closed (1)", "#3 New thing", "#2 Busy thing", "#1 Done thing"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got %q", want, out)
+		}
+	}
+}
+
+func TestBoardMove(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v4/projects/test-owner/test-repo/boards":
+			cmdtest.JSONResponse(w, 200, []any{testBoard})
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/test-owner/test-repo/issues/42":
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 1, "iid": 42, "state": "opened", "labels": []string{"bug", "To Do"}})
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v4/projects/test-owner/test-repo/issues/42":
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 1, "iid": 42})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newBoardMoveCmd(f.Factory)
+	cmd.SetArgs([]string{"42", "--to-list", "doing"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["add_labels"] != "Doing" || body["remove_labels"] != "To Do" {
+		t.Errorf("unexpected request body: %v", body)
+	}
+	if _, ok := body["state_event"]; ok {
+		t.Errorf("expected no state change, got %v", body)
+	}
+	if !strings.Contains(f.IO.String(), "Moved issue #42 from To Do to Doing") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestBoardList_Group(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/groups/my-group/boards" {
+			cmdtest.JSONResponse(w, 200, []any{testBoard})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newBoardListCmd(f.Factory)
+	cmd.SetArgs([]string{"--group", "my-group"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(f.IO.String(), "Development") || !strings.Contains(f.IO.String(), "To Do, Doing") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestBoardView_Group(t *testing.T) {
+	var queries []string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v4/groups/my-group/boards":
+			board := map[string]any{"labels": []any{map[string]any{"id": 5, "name": "backend"}}}
+			for k, v := range testBoard {
+				board[k] = v
+			}
+			cmdtest.JSONResponse(w, 200, []any{board})
+		case "/api/v4/groups/my-group/issues":
+			queries = append(queries, r.URL.Query().Encode())
+			cmdtest.JSONResponse(w, 200, []any{map[string]any{"id": 1, "iid": 7, "title": "Group thing"}})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newBoardViewCmd(f.Factory)
+	cmd.SetArgs([]string{"--group", "my-group"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(queries) != 4 {
+		t.Fatalf("expected 4 group issue queries, got %d: %v", len(queries), queries)
+	}
+	for _, q := range queries {
+		if !strings.Contains(q, "labels=backend") {
+			t.Errorf("expected the board's label scope in %q", q)
+		}
+	}
+	if !strings.Contains(f.IO.String(), "Doing (1)") || !strings.Contains(f.IO.String(), "#7 Group thing") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestBoardMove_Group(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v4/groups/my-group/boards/3":
+			cmdtest.JSONResponse(w, 200, testBoard)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/test-owner/test-repo/issues/42":
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 1, "iid": 42, "state": "opened", "labels": []string{"Doing"}})
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v4/projects/test-owner/test-repo/issues/42":
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 1, "iid": 42})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newBoardMoveCmd(f.Factory)
+	cmd.SetArgs([]string{"42", "--to-list", "closed", "--group", "my-group", "--board", "3"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["state_event"] != "close" || body["remove_labels"] != "Doing" {
+		t.Errorf("unexpected request body: %v", body)
+	}
+	if !strings.Contains(f.IO.String(), "Moved issue #42 from Doing to Closed") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestBoardMoveOptions(t *testing.T) {
+	lists := []*gitlab.BoardList{
+		{ID: 10, Label: &gitlab.Label{Name: "To Do"}},
+		{ID: 11, Label: &gitlab.Label{Name: "Doing"}},
+	}
+
+	t.Run("close", func(t *testing.T) {
+		issue := &gitlab.Issue{State: "opened", Labels: gitlab.Labels{"Doing"}}
+		opts, from := boardMoveOptions(lists, issue, nil, "Closed")
+		if from != "Doing" || opts == nil || *opts.StateEvent != "close" || (*opts.RemoveLabels)[0] != "Doing" {
+			t.Errorf("unexpected result: %+v from %q", opts, from)
+		}
+	})
+
+	t.Run("reopen into list", func(t *testing.T) {
+		issue := &gitlab.Issue{State: "closed"}
+		opts, from := boardMoveOptions(lists, issue, lists[0], "To Do")
+		if from != "Closed" || opts == nil || *opts.StateEvent != "reopen" || (*opts.AddLabels)[0] != "To Do" {
+			t.Errorf("unexpected result: %+v from %q", opts, from)
+		}
+	})
+
+	t.Run("already there", func(t *testing.T) {
+		issue := &gitlab.Issue{State: "opened", Labels: gitlab.Labels{"Doing"}}
+		if opts, _ := boardMoveOptions(lists, issue, lists[1], "Doing"); opts != nil {
+			t.Errorf("expected no update, got %+v", opts)
+		}
+	})
+}
+
+func TestFindBoardList_Unknown(t *testing.T) {
+	_, _, err := findBoardList([]*gitlab.BoardList{{ID: 10, Label: &gitlab.Label{Name: "To Do"}}}, "Review")
+	if err == nil || !strings.Contains(err.Error(), `no list "Review"`) {
+		t.Errorf("expected unknown list error, got %v", err)
+	}
+}
+
+func TestRenderBoard(t *testing.T) {
+	columns := []boardColumn{
+		{Name: "Open", Issues: []*gitlab.Issue{{IID: 1, Title: "A rather long issue title that will not fit"}}},
+		{Name: "Closed", Issues: []*gitlab.Issue{{IID: 2, Title: "Short"}, {IID: 3, Title: "Other"}}},
+	}
+
+	t.Run("columns", func(t *testing.T) {
+		var buf bytes.Buffer
+		renderBoard(&buf, 53, columns)
+		lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+		if len(lines) != 4 {
+			t.Fatalf("expected 4 lines, got %q", buf.String())
+		}
+		if lines[0] != "Open (1)                  │ Closed (2)" {
+			t.Errorf("unexpected header: %q", lines[0])
+		}
+		if lines[2] != "#1 A rather long issue t… │ #2 Short" {
+			t.Errorf("unexpected first row: %q", lines[2])
+		}
+		if lines[3] != "                          │ #3 Other" {
+			t.Errorf("unexpected second row: %q", lines[3])
+		}
+	})
+
+	t.Run("stacked", func(t *testing.T) {
+		var buf bytes.Buffer
+		renderBoard(&buf, 30, columns)
+		want := "Open (1)\n  #1 A rather long issue title that will not fit\n\nClosed (2)\n  #2 Short\n  #3 Other\n"
+		if buf.String() != want {
+			t.Errorf("unexpected output:\n%s", buf.String())
+		}
+	})
+}
@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/PhilipKram/gitlab-cli/internal/api"
 	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
@@ -15,30 +17,40 @@ func NewBranchCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "branch <command>",
 		Short: "Manage branches",
-		Long:  "List, create, and delete repository branches.",
+		Long:  "List, create, delete, and protect repository branches.",
 	}
 
 	cmd.AddCommand(newBranchListCmd(f))
 	cmd.AddCommand(newBranchCreateCmd(f))
 	cmd.AddCommand(newBranchDeleteCmd(f))
+	cmd.AddCommand(newBranchProtectCmd(f))
+	cmd.AddCommand(newBranchUnprotectCmd(f))
 
 	return cmd
 }
 
 func newBranchListCmd(f *cmdutil.Factory) *cobra.Command {
 	var (
-		limit    int
-		format   string
-		jsonFlag bool
-		search   string
+		limit       int
+		format      string
+		jsonFlag    bool
+		search      string
+		aheadBehind bool
 	)
 
 	cmd := &cobra.Command{
 		Use:     "list",
 		Short:   "List branches",
 		Aliases: []string{"ls"},
+		Long: `List repository branches.
+
+The table shows whether each branch is the default branch, protected, or
+merged into the default branch. With --ahead-behind, it also shows how many
+commits each branch is ahead of and behind the default branch; this makes
+two extra API requests per branch.`,
 		Example: `  $ glab branch list
   $ glab branch list --search feature
+  $ glab branch list --ahead-behind
   $ glab branch list --json`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := f.Client()
@@ -74,14 +86,46 @@ func newBranchListCmd(f *cmdutil.Factory) *cobra.Command {
 				return nil
 			}
 
-			return f.FormatAndPrint(branches, format, jsonFlag)
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(branches, format, jsonFlag)
+			}
+
+			defaultBranch := ""
+			if aheadBehind {
+				defaultBranch, err = projectDefaultBranch(client, project, branches)
+				if err != nil {
+					return err
+				}
+			}
+
+			tp := f.NewTablePrinter()
+			for _, b := range branches {
+				row := []string{b.Name, branchStatus(b)}
+				if aheadBehind {
+					counts := ""
+					if b.Name != defaultBranch {
+						ahead, behind, err := branchAheadBehind(client, project, defaultBranch, b.Name)
+						if err != nil {
+							return err
+						}
+						counts = fmt.Sprintf("+%d -%d", ahead, behind)
+					}
+					row = append(row, counts)
+				}
+				if b.Commit != nil {
+					row = append(row, b.Commit.ShortID, truncate(b.Commit.Title, 50), timeAgo(b.Commit.CommittedDate))
+				}
+				tp.AddRow(row...)
+			}
+			return tp.Render()
 		},
 	}
 
 	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
 	cmd.Flags().StringVar(&search, "search", "", "Search branches by name")
+	cmd.Flags().BoolVar(&aheadBehind, "ahead-behind", false, "Show commits ahead of and behind the default branch")
 
 	return cmd
 }
@@ -171,3 +215,228 @@ func newBranchDeleteCmd(f *cmdutil.Factory) *cobra.Command {
 
 	return cmd
 }
+
+func newBranchProtectCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		pushLevel         string
+		mergeLevel        string
+		unprotectLevel    string
+		allowForcePush    bool
+		codeOwnerApproval bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "protect <branch>",
+		Short: "Protect a branch",
+		Long: `Protect a branch, or a wildcard pattern such as "release/*".
+
+Access levels can be one of: no-access, developer, maintainer, or admin.
+Levels that are not specified are left to the GitLab defaults
+(maintainer for push, merge, and unprotect).`,
+		Example: `  $ glab branch protect main
+  $ glab branch protect main --push-access-level no-access --merge-access-level developer
+  $ glab branch protect "release/*" --allow-force-push=false --code-owner-approval`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			opts := &gitlab.ProtectRepositoryBranchesOptions{
+				Name: &name,
+			}
+
+			levels := []struct {
+				flag  string
+				value string
+				dest  **gitlab.AccessLevelValue
+			}{
+				{"push-access-level", pushLevel, &opts.PushAccessLevel},
+				{"merge-access-level", mergeLevel, &opts.MergeAccessLevel},
+				{"unprotect-access-level", unprotectLevel, &opts.UnprotectAccessLevel},
+			}
+			for _, l := range levels {
+				if !cmd.Flags().Changed(l.flag) {
+					continue
+				}
+				level, err := parseAccessLevel(l.value)
+				if err != nil {
+					return fmt.Errorf("invalid --%s: %w", l.flag, err)
+				}
+				*l.dest = gitlab.Ptr(level)
+			}
+			if cmd.Flags().Changed("allow-force-push") {
+				opts.AllowForcePush = &allowForcePush
+			}
+			if cmd.Flags().Changed("code-owner-approval") {
+				opts.CodeOwnerApprovalRequired = &codeOwnerApproval
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			pb, resp, err := client.ProtectedBranches.ProtectRepositoryBranches(project, opts)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + project + "/protected_branches"
+				return errors.NewAPIError("POST", url, statusCode, "Failed to protect branch", err)
+			}
+
+			out := f.IOStreams.Out
+			_, _ = fmt.Fprintf(out, "Protected branch %q\n", pb.Name)
+			_, _ = fmt.Fprintf(out, "Push:      %s\n", branchAccessDescription(pb.PushAccessLevels))
+			_, _ = fmt.Fprintf(out, "Merge:     %s\n", branchAccessDescription(pb.MergeAccessLevels))
+			_, _ = fmt.Fprintf(out, "Unprotect: %s\n", branchAccessDescription(pb.UnprotectAccessLevels))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&pushLevel, "push-access-level", "", "Access level allowed to push: no-access, developer, maintainer, or admin")
+	cmd.Flags().StringVar(&mergeLevel, "merge-access-level", "", "Access level allowed to merge: no-access, developer, maintainer, or admin")
+	cmd.Flags().StringVar(&unprotectLevel, "unprotect-access-level", "", "Access level allowed to unprotect: developer, maintainer, or admin")
+	cmd.Flags().BoolVar(&allowForcePush, "allow-force-push", false, "Allow force pushes to the branch")
+	cmd.Flags().BoolVar(&codeOwnerApproval, "code-owner-approval", false, "Require code owner approval for changes to the branch")
+
+	return cmd
+}
+
+func newBranchUnprotectCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unprotect <branch>",
+		Short:   "Remove protection from a branch",
+		Example: `  $ glab branch unprotect main`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			name := args[0]
+
+			resp, err := client.ProtectedBranches.UnprotectRepositoryBranches(project, name)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + project + "/protected_branches/" + name
+				return errors.NewAPIError("DELETE", url, statusCode, "Failed to unprotect branch", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Unprotected branch %q\n", name)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// branchStatus summarizes the default, protected, and merged state of a branch.
+func branchStatus(b *gitlab.Branch) string {
+	var parts []string
+	if b.Default {
+		parts = append(parts, "default")
+	}
+	if b.Protected {
+		parts = append(parts, "protected")
+	}
+	if b.Merged {
+		parts = append(parts, "merged")
+	}
+	return strings.Join(parts, ", ")
+}
+
+// projectDefaultBranch returns the name of the default branch, taken from
+// branches when it is listed there and from the project otherwise.
+func projectDefaultBranch(client *api.Client, project string, branches []*gitlab.Branch) (string, error) {
+	for _, b := range branches {
+		if b.Default {
+			return b.Name, nil
+		}
+	}
+	p, resp, err := client.Projects.GetProject(project, nil)
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := api.APIURL(client.Host()) + "/projects/" + project
+		return "", errors.NewAPIError("GET", url, statusCode, "Failed to get project", err)
+	}
+	return p.DefaultBranch, nil
+}
+
+// branchAheadBehind returns how many commits branch is ahead of and behind base.
+func branchAheadBehind(client *api.Client, project, base, branch string) (int, int, error) {
+	count := func(from, to string) (int, error) {
+		cmp, resp, err := client.Repositories.Compare(project, &gitlab.CompareOptions{From: &from, To: &to})
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			url := fmt.Sprintf("%s/projects/%s/repository/compare?from=%s&to=%s", api.APIURL(client.Host()), project, from, to)
+			return 0, errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to compare %s with %s", to, from), err)
+		}
+		return len(cmp.Commits), nil
+	}
+
+	ahead, err := count(base, branch)
+	if err != nil {
+		return 0, 0, err
+	}
+	behind, err := count(branch, base)
+	if err != nil {
+		return 0, 0, err
+	}
+	return ahead, behind, nil
+}
+
+// parseAccessLevel maps a protection access level name or number to its API value.
+func parseAccessLevel(s string) (gitlab.AccessLevelValue, error) {
+	switch strings.ToLower(s) {
+	case "no-access", "none", "noone", "no_access":
+		return gitlab.NoPermissions, nil
+	case "developer", "developers":
+		return gitlab.DeveloperPermissions, nil
+	case "maintainer", "maintainers":
+		return gitlab.MaintainerPermissions, nil
+	case "admin", "admins":
+		return gitlab.AdminPermissions, nil
+	}
+	if n, err := strconv.Atoi(s); err == nil {
+		switch level := gitlab.AccessLevelValue(n); level {
+		case gitlab.NoPermissions, gitlab.DeveloperPermissions, gitlab.MaintainerPermissions, gitlab.AdminPermissions:
+			return level, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown access level %q: must be no-access, developer, maintainer, or admin", s)
+}
+
+// branchAccessDescription renders the access levels of a protected branch rule.
+func branchAccessDescription(levels []*gitlab.BranchAccessDescription) string {
+	if len(levels) == 0 {
+		return "No one"
+	}
+	names := make([]string, len(levels))
+	for i, l := range levels {
+		names[i] = l.AccessLevelDescription
+		if names[i] == "" {
+			names[i] = accessLevelName(l.AccessLevel)
+		}
+	}
+	return strings.Join(names, ", ")
+}
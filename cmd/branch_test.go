@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"encoding/json"
 	"net/http"
 	"strings"
 	"testing"
@@ -29,6 +30,8 @@ func TestBranchCmd_HasSubcommands(t *testing.T) {
 		"list",
 		"create",
 		"delete",
+		"protect",
+		"unprotect",
 	}
 
 	subcommands := cmd.Commands()
@@ -260,3 +263,137 @@ func TestBranchDelete_NotFound(t *testing.T) {
 		t.Fatal("expected error for not found branch")
 	}
 }
+
+func TestBranchList_AheadBehind(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v4/projects/test-owner/test-repo/repository/branches":
+			cmdtest.JSONResponse(w, 200, []map[string]any{
+				{"name": "main", "default": true, "protected": true, "commit": map[string]any{"short_id": "aaa111", "title": "Release"}},
+				{"name": "feature", "merged": true, "commit": map[string]any{"short_id": "bbb222", "title": "Add feature"}},
+			})
+		case "/api/v4/projects/test-owner/test-repo/repository/compare":
+			commits := []map[string]any{{"id": "1"}, {"id": "2"}}
+			if r.URL.Query().Get("from") == "feature" {
+				commits = commits[:1]
+			}
+			cmdtest.JSONResponse(w, 200, map[string]any{"commits": commits})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newBranchListCmd(f.Factory)
+	cmd.SetArgs([]string{"--ahead-behind"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := f.IO.String()
+	for _, want := range []string{"default, protected", "merged", "+2 -1", "bbb222"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got %q", want, out)
+		}
+	}
+}
+
+func TestBranchProtect(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v4/projects/test-owner/test-repo/protected_branches" {
+			cmdtest.ErrorResponse(w, 404, "not found")
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		cmdtest.JSONResponse(w, 201, map[string]any{
+			"name":                    "main",
+			"push_access_levels":      []map[string]any{{"access_level": 0, "access_level_description": "No one"}},
+			"merge_access_levels":     []map[string]any{{"access_level": 30, "access_level_description": "Developers + Maintainers"}},
+			"unprotect_access_levels": []map[string]any{{"access_level": 40}},
+		})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newBranchProtectCmd(f.Factory)
+	cmd.SetArgs([]string{"main", "--push-access-level", "no-access", "--merge-access-level", "developer", "--allow-force-push=false"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["name"] != "main" || body["push_access_level"] != float64(0) || body["merge_access_level"] != float64(30) {
+		t.Errorf("unexpected request body: %v", body)
+	}
+	if v, ok := body["allow_force_push"]; !ok || v != false {
+		t.Errorf("expected allow_force_push=false to be sent, got %v", body)
+	}
+	if _, ok := body["unprotect_access_level"]; ok {
+		t.Errorf("expected unprotect_access_level to be omitted, got %v", body)
+	}
+
+	out := f.IO.String()
+	for _, want := range []string{`Protected branch "main"`, "Push:      No one", "Developers + Maintainers", "Maintainer"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got %q", want, out)
+		}
+	}
+}
+
+func TestBranchProtect_InvalidAccessLevel(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newBranchProtectCmd(f.Factory)
+	cmd.SetArgs([]string{"main", "--push-access-level", "reporter"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "invalid --push-access-level") {
+		t.Errorf("expected access level error, got %v", err)
+	}
+}
+
+func TestBranchUnprotect(t *testing.T) {
+	var method, path string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		w.WriteHeader(204)
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newBranchUnprotectCmd(f.Factory)
+	cmd.SetArgs([]string{"release/*"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if method != http.MethodDelete || path != "/api/v4/projects/test-owner/test-repo/protected_branches/release/*" {
+		t.Errorf("unexpected request: %s %s", method, path)
+	}
+	if !strings.Contains(f.IO.String(), `Unprotected branch "release/*"`) {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestParseAccessLevel(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    int
+		wantErr bool
+	}{
+		{"no-access", 0, false},
+		{"Developer", 30, false},
+		{"maintainer", 40, false},
+		{"admin", 60, false},
+		{"40", 40, false},
+		{"reporter", 0, true},
+		{"20", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseAccessLevel(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseAccessLevel(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && int(got) != tt.want {
+			t.Errorf("parseAccessLevel(%q) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
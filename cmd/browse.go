@@ -2,39 +2,79 @@ package cmd
 
 import (
 	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 
 	"github.com/PhilipKram/gitlab-cli/internal/api"
 	"github.com/PhilipKram/gitlab-cli/internal/browser"
 	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	gitutil "github.com/PhilipKram/gitlab-cli/internal/git"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
+// lineSuffixRE matches the line or line range suffix of a browse path, as in
+// src/main.go:42 or src/main.go:42-50.
+var lineSuffixRE = regexp.MustCompile(`:(\d+)(?:-(\d+))?$`)
+
+// issueNumberRE matches a browse argument that is an issue number.
+var issueNumberRE = regexp.MustCompile(`^[1-9]\d*$`)
+
 // NewBrowseCmd creates the browse command.
 func NewBrowseCmd(f *cmdutil.Factory) *cobra.Command {
 	var (
-		branch   string
-		settings bool
-		members  bool
-		issues   bool
-		mrs      bool
-		pipeline bool
+		branch    string
+		commit    string
+		mr        int
+		settings  bool
+		members   bool
+		issues    bool
+		mrs       bool
+		pipelines bool
+		noBrowser bool
 	)
 
 	cmd := &cobra.Command{
 		Use:   "browse [path]",
 		Short: "Open project in browser",
-		Long:  "Open the GitLab project page in your default web browser.",
+		Long: `Open the GitLab project page in your default web browser.
+
+With a path, open that file or directory of the project. Paths are relative
+to the current directory, or to the repository root when they start with "/".
+A ":LINE" or ":START-END" suffix highlights those lines of a file. Files are
+shown on the current branch unless --branch is given; --branch alone opens
+the branch's tree.
+
+With a number, open that issue.`,
 		Example: `  $ glab browse
-  $ glab browse --settings
-  $ glab browse --issues
-  $ glab browse --mrs
-  $ glab browse --pipeline
-  $ glab browse src/main.go
-  $ glab browse src/main.go --branch develop`,
+  $ glab browse src/main.go:42
+  $ glab browse src/main.go:42-50 --branch develop
+  $ glab browse 123
+  $ glab browse --mr 45
+  $ glab browse --commit a1b2c3d
+  $ glab browse --pipelines
+  $ glab browse --settings`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			remote, err := f.Remote()
-			if err != nil {
-				return err
+			selected := 0
+			for _, set := range []bool{commit != "", mr != 0, settings, members, issues, mrs, pipelines, len(args) > 0} {
+				if set {
+					selected++
+				}
+			}
+			if selected > 1 {
+				return fmt.Errorf("specify only one of a path, --commit, --mr, --settings, --members, --issues, --mrs, or --pipelines")
+			}
+
+			// Without --repo, the project is that of the current git remote.
+			local := !f.HasRepoOverride()
+			if local {
+				if _, err := f.Remote(); err != nil {
+					return err
+				}
 			}
 
 			project, err := f.FullProjectPath()
@@ -42,41 +82,132 @@ func NewBrowseCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			baseURL := api.WebURL(remote.Host, project)
+			baseURL := api.WebURL(f.Host(), project)
 
-			var url string
+			var target string
 			switch {
+			case commit != "":
+				if local {
+					if sha, err := gitutil.CommitSHA(commit); err == nil {
+						commit = sha
+					}
+				}
+				target = baseURL + "/-/commit/" + url.PathEscape(commit)
+			case mr != 0:
+				target = fmt.Sprintf("%s/-/merge_requests/%d", baseURL, mr)
 			case settings:
-				url = baseURL + "/-/edit"
+				target = baseURL + "/-/edit"
 			case members:
-				url = baseURL + "/-/project_members"
+				target = baseURL + "/-/project_members"
 			case issues:
-				url = baseURL + "/-/issues"
+				target = baseURL + "/-/issues"
 			case mrs:
-				url = baseURL + "/-/merge_requests"
-			case pipeline:
-				url = baseURL + "/-/pipelines"
+				target = baseURL + "/-/merge_requests"
+			case pipelines:
+				target = baseURL + "/-/pipelines"
+			case len(args) > 0 && issueNumberRE.MatchString(args[0]):
+				target = baseURL + "/-/issues/" + args[0]
 			case len(args) > 0:
-				path := args[0]
 				if branch == "" {
 					branch = "HEAD"
+					if current, err := gitutil.CurrentBranch(); local && err == nil {
+						branch = current
+					}
+				}
+				target, err = browsePathURL(baseURL, branch, args[0], local)
+				if err != nil {
+					return err
+				}
+			case branch != "":
+				target, err = browsePathURL(baseURL, branch, "/", local)
+				if err != nil {
+					return err
 				}
-				url = fmt.Sprintf("%s/-/blob/%s/%s", baseURL, branch, path)
 			default:
-				url = baseURL
+				target = baseURL
 			}
 
-			_, _ = fmt.Fprintln(f.IOStreams.Out, url)
-			return browser.Open(url)
+			_, _ = fmt.Fprintln(f.IOStreams.Out, target)
+			if noBrowser {
+				return nil
+			}
+			return browser.Open(target)
 		},
 	}
 
-	cmd.Flags().StringVarP(&branch, "branch", "b", "", "Branch to browse")
+	cmd.Flags().StringVarP(&branch, "branch", "b", "", "Branch to browse (default: the current branch for a path)")
+	cmd.Flags().StringVarP(&commit, "commit", "c", "", "Open a commit")
+	cmd.Flags().IntVarP(&mr, "mr", "m", 0, "Open a merge request by `number`")
 	cmd.Flags().BoolVarP(&settings, "settings", "s", false, "Open settings page")
 	cmd.Flags().BoolVar(&members, "members", false, "Open members page")
 	cmd.Flags().BoolVar(&issues, "issues", false, "Open issues page")
 	cmd.Flags().BoolVar(&mrs, "mrs", false, "Open merge requests page")
-	cmd.Flags().BoolVarP(&pipeline, "pipeline", "p", false, "Open pipelines page")
+	cmd.Flags().BoolVarP(&pipelines, "pipelines", "p", false, "Open pipelines page")
+	cmd.Flags().BoolVarP(&noBrowser, "no-browser", "n", false, "Print the URL instead of opening it")
+
+	// --pipeline is the flag's former name.
+	cmd.Flags().SetNormalizeFunc(func(_ *pflag.FlagSet, name string) pflag.NormalizedName {
+		if name == "pipeline" {
+			name = "pipelines"
+		}
+		return pflag.NormalizedName(name)
+	})
 
 	return cmd
 }
+
+// browsePathURL returns the web URL of path, with an optional line suffix,
+// on ref. When local is set, path is resolved against the current directory
+// of the git checkout, and checked for being a directory.
+func browsePathURL(baseURL, ref, path string, local bool) (string, error) {
+	var fragment string
+	if m := lineSuffixRE.FindStringSubmatch(path); m != nil {
+		path = strings.TrimSuffix(path, m[0])
+		fragment = "#L" + m[1]
+		if m[2] != "" {
+			fragment += "-" + m[2]
+		}
+	}
+
+	isDir := strings.HasSuffix(path, "/")
+	if strings.HasPrefix(path, "/") {
+		path = strings.TrimLeft(path, "/")
+	} else if local {
+		prefix, err := gitutil.PathPrefix()
+		if err != nil {
+			return "", err
+		}
+		path = filepath.ToSlash(filepath.Join(filepath.FromSlash(prefix), filepath.FromSlash(path)))
+		if path == ".." || strings.HasPrefix(path, "../") {
+			return "", fmt.Errorf("%s is outside the repository", path)
+		}
+	}
+	path = strings.Trim(path, "/")
+	if path == "." {
+		path = ""
+	}
+	if local {
+		if top, err := gitutil.TopLevelDir(); err == nil {
+			if info, err := os.Stat(filepath.Join(top, filepath.FromSlash(path))); err == nil && info.IsDir() {
+				isDir = true
+			}
+		}
+	}
+
+	if isDir && fragment != "" {
+		return "", fmt.Errorf("a line number requires a file, not a directory")
+	}
+	kind := "blob"
+	if isDir || path == "" {
+		kind = "tree"
+	}
+
+	segments := strings.Split(ref, "/")
+	if path != "" {
+		segments = append(segments, strings.Split(path, "/")...)
+	}
+	for i, s := range segments {
+		segments[i] = url.PathEscape(s)
+	}
+	return fmt.Sprintf("%s/-/%s/%s%s", baseURL, kind, strings.Join(segments, "/"), fragment), nil
+}
@@ -2,6 +2,9 @@ package cmd
 
 import (
 	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"testing"
 
 	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
@@ -130,3 +133,85 @@ func TestBrowse_WithBranch(t *testing.T) {
 	// Will fail without git repo, but tests flag parsing
 	_ = cmd.Execute()
 }
+
+func TestBrowse_URLs(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "src", "pkg"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "src", "main.go"), []byte("package main\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for _, args := range [][]string{
+		{"init", "-q", "-b", "feature/x", dir},
+		{"-C", dir, "add", "."},
+		{"-C", dir, "-c", "user.name=Test", "-c", "user.email=test@example.com", "commit", "-q", "-m", "init"},
+	} {
+		if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+			t.Skipf("git %v failed: %v: %s", args, err, out)
+		}
+	}
+	sha, err := exec.Command("git", "-C", dir, "rev-parse", "HEAD").Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(filepath.Join(dir, "src"))
+
+	base := "https://gitlab.com/test-owner/test-repo"
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"project", nil, base},
+		{"file with line", []string{"main.go:42"}, base + "/-/blob/feature/x/src/main.go#L42"},
+		{"line range on branch", []string{"main.go:42-50", "-b", "develop"}, base + "/-/blob/develop/src/main.go#L42-50"},
+		{"directory", []string{"pkg"}, base + "/-/tree/feature/x/src/pkg"},
+		{"repository root path", []string{"/README.md"}, base + "/-/blob/feature/x/README.md"},
+		{"branch", []string{"-b", "develop"}, base + "/-/tree/develop"},
+		{"issue", []string{"123"}, base + "/-/issues/123"},
+		{"merge request", []string{"--mr", "45"}, base + "/-/merge_requests/45"},
+		{"commit", []string{"--commit", "HEAD"}, base + "/-/commit/" + string(sha[:len(sha)-1])},
+		{"pipelines", []string{"--pipelines"}, base + "/-/pipelines"},
+		{"former pipeline flag", []string{"--pipeline"}, base + "/-/pipelines"},
+		{"settings", []string{"-s"}, base + "/-/edit"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := cmdtest.NewTestFactory(t)
+			cmd := NewBrowseCmd(f.Factory)
+			cmd.SetArgs(append([]string{"--no-browser"}, tt.args...))
+			if err := cmd.Execute(); err != nil {
+				t.Fatalf("browse %v: %v", tt.args, err)
+			}
+			if got := f.IO.String(); got != tt.want+"\n" {
+				t.Errorf("browse %v printed %q, want %q", tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBrowse_RepoOverride(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	if err := f.Factory.SetRepoOverride("gitlab.example.com/group/sub/project"); err != nil {
+		t.Fatal(err)
+	}
+	cmd := NewBrowseCmd(f.Factory)
+	cmd.SetArgs([]string{"--no-browser", "--branch", "main", "docs/a b.md:3"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("browse: %v", err)
+	}
+	want := "https://gitlab.example.com/group/sub/project/-/blob/main/docs/a%20b.md#L3\n"
+	if got := f.IO.String(); got != want {
+		t.Errorf("browse printed %q, want %q", got, want)
+	}
+}
+
+func TestBrowse_ConflictingTargets(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewBrowseCmd(f.Factory)
+	cmd.SetArgs([]string{"--no-browser", "--mr", "1", "--issues"})
+	if err := cmd.Execute(); err == nil {
+		t.Error("expected an error for --mr with --issues")
+	}
+}
@@ -0,0 +1,335 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/ciconfig"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/config"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	gitutil "github.com/PhilipKram/gitlab-cli/internal/git"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ciExecMount is where the generated job script and file variables are
+// mounted in the job container.
+const ciExecMount = "/glab-ci"
+
+// ciExecPlan is everything needed to run a job in Docker.
+type ciExecPlan struct {
+	Args   []string          // docker arguments
+	Env    map[string]string // variables passed to the container
+	Script string            // contents of the job script
+	Files  map[string]string // file variables, by file name
+}
+
+func newCIExecCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		file          string
+		ref           string
+		env           []string
+		image         string
+		noProjectVars bool
+		dryRun        bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "exec [<job>]",
+		Short: "Run a CI job locally in Docker",
+		Long: `Run a job of the project's CI configuration on this machine in Docker,
+approximating "gitlab-runner exec".
+
+The local .gitlab-ci.yml is sent to the project's CI Lint API to resolve
+includes, then the job's before_script, script, and after_script run in the
+job's image with the repository mounted as the working directory.
+
+Variables come, from lowest to highest precedence, from a subset of the
+predefined CI variables, the configuration, the project's CI/CD variables
+without an environment scope, and --env. Services, caches, artifacts, and
+rules are not supported; services are reported and skipped.
+
+Without a job name, the jobs of the configuration are listed.`,
+		Example: `  $ glab ci exec
+  $ glab ci exec test
+  $ glab ci exec build --env GOFLAGS=-mod=mod --no-project-variables
+  $ glab ci exec lint --image golang:1.22 --dry-run`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			extra, err := parsePipelineVariables(env)
+			if err != nil {
+				return err
+			}
+
+			root, err := gitutil.TopLevelDir()
+			if err != nil {
+				return fmt.Errorf("ci exec must be run inside a git repository: %w", err)
+			}
+			if file == "" {
+				file = filepath.Join(root, ".gitlab-ci.yml")
+			}
+			content, err := os.ReadFile(file)
+			if err != nil {
+				return fmt.Errorf("reading %s: %w", file, err)
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			merged, err := mergeCIConfig(cmd, client, project, string(content), ref)
+			if err != nil {
+				return err
+			}
+			cfg, err := ciconfig.Parse([]byte(merged))
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 0 {
+				tp := f.NewTablePrinter()
+				tp.SetHeader("JOB", "STAGE", "IMAGE")
+				for _, name := range cfg.JobNames() {
+					job := cfg.Jobs[name]
+					tp.AddRow(name, job.Stage, job.Image)
+				}
+				return tp.Render()
+			}
+
+			job, ok := cfg.Jobs[args[0]]
+			if !ok {
+				return fmt.Errorf("job %q not found; run glab ci exec to list jobs", args[0])
+			}
+			if image != "" {
+				job.Image = image
+			}
+			if job.Image == "" {
+				return fmt.Errorf("job %q has no image; use --image to choose one", job.Name)
+			}
+
+			vars := ciPredefinedVariables(job, client.Host(), project)
+			for k, v := range job.Variables {
+				vars[k] = v
+			}
+			files := map[string]string{}
+			if !noProjectVars {
+				projectVars, err := listCIVariables(client, variableTarget{project: project})
+				if err != nil {
+					return err
+				}
+				for _, v := range projectVars {
+					if v.EnvironmentScope != "*" && v.EnvironmentScope != "" {
+						continue
+					}
+					if v.VariableType == gitlab.FileVariableType {
+						files[v.Key] = v.Value
+						vars[v.Key] = path.Join(ciExecMount, "files", v.Key)
+						continue
+					}
+					vars[v.Key] = v.Value
+				}
+			}
+			for k, v := range extra {
+				vars[k] = v
+			}
+
+			plan := planCIExec(job, project, root, vars, files, f.IOStreams.IsStdinTTY())
+
+			errOut := f.IOStreams.ErrOut
+			for _, svc := range job.Services {
+				_, _ = fmt.Fprintf(errOut, "Warning: service %s is not started by ci exec\n", svc)
+			}
+
+			if dryRun {
+				out := f.IOStreams.Out
+				_, _ = fmt.Fprintf(out, "docker %s\n\n", strings.Join(plan.Args, " "))
+				_, _ = fmt.Fprint(out, plan.Script)
+				return nil
+			}
+
+			return runCIExec(f, plan, job.Name)
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "f", "", "CI configuration file (default: .gitlab-ci.yml at the repository root)")
+	cmd.Flags().StringVar(&ref, "ref", "", "Branch or tag to use as context for resolving includes")
+	cmd.Flags().StringArrayVarP(&env, "env", "e", nil, "Set a variable for the job (KEY=value)")
+	cmd.Flags().StringVar(&image, "image", "", "Run the job in this image instead of the configured one")
+	cmd.Flags().BoolVar(&noProjectVars, "no-project-variables", false, "Do not inject the project's CI/CD variables")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Print the docker command and job script without running them")
+
+	return cmd
+}
+
+// mergeCIConfig returns content with its includes merged in by the CI Lint
+// API.
+func mergeCIConfig(cmd *cobra.Command, client *api.Client, project, content, ref string) (string, error) {
+	opts := &gitlab.ProjectNamespaceLintOptions{Content: &content}
+	if ref != "" {
+		opts.Ref = &ref
+	}
+	result, resp, err := client.Validate.ProjectNamespaceLint(project, opts, gitlab.WithContext(cmd.Context()))
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := api.APIURL(client.Host()) + "/projects/" + project + "/ci/lint"
+		return "", errors.NewAPIError("POST", url, statusCode, "Failed to resolve CI configuration", err)
+	}
+	if !result.Valid {
+		return "", fmt.Errorf("CI configuration is invalid:\n  - %s", strings.Join(result.Errors, "\n  - "))
+	}
+	return result.MergedYaml, nil
+}
+
+// ciPredefinedVariables returns the predefined CI variables that can be
+// derived locally.
+func ciPredefinedVariables(job *ciconfig.Job, host, project string) map[string]string {
+	vars := map[string]string{
+		"CI":                "true",
+		"GITLAB_CI":         "true",
+		"CI_JOB_NAME":       job.Name,
+		"CI_JOB_STAGE":      job.Stage,
+		"CI_PROJECT_DIR":    path.Join("/builds", project),
+		"CI_PROJECT_PATH":   project,
+		"CI_PROJECT_NAME":   path.Base(project),
+		"CI_SERVER_HOST":    host,
+		"CI_SERVER_URL":     config.WebBaseURL(host),
+		"CI_REGISTRY_IMAGE": host + "/" + project,
+	}
+	if sha, err := gitutil.HeadSHA(); err == nil {
+		vars["CI_COMMIT_SHA"] = sha
+		vars["CI_COMMIT_SHORT_SHA"] = shortSHA(sha)
+	}
+	if branch, err := gitutil.CurrentBranch(); err == nil && branch != "HEAD" {
+		vars["CI_COMMIT_REF_NAME"] = branch
+		vars["CI_COMMIT_BRANCH"] = branch
+	}
+	return vars
+}
+
+// planCIExec builds the docker invocation and script for job. Variable
+// values are passed through the environment of the docker process rather
+// than its arguments so they do not show up in process listings.
+func planCIExec(job *ciconfig.Job, project, root string, vars, files map[string]string, tty bool) *ciExecPlan {
+	dir := path.Join("/builds", project)
+	args := []string{"run", "--rm", "-i"}
+	if tty {
+		args = append(args, "-t")
+	}
+	args = append(args,
+		"-v", root+":"+dir,
+		"-w", dir,
+		"-v", "<script-dir>:"+ciExecMount+":ro",
+	)
+
+	keys := make([]string, 0, len(vars))
+	for k := range vars {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		args = append(args, "-e", k)
+	}
+	args = append(args, "--entrypoint", "/bin/sh", job.Image, path.Join(ciExecMount, "job.sh"))
+
+	return &ciExecPlan{
+		Args:   args,
+		Env:    vars,
+		Script: ciExecScript(job),
+		Files:  files,
+	}
+}
+
+// ciExecScript returns a shell script running the job's scripts like a
+// runner: each command is echoed, the first failure stops before_script and
+// script, and after_script always runs without changing the exit status.
+func ciExecScript(job *ciconfig.Job) string {
+	var b strings.Builder
+	b.WriteString("#!/bin/sh\n")
+	b.WriteString("glab_step() { printf '\\033[32;1m$ %s\\033[0;m\\n' \"$1\"; }\n")
+	b.WriteString("(\nset -e\n")
+	for _, line := range append(append([]string{}, job.BeforeScript...), job.Script...) {
+		fmt.Fprintf(&b, "glab_step %s\n%s\n", shellQuote(line), line)
+	}
+	b.WriteString(")\nstatus=$?\n")
+	if len(job.AfterScript) > 0 {
+		b.WriteString("(\nset -e\n")
+		for _, line := range job.AfterScript {
+			fmt.Fprintf(&b, "glab_step %s\n%s\n", shellQuote(line), line)
+		}
+		b.WriteString(") || true\n")
+	}
+	b.WriteString("exit $status\n")
+	return b.String()
+}
+
+// shellQuote quotes s for a POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// runCIExec writes the job script and file variables to a temporary
+// directory and runs the job container.
+func runCIExec(f *cmdutil.Factory, plan *ciExecPlan, jobName string) error {
+	docker, err := exec.LookPath("docker")
+	if err != nil {
+		return fmt.Errorf("docker not found in PATH; ci exec runs jobs in Docker")
+	}
+
+	dir, err := os.MkdirTemp("", "glab-ci-exec-")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	if err := os.WriteFile(filepath.Join(dir, "job.sh"), []byte(plan.Script), 0o755); err != nil {
+		return err
+	}
+	if len(plan.Files) > 0 {
+		if err := os.Mkdir(filepath.Join(dir, "files"), 0o700); err != nil {
+			return err
+		}
+		for name, value := range plan.Files {
+			if err := os.WriteFile(filepath.Join(dir, "files", name), []byte(value), 0o600); err != nil {
+				return err
+			}
+		}
+	}
+
+	args := make([]string, len(plan.Args))
+	for i, a := range plan.Args {
+		args[i] = strings.Replace(a, "<script-dir>", dir, 1)
+	}
+
+	c := exec.Command(docker, args...)
+	c.Stdin = f.IOStreams.In
+	c.Stdout = f.IOStreams.Out
+	c.Stderr = f.IOStreams.ErrOut
+	c.Env = os.Environ()
+	for k, v := range plan.Env {
+		c.Env = append(c.Env, k+"="+v)
+	}
+
+	cs := f.IOStreams.ColorScheme()
+	if err := c.Run(); err != nil {
+		_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "%s Job %s failed\n", cs.Red("✗"), jobName)
+		return fmt.Errorf("job %s failed: %w", jobName, err)
+	}
+	_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "%s Job %s succeeded\n", cs.Green("✓"), jobName)
+	return nil
+}
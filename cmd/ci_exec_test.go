@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/ciconfig"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+const ciExecConfig = `image: golang:1.22
+variables:
+  FROM_CONFIG: config
+test:
+  stage: test
+  services: [postgres:16]
+  before_script:
+    - go version
+  script:
+    - echo "it's $FROM_CONFIG"
+  after_script:
+    - echo done
+`
+
+// setupCIExecRepo creates a git repository with a .gitlab-ci.yml, makes it
+// the working directory, and serves the CI Lint and variables APIs.
+func setupCIExecRepo(t *testing.T) {
+	t.Helper()
+	dir := t.TempDir()
+	t.Chdir(dir)
+	if out, err := exec.Command("git", "init", "-b", "main").CombinedOutput(); err != nil {
+		t.Fatalf("git init failed: %v\n%s", err, out)
+	}
+	if err := os.WriteFile(".gitlab-ci.yml", []byte(ciExecConfig), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/ci/lint":
+			cmdtest.JSONResponse(w, 200, map[string]interface{}{"valid": true, "merged_yaml": ciExecConfig})
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/test-owner/test-repo/variables":
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+				{"key": "API_TOKEN", "value": "secret", "variable_type": "env_var", "environment_scope": "*"},
+				{"key": "KUBECONFIG", "value": "apiVersion: v1", "variable_type": "file", "environment_scope": "*"},
+				{"key": "PROD_ONLY", "value": "x", "variable_type": "env_var", "environment_scope": "production"},
+			})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+}
+
+func TestCIExec_ListJobs(t *testing.T) {
+	setupCIExecRepo(t)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newCIExecCmd(f.Factory)
+	cmd.SetArgs([]string{})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out := f.IO.String(); !strings.Contains(out, "test") || !strings.Contains(out, "golang:1.22") {
+		t.Errorf("unexpected output: %s", out)
+	}
+}
+
+func TestCIExec_DryRun(t *testing.T) {
+	setupCIExecRepo(t)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newCIExecCmd(f.Factory)
+	cmd.SetArgs([]string{"test", "--dry-run", "--env", "EXTRA=1"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := f.IO.String()
+	for _, want := range []string{
+		"docker run --rm -i",
+		"-w /builds/test-owner/test-repo",
+		"-e API_TOKEN", "-e EXTRA", "-e FROM_CONFIG", "-e KUBECONFIG", "-e CI_JOB_NAME",
+		"--entrypoint /bin/sh golang:1.22 /glab-ci/job.sh",
+		"glab_step 'go version'",
+		`glab_step 'echo "it'\''s $FROM_CONFIG"'`,
+		") || true",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "secret") || strings.Contains(out, "PROD_ONLY") {
+		t.Errorf("output leaks variable values or scoped variables:\n%s", out)
+	}
+	if !strings.Contains(f.IO.ErrString(), "service postgres:16 is not started") {
+		t.Errorf("expected a warning about services, got: %s", f.IO.ErrString())
+	}
+}
+
+func TestCIExec_UnknownJob(t *testing.T) {
+	setupCIExecRepo(t)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newCIExecCmd(f.Factory)
+	cmd.SetArgs([]string{"deploy", "--dry-run"})
+
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), `job "deploy" not found`) {
+		t.Errorf("expected job not found error, got %v", err)
+	}
+}
+
+func TestPlanCIExec_Variables(t *testing.T) {
+	job := &ciconfig.Job{Name: "build", Stage: "build", Image: "alpine", Script: []string{"true"}}
+	plan := planCIExec(job, "group/app", "/src", map[string]string{"B": "2", "A": "1"}, nil, false)
+
+	if got := strings.Join(plan.Args, " "); !strings.Contains(got, "-e A -e B") || strings.Contains(got, "-t") {
+		t.Errorf("Args = %s", got)
+	}
+	if plan.Env["A"] != "1" || plan.Env["B"] != "2" {
+		t.Errorf("Env = %v", plan.Env)
+	}
+	if strings.Contains(plan.Script, "|| true") {
+		t.Errorf("script has an after_script section without after_script:\n%s", plan.Script)
+	}
+}
@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/citemplate"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	gitutil "github.com/PhilipKram/gitlab-cli/internal/git"
+	"github.com/PhilipKram/gitlab-cli/internal/prompt"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func newCIInitCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		lang     string
+		template string
+		output   string
+		force    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Create a starter .gitlab-ci.yml",
+		Long: `Write a starter .gitlab-ci.yml for the project.
+
+By default the project language (go, node, python, or docker) is detected
+from the files at the repository root and a built-in template is used; when
+run interactively you can pick another one. --template fetches one of the
+official GitLab templates by name instead, such as "Go" or "Python".
+
+An existing file is only replaced with --force or after confirmation.`,
+		Example: `  $ glab ci init
+  $ glab ci init --lang node
+  $ glab ci init --template Go
+  $ glab ci init --template Docker --output ci/docker.yml --force`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if lang != "" && template != "" {
+				return fmt.Errorf("specify only one of --lang or --template")
+			}
+
+			root := "."
+			if dir, err := gitutil.TopLevelDir(); err == nil {
+				root = dir
+			}
+			if output == "" {
+				output = filepath.Join(root, ".gitlab-ci.yml")
+			}
+
+			var content, source string
+			if template != "" {
+				client, err := f.Client()
+				if err != nil {
+					return err
+				}
+				tmpl, resp, err := client.CIYMLTemplate.GetTemplate(template, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/templates/gitlab_ci_ymls/" + template
+					return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get CI template %q", template), err)
+				}
+				content, source = tmpl.Content, fmt.Sprintf("GitLab template %q", tmpl.Name)
+			} else {
+				if lang == "" {
+					var err error
+					lang, err = chooseCILanguage(f, citemplate.Detect(root))
+					if err != nil {
+						return err
+					}
+				}
+				var err error
+				content, err = citemplate.Template(lang)
+				if err != nil {
+					return err
+				}
+				source = lang + " template"
+			}
+
+			if _, err := os.Stat(output); err == nil && !force {
+				if !f.IOStreams.CanPrompt() {
+					return fmt.Errorf("%s already exists; use --force to overwrite it", output)
+				}
+				confirmed, err := prompt.Confirm(f.IOStreams.In, f.IOStreams.ErrOut, fmt.Sprintf("%s already exists. Overwrite it?", output), false)
+				if err != nil {
+					return err
+				}
+				if !confirmed {
+					_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "Init cancelled")
+					return nil
+				}
+			}
+
+			if dir := filepath.Dir(output); dir != "." {
+				if err := os.MkdirAll(dir, 0o755); err != nil {
+					return err
+				}
+			}
+			if err := os.WriteFile(output, []byte(content), 0o644); err != nil {
+				return fmt.Errorf("writing %s: %w", output, err)
+			}
+
+			cs := f.IOStreams.ColorScheme()
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "%s Wrote %s from the %s\n", cs.Green("✓"), output, source)
+			_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "Validate it with: glab ci lint "+output)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&lang, "lang", "l", "", "Built-in template to use: go, node, python, or docker (default: detected)")
+	cmd.Flags().StringVarP(&template, "template", "t", "", "Name of an official GitLab CI template to fetch, e.g. Go")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "File to write (default: .gitlab-ci.yml at the repository root)")
+	cmd.Flags().BoolVar(&force, "force", false, "Overwrite an existing file without asking")
+
+	return cmd
+}
+
+// chooseCILanguage returns the built-in template to use. Interactively the
+// user picks from the list with the detected language first; otherwise the
+// detected language is used.
+func chooseCILanguage(f *cmdutil.Factory, detected string) (string, error) {
+	if !f.IOStreams.CanPrompt() {
+		if detected == "" {
+			return "", fmt.Errorf("could not detect the project language; use --lang or --template")
+		}
+		return detected, nil
+	}
+
+	langs := []string{}
+	options := []string{}
+	if detected != "" {
+		langs = append(langs, detected)
+		options = append(options, detected+" (detected)")
+	}
+	for _, l := range citemplate.Languages {
+		if l != detected {
+			langs = append(langs, l)
+			options = append(options, l)
+		}
+	}
+	idx, err := prompt.Select(f.IOStreams.In, f.IOStreams.ErrOut, "Which template do you want to start from?", options)
+	if err != nil {
+		return "", err
+	}
+	return langs[idx], nil
+}
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestCIInit_DetectsLanguage(t *testing.T) {
+	t.Chdir(t.TempDir())
+	if err := os.WriteFile("go.mod", []byte("module example.com/x\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newCIInitCmd(f.Factory)
+	cmd.SetArgs([]string{})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data, err := os.ReadFile(".gitlab-ci.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "image: golang") {
+		t.Errorf("expected the Go template, got:\n%s", data)
+	}
+	if !strings.Contains(f.IO.String(), "go template") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestCIInit_NoLanguageDetected(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newCIInitCmd(f.Factory)
+	cmd.SetArgs([]string{})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "could not detect") {
+		t.Errorf("expected detection error, got %v", err)
+	}
+}
+
+func TestCIInit_ExistingFile(t *testing.T) {
+	t.Chdir(t.TempDir())
+	if err := os.WriteFile(".gitlab-ci.yml", []byte("old\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newCIInitCmd(f.Factory)
+	cmd.SetArgs([]string{"--lang", "docker"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "--force") {
+		t.Fatalf("expected an error about --force, got %v", err)
+	}
+
+	cmd = newCIInitCmd(f.Factory)
+	cmd.SetArgs([]string{"--lang", "docker", "--force"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data, _ := os.ReadFile(".gitlab-ci.yml")
+	if !strings.Contains(string(data), "docker build") {
+		t.Errorf("file was not overwritten:\n%s", data)
+	}
+}
+
+func TestCIInit_OfficialTemplate(t *testing.T) {
+	t.Chdir(t.TempDir())
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == "/api/v4/templates/gitlab_ci_ymls/Go" {
+			cmdtest.JSONResponse(w, 200, map[string]interface{}{"name": "Go", "content": "image: golang:latest\n"})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newCIInitCmd(f.Factory)
+	cmd.SetArgs([]string{"--template", "Go", "--output", "ci/go.yml"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data, err := os.ReadFile("ci/go.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "image: golang:latest\n" {
+		t.Errorf("unexpected content: %q", data)
+	}
+}
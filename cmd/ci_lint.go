@@ -19,6 +19,8 @@ func newCILintCmd(f *cmdutil.Factory) *cobra.Command {
 		ref         string
 		dryRun      bool
 		includeJobs bool
+		includeYAML bool
+		file        string
 		format      string
 		jsonFlag    bool
 	)
@@ -29,13 +31,28 @@ func newCILintCmd(f *cmdutil.Factory) *cobra.Command {
 		Long: `Validate a project's CI/CD configuration.
 
 Without arguments, validates the project's committed .gitlab-ci.yml.
-With a file argument (or stdin via -), validates the provided YAML content.`,
-		Example: `  $ glab pipeline lint
-  $ glab pipeline lint --ref main --dry-run
-  $ glab pipeline lint .gitlab-ci.yml
-  $ cat .gitlab-ci.yml | glab pipeline lint -
-  $ glab pipeline lint --include-jobs`,
+With a file argument or --file (or stdin via -), the local YAML content is
+posted to the project's CI Lint API, so includes and project variables are
+resolved in the context of the project.
+
+Use --include-merged-yaml to print the fully expanded configuration with
+all includes merged in.`,
+		Example: `  $ glab ci lint
+  $ glab ci lint --ref main --dry-run
+  $ glab ci lint .gitlab-ci.yml
+  $ glab ci lint --file ci/templates/build.yml
+  $ cat .gitlab-ci.yml | glab ci lint -
+  $ glab ci lint .gitlab-ci.yml --include-merged-yaml
+  $ glab ci lint --include-jobs`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if file != "" {
+				if len(args) > 0 {
+					return fmt.Errorf("specify the file either as an argument or with --file, not both")
+				}
+				args = []string{file}
+			}
+
 			client, err := f.Client()
 			if err != nil {
 				return err
@@ -53,7 +70,7 @@ With a file argument (or stdin via -), validates the provided YAML content.`,
 				// File or stdin mode: validate provided YAML content
 				var content string
 				if args[0] == "-" {
-					data, err := io.ReadAll(os.Stdin)
+					data, err := io.ReadAll(f.IOStreams.In)
 					if err != nil {
 						return fmt.Errorf("reading stdin: %w", err)
 					}
@@ -155,6 +172,14 @@ With a file argument (or stdin via -), validates the provided YAML content.`,
 				}
 			}
 
+			if includeYAML && result.MergedYaml != "" {
+				_, _ = fmt.Fprintln(out, "\nMerged YAML:")
+				_, _ = fmt.Fprint(out, result.MergedYaml)
+				if !strings.HasSuffix(result.MergedYaml, "\n") {
+					_, _ = fmt.Fprintln(out)
+				}
+			}
+
 			if !result.Valid {
 				return fmt.Errorf("CI configuration has %d error(s)", len(result.Errors))
 			}
@@ -166,7 +191,9 @@ With a file argument (or stdin via -), validates the provided YAML content.`,
 	cmd.Flags().StringVar(&ref, "ref", "", "Branch or tag to use as context for linting")
 	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Run pipeline creation simulation")
 	cmd.Flags().BoolVar(&includeJobs, "include-jobs", false, "Include job details in the response")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().BoolVar(&includeYAML, "include-merged-yaml", false, "Print the merged configuration with all includes expanded")
+	cmd.Flags().StringVarP(&file, "file", "f", "", "Path to a local CI configuration file to validate")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
 
 	return cmd
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"encoding/json"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -22,7 +23,7 @@ func TestNewCILintCmd(t *testing.T) {
 	}
 
 	// Flags we advertise must exist.
-	for _, name := range []string{"ref", "dry-run", "include-jobs", "format", "json"} {
+	for _, name := range []string{"ref", "dry-run", "include-jobs", "include-merged-yaml", "file", "format", "json"} {
 		if cmd.Flags().Lookup(name) == nil {
 			t.Errorf("expected --%s flag", name)
 		}
@@ -115,6 +116,55 @@ func TestCILint_FileMode(t *testing.T) {
 	}
 }
 
+// TestCILint_FileFlagWithMergedYAML covers --file and printing the expanded
+// configuration returned by the API.
+func TestCILint_FileFlagWithMergedYAML(t *testing.T) {
+	tmpFile := filepath.Join(t.TempDir(), "build.yml")
+	if err := os.WriteFile(tmpFile, []byte("include: base.yml\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var content string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "/ci/lint") && r.Method == http.MethodPost {
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			content, _ = body["content"].(string)
+			cmdtest.JSONResponse(w, 200, map[string]any{
+				"valid":       true,
+				"errors":      []string{},
+				"merged_yaml": "stages:\n- build\nbuild:\n  script: make\n",
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newCILintCmd(f.Factory)
+	cmd.SetArgs([]string{"--file", tmpFile, "--include-merged-yaml"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if content != "include: base.yml\n" {
+		t.Errorf("expected file content to be posted, got %q", content)
+	}
+	out := f.IO.Out.String()
+	if !strings.Contains(out, "Merged YAML:\nstages:\n- build\n") {
+		t.Errorf("expected merged YAML in output, got: %s", out)
+	}
+}
+
+func TestCILint_FileFlagAndArgument(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newCILintCmd(f.Factory)
+	cmd.SetArgs([]string{"a.yml", "--file", "b.yml"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "not both") {
+		t.Fatalf("expected conflict error, got %v", err)
+	}
+}
+
 // TestCILint_FileNotFound covers the file-read error path.
 func TestCILint_FileNotFound(t *testing.T) {
 	f := cmdtest.NewTestFactory(t)
@@ -161,6 +161,12 @@ func TestReleaseUploadCmd_FileNotFound(t *testing.T) {
 
 func TestReleaseUploadCmd_Success(t *testing.T) {
 	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/uploads") {
+			cmdtest.JSONResponse(w, 201, map[string]interface{}{
+				"url": "/uploads/abc123/test-asset.tar.gz",
+			})
+			return
+		}
 		if r.Method == "POST" && strings.Contains(r.URL.Path, "/releases/") {
 			cmdtest.JSONResponse(w, 201, map[string]interface{}{
 				"id":               1,
@@ -772,16 +778,24 @@ func TestIssueCreate_WithDescription(t *testing.T) {
 }
 
 func TestIssueCreate_InvalidMilestone(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/milestones") {
+			cmdtest.JSONResponse(w, 200, []interface{}{})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
 	f := cmdtest.NewTestFactory(t)
 	cmd := newIssueCreateCmd(f.Factory)
-	cmd.SetArgs([]string{"--title", "Test", "--milestone", "not-a-number"})
+	cmd.SetArgs([]string{"--title", "Test", "--milestone", "no-such-milestone"})
 
 	err := cmd.Execute()
 	if err == nil {
-		t.Fatal("expected error for invalid milestone")
+		t.Fatal("expected error for unknown milestone")
 	}
-	if !strings.Contains(err.Error(), "invalid milestone") {
-		t.Errorf("expected 'invalid milestone' error, got: %v", err)
+	if !strings.Contains(err.Error(), `milestone "no-such-milestone" not found`) {
+		t.Errorf("expected milestone not found error, got: %v", err)
 	}
 }
 
@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// listAllDiscussions pages through discussions using list, which fetches a
+// single page.
+func listAllDiscussions(list func(opts gitlab.ListOptions) ([]*gitlab.Discussion, *gitlab.Response, error)) ([]*gitlab.Discussion, *gitlab.Response, error) {
+	var all []*gitlab.Discussion
+	opts := gitlab.ListOptions{PerPage: 100, Page: 1}
+	for {
+		discussions, resp, err := list(opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		all = append(all, discussions...)
+		if resp == nil || resp.NextPage == 0 {
+			return all, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// parseSince parses the --since flag: either a date in YYYY-MM-DD or RFC 3339
+// format, or a duration before now such as "36h" or "7d".
+func parseSince(s string) (time.Time, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		if n, err := strconv.Atoi(days); err == nil && n >= 0 {
+			return time.Now().AddDate(0, 0, -n), nil
+		}
+	}
+	if d, err := time.ParseDuration(s); err == nil && d >= 0 {
+		return time.Now().Add(-d), nil
+	}
+	t, err := parseDate(s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --since value %q: use a date (YYYY-MM-DD) or a duration such as 24h or 7d", s)
+	}
+	return t, nil
+}
+
+// filterComments drops system notes and, when since is set, notes created
+// before it. Threads with no notes left are removed.
+func filterComments(discussions []*gitlab.Discussion, since time.Time) []*gitlab.Discussion {
+	var filtered []*gitlab.Discussion
+	for _, d := range discussions {
+		var notes []*gitlab.Note
+		for _, n := range d.Notes {
+			if n.System {
+				continue
+			}
+			if !since.IsZero() && (n.CreatedAt == nil || n.CreatedAt.Before(since)) {
+				continue
+			}
+			notes = append(notes, n)
+		}
+		if len(notes) == 0 {
+			continue
+		}
+		filtered = append(filtered, &gitlab.Discussion{
+			ID:             d.ID,
+			IndividualNote: d.IndividualNote,
+			Notes:          notes,
+		})
+	}
+	return filtered
+}
+
+// printComments renders discussion threads below a merge request or issue
+// view. Each thread shows its author, age, resolution state, and the file
+// position of inline comments, followed by its replies.
+func printComments(f *cmdutil.Factory, discussions []*gitlab.Discussion, raw bool) {
+	out := f.IOStreams.Out
+	cs := f.IOStreams.ColorScheme()
+
+	_, _ = fmt.Fprintf(out, "\n%s\n", cs.Bold(fmt.Sprintf("Comments (%d)", len(discussions))))
+	if len(discussions) == 0 {
+		_, _ = fmt.Fprintln(out, cs.Gray("No comments"))
+		return
+	}
+
+	for _, d := range discussions {
+		first := d.Notes[0]
+		header := commentHeader(cs.Bold(first.Author.Username), first)
+		if first.Resolvable {
+			if first.Resolved {
+				header += " • " + cs.Green("resolved")
+			} else {
+				header += " • " + cs.Yellow("unresolved")
+			}
+		}
+		if pos := first.Position; pos != nil {
+			path, line := pos.NewPath, pos.NewLine
+			if path == "" {
+				path = pos.OldPath
+			}
+			if line == 0 {
+				line = pos.OldLine
+			}
+			if path != "" {
+				header += " • " + path
+				if line != 0 {
+					header += fmt.Sprintf(":%d", line)
+				}
+			}
+		}
+		_, _ = fmt.Fprintf(out, "\n%s\n", header)
+		printCommentBody(f, first.Body, raw, "  ")
+
+		for _, reply := range d.Notes[1:] {
+			_, _ = fmt.Fprintf(out, "  └─ %s\n", commentHeader(cs.Bold(reply.Author.Username), reply))
+			printCommentBody(f, reply.Body, raw, "     ")
+		}
+	}
+}
+
+func commentHeader(author string, n *gitlab.Note) string {
+	if n.CreatedAt == nil {
+		return author
+	}
+	return author + " • " + timeAgo(n.CreatedAt)
+}
+
+func printCommentBody(f *cmdutil.Factory, body string, raw bool, indent string) {
+	for _, line := range strings.Split(renderMarkdown(f, strings.TrimRight(body, "\n"), raw), "\n") {
+		if line == "" {
+			_, _ = fmt.Fprintln(f.IOStreams.Out)
+			continue
+		}
+		_, _ = fmt.Fprintf(f.IOStreams.Out, "%s%s\n", indent, line)
+	}
+}
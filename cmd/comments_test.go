@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestParseSince(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{"36h", now.Add(-36 * time.Hour)},
+		{"7d", now.AddDate(0, 0, -7)},
+		{"2024-03-01", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := parseSince(tt.in)
+		if err != nil {
+			t.Fatalf("parseSince(%q): %v", tt.in, err)
+		}
+		if d := got.Sub(tt.want); d < -time.Minute || d > time.Minute {
+			t.Errorf("parseSince(%q) = %v, want about %v", tt.in, got, tt.want)
+		}
+	}
+
+	if _, err := parseSince("yesterday"); err == nil {
+		t.Error("expected error for invalid --since value")
+	}
+}
+
+func commentsHandler(t *testing.T, itemPath string, item map[string]any) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/discussions"):
+			cmdtest.JSONResponse(w, 200, []map[string]any{
+				{"id": "d1", "notes": []map[string]any{
+					{"id": 1, "body": "Please rename this", "author": map[string]any{"username": "alice"},
+						"created_at": "2024-01-02T10:00:00Z", "resolvable": true, "resolved": false,
+						"position": map[string]any{"new_path": "main.go", "new_line": 12}},
+					{"id": 2, "body": "Done", "author": map[string]any{"username": "bob"},
+						"created_at": time.Now().Add(-2 * time.Hour).Format(time.RFC3339)},
+				}},
+				{"id": "d2", "individual_note": true, "notes": []map[string]any{
+					{"id": 3, "body": "added label", "system": true, "author": map[string]any{"username": "bot"},
+						"created_at": time.Now().Format(time.RFC3339)},
+				}},
+			})
+		case strings.HasSuffix(r.URL.Path, itemPath):
+			cmdtest.JSONResponse(w, 200, item)
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	}
+}
+
+func TestMRView_Comments(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", commentsHandler(t, "/merge_requests/5", map[string]any{
+		"id": 50, "iid": 5, "title": "Refactor", "state": "opened",
+		"author": map[string]any{"username": "carol"},
+	}))
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRViewCmd(f.Factory)
+	cmd.SetArgs([]string{"5", "--comments"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := f.IO.String()
+	for _, want := range []string{"Comments (1)", "alice • ", "unresolved • main.go:12", "  Please rename this", "  └─ bob • 2 hours ago", "     Done"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "added label") {
+		t.Errorf("system notes should be hidden, got:\n%s", out)
+	}
+}
+
+func TestMRView_CommentsSince(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", commentsHandler(t, "/merge_requests/5", map[string]any{
+		"id": 50, "iid": 5, "title": "Refactor", "state": "opened",
+		"author": map[string]any{"username": "carol"},
+	}))
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRViewCmd(f.Factory)
+	cmd.SetArgs([]string{"5", "--since", "1d"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := f.IO.String()
+	if strings.Contains(out, "Please rename this") {
+		t.Errorf("expected old note to be filtered out, got:\n%s", out)
+	}
+	if !strings.Contains(out, "bob • 2 hours ago") {
+		t.Errorf("expected recent note, got:\n%s", out)
+	}
+}
+
+func TestIssueView_CommentsJSON(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", commentsHandler(t, "/issues/7", map[string]any{
+		"id": 70, "iid": 7, "title": "Crash", "state": "opened",
+	}))
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueViewCmd(f.Factory)
+	cmd.SetArgs([]string{"7", "--comments", "--format", "json"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got struct {
+		IID         int64 `json:"iid"`
+		Discussions []struct {
+			Notes []struct {
+				Body string `json:"body"`
+			} `json:"notes"`
+		} `json:"discussions"`
+	}
+	if err := json.Unmarshal([]byte(f.IO.String()), &got); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, f.IO.String())
+	}
+	if got.IID != 7 {
+		t.Errorf("expected iid 7, got %d", got.IID)
+	}
+	if len(got.Discussions) != 1 || len(got.Discussions[0].Notes) != 2 {
+		t.Fatalf("expected one thread with two notes, got %+v", got.Discussions)
+	}
+}
@@ -0,0 +1,424 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/browser"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// NewCommitCmd creates the commit command group.
+func NewCommitCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit <command>",
+		Short: "Inspect repository commits",
+		Long:  "List, view, diff, comment on, and cherry-pick commits in a project's repository.",
+	}
+
+	cmd.AddCommand(newCommitListCmd(f))
+	cmd.AddCommand(newCommitViewCmd(f))
+	cmd.AddCommand(newCommitDiffCmd(f))
+	cmd.AddCommand(newCommitCommentCmd(f))
+	cmd.AddCommand(newCommitCherryPickCmd(f))
+
+	return cmd
+}
+
+func newCommitListCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		ref      string
+		author   string
+		path     string
+		since    string
+		until    string
+		limit    int
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List commits",
+		Aliases: []string{"ls"},
+		Example: `  $ glab commit list
+  $ glab commit list --ref develop --author alice
+  $ glab commit list --since 2024-01-01 --until 2024-02-01
+  $ glab commit list --path cmd/root.go --limit 10`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := &gitlab.ListCommitsOptions{
+				ListOptions: gitlab.ListOptions{PerPage: int64(limit)},
+			}
+			if ref != "" {
+				opts.RefName = &ref
+			}
+			if author != "" {
+				opts.Author = &author
+			}
+			if path != "" {
+				opts.Path = &path
+			}
+			if since != "" {
+				t, err := parseDate(since)
+				if err != nil {
+					return fmt.Errorf("invalid --since: %w", err)
+				}
+				opts.Since = &t
+			}
+			if until != "" {
+				t, err := parseDate(until)
+				if err != nil {
+					return fmt.Errorf("invalid --until: %w", err)
+				}
+				opts.Until = &t
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			commits, resp, err := client.Commits.ListCommits(project, opts)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + project + "/repository/commits"
+				return errors.NewAPIError("GET", url, statusCode, "Failed to list commits", err)
+			}
+
+			if len(commits) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No commits found")
+				return nil
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(commits, format, jsonFlag)
+			}
+
+			tp := f.NewTablePrinter()
+			for _, c := range commits {
+				tp.AddRow(c.ShortID, truncate(c.Title, 60), c.AuthorName, timeAgo(c.CommittedDate))
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().StringVarP(&ref, "ref", "r", "", "Branch, tag, or commit to list history from (default: default branch)")
+	cmd.Flags().StringVarP(&author, "author", "a", "", "Filter by author name or email")
+	cmd.Flags().StringVar(&path, "path", "", "Only list commits that touch this file path")
+	cmd.Flags().StringVar(&since, "since", "", "Only commits after this date (YYYY-MM-DD or RFC 3339)")
+	cmd.Flags().StringVar(&until, "until", "", "Only commits before this date (YYYY-MM-DD or RFC 3339)")
+	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+func newCommitViewCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		format   string
+		jsonFlag bool
+		web      bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "view <sha>",
+		Short: "View a commit",
+		Example: `  $ glab commit view 1a2b3c4d
+  $ glab commit view main --web`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			commit, err := getCommit(client, project, args[0])
+			if err != nil {
+				return err
+			}
+
+			if web {
+				return browser.Open(commit.WebURL)
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(commit, format, jsonFlag)
+			}
+
+			out := f.IOStreams.Out
+			_, _ = fmt.Fprintf(out, "commit %s\n", commit.ID)
+			_, _ = fmt.Fprintf(out, "Author:     %s <%s>\n", commit.AuthorName, commit.AuthorEmail)
+			if commit.AuthoredDate != nil {
+				_, _ = fmt.Fprintf(out, "Date:       %s (%s)\n", commit.AuthoredDate.Format(time.RFC1123Z), timeAgo(commit.AuthoredDate))
+			}
+			if commit.CommitterName != "" && commit.CommitterName != commit.AuthorName {
+				_, _ = fmt.Fprintf(out, "Committer:  %s <%s>\n", commit.CommitterName, commit.CommitterEmail)
+			}
+			if len(commit.ParentIDs) > 0 {
+				parents := make([]string, len(commit.ParentIDs))
+				for i, p := range commit.ParentIDs {
+					parents[i] = shortSHA(p)
+				}
+				_, _ = fmt.Fprintf(out, "Parents:    %s\n", strings.Join(parents, " "))
+			}
+			if commit.Stats != nil {
+				_, _ = fmt.Fprintf(out, "Changes:    +%d -%d\n", commit.Stats.Additions, commit.Stats.Deletions)
+			}
+			if commit.LastPipeline != nil {
+				_, _ = fmt.Fprintf(out, "Pipeline:   #%d %s\n", commit.LastPipeline.ID, statusColor(commit.LastPipeline.Status))
+			} else if commit.Status != nil {
+				_, _ = fmt.Fprintf(out, "CI status:  %s\n", statusColor(string(*commit.Status)))
+			}
+			_, _ = fmt.Fprintf(out, "URL:        %s\n", commit.WebURL)
+
+			_, _ = fmt.Fprintln(out)
+			for _, line := range strings.Split(strings.TrimRight(commit.Message, "\n"), "\n") {
+				_, _ = fmt.Fprintf(out, "    %s\n", line)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open the commit in the browser")
+
+	return cmd
+}
+
+func newCommitDiffCmd(f *cmdutil.Factory) *cobra.Command {
+	var nameOnly bool
+
+	cmd := &cobra.Command{
+		Use:   "diff <sha>",
+		Short: "View changes in a commit",
+		Example: `  $ glab commit diff 1a2b3c4d
+  $ glab commit diff 1a2b3c4d --name-only`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			sha := args[0]
+			opts := &gitlab.GetCommitDiffOptions{
+				ListOptions: gitlab.ListOptions{PerPage: 100},
+			}
+
+			out := f.IOStreams.Out
+			for {
+				diffs, resp, err := client.Commits.GetCommitDiff(project, sha, opts)
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/repository/commits/%s/diff", api.APIURL(client.Host()), project, sha)
+					return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get diff for commit %s", sha), err)
+				}
+
+				for _, diff := range diffs {
+					if nameOnly {
+						_, _ = fmt.Fprintln(out, diff.NewPath)
+						continue
+					}
+					_, _ = fmt.Fprintf(out, "--- a/%s\n+++ b/%s\n", diff.OldPath, diff.NewPath)
+					_, _ = fmt.Fprintln(out, diff.Diff)
+				}
+
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&nameOnly, "name-only", false, "Only show the names of changed files")
+
+	return cmd
+}
+
+func newCommitCommentCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		body string
+		file string
+		line int64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "comment <sha>",
+		Short: "Add a comment to a commit",
+		Long: `Add a comment to a commit.
+
+Without --file, adds a comment to the commit as a whole. With --file and
+--line, adds an inline comment on the specified line of the new version of
+the file.`,
+		Example: `  $ glab commit comment 1a2b3c4d --body "Nice cleanup"
+  $ glab commit comment 1a2b3c4d --body "Typo here" --file README.md --line 12`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().Changed("file") != cmd.Flags().Changed("line") {
+				return fmt.Errorf("--file and --line must be used together")
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			sha := args[0]
+			opts := &gitlab.PostCommitCommentOptions{
+				Note: &body,
+			}
+			if file != "" {
+				opts.Path = &file
+				opts.Line = &line
+				opts.LineType = gitlab.Ptr("new")
+			}
+
+			comment, resp, err := client.Commits.PostCommitComment(project, sha, opts)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/repository/commits/%s/comments", api.APIURL(client.Host()), project, sha)
+				return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to add comment to commit %s", sha), err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Added comment to %s\n%s\n", shortSHA(sha), comment.Note)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&body, "body", "b", "", "Comment body (required)")
+	cmd.Flags().StringVarP(&file, "file", "f", "", "File path for an inline comment")
+	cmd.Flags().Int64VarP(&line, "line", "l", 0, "Line number in the new version of the file")
+	_ = cmd.MarkFlagRequired("body")
+
+	return cmd
+}
+
+func newCommitCherryPickCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		branch  string
+		message string
+		dryRun  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "cherry-pick <sha>",
+		Short: "Cherry-pick a commit onto a branch",
+		Long: `Cherry-pick a commit onto a branch on the server, without a local clone.
+
+A merge commit is picked relative to its first parent. --dry-run only checks
+whether the commit can be picked without conflicts.`,
+		Example: `  $ glab commit cherry-pick 1a2b3c4d --branch release-1.2
+  $ glab commit cherry-pick 1a2b3c4d --branch release-1.2 --dry-run
+  $ glab commit cherry-pick 1a2b3c4d --branch stable --message "Backport login fix"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			sha := args[0]
+			opts := &gitlab.CherryPickCommitOptions{Branch: &branch}
+			if message != "" {
+				opts.Message = &message
+			}
+			if dryRun {
+				opts.DryRun = gitlab.Ptr(true)
+			}
+
+			commit, resp, err := client.Commits.CherryPickCommit(project, sha, opts, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/repository/commits/%s/cherry_pick", api.APIURL(client.Host()), project, sha)
+				return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to cherry-pick commit %s onto %s", shortSHA(sha), branch), err)
+			}
+
+			if dryRun {
+				_, _ = fmt.Fprintf(f.IOStreams.Out, "Commit %s can be cherry-picked onto %s\n", shortSHA(sha), branch)
+				return nil
+			}
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Cherry-picked %s onto %s as %s\n", shortSHA(sha), branch, commit.ShortID)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&branch, "branch", "b", "", "Branch to cherry-pick the commit onto (required)")
+	cmd.Flags().StringVarP(&message, "message", "m", "", "Commit message (default: the original message)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only check whether the commit can be cherry-picked")
+	_ = cmd.MarkFlagRequired("branch")
+
+	return cmd
+}
+
+// getCommit fetches a single commit, including its stats, by SHA or ref name.
+func getCommit(client *api.Client, project, sha string) (*gitlab.Commit, error) {
+	commit, resp, err := client.Commits.GetCommit(project, sha, &gitlab.GetCommitOptions{Stats: gitlab.Ptr(true)})
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := fmt.Sprintf("%s/projects/%s/repository/commits/%s", api.APIURL(client.Host()), project, sha)
+		return nil, errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get commit %s", sha), err)
+	}
+	return commit, nil
+}
+
+// parseDate parses a date given as YYYY-MM-DD or RFC 3339.
+func parseDate(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is not a date in YYYY-MM-DD or RFC 3339 format", s)
+	}
+	return t, nil
+}
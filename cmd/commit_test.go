@@ -0,0 +1,240 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestNewCommitCmd(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewCommitCmd(f)
+
+	if cmd.Use != "commit <command>" {
+		t.Errorf("expected Use to be 'commit <command>', got %q", cmd.Use)
+	}
+}
+
+func TestCommitCmd_HasSubcommands(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewCommitCmd(f)
+
+	expectedSubcommands := []string{"list", "view", "diff", "comment", "cherry-pick"}
+	subcommands := cmd.Commands()
+	if len(subcommands) != len(expectedSubcommands) {
+		t.Errorf("expected %d subcommands, got %d", len(expectedSubcommands), len(subcommands))
+	}
+
+	names := map[string]bool{}
+	for _, sub := range subcommands {
+		names[sub.Name()] = true
+	}
+	for _, expected := range expectedSubcommands {
+		if !names[expected] {
+			t.Errorf("expected subcommand %q not found", expected)
+		}
+	}
+}
+
+func TestCommitList_Filters(t *testing.T) {
+	var query map[string][]string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v4/projects/test-owner/test-repo/repository/commits" {
+			cmdtest.ErrorResponse(w, 404, "not found")
+			return
+		}
+		query = r.URL.Query()
+		cmdtest.JSONResponse(w, 200, []map[string]any{
+			{"id": "1a2b3c4d5e6f", "short_id": "1a2b3c4d", "title": "Fix flaky test", "author_name": "Alice"},
+		})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newCommitListCmd(f.Factory)
+	cmd.SetArgs([]string{"--ref", "develop", "--author", "alice", "--since", "2024-01-01", "--until", "2024-02-01T12:00:00Z"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]string{
+		"ref_name": "develop",
+		"author":   "alice",
+		"since":    "2024-01-01T00:00:00Z",
+		"until":    "2024-02-01T12:00:00Z",
+	}
+	for k, v := range want {
+		if got := strings.Join(query[k], ","); got != v {
+			t.Errorf("expected %s=%q, got %q", k, v, got)
+		}
+	}
+
+	out := f.IO.String()
+	if !strings.Contains(out, "1a2b3c4d") || !strings.Contains(out, "Fix flaky test") {
+		t.Errorf("unexpected output: %q", out)
+	}
+}
+
+func TestCommitList_InvalidDate(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newCommitListCmd(f.Factory)
+	cmd.SetArgs([]string{"--since", "last week"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "invalid --since") {
+		t.Errorf("expected invalid --since error, got %v", err)
+	}
+}
+
+func TestCommitView(t *testing.T) {
+	var statsParam string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v4/projects/test-owner/test-repo/repository/commits/main" {
+			cmdtest.ErrorResponse(w, 404, "not found")
+			return
+		}
+		statsParam = r.URL.Query().Get("stats")
+		cmdtest.JSONResponse(w, 200, map[string]any{
+			"id":           "1a2b3c4d5e6f7a8b",
+			"short_id":     "1a2b3c4d",
+			"title":        "Add caching",
+			"message":      "Add caching\n\nSpeeds up lookups.\n",
+			"author_name":  "Alice",
+			"author_email": "alice@example.com",
+			"parent_ids":   []string{"0f0f0f0f0f0f0f0f"},
+			"stats":        map[string]any{"additions": 12, "deletions": 3, "total": 15},
+			"last_pipeline": map[string]any{
+				"id":     300,
+				"status": "success",
+			},
+			"web_url": "https://gitlab.com/test-owner/test-repo/-/commit/1a2b3c4d5e6f7a8b",
+		})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newCommitViewCmd(f.Factory)
+	cmd.SetArgs([]string{"main"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if statsParam != "true" {
+		t.Errorf("expected stats=true, got %q", statsParam)
+	}
+	out := f.IO.String()
+	for _, want := range []string{"commit 1a2b3c4d5e6f7a8b", "Alice <alice@example.com>", "+12 -3", "#300", "success", "0f0f0f0f", "    Speeds up lookups."} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got %q", want, out)
+		}
+	}
+}
+
+func TestCommitDiff(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v4/projects/test-owner/test-repo/repository/commits/abc123/diff" {
+			cmdtest.ErrorResponse(w, 404, "not found")
+			return
+		}
+		cmdtest.JSONResponse(w, 200, []map[string]any{
+			{"old_path": "a.go", "new_path": "a.go", "diff": "@@ -1 +1 @@\n-old\n+new\n"},
+			{"old_path": "b.go", "new_path": "c.go", "diff": ""},
+		})
+	})
+
+	t.Run("full", func(t *testing.T) {
+		f := cmdtest.NewTestFactory(t)
+		cmd := newCommitDiffCmd(f.Factory)
+		cmd.SetArgs([]string{"abc123"})
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		out := f.IO.String()
+		if !strings.Contains(out, "--- a/a.go\n+++ b/a.go") || !strings.Contains(out, "+new") {
+			t.Errorf("unexpected diff output: %q", out)
+		}
+	})
+
+	t.Run("name only", func(t *testing.T) {
+		f := cmdtest.NewTestFactory(t)
+		cmd := newCommitDiffCmd(f.Factory)
+		cmd.SetArgs([]string{"abc123", "--name-only"})
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if out := f.IO.String(); out != "a.go\nc.go\n" {
+			t.Errorf("expected file names only, got %q", out)
+		}
+	})
+}
+
+func TestCommitComment(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v4/projects/test-owner/test-repo/repository/commits/abc123/comments" {
+			cmdtest.ErrorResponse(w, 404, "not found")
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		cmdtest.JSONResponse(w, 201, map[string]any{"note": body["note"]})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newCommitCommentCmd(f.Factory)
+	cmd.SetArgs([]string{"abc123", "--body", "Typo here", "--file", "README.md", "--line", "12"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["note"] != "Typo here" || body["path"] != "README.md" || body["line"] != float64(12) || body["line_type"] != "new" {
+		t.Errorf("unexpected request body: %v", body)
+	}
+	if !strings.Contains(f.IO.String(), "Added comment to abc123") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestCommitComment_FileRequiresLine(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newCommitCommentCmd(f.Factory)
+	cmd.SetArgs([]string{"abc123", "--body", "x", "--file", "README.md"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "--file and --line must be used together") {
+		t.Errorf("expected --file/--line error, got %v", err)
+	}
+}
+
+func TestCommitCherryPick(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v4/projects/test-owner/test-repo/repository/commits/abc123/cherry_pick" {
+			cmdtest.ErrorResponse(w, 404, "not found")
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		cmdtest.JSONResponse(w, 201, map[string]any{"id": "def4567890", "short_id": "def45678"})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newCommitCherryPickCmd(f.Factory)
+	cmd.SetArgs([]string{"abc123", "--branch", "release-1.2", "--message", "Backport fix"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["branch"] != "release-1.2" || body["message"] != "Backport fix" || body["dry_run"] != nil {
+		t.Errorf("unexpected request body: %v", body)
+	}
+	if !strings.Contains(f.IO.String(), "Cherry-picked abc123 onto release-1.2 as def45678") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestCommitCherryPick_RequiresBranch(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newCommitCherryPickCmd(f.Factory)
+	cmd.SetArgs([]string{"abc123"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "branch") {
+		t.Errorf("expected missing --branch error, got %v", err)
+	}
+}
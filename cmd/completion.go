@@ -13,6 +13,9 @@ func NewCompletionCmd() *cobra.Command {
 		Short: "Generate shell completion scripts",
 		Long: `Generate shell completion scripts for glab.
 
+Merge request and issue IDs, labels, milestones, and project members are
+completed with values fetched from GitLab for the current project.
+
 To load completions:
 
   Bash:
@@ -36,7 +39,7 @@ To load completions:
 		RunE: func(cmd *cobra.Command, args []string) error {
 			switch args[0] {
 			case "bash":
-				return cmd.Root().GenBashCompletion(os.Stdout)
+				return cmd.Root().GenBashCompletionV2(os.Stdout, true)
 			case "zsh":
 				return cmd.Root().GenZshCompletion(os.Stdout)
 			case "fish":
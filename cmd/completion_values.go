@@ -0,0 +1,289 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/config"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// completionCacheTTL is how long completion values fetched from GitLab are
+// reused, so that pressing TAB repeatedly does not query the API each time.
+const completionCacheTTL = 2 * time.Minute
+
+const completionCacheFile = "completion_cache.json"
+
+// completionCacheEntry holds the completion values of one kind for one
+// project.
+type completionCacheEntry struct {
+	FetchedAt time.Time `json:"fetched_at"`
+	Values    []string  `json:"values"`
+}
+
+// completionFetcher lists completion values for project, each as
+// "value\tdescription" or just "value".
+type completionFetcher func(client *api.Client, project string) ([]string, error)
+
+// registerIssuableCompletions sets up dynamic completion on the commands of
+// an mr or issue command tree: the <id> argument completes the project's
+// merge requests or issues from ids, and the label, milestone, and user
+// flags complete the project's labels, milestones, and members.
+func registerIssuableCompletions(f *cmdutil.Factory, cmd *cobra.Command, ids func(state string) completionFetcher) {
+	registerCompletions(f, cmd, cmd.Name(), ids)
+}
+
+func registerCompletions(f *cmdutil.Factory, cmd *cobra.Command, tree string, ids func(state string) completionFetcher) {
+	for _, c := range cmd.Commands() {
+		registerCompletions(f, c, tree, ids)
+	}
+	if cmd.HasSubCommands() {
+		return
+	}
+
+	if cmd.ValidArgsFunction == nil && strings.Contains(cmd.Use, "<id>") {
+		state := "opened"
+		switch cmd.Name() {
+		case "reopen":
+			state = "closed"
+		case "revert", "cherry-pick":
+			state = "merged"
+		}
+		cmd.ValidArgsFunction = completeArg(f, tree+":"+state, ids(state))
+	}
+
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		var fetch completionFetcher
+		var kind string
+		switch flag.Name {
+		case "label", "add-label", "remove-label", "unlabel":
+			fetch, kind = fetchLabels, "labels"
+		case "milestone", "set-milestone":
+			fetch, kind = fetchMilestones, "milestones"
+		case "assignee", "set-assignee", "reviewer":
+			fetch, kind = fetchMembers, "members"
+		default:
+			return
+		}
+		if _, ok := cmd.GetFlagCompletionFunc(flag.Name); ok {
+			return
+		}
+		_ = cmd.RegisterFlagCompletionFunc(flag.Name, completeFlag(f, kind, fetch, strings.HasSuffix(flag.Value.Type(), "Slice")))
+	})
+}
+
+// completeArg returns a completion function for the first argument of a
+// command.
+func completeArg(f *cmdutil.Factory, kind string, fetch completionFetcher) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			// Later arguments, such as the file of "attach", keep the
+			// shell's default completion.
+			return nil, cobra.ShellCompDirectiveDefault
+		}
+		values := completionValues(f, cmd, kind, fetch)
+		return filterCompletions(values, "", toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeFlag returns a completion function for a flag. The value of a
+// list flag may hold several comma-separated items, of which only the last
+// is completed.
+func completeFlag(f *cmdutil.Factory, kind string, fetch completionFetcher, list bool) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		prefix := ""
+		if i := strings.LastIndex(toComplete, ","); list && i >= 0 {
+			prefix, toComplete = toComplete[:i+1], toComplete[i+1:]
+		}
+		values := completionValues(f, cmd, kind, fetch)
+		return filterCompletions(values, prefix, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// filterCompletions returns the values that start with toComplete, each
+// prefixed with prefix.
+func filterCompletions(values []string, prefix, toComplete string) []string {
+	var matches []string
+	for _, v := range values {
+		if strings.HasPrefix(v, toComplete) {
+			matches = append(matches, prefix+v)
+		}
+	}
+	return matches
+}
+
+// completionValues returns the values of kind for the project the command
+// operates on, from the completion cache when it is fresh. Errors yield no
+// values: completion must never fail loudly.
+func completionValues(f *cmdutil.Factory, cmd *cobra.Command, kind string, fetch completionFetcher) []string {
+	// Persistent pre-runs are skipped while completing, so apply --repo here.
+	if repo, _ := cmd.Flags().GetString("repo"); repo != "" {
+		_ = f.SetRepoOverride(repo)
+	} else if pc, err := f.ProjectConfig(); err == nil && pc != nil && pc.Repo != "" {
+		_ = f.SetRepoOverride(pc.Repo)
+	}
+	project, err := f.FullProjectPath()
+	if err != nil {
+		return nil
+	}
+	key := f.Host() + "/" + project + " " + kind
+
+	cache := loadCompletionCache()
+	if entry, ok := cache[key]; ok && time.Since(entry.FetchedAt) < completionCacheTTL {
+		return entry.Values
+	}
+
+	client, err := f.Client()
+	if err != nil {
+		return nil
+	}
+	values, err := fetch(client, project)
+	if err != nil {
+		return nil
+	}
+
+	now := time.Now()
+	for k, entry := range cache {
+		if now.Sub(entry.FetchedAt) >= completionCacheTTL {
+			delete(cache, k)
+		}
+	}
+	cache[key] = completionCacheEntry{FetchedAt: now, Values: values}
+	saveCompletionCache(cache)
+	return values
+}
+
+func completionCachePath() string {
+	return filepath.Join(config.ConfigDir(), completionCacheFile)
+}
+
+func loadCompletionCache() map[string]completionCacheEntry {
+	cache := make(map[string]completionCacheEntry)
+	data, err := os.ReadFile(completionCachePath())
+	if err != nil {
+		return cache
+	}
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return make(map[string]completionCacheEntry)
+	}
+	return cache
+}
+
+func saveCompletionCache(cache map[string]completionCacheEntry) {
+	if err := os.MkdirAll(config.ConfigDir(), 0o755); err != nil {
+		return
+	}
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return
+	}
+	_ = os.WriteFile(completionCachePath(), data, 0o600)
+}
+
+// completionDescription joins a completion value with its description,
+// keeping the description on one line.
+func completionDescription(value, description string) string {
+	description = strings.Join(strings.Fields(description), " ")
+	if description == "" {
+		return value
+	}
+	return value + "\t" + description
+}
+
+// fetchMergeRequests returns a fetcher of the IIDs and titles of the
+// project's merge requests in state.
+func fetchMergeRequests(state string) completionFetcher {
+	return func(client *api.Client, project string) ([]string, error) {
+		orderBy := "updated_at"
+		mrs, _, err := client.MergeRequests.ListProjectMergeRequests(project, &gitlab.ListProjectMergeRequestsOptions{
+			ListOptions: gitlab.ListOptions{PerPage: 100},
+			State:       &state,
+			OrderBy:     &orderBy,
+		})
+		if err != nil {
+			return nil, err
+		}
+		values := make([]string, 0, len(mrs))
+		for _, mr := range mrs {
+			values = append(values, completionDescription(fmt.Sprint(mr.IID), mr.Title))
+		}
+		return values, nil
+	}
+}
+
+// fetchIssues returns a fetcher of the IIDs and titles of the project's
+// issues in state.
+func fetchIssues(state string) completionFetcher {
+	return func(client *api.Client, project string) ([]string, error) {
+		orderBy := "updated_at"
+		issues, _, err := client.Issues.ListProjectIssues(project, &gitlab.ListProjectIssuesOptions{
+			ListOptions: gitlab.ListOptions{PerPage: 100},
+			State:       &state,
+			OrderBy:     &orderBy,
+		})
+		if err != nil {
+			return nil, err
+		}
+		values := make([]string, 0, len(issues))
+		for _, issue := range issues {
+			values = append(values, completionDescription(fmt.Sprint(issue.IID), issue.Title))
+		}
+		return values, nil
+	}
+}
+
+// fetchLabels returns the names and descriptions of the project's labels.
+func fetchLabels(client *api.Client, project string) ([]string, error) {
+	labels, _, err := client.Labels.ListLabels(project, &gitlab.ListLabelsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100},
+	})
+	if err != nil {
+		return nil, err
+	}
+	values := make([]string, 0, len(labels))
+	for _, label := range labels {
+		values = append(values, completionDescription(label.Name, label.Description))
+	}
+	return values, nil
+}
+
+// fetchMilestones returns the titles of the project's active milestones.
+func fetchMilestones(client *api.Client, project string) ([]string, error) {
+	state := "active"
+	milestones, _, err := client.Milestones.ListMilestones(project, &gitlab.ListMilestonesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100},
+		State:       &state,
+	})
+	if err != nil {
+		return nil, err
+	}
+	values := make([]string, 0, len(milestones))
+	for _, m := range milestones {
+		values = append(values, completionDescription(m.Title, m.Description))
+	}
+	return values, nil
+}
+
+// fetchMembers returns the usernames and names of the project's members,
+// including those inherited from its groups.
+func fetchMembers(client *api.Client, project string) ([]string, error) {
+	members, _, err := client.ProjectMembers.ListAllProjectMembers(project, &gitlab.ListProjectMembersOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100},
+	})
+	if err != nil {
+		return nil, err
+	}
+	values := make([]string, 0, len(members))
+	for _, m := range members {
+		values = append(values, completionDescription(m.Username, m.Name))
+	}
+	return values, nil
+}
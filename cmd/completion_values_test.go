@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+	"github.com/spf13/cobra"
+)
+
+// runCompletion runs cobra's hidden completion command for args and returns
+// the completion lines, without the trailing directive.
+func runCompletion(t *testing.T, f *cmdtest.TestFactory, args ...string) []string {
+	t.Helper()
+	root := &cobra.Command{Use: "glab"}
+	root.PersistentFlags().StringP("repo", "R", "", "")
+	root.AddCommand(NewMRCmd(f.Factory))
+	root.AddCommand(NewIssueCmd(f.Factory))
+
+	out := &bytes.Buffer{}
+	root.SetOut(out)
+	root.SetArgs(append([]string{cobra.ShellCompRequestCmd}, args...))
+	if err := root.Execute(); err != nil {
+		t.Fatalf("completing %v: %v", args, err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	return lines[:len(lines)-1]
+}
+
+func TestCompletion_MergeRequestIDs(t *testing.T) {
+	var requests atomic.Int32
+	_ = cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/projects/test-owner/test-repo/merge_requests" {
+			requests.Add(1)
+			if got := r.URL.Query().Get("state"); got != "opened" {
+				t.Errorf("expected state=opened, got %q", got)
+			}
+			cmdtest.JSONResponse(w, 200, []map[string]any{
+				{"iid": 12, "title": "Add   login\npage"},
+				{"iid": 7, "title": "Fix typo"},
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+	f := cmdtest.NewTestFactory(t)
+
+	got := runCompletion(t, f, "mr", "merge", "1")
+	if want := []string{"12\tAdd login page"}; strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("completions = %q, want %q", got, want)
+	}
+
+	// The second completion is served from the cache.
+	got = runCompletion(t, f, "mr", "view", "")
+	if len(got) != 2 {
+		t.Errorf("expected 2 completions, got %q", got)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("expected 1 API request, got %d", n)
+	}
+
+	// Later arguments keep the default completion.
+	if got := runCompletion(t, f, "mr", "attach", "12", ""); len(got) != 0 {
+		t.Errorf("expected no completions for a second argument, got %q", got)
+	}
+}
+
+func TestCompletion_Flags(t *testing.T) {
+	_ = cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v4/projects/test-owner/test-repo/labels":
+			cmdtest.JSONResponse(w, 200, []map[string]any{
+				{"name": "bug", "description": "Something is broken"},
+				{"name": "backend"},
+				{"name": "docs"},
+			})
+		case "/api/v4/projects/test-owner/test-repo/milestones":
+			cmdtest.JSONResponse(w, 200, []map[string]any{{"title": "v1.0"}})
+		case "/api/v4/projects/test-owner/test-repo/members/all":
+			cmdtest.JSONResponse(w, 200, []map[string]any{{"username": "alice", "name": "Alice A"}})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+	f := cmdtest.NewTestFactory(t)
+
+	tests := []struct {
+		args []string
+		want []string
+	}{
+		{[]string{"mr", "create", "--label", "b"}, []string{"bug\tSomething is broken", "backend"}},
+		{[]string{"issue", "create", "--label", "docs,ba"}, []string{"docs,backend"}},
+		{[]string{"mr", "edit", "--milestone", ""}, []string{"v1.0"}},
+		{[]string{"mr", "create", "--reviewer", "a"}, []string{"alice\tAlice A"}},
+		{[]string{"issue", "list", "--assignee", "a"}, []string{"alice\tAlice A"}},
+	}
+	for _, tt := range tests {
+		got := runCompletion(t, f, tt.args...)
+		if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+			t.Errorf("completing %v = %q, want %q", tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestCompletion_UnknownProject(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	f.Remote.Owner = ""
+
+	if got := runCompletion(t, f, "mr", "merge", ""); len(got) != 0 {
+		t.Errorf("expected no completions without a project, got %q", got)
+	}
+}
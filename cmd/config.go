@@ -13,12 +13,13 @@ func NewConfigCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "config <command>",
 		Short: "Manage configuration",
-		Long:  "Get and set glab configuration options.",
+		Long:  "Get, set, list, and unset glab configuration options.",
 	}
 
 	cmd.AddCommand(newConfigGetCmd(f))
 	cmd.AddCommand(newConfigSetCmd(f))
 	cmd.AddCommand(newConfigListCmd(f))
+	cmd.AddCommand(newConfigUnsetCmd(f))
 
 	return cmd
 }
@@ -35,6 +36,7 @@ func newConfigGetCmd(f *cmdutil.Factory) *cobra.Command {
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if host != "" {
+				host, _ = config.NormalizeHost(host)
 				value, err := config.GetHostValue(host, args[0])
 				if err != nil {
 					return err
@@ -65,7 +67,9 @@ func newConfigGetCmd(f *cmdutil.Factory) *cobra.Command {
 		},
 	}
 
-	cmd.Flags().StringVar(&host, "host", "", "Get per-host configuration value")
+	cmd.Flags().StringVarP(&host, "host", "h", "", "Get per-host configuration value")
+	// -h selects the host, so help is only available as --help.
+	cmd.Flags().Bool("help", false, "Show help for command")
 
 	return cmd
 }
@@ -79,22 +83,45 @@ func newConfigSetCmd(f *cmdutil.Factory) *cobra.Command {
 		Long: `Set a configuration value.
 
 Available global keys:
-  editor       - Preferred text editor
-  pager        - Preferred pager program
-  browser      - Preferred web browser
-  protocol     - Preferred git protocol (https or ssh)
-  git_remote   - Preferred git remote name
+  editor            - Preferred text editor
+  pager             - Preferred pager program
+  browser           - Preferred web browser
+  protocol          - Preferred git protocol (https or ssh)
+  git_remote        - Preferred git remote name
+  hyperlinks        - Clickable terminal links (auto, always, or never)
+  http_concurrency  - Maximum number of API requests in flight (default 10)
+  proxy             - Proxy URL, used when HTTPS_PROXY and HTTP_PROXY are unset
+  credential_backend - Where tokens are stored: file (hosts.json) or keyring
+
+Defaults for created merge requests and issues, used when the flag is not given:
+  defaults.mr_target_branch - Target branch of "mr create"
+  defaults.mr_labels        - Comma-separated labels of "mr create"
+  defaults.mr_reviewers     - Comma-separated reviewer usernames of "mr create"
+  defaults.mr_squash        - Squash commits on merge for "mr create" (true or false)
+  defaults.issue_labels     - Comma-separated labels of "issue create"
+
+A .glab.yml at the root of a repository overrides these defaults for that
+project; "config set" always writes the global configuration.
 
 Available per-host keys (use with --host):
-  client_id    - OAuth application ID
-  protocol     - Preferred git protocol for this host
-  api_host     - API hostname override`,
+  client_id         - OAuth application ID
+  protocol          - Preferred git protocol for this host (https or ssh)
+  api_host          - API hostname override, optionally with scheme and port
+  api_protocol      - Protocol of the API and web interface (https or http)
+  ca_cert           - PEM file of additional trusted certificate authorities
+  skip_tls_verify   - Do not verify the host's TLS certificate (true or false)`,
 		Example: `  $ glab config set editor vim
   $ glab config set protocol ssh
-  $ glab config set client_id <app-id> --host gitlab.example.com`,
+  $ glab config set client_id <app-id> --host gitlab.example.com
+  $ glab config set -h gitlab.corp ca_cert /path/ca.pem
+  $ glab config set proxy http://proxy.corp:3128
+  $ glab config set credential_backend keyring
+  $ glab config set defaults.mr_target_branch develop
+  $ glab config set defaults.issue_labels triage,needs-review`,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if host != "" {
+				host, _ = config.NormalizeHost(host)
 				if err := config.SetHostValue(host, args[0], args[1]); err != nil {
 					return err
 				}
@@ -116,17 +143,29 @@ Available per-host keys (use with --host):
 		},
 	}
 
-	cmd.Flags().StringVar(&host, "host", "", "Set per-host configuration value")
+	cmd.Flags().StringVarP(&host, "host", "h", "", "Set per-host configuration value")
+	// -h selects the host, so help is only available as --help.
+	cmd.Flags().Bool("help", false, "Show help for command")
 
 	return cmd
 }
 
 func newConfigListCmd(f *cmdutil.Factory) *cobra.Command {
+	var host string
+
 	cmd := &cobra.Command{
-		Use:     "list",
-		Short:   "List configuration values",
+		Use:   "list",
+		Short: "List configuration values",
+		Long: `List the value in effect for every configuration key. Values provided by
+an environment variable, such as GLAB_PAGER or HTTPS_PROXY, are shown with
+the variable they come from, and defaults overridden by the repository's
+.glab.yml are shown as coming from it.
+
+With --host, list the per-host keys of that host instead.`,
 		Aliases: []string{"ls"},
-		Example: `  $ glab config list`,
+		Example: `  $ glab config list
+  $ glab config list --host gitlab.example.com`,
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cfg, err := f.Config()
 			if err != nil {
@@ -134,17 +173,83 @@ func newConfigListCmd(f *cmdutil.Factory) *cobra.Command {
 			}
 
 			out := f.IOStreams.Out
+			if host != "" {
+				host, _ = config.NormalizeHost(host)
+				for _, key := range config.HostKeys() {
+					value, source, err := config.EffectiveHostValue(host, key, cfg)
+					if err != nil {
+						return err
+					}
+					_, _ = fmt.Fprintln(out, configListLine(key, value, source))
+				}
+				return nil
+			}
+
 			for _, key := range config.Keys() {
-				value, _ := cfg.Get(key)
-				if value == "" {
-					value = "(not set)"
+				value, source, _ := cfg.Effective(key)
+				_, _ = fmt.Fprintln(out, configListLine(key, value, source))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&host, "host", "h", "", "List per-host configuration values")
+	// -h selects the host, so help is only available as --help.
+	cmd.Flags().Bool("help", false, "Show help for command")
+
+	return cmd
+}
+
+// configListLine formats a key and its effective value for config list.
+func configListLine(key, value, source string) string {
+	if value == "" {
+		return key + "=(not set)"
+	}
+	if source != "" {
+		return fmt.Sprintf("%s=%s (from %s)", key, value, source)
+	}
+	return key + "=" + value
+}
+
+func newConfigUnsetCmd(f *cmdutil.Factory) *cobra.Command {
+	var host string
+
+	cmd := &cobra.Command{
+		Use:   "unset <key>",
+		Short: "Remove a configuration value",
+		Long: `Remove a configuration value so the key falls back to its environment
+variable or built-in default. With --host, remove a per-host value.`,
+		Example: `  $ glab config unset editor
+  $ glab config unset defaults.mr_labels
+  $ glab config unset ca_cert --host gitlab.example.com`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if host != "" {
+				host, _ = config.NormalizeHost(host)
+				if err := config.UnsetHostValue(host, args[0]); err != nil {
+					return err
 				}
-				_, _ = fmt.Fprintf(out, "%s=%s\n", key, value)
+				_, _ = fmt.Fprintf(f.IOStreams.Out, "Unset %s for host %s\n", args[0], host)
+				return nil
 			}
 
+			cfg, err := f.Config()
+			if err != nil {
+				return err
+			}
+			if err := cfg.Unset(args[0]); err != nil {
+				return err
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Unset %s\n", args[0])
 			return nil
 		},
 	}
 
+	cmd.Flags().StringVarP(&host, "host", "h", "", "Unset per-host configuration value")
+	// -h selects the host, so help is only available as --help.
+	cmd.Flags().Bool("help", false, "Show help for command")
+
 	return cmd
 }
@@ -1,9 +1,11 @@
 package cmd
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+	"github.com/PhilipKram/gitlab-cli/internal/config"
 )
 
 func TestNewConfigCmd(t *testing.T) {
@@ -27,6 +29,7 @@ func TestConfigCmd_HasSubcommands(t *testing.T) {
 		"get",
 		"set",
 		"list",
+		"unset",
 	}
 
 	subcommands := cmd.Commands()
@@ -204,3 +207,123 @@ func TestConfigSet_Hostname(t *testing.T) {
 	err := cmd.Execute()
 	_ = err
 }
+
+func TestConfigList_EffectiveValues(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	t.Setenv("GLAB_PAGER", "less -R")
+	t.Setenv("GITLAB_HOST", "")
+	t.Setenv("GIT_EDITOR", "")
+	t.Setenv("VISUAL", "")
+	t.Setenv("EDITOR", "nano")
+	f.Config.Defaults.MRLabels = "backend,review"
+
+	cmd := newConfigListCmd(f.Factory)
+	cmd.SetArgs([]string{})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := f.IO.String()
+	for _, want := range []string{
+		"pager=less -R (from GLAB_PAGER)",
+		"editor=nano (from EDITOR)",
+		"git_remote=origin\n",
+		"browser=(not set)",
+		"defaults.mr_labels=backend,review\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestConfigList_Host(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	t.Setenv("GITLAB_HOST", "http://gitlab.local:8080")
+	f.Config.Protocol = "ssh"
+	if err := config.SetHostValue("gitlab.local:8080", "client_id", "app-id"); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := newConfigListCmd(f.Factory)
+	cmd.SetArgs([]string{"-h", "http://gitlab.local:8080"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := f.IO.String()
+	for _, want := range []string{
+		"client_id=app-id\n",
+		"api_protocol=http (from GITLAB_HOST)",
+		"protocol=ssh (from global config)",
+		"ca_cert=(not set)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestConfigUnset(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	f.Config.Editor = "vim"
+	f.Config.Protocol = "ssh"
+
+	for _, key := range []string{"editor", "protocol"} {
+		cmd := newConfigUnsetCmd(f.Factory)
+		cmd.SetArgs([]string{key})
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("unset %s: %v", key, err)
+		}
+	}
+	if f.Config.Editor != "" {
+		t.Errorf("expected editor to be unset, got %q", f.Config.Editor)
+	}
+	if f.Config.Protocol != "https" {
+		t.Errorf("expected protocol to fall back to https, got %q", f.Config.Protocol)
+	}
+
+	cmd := newConfigUnsetCmd(f.Factory)
+	cmd.SetArgs([]string{"nonexistent"})
+	if err := cmd.Execute(); err == nil {
+		t.Error("expected error for an unknown key")
+	}
+}
+
+func TestConfigUnset_Host(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	if err := config.SetHostValue("gitlab.example.com", "skip_tls_verify", "true"); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := newConfigUnsetCmd(f.Factory)
+	cmd.SetArgs([]string{"skip_tls_verify", "--host", "gitlab.example.com"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value, _ := config.GetHostValue("gitlab.example.com", "skip_tls_verify"); value != "" {
+		t.Errorf("expected skip_tls_verify to be unset, got %q", value)
+	}
+
+	cmd = newConfigUnsetCmd(f.Factory)
+	cmd.SetArgs([]string{"client_id", "--host", "gitlab.unknown.com"})
+	if err := cmd.Execute(); err == nil {
+		t.Error("expected error for a host without configuration")
+	}
+}
+
+func TestConfigSet_InvalidProtocol(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+
+	cmd := newConfigSetCmd(f.Factory)
+	cmd.SetArgs([]string{"protocol", "ftp"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "https or ssh") {
+		t.Errorf("expected an invalid protocol error, got %v", err)
+	}
+
+	cmd = newConfigSetCmd(f.Factory)
+	cmd.SetArgs([]string{"protocol", "git", "--host", "gitlab.example.com"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "https or ssh") {
+		t.Errorf("expected an invalid protocol error for the host, got %v", err)
+	}
+}
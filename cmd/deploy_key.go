@@ -0,0 +1,268 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/PhilipKram/gitlab-cli/internal/prompt"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// NewDeployKeyCmd creates the deploy-key command group.
+func NewDeployKeyCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deploy-key <command>",
+		Short: "Manage deploy keys",
+		Long:  "List, add, and remove the SSH deploy keys of a project.",
+	}
+
+	cmd.AddCommand(newDeployKeyListCmd(f))
+	cmd.AddCommand(newDeployKeyAddCmd(f))
+	cmd.AddCommand(newDeployKeyRemoveCmd(f))
+
+	return cmd
+}
+
+func newDeployKeyListCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		limit    int
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List deploy keys",
+		Aliases: []string{"ls"},
+		Example: `  $ glab deploy-key list
+  $ glab deploy-key list --format json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			keys, resp, err := client.DeployKeys.ListProjectDeployKeys(project, &gitlab.ListProjectDeployKeysOptions{
+				ListOptions: gitlab.ListOptions{PerPage: int64(limit)},
+			}, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + project + "/deploy_keys"
+				return errors.NewAPIError("GET", url, statusCode, "Failed to list deploy keys", err)
+			}
+
+			if len(keys) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No deploy keys found")
+				return nil
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(keys, format, jsonFlag)
+			}
+
+			tp := f.NewTablePrinter()
+			for _, k := range keys {
+				access := "read-only"
+				if k.CanPush {
+					access = "read-write"
+				}
+				expires := ""
+				if k.ExpiresAt != nil {
+					expires = "expires " + k.ExpiresAt.Format("2006-01-02")
+				}
+				tp.AddRow(strconv.FormatInt(k.ID, 10), k.Title, access, k.FingerprintSHA256, timeAgo(k.CreatedAt), expires)
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+func newDeployKeyAddCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		title     string
+		canPush   bool
+		expiresAt string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "add <key-file>",
+		Short: "Add a deploy key",
+		Long: `Add an SSH public key as a deploy key of the project.
+
+The key is read from the given file, or from standard input when the file is
+"-". The title defaults to the key's comment.`,
+		Example: `  $ glab deploy-key add ~/.ssh/deploy.pub
+  $ glab deploy-key add deploy.pub --title "CI runner" --can-push
+  $ cat deploy.pub | glab deploy-key add - --title staging --expires-at 2025-12-31`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := readPublicKey(f, args[0])
+			if err != nil {
+				return err
+			}
+			if title == "" {
+				title = publicKeyComment(key)
+			}
+			if title == "" {
+				return fmt.Errorf("--title required: the key has no comment to use as a title")
+			}
+
+			opts := &gitlab.AddDeployKeyOptions{
+				Key:   &key,
+				Title: &title,
+			}
+			if canPush {
+				opts.CanPush = gitlab.Ptr(true)
+			}
+			if expiresAt != "" {
+				t, err := parseDate(expiresAt)
+				if err != nil {
+					return fmt.Errorf("invalid --expires-at: %w", err)
+				}
+				opts.ExpiresAt = &t
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			deployKey, resp, err := client.DeployKeys.AddDeployKey(project, opts, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + project + "/deploy_keys"
+				return errors.NewAPIError("POST", url, statusCode, "Failed to add deploy key", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Added deploy key %d (%s) to %s\n", deployKey.ID, deployKey.Title, project)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&title, "title", "t", "", "Key title (default: the key's comment)")
+	cmd.Flags().BoolVar(&canPush, "can-push", false, "Allow the key to push to the repository")
+	cmd.Flags().StringVar(&expiresAt, "expires-at", "", "Date the key expires (YYYY-MM-DD)")
+
+	return cmd
+}
+
+func newDeployKeyRemoveCmd(f *cmdutil.Factory) *cobra.Command {
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:     "remove <id>",
+		Short:   "Remove a deploy key from the project",
+		Aliases: []string{"delete"},
+		Example: `  $ glab deploy-key remove 42
+  $ glab deploy-key remove 42 --yes`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid deploy key ID: %s", args[0])
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			if !yes {
+				confirmed, err := prompt.Confirm(f.IOStreams.In, f.IOStreams.ErrOut, fmt.Sprintf("Remove deploy key %d from %s?", id, project), false)
+				if err != nil {
+					return err
+				}
+				if !confirmed {
+					_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "Removal cancelled")
+					return nil
+				}
+			}
+
+			resp, err := client.DeployKeys.DeleteDeployKey(project, id, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/deploy_keys/%d", api.APIURL(client.Host()), project, id)
+				return errors.NewAPIError("DELETE", url, statusCode, "Failed to remove deploy key", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Removed deploy key %d from %s\n", id, project)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+// readPublicKey reads a public key from path, or from standard input
+// when path is "-".
+func readPublicKey(f *cmdutil.Factory, path string) (string, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(f.IOStreams.In)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read public key: %w", err)
+	}
+
+	key := strings.TrimSpace(string(data))
+	if key == "" {
+		return "", fmt.Errorf("public key is empty")
+	}
+	if strings.Contains(key, "PRIVATE KEY") {
+		return "", fmt.Errorf("%s looks like a private key; pass the public key instead", path)
+	}
+	return key, nil
+}
+
+// publicKeyComment returns the comment field of an OpenSSH public key, which
+// usually names the key's owner or machine.
+func publicKeyComment(key string) string {
+	fields := strings.Fields(key)
+	if len(fields) < 3 {
+		return ""
+	}
+	return strings.Join(fields[2:], " ")
+}
@@ -0,0 +1,274 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/PhilipKram/gitlab-cli/internal/prompt"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// NewDeployTokenCmd creates the deploy-token command group.
+func NewDeployTokenCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deploy-token <command>",
+		Short: "Manage deploy tokens",
+		Long: `List, create, and revoke deploy tokens of a project, or of a group with
+--group.`,
+	}
+
+	cmd.AddCommand(newDeployTokenListCmd(f))
+	cmd.AddCommand(newDeployTokenCreateCmd(f))
+	cmd.AddCommand(newDeployTokenRevokeCmd(f))
+
+	return cmd
+}
+
+func newDeployTokenListCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		group    string
+		limit    int
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List deploy tokens",
+		Aliases: []string{"ls"},
+		Example: `  $ glab deploy-token list
+  $ glab deploy-token list --group my-group --format json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			listOpts := gitlab.ListOptions{PerPage: int64(limit)}
+			var tokens []*gitlab.DeployToken
+			var resp *gitlab.Response
+			var url string
+			if group != "" {
+				url = api.APIURL(client.Host()) + "/groups/" + group + "/deploy_tokens"
+				tokens, resp, err = client.DeployTokens.ListGroupDeployTokens(group, &gitlab.ListGroupDeployTokensOptions{ListOptions: listOpts}, gitlab.WithContext(cmd.Context()))
+			} else {
+				project, perr := f.FullProjectPath()
+				if perr != nil {
+					return perr
+				}
+				url = api.APIURL(client.Host()) + "/projects/" + project + "/deploy_tokens"
+				tokens, resp, err = client.DeployTokens.ListProjectDeployTokens(project, &gitlab.ListProjectDeployTokensOptions{ListOptions: listOpts}, gitlab.WithContext(cmd.Context()))
+			}
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				return errors.NewAPIError("GET", url, statusCode, "Failed to list deploy tokens", err)
+			}
+
+			if len(tokens) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No deploy tokens found")
+				return nil
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(tokens, format, jsonFlag)
+			}
+
+			tp := f.NewTablePrinter()
+			for _, t := range tokens {
+				status := "active"
+				switch {
+				case t.Revoked:
+					status = "revoked"
+				case t.Expired:
+					status = "expired"
+				}
+				expires := "never expires"
+				if t.ExpiresAt != nil {
+					expires = "expires " + t.ExpiresAt.Format("2006-01-02")
+				}
+				tp.AddRow(strconv.FormatInt(t.ID, 10), t.Name, t.Username, strings.Join(t.Scopes, ","), status, expires)
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().StringVarP(&group, "group", "g", "", "List the deploy tokens of a group")
+	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+func newDeployTokenCreateCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		group     string
+		scopes    []string
+		username  string
+		expiresAt string
+		format    string
+		jsonFlag  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a deploy token",
+		Long: `Create a deploy token with the given scopes.
+
+The token value is only shown once. Use --format json to capture it in
+scripts.
+
+Scopes: read_repository, read_registry, write_registry,
+read_package_registry, write_package_registry.`,
+		Example: `  $ glab deploy-token create ci-pull --scopes read_repository,read_registry
+  $ glab deploy-token create k8s --scopes read_registry --expires-at 2025-12-31 --username k8s-puller
+  $ glab deploy-token create packages --scopes read_package_registry --group my-group --format json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			opts := &gitlab.CreateProjectDeployTokenOptions{
+				Name:   &name,
+				Scopes: &scopes,
+			}
+			if username != "" {
+				opts.Username = &username
+			}
+			if expiresAt != "" {
+				t, err := parseDate(expiresAt)
+				if err != nil {
+					return fmt.Errorf("invalid --expires-at: %w", err)
+				}
+				opts.ExpiresAt = &t
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			var token *gitlab.DeployToken
+			var resp *gitlab.Response
+			var url, owner string
+			if group != "" {
+				owner = group
+				url = api.APIURL(client.Host()) + "/groups/" + group + "/deploy_tokens"
+				token, resp, err = client.DeployTokens.CreateGroupDeployToken(group, (*gitlab.CreateGroupDeployTokenOptions)(opts), gitlab.WithContext(cmd.Context()))
+			} else {
+				owner, err = f.FullProjectPath()
+				if err != nil {
+					return err
+				}
+				url = api.APIURL(client.Host()) + "/projects/" + owner + "/deploy_tokens"
+				token, resp, err = client.DeployTokens.CreateProjectDeployToken(owner, opts, gitlab.WithContext(cmd.Context()))
+			}
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				return errors.NewAPIError("POST", url, statusCode, "Failed to create deploy token", err)
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(token, format, jsonFlag)
+			}
+
+			out := f.IOStreams.Out
+			_, _ = fmt.Fprintf(out, "Created deploy token %d (%s) for %s\n", token.ID, token.Name, owner)
+			_, _ = fmt.Fprintf(out, "Username: %s\n", token.Username)
+			_, _ = fmt.Fprintf(out, "Token:    %s\n", token.Token)
+			_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "Store the token now; it will not be shown again.")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&group, "group", "g", "", "Create a group deploy token")
+	cmd.Flags().StringSliceVarP(&scopes, "scopes", "s", nil, "Token scopes (required)")
+	cmd.Flags().StringVarP(&username, "username", "u", "", "Username for the token (default: generated by GitLab)")
+	cmd.Flags().StringVar(&expiresAt, "expires-at", "", "Date the token expires (YYYY-MM-DD)")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	_ = cmd.MarkFlagRequired("scopes")
+
+	return cmd
+}
+
+func newDeployTokenRevokeCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		group string
+		yes   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "revoke <id>",
+		Short:   "Revoke a deploy token",
+		Aliases: []string{"delete"},
+		Example: `  $ glab deploy-token revoke 17
+  $ glab deploy-token revoke 17 --group my-group --yes`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid deploy token ID: %s", args[0])
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			owner := group
+			if owner == "" {
+				owner, err = f.FullProjectPath()
+				if err != nil {
+					return err
+				}
+			}
+
+			if !yes {
+				confirmed, err := prompt.Confirm(f.IOStreams.In, f.IOStreams.ErrOut, fmt.Sprintf("Revoke deploy token %d of %s?", id, owner), false)
+				if err != nil {
+					return err
+				}
+				if !confirmed {
+					_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "Revocation cancelled")
+					return nil
+				}
+			}
+
+			var resp *gitlab.Response
+			var url string
+			if group != "" {
+				url = fmt.Sprintf("%s/groups/%s/deploy_tokens/%d", api.APIURL(client.Host()), group, id)
+				resp, err = client.DeployTokens.DeleteGroupDeployToken(group, id, gitlab.WithContext(cmd.Context()))
+			} else {
+				url = fmt.Sprintf("%s/projects/%s/deploy_tokens/%d", api.APIURL(client.Host()), owner, id)
+				resp, err = client.DeployTokens.DeleteProjectDeployToken(owner, id, gitlab.WithContext(cmd.Context()))
+			}
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				return errors.NewAPIError("DELETE", url, statusCode, "Failed to revoke deploy token", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Revoked deploy token %d\n", id)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&group, "group", "g", "", "Revoke a group deploy token")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestDeployTokenCmd_HasSubcommands(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewDeployTokenCmd(f)
+
+	expectedSubcommands := []string{
+		"list",
+		"create",
+		"revoke",
+	}
+
+	subcommands := cmd.Commands()
+	if len(subcommands) != len(expectedSubcommands) {
+		t.Errorf("expected %d subcommands, got %d", len(expectedSubcommands), len(subcommands))
+	}
+
+	foundSubcommands := make(map[string]bool)
+	for _, subcmd := range subcommands {
+		foundSubcommands[subcmd.Name()] = true
+	}
+
+	for _, expected := range expectedSubcommands {
+		if !foundSubcommands[expected] {
+			t.Errorf("expected subcommand %q not found", expected)
+		}
+	}
+}
+
+func TestDeployTokenList_Group(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/groups/my-group/deploy_tokens" {
+			cmdtest.JSONResponse(w, 200, []any{
+				map[string]any{
+					"id": 17, "name": "registry-pull", "username": "gitlab+deploy-token-17",
+					"scopes": []string{"read_registry", "read_repository"}, "expired": true,
+				},
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newDeployTokenListCmd(f.Factory)
+	cmd.SetArgs([]string{"--group", "my-group"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := f.IO.String()
+	for _, want := range []string{"17", "registry-pull", "gitlab+deploy-token-17", "read_registry,read_repository", "expired"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got %q", want, out)
+		}
+	}
+}
+
+func TestDeployTokenCreate(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/deploy_tokens" {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 201, map[string]any{
+				"id": 18, "name": body["name"], "username": "puller", "token": "gldt-secret",
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newDeployTokenCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"ci-pull", "--scopes", "read_repository,read_registry", "--username", "puller"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	scopes, _ := body["scopes"].([]any)
+	if body["name"] != "ci-pull" || body["username"] != "puller" || len(scopes) != 2 {
+		t.Errorf("unexpected request body: %v", body)
+	}
+	if !strings.Contains(f.IO.String(), "Token:    gldt-secret") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+	if !strings.Contains(f.IO.ErrString(), "will not be shown again") {
+		t.Errorf("unexpected stderr: %q", f.IO.ErrString())
+	}
+}
+
+func TestDeployTokenCreate_RequiresScopes(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newDeployTokenCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"ci-pull"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "scopes") {
+		t.Errorf("expected missing scopes error, got %v", err)
+	}
+}
+
+func TestDeployTokenRevoke_Group(t *testing.T) {
+	revoked := false
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete && r.URL.Path == "/api/v4/groups/my-group/deploy_tokens/17" {
+			revoked = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newDeployTokenRevokeCmd(f.Factory)
+	cmd.SetArgs([]string{"17", "--group", "my-group", "--yes"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !revoked {
+		t.Error("expected the deploy token to be revoked")
+	}
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/PhilipKram/gitlab-cli/internal/api"
 	"github.com/PhilipKram/gitlab-cli/internal/browser"
@@ -23,6 +24,9 @@ func NewDeploymentCmd(f *cmdutil.Factory) *cobra.Command {
 
 	cmd.AddCommand(newDeploymentListCmd(f))
 	cmd.AddCommand(newDeploymentViewCmd(f))
+	cmd.AddCommand(newDeploymentCreateCmd(f))
+	cmd.AddCommand(newDeploymentApprovalCmd(f, gitlab.DeploymentApprovalStatusApproved))
+	cmd.AddCommand(newDeploymentApprovalCmd(f, gitlab.DeploymentApprovalStatusRejected))
 
 	return cmd
 }
@@ -63,7 +67,7 @@ func newDeploymentListCmd(f *cmdutil.Factory) *cobra.Command {
 				if remote != nil {
 					host = remote.Host
 				}
-				return browser.Open(fmt.Sprintf("https://%s/%s/-/deployments", host, project))
+				return browser.Open(api.WebURL(host, project+"/-/deployments"))
 			}
 
 			opts := &gitlab.ListProjectDeploymentsOptions{
@@ -107,7 +111,7 @@ func newDeploymentListCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.Flags().StringVar(&orderBy, "order-by", "", "Order by: id, iid, created_at, updated_at, ref")
 	cmd.Flags().StringVar(&sort, "sort", "", "Sort order: asc or desc")
 	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
 	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open in browser")
 
@@ -156,7 +160,7 @@ func newDeploymentViewCmd(f *cmdutil.Factory) *cobra.Command {
 				if remote != nil {
 					host = remote.Host
 				}
-				return browser.Open(fmt.Sprintf("https://%s/%s/-/deployments/%d", host, project, deploymentID))
+				return browser.Open(api.WebURL(host, fmt.Sprintf("%s/-/deployments/%d", project, deploymentID)))
 			}
 
 			// Backward compatibility: --json flag sets format to json
@@ -173,12 +177,175 @@ func newDeploymentViewCmd(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open in browser")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
 
 	return cmd
 }
 
+func newDeploymentCreateCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		environment string
+		ref         string
+		sha         string
+		tag         bool
+		status      string
+		format      string
+		jsonFlag    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Record a deployment",
+		Long: `Record a deployment of a ref to an environment.
+
+This is useful for deployments performed outside of GitLab CI/CD. The
+environment is created if it does not exist yet. When --sha is omitted, the
+commit the ref currently points to is used.`,
+		Example: `  $ glab deployment create --environment production --ref main
+  $ glab deployment create -e staging --ref v1.2.0 --tag --status running
+  $ glab deployment create -e review/feature --ref feature --sha 1a2b3c4d`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch gitlab.DeploymentStatusValue(status) {
+			case gitlab.DeploymentStatusCreated, gitlab.DeploymentStatusRunning, gitlab.DeploymentStatusSuccess,
+				gitlab.DeploymentStatusFailed, gitlab.DeploymentStatusCanceled:
+			default:
+				return fmt.Errorf("invalid status %q: must be created, running, success, failed, or canceled", status)
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			if sha == "" {
+				commit, resp, err := client.Commits.GetCommit(project, ref, nil)
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/projects/" + project + "/repository/commits/" + ref
+					return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to resolve ref %s", ref), err)
+				}
+				sha = commit.ID
+			}
+
+			opts := &gitlab.CreateProjectDeploymentOptions{
+				Environment: &environment,
+				Ref:         &ref,
+				SHA:         &sha,
+				Tag:         &tag,
+				Status:      gitlab.Ptr(gitlab.DeploymentStatusValue(status)),
+			}
+
+			deployment, resp, err := client.Deployments.CreateProjectDeployment(project, opts)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + project + "/deployments"
+				return errors.NewAPIError("POST", url, statusCode, "Failed to create deployment", err)
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(deployment, format, jsonFlag)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Created deployment #%d of %s (%s) to %s with status %s\n",
+				deployment.ID, ref, shortSHA(sha), environment, deployment.Status)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&environment, "environment", "e", "", "Environment name (required)")
+	cmd.Flags().StringVarP(&ref, "ref", "r", "", "Branch or tag that was deployed (required)")
+	cmd.Flags().StringVar(&sha, "sha", "", "Commit SHA that was deployed (default: the commit of --ref)")
+	cmd.Flags().BoolVar(&tag, "tag", false, "Treat --ref as a tag")
+	cmd.Flags().StringVar(&status, "status", "success", "Deployment status: created, running, success, failed, or canceled")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	_ = cmd.MarkFlagRequired("environment")
+	_ = cmd.MarkFlagRequired("ref")
+
+	return cmd
+}
+
+// newDeploymentApprovalCmd creates the approve or reject command for
+// deployments blocked on a protected environment.
+func newDeploymentApprovalCmd(f *cmdutil.Factory, status gitlab.DeploymentApprovalStatus) *cobra.Command {
+	var (
+		comment       string
+		representedAs string
+	)
+
+	use, short, done := "approve", "Approve a blocked deployment", "Approved"
+	if status == gitlab.DeploymentApprovalStatusRejected {
+		use, short, done = "reject", "Reject a blocked deployment", "Rejected"
+	}
+
+	cmd := &cobra.Command{
+		Use:   use + " <id>",
+		Short: short,
+		Long: fmt.Sprintf(`%s a deployment waiting for approval on a protected environment.
+
+Use --represented-as when you belong to several approval rules to choose
+which one the decision counts towards.`, strings.ToUpper(use[:1])+use[1:]),
+		Example: fmt.Sprintf(`  $ glab deployment %[1]s 456
+  $ glab deployment %[1]s 456 --comment "Checked the release notes"
+  $ glab deployment %[1]s 456 --represented-as "Release managers"`, use),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			deploymentID, err := parseDeploymentID(args)
+			if err != nil {
+				return err
+			}
+
+			opts := &gitlab.ApproveOrRejectProjectDeploymentOptions{Status: &status}
+			if comment != "" {
+				opts.Comment = &comment
+			}
+			if representedAs != "" {
+				opts.RepresentedAs = &representedAs
+			}
+
+			resp, err := client.Deployments.ApproveOrRejectProjectDeployment(project, deploymentID, opts)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + project + "/deployments/" + strconv.FormatInt(deploymentID, 10) + "/approval"
+				return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to %s deployment", use), err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "%s deployment #%d\n", done, deploymentID)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&comment, "comment", "c", "", "Comment explaining the decision")
+	cmd.Flags().StringVar(&representedAs, "represented-as", "", "Name of the approval rule or group to act for")
+
+	return cmd
+}
+
 // parseDeploymentID parses a deployment ID from command arguments.
 func parseDeploymentID(args []string) (int64, error) {
 	if len(args) == 0 {
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"encoding/json"
 	"net/http"
 	"strings"
 	"testing"
@@ -38,6 +39,9 @@ func TestDeploymentCmd_HasSubcommands(t *testing.T) {
 	expectedSubcommands := []string{
 		"list",
 		"view",
+		"create",
+		"approve",
+		"reject",
 	}
 
 	subcommands := cmd.Commands()
@@ -390,3 +394,108 @@ func TestDeploymentView_JSONFormat(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestDeploymentApprove_WithComment(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/deployments/456/approval") {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 201, map[string]any{"status": "approved"})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewDeploymentCmd(f.Factory)
+	cmd.SetArgs([]string{"approve", "456", "--comment", "ship it"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["status"] != "approved" || body["comment"] != "ship it" {
+		t.Errorf("unexpected request body: %v", body)
+	}
+	if !strings.Contains(f.IO.String(), "Approved deployment #456") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestDeploymentReject(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/deployments/456/approval") {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 201, map[string]any{"status": "rejected"})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewDeploymentCmd(f.Factory)
+	cmd.SetArgs([]string{"reject", "456"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["status"] != "rejected" {
+		t.Errorf("unexpected request body: %v", body)
+	}
+	if !strings.Contains(f.IO.String(), "Rejected deployment #456") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestDeploymentApprove_Forbidden(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		cmdtest.ErrorResponse(w, 403, "403 Forbidden")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewDeploymentCmd(f.Factory)
+	cmd.SetArgs([]string{"approve", "456"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "Failed to approve deployment") {
+		t.Fatalf("expected approval error, got %v", err)
+	}
+}
+
+func TestDeploymentCreate_ResolvesSHA(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/repository/commits/main"):
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": "abc123def4567890"})
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/deployments"):
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": 789, "status": body["status"]})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewDeploymentCmd(f.Factory)
+	cmd.SetArgs([]string{"create", "--environment", "production", "--ref", "main"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["sha"] != "abc123def4567890" || body["environment"] != "production" || body["status"] != "success" {
+		t.Errorf("unexpected request body: %v", body)
+	}
+	if !strings.Contains(f.IO.String(), "Created deployment #789 of main (abc123de) to production") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestDeploymentCreate_InvalidStatus(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewDeploymentCmd(f.Factory)
+	cmd.SetArgs([]string{"create", "-e", "production", "-r", "main", "--status", "done"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), `invalid status "done"`) {
+		t.Fatalf("expected invalid status error, got %v", err)
+	}
+}
@@ -62,7 +62,7 @@ func newEnvironmentListCmd(f *cmdutil.Factory) *cobra.Command {
 				if remote != nil {
 					host = remote.Host
 				}
-				return browser.Open(fmt.Sprintf("https://%s/%s/-/environments", host, project))
+				return browser.Open(api.WebURL(host, project+"/-/environments"))
 			}
 
 			opts := &gitlab.ListEnvironmentsOptions{
@@ -94,7 +94,7 @@ func newEnvironmentListCmd(f *cmdutil.Factory) *cobra.Command {
 
 	cmd.Flags().StringVar(&state, "state", "", "Filter by state: available or stopped")
 	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
 	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open in browser")
 
@@ -143,7 +143,7 @@ func newEnvironmentViewCmd(f *cmdutil.Factory) *cobra.Command {
 				if remote != nil {
 					host = remote.Host
 				}
-				return browser.Open(fmt.Sprintf("https://%s/%s/-/environments/%d", host, project, environmentID))
+				return browser.Open(api.WebURL(host, fmt.Sprintf("%s/-/environments/%d", project, environmentID)))
 			}
 
 			// Backward compatibility: --json flag sets format to json
@@ -160,7 +160,7 @@ func newEnvironmentViewCmd(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open in browser")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
 
 	return cmd
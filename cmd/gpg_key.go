@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/PhilipKram/gitlab-cli/internal/prompt"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// NewGPGKeyCmd creates the gpg-key command group.
+func NewGPGKeyCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gpg-key <command>",
+		Short: "Manage GPG keys",
+		Long:  "List, add, and delete the GPG keys of the authenticated user.",
+	}
+
+	cmd.AddCommand(newGPGKeyListCmd(f))
+	cmd.AddCommand(newGPGKeyAddCmd(f))
+	cmd.AddCommand(newGPGKeyDeleteCmd(f))
+
+	return cmd
+}
+
+func newGPGKeyListCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List your GPG keys",
+		Aliases: []string{"ls"},
+		Example: `  $ glab gpg-key list
+  $ glab gpg-key list --format json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			keys, resp, err := client.Users.ListGPGKeys(gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/user/gpg_keys"
+				return errors.NewAPIError("GET", url, statusCode, "Failed to list GPG keys", err)
+			}
+
+			if len(keys) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No GPG keys found")
+				return nil
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(keys, format, jsonFlag)
+			}
+
+			tp := f.NewTablePrinter()
+			for _, k := range keys {
+				tp.AddRow(strconv.FormatInt(k.ID, 10), timeAgo(k.CreatedAt))
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+func newGPGKeyAddCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <key-file>",
+		Short: "Add a GPG key to your account",
+		Long: `Add an ASCII-armored GPG public key to the authenticated user's account.
+
+The key is read from the given file, or from standard input when the file is
+"-".`,
+		Example: `  $ gpg --armor --export you@example.com | glab gpg-key add -
+  $ glab gpg-key add public.asc`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := readPublicKey(f, args[0])
+			if err != nil {
+				return err
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			gpgKey, resp, err := client.Users.AddGPGKey(&gitlab.AddGPGKeyOptions{Key: &key}, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/user/gpg_keys"
+				return errors.NewAPIError("POST", url, statusCode, "Failed to add GPG key", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Added GPG key %d\n", gpgKey.ID)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newGPGKeyDeleteCmd(f *cmdutil.Factory) *cobra.Command {
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:     "delete <id>",
+		Short:   "Delete a GPG key from your account",
+		Aliases: []string{"remove"},
+		Example: `  $ glab gpg-key delete 7
+  $ glab gpg-key delete 7 --yes`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid GPG key ID: %s", args[0])
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			if !yes {
+				confirmed, err := prompt.Confirm(f.IOStreams.In, f.IOStreams.ErrOut, fmt.Sprintf("Delete GPG key %d?", id), false)
+				if err != nil {
+					return err
+				}
+				if !confirmed {
+					_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "Deletion cancelled")
+					return nil
+				}
+			}
+
+			resp, err := client.Users.DeleteGPGKey(id, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/user/gpg_keys/%d", api.APIURL(client.Host()), id)
+				return errors.NewAPIError("DELETE", url, statusCode, "Failed to delete GPG key", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Deleted GPG key %d\n", id)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
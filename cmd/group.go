@@ -0,0 +1,490 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/browser"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// NewGroupCmd creates the group command group.
+func NewGroupCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group <command>",
+		Short: "Manage groups",
+		Long:  "List, view, and create GitLab groups and manage their members.",
+	}
+
+	cmd.AddCommand(newGroupListCmd(f))
+	cmd.AddCommand(newGroupViewCmd(f))
+	cmd.AddCommand(newGroupCreateCmd(f))
+	cmd.AddCommand(newGroupSubgroupsCmd(f))
+	cmd.AddCommand(newGroupMembersCmd(f))
+	cmd.AddCommand(newGroupAddMemberCmd(f))
+	cmd.AddCommand(newGroupRemoveMemberCmd(f))
+
+	return cmd
+}
+
+func newGroupListCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		search   string
+		owned    bool
+		topLevel bool
+		limit    int
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List groups",
+		Aliases: []string{"ls"},
+		Example: `  $ glab group list
+  $ glab group list --owned --top-level
+  $ glab group list --search platform --format json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			opts := &gitlab.ListGroupsOptions{
+				ListOptions: gitlab.ListOptions{PerPage: int64(limit)},
+			}
+			if search != "" {
+				opts.Search = &search
+			}
+			if owned {
+				opts.Owned = gitlab.Ptr(true)
+			}
+			if topLevel {
+				opts.TopLevelOnly = gitlab.Ptr(true)
+			}
+
+			groups, resp, err := client.Groups.ListGroups(opts, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/groups"
+				return errors.NewAPIError("GET", url, statusCode, "Failed to list groups", err)
+			}
+
+			return printGroups(f, groups, format, jsonFlag)
+		},
+	}
+
+	cmd.Flags().StringVar(&search, "search", "", "Search groups by name or path")
+	cmd.Flags().BoolVar(&owned, "owned", false, "Only list groups you own")
+	cmd.Flags().BoolVar(&topLevel, "top-level", false, "Only list top-level groups")
+	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+func newGroupViewCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		web      bool
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "view <group>",
+		Short: "View group details",
+		Example: `  $ glab group view my-group
+  $ glab group view my-group/platform --web`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			groupPath := args[0]
+			group, resp, err := client.Groups.GetGroup(groupPath, &gitlab.GetGroupOptions{WithProjects: gitlab.Ptr(false)}, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/groups/" + groupPath
+				return errors.NewAPIError("GET", url, statusCode, "Failed to get group", err)
+			}
+
+			if web {
+				return browser.Open(group.WebURL)
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(group, format, jsonFlag)
+			}
+
+			out := f.IOStreams.Out
+			_, _ = fmt.Fprintf(out, "%s\n", group.FullPath)
+			if group.Description != "" {
+				_, _ = fmt.Fprintf(out, "\n%s\n\n", group.Description)
+			}
+			_, _ = fmt.Fprintf(out, "ID:          %d\n", group.ID)
+			_, _ = fmt.Fprintf(out, "Name:        %s\n", group.FullName)
+			_, _ = fmt.Fprintf(out, "Visibility:  %s\n", group.Visibility)
+			if group.ParentID != 0 {
+				_, _ = fmt.Fprintf(out, "Parent ID:   %d\n", group.ParentID)
+			}
+			if group.CreatedAt != nil {
+				_, _ = fmt.Fprintf(out, "Created:     %s\n", timeAgo(group.CreatedAt))
+			}
+			_, _ = fmt.Fprintf(out, "URL:         %s\n", group.WebURL)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open the group in the browser")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+func newGroupCreateCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		name        string
+		parent      string
+		description string
+		visibility  string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create <path>",
+		Short: "Create a group",
+		Long: `Create a group with the given path.
+
+Use --parent to create a subgroup. The group name defaults to the path.`,
+		Example: `  $ glab group create platform --name "Platform Team"
+  $ glab group create tools --parent my-group --visibility internal`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vis := gitlab.VisibilityValue(visibility)
+			switch vis {
+			case gitlab.PrivateVisibility, gitlab.InternalVisibility, gitlab.PublicVisibility:
+			default:
+				return fmt.Errorf("invalid visibility %q: must be private, internal, or public", visibility)
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			path := args[0]
+			if name == "" {
+				name = path
+			}
+			opts := &gitlab.CreateGroupOptions{
+				Name:       &name,
+				Path:       &path,
+				Visibility: &vis,
+			}
+			if description != "" {
+				opts.Description = &description
+			}
+
+			if parent != "" {
+				parentGroup, resp, err := client.Groups.GetGroup(parent, &gitlab.GetGroupOptions{WithProjects: gitlab.Ptr(false)}, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/groups/" + parent
+					return errors.NewAPIError("GET", url, statusCode, "Failed to get parent group", err)
+				}
+				opts.ParentID = &parentGroup.ID
+			}
+
+			group, resp, err := client.Groups.CreateGroup(opts, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/groups"
+				return errors.NewAPIError("POST", url, statusCode, "Failed to create group", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Created group %s\n", group.FullPath)
+			_, _ = fmt.Fprintln(f.IOStreams.Out, group.WebURL)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Group name (default: the path)")
+	cmd.Flags().StringVarP(&parent, "parent", "p", "", "Parent group to create a subgroup in")
+	cmd.Flags().StringVarP(&description, "description", "d", "", "Group description")
+	cmd.Flags().StringVar(&visibility, "visibility", "private", "Visibility: private, internal, public")
+
+	return cmd
+}
+
+func newGroupSubgroupsCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		all      bool
+		limit    int
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "subgroups <group>",
+		Short: "List the subgroups of a group",
+		Example: `  $ glab group subgroups my-group
+  $ glab group subgroups my-group --all`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			groupPath := args[0]
+			listOpts := gitlab.ListGroupsOptions{
+				ListOptions: gitlab.ListOptions{PerPage: int64(limit)},
+			}
+
+			var groups []*gitlab.Group
+			var resp *gitlab.Response
+			endpoint := "/subgroups"
+			if all {
+				endpoint = "/descendant_groups"
+				opts := gitlab.ListDescendantGroupsOptions(listOpts)
+				groups, resp, err = client.Groups.ListDescendantGroups(groupPath, &opts, gitlab.WithContext(cmd.Context()))
+			} else {
+				opts := gitlab.ListSubGroupsOptions(listOpts)
+				groups, resp, err = client.Groups.ListSubGroups(groupPath, &opts, gitlab.WithContext(cmd.Context()))
+			}
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/groups/" + groupPath + endpoint
+				return errors.NewAPIError("GET", url, statusCode, "Failed to list subgroups", err)
+			}
+
+			return printGroups(f, groups, format, jsonFlag)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&all, "all", "a", false, "Include subgroups at every level")
+	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+func newGroupMembersCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		inherited bool
+		query     string
+		limit     int
+		format    string
+		jsonFlag  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "members <group>",
+		Short: "List group members",
+		Example: `  $ glab group members my-group
+  $ glab group members my-group/platform --inherited
+  $ glab group members my-group --query alice`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			groupPath := args[0]
+			opts := &gitlab.ListGroupMembersOptions{
+				ListOptions: gitlab.ListOptions{PerPage: int64(limit)},
+			}
+			if query != "" {
+				opts.Query = &query
+			}
+
+			var members []*gitlab.GroupMember
+			var resp *gitlab.Response
+			endpoint := "/members"
+			if inherited {
+				endpoint = "/members/all"
+				members, resp, err = client.Groups.ListAllGroupMembers(groupPath, opts, gitlab.WithContext(cmd.Context()))
+			} else {
+				members, resp, err = client.Groups.ListGroupMembers(groupPath, opts, gitlab.WithContext(cmd.Context()))
+			}
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/groups/" + groupPath + endpoint
+				return errors.NewAPIError("GET", url, statusCode, "Failed to list group members", err)
+			}
+
+			if len(members) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No members found")
+				return nil
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(members, format, jsonFlag)
+			}
+
+			tp := f.NewTablePrinter()
+			for _, m := range members {
+				expires := ""
+				if m.ExpiresAt != nil {
+					expires = "expires " + m.ExpiresAt.String()
+				}
+				tp.AddRow(m.Username, m.Name, accessLevelName(m.AccessLevel), expires)
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().BoolVar(&inherited, "inherited", false, "Include members inherited from parent groups")
+	cmd.Flags().StringVarP(&query, "query", "q", "", "Filter members by name or username")
+	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+func newGroupAddMemberCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		accessLevel string
+		expiresAt   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "add-member <group> <username>...",
+		Short: "Add members to a group",
+		Example: `  $ glab group add-member my-group alice --access-level developer
+  $ glab group add-member my-group bob carol --access-level reporter --expires-at 2025-12-31`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			level, err := parseMemberAccessLevel(accessLevel)
+			if err != nil {
+				return err
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			groupPath := args[0]
+			usernames := args[1:]
+			ids, err := resolveUserIDs(client, usernames)
+			if err != nil {
+				return err
+			}
+
+			for i, id := range ids {
+				opts := &gitlab.AddGroupMemberOptions{
+					UserID:      gitlab.Ptr(id),
+					AccessLevel: &level,
+				}
+				if expiresAt != "" {
+					opts.ExpiresAt = &expiresAt
+				}
+				_, resp, err := client.GroupMembers.AddGroupMember(groupPath, opts, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/groups/" + groupPath + "/members"
+					return errors.NewAPIError("POST", url, statusCode, "Failed to add "+usernames[i]+" to group", err)
+				}
+				_, _ = fmt.Fprintf(f.IOStreams.Out, "Added %s to %s as %s\n", strings.TrimPrefix(usernames[i], "@"), groupPath, accessLevelName(level))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&accessLevel, "access-level", "a", "", "Access level: "+strings.Join(memberAccessLevels, ", ")+" (required)")
+	cmd.Flags().StringVar(&expiresAt, "expires-at", "", "Date the membership expires (YYYY-MM-DD)")
+	_ = cmd.MarkFlagRequired("access-level")
+
+	return cmd
+}
+
+func newGroupRemoveMemberCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-member <group> <username>...",
+		Short: "Remove members from a group",
+		Example: `  $ glab group remove-member my-group alice
+  $ glab group remove-member my-group bob carol`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			groupPath := args[0]
+			usernames := args[1:]
+			ids, err := resolveUserIDs(client, usernames)
+			if err != nil {
+				return err
+			}
+
+			for i, id := range ids {
+				resp, err := client.GroupMembers.RemoveGroupMember(groupPath, id, nil, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/groups/%s/members/%d", api.APIURL(client.Host()), groupPath, id)
+					return errors.NewAPIError("DELETE", url, statusCode, "Failed to remove "+usernames[i]+" from group", err)
+				}
+				_, _ = fmt.Fprintf(f.IOStreams.Out, "Removed %s from %s\n", strings.TrimPrefix(usernames[i], "@"), groupPath)
+			}
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// printGroups renders a list of groups as a table or in the requested format.
+func printGroups(f *cmdutil.Factory, groups []*gitlab.Group, format string, jsonFlag bool) error {
+	if len(groups) == 0 {
+		_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No groups found")
+		return nil
+	}
+
+	if jsonFlag || (format != "" && format != "table") {
+		return f.FormatAndPrint(groups, format, jsonFlag)
+	}
+
+	tp := f.NewTablePrinter()
+	for _, g := range groups {
+		tp.AddRow(g.FullPath, g.Name, string(g.Visibility), truncate(g.Description, 50))
+	}
+	return tp.Render()
+}
@@ -0,0 +1,291 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestNewGroupCmd(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewGroupCmd(f)
+
+	if cmd.Use != "group <command>" {
+		t.Errorf("expected Use to be 'group <command>', got %q", cmd.Use)
+	}
+
+	if cmd.Short != "Manage groups" {
+		t.Errorf("expected Short to be 'Manage groups', got %q", cmd.Short)
+	}
+}
+
+func TestGroupCmd_HasSubcommands(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewGroupCmd(f)
+
+	expectedSubcommands := []string{
+		"list",
+		"view",
+		"create",
+		"subgroups",
+		"members",
+		"add-member",
+		"remove-member",
+	}
+
+	subcommands := cmd.Commands()
+	if len(subcommands) != len(expectedSubcommands) {
+		t.Errorf("expected %d subcommands, got %d", len(expectedSubcommands), len(subcommands))
+	}
+
+	foundSubcommands := make(map[string]bool)
+	for _, subcmd := range subcommands {
+		foundSubcommands[subcmd.Name()] = true
+	}
+
+	for _, expected := range expectedSubcommands {
+		if !foundSubcommands[expected] {
+			t.Errorf("expected subcommand %q not found", expected)
+		}
+	}
+}
+
+func TestGroupList(t *testing.T) {
+	var query string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/groups" {
+			query = r.URL.RawQuery
+			cmdtest.JSONResponse(w, 200, []any{
+				map[string]any{"id": 1, "name": "Platform", "full_path": "acme/platform", "visibility": "private"},
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newGroupListCmd(f.Factory)
+	cmd.SetArgs([]string{"--owned", "--search", "plat"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(query, "owned=true") || !strings.Contains(query, "search=plat") {
+		t.Errorf("unexpected query: %q", query)
+	}
+	if !strings.Contains(f.IO.String(), "acme/platform") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestGroupView(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/groups/acme/platform" {
+			cmdtest.JSONResponse(w, 200, map[string]any{
+				"id": 7, "name": "Platform", "full_name": "Acme / Platform", "full_path": "acme/platform",
+				"visibility": "internal", "parent_id": 1, "web_url": "https://gitlab.com/groups/acme/platform",
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newGroupViewCmd(f.Factory)
+	cmd.SetArgs([]string{"acme/platform"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := f.IO.String()
+	for _, want := range []string{"acme/platform", "ID:          7", "Visibility:  internal", "Parent ID:   1"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got %q", want, out)
+		}
+	}
+}
+
+func TestGroupCreate_Subgroup(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/groups/acme":
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 1, "full_path": "acme"})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v4/groups":
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": 9, "full_path": "acme/tools", "web_url": "https://gitlab.com/groups/acme/tools"})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newGroupCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"tools", "--parent", "acme", "--visibility", "internal"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["path"] != "tools" || body["name"] != "tools" || body["visibility"] != "internal" || body["parent_id"] != float64(1) {
+		t.Errorf("unexpected request body: %v", body)
+	}
+	if !strings.Contains(f.IO.String(), "Created group acme/tools") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestGroupCreate_InvalidVisibility(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newGroupCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"tools", "--visibility", "secret"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "invalid visibility") {
+		t.Errorf("expected visibility error, got %v", err)
+	}
+}
+
+func TestGroupSubgroups_All(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/groups/acme/descendant_groups" {
+			cmdtest.JSONResponse(w, 200, []any{
+				map[string]any{"id": 2, "full_path": "acme/platform"},
+				map[string]any{"id": 3, "full_path": "acme/platform/ci"},
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newGroupSubgroupsCmd(f.Factory)
+	cmd.SetArgs([]string{"acme", "--all"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(f.IO.String(), "acme/platform/ci") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestGroupMembers(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/groups/acme/members/all" {
+			cmdtest.JSONResponse(w, 200, []any{
+				map[string]any{"id": 1, "username": "alice", "name": "Alice", "access_level": 50},
+				map[string]any{"id": 2, "username": "bob", "name": "Bob", "access_level": 30, "expires_at": "2025-12-31"},
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newGroupMembersCmd(f.Factory)
+	cmd.SetArgs([]string{"acme", "--inherited"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := f.IO.String()
+	if !strings.Contains(out, "Owner") || !strings.Contains(out, "expires 2025-12-31") {
+		t.Errorf("unexpected output: %q", out)
+	}
+}
+
+func TestGroupAddMember(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v4/users" && r.URL.Query().Get("username") == "alice":
+			cmdtest.JSONResponse(w, 200, []any{map[string]any{"id": 42, "username": "alice"}})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v4/groups/acme/members":
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": 42, "username": "alice", "access_level": 30})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newGroupAddMemberCmd(f.Factory)
+	cmd.SetArgs([]string{"acme", "@alice", "--access-level", "developer", "--expires-at", "2025-12-31"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["user_id"] != float64(42) || body["access_level"] != float64(30) || body["expires_at"] != "2025-12-31" {
+		t.Errorf("unexpected request body: %v", body)
+	}
+	if !strings.Contains(f.IO.String(), "Added alice to acme as Developer") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestGroupAddMember_InvalidAccessLevel(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newGroupAddMemberCmd(f.Factory)
+	cmd.SetArgs([]string{"acme", "alice", "--access-level", "superuser"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "unknown access level") {
+		t.Errorf("expected access level error, got %v", err)
+	}
+}
+
+func TestGroupRemoveMember(t *testing.T) {
+	removed := false
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v4/users":
+			cmdtest.JSONResponse(w, 200, []any{map[string]any{"id": 42, "username": "alice"}})
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v4/groups/acme/members/42":
+			removed = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newGroupRemoveMemberCmd(f.Factory)
+	cmd.SetArgs([]string{"acme", "alice"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !removed {
+		t.Error("expected the member to be removed")
+	}
+}
+
+func TestParseMemberAccessLevel(t *testing.T) {
+	tests := []struct {
+		input string
+		want  gitlab.AccessLevelValue
+	}{
+		{"guest", gitlab.GuestPermissions},
+		{"Reporter", gitlab.ReporterPermissions},
+		{"developer", gitlab.DeveloperPermissions},
+		{"40", gitlab.MaintainerPermissions},
+		{"owner", gitlab.OwnerPermissions},
+		{"minimal", gitlab.MinimalAccessPermissions},
+	}
+	for _, tt := range tests {
+		got, err := parseMemberAccessLevel(tt.input)
+		if err != nil {
+			t.Errorf("parseMemberAccessLevel(%q) returned error: %v", tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseMemberAccessLevel(%q) = %d, want %d", tt.input, got, tt.want)
+		}
+	}
+
+	if _, err := parseMemberAccessLevel("60"); err == nil {
+		t.Error("expected error for admin level")
+	}
+}
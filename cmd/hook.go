@@ -0,0 +1,579 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/PhilipKram/gitlab-cli/internal/prompt"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// hookEvent describes a webhook trigger that can be toggled from the CLI.
+type hookEvent struct {
+	name string
+	set  func(opts *gitlab.AddProjectHookOptions, enabled bool)
+	get  func(hook *gitlab.ProjectHook) bool
+}
+
+// hookEvents lists the webhook triggers in the order GitLab shows them.
+var hookEvents = []hookEvent{
+	{"push", func(o *gitlab.AddProjectHookOptions, v bool) { o.PushEvents = &v }, func(h *gitlab.ProjectHook) bool { return h.PushEvents }},
+	{"tag_push", func(o *gitlab.AddProjectHookOptions, v bool) { o.TagPushEvents = &v }, func(h *gitlab.ProjectHook) bool { return h.TagPushEvents }},
+	{"note", func(o *gitlab.AddProjectHookOptions, v bool) { o.NoteEvents = &v }, func(h *gitlab.ProjectHook) bool { return h.NoteEvents }},
+	{"confidential_note", func(o *gitlab.AddProjectHookOptions, v bool) { o.ConfidentialNoteEvents = &v }, func(h *gitlab.ProjectHook) bool { return h.ConfidentialNoteEvents }},
+	{"issues", func(o *gitlab.AddProjectHookOptions, v bool) { o.IssuesEvents = &v }, func(h *gitlab.ProjectHook) bool { return h.IssuesEvents }},
+	{"confidential_issues", func(o *gitlab.AddProjectHookOptions, v bool) { o.ConfidentialIssuesEvents = &v }, func(h *gitlab.ProjectHook) bool { return h.ConfidentialIssuesEvents }},
+	{"merge_requests", func(o *gitlab.AddProjectHookOptions, v bool) { o.MergeRequestsEvents = &v }, func(h *gitlab.ProjectHook) bool { return h.MergeRequestsEvents }},
+	{"job", func(o *gitlab.AddProjectHookOptions, v bool) { o.JobEvents = &v }, func(h *gitlab.ProjectHook) bool { return h.JobEvents }},
+	{"pipeline", func(o *gitlab.AddProjectHookOptions, v bool) { o.PipelineEvents = &v }, func(h *gitlab.ProjectHook) bool { return h.PipelineEvents }},
+	{"wiki_page", func(o *gitlab.AddProjectHookOptions, v bool) { o.WikiPageEvents = &v }, func(h *gitlab.ProjectHook) bool { return h.WikiPageEvents }},
+	{"deployment", func(o *gitlab.AddProjectHookOptions, v bool) { o.DeploymentEvents = &v }, func(h *gitlab.ProjectHook) bool { return h.DeploymentEvents }},
+	{"releases", func(o *gitlab.AddProjectHookOptions, v bool) { o.ReleasesEvents = &v }, func(h *gitlab.ProjectHook) bool { return h.ReleasesEvents }},
+	{"emoji", func(o *gitlab.AddProjectHookOptions, v bool) { o.EmojiEvents = &v }, func(h *gitlab.ProjectHook) bool { return h.EmojiEvents }},
+	{"resource_access_token", func(o *gitlab.AddProjectHookOptions, v bool) { o.ResourceAccessTokenEvents = &v }, func(h *gitlab.ProjectHook) bool { return h.ResourceAccessTokenEvents }},
+}
+
+// hookDelivery is a single entry of a webhook's delivery log. The client
+// library does not cover this endpoint yet.
+type hookDelivery struct {
+	ID                int64          `json:"id"`
+	URL               string         `json:"url"`
+	Trigger           string         `json:"trigger"`
+	RequestHeaders    map[string]any `json:"request_headers"`
+	RequestData       any            `json:"request_data"`
+	ResponseHeaders   map[string]any `json:"response_headers"`
+	ResponseBody      string         `json:"response_body"`
+	ExecutionDuration float64        `json:"execution_duration"`
+	ResponseStatus    any            `json:"response_status"`
+}
+
+// NewHookCmd creates the hook command group.
+func NewHookCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hook <command>",
+		Short: "Manage project webhooks",
+		Long: `List, create, edit, delete, and test project webhooks, and inspect their
+recent deliveries.
+
+Event triggers are named after the GitLab API without the "_events" suffix:
+` + strings.Join(hookEventNames(), ", ") + ".",
+		Aliases: []string{"webhook"},
+	}
+
+	cmd.AddCommand(newHookListCmd(f))
+	cmd.AddCommand(newHookCreateCmd(f))
+	cmd.AddCommand(newHookEditCmd(f))
+	cmd.AddCommand(newHookDeleteCmd(f))
+	cmd.AddCommand(newHookTestCmd(f))
+	cmd.AddCommand(newHookDeliveriesCmd(f))
+
+	return cmd
+}
+
+func newHookListCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List project webhooks",
+		Aliases: []string{"ls"},
+		Example: `  $ glab hook list
+  $ glab hook list --format json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			hooks, resp, err := client.Projects.ListProjectHooks(project, &gitlab.ListProjectHooksOptions{
+				ListOptions: gitlab.ListOptions{PerPage: 100},
+			}, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + project + "/hooks"
+				return errors.NewAPIError("GET", url, statusCode, "Failed to list webhooks", err)
+			}
+
+			if len(hooks) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No webhooks found")
+				return nil
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(hooks, format, jsonFlag)
+			}
+
+			tp := f.NewTablePrinter()
+			for _, h := range hooks {
+				ssl := "ssl"
+				if !h.EnableSSLVerification {
+					ssl = "no ssl"
+				}
+				tp.AddRow(strconv.FormatInt(h.ID, 10), h.URL, strings.Join(enabledHookEvents(h), ","), ssl, h.AlertStatus)
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+func newHookCreateCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		name         string
+		description  string
+		events       []string
+		token        string
+		branchFilter string
+		sslVerify    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create <url>",
+		Short: "Create a project webhook",
+		Long: `Create a webhook that sends the selected events to the given URL.
+
+Only the events listed in --events are enabled.`,
+		Example: `  $ glab hook create https://ci.example.com/hook --events push,merge_requests
+  $ glab hook create https://example.com/deploy --events pipeline,deployment --token "$HOOK_SECRET"
+  $ glab hook create https://localhost:8443/hook --events push --branch-filter main --ssl-verification=false`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := &gitlab.AddProjectHookOptions{
+				URL:                   &args[0],
+				EnableSSLVerification: &sslVerify,
+			}
+			enabled, err := parseHookEvents(events)
+			if err != nil {
+				return err
+			}
+			for _, e := range hookEvents {
+				e.set(opts, enabled[e.name])
+			}
+			if name != "" {
+				opts.Name = &name
+			}
+			if description != "" {
+				opts.Description = &description
+			}
+			if token != "" {
+				opts.Token = &token
+			}
+			if branchFilter != "" {
+				opts.PushEventsBranchFilter = &branchFilter
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			hook, resp, err := client.Projects.AddProjectHook(project, opts, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + project + "/hooks"
+				return errors.NewAPIError("POST", url, statusCode, "Failed to create webhook", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Created webhook %d for %s\n", hook.ID, hook.URL)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Webhook name")
+	cmd.Flags().StringVarP(&description, "description", "d", "", "Webhook description")
+	cmd.Flags().StringSliceVarP(&events, "events", "e", []string{"push"}, "Events that trigger the webhook")
+	cmd.Flags().StringVarP(&token, "token", "t", "", "Secret token sent in the X-Gitlab-Token header")
+	cmd.Flags().StringVar(&branchFilter, "branch-filter", "", "Only trigger push events for matching branches")
+	cmd.Flags().BoolVar(&sslVerify, "ssl-verification", true, "Verify the SSL certificate of the webhook URL")
+
+	return cmd
+}
+
+func newHookEditCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		url           string
+		name          string
+		description   string
+		enableEvents  []string
+		disableEvents []string
+		token         string
+		branchFilter  string
+		sslVerify     bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "edit <id>",
+		Short: "Edit a project webhook",
+		Long: `Change a webhook's URL, settings, or event triggers.
+
+Only the given settings are changed. Use --enable-events and --disable-events
+to toggle individual triggers while leaving the others as they are.`,
+		Example: `  $ glab hook edit 12 --enable-events pipeline,job --disable-events push
+  $ glab hook edit 12 --url https://ci.example.com/v2/hook
+  $ glab hook edit 12 --ssl-verification=false`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid webhook ID: %s", args[0])
+			}
+
+			enable, err := parseHookEvents(enableEvents)
+			if err != nil {
+				return err
+			}
+			disable, err := parseHookEvents(disableEvents)
+			if err != nil {
+				return err
+			}
+			for e := range enable {
+				if disable[e] {
+					return fmt.Errorf("event %q cannot be both enabled and disabled", e)
+				}
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			// The edit endpoint requires the URL, so start from the current hook.
+			hook, resp, err := client.Projects.GetProjectHook(project, id, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				apiURL := fmt.Sprintf("%s/projects/%s/hooks/%d", api.APIURL(client.Host()), project, id)
+				return errors.NewAPIError("GET", apiURL, statusCode, "Failed to get webhook", err)
+			}
+
+			opts := &gitlab.AddProjectHookOptions{URL: &hook.URL}
+			if url != "" {
+				opts.URL = &url
+			}
+			for _, e := range hookEvents {
+				switch {
+				case enable[e.name]:
+					e.set(opts, true)
+				case disable[e.name]:
+					e.set(opts, false)
+				}
+			}
+			if cmd.Flags().Changed("name") {
+				opts.Name = &name
+			}
+			if cmd.Flags().Changed("description") {
+				opts.Description = &description
+			}
+			if cmd.Flags().Changed("token") {
+				opts.Token = &token
+			}
+			if cmd.Flags().Changed("branch-filter") {
+				opts.PushEventsBranchFilter = &branchFilter
+			}
+			if cmd.Flags().Changed("ssl-verification") {
+				opts.EnableSSLVerification = &sslVerify
+			}
+
+			editOpts := gitlab.EditProjectHookOptions(*opts)
+			hook, resp, err = client.Projects.EditProjectHook(project, id, &editOpts, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				apiURL := fmt.Sprintf("%s/projects/%s/hooks/%d", api.APIURL(client.Host()), project, id)
+				return errors.NewAPIError("PUT", apiURL, statusCode, "Failed to edit webhook", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Updated webhook %d for %s\n", hook.ID, hook.URL)
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Events: %s\n", strings.Join(enabledHookEvents(hook), ", "))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&url, "url", "", "New webhook URL")
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Webhook name")
+	cmd.Flags().StringVarP(&description, "description", "d", "", "Webhook description")
+	cmd.Flags().StringSliceVar(&enableEvents, "enable-events", nil, "Events to start triggering the webhook")
+	cmd.Flags().StringSliceVar(&disableEvents, "disable-events", nil, "Events to stop triggering the webhook")
+	cmd.Flags().StringVarP(&token, "token", "t", "", "Secret token sent in the X-Gitlab-Token header")
+	cmd.Flags().StringVar(&branchFilter, "branch-filter", "", "Only trigger push events for matching branches")
+	cmd.Flags().BoolVar(&sslVerify, "ssl-verification", true, "Verify the SSL certificate of the webhook URL")
+
+	return cmd
+}
+
+func newHookDeleteCmd(f *cmdutil.Factory) *cobra.Command {
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Delete a project webhook",
+		Example: `  $ glab hook delete 12
+  $ glab hook delete 12 --yes`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid webhook ID: %s", args[0])
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			if !yes {
+				confirmed, err := prompt.Confirm(f.IOStreams.In, f.IOStreams.ErrOut, fmt.Sprintf("Delete webhook %d?", id), false)
+				if err != nil {
+					return err
+				}
+				if !confirmed {
+					_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "Deletion cancelled")
+					return nil
+				}
+			}
+
+			resp, err := client.Projects.DeleteProjectHook(project, id, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/hooks/%d", api.APIURL(client.Host()), project, id)
+				return errors.NewAPIError("DELETE", url, statusCode, "Failed to delete webhook", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Deleted webhook %d\n", id)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func newHookTestCmd(f *cmdutil.Factory) *cobra.Command {
+	var event string
+
+	cmd := &cobra.Command{
+		Use:   "test <id>",
+		Short: "Send a test event to a project webhook",
+		Long: `Trigger a test delivery of a webhook with sample data for an event.
+
+GitLab rate-limits this endpoint to a few requests per minute.`,
+		Example: `  $ glab hook test 12
+  $ glab hook test 12 --event merge_requests`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid webhook ID: %s", args[0])
+			}
+			if _, err := parseHookEvents([]string{event}); err != nil {
+				return err
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			trigger := gitlab.ProjectHookEvent(event + "_events")
+			resp, err := client.Projects.TriggerTestProjectHook(project, id, trigger, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/hooks/%d/test/%s", api.APIURL(client.Host()), project, id, trigger)
+				return errors.NewAPIError("POST", url, statusCode, "Failed to test webhook", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Sent test %s event to webhook %d\n", event, id)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&event, "event", "e", "push", "Event to send sample data for")
+
+	return cmd
+}
+
+func newHookDeliveriesCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		status   string
+		limit    int
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "deliveries <id>",
+		Short: "Show recent deliveries of a project webhook",
+		Long: `Show the webhook's delivery log from the last seven days.
+
+Requires GitLab 17.3 or later.`,
+		Example: `  $ glab hook deliveries 12
+  $ glab hook deliveries 12 --status server_failure
+  $ glab hook deliveries 12 --format json`,
+		Aliases: []string{"events"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid webhook ID: %s", args[0])
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			opts := struct {
+				gitlab.ListOptions
+				Status *string `url:"status,omitempty"`
+			}{ListOptions: gitlab.ListOptions{PerPage: int64(limit)}}
+			if status != "" {
+				opts.Status = &status
+			}
+
+			path := fmt.Sprintf("projects/%s/hooks/%d/events", gitlab.PathEscape(project), id)
+			req, err := client.NewRequest(http.MethodGet, path, &opts, []gitlab.RequestOptionFunc{gitlab.WithContext(cmd.Context())})
+			if err != nil {
+				return err
+			}
+			var deliveries []*hookDelivery
+			resp, err := client.Do(req, &deliveries)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/hooks/%d/events", api.APIURL(client.Host()), project, id)
+				return errors.NewAPIError("GET", url, statusCode, "Failed to list webhook deliveries", err)
+			}
+
+			if len(deliveries) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No deliveries in the last seven days")
+				return nil
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(deliveries, format, jsonFlag)
+			}
+
+			tp := f.NewTablePrinter()
+			for _, d := range deliveries {
+				tp.AddRow(
+					strconv.FormatInt(d.ID, 10),
+					fmt.Sprintf("%v", d.ResponseStatus),
+					strings.TrimSuffix(d.Trigger, "_hooks"),
+					fmt.Sprintf("%.2fs", d.ExecutionDuration),
+					truncate(d.ResponseBody, 50),
+				)
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().StringVarP(&status, "status", "s", "", "Filter by response: successful, client_failure, server_failure, or an HTTP status code")
+	cmd.Flags().IntVarP(&limit, "limit", "L", 20, "Maximum number of results")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+func hookEventNames() []string {
+	names := make([]string, len(hookEvents))
+	for i, e := range hookEvents {
+		names[i] = e.name
+	}
+	return names
+}
+
+// parseHookEvents validates event names, accepting them with or without the
+// "_events" suffix, and returns the set of names given.
+func parseHookEvents(names []string) (map[string]bool, error) {
+	set := make(map[string]bool, len(names))
+	for _, n := range names {
+		n = strings.TrimSuffix(strings.TrimSpace(n), "_events")
+		found := false
+		for _, e := range hookEvents {
+			if e.name == n {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown webhook event %q: must be one of %s", n, strings.Join(hookEventNames(), ", "))
+		}
+		set[n] = true
+	}
+	return set, nil
+}
+
+// enabledHookEvents returns the names of the events that trigger hook.
+func enabledHookEvents(hook *gitlab.ProjectHook) []string {
+	var names []string
+	for _, e := range hookEvents {
+		if e.get(hook) {
+			names = append(names, e.name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,246 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestNewHookCmd(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewHookCmd(f)
+
+	if cmd.Use != "hook <command>" {
+		t.Errorf("expected Use to be 'hook <command>', got %q", cmd.Use)
+	}
+
+	if cmd.Short != "Manage project webhooks" {
+		t.Errorf("expected Short to be 'Manage project webhooks', got %q", cmd.Short)
+	}
+}
+
+func TestHookCmd_HasSubcommands(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewHookCmd(f)
+
+	expectedSubcommands := []string{
+		"list",
+		"create",
+		"edit",
+		"delete",
+		"test",
+		"deliveries",
+	}
+
+	subcommands := cmd.Commands()
+	if len(subcommands) != len(expectedSubcommands) {
+		t.Errorf("expected %d subcommands, got %d", len(expectedSubcommands), len(subcommands))
+	}
+
+	foundSubcommands := make(map[string]bool)
+	for _, subcmd := range subcommands {
+		foundSubcommands[subcmd.Name()] = true
+	}
+
+	for _, expected := range expectedSubcommands {
+		if !foundSubcommands[expected] {
+			t.Errorf("expected subcommand %q not found", expected)
+		}
+	}
+}
+
+func TestHookList(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/projects/test-owner/test-repo/hooks" {
+			cmdtest.JSONResponse(w, 200, []any{
+				map[string]any{
+					"id": 12, "url": "https://ci.example.com/hook", "push_events": true,
+					"merge_requests_events": true, "enable_ssl_verification": false, "alert_status": "executable",
+				},
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newHookListCmd(f.Factory)
+	cmd.SetArgs([]string{})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := f.IO.String()
+	for _, want := range []string{"12", "https://ci.example.com/hook", "push,merge_requests", "no ssl", "executable"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got %q", want, out)
+		}
+	}
+}
+
+func TestHookCreate(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/hooks" {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": 7, "url": body["url"]})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newHookCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"https://example.com/hook", "--events", "merge_requests,pipeline_events", "--token", "s3cret"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["merge_requests_events"] != true || body["pipeline_events"] != true || body["push_events"] != false {
+		t.Errorf("unexpected events: %v", body)
+	}
+	if body["token"] != "s3cret" || body["enable_ssl_verification"] != true {
+		t.Errorf("unexpected request body: %v", body)
+	}
+	if !strings.Contains(f.IO.String(), "Created webhook 7") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestHookCreate_UnknownEvent(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newHookCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"https://example.com/hook", "--events", "commits"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), `unknown webhook event "commits"`) {
+		t.Errorf("expected unknown event error, got %v", err)
+	}
+}
+
+func TestHookEdit_ToggleEvents(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/test-owner/test-repo/hooks/12":
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 12, "url": "https://ci.example.com/hook", "push_events": true, "issues_events": true})
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v4/projects/test-owner/test-repo/hooks/12":
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 12, "url": body["url"], "pipeline_events": true, "issues_events": true})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newHookEditCmd(f.Factory)
+	cmd.SetArgs([]string{"12", "--enable-events", "pipeline", "--disable-events", "push", "--ssl-verification=false"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["url"] != "https://ci.example.com/hook" {
+		t.Errorf("expected the current URL to be sent, got %v", body["url"])
+	}
+	if body["pipeline_events"] != true || body["push_events"] != false || body["enable_ssl_verification"] != false {
+		t.Errorf("unexpected request body: %v", body)
+	}
+	if _, ok := body["issues_events"]; ok {
+		t.Errorf("expected untouched events to be omitted, got %v", body)
+	}
+	if !strings.Contains(f.IO.String(), "Events: issues, pipeline") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestHookEdit_ConflictingEvents(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newHookEditCmd(f.Factory)
+	cmd.SetArgs([]string{"12", "--enable-events", "push", "--disable-events", "push"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "both enabled and disabled") {
+		t.Errorf("expected conflict error, got %v", err)
+	}
+}
+
+func TestHookTest(t *testing.T) {
+	var path string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			path = r.URL.Path
+			cmdtest.JSONResponse(w, 201, map[string]any{"message": "201 Created"})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newHookTestCmd(f.Factory)
+	cmd.SetArgs([]string{"12", "--event", "merge_requests"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if path != "/api/v4/projects/test-owner/test-repo/hooks/12/test/merge_requests_events" {
+		t.Errorf("unexpected path: %q", path)
+	}
+}
+
+func TestHookDelete(t *testing.T) {
+	deleted := false
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete && r.URL.Path == "/api/v4/projects/test-owner/test-repo/hooks/12" {
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newHookDeleteCmd(f.Factory)
+	cmd.SetArgs([]string{"12", "--yes"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !deleted {
+		t.Error("expected the webhook to be deleted")
+	}
+}
+
+func TestHookDeliveries(t *testing.T) {
+	var status string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/projects/test-owner/test-repo/hooks/12/events" {
+			status = r.URL.Query().Get("status")
+			cmdtest.JSONResponse(w, 200, []any{
+				map[string]any{
+					"id": 99, "trigger": "push_hooks", "response_status": "500",
+					"execution_duration": 1.25, "response_body": "internal error",
+				},
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newHookDeliveriesCmd(f.Factory)
+	cmd.SetArgs([]string{"12", "--status", "server_failure"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if status != "server_failure" {
+		t.Errorf("expected status filter, got %q", status)
+	}
+	out := f.IO.String()
+	for _, want := range []string{"99", "500", "push", "1.25s", "internal error"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got %q", want, out)
+		}
+	}
+}
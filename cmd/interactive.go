@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/PhilipKram/gitlab-cli/internal/browser"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/tui"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// checkInteractive returns an error when --interactive is combined with a
+// flag that selects another kind of output.
+func checkInteractive(cmd *cobra.Command) error {
+	for _, name := range []string{"format", "json", "stream", "web", "jq", "template"} {
+		if flag := cmd.Flags().Lookup(name); flag != nil && flag.Changed {
+			return fmt.Errorf("--interactive cannot be used with --%s", name)
+		}
+	}
+	return nil
+}
+
+// runItemCommand runs a single-item command, such as "mr view", for the
+// item with the given ID.
+func runItemCommand(cmd *cobra.Command, id string) error {
+	cmd.SetArgs([]string{id})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	return cmd.Execute()
+}
+
+// openItem returns an action that opens the web URL urls holds for an item.
+func openItem(urls map[string]string) tui.Action {
+	return tui.Action{Key: 'o', Name: "open", Run: func(item tui.Item) error {
+		return browser.Open(urls[item.Value])
+	}}
+}
+
+// mrInteractiveList returns the interactive list of mr list --interactive.
+func mrInteractiveList(f *cmdutil.Factory, project string, mrs []*gitlab.BasicMergeRequest) *tui.List {
+	urls := make(map[string]string, len(mrs))
+	items := make([]tui.Item, len(mrs))
+	for i, mr := range mrs {
+		id := strconv.FormatInt(mr.IID, 10)
+		urls[id] = mr.WebURL
+		author := ""
+		if mr.Author != nil {
+			author = mr.Author.Username
+		}
+		items[i] = tui.Item{
+			Columns: []string{"!" + id, mr.Title, author, mr.SourceBranch, timeAgo(mr.UpdatedAt)},
+			Value:   id,
+		}
+	}
+
+	return &tui.List{
+		Title:  "Merge requests in " + project,
+		Header: []string{"ID", "TITLE", "AUTHOR", "BRANCH", "UPDATED"},
+		Items:  items,
+		Actions: []tui.Action{
+			{Key: 'v', Name: "view", Wait: true, Run: func(item tui.Item) error {
+				return runItemCommand(newMRViewCmd(f), item.Value)
+			}},
+			openItem(urls),
+			{Key: 'c', Name: "checkout", Quit: true, Run: func(item tui.Item) error {
+				return runItemCommand(newMRCheckoutCmd(f), item.Value)
+			}},
+			{Key: 'a', Name: "approve", Wait: true, Run: func(item tui.Item) error {
+				return runItemCommand(newMRApproveCmd(f), item.Value)
+			}},
+		},
+	}
+}
+
+// issueInteractiveList returns the interactive list of issue list
+// --interactive.
+func issueInteractiveList(f *cmdutil.Factory, project string, issues []*gitlab.Issue) *tui.List {
+	urls := make(map[string]string, len(issues))
+	items := make([]tui.Item, len(issues))
+	for i, issue := range issues {
+		id := strconv.FormatInt(issue.IID, 10)
+		urls[id] = issue.WebURL
+		author := ""
+		if issue.Author != nil {
+			author = issue.Author.Username
+		}
+		items[i] = tui.Item{
+			Columns: []string{"#" + id, issue.Title, author, issue.State, timeAgo(issue.UpdatedAt)},
+			Value:   id,
+		}
+	}
+
+	return &tui.List{
+		Title:  "Issues in " + project,
+		Header: []string{"ID", "TITLE", "AUTHOR", "STATE", "UPDATED"},
+		Items:  items,
+		Actions: []tui.Action{
+			{Key: 'v', Name: "view", Wait: true, Run: func(item tui.Item) error {
+				return runItemCommand(newIssueViewCmd(f), item.Value)
+			}},
+			openItem(urls),
+		},
+	}
+}
+
+// pipelineInteractiveList returns the interactive list of pipeline list
+// --interactive.
+func pipelineInteractiveList(f *cmdutil.Factory, project string, pipelines []*gitlab.PipelineInfo) *tui.List {
+	urls := make(map[string]string, len(pipelines))
+	items := make([]tui.Item, len(pipelines))
+	for i, p := range pipelines {
+		id := strconv.FormatInt(p.ID, 10)
+		urls[id] = p.WebURL
+		items[i] = tui.Item{
+			Columns: []string{"#" + id, p.Status, p.Ref, p.Source, timeAgo(p.UpdatedAt)},
+			Value:   id,
+		}
+	}
+
+	return &tui.List{
+		Title:  "Pipelines in " + project,
+		Header: []string{"ID", "STATUS", "REF", "SOURCE", "UPDATED"},
+		Items:  items,
+		Actions: []tui.Action{
+			{Key: 'v', Name: "view", Wait: true, Run: func(item tui.Item) error {
+				return runItemCommand(newPipelineViewCmd(f), item.Value)
+			}},
+			openItem(urls),
+			{Key: 'r', Name: "retry", Wait: true, Run: func(item tui.Item) error {
+				return runItemCommand(newPipelineRetryCmd(f), item.Value)
+			}},
+		},
+	}
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestListInteractive_ConflictingFlags(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRListCmd(f.Factory)
+	cmd.SetArgs([]string{"--interactive", "--format", "json"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "--interactive cannot be used with --format") {
+		t.Errorf("expected a conflicting flags error, got %v", err)
+	}
+}
+
+func TestListInteractive_RequiresTerminal(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		cmdtest.JSONResponse(w, 200, []map[string]any{{"id": 7, "iid": 1, "title": "Fix typo"}})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	for _, cmd := range []*cobra.Command{
+		newMRListCmd(f.Factory),
+		newIssueListCmd(f.Factory),
+		newPipelineListCmd(f.Factory),
+	} {
+		cmd.SetArgs([]string{"-i"})
+		if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "requires a terminal") {
+			t.Errorf("list -i: expected a terminal error, got %v", err)
+		}
+	}
+}
+
+func TestMRInteractiveList(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	list := mrInteractiveList(f.Factory, "g/p", []*gitlab.BasicMergeRequest{
+		{IID: 12, Title: "Add login", SourceBranch: "login", Author: &gitlab.BasicUser{Username: "alice"}, WebURL: "https://gitlab.com/g/p/-/merge_requests/12"},
+	})
+
+	if list.Title != "Merge requests in g/p" {
+		t.Errorf("unexpected title %q", list.Title)
+	}
+	if got := strings.Join(list.Items[0].Columns[:4], "|"); got != "!12|Add login|alice|login" || list.Items[0].Value != "12" {
+		t.Errorf("unexpected item %q (value %q)", got, list.Items[0].Value)
+	}
+	var keys []string
+	for _, a := range list.Actions {
+		keys = append(keys, string(a.Key)+" "+a.Name)
+	}
+	if got := strings.Join(keys, ", "); got != "v view, o open, c checkout, a approve" {
+		t.Errorf("unexpected actions %q", got)
+	}
+}
+
+func TestMRInteractiveList_Approve(t *testing.T) {
+	var approved bool
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/merge_requests/12/approve" {
+			approved = true
+			cmdtest.JSONResponse(w, 200, map[string]any{})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	list := mrInteractiveList(f.Factory, "test-owner/test-repo", []*gitlab.BasicMergeRequest{{IID: 12}})
+	approve := list.Actions[3]
+	if err := approve.Run(list.Items[0]); err != nil {
+		t.Fatalf("approve: %v", err)
+	}
+	if !approved {
+		t.Error("expected the merge request to be approved")
+	}
+	if !strings.Contains(f.IO.String(), "Approved merge request !12") {
+		t.Errorf("unexpected output %q", f.IO.String())
+	}
+}
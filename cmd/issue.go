@@ -1,14 +1,15 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/PhilipKram/gitlab-cli/internal/api"
 	"github.com/PhilipKram/gitlab-cli/internal/browser"
 	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/config"
 	"github.com/PhilipKram/gitlab-cli/internal/errors"
 	"github.com/spf13/cobra"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
@@ -29,7 +30,22 @@ func NewIssueCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(newIssueReopenCmd(f))
 	cmd.AddCommand(newIssueCommentCmd(f))
 	cmd.AddCommand(newIssueEditCmd(f))
+	cmd.AddCommand(newIssueBulkEditCmd(f))
 	cmd.AddCommand(newIssueDeleteCmd(f))
+	cmd.AddCommand(newIssueMetricsCmd(f))
+	cmd.AddCommand(newIssueRelateToMRCmd(f))
+	cmd.AddCommand(newIssueLinkCmd(f))
+	cmd.AddCommand(newIssueUnlinkCmd(f))
+	cmd.AddCommand(newIssueLinksCmd(f))
+	cmd.AddCommand(newIssueSubscribeCmd(f))
+	cmd.AddCommand(newIssueUnsubscribeCmd(f))
+	cmd.AddCommand(newIssueTodoCmd(f))
+	cmd.AddCommand(newIssueAttachCmd(f))
+	cmd.AddCommand(newIssueDevelopCmd(f))
+	cmd.AddCommand(newIssueMoveCmd(f))
+	cmd.AddCommand(newIssueCloneCmd(f))
+
+	registerIssuableCompletions(f, cmd, fetchIssues)
 
 	return cmd
 }
@@ -44,12 +60,23 @@ func newIssueCreateCmd(f *cmdutil.Factory) *cobra.Command {
 		confidential bool
 		weight       int64
 		web          bool
+		template     string
 	)
 
 	cmd := &cobra.Command{
 		Use:   "create",
 		Short: "Create an issue",
+		Long: `Create a new issue on GitLab.
+
+If the project has issue templates, in .gitlab/issue_templates/ or configured
+on GitLab, and no description is given, a terminal session offers them to
+pre-fill the description and then opens your editor to fill it in. Use
+--template to pick one by name non-interactively.
+
+Without --label, the labels of the defaults.issue_labels config key, or of
+issue.labels in the repository's .glab.yml, are used.`,
 		Example: `  $ glab issue create --title "Bug report" --description "Steps to reproduce..."
+  $ glab issue create --title "Login fails" --template Bug
   $ glab issue create --title "Feature request" --label enhancement --assignee @user1
   $ glab issue create --title "Secret issue" --confidential`,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -63,6 +90,28 @@ func newIssueCreateCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
+			if template != "" {
+				if cmd.Flags().Changed("description") {
+					return fmt.Errorf("--template and --description cannot be used together")
+				}
+				description, err = findDescriptionTemplate(f, client, project, issueTemplates, template)
+				if err != nil {
+					return err
+				}
+			} else if description == "" && f.IOStreams.CanPrompt() {
+				description, err = pickDescriptionTemplate(f, client, project, issueTemplates)
+				if err != nil {
+					return err
+				}
+				if description != "" {
+					cfg, _ := f.Config()
+					description, err = cmdutil.EditText(cmdutil.DetermineEditor(cfg), description, f.IOStreams.In, f.IOStreams.Out, f.IOStreams.ErrOut)
+					if err != nil {
+						return err
+					}
+				}
+			}
+
 			opts := &gitlab.CreateIssueOptions{
 				Title:        &title,
 				Description:  &description,
@@ -77,15 +126,20 @@ func newIssueCreateCmd(f *cmdutil.Factory) *cobra.Command {
 				opts.AssigneeIDs = &ids
 			}
 
+			if !cmd.Flags().Changed("label") {
+				if cfg, _ := f.Config(); cfg != nil {
+					labels = config.SplitList(cfg.Defaults.IssueLabels)
+				}
+			}
 			if len(labels) > 0 {
 				labelOpts := gitlab.LabelOptions(labels)
 				opts.Labels = &labelOpts
 			}
 
 			if milestone != "" {
-				mid, err := strconv.ParseInt(milestone, 10, 64)
+				mid, err := resolveMilestoneID(client, project, milestone)
 				if err != nil {
-					return fmt.Errorf("invalid milestone ID: %s", milestone)
+					return err
 				}
 				opts.MilestoneID = &mid
 			}
@@ -118,9 +172,10 @@ func newIssueCreateCmd(f *cmdutil.Factory) *cobra.Command {
 
 	cmd.Flags().StringVarP(&title, "title", "t", "", "Issue title (required)")
 	cmd.Flags().StringVarP(&description, "description", "d", "", "Issue description")
+	cmd.Flags().StringVar(&template, "template", "", "Use the named issue template as the description")
 	cmd.Flags().StringSliceVarP(&assignees, "assignee", "a", nil, "Assign users by username")
 	cmd.Flags().StringSliceVarP(&labels, "label", "l", nil, "Add labels")
-	cmd.Flags().StringVarP(&milestone, "milestone", "m", "", "Milestone ID")
+	cmd.Flags().StringVarP(&milestone, "milestone", "m", "", "Milestone ID or title")
 	cmd.Flags().BoolVar(&confidential, "confidential", false, "Mark as confidential")
 	cmd.Flags().Int64Var(&weight, "weight", 0, "Issue weight")
 	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open in browser after creation")
@@ -131,17 +186,18 @@ func newIssueCreateCmd(f *cmdutil.Factory) *cobra.Command {
 
 func newIssueListCmd(f *cmdutil.Factory) *cobra.Command {
 	var (
-		state     string
-		author    string
-		assignee  string
-		labels    []string
-		milestone string
-		search    string
-		limit     int
-		format    string
-		jsonFlag  bool
-		web       bool
-		stream    bool
+		state       string
+		author      string
+		assignee    string
+		labels      []string
+		milestone   string
+		search      string
+		limit       int
+		format      string
+		jsonFlag    bool
+		web         bool
+		stream      bool
+		interactive bool
 	)
 
 	cmd := &cobra.Command{
@@ -150,8 +206,15 @@ func newIssueListCmd(f *cmdutil.Factory) *cobra.Command {
 		Aliases: []string{"ls"},
 		Example: `  $ glab issue list
   $ glab issue list --state closed --author johndoe
-  $ glab issue list --label bug,critical --limit 50`,
+  $ glab issue list --label bug,critical --limit 50
+  $ glab issue list --interactive`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if interactive {
+				if err := checkInteractive(cmd); err != nil {
+					return err
+				}
+			}
+
 			client, err := f.Client()
 			if err != nil {
 				return err
@@ -203,7 +266,7 @@ func newIssueListCmd(f *cmdutil.Factory) *cobra.Command {
 			// Use streaming mode if --stream flag is set
 			if stream {
 				// Create context for pagination
-				ctx := context.Background()
+				ctx := cmd.Context()
 
 				// Create fetch function for pagination
 				fetchFunc := func(page int) ([]*gitlab.Issue, *gitlab.Response, error) {
@@ -212,7 +275,7 @@ func newIssueListCmd(f *cmdutil.Factory) *cobra.Command {
 					if pageOpts.PerPage == 0 {
 						pageOpts.PerPage = 100
 					}
-					return client.Issues.ListProjectIssues(project, &pageOpts)
+					return client.Issues.ListProjectIssues(project, &pageOpts, gitlab.WithContext(ctx))
 				}
 
 				// Configure pagination options
@@ -247,6 +310,10 @@ func newIssueListCmd(f *cmdutil.Factory) *cobra.Command {
 				return nil
 			}
 
+			if interactive {
+				return issueInteractiveList(f, project, issues).Run(f.IOStreams.In, f.IOStreams.Out)
+			}
+
 			return f.FormatAndPrint(issues, string(outputFormat), false)
 		},
 	}
@@ -258,16 +325,21 @@ func newIssueListCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.Flags().StringVarP(&milestone, "milestone", "m", "", "Filter by milestone")
 	cmd.Flags().StringVar(&search, "search", "", "Search in title and description")
 	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
 	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open in browser")
 	cmd.Flags().BoolVar(&stream, "stream", false, "Enable streaming mode")
+	cmd.Flags().BoolVarP(&interactive, "interactive", "i", false, "Browse the results interactively: filter, view, or open")
 
 	return cmd
 }
 
 func newIssueViewCmd(f *cmdutil.Factory) *cobra.Command {
 	var web bool
+	var raw bool
+	var comments bool
+	var since string
 	var format string
 	var jsonFlag bool
 
@@ -275,7 +347,8 @@ func newIssueViewCmd(f *cmdutil.Factory) *cobra.Command {
 		Use:   "view [<id>]",
 		Short: "View an issue",
 		Example: `  $ glab issue view 42
-  $ glab issue view 42 --web`,
+  $ glab issue view 42 --web
+  $ glab issue view 42 --comments`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := f.Client()
 			if err != nil {
@@ -306,20 +379,52 @@ func newIssueViewCmd(f *cmdutil.Factory) *cobra.Command {
 				return browser.Open(issue.WebURL)
 			}
 
+			var discussions []*gitlab.Discussion
+			comments = comments || since != ""
+			if comments {
+				var sinceTime time.Time
+				if since != "" {
+					sinceTime, err = parseSince(since)
+					if err != nil {
+						return err
+					}
+				}
+				discussions, resp, err = listAllDiscussions(func(opts gitlab.ListOptions) ([]*gitlab.Discussion, *gitlab.Response, error) {
+					return client.Discussions.ListIssueDiscussions(project, issueID, &gitlab.ListIssueDiscussionsOptions{ListOptions: opts}, gitlab.WithContext(cmd.Context()))
+				})
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/issues/%d/discussions", api.APIURL(client.Host()), project, issueID)
+					return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to list discussions for issue #%d", issueID), err)
+				}
+				discussions = filterComments(discussions, sinceTime)
+			}
+
 			// Backward compatibility: --json flag sets format to json
 			if jsonFlag {
 				format = "json"
 			}
 
 			// Use formatter for non-default formats
-			if format != "" && format != "table" {
+			if f.ExportRequested() || (format != "" && format != "table") {
+				if comments {
+					// Embedding keeps the issue's own fields at the top level.
+					return f.FormatAndPrint(struct {
+						*gitlab.Issue
+						Discussions []*gitlab.Discussion `json:"discussions"`
+					}{issue, discussions}, format, false)
+				}
 				return f.FormatAndPrint(issue, format, false)
 			}
 
 			// Default custom display
 			out := f.IOStreams.Out
-			_, _ = fmt.Fprintf(out, "#%d %s\n", issue.IID, issue.Title)
-			_, _ = fmt.Fprintf(out, "State:   %s\n", issue.State)
+			cs := f.IOStreams.ColorScheme()
+			_, _ = fmt.Fprintf(out, "%s %s\n", cs.Bold(fmt.Sprintf("#%d", issue.IID)), cs.Bold(issue.Title))
+			_, _ = fmt.Fprintf(out, "State:   %s\n", cs.State(issue.State))
 			_, _ = fmt.Fprintf(out, "Author:  %s\n", issue.Author.Username)
 			if len(issue.Assignees) > 0 {
 				var names []string
@@ -340,7 +445,10 @@ func newIssueViewCmd(f *cmdutil.Factory) *cobra.Command {
 			_, _ = fmt.Fprintf(out, "Created: %s\n", timeAgo(issue.CreatedAt))
 			_, _ = fmt.Fprintf(out, "URL:     %s\n", issue.WebURL)
 			if issue.Description != "" {
-				_, _ = fmt.Fprintf(out, "\n%s\n", issue.Description)
+				_, _ = fmt.Fprintf(out, "\n%s\n", renderMarkdown(f, issue.Description, raw))
+			}
+			if comments {
+				printComments(f, discussions, raw)
 			}
 
 			return nil
@@ -348,8 +456,12 @@ func newIssueViewCmd(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open in browser")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().BoolVar(&raw, "raw", false, "Print the description as raw markdown")
+	cmd.Flags().BoolVarP(&comments, "comments", "c", false, "Show discussion threads and comments")
+	cmd.Flags().StringVar(&since, "since", "", "Only show comments created since a date (YYYY-MM-DD) or duration (e.g. 24h, 7d); implies --comments")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
 
 	return cmd
 }
@@ -542,9 +654,9 @@ func newIssueEditCmd(f *cmdutil.Factory) *cobra.Command {
 				opts.Labels = &labelOpts
 			}
 			if cmd.Flags().Changed("milestone") {
-				mid, err := strconv.ParseInt(milestone, 10, 64)
+				mid, err := resolveMilestoneID(client, project, milestone)
 				if err != nil {
-					return fmt.Errorf("invalid milestone ID: %s", milestone)
+					return err
 				}
 				opts.MilestoneID = &mid
 			}
@@ -574,7 +686,7 @@ func newIssueEditCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.Flags().StringVarP(&description, "description", "d", "", "New description")
 	cmd.Flags().StringSliceVarP(&assignees, "assignee", "a", nil, "Assignees")
 	cmd.Flags().StringSliceVarP(&labels, "label", "l", nil, "Labels")
-	cmd.Flags().StringVarP(&milestone, "milestone", "m", "", "Milestone ID")
+	cmd.Flags().StringVarP(&milestone, "milestone", "m", "", "Milestone ID or title")
 	cmd.Flags().BoolVar(&confidential, "confidential", false, "Mark as confidential")
 	cmd.Flags().Int64Var(&weight, "weight", 0, "Issue weight")
 
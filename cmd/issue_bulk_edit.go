@@ -0,0 +1,323 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/PhilipKram/gitlab-cli/internal/prompt"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// bulkEditTarget is an issue selected by issue bulk-edit. Title is empty when
+// the issue was given by ID on standard input.
+type bulkEditTarget struct {
+	IID   int64
+	Title string
+}
+
+func newIssueBulkEditCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		state        string
+		author       string
+		assignee     string
+		labels       []string
+		milestone    string
+		search       string
+		limit        int
+		fromStdin    bool
+		addLabels    []string
+		removeLabels []string
+		setMilestone string
+		setAssignees []string
+		closeIssues  bool
+		reopen       bool
+		dryRun       bool
+		yes          bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "bulk-edit",
+		Short: "Edit many issues at once",
+		Long: `Apply label, milestone, assignee, and state changes to every issue that
+matches a filter.
+
+Issues are selected with the same filter flags as "issue list", or read from
+standard input with --stdin, one ID per line. Only the first field of each
+line is used, so the output of "glab issue list --format plain" can be piped
+in directly.
+
+Pass "none" to --set-milestone or --set-assignee to clear the milestone or
+the assignees. Use --dry-run to see which issues would change.`,
+		Example: `  $ glab issue bulk-edit --label needs-triage --add-label backlog --remove-label needs-triage
+  $ glab issue bulk-edit --milestone "Sprint 4" --state opened --set-milestone "Sprint 5" --dry-run
+  $ glab issue bulk-edit --search flaky --set-assignee alice --yes
+  $ printf '12\n#15\n' | glab issue bulk-edit --stdin --close --yes`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if closeIssues && reopen {
+				return fmt.Errorf("--close and --reopen cannot be used together")
+			}
+			changes := []string{"add-label", "remove-label", "set-milestone", "set-assignee", "close", "reopen"}
+			changed := false
+			for _, name := range changes {
+				if cmd.Flags().Changed(name) {
+					changed = true
+					break
+				}
+			}
+			if !changed {
+				return fmt.Errorf("nothing to change: specify --add-label, --remove-label, --set-milestone, --set-assignee, --close, or --reopen")
+			}
+			if fromStdin {
+				for _, name := range []string{"state", "author", "assignee", "label", "milestone", "search"} {
+					if cmd.Flags().Changed(name) {
+						return fmt.Errorf("--stdin cannot be combined with --%s", name)
+					}
+				}
+				if !yes && !dryRun {
+					return fmt.Errorf("--yes or --dry-run required when reading issue IDs from standard input")
+				}
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			var targets []bulkEditTarget
+			if fromStdin {
+				targets, err = readBulkEditIDs(f)
+			} else {
+				opts := &gitlab.ListProjectIssuesOptions{}
+				if state != "" {
+					opts.State = &state
+				}
+				if author != "" {
+					opts.AuthorUsername = &author
+				}
+				if assignee != "" {
+					opts.AssigneeUsername = &assignee
+				}
+				if len(labels) > 0 {
+					labelOpts := gitlab.LabelOptions(labels)
+					opts.Labels = &labelOpts
+				}
+				if milestone != "" {
+					opts.Milestone = &milestone
+				}
+				if search != "" {
+					opts.Search = &search
+				}
+				targets, err = listBulkEditTargets(cmd, client, project, opts, limit)
+			}
+			if err != nil {
+				return err
+			}
+
+			if len(targets) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No issues match your filters")
+				return nil
+			}
+
+			update := &gitlab.UpdateIssueOptions{}
+			if len(addLabels) > 0 {
+				labelOpts := gitlab.LabelOptions(addLabels)
+				update.AddLabels = &labelOpts
+			}
+			if len(removeLabels) > 0 {
+				labelOpts := gitlab.LabelOptions(removeLabels)
+				update.RemoveLabels = &labelOpts
+			}
+			if cmd.Flags().Changed("set-milestone") {
+				var mid int64
+				if setMilestone != "none" {
+					mid, err = resolveMilestoneID(client, project, setMilestone)
+					if err != nil {
+						return err
+					}
+				}
+				update.MilestoneID = &mid
+			}
+			if cmd.Flags().Changed("set-assignee") {
+				ids := []int64{}
+				if len(setAssignees) != 1 || setAssignees[0] != "none" {
+					ids, err = resolveUserIDs(client, setAssignees)
+					if err != nil {
+						return fmt.Errorf("resolving assignees: %w", err)
+					}
+				}
+				update.AssigneeIDs = &ids
+			}
+			if closeIssues {
+				update.StateEvent = gitlab.Ptr("close")
+			}
+			if reopen {
+				update.StateEvent = gitlab.Ptr("reopen")
+			}
+
+			summary := bulkEditSummary(addLabels, removeLabels, setMilestone, setAssignees, closeIssues, reopen)
+
+			if dryRun {
+				out := f.IOStreams.Out
+				_, _ = fmt.Fprintf(out, "Would update %d issue(s): %s\n\n", len(targets), summary)
+				tp := f.NewTablePrinter()
+				for _, t := range targets {
+					tp.AddRow(fmt.Sprintf("#%d", t.IID), t.Title)
+				}
+				return tp.Render()
+			}
+
+			if !yes {
+				confirmed, err := prompt.Confirm(f.IOStreams.In, f.IOStreams.ErrOut, fmt.Sprintf("Update %d issue(s) in %s (%s)?", len(targets), project, summary), false)
+				if err != nil {
+					return err
+				}
+				if !confirmed {
+					_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "Update cancelled")
+					return nil
+				}
+			}
+
+			errOut := f.IOStreams.ErrOut
+			updated := 0
+			for i, t := range targets {
+				_, resp, err := client.Issues.UpdateIssue(project, t.IID, update, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/issues/%d", api.APIURL(client.Host()), project, t.IID)
+					apiErr := errors.NewAPIError("PUT", url, statusCode, fmt.Sprintf("Failed to update issue #%d", t.IID), err)
+					_, _ = fmt.Fprintf(errOut, "[%d/%d] Failed #%d: %v\n", i+1, len(targets), t.IID, apiErr)
+					continue
+				}
+				updated++
+				_, _ = fmt.Fprintf(errOut, "[%d/%d] Updated #%d\n", i+1, len(targets), t.IID)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Updated %d of %d issue(s)\n", updated, len(targets))
+			if failed := len(targets) - updated; failed > 0 {
+				return fmt.Errorf("failed to update %d of %d issue(s)", failed, len(targets))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&state, "state", "opened", "Filter by state: opened, closed, all")
+	cmd.Flags().StringVar(&author, "author", "", "Filter by author username")
+	cmd.Flags().StringVar(&assignee, "assignee", "", "Filter by assignee username")
+	cmd.Flags().StringSliceVarP(&labels, "label", "l", nil, "Filter by labels")
+	cmd.Flags().StringVarP(&milestone, "milestone", "m", "", "Filter by milestone")
+	cmd.Flags().StringVar(&search, "search", "", "Search in title and description")
+	cmd.Flags().IntVarP(&limit, "limit", "L", 100, "Maximum number of issues to edit")
+	cmd.Flags().BoolVar(&fromStdin, "stdin", false, "Read issue IDs from standard input instead of filtering")
+	cmd.Flags().StringSliceVar(&addLabels, "add-label", nil, "Labels to add")
+	cmd.Flags().StringSliceVar(&removeLabels, "remove-label", nil, "Labels to remove")
+	cmd.Flags().StringVar(&setMilestone, "set-milestone", "", "Milestone ID or title to set, or \"none\" to clear it")
+	cmd.Flags().StringSliceVar(&setAssignees, "set-assignee", nil, "Usernames to assign, or \"none\" to unassign everyone")
+	cmd.Flags().BoolVar(&closeIssues, "close", false, "Close the issues")
+	cmd.Flags().BoolVar(&reopen, "reopen", false, "Reopen the issues")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show the issues that would be updated without changing them")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+// listBulkEditTargets pages through the project's issues matching opts until
+// limit issues have been collected.
+func listBulkEditTargets(cmd *cobra.Command, client *api.Client, project string, opts *gitlab.ListProjectIssuesOptions, limit int) ([]bulkEditTarget, error) {
+	var targets []bulkEditTarget
+	opts.PerPage = 100
+	if limit > 0 && limit < 100 {
+		opts.PerPage = int64(limit)
+	}
+	opts.Page = 1
+	for {
+		issues, resp, err := client.Issues.ListProjectIssues(project, opts, gitlab.WithContext(cmd.Context()))
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			url := api.APIURL(client.Host()) + "/projects/" + project + "/issues"
+			return nil, errors.NewAPIError("GET", url, statusCode, "Failed to list issues", err)
+		}
+		for _, issue := range issues {
+			targets = append(targets, bulkEditTarget{IID: issue.IID, Title: issue.Title})
+			if limit > 0 && len(targets) >= limit {
+				return targets, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return targets, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// readBulkEditIDs reads issue IDs from standard input, one per line. Blank
+// lines are skipped and a leading "#" is allowed.
+func readBulkEditIDs(f *cmdutil.Factory) ([]bulkEditTarget, error) {
+	var targets []bulkEditTarget
+	seen := make(map[int64]bool)
+	scanner := bufio.NewScanner(f.IOStreams.In)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		id, err := strconv.ParseInt(strings.TrimPrefix(fields[0], "#"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid issue ID on standard input: %s", fields[0])
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		targets = append(targets, bulkEditTarget{IID: id})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read issue IDs: %w", err)
+	}
+	return targets, nil
+}
+
+// bulkEditSummary describes the changes issue bulk-edit will apply.
+func bulkEditSummary(addLabels, removeLabels []string, milestone string, assignees []string, closeIssues, reopen bool) string {
+	var parts []string
+	if len(addLabels) > 0 {
+		parts = append(parts, "add labels "+strings.Join(addLabels, ", "))
+	}
+	if len(removeLabels) > 0 {
+		parts = append(parts, "remove labels "+strings.Join(removeLabels, ", "))
+	}
+	if milestone == "none" {
+		parts = append(parts, "clear milestone")
+	} else if milestone != "" {
+		parts = append(parts, "set milestone "+milestone)
+	}
+	if len(assignees) == 1 && assignees[0] == "none" {
+		parts = append(parts, "unassign everyone")
+	} else if len(assignees) > 0 {
+		parts = append(parts, "assign "+strings.Join(assignees, ", "))
+	}
+	if closeIssues {
+		parts = append(parts, "close")
+	}
+	if reopen {
+		parts = append(parts, "reopen")
+	}
+	return strings.Join(parts, "; ")
+}
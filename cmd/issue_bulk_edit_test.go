@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestIssueBulkEdit_Filter(t *testing.T) {
+	var labelFilter string
+	bodies := map[string]map[string]any{}
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/test-owner/test-repo/issues":
+			labelFilter = r.URL.Query().Get("labels")
+			cmdtest.JSONResponse(w, 200, []any{
+				map[string]any{"id": 1, "iid": 12, "title": "First"},
+				map[string]any{"id": 2, "iid": 15, "title": "Second"},
+			})
+		case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/api/v4/projects/test-owner/test-repo/issues/"):
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			bodies[r.URL.Path] = body
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 1})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueBulkEditCmd(f.Factory)
+	cmd.SetArgs([]string{"--label", "needs-triage", "--add-label", "backlog", "--remove-label", "needs-triage", "--close", "--yes"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if labelFilter != "needs-triage" {
+		t.Errorf("expected label filter, got %q", labelFilter)
+	}
+	if len(bodies) != 2 {
+		t.Fatalf("expected 2 updates, got %d", len(bodies))
+	}
+	body := bodies["/api/v4/projects/test-owner/test-repo/issues/15"]
+	if body["add_labels"] != "backlog" || body["remove_labels"] != "needs-triage" || body["state_event"] != "close" {
+		t.Errorf("unexpected request body: %v", body)
+	}
+	if !strings.Contains(f.IO.ErrString(), "[2/2] Updated #15") {
+		t.Errorf("expected progress output, got %q", f.IO.ErrString())
+	}
+	if !strings.Contains(f.IO.String(), "Updated 2 of 2 issue(s)") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestIssueBulkEdit_StdinDryRun(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	f.IO.In.WriteString("12\tFirst issue\n\n#15\n12\n")
+	cmd := newIssueBulkEditCmd(f.Factory)
+	cmd.SetArgs([]string{"--stdin", "--set-milestone", "none", "--dry-run"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := f.IO.String()
+	for _, want := range []string{"Would update 2 issue(s): clear milestone", "#12", "#15"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got %q", want, out)
+		}
+	}
+}
+
+func TestIssueBulkEdit_PartialFailure(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut && r.URL.Path == "/api/v4/projects/test-owner/test-repo/issues/12" {
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 1})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	f.IO.In.WriteString("12\n99\n")
+	cmd := newIssueBulkEditCmd(f.Factory)
+	cmd.SetArgs([]string{"--stdin", "--reopen", "--yes"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "failed to update 1 of 2 issue(s)") {
+		t.Errorf("expected partial failure error, got %v", err)
+	}
+	if !strings.Contains(f.IO.ErrString(), "[2/2] Failed #99") {
+		t.Errorf("expected failure progress, got %q", f.IO.ErrString())
+	}
+}
+
+func TestIssueBulkEdit_Validation(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"no changes", []string{"--label", "bug"}, "nothing to change"},
+		{"close and reopen", []string{"--close", "--reopen"}, "cannot be used together"},
+		{"stdin with filter", []string{"--stdin", "--label", "bug", "--close"}, "--stdin cannot be combined with --label"},
+		{"stdin without yes", []string{"--stdin", "--close"}, "--yes or --dry-run required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := cmdtest.NewTestFactory(t)
+			cmd := newIssueBulkEditCmd(f.Factory)
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
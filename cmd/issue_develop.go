@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	gitutil "github.com/PhilipKram/gitlab-cli/internal/git"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// maxBranchSlugLength bounds the part of a branch name derived from an
+// issue title.
+const maxBranchSlugLength = 50
+
+func newIssueDevelopCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		base     string
+		name     string
+		createMR bool
+		checkout bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "develop <id>",
+		Short: "Create a branch, and optionally a merge request, for an issue",
+		Long: `Start work on an issue: create a branch for it on GitLab, named after the
+issue like "42-fix-login-timeout", from the project's default branch or --base.
+
+With --mr, also open a draft merge request for the branch that closes the
+issue when merged. With --checkout, fetch the branch and switch to it locally.`,
+		Example: `  $ glab issue develop 42
+  $ glab issue develop 42 --base release-1.2 --checkout
+  $ glab issue develop 42 --mr --checkout
+  $ glab issue develop 42 --name fix/login-timeout`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			issueID, err := parseIssueArg(args)
+			if err != nil {
+				return err
+			}
+
+			issue, resp, err := client.Issues.GetIssue(project, issueID)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/issues/%d", api.APIURL(client.Host()), project, issueID)
+				return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get issue #%d", issueID), err)
+			}
+
+			if base == "" {
+				p, resp, err := client.Projects.GetProject(project, nil)
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/projects/" + project
+					return errors.NewAPIError("GET", url, statusCode, "Failed to get project", err)
+				}
+				base = p.DefaultBranch
+			}
+			if name == "" {
+				name = issueBranchName(issue.IID, issue.Title)
+			}
+
+			out := f.IOStreams.Out
+			cs := f.IOStreams.ColorScheme()
+
+			_, resp, err = client.Branches.CreateBranch(project, &gitlab.CreateBranchOptions{
+				Branch: &name,
+				Ref:    &base,
+			})
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/repository/branches", api.APIURL(client.Host()), project)
+				return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to create branch %s", name), err)
+			}
+			_, _ = fmt.Fprintf(out, "%s Created branch %s from %s\n", cs.Green("✓"), name, base)
+
+			if createMR {
+				mr, resp, err := client.MergeRequests.CreateMergeRequest(project, &gitlab.CreateMergeRequestOptions{
+					Title:        gitlab.Ptr(fmt.Sprintf("Draft: Resolve \"%s\"", issue.Title)),
+					Description:  gitlab.Ptr(fmt.Sprintf("Closes #%d", issue.IID)),
+					SourceBranch: &name,
+					TargetBranch: &base,
+				})
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/merge_requests", api.APIURL(client.Host()), project)
+					return errors.NewAPIError("POST", url, statusCode, "Failed to create merge request", err)
+				}
+				_, _ = fmt.Fprintf(out, "%s Created draft merge request !%d\n%s\n", cs.Green("✓"), mr.IID, mr.WebURL)
+			}
+
+			if checkout {
+				remote, err := f.Remote()
+				if err != nil {
+					return fmt.Errorf("could not determine git remote: %w", err)
+				}
+				if err := gitutil.CheckoutRemoteBranch(remote.Name, name); err != nil {
+					return err
+				}
+				_, _ = fmt.Fprintf(out, "Switched to branch '%s'\n", name)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&base, "base", "b", "", "Branch to create the new branch from (default: the default branch)")
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Name of the branch (default: from the issue number and title)")
+	cmd.Flags().BoolVar(&createMR, "mr", false, "Create a draft merge request that closes the issue")
+	cmd.Flags().BoolVarP(&checkout, "checkout", "c", false, "Check out the branch locally")
+
+	return cmd
+}
+
+// issueBranchName returns the name of the branch for an issue: its number
+// and the words of its title, lowercased and joined by dashes.
+func issueBranchName(iid int64, title string) string {
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	name := fmt.Sprint(iid)
+	slug := ""
+	for _, w := range words {
+		if slug != "" && len(slug)+len(w)+1 > maxBranchSlugLength {
+			break
+		}
+		if r := []rune(w); len(r) > maxBranchSlugLength {
+			w = string(r[:maxBranchSlugLength])
+		}
+		slug += "-" + w
+	}
+	return name + slug
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestIssueBranchName(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{"Fix login timeout", "42-fix-login-timeout"},
+		{"  Crash on `glab mr view --web`!  ", "42-crash-on-glab-mr-view-web"},
+		{"Ünïcode títle", "42-ünïcode-títle"},
+		{"", "42"},
+		{"This title is far too long to be used in full as the name of a branch", "42-this-title-is-far-too-long-to-be-used-in-full-as"},
+	}
+	for _, tt := range tests {
+		if got := issueBranchName(42, tt.title); got != tt.want {
+			t.Errorf("issueBranchName(%q) = %q, want %q", tt.title, got, tt.want)
+		}
+	}
+}
+
+func TestIssueDevelop(t *testing.T) {
+	var branch, mr map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/test-owner/test-repo/issues/42":
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 420, "iid": 42, "title": "Fix login timeout"})
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/test-owner/test-repo":
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 1, "default_branch": "main"})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/repository/branches":
+			branch = decodeRequest(t, r)
+			cmdtest.JSONResponse(w, 201, map[string]any{"name": branch["branch"]})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/merge_requests":
+			mr = decodeRequest(t, r)
+			cmdtest.JSONResponse(w, 201, map[string]any{"iid": 7, "web_url": "https://gitlab.com/test-owner/test-repo/-/merge_requests/7"})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueDevelopCmd(f.Factory)
+	cmd.SetArgs([]string{"42", "--mr"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if branch["branch"] != "42-fix-login-timeout" || branch["ref"] != "main" {
+		t.Errorf("unexpected branch request %v", branch)
+	}
+	if mr["title"] != `Draft: Resolve "Fix login timeout"` || mr["description"] != "Closes #42" ||
+		mr["source_branch"] != "42-fix-login-timeout" || mr["target_branch"] != "main" {
+		t.Errorf("unexpected merge request request %v", mr)
+	}
+	out := f.IO.String()
+	for _, want := range []string{"Created branch 42-fix-login-timeout from main", "Created draft merge request !7"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got %q", want, out)
+		}
+	}
+}
+
+func TestIssueDevelop_BaseAndName(t *testing.T) {
+	var branch map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/test-owner/test-repo/issues/42":
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 420, "iid": 42, "title": "Fix login timeout"})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/repository/branches":
+			branch = decodeRequest(t, r)
+			cmdtest.JSONResponse(w, 201, map[string]any{"name": branch["branch"]})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueDevelopCmd(f.Factory)
+	cmd.SetArgs([]string{"#42", "--base", "release-1.2", "--name", "fix/login"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if branch["branch"] != "fix/login" || branch["ref"] != "release-1.2" {
+		t.Errorf("unexpected branch request %v", branch)
+	}
+}
+
+func decodeRequest(t *testing.T, r *http.Request) map[string]any {
+	t.Helper()
+	body := map[string]any{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		t.Errorf("decoding request body: %v", err)
+	}
+	return body
+}
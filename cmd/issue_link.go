@@ -0,0 +1,265 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// issueLinkTypes are the relation types accepted by the Issue Links API.
+var issueLinkTypes = []string{"relates_to", "blocks", "is_blocked_by"}
+
+func newIssueLinkCmd(f *cmdutil.Factory) *cobra.Command {
+	var linkType string
+
+	cmd := &cobra.Command{
+		Use:   "link <id> <other-id>",
+		Short: "Link two issues",
+		Long: `Create a relation between two issues.
+
+The other issue may live in another project, given as OWNER/REPO#ID.`,
+		Example: `  $ glab issue link 42 43
+  $ glab issue link 42 43 --type blocks
+  $ glab issue link 42 other-group/other-repo#7 --type is_blocked_by`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !isIssueLinkType(linkType) {
+				return fmt.Errorf("invalid link type %q (must be one of: %s)", linkType, strings.Join(issueLinkTypes, ", "))
+			}
+
+			issueID, err := parseIssueArg(args[:1])
+			if err != nil {
+				return err
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			targetProject, targetID, err := parseIssueRef(args[1], project)
+			if err != nil {
+				return err
+			}
+
+			link, resp, err := client.IssueLinks.CreateIssueLink(project, issueID, &gitlab.CreateIssueLinkOptions{
+				TargetProjectID: &targetProject,
+				TargetIssueIID:  gitlab.Ptr(strconv.FormatInt(targetID, 10)),
+				LinkType:        &linkType,
+			}, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/issues/%d/links", api.APIURL(client.Host()), project, issueID)
+				return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to link issue #%d", issueID), err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Issue #%d %s %s\n", issueID, issueLinkTypeName(link.LinkType), args[1])
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&linkType, "type", "t", "relates_to", "Link type: relates_to, blocks, or is_blocked_by")
+
+	return cmd
+}
+
+func newIssueUnlinkCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlink <id> <other-id>",
+		Short: "Remove the link between two issues",
+		Example: `  $ glab issue unlink 42 43
+  $ glab issue unlink 42 other-group/other-repo#7`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			issueID, err := parseIssueArg(args[:1])
+			if err != nil {
+				return err
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			targetProject, targetID, err := parseIssueRef(args[1], project)
+			if err != nil {
+				return err
+			}
+
+			relations, err := listIssueRelations(cmd, client, project, issueID)
+			if err != nil {
+				return err
+			}
+
+			var linkID int64
+			for _, rel := range relations {
+				if issueRelationMatches(rel, project, targetProject, targetID) {
+					linkID = rel.IssueLinkID
+					break
+				}
+			}
+			if linkID == 0 {
+				return fmt.Errorf("issue #%d is not linked to %s", issueID, args[1])
+			}
+
+			_, resp, err := client.IssueLinks.DeleteIssueLink(project, issueID, linkID, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/issues/%d/links/%d", api.APIURL(client.Host()), project, issueID, linkID)
+				return errors.NewAPIError("DELETE", url, statusCode, fmt.Sprintf("Failed to unlink issue #%d", issueID), err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Removed link between #%d and %s\n", issueID, args[1])
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newIssueLinksCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "links <id>",
+		Short: "List the issues linked to an issue",
+		Example: `  $ glab issue links 42
+  $ glab issue links 42 --json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			issueID, err := parseIssueArg(args)
+			if err != nil {
+				return err
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			relations, err := listIssueRelations(cmd, client, project, issueID)
+			if err != nil {
+				return err
+			}
+
+			if len(relations) == 0 {
+				_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "No linked issues for #%d\n", issueID)
+				return nil
+			}
+
+			if jsonFlag || f.ExportRequested() || (format != "" && format != "table") {
+				return f.FormatAndPrint(relations, format, jsonFlag)
+			}
+
+			tp := f.NewTablePrinter()
+			for _, rel := range relations {
+				ref := fmt.Sprintf("#%d", rel.IID)
+				if rel.References != nil && rel.References.Relative != "" {
+					ref = rel.References.Relative
+				}
+				tp.AddRow(issueLinkTypeName(rel.LinkType), ref, rel.State, rel.Title)
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
+
+	return cmd
+}
+
+func listIssueRelations(cmd *cobra.Command, client *api.Client, project string, issueID int64) ([]*gitlab.IssueRelation, error) {
+	relations, resp, err := client.IssueLinks.ListIssueRelations(project, issueID, gitlab.WithContext(cmd.Context()))
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := fmt.Sprintf("%s/projects/%s/issues/%d/links", api.APIURL(client.Host()), project, issueID)
+		return nil, errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to list links of issue #%d", issueID), err)
+	}
+	return relations, nil
+}
+
+// parseIssueRef parses an issue reference of the form ID, #ID, or
+// OWNER/REPO#ID. References without a project resolve to defaultProject.
+func parseIssueRef(ref, defaultProject string) (string, int64, error) {
+	project := defaultProject
+	id := ref
+	if i := strings.LastIndex(ref, "#"); i > 0 {
+		project, id = ref[:i], ref[i+1:]
+	}
+	n, err := strconv.ParseInt(strings.TrimPrefix(id, "#"), 10, 64)
+	if err != nil || n <= 0 {
+		return "", 0, fmt.Errorf("invalid issue reference: %s", ref)
+	}
+	return project, n, nil
+}
+
+// issueRelationMatches reports whether rel, listed for an issue of project,
+// refers to issue targetID of targetProject.
+func issueRelationMatches(rel *gitlab.IssueRelation, project, targetProject string, targetID int64) bool {
+	if rel.IID != targetID {
+		return false
+	}
+	if rel.References == nil {
+		return targetProject == project
+	}
+	if targetProject == project {
+		return rel.References.Relative == fmt.Sprintf("#%d", targetID)
+	}
+	return rel.References.Full == fmt.Sprintf("%s#%d", targetProject, targetID)
+}
+
+func isIssueLinkType(t string) bool {
+	for _, lt := range issueLinkTypes {
+		if t == lt {
+			return true
+		}
+	}
+	return false
+}
+
+// issueLinkTypeName returns the phrase GitLab uses for a link type.
+func issueLinkTypeName(t string) string {
+	switch t {
+	case "blocks":
+		return "blocks"
+	case "is_blocked_by":
+		return "is blocked by"
+	default:
+		return "relates to"
+	}
+}
@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestIssueLink(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/issues/42/links" {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": 1, "link_type": body["link_type"]})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueLinkCmd(f.Factory)
+	cmd.SetArgs([]string{"42", "other/repo#7", "--type", "is_blocked_by"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["target_project_id"] != "other/repo" || body["target_issue_iid"] != "7" || body["link_type"] != "is_blocked_by" {
+		t.Errorf("unexpected request body: %v", body)
+	}
+	if !strings.Contains(f.IO.String(), "Issue #42 is blocked by other/repo#7") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestIssueLink_InvalidType(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueLinkCmd(f.Factory)
+	cmd.SetArgs([]string{"42", "43", "--type", "duplicates"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), `invalid link type "duplicates"`) {
+		t.Errorf("expected invalid type error, got %v", err)
+	}
+}
+
+func TestIssueUnlink(t *testing.T) {
+	deletedPath := ""
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/test-owner/test-repo/issues/42/links":
+			cmdtest.JSONResponse(w, 200, []any{
+				map[string]any{"iid": 43, "issue_link_id": 90, "references": map[string]any{"relative": "other/repo#43", "full": "other/repo#43"}},
+				map[string]any{"iid": 43, "issue_link_id": 91, "references": map[string]any{"relative": "#43", "full": "test-owner/test-repo#43"}},
+			})
+		case r.Method == http.MethodDelete:
+			deletedPath = r.URL.Path
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 91})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueUnlinkCmd(f.Factory)
+	cmd.SetArgs([]string{"42", "#43"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if deletedPath != "/api/v4/projects/test-owner/test-repo/issues/42/links/91" {
+		t.Errorf("unexpected delete path: %q", deletedPath)
+	}
+}
+
+func TestIssueUnlink_NotLinked(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		cmdtest.JSONResponse(w, 200, []any{})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueUnlinkCmd(f.Factory)
+	cmd.SetArgs([]string{"42", "43"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "issue #42 is not linked to 43") {
+		t.Errorf("expected not linked error, got %v", err)
+	}
+}
+
+func TestIssueLinks(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/projects/test-owner/test-repo/issues/42/links" {
+			cmdtest.JSONResponse(w, 200, []any{
+				map[string]any{"iid": 43, "title": "Schema change", "state": "opened", "link_type": "blocks", "references": map[string]any{"relative": "#43"}},
+				map[string]any{"iid": 7, "title": "Upstream fix", "state": "closed", "link_type": "relates_to", "references": map[string]any{"relative": "other/repo#7"}},
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueLinksCmd(f.Factory)
+	cmd.SetArgs([]string{"42"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := f.IO.String()
+	for _, want := range []string{"blocks", "#43", "Schema change", "relates to", "other/repo#7", "closed"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got %q", want, out)
+		}
+	}
+}
+
+func TestParseIssueRef(t *testing.T) {
+	tests := []struct {
+		ref         string
+		wantProject string
+		wantID      int64
+		wantErr     bool
+	}{
+		{"12", "me/repo", 12, false},
+		{"#12", "me/repo", 12, false},
+		{"group/sub/repo#7", "group/sub/repo", 7, false},
+		{"repo#x", "", 0, true},
+		{"0", "", 0, true},
+	}
+	for _, tt := range tests {
+		project, id, err := parseIssueRef(tt.ref, "me/repo")
+		if (err != nil) != tt.wantErr || project != tt.wantProject || id != tt.wantID {
+			t.Errorf("parseIssueRef(%q) = %q, %d, %v", tt.ref, project, id, err)
+		}
+	}
+}
@@ -0,0 +1,480 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/PhilipKram/gitlab-cli/internal/formatter"
+	"github.com/PhilipKram/gitlab-cli/internal/tableprinter"
+	"github.com/PhilipKram/gitlab-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// IssueSLAEntry represents the SLA status of a single issue.
+type IssueSLAEntry struct {
+	IID                      int64    `json:"iid"`
+	Title                    string   `json:"title"`
+	Group                    string   `json:"group"`
+	State                    string   `json:"state"`
+	CreatedAt                string   `json:"created_at"`
+	FirstResponseHours       *float64 `json:"first_response_hours"`
+	ResolutionHours          *float64 `json:"resolution_hours"`
+	FirstResponseTargetHours float64  `json:"first_response_target_hours"`
+	ResolutionTargetHours    float64  `json:"resolution_target_hours"`
+	FirstResponseBreached    bool     `json:"first_response_breached"`
+	ResolutionBreached       bool     `json:"resolution_breached"`
+	WebURL                   string   `json:"web_url"`
+}
+
+// IssueSLAGroup summarizes SLA compliance for one label group.
+type IssueSLAGroup struct {
+	Group                 string  `json:"group"`
+	Issues                int     `json:"issues"`
+	Responded             int     `json:"responded"`
+	AvgFirstResponseHours float64 `json:"avg_first_response_hours"`
+	FirstResponseBreaches int     `json:"first_response_breaches"`
+	Resolved              int     `json:"resolved"`
+	AvgResolutionHours    float64 `json:"avg_resolution_hours"`
+	ResolutionBreaches    int     `json:"resolution_breaches"`
+}
+
+// IssueSLAReport represents the result of the SLA analysis.
+type IssueSLAReport struct {
+	Groups         []IssueSLAGroup `json:"groups"`
+	Issues         []IssueSLAEntry `json:"issues"`
+	TotalIssues    int             `json:"total_issues"`
+	Breaches       int             `json:"breaches"`
+	TimePeriodDays int             `json:"time_period_days"`
+	GroupBy        string          `json:"group_by"`
+}
+
+// slaTargets holds the first-response and resolution targets per group.
+type slaTargets struct {
+	response          time.Duration
+	resolution        time.Duration
+	responseByGroup   map[string]time.Duration
+	resolutionByGroup map[string]time.Duration
+}
+
+func (t slaTargets) forGroup(group string) (time.Duration, time.Duration) {
+	response, resolution := t.response, t.resolution
+	if d, ok := t.responseByGroup[group]; ok {
+		response = d
+	}
+	if d, ok := t.resolutionByGroup[group]; ok {
+		resolution = d
+	}
+	return response, resolution
+}
+
+func newIssueMetricsCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "metrics <command>",
+		Short: "Analyze issue metrics",
+		Long:  "Compute support metrics such as first-response and resolution times for project issues.",
+	}
+
+	cmd.AddCommand(newIssueMetricsSLACmd(f))
+
+	return cmd
+}
+
+func newIssueMetricsSLACmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		days              int
+		labels            []string
+		groupBy           string
+		responseSLA       string
+		resolutionSLA     string
+		responseTargets   []string
+		resolutionTargets []string
+		breachesOnly      bool
+		failOnBreach      bool
+		format            string
+		jsonFlag          bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "sla",
+		Short: "Track first-response and resolution times against SLA targets",
+		Long: `Compute first-response and resolution times for issues created in the
+given time period, grouped by a scoped label such as severity::1, and flag
+issues that breach their SLA targets.
+
+The first response is the first comment by someone other than the issue
+author. Open issues without a response (or resolution) count as breached
+once their age exceeds the target.
+
+Targets are set with --response-sla and --resolution-sla, and can be
+overridden per group with --response-target and --resolution-target.
+Durations use d, h, m, or s units. Use --fail-on-breach to make a scheduled
+CI job fail when any SLA is breached.`,
+		Example: `  $ glab issue metrics sla
+  $ glab issue metrics sla --group-by priority --response-sla 8h --resolution-sla 5d
+  $ glab issue metrics sla --response-target severity::1=2h --resolution-target severity::1=1d
+  $ glab issue metrics sla --label support --breaches-only --fail-on-breach --format json > sla.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFormat, err := f.ResolveFormat(format, jsonFlag)
+			if err != nil {
+				return err
+			}
+
+			targets, err := parseSLATargets(responseSLA, resolutionSLA, responseTargets, resolutionTargets)
+			if err != nil {
+				return err
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			// Calculate date cutoff
+			cutoffDate := time.Now().AddDate(0, 0, -days)
+
+			opts := &gitlab.ListProjectIssuesOptions{
+				ListOptions:  gitlab.ListOptions{PerPage: 100},
+				CreatedAfter: &cutoffDate,
+			}
+			if len(labels) > 0 {
+				labelOpts := gitlab.LabelOptions(labels)
+				opts.Labels = &labelOpts
+			}
+
+			var allIssues []*gitlab.Issue
+			page := 1
+
+			for {
+				opts.Page = int64(page)
+				issues, resp, err := client.Issues.ListProjectIssues(project, opts)
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/projects/" + project + "/issues"
+					return errors.NewAPIError("GET", url, statusCode, "Failed to list issues", err)
+				}
+
+				if len(issues) == 0 {
+					break
+				}
+
+				allIssues = append(allIssues, issues...)
+
+				// Check if we've retrieved all pages
+				if resp.NextPage == 0 {
+					break
+				}
+				page = int(resp.NextPage)
+			}
+
+			if len(allIssues) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No issues found in the specified time period")
+				return nil
+			}
+
+			now := time.Now()
+			var entries []IssueSLAEntry
+
+			for _, issue := range allIssues {
+				notes, err := listIssueNotesByCreation(client, project, issue.IID)
+				if err != nil {
+					return err
+				}
+				entries = append(entries, computeIssueSLA(issue, notes, issueSLAGroup(issue.Labels, groupBy), targets, now))
+			}
+
+			report := buildIssueSLAReport(entries, days, groupBy)
+			if breachesOnly {
+				var breached []IssueSLAEntry
+				for _, e := range report.Issues {
+					if e.FirstResponseBreached || e.ResolutionBreached {
+						breached = append(breached, e)
+					}
+				}
+				report.Issues = breached
+			}
+			if report.Issues == nil {
+				report.Issues = []IssueSLAEntry{}
+			}
+
+			if outputFormat == formatter.TableFormat {
+				printIssueSLAReport(f, report)
+			} else if err := f.FormatAndPrint(report, string(outputFormat), false); err != nil {
+				return err
+			}
+
+			if failOnBreach && report.Breaches > 0 {
+				return fmt.Errorf("%d issue(s) breached their SLA", report.Breaches)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVarP(&days, "days", "d", 30, "Number of days to analyze")
+	cmd.Flags().StringSliceVarP(&labels, "label", "l", nil, "Only include issues with these labels")
+	cmd.Flags().StringVar(&groupBy, "group-by", "severity", "Scoped label prefix to group issues by (e.g. severity, priority)")
+	cmd.Flags().StringVar(&responseSLA, "response-sla", "24h", "Default first-response target")
+	cmd.Flags().StringVar(&resolutionSLA, "resolution-sla", "7d", "Default resolution target")
+	cmd.Flags().StringSliceVar(&responseTargets, "response-target", nil, "First-response target for a group as LABEL=DURATION")
+	cmd.Flags().StringSliceVar(&resolutionTargets, "resolution-target", nil, "Resolution target for a group as LABEL=DURATION")
+	cmd.Flags().BoolVar(&breachesOnly, "breaches-only", false, "Only list issues that breached an SLA")
+	cmd.Flags().BoolVar(&failOnBreach, "fail-on-breach", false, "Exit with an error when any SLA is breached")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+// listIssueNotesByCreation returns all notes of an issue, oldest first.
+func listIssueNotesByCreation(client *api.Client, project string, iid int64) ([]*gitlab.Note, error) {
+	opts := &gitlab.ListIssueNotesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100},
+		OrderBy:     gitlab.Ptr("created_at"),
+		Sort:        gitlab.Ptr("asc"),
+	}
+
+	var all []*gitlab.Note
+	for {
+		notes, resp, err := client.Notes.ListIssueNotes(project, iid, opts)
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			url := fmt.Sprintf("%s/projects/%s/issues/%d/notes", api.APIURL(client.Host()), project, iid)
+			return nil, errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to list notes of issue #%d", iid), err)
+		}
+		all = append(all, notes...)
+
+		if resp == nil || resp.NextPage == 0 {
+			return all, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// parseSLATargets builds SLA targets from the default durations and
+// per-group LABEL=DURATION overrides.
+func parseSLATargets(response, resolution string, responseTargets, resolutionTargets []string) (slaTargets, error) {
+	var targets slaTargets
+	var err error
+
+	if targets.response, err = parseDuration(response); err != nil {
+		return targets, fmt.Errorf("invalid --response-sla %q: %w", response, err)
+	}
+	if targets.resolution, err = parseDuration(resolution); err != nil {
+		return targets, fmt.Errorf("invalid --resolution-sla %q: %w", resolution, err)
+	}
+	if targets.responseByGroup, err = parseSLAOverrides("response-target", responseTargets); err != nil {
+		return targets, err
+	}
+	if targets.resolutionByGroup, err = parseSLAOverrides("resolution-target", resolutionTargets); err != nil {
+		return targets, err
+	}
+	return targets, nil
+}
+
+func parseSLAOverrides(flag string, values []string) (map[string]time.Duration, error) {
+	overrides := make(map[string]time.Duration, len(values))
+	for _, v := range values {
+		idx := strings.LastIndex(v, "=")
+		if idx <= 0 {
+			return nil, fmt.Errorf("invalid --%s %q: expected LABEL=DURATION", flag, v)
+		}
+		d, err := parseDuration(v[idx+1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s %q: %w", flag, v, err)
+		}
+		overrides[v[:idx]] = d
+	}
+	return overrides, nil
+}
+
+// issueSLAGroup returns the first label matching the scoped prefix, or "none".
+func issueSLAGroup(labels []string, groupBy string) string {
+	prefix := strings.TrimSuffix(groupBy, "::") + "::"
+	for _, l := range labels {
+		if strings.HasPrefix(l, prefix) {
+			return l
+		}
+	}
+	return "none"
+}
+
+// computeIssueSLA measures an issue's first-response and resolution times.
+// Notes must be sorted by creation time in ascending order.
+func computeIssueSLA(issue *gitlab.Issue, notes []*gitlab.Note, group string, targets slaTargets, now time.Time) IssueSLAEntry {
+	responseTarget, resolutionTarget := targets.forGroup(group)
+	entry := IssueSLAEntry{
+		IID:                      issue.IID,
+		Title:                    issue.Title,
+		Group:                    group,
+		State:                    issue.State,
+		FirstResponseTargetHours: responseTarget.Hours(),
+		ResolutionTargetHours:    resolutionTarget.Hours(),
+		WebURL:                   issue.WebURL,
+	}
+	if issue.CreatedAt == nil {
+		return entry
+	}
+	created := *issue.CreatedAt
+	entry.CreatedAt = created.Format(time.RFC3339)
+	age := now.Sub(created)
+
+	var authorID int64
+	if issue.Author != nil {
+		authorID = issue.Author.ID
+	}
+	for _, note := range notes {
+		if note.System || note.CreatedAt == nil || note.Author.ID == authorID {
+			continue
+		}
+		hours := note.CreatedAt.Sub(created).Hours()
+		entry.FirstResponseHours = &hours
+		entry.FirstResponseBreached = note.CreatedAt.Sub(created) > responseTarget
+		break
+	}
+	if entry.FirstResponseHours == nil {
+		entry.FirstResponseBreached = age > responseTarget
+	}
+
+	if issue.ClosedAt != nil {
+		hours := issue.ClosedAt.Sub(created).Hours()
+		entry.ResolutionHours = &hours
+		entry.ResolutionBreached = issue.ClosedAt.Sub(created) > resolutionTarget
+	} else {
+		entry.ResolutionBreached = age > resolutionTarget
+	}
+
+	return entry
+}
+
+// buildIssueSLAReport aggregates per-issue SLA entries into group summaries.
+func buildIssueSLAReport(entries []IssueSLAEntry, days int, groupBy string) IssueSLAReport {
+	report := IssueSLAReport{
+		Issues:         entries,
+		TotalIssues:    len(entries),
+		TimePeriodDays: days,
+		GroupBy:        groupBy,
+	}
+
+	groups := map[string]*IssueSLAGroup{}
+	for _, e := range entries {
+		g, ok := groups[e.Group]
+		if !ok {
+			g = &IssueSLAGroup{Group: e.Group}
+			groups[e.Group] = g
+		}
+		g.Issues++
+		if e.FirstResponseHours != nil {
+			g.Responded++
+			g.AvgFirstResponseHours += *e.FirstResponseHours
+		}
+		if e.ResolutionHours != nil {
+			g.Resolved++
+			g.AvgResolutionHours += *e.ResolutionHours
+		}
+		if e.FirstResponseBreached {
+			g.FirstResponseBreaches++
+		}
+		if e.ResolutionBreached {
+			g.ResolutionBreaches++
+		}
+		if e.FirstResponseBreached || e.ResolutionBreached {
+			report.Breaches++
+		}
+	}
+
+	for _, g := range groups {
+		if g.Responded > 0 {
+			g.AvgFirstResponseHours /= float64(g.Responded)
+		}
+		if g.Resolved > 0 {
+			g.AvgResolutionHours /= float64(g.Resolved)
+		}
+		report.Groups = append(report.Groups, *g)
+	}
+	sort.Slice(report.Groups, func(i, j int) bool {
+		return report.Groups[i].Group < report.Groups[j].Group
+	})
+
+	return report
+}
+
+// printIssueSLAReport renders the group summary followed by the issue list.
+func printIssueSLAReport(f *cmdutil.Factory, report IssueSLAReport) {
+	out := f.IOStreams.Out
+	hyperlinks := f.HyperlinksEnabled()
+
+	tp := tableprinter.New(out)
+	tp.AddRow("GROUP", "ISSUES", "AVG RESPONSE", "RESPONSE BREACHES", "AVG RESOLUTION", "RESOLUTION BREACHES")
+	for _, g := range report.Groups {
+		tp.AddRow(
+			g.Group,
+			fmt.Sprintf("%d", g.Issues),
+			formatSLAHours(g.AvgFirstResponseHours, g.Responded > 0),
+			fmt.Sprintf("%d", g.FirstResponseBreaches),
+			formatSLAHours(g.AvgResolutionHours, g.Resolved > 0),
+			fmt.Sprintf("%d", g.ResolutionBreaches),
+		)
+	}
+	_ = tp.Render()
+
+	if len(report.Issues) > 0 {
+		_, _ = fmt.Fprintln(out)
+		tp = tableprinter.New(out)
+		tp.AddRow("IID", "GROUP", "STATE", "RESPONSE", "RESOLUTION", "BREACH", "TITLE")
+		for _, e := range report.Issues {
+			var breach []string
+			if e.FirstResponseBreached {
+				breach = append(breach, "response")
+			}
+			if e.ResolutionBreached {
+				breach = append(breach, "resolution")
+			}
+			response, resolution := "-", "-"
+			if e.FirstResponseHours != nil {
+				response = formatSLAHours(*e.FirstResponseHours, true)
+			}
+			if e.ResolutionHours != nil {
+				resolution = formatSLAHours(*e.ResolutionHours, true)
+			}
+			iid := fmt.Sprintf("#%d", e.IID)
+			if hyperlinks {
+				iid = iostreams.Hyperlink(e.WebURL, iid)
+			}
+			tp.AddRow(
+				iid,
+				e.Group,
+				e.State,
+				response,
+				resolution,
+				strings.Join(breach, ","),
+				truncate(e.Title, 50),
+			)
+		}
+		_ = tp.Render()
+	}
+
+	_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "\n%d issue(s) analyzed over %d days, %d breached SLA\n", report.TotalIssues, report.TimePeriodDays, report.Breaches)
+}
+
+func formatSLAHours(hours float64, ok bool) string {
+	if !ok {
+		return "-"
+	}
+	return fmt.Sprintf("%.1fh", hours)
+}
@@ -0,0 +1,199 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestIssueMetricsSLACmd_Flags(t *testing.T) {
+	f := newTestFactory()
+	cmd := newIssueMetricsCmd(f)
+
+	sla, _, err := cmd.Find([]string{"sla"})
+	if err != nil || sla.Name() != "sla" {
+		t.Fatalf("expected sla subcommand, got err=%v", err)
+	}
+	for _, name := range []string{"days", "label", "group-by", "response-sla", "resolution-sla", "response-target", "resolution-target", "breaches-only", "fail-on-breach", "format", "json"} {
+		if sla.Flags().Lookup(name) == nil {
+			t.Errorf("expected --%s flag", name)
+		}
+	}
+}
+
+func TestParseSLATargets(t *testing.T) {
+	targets, err := parseSLATargets("24h", "7d", []string{"severity::1=2h"}, []string{"severity::1=1d"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	response, resolution := targets.forGroup("severity::1")
+	if response != 2*time.Hour || resolution != 24*time.Hour {
+		t.Errorf("severity::1 targets = %v/%v", response, resolution)
+	}
+	response, resolution = targets.forGroup("none")
+	if response != 24*time.Hour || resolution != 7*24*time.Hour {
+		t.Errorf("default targets = %v/%v", response, resolution)
+	}
+
+	if _, err := parseSLATargets("24h", "7d", []string{"severity::1"}, nil); err == nil {
+		t.Error("expected error for override without duration")
+	}
+	if _, err := parseSLATargets("soon", "7d", nil, nil); err == nil {
+		t.Error("expected error for invalid default duration")
+	}
+}
+
+func TestComputeIssueSLA(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	reply := created.Add(3 * time.Hour)
+	ownReply := created.Add(time.Hour)
+	closed := created.Add(48 * time.Hour)
+	targets := slaTargets{response: 2 * time.Hour, resolution: 72 * time.Hour}
+
+	issue := &gitlab.Issue{
+		IID:       5,
+		State:     "closed",
+		Author:    &gitlab.IssueAuthor{ID: 1},
+		CreatedAt: &created,
+		ClosedAt:  &closed,
+	}
+	notes := []*gitlab.Note{
+		{System: true, CreatedAt: &ownReply, Author: gitlab.NoteAuthor{ID: 2}},
+		{CreatedAt: &ownReply, Author: gitlab.NoteAuthor{ID: 1}},
+		{CreatedAt: &reply, Author: gitlab.NoteAuthor{ID: 2}},
+	}
+
+	entry := computeIssueSLA(issue, notes, "none", targets, created.Add(100*time.Hour))
+	if entry.FirstResponseHours == nil || *entry.FirstResponseHours != 3 {
+		t.Fatalf("FirstResponseHours = %v, want 3", entry.FirstResponseHours)
+	}
+	if !entry.FirstResponseBreached {
+		t.Error("expected first response to be breached")
+	}
+	if entry.ResolutionHours == nil || *entry.ResolutionHours != 48 || entry.ResolutionBreached {
+		t.Errorf("unexpected resolution: %v breached=%v", entry.ResolutionHours, entry.ResolutionBreached)
+	}
+
+	// An open issue without a response breaches once it is older than the target.
+	open := &gitlab.Issue{IID: 6, State: "opened", Author: &gitlab.IssueAuthor{ID: 1}, CreatedAt: &created}
+	entry = computeIssueSLA(open, nil, "none", targets, created.Add(time.Hour))
+	if entry.FirstResponseBreached || entry.ResolutionBreached {
+		t.Errorf("expected no breach yet, got %+v", entry)
+	}
+	entry = computeIssueSLA(open, nil, "none", targets, created.Add(80*time.Hour))
+	if !entry.FirstResponseBreached || !entry.ResolutionBreached {
+		t.Errorf("expected both SLAs breached, got %+v", entry)
+	}
+}
+
+func TestIssueMetricsSLA_JSON(t *testing.T) {
+	created := time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)
+	replied := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/issues/1/notes"):
+			cmdtest.JSONResponse(w, 200, []map[string]any{
+				{"id": 10, "body": "on it", "system": false, "created_at": replied, "author": map[string]any{"id": 2}},
+			})
+		case strings.HasSuffix(r.URL.Path, "/issues"):
+			cmdtest.JSONResponse(w, 200, []map[string]any{
+				{"id": 100, "iid": 1, "title": "Login broken", "state": "opened", "labels": []string{"severity::1"}, "created_at": created, "author": map[string]any{"id": 1}},
+			})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueMetricsSLACmd(f.Factory)
+	cmd.SetArgs([]string{"--response-target", "severity::1=30m", "--format", "json", "--fail-on-breach"})
+	err := cmd.Execute()
+	if err == nil {
+		t.Fatal("expected --fail-on-breach to return an error")
+	}
+
+	var report IssueSLAReport
+	if err := json.Unmarshal(f.IO.Out.Bytes(), &report); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, f.IO.Out.String())
+	}
+	if report.TotalIssues != 1 || report.Breaches != 1 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	if len(report.Groups) != 1 || report.Groups[0].Group != "severity::1" || report.Groups[0].FirstResponseBreaches != 1 {
+		t.Errorf("unexpected groups: %+v", report.Groups)
+	}
+}
+
+func TestIssueMetricsSLA_NotesErrorFails(t *testing.T) {
+	created := time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)
+
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/issues/1/notes"):
+			cmdtest.ErrorResponse(w, 500, "500 Internal Server Error")
+		case strings.HasSuffix(r.URL.Path, "/issues"):
+			cmdtest.JSONResponse(w, 200, []map[string]any{
+				{"id": 100, "iid": 1, "title": "Login broken", "state": "opened", "created_at": created, "author": map[string]any{"id": 1}},
+			})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueMetricsSLACmd(f.Factory)
+	cmd.SetArgs([]string{"--fail-on-breach"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "Failed to list notes of issue #1") {
+		t.Fatalf("expected the notes error to be returned, got %v", err)
+	}
+}
+
+func TestIssueMetricsSLA_NotesPages(t *testing.T) {
+	created := time.Now().Add(-3 * time.Hour).UTC().Format(time.RFC3339)
+	note := time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)
+	replied := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/issues/1/notes"):
+			if r.URL.Query().Get("page") == "2" {
+				cmdtest.JSONResponse(w, 200, []map[string]any{
+					{"id": 11, "body": "on it", "system": false, "created_at": replied, "author": map[string]any{"id": 2}},
+				})
+				return
+			}
+			w.Header().Set("X-Next-Page", "2")
+			cmdtest.JSONResponse(w, 200, []map[string]any{
+				{"id": 10, "body": "more details", "system": false, "created_at": note, "author": map[string]any{"id": 1}},
+			})
+		case strings.HasSuffix(r.URL.Path, "/issues"):
+			cmdtest.JSONResponse(w, 200, []map[string]any{
+				{"id": 100, "iid": 1, "title": "Login broken", "state": "opened", "created_at": created, "author": map[string]any{"id": 1}},
+			})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueMetricsSLACmd(f.Factory)
+	cmd.SetArgs([]string{"--format", "json"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var report IssueSLAReport
+	if err := json.Unmarshal(f.IO.Out.Bytes(), &report); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, f.IO.Out.String())
+	}
+	if len(report.Issues) != 1 || report.Issues[0].FirstResponseHours == nil {
+		t.Errorf("expected the reply on the second page to count as the first response, got %+v", report.Issues)
+	}
+}
@@ -0,0 +1,183 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func newIssueMoveCmd(f *cmdutil.Factory) *cobra.Command {
+	var to string
+
+	cmd := &cobra.Command{
+		Use:   "move <id>",
+		Short: "Move an issue to another project",
+		Long: `Move an issue to another project on the same GitLab host. The issue is
+recreated in the target project with its comments, and the original is closed
+with a note pointing to the new issue.
+
+The target project is given in any form --repo accepts.`,
+		Example: `  $ glab issue move 42 --to group/other-project
+  $ glab issue move 42 --to https://gitlab.com/group/other-project`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			issueID, err := parseIssueArg(args)
+			if err != nil {
+				return err
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			target, err := resolveTargetProject(client, to)
+			if err != nil {
+				return err
+			}
+
+			issue, resp, err := client.Issues.MoveIssue(project, issueID, &gitlab.MoveIssueOptions{
+				ToProjectID: &target.ID,
+			}, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/issues/%d/move", api.APIURL(client.Host()), project, issueID)
+				return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to move issue #%d", issueID), err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Moved issue #%d to %s#%d\n%s\n", issueID, target.PathWithNamespace, issue.IID, issue.WebURL)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&to, "to", "t", "", "Project to move the issue to")
+	_ = cmd.MarkFlagRequired("to")
+
+	return cmd
+}
+
+func newIssueCloneCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		to        string
+		withNotes bool
+		noLink    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "clone <id>",
+		Short: "Copy an issue to another project",
+		Long: `Copy an issue to a project on the same GitLab host, which may be the
+current one. The original issue stays open, and the copy is linked to it as
+related unless --no-link is given.
+
+The target project is given in any form --repo accepts.`,
+		Example: `  $ glab issue clone 42 --to group/other-project
+  $ glab issue clone 42 --to group/other-project --with-notes
+  $ glab issue clone 42 --to 1234 --no-link`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			issueID, err := parseIssueArg(args)
+			if err != nil {
+				return err
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			target, err := resolveTargetProject(client, to)
+			if err != nil {
+				return err
+			}
+
+			// The client library has no wrapper for the clone endpoint.
+			opts := struct {
+				ToProjectID int64 `json:"to_project_id"`
+				WithNotes   bool  `json:"with_notes,omitempty"`
+			}{ToProjectID: target.ID, WithNotes: withNotes}
+			path := fmt.Sprintf("projects/%s/issues/%d/clone", gitlab.PathEscape(project), issueID)
+			req, err := client.NewRequest(http.MethodPost, path, &opts, []gitlab.RequestOptionFunc{gitlab.WithContext(cmd.Context())})
+			if err != nil {
+				return err
+			}
+			var clone gitlab.Issue
+			resp, err := client.Do(req, &clone)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/issues/%d/clone", api.APIURL(client.Host()), project, issueID)
+				return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to clone issue #%d", issueID), err)
+			}
+
+			if !noLink {
+				_, resp, err := client.IssueLinks.CreateIssueLink(project, issueID, &gitlab.CreateIssueLinkOptions{
+					TargetProjectID: gitlab.Ptr(strconv.FormatInt(target.ID, 10)),
+					TargetIssueIID:  gitlab.Ptr(strconv.FormatInt(clone.IID, 10)),
+				}, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/issues/%d/links", api.APIURL(client.Host()), project, issueID)
+					return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Cloned issue #%d to %s#%d, but failed to link them", issueID, target.PathWithNamespace, clone.IID), err)
+				}
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Cloned issue #%d to %s#%d\n%s\n", issueID, target.PathWithNamespace, clone.IID, clone.WebURL)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&to, "to", "t", "", "Project to copy the issue to")
+	cmd.Flags().BoolVar(&withNotes, "with-notes", false, "Copy the issue's comments too")
+	cmd.Flags().BoolVar(&noLink, "no-link", false, "Do not link the copy to the original issue")
+	_ = cmd.MarkFlagRequired("to")
+
+	return cmd
+}
+
+// resolveTargetProject looks up the project repo names, which must be on the
+// host of client.
+func resolveTargetProject(client *api.Client, repo string) (*gitlab.Project, error) {
+	host, path, err := cmdutil.ParseRepo(repo)
+	if err != nil {
+		return nil, err
+	}
+	if host != "" && host != client.Host() {
+		return nil, fmt.Errorf("%s is on %s; issues can only be moved or copied within %s", path, host, client.Host())
+	}
+
+	p, resp, err := client.Projects.GetProject(path, nil)
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := api.APIURL(client.Host()) + "/projects/" + path
+		return nil, errors.NewAPIError("GET", url, statusCode, "Failed to get project", err)
+	}
+	return p, nil
+}
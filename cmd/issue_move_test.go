@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestIssueMove(t *testing.T) {
+	var moveBody map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/group/other":
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 77, "path_with_namespace": "group/other"})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/issues/42/move":
+			moveBody = decodeRequest(t, r)
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": 900, "iid": 5, "web_url": "https://gitlab.com/group/other/-/issues/5"})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueMoveCmd(f.Factory)
+	cmd.SetArgs([]string{"42", "--to", "https://gitlab.com/group/other"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if moveBody["to_project_id"] != float64(77) {
+		t.Errorf("expected to_project_id 77, got %v", moveBody)
+	}
+	if out := f.IO.String(); !strings.Contains(out, "Moved issue #42 to group/other#5") {
+		t.Errorf("unexpected output %q", out)
+	}
+}
+
+func TestIssueMove_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"missing target", []string{"42"}, `required flag(s) "to" not set`},
+		{"other host", []string{"42", "--to", "gitlab.example.com/group/other"}, "can only be moved or copied within gitlab.com"},
+		{"invalid issue", []string{"abc", "--to", "group/other"}, "invalid issue ID"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := cmdtest.NewTestFactory(t)
+			cmd := newIssueMoveCmd(f.Factory)
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestIssueClone(t *testing.T) {
+	var cloneBody, linkBody map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/group/other":
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 77, "path_with_namespace": "group/other"})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/issues/42/clone":
+			cloneBody = decodeRequest(t, r)
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": 901, "iid": 6, "web_url": "https://gitlab.com/group/other/-/issues/6"})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/issues/42/links":
+			linkBody = decodeRequest(t, r)
+			cmdtest.JSONResponse(w, 201, map[string]any{"link_type": "relates_to"})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueCloneCmd(f.Factory)
+	cmd.SetArgs([]string{"42", "--to", "group/other", "--with-notes"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cloneBody["to_project_id"] != float64(77) || cloneBody["with_notes"] != true {
+		t.Errorf("unexpected clone request %v", cloneBody)
+	}
+	if linkBody["target_project_id"] != "77" || linkBody["target_issue_iid"] != "6" {
+		t.Errorf("unexpected link request %v", linkBody)
+	}
+	if out := f.IO.String(); !strings.Contains(out, "Cloned issue #42 to group/other#6") {
+		t.Errorf("unexpected output %q", out)
+	}
+}
+
+func TestIssueClone_NoLink(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/77":
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 77, "path_with_namespace": "group/other"})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/issues/42/clone":
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": 901, "iid": 6})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueCloneCmd(f.Factory)
+	cmd.SetArgs([]string{"42", "--to", "77", "--no-link"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,208 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// closingKeywords are the keywords recognized by GitLab's default issue
+// closing pattern.
+var closingKeywords = []string{
+	"close", "closes", "closed", "closing",
+	"fix", "fixes", "fixed", "fixing",
+	"resolve", "resolves", "resolved", "resolving",
+	"implement", "implements", "implemented", "implementing",
+}
+
+func newIssueRelateToMRCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		keyword string
+		related bool
+		dryRun  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "relate-to-mr <issue-id> <mr-id>",
+		Short: "Link an issue to a merge request with a closing pattern",
+		Long: `Append a closing pattern such as "Closes #42" to a merge request's
+description so the issue is closed automatically when the merge request is merged.
+
+Before updating, the command checks that the pattern will actually take effect:
+the project must have automatic issue closing enabled, the merge request must
+target the default branch, and both must still be open. After updating, the
+issues GitLab will close on merge are queried to confirm the link.
+
+Use --related to add a non-closing "Related to #42" reference instead.`,
+		Example: `  $ glab issue relate-to-mr 42 123
+  $ glab issue relate-to-mr 42 123 --keyword Fixes
+  $ glab issue relate-to-mr 42 123 --related
+  $ glab issue relate-to-mr 42 123 --dry-run`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !related && !isClosingKeyword(keyword) {
+				return fmt.Errorf("invalid closing keyword %q (must be one of: %s)", keyword, strings.Join(closingKeywords, ", "))
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			issueID, err := parseIssueArg(args[:1])
+			if err != nil {
+				return err
+			}
+			mrID, err := parseMRArg(args[1:])
+			if err != nil {
+				return err
+			}
+
+			issue, resp, err := client.Issues.GetIssue(project, issueID)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/issues/%d", api.APIURL(client.Host()), project, issueID)
+				return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get issue #%d", issueID), err)
+			}
+
+			mr, resp, err := client.MergeRequests.GetMergeRequest(project, mrID, nil)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/merge_requests/%d", api.APIURL(client.Host()), project, mrID)
+				return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get merge request !%d", mrID), err)
+			}
+
+			errOut := f.IOStreams.ErrOut
+			out := f.IOStreams.Out
+
+			if !related {
+				proj, resp, err := client.Projects.GetProject(project, nil)
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/projects/" + project
+					return errors.NewAPIError("GET", url, statusCode, "Failed to get project", err)
+				}
+				for _, problem := range closingPatternProblems(proj, issue, mr) {
+					_, _ = fmt.Fprintf(errOut, "Warning: %s\n", problem)
+				}
+			}
+
+			if hasIssueReference(mr.Description, issue.IID, related) {
+				_, _ = fmt.Fprintf(out, "!%d already references #%d\n", mr.IID, issue.IID)
+				return nil
+			}
+
+			line := fmt.Sprintf("%s #%d", keyword, issue.IID)
+			if related {
+				line = fmt.Sprintf("Related to #%d", issue.IID)
+			}
+
+			if dryRun {
+				_, _ = fmt.Fprintf(out, "Would add %q to the description of !%d\n", line, mr.IID)
+				return nil
+			}
+
+			description := strings.TrimRight(mr.Description, "\n")
+			if description != "" {
+				description += "\n\n"
+			}
+			description += line
+
+			_, resp, err = client.MergeRequests.UpdateMergeRequest(project, mrID, &gitlab.UpdateMergeRequestOptions{
+				Description: &description,
+			})
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/merge_requests/%d", api.APIURL(client.Host()), project, mrID)
+				return errors.NewAPIError("PUT", url, statusCode, fmt.Sprintf("Failed to update merge request !%d", mrID), err)
+			}
+
+			_, _ = fmt.Fprintf(out, "Added %q to !%d\n", line, mr.IID)
+
+			if related {
+				return nil
+			}
+
+			closes, _, err := client.MergeRequests.GetIssuesClosedOnMerge(project, mrID, nil)
+			if err != nil {
+				return nil
+			}
+			for _, i := range closes {
+				if i.ID == issue.ID {
+					_, _ = fmt.Fprintf(out, "#%d will be closed when !%d is merged\n", issue.IID, mr.IID)
+					return nil
+				}
+			}
+			_, _ = fmt.Fprintf(errOut, "Warning: GitLab does not list #%d among the issues closed by !%d\n", issue.IID, mr.IID)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&keyword, "keyword", "k", "Closes", "Closing keyword to use (e.g. Closes, Fixes, Resolves)")
+	cmd.Flags().BoolVar(&related, "related", false, "Add a non-closing \"Related to\" reference instead")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Validate and show the change without updating the merge request")
+
+	return cmd
+}
+
+// closingPatternProblems returns reasons why a closing pattern in the merge
+// request would not close the issue on merge.
+func closingPatternProblems(project *gitlab.Project, issue *gitlab.Issue, mr *gitlab.MergeRequest) []string {
+	var problems []string
+	if !project.AutocloseReferencedIssues {
+		problems = append(problems, "automatic issue closing is disabled for this project (Settings > Repository > Default branch)")
+	}
+	if project.DefaultBranch != "" && mr.TargetBranch != project.DefaultBranch {
+		problems = append(problems, fmt.Sprintf("!%d targets %s, but issues are only closed by merges into the default branch %s", mr.IID, mr.TargetBranch, project.DefaultBranch))
+	}
+	if mr.State != "opened" {
+		problems = append(problems, fmt.Sprintf("!%d is %s, so its description no longer closes issues", mr.IID, mr.State))
+	}
+	if issue.State != "opened" {
+		problems = append(problems, fmt.Sprintf("#%d is already %s", issue.IID, issue.State))
+	}
+	return problems
+}
+
+// hasIssueReference reports whether the description already contains a
+// closing pattern (or, when related is set, any reference) for the issue.
+func hasIssueReference(description string, iid int64, related bool) bool {
+	ref := fmt.Sprintf(`#%d\b`, iid)
+	pattern := `(?i)\b(?:` + strings.Join(closingKeywords, "|") + `):?\s+` + ref
+	if related {
+		pattern = ref
+	}
+	return regexp.MustCompile(pattern).MatchString(description)
+}
+
+func isClosingKeyword(keyword string) bool {
+	for _, k := range closingKeywords {
+		if strings.EqualFold(k, keyword) {
+			return true
+		}
+	}
+	return false
+}
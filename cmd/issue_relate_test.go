@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestHasIssueReference(t *testing.T) {
+	tests := []struct {
+		description string
+		related     bool
+		want        bool
+	}{
+		{"Closes #12", false, true},
+		{"Some text\n\nfixes: #12", false, true},
+		{"Related to #12", false, false},
+		{"Closes #123", false, false},
+		{"Related to #12", true, true},
+		{"", true, false},
+	}
+	for _, tt := range tests {
+		if got := hasIssueReference(tt.description, 12, tt.related); got != tt.want {
+			t.Errorf("hasIssueReference(%q, related=%v) = %v, want %v", tt.description, tt.related, got, tt.want)
+		}
+	}
+}
+
+func TestClosingPatternProblems(t *testing.T) {
+	project := &gitlab.Project{AutocloseReferencedIssues: true, DefaultBranch: "main"}
+	issue := &gitlab.Issue{IID: 1, State: "opened"}
+	mr := &gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{IID: 2, State: "opened", TargetBranch: "main"}}
+
+	if problems := closingPatternProblems(project, issue, mr); len(problems) != 0 {
+		t.Errorf("expected no problems, got %v", problems)
+	}
+
+	project.AutocloseReferencedIssues = false
+	mr.TargetBranch = "develop"
+	if problems := closingPatternProblems(project, issue, mr); len(problems) != 2 {
+		t.Errorf("expected 2 problems, got %v", problems)
+	}
+}
+
+func TestIssueRelateToMR(t *testing.T) {
+	var updated map[string]any
+
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/issues/12"):
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 500, "iid": 12, "state": "opened"})
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/merge_requests/34"):
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 900, "iid": 34, "state": "opened", "target_branch": "develop", "description": "Adds login."})
+		case r.Method == http.MethodPut && strings.HasSuffix(r.URL.Path, "/merge_requests/34"):
+			_ = json.NewDecoder(r.Body).Decode(&updated)
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 900, "iid": 34})
+		case strings.HasSuffix(r.URL.Path, "/closes_issues"):
+			cmdtest.JSONResponse(w, 200, []map[string]any{})
+		case strings.HasSuffix(r.URL.Path, "/projects/test-owner/test-repo"):
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 1, "default_branch": "main", "autoclose_referenced_issues": true})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueRelateToMRCmd(f.Factory)
+	cmd.SetArgs([]string{"12", "34", "--keyword", "Fixes"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if updated["description"] != "Adds login.\n\nFixes #12" {
+		t.Errorf("unexpected updated description: %q", updated["description"])
+	}
+	errOut := f.IO.ErrString()
+	if !strings.Contains(errOut, "only closed by merges into the default branch main") {
+		t.Errorf("expected target branch warning, got: %s", errOut)
+	}
+	if !strings.Contains(errOut, "does not list #12") {
+		t.Errorf("expected verification warning, got: %s", errOut)
+	}
+}
+
+func TestIssueRelateToMR_InvalidKeyword(t *testing.T) {
+	f := newTestFactory()
+	cmd := newIssueRelateToMRCmd(f)
+	cmd.SetArgs([]string{"1", "2", "--keyword", "Kills"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "invalid closing keyword") {
+		t.Fatalf("expected invalid keyword error, got %v", err)
+	}
+}
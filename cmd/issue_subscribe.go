@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func newIssueSubscribeCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "subscribe <id>",
+		Short:   "Subscribe to notifications for an issue",
+		Example: `  $ glab issue subscribe 42`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			issueID, err := parseIssueArg(args)
+			if err != nil {
+				return err
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			_, resp, err := client.Issues.SubscribeToIssue(project, issueID, gitlab.WithContext(cmd.Context()))
+			if resp != nil && resp.StatusCode == http.StatusNotModified {
+				_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Already subscribed to issue #%d\n", issueID)
+				return nil
+			}
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/issues/%d/subscribe", api.APIURL(client.Host()), project, issueID)
+				return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to subscribe to issue #%d", issueID), err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Subscribed to issue #%d\n", issueID)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newIssueUnsubscribeCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unsubscribe <id>",
+		Short:   "Unsubscribe from notifications for an issue",
+		Example: `  $ glab issue unsubscribe 42`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			issueID, err := parseIssueArg(args)
+			if err != nil {
+				return err
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			_, resp, err := client.Issues.UnsubscribeFromIssue(project, issueID, gitlab.WithContext(cmd.Context()))
+			if resp != nil && resp.StatusCode == http.StatusNotModified {
+				_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Not subscribed to issue #%d\n", issueID)
+				return nil
+			}
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/issues/%d/unsubscribe", api.APIURL(client.Host()), project, issueID)
+				return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to unsubscribe from issue #%d", issueID), err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Unsubscribed from issue #%d\n", issueID)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newIssueTodoCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "todo <id>",
+		Short:   "Add an issue to your to-do list",
+		Example: `  $ glab issue todo 42`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			issueID, err := parseIssueArg(args)
+			if err != nil {
+				return err
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			_, resp, err := client.Issues.CreateTodo(project, issueID, gitlab.WithContext(cmd.Context()))
+			if resp != nil && resp.StatusCode == http.StatusNotModified {
+				_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Issue #%d is already on your to-do list\n", issueID)
+				return nil
+			}
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/issues/%d/todo", api.APIURL(client.Host()), project, issueID)
+				return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to add issue #%d to your to-do list", issueID), err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Added issue #%d to your to-do list\n", issueID)
+			return nil
+		},
+	}
+
+	return cmd
+}
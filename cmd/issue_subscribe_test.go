@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestIssueSubscribe(t *testing.T) {
+	called := false
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/issues/42/subscribe" {
+			called = true
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": 1, "iid": 42, "subscribed": true})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueSubscribeCmd(f.Factory)
+	cmd.SetArgs([]string{"#42"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !called || !strings.Contains(f.IO.String(), "Subscribed to issue #42") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestIssueUnsubscribe_NotSubscribed(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotModified)
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueUnsubscribeCmd(f.Factory)
+	cmd.SetArgs([]string{"42"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(f.IO.ErrString(), "Not subscribed to issue #42") {
+		t.Errorf("unexpected stderr: %q", f.IO.ErrString())
+	}
+}
+
+func TestIssueTodo(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/issues/42/todo" {
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": 5, "action_name": "marked"})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueTodoCmd(f.Factory)
+	cmd.SetArgs([]string{"42"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(f.IO.String(), "Added issue #42 to your to-do list") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"encoding/json"
 	"net/http"
 	"strings"
 	"testing"
@@ -33,7 +34,20 @@ func TestIssueCmd_HasSubcommands(t *testing.T) {
 		"reopen",
 		"comment",
 		"edit",
+		"bulk-edit",
 		"delete",
+		"metrics",
+		"relate-to-mr",
+		"link",
+		"unlink",
+		"links",
+		"subscribe",
+		"unsubscribe",
+		"todo",
+		"attach",
+		"develop",
+		"move",
+		"clone",
 	}
 
 	subcommands := cmd.Commands()
@@ -133,7 +147,7 @@ func TestIssueViewCmd_Flags(t *testing.T) {
 	f := newTestFactory()
 	cmd := newIssueViewCmd(f)
 
-	expectedFlags := []string{"web", "json"}
+	expectedFlags := []string{"web", "raw", "comments", "since", "json"}
 
 	for _, flagName := range expectedFlags {
 		flag := cmd.Flags().Lookup(flagName)
@@ -326,6 +340,58 @@ func TestIssueView_Success(t *testing.T) {
 	}
 }
 
+func TestIssueView_JQ(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		cmdtest.JSONResponse(w, 200, map[string]any{
+			"id": 200, "iid": 10, "state": "opened",
+			"web_url": "https://gitlab.com/test-owner/test-repo/-/issues/10",
+		})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueViewCmd(f.Factory)
+	cmd.SetArgs([]string{"10", "--jq", ".state + \" \" + .web_url"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := f.IO.String(); got != "opened https://gitlab.com/test-owner/test-repo/-/issues/10\n" {
+		t.Errorf("unexpected output: %q", got)
+	}
+}
+
+func TestIssueView_Color(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		cmdtest.JSONResponse(w, 200, map[string]any{
+			"id": 200, "iid": 10, "title": "Crash", "state": "closed",
+			"author": map[string]any{"username": "alice"},
+		})
+	})
+
+	t.Setenv("NO_COLOR", "")
+	t.Setenv("CLICOLOR_FORCE", "1")
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueViewCmd(f.Factory)
+	cmd.SetArgs([]string{"10"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(f.IO.String(), "State:   \x1b[31m✗ closed\x1b[0m") {
+		t.Errorf("expected colored state, got %q", f.IO.String())
+	}
+
+	f = cmdtest.NewTestFactory(t)
+	f.Factory.IOStreams.DisableColor()
+	cmd = newIssueViewCmd(f.Factory)
+	cmd.SetArgs([]string{"10"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(f.IO.String(), "State:   closed\n") {
+		t.Errorf("expected plain state with color disabled, got %q", f.IO.String())
+	}
+}
+
 func TestIssueCreate_Success(t *testing.T) {
 	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
 		if r.Method == "POST" && strings.Contains(r.URL.Path, "/issues") {
@@ -350,6 +416,29 @@ func TestIssueCreate_Success(t *testing.T) {
 	}
 }
 
+func TestIssueCreate_DefaultLabels(t *testing.T) {
+	var body map[string]interface{}
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" && strings.Contains(r.URL.Path, "/issues") {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 201, cmdtest.FixtureIssueOpen)
+			return
+		}
+		cmdtest.JSONResponse(w, 200, map[string]interface{}{})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	f.Config.Defaults.IssueLabels = "triage"
+	cmd := newIssueCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"--title", "Test Issue"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body["labels"] != "triage" {
+		t.Errorf("expected labels triage, got %v", body["labels"])
+	}
+}
+
 func TestIssueClose_Success(t *testing.T) {
 	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
 		if r.Method == "PUT" && strings.Contains(r.URL.Path, "/issues/1") {
@@ -0,0 +1,569 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/browser"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// NewJobCmd creates the job command group.
+func NewJobCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "job <command>",
+		Short: "Manage CI/CD jobs",
+		Long:  "List, inspect, run, and download artifacts from CI/CD jobs.",
+	}
+
+	cmd.AddCommand(newJobListCmd(f))
+	cmd.AddCommand(newJobViewCmd(f))
+	cmd.AddCommand(newJobLogCmd(f))
+	cmd.AddCommand(newJobRetryCmd(f))
+	cmd.AddCommand(newJobCancelCmd(f))
+	cmd.AddCommand(newJobPlayCmd(f))
+	cmd.AddCommand(newJobArtifactsCmd(f))
+
+	return cmd
+}
+
+func newJobListCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		pipelineID int64
+		statuses   []string
+		limit      int
+		format     string
+		jsonFlag   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List jobs",
+		Aliases: []string{"ls"},
+		Long:    "List the project's most recent jobs, or the jobs of a single pipeline with --pipeline.",
+		Example: `  $ glab job list
+  $ glab job list --pipeline 12345
+  $ glab job list --status failed,manual --limit 10`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			opts := &gitlab.ListJobsOptions{
+				ListOptions: gitlab.ListOptions{PerPage: int64(limit)},
+			}
+			if len(statuses) > 0 {
+				scope := make([]gitlab.BuildStateValue, len(statuses))
+				for i, s := range statuses {
+					scope[i] = gitlab.BuildStateValue(s)
+				}
+				opts.Scope = &scope
+			}
+
+			var (
+				jobs []*gitlab.Job
+				resp *gitlab.Response
+				url  = api.APIURL(client.Host()) + "/projects/" + project + "/jobs"
+			)
+			if pipelineID != 0 {
+				url = api.APIURL(client.Host()) + "/projects/" + project + "/pipelines/" + strconv.FormatInt(pipelineID, 10) + "/jobs"
+				jobs, resp, err = client.Jobs.ListPipelineJobs(project, pipelineID, opts, gitlab.WithContext(cmd.Context()))
+			} else {
+				jobs, resp, err = client.Jobs.ListProjectJobs(project, opts, gitlab.WithContext(cmd.Context()))
+			}
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				return errors.NewAPIError("GET", url, statusCode, "Failed to list jobs", err)
+			}
+
+			if len(jobs) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No jobs found. Try adjusting filters or increase --limit.")
+				return nil
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(jobs, format, jsonFlag)
+			}
+
+			cs := f.IOStreams.ColorScheme()
+			tp := f.NewTablePrinter()
+			tp.SetHeader("ID", "NAME", "STAGE", "STATUS", "REF", "DURATION")
+			for _, j := range jobs {
+				tp.AddRow(
+					fmt.Sprintf("%d", j.ID),
+					j.Name,
+					j.Stage,
+					cs.ForState(j.Status, j.Status),
+					j.Ref,
+					fmt.Sprintf("%.0fs", j.Duration),
+				)
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().Int64VarP(&pipelineID, "pipeline", "p", 0, "List jobs of this pipeline only")
+	cmd.Flags().StringSliceVar(&statuses, "status", nil, "Filter by status: created, pending, running, failed, success, canceled, skipped, manual")
+	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+func newJobViewCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		web      bool
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "view <job-id>",
+		Short: "View a job",
+		Example: `  $ glab job view 67890
+  $ glab job view 67890 --web`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			jobID, err := parseJobArg(args)
+			if err != nil {
+				return err
+			}
+
+			job, err := getJob(cmd, client, project, jobID)
+			if err != nil {
+				return err
+			}
+
+			if web {
+				return browser.Open(job.WebURL)
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(job, format, jsonFlag)
+			}
+
+			out := f.IOStreams.Out
+			cs := f.IOStreams.ColorScheme()
+			_, _ = fmt.Fprintf(out, "%s\n", cs.Bold(fmt.Sprintf("Job #%d %s", job.ID, job.Name)))
+			_, _ = fmt.Fprintf(out, "Status:    %s\n", cs.State(job.Status))
+			if job.FailureReason != "" {
+				_, _ = fmt.Fprintf(out, "Reason:    %s\n", job.FailureReason)
+			}
+			if job.AllowFailure {
+				_, _ = fmt.Fprintln(out, "Allowed to fail: yes")
+			}
+			_, _ = fmt.Fprintf(out, "Stage:     %s\n", job.Stage)
+			_, _ = fmt.Fprintf(out, "Ref:       %s\n", job.Ref)
+			_, _ = fmt.Fprintf(out, "Pipeline:  #%d\n", job.Pipeline.ID)
+			if job.Commit != nil {
+				_, _ = fmt.Fprintf(out, "Commit:    %s %s\n", shortSHA(job.Commit.ID), job.Commit.Title)
+			}
+			if job.Runner.Description != "" {
+				_, _ = fmt.Fprintf(out, "Runner:    %s\n", job.Runner.Description)
+			}
+			if job.User != nil {
+				_, _ = fmt.Fprintf(out, "User:      %s\n", job.User.Username)
+			}
+			_, _ = fmt.Fprintf(out, "Created:   %s\n", timeAgo(job.CreatedAt))
+			if job.StartedAt != nil {
+				_, _ = fmt.Fprintf(out, "Started:   %s\n", timeAgo(job.StartedAt))
+			}
+			if job.FinishedAt != nil {
+				_, _ = fmt.Fprintf(out, "Finished:  %s\n", timeAgo(job.FinishedAt))
+			}
+			if job.Duration > 0 {
+				_, _ = fmt.Fprintf(out, "Duration:  %.0fs\n", job.Duration)
+			}
+			if job.Coverage > 0 {
+				_, _ = fmt.Fprintf(out, "Coverage:  %.2f%%\n", job.Coverage)
+			}
+			if len(job.Artifacts) > 0 {
+				_, _ = fmt.Fprintln(out, "Artifacts:")
+				for _, a := range job.Artifacts {
+					_, _ = fmt.Fprintf(out, "  %s (%s, %s)\n", a.Filename, a.FileType, byteCountSI(a.Size))
+				}
+			}
+			_, _ = fmt.Fprintf(out, "URL:       %s\n", job.WebURL)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open in browser")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+func newJobLogCmd(f *cmdutil.Factory) *cobra.Command {
+	var follow bool
+
+	cmd := &cobra.Command{
+		Use:     "log <job-id>",
+		Short:   "View the log/trace of a job",
+		Aliases: []string{"trace"},
+		Example: `  $ glab job log 67890
+  $ glab job log 67890 --follow`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			jobID, err := parseJobArg(args)
+			if err != nil {
+				return err
+			}
+
+			if follow {
+				return followJobLog(cmd.Context(), f, client, project, int(jobID))
+			}
+			return printJobTrace(cmd, f, client, project, jobID)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&follow, "follow", "f", false, "Stream job log in real-time")
+
+	return cmd
+}
+
+func newJobRetryCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "retry <job-id>",
+		Short:   "Retry a job",
+		Example: `  $ glab job retry 67890`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			jobID, err := parseJobArg(args)
+			if err != nil {
+				return err
+			}
+
+			job, resp, err := client.Jobs.RetryJob(project, jobID, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/jobs/%d/retry", api.APIURL(client.Host()), project, jobID)
+				return errors.NewAPIError("POST", url, statusCode, "Failed to retry job", err)
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(job, format, jsonFlag)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Retried job #%d as #%d (status: %s)\n", jobID, job.ID, job.Status)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+func newJobCancelCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "cancel <job-id>",
+		Short:   "Cancel a running job",
+		Example: `  $ glab job cancel 67890`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			jobID, err := parseJobArg(args)
+			if err != nil {
+				return err
+			}
+
+			job, resp, err := client.Jobs.CancelJob(project, jobID, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/jobs/%d/cancel", api.APIURL(client.Host()), project, jobID)
+				return errors.NewAPIError("POST", url, statusCode, "Failed to cancel job", err)
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(job, format, jsonFlag)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Canceled job #%d (status: %s)\n", job.ID, job.Status)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+func newJobPlayCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		variables []string
+		format    string
+		jsonFlag  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "play <job-id>",
+		Short: "Run a manual job",
+		Long: `Trigger a manual job, such as a deployment gated behind "when: manual".
+
+Use --variables to pass CI/CD variables to the job run.`,
+		Example: `  $ glab job play 67890
+  $ glab job play 67890 --variables ENVIRONMENT=staging --variables DRY_RUN=false`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			jobID, err := parseJobArg(args)
+			if err != nil {
+				return err
+			}
+
+			opts := &gitlab.PlayJobOptions{}
+			if len(variables) > 0 {
+				attrs := make([]*gitlab.JobVariableOptions, 0, len(variables))
+				for _, v := range variables {
+					key, value, ok := strings.Cut(v, "=")
+					if !ok || key == "" {
+						return fmt.Errorf("invalid variable format: %s (use KEY=value)", v)
+					}
+					attrs = append(attrs, &gitlab.JobVariableOptions{
+						Key:   gitlab.Ptr(key),
+						Value: gitlab.Ptr(value),
+					})
+				}
+				opts.JobVariablesAttributes = &attrs
+			}
+
+			job, resp, err := client.Jobs.PlayJob(project, jobID, opts, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/jobs/%d/play", api.APIURL(client.Host()), project, jobID)
+				return errors.NewAPIError("POST", url, statusCode, "Failed to play job", err)
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(job, format, jsonFlag)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Started job #%d %q (status: %s)\n", job.ID, job.Name, job.Status)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&variables, "variables", nil, "Job variables (KEY=value)")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+func newJobArtifactsCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		outputPath string
+		paths      []string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "artifacts <job-id>",
+		Short: "Download job artifacts",
+		Long: `Download the artifacts archive of a job.
+
+With --path, only the given files are downloaded from the archive, without
+fetching the whole zip. --output sets the destination of the archive or of a
+single extracted file; other extracted files are written to their base name.`,
+		Example: `  $ glab job artifacts 67890
+  $ glab job artifacts 67890 --output build.zip
+  $ glab job artifacts 67890 --path coverage/report.xml
+  $ glab job artifacts 67890 --path dist/app --path dist/app.sha256`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if outputPath != "" && len(paths) > 1 {
+				return fmt.Errorf("--output cannot be used with more than one --path")
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			jobID, err := parseJobArg(args)
+			if err != nil {
+				return err
+			}
+
+			out := f.IOStreams.Out
+
+			for _, p := range paths {
+				reader, resp, err := client.Jobs.DownloadSingleArtifactsFile(project, jobID, p, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/jobs/%d/artifacts/%s", api.APIURL(client.Host()), project, jobID, p)
+					return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to download artifact %s", p), err)
+				}
+
+				dest := outputPath
+				if dest == "" {
+					dest = filepath.Base(p)
+				}
+				written, err := cmdutil.WriteFileAtomic(dest, reader, 0o644)
+				if err != nil {
+					return fmt.Errorf("writing %s: %w", dest, err)
+				}
+				_, _ = fmt.Fprintf(out, "Extracted %s to %s (%d bytes)\n", p, dest, written)
+			}
+			if len(paths) > 0 {
+				return nil
+			}
+
+			reader, resp, err := client.Jobs.GetJobArtifacts(project, jobID, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/jobs/%d/artifacts", api.APIURL(client.Host()), project, jobID)
+				return errors.NewAPIError("GET", url, statusCode, "Failed to download job artifacts", err)
+			}
+
+			if outputPath == "" {
+				outputPath = "artifacts.zip"
+			}
+			written, err := cmdutil.WriteFileAtomic(outputPath, reader, 0o644)
+			if err != nil {
+				return fmt.Errorf("writing artifacts to file: %w", err)
+			}
+
+			_, _ = fmt.Fprintf(out, "Downloaded artifacts to %s (%d bytes)\n", outputPath, written)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Output file path (default: artifacts.zip, or the file's base name with --path)")
+	cmd.Flags().StringArrayVar(&paths, "path", nil, "Download only this file from the artifacts (repeatable)")
+
+	return cmd
+}
+
+// newPipelinePlayJobCmd exposes "job play" under the pipeline group, next to
+// the other per-job pipeline commands.
+func newPipelinePlayJobCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := newJobPlayCmd(f)
+	cmd.Use = "play-job <job-id>"
+	cmd.Example = `  $ glab pipeline play-job 67890
+  $ glab pipeline play-job 67890 --variables ENVIRONMENT=production`
+	return cmd
+}
+
+// parseJobArg parses a job ID, optionally prefixed with "#", from args.
+func parseJobArg(args []string) (int64, error) {
+	if len(args) == 0 {
+		return 0, fmt.Errorf("job ID required")
+	}
+	id := strings.TrimPrefix(args[0], "#")
+	n, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid job ID: %s", args[0])
+	}
+	return n, nil
+}
+
+func getJob(cmd *cobra.Command, client *api.Client, project string, jobID int64) (*gitlab.Job, error) {
+	job, resp, err := client.Jobs.GetJob(project, jobID, gitlab.WithContext(cmd.Context()))
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := fmt.Sprintf("%s/projects/%s/jobs/%d", api.APIURL(client.Host()), project, jobID)
+		return nil, errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get job #%d", jobID), err)
+	}
+	return job, nil
+}
@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/joblog"
+	"github.com/PhilipKram/gitlab-cli/pkg/iostreams"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// jobLogPollInterval is how often followJobLog checks a running job for new
+// output.
+var jobLogPollInterval = 2 * time.Second
+
+// followJobLog polls a job's trace and prints new output until the job
+// finishes or ctx is canceled.
+func followJobLog(ctx context.Context, f *cmdutil.Factory, client *api.Client, project string, jobID int) error {
+	var offset int64
+	jobIDInt64 := int64(jobID)
+
+	w := newJobLogWriter(f.IOStreams.Out, f.IOStreams.ColorScheme())
+	defer w.Flush()
+
+	ticker := time.NewTicker(jobLogPollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Get job status before the trace so the last poll sees all output
+		job, _, err := client.Jobs.GetJob(project, jobIDInt64, gitlab.WithContext(ctx))
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("getting job status: %w", err)
+		}
+
+		n, err := writeTraceFrom(ctx, client, project, jobIDInt64, offset, w)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("getting job trace: %w", err)
+		}
+		offset += n
+
+		jobFinished := job.Status == "success" || job.Status == "failed" ||
+			job.Status == "canceled" || job.Status == "skipped"
+		if jobFinished {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// writeTraceFrom writes the part of a job's trace after offset to w and
+// returns the number of bytes written. Only the new bytes are requested,
+// with a Range header; when the server ignores it and sends the whole
+// trace, the part already seen is skipped.
+func writeTraceFrom(ctx context.Context, client *api.Client, project string, jobID, offset int64, w io.Writer) (int64, error) {
+	opts := []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)}
+	if offset > 0 {
+		opts = append(opts, gitlab.WithHeader("Range", fmt.Sprintf("bytes=%d-", offset)))
+	}
+
+	reader, resp, err := client.Jobs.GetTraceFile(project, jobID, opts...)
+	if err != nil {
+		// The client library reports any status it does not expect as an
+		// error; a partial response carries the new bytes in its body.
+		var errResp *gitlab.ErrorResponse
+		switch {
+		case errors.As(err, &errResp) && errResp.Response != nil && errResp.Response.StatusCode == http.StatusPartialContent:
+			return io.Copy(w, bytes.NewReader(errResp.Body))
+		case resp != nil && resp.StatusCode == http.StatusRequestedRangeNotSatisfiable:
+			// Nothing new since the last poll
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	if offset > 0 {
+		if _, err := reader.Seek(offset, io.SeekStart); err != nil {
+			return 0, err
+		}
+	}
+	return io.Copy(w, reader)
+}
+
+// jobLogWriter writes a job trace line by line, replacing the section
+// markers GitLab puts in the log with a highlighted header when a section
+// starts and its duration when it ends.
+type jobLogWriter struct {
+	out     io.Writer
+	cs      *iostreams.ColorScheme
+	partial []byte
+	starts  map[string]time.Time
+}
+
+func newJobLogWriter(out io.Writer, cs *iostreams.ColorScheme) *jobLogWriter {
+	return &jobLogWriter{out: out, cs: cs, starts: make(map[string]time.Time)}
+}
+
+// Write prints every complete line in p and keeps the rest until the line
+// is completed by a later write or Flush.
+func (w *jobLogWriter) Write(p []byte) (int, error) {
+	w.partial = append(w.partial, p...)
+	for {
+		i := bytes.IndexByte(w.partial, '\n')
+		if i < 0 {
+			break
+		}
+		line := string(w.partial[:i])
+		w.partial = w.partial[i+1:]
+		if err := w.writeLine(line, true); err != nil {
+			return len(p), err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush prints a trailing line that has no newline yet.
+func (w *jobLogWriter) Flush() {
+	if len(w.partial) > 0 {
+		_ = w.writeLine(string(w.partial), false)
+		w.partial = nil
+	}
+}
+
+func (w *jobLogWriter) writeLine(line string, newline bool) error {
+	var started string
+	hadMarker := false
+	for {
+		sec, rest, ok := joblog.ParseSection(line)
+		if !ok {
+			break
+		}
+		hadMarker = true
+		line = rest
+		if !sec.End {
+			w.starts[sec.Name] = sec.Time
+			started = sec.Name
+			continue
+		}
+		if start, ok := w.starts[sec.Name]; ok {
+			delete(w.starts, sec.Name)
+			summary := fmt.Sprintf("◂ %s (%s)", sec.Name, sec.Time.Sub(start))
+			if _, err := fmt.Fprintln(w.out, w.cs.Gray(summary)); err != nil {
+				return err
+			}
+		}
+	}
+
+	switch {
+	case started != "":
+		if strings.TrimSpace(joblog.Clean(line)) == "" {
+			line = started
+		}
+		line = w.cs.Cyan("▸ ") + line
+	case hadMarker && line == "":
+		// The line held only section end markers
+		return nil
+	}
+
+	if newline {
+		line += "\n"
+	}
+	_, err := io.WriteString(w.out, line)
+	return err
+}
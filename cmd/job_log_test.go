@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+// mockRunningJobTrace serves a job that is running for the first poll and
+// finished for the second, whose trace grows from first to first+second.
+// When honorRange is false the Range header is ignored, like older servers.
+func mockRunningJobTrace(t *testing.T, first, second string, honorRange bool) *[]string {
+	t.Helper()
+	old := jobLogPollInterval
+	jobLogPollInterval = time.Millisecond
+	t.Cleanup(func() { jobLogPollInterval = old })
+
+	var ranges []string
+	polls := 0
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v4/projects/test-owner/test-repo/jobs/123":
+			polls++
+			status := "running"
+			if polls > 1 {
+				status = "success"
+			}
+			cmdtest.JSONResponse(w, 200, map[string]interface{}{"id": 123, "status": status})
+		case "/api/v4/projects/test-owner/test-repo/jobs/123/trace":
+			ranges = append(ranges, r.Header.Get("Range"))
+			trace := first
+			if polls > 1 {
+				trace += second
+			}
+			if rng := r.Header.Get("Range"); rng != "" && honorRange {
+				w.WriteHeader(http.StatusPartialContent)
+				_, _ = w.Write([]byte(trace[len(first):]))
+				return
+			}
+			_, _ = w.Write([]byte(trace))
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+	return &ranges
+}
+
+func TestFollowJobLog_RangeRequests(t *testing.T) {
+	ranges := mockRunningJobTrace(t, "line 1\n", "line 2\n", true)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newPipelineJobLogCmd(f.Factory)
+	cmd.SetArgs([]string{"123", "--follow"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := f.IO.String(); got != "line 1\nline 2\n" {
+		t.Errorf("output = %q", got)
+	}
+	if len(*ranges) != 2 || (*ranges)[0] != "" || (*ranges)[1] != "bytes=7-" {
+		t.Errorf("Range headers = %q, want [\"\" \"bytes=7-\"]", *ranges)
+	}
+}
+
+func TestFollowJobLog_RangeIgnored(t *testing.T) {
+	mockRunningJobTrace(t, "line 1\n", "line 2\n", false)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newPipelineJobLogCmd(f.Factory)
+	cmd.SetArgs([]string{"123", "--follow"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := f.IO.String(); got != "line 1\nline 2\n" {
+		t.Errorf("output = %q, want each line once", got)
+	}
+}
+
+func TestFollowJobLog_Sections(t *testing.T) {
+	mockRunningJobTrace(t,
+		"section_start:1700000000:step_script\r\x1b[0KExecuting step\n",
+		"make test\nsection_end:1700000042:step_script\r\x1b[0K\nJob succeeded",
+		true)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newJobLogCmd(f.Factory)
+	cmd.SetArgs([]string{"123", "--follow"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "▸ Executing step\nmake test\n◂ step_script (42s)\nJob succeeded"
+	if got := f.IO.String(); got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
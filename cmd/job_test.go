@@ -0,0 +1,228 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestNewJobCmd(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewJobCmd(f)
+
+	if cmd.Use != "job <command>" {
+		t.Errorf("expected Use to be 'job <command>', got %q", cmd.Use)
+	}
+
+	if cmd.Short != "Manage CI/CD jobs" {
+		t.Errorf("expected Short to be 'Manage CI/CD jobs', got %q", cmd.Short)
+	}
+}
+
+func TestJobCmd_HasSubcommands(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewJobCmd(f)
+
+	expectedSubcommands := []string{
+		"list",
+		"view",
+		"log",
+		"retry",
+		"cancel",
+		"play",
+		"artifacts",
+	}
+
+	subcommands := cmd.Commands()
+	if len(subcommands) != len(expectedSubcommands) {
+		t.Errorf("expected %d subcommands, got %d", len(expectedSubcommands), len(subcommands))
+	}
+
+	foundSubcommands := make(map[string]bool)
+	for _, subcmd := range subcommands {
+		foundSubcommands[subcmd.Name()] = true
+	}
+
+	for _, expected := range expectedSubcommands {
+		if !foundSubcommands[expected] {
+			t.Errorf("expected subcommand %q not found", expected)
+		}
+	}
+}
+
+func TestParseJobArg(t *testing.T) {
+	tests := []struct {
+		args    []string
+		want    int64
+		wantErr string
+	}{
+		{args: []string{"123"}, want: 123},
+		{args: []string{"#456"}, want: 456},
+		{args: []string{"abc"}, wantErr: "invalid job ID: abc"},
+		{args: nil, wantErr: "job ID required"},
+	}
+
+	for _, tt := range tests {
+		got, err := parseJobArg(tt.args)
+		if tt.wantErr != "" {
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("parseJobArg(%v) error = %v, want %q", tt.args, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("parseJobArg(%v) = %d, %v; want %d", tt.args, got, err, tt.want)
+		}
+	}
+}
+
+func TestJobList_Pipeline(t *testing.T) {
+	var path, query string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		query = r.URL.RawQuery
+		cmdtest.JSONResponse(w, 200, []map[string]any{
+			{"id": 7, "name": "deploy", "stage": "deploy", "status": "manual", "ref": "main"},
+		})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newJobListCmd(f.Factory)
+	cmd.SetArgs([]string{"--pipeline", "12", "--status", "manual"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.HasSuffix(path, "/pipelines/12/jobs") {
+		t.Errorf("expected pipeline jobs endpoint, got %s", path)
+	}
+	if !strings.Contains(query, "scope%5B%5D=manual") {
+		t.Errorf("expected scope filter, got %s", query)
+	}
+	if !strings.Contains(f.IO.String(), "deploy") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestJobView(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/jobs/7") {
+			cmdtest.JSONResponse(w, 200, map[string]any{
+				"id": 7, "name": "unit", "stage": "test", "status": "failed",
+				"failure_reason": "script_failure", "ref": "main",
+				"pipeline": map[string]any{"id": 12},
+				"web_url":  "https://gitlab.com/test-owner/test-repo/-/jobs/7",
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newJobViewCmd(f.Factory)
+	cmd.SetArgs([]string{"7"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := f.IO.String()
+	for _, want := range []string{"Job #7 unit", "Reason:    script_failure", "Pipeline:  #12"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestJobPlay_WithVariables(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/jobs/7/play") {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 7, "name": "deploy", "status": "pending"})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newJobPlayCmd(f.Factory)
+	cmd.SetArgs([]string{"7", "--variables", "ENV=staging"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	vars, _ := body["job_variables_attributes"].([]any)
+	if len(vars) != 1 {
+		t.Fatalf("expected one job variable, got %v", body)
+	}
+	v, _ := vars[0].(map[string]any)
+	if v["key"] != "ENV" || v["value"] != "staging" {
+		t.Errorf("unexpected job variable: %v", v)
+	}
+	if !strings.Contains(f.IO.String(), `Started job #7 "deploy"`) {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestJobPlay_InvalidVariable(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newJobPlayCmd(f.Factory)
+	cmd.SetArgs([]string{"7", "--variables", "NOVALUE"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "invalid variable format") {
+		t.Fatalf("expected invalid variable error, got %v", err)
+	}
+}
+
+func TestJobArtifacts_SingleFile(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/jobs/7/artifacts/coverage/report.xml") {
+			_, _ = w.Write([]byte("<coverage/>"))
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	dest := filepath.Join(t.TempDir(), "report.xml")
+	f := cmdtest.NewTestFactory(t)
+	cmd := newJobArtifactsCmd(f.Factory)
+	cmd.SetArgs([]string{"7", "--path", "coverage/report.xml", "--output", dest})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := os.ReadFile(dest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "<coverage/>" {
+		t.Errorf("unexpected file content: %q", data)
+	}
+}
+
+func TestJobArtifacts_OutputWithMultiplePaths(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newJobArtifactsCmd(f.Factory)
+	cmd.SetArgs([]string{"7", "--path", "a", "--path", "b", "--output", "x"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "--output cannot be used") {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
+
+func TestPipelinePlayJobCmd(t *testing.T) {
+	f := newTestFactory()
+	cmd := newPipelinePlayJobCmd(f)
+
+	if cmd.Use != "play-job <job-id>" {
+		t.Errorf("expected Use 'play-job <job-id>', got %q", cmd.Use)
+	}
+	if cmd.Flags().Lookup("variables") == nil {
+		t.Error("expected --variables flag")
+	}
+}
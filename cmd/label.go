@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/PhilipKram/gitlab-cli/internal/api"
@@ -17,12 +16,19 @@ func NewLabelCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "label <command>",
 		Short: "Manage labels",
-		Long:  "Create, list, and delete project labels.",
+		Long: `Create, list, edit, and delete project and group labels, and keep them in
+sync with a file.
+
+Use --group to operate on the labels of a group instead of the current
+project.`,
 	}
 
 	cmd.AddCommand(newLabelCreateCmd(f))
 	cmd.AddCommand(newLabelListCmd(f))
+	cmd.AddCommand(newLabelEditCmd(f))
 	cmd.AddCommand(newLabelDeleteCmd(f))
+	cmd.AddCommand(newLabelSyncCmd(f))
+	cmd.AddCommand(newLabelExportCmd(f))
 
 	return cmd
 }
@@ -33,42 +39,33 @@ func newLabelCreateCmd(f *cmdutil.Factory) *cobra.Command {
 		color       string
 		description string
 		priority    int64
+		group       string
 	)
 
 	cmd := &cobra.Command{
 		Use:   "create",
 		Short: "Create a label",
 		Example: `  $ glab label create --name bug --color "#FF0000"
-  $ glab label create --name feature --color "#00FF00" --description "New features"`,
+  $ glab label create --name feature --color "#00FF00" --description "New features"
+  $ glab label create --name security --color "#D9534F" --group my-group`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := f.Client()
 			if err != nil {
 				return err
 			}
 
-			project, err := f.FullProjectPath()
+			target, err := resolveLabelTarget(f, group)
 			if err != nil {
 				return err
 			}
 
-			opts := &gitlab.CreateLabelOptions{
-				Name:        &name,
-				Color:       &color,
-				Description: &description,
-			}
-
+			label := &labelSpec{Name: name, Color: color, Description: description}
 			if cmd.Flags().Changed("priority") {
-				opts.Priority = &priority
+				label.Priority = &priority
 			}
 
-			label, resp, err := client.Labels.CreateLabel(project, opts)
-			if err != nil {
-				statusCode := 0
-				if resp != nil {
-					statusCode = resp.StatusCode
-				}
-				url := api.APIURL(client.Host()) + "/projects/" + project + "/labels"
-				return errors.NewAPIError("POST", url, statusCode, "Failed to create label", err)
+			if err := createLabel(client, target, label); err != nil {
+				return err
 			}
 
 			_, _ = fmt.Fprintf(f.IOStreams.Out, "Created label %q (%s)\n", label.Name, label.Color)
@@ -80,6 +77,7 @@ func newLabelCreateCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.Flags().StringVarP(&color, "color", "c", "", "Label color in hex (required, e.g., #FF0000)")
 	cmd.Flags().StringVarP(&description, "description", "d", "", "Label description")
 	cmd.Flags().Int64Var(&priority, "priority", 0, "Label priority")
+	cmd.Flags().StringVarP(&group, "group", "g", "", "Create the label in a group")
 	_ = cmd.MarkFlagRequired("name")
 	_ = cmd.MarkFlagRequired("color")
 
@@ -94,31 +92,36 @@ func newLabelListCmd(f *cmdutil.Factory) *cobra.Command {
 		search   string
 		stream   bool
 		web      bool
+		group    string
 	)
 
 	cmd := &cobra.Command{
 		Use:     "list",
 		Short:   "List labels",
 		Aliases: []string{"ls"},
-		Example: `  $ glab label list`,
+		Example: `  $ glab label list
+  $ glab label list --group my-group`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := f.Client()
 			if err != nil {
 				return err
 			}
 
-			project, err := f.FullProjectPath()
+			target, err := resolveLabelTarget(f, group)
 			if err != nil {
 				return err
 			}
 
 			if web {
+				if group != "" {
+					return browser.Open(api.WebURL(client.Host(), "groups/"+group+"/-/labels"))
+				}
 				remote, _ := f.Remote()
 				host := "gitlab.com"
 				if remote != nil {
 					host = remote.Host
 				}
-				return browser.Open(api.WebURL(host, project+"/-/labels"))
+				return browser.Open(api.WebURL(host, target.project+"/-/labels"))
 			}
 
 			opts := &gitlab.ListLabelsOptions{
@@ -137,7 +140,7 @@ func newLabelListCmd(f *cmdutil.Factory) *cobra.Command {
 			// Use streaming mode if --stream flag is set
 			if stream {
 				// Create context for pagination
-				ctx := context.Background()
+				ctx := cmd.Context()
 
 				// Create fetch function for pagination
 				fetchFunc := func(page int) ([]*gitlab.Label, *gitlab.Response, error) {
@@ -146,7 +149,7 @@ func newLabelListCmd(f *cmdutil.Factory) *cobra.Command {
 					if pageOpts.PerPage == 0 {
 						pageOpts.PerPage = 100
 					}
-					return client.Labels.ListLabels(project, &pageOpts)
+					return listLabels(client, target, &pageOpts, gitlab.WithContext(ctx))
 				}
 
 				// Configure pagination options
@@ -166,14 +169,13 @@ func newLabelListCmd(f *cmdutil.Factory) *cobra.Command {
 			}
 
 			// Non-streaming mode: fetch all at once
-			labels, resp, err := client.Labels.ListLabels(project, opts)
+			labels, resp, err := listLabels(client, target, opts)
 			if err != nil {
 				statusCode := 0
 				if resp != nil {
 					statusCode = resp.StatusCode
 				}
-				url := api.APIURL(client.Host()) + "/projects/" + project + "/labels"
-				return errors.NewAPIError("GET", url, statusCode, "Failed to list labels", err)
+				return errors.NewAPIError("GET", target.path(client), statusCode, "Failed to list labels", err)
 			}
 
 			if len(labels) == 0 {
@@ -186,40 +188,116 @@ func newLabelListCmd(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
 	cmd.Flags().StringVar(&search, "search", "", "Search labels")
 	cmd.Flags().BoolVar(&stream, "stream", false, "Enable streaming mode")
 	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open in browser")
+	cmd.Flags().StringVarP(&group, "group", "g", "", "List labels of a group")
 
 	return cmd
 }
 
-func newLabelDeleteCmd(f *cmdutil.Factory) *cobra.Command {
+func newLabelEditCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		name        string
+		color       string
+		description string
+		priority    int64
+		group       string
+	)
+
 	cmd := &cobra.Command{
-		Use:     "delete <name>",
-		Short:   "Delete a label",
-		Example: `  $ glab label delete bug`,
-		Args:    cobra.ExactArgs(1),
+		Use:   "edit <name>",
+		Short: "Edit a label",
+		Example: `  $ glab label edit bug --color "#CC0000"
+  $ glab label edit bug --name defect --description "Something isn't working"
+  $ glab label edit security --priority 1 --group my-group`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := &gitlab.UpdateLabelOptions{}
+			if cmd.Flags().Changed("name") {
+				opts.NewName = &name
+			}
+			if cmd.Flags().Changed("color") {
+				opts.Color = &color
+			}
+			if cmd.Flags().Changed("description") {
+				opts.Description = &description
+			}
+			if cmd.Flags().Changed("priority") {
+				opts.Priority = &priority
+			}
+			if opts.NewName == nil && opts.Color == nil && opts.Description == nil && opts.Priority == nil {
+				return fmt.Errorf("specify at least one of --name, --color, --description, or --priority")
+			}
+
 			client, err := f.Client()
 			if err != nil {
 				return err
 			}
 
-			project, err := f.FullProjectPath()
+			target, err := resolveLabelTarget(f, group)
 			if err != nil {
 				return err
 			}
 
-			resp, err := client.Labels.DeleteLabel(project, args[0], nil)
+			var (
+				label *gitlab.Label
+				resp  *gitlab.Response
+			)
+			if group != "" {
+				var gl *gitlab.GroupLabel
+				gl, resp, err = client.GroupLabels.UpdateGroupLabel(group, args[0], (*gitlab.UpdateGroupLabelOptions)(opts))
+				label = (*gitlab.Label)(gl)
+			} else {
+				label, resp, err = client.Labels.UpdateLabel(target.project, args[0], opts)
+			}
 			if err != nil {
 				statusCode := 0
 				if resp != nil {
 					statusCode = resp.StatusCode
 				}
-				url := api.APIURL(client.Host()) + "/projects/" + project + "/labels/" + args[0]
-				return errors.NewAPIError("DELETE", url, statusCode, "Failed to delete label", err)
+				return errors.NewAPIError("PUT", target.path(client)+"/"+args[0], statusCode, "Failed to update label", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Updated label %q (%s)\n", label.Name, label.Color)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&name, "name", "n", "", "New label name")
+	cmd.Flags().StringVarP(&color, "color", "c", "", "Label color in hex (e.g., #FF0000)")
+	cmd.Flags().StringVarP(&description, "description", "d", "", "Label description")
+	cmd.Flags().Int64Var(&priority, "priority", 0, "Label priority")
+	cmd.Flags().StringVarP(&group, "group", "g", "", "Edit a label of a group")
+
+	return cmd
+}
+
+func newLabelDeleteCmd(f *cmdutil.Factory) *cobra.Command {
+	var group string
+
+	cmd := &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete a label",
+		Example: `  $ glab label delete bug
+  $ glab label delete security --group my-group`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			target, err := resolveLabelTarget(f, group)
+			if err != nil {
+				return err
+			}
+
+			if err := deleteLabel(client, target, args[0]); err != nil {
+				return err
 			}
 
 			_, _ = fmt.Fprintf(f.IOStreams.Out, "Deleted label %q\n", args[0])
@@ -227,5 +305,24 @@ func newLabelDeleteCmd(f *cmdutil.Factory) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().StringVarP(&group, "group", "g", "", "Delete a label of a group")
+
 	return cmd
 }
+
+// listLabels returns a page of the labels of target, including those
+// inherited from ancestor groups.
+func listLabels(client *api.Client, target labelTarget, opts *gitlab.ListLabelsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Label, *gitlab.Response, error) {
+	if target.group == "" {
+		return client.Labels.ListLabels(target.project, opts, options...)
+	}
+	groupLabels, resp, err := client.GroupLabels.ListGroupLabels(target.group, &gitlab.ListGroupLabelsOptions{
+		ListOptions: opts.ListOptions,
+		Search:      opts.Search,
+	}, options...)
+	labels := make([]*gitlab.Label, len(groupLabels))
+	for i, l := range groupLabels {
+		labels[i] = (*gitlab.Label)(l)
+	}
+	return labels, resp, err
+}
@@ -0,0 +1,512 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"gopkg.in/yaml.v3"
+)
+
+// labelSpec is a label as declared in a "label sync" file. "label export"
+// writes the same form.
+type labelSpec struct {
+	Name        string `json:"name" yaml:"name"`
+	Color       string `json:"color" yaml:"color"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	Priority    *int64 `json:"priority,omitempty" yaml:"priority,omitempty"`
+}
+
+// labelChange is the planned action for one label.
+type labelChange struct {
+	Label   *labelSpec
+	Action  string   // "create", "update", "delete", or "unchanged"
+	Changed []string // attributes that differ, for updates
+}
+
+// labelTarget is where labels live: a project or a group.
+type labelTarget struct {
+	project string
+	group   string
+}
+
+// resolveLabelTarget returns the group target when asked for, and the
+// current project otherwise.
+func resolveLabelTarget(f *cmdutil.Factory, group string) (labelTarget, error) {
+	if group != "" {
+		return labelTarget{group: group}, nil
+	}
+	project, err := f.FullProjectPath()
+	if err != nil {
+		return labelTarget{}, err
+	}
+	return labelTarget{project: project}, nil
+}
+
+// String returns the project or group path.
+func (t labelTarget) String() string {
+	if t.group != "" {
+		return t.group
+	}
+	return t.project
+}
+
+// path returns the API URL of the target's labels.
+func (t labelTarget) path(client *api.Client) string {
+	if t.group != "" {
+		return api.APIURL(client.Host()) + "/groups/" + t.group + "/labels"
+	}
+	return api.APIURL(client.Host()) + "/projects/" + t.project + "/labels"
+}
+
+// newLabelSpec returns the spec of label. A zero priority means none.
+func newLabelSpec(label *gitlab.Label) *labelSpec {
+	s := &labelSpec{Name: label.Name, Color: label.Color, Description: label.Description}
+	if label.Priority != 0 {
+		s.Priority = gitlab.Ptr(label.Priority)
+	}
+	return s
+}
+
+// listLabelSpecs returns the labels defined by target itself, leaving out
+// those inherited from ancestor groups.
+func listLabelSpecs(client *api.Client, target labelTarget) ([]*labelSpec, error) {
+	var all []*labelSpec
+	listOpts := gitlab.ListOptions{PerPage: 100}
+	for {
+		var (
+			resp *gitlab.Response
+			err  error
+		)
+		if target.group != "" {
+			var labels []*gitlab.GroupLabel
+			labels, resp, err = client.GroupLabels.ListGroupLabels(target.group, &gitlab.ListGroupLabelsOptions{
+				ListOptions:           listOpts,
+				IncludeAncestorGroups: gitlab.Ptr(false),
+				OnlyGroupLabels:       gitlab.Ptr(true),
+			})
+			for _, l := range labels {
+				all = append(all, newLabelSpec((*gitlab.Label)(l)))
+			}
+		} else {
+			var labels []*gitlab.Label
+			labels, resp, err = client.Labels.ListLabels(target.project, &gitlab.ListLabelsOptions{
+				ListOptions:           listOpts,
+				IncludeAncestorGroups: gitlab.Ptr(false),
+			})
+			for _, l := range labels {
+				if l.IsProjectLabel {
+					all = append(all, newLabelSpec(l))
+				}
+			}
+		}
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			return nil, errors.NewAPIError("GET", target.path(client), statusCode, "Failed to list labels", err)
+		}
+		if resp == nil || resp.NextPage == 0 {
+			return all, nil
+		}
+		listOpts.Page = resp.NextPage
+	}
+}
+
+// createLabel creates l in target.
+func createLabel(client *api.Client, target labelTarget, l *labelSpec) error {
+	var (
+		resp *gitlab.Response
+		err  error
+	)
+	if target.group != "" {
+		_, resp, err = client.GroupLabels.CreateGroupLabel(target.group, &gitlab.CreateGroupLabelOptions{
+			Name:        &l.Name,
+			Color:       &l.Color,
+			Description: &l.Description,
+			Priority:    l.Priority,
+		})
+	} else {
+		_, resp, err = client.Labels.CreateLabel(target.project, &gitlab.CreateLabelOptions{
+			Name:        &l.Name,
+			Color:       &l.Color,
+			Description: &l.Description,
+			Priority:    l.Priority,
+		})
+	}
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		return errors.NewAPIError("POST", target.path(client), statusCode, fmt.Sprintf("Failed to create label %q", l.Name), err)
+	}
+	return nil
+}
+
+// updateLabel updates the label named l.Name to match l.
+func updateLabel(client *api.Client, target labelTarget, l *labelSpec) error {
+	var (
+		resp *gitlab.Response
+		err  error
+	)
+	if target.group != "" {
+		_, resp, err = client.GroupLabels.UpdateGroupLabel(target.group, l.Name, &gitlab.UpdateGroupLabelOptions{
+			Color:       &l.Color,
+			Description: &l.Description,
+			Priority:    l.Priority,
+		})
+	} else {
+		_, resp, err = client.Labels.UpdateLabel(target.project, l.Name, &gitlab.UpdateLabelOptions{
+			Color:       &l.Color,
+			Description: &l.Description,
+			Priority:    l.Priority,
+		})
+	}
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		return errors.NewAPIError("PUT", target.path(client)+"/"+l.Name, statusCode, fmt.Sprintf("Failed to update label %q", l.Name), err)
+	}
+	return nil
+}
+
+// deleteLabel deletes the label named name from target.
+func deleteLabel(client *api.Client, target labelTarget, name string) error {
+	var (
+		resp *gitlab.Response
+		err  error
+	)
+	if target.group != "" {
+		resp, err = client.GroupLabels.DeleteGroupLabel(target.group, name, nil)
+	} else {
+		resp, err = client.Labels.DeleteLabel(target.project, name, nil)
+	}
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		return errors.NewAPIError("DELETE", target.path(client)+"/"+name, statusCode, fmt.Sprintf("Failed to delete label %q", name), err)
+	}
+	return nil
+}
+
+// planLabelChanges compares incoming labels with the existing ones, matching
+// them by name. Priorities are only compared for incoming labels that have
+// one. With prune, existing labels missing from incoming are deleted.
+func planLabelChanges(existing, incoming []*labelSpec, prune bool) []labelChange {
+	byName := make(map[string]*labelSpec, len(existing))
+	for _, l := range existing {
+		byName[l.Name] = l
+	}
+
+	changes := make([]labelChange, 0, len(incoming))
+	for _, l := range incoming {
+		old, ok := byName[l.Name]
+		if !ok {
+			changes = append(changes, labelChange{Label: l, Action: "create"})
+			continue
+		}
+		var changed []string
+		if !strings.EqualFold(old.Color, l.Color) {
+			changed = append(changed, "color")
+		}
+		if old.Description != l.Description {
+			changed = append(changed, "description")
+		}
+		if l.Priority != nil && (old.Priority == nil || *old.Priority != *l.Priority) {
+			changed = append(changed, "priority")
+		}
+		action := "update"
+		if len(changed) == 0 {
+			action = "unchanged"
+		}
+		changes = append(changes, labelChange{Label: l, Action: action, Changed: changed})
+	}
+
+	if prune {
+		wanted := make(map[string]bool, len(incoming))
+		for _, l := range incoming {
+			wanted[l.Name] = true
+		}
+		for _, l := range existing {
+			if !wanted[l.Name] {
+				changes = append(changes, labelChange{Label: l, Action: "delete"})
+			}
+		}
+	}
+	return changes
+}
+
+// printLabelPlan prints the planned changes followed by a summary line.
+func printLabelPlan(out io.Writer, changes []labelChange) {
+	var c changeCounts
+	for _, ch := range changes {
+		switch ch.Action {
+		case "create":
+			c.Created++
+			_, _ = fmt.Fprintf(out, "+ %s (%s)\n", ch.Label.Name, ch.Label.Color)
+		case "update":
+			c.Updated++
+			_, _ = fmt.Fprintf(out, "~ %s: %s\n", ch.Label.Name, strings.Join(ch.Changed, ", "))
+		case "delete":
+			c.Deleted++
+			_, _ = fmt.Fprintf(out, "- %s\n", ch.Label.Name)
+		default:
+			c.Unchanged++
+			_, _ = fmt.Fprintf(out, "  %s: unchanged\n", ch.Label.Name)
+		}
+	}
+	if c.Deleted > 0 {
+		_, _ = fmt.Fprintf(out, "Would create %d, update %d, delete %d, and leave %d unchanged\n", c.Created, c.Updated, c.Deleted, c.Unchanged)
+	} else {
+		_, _ = fmt.Fprintf(out, "Would create %d, update %d, and leave %d unchanged\n", c.Created, c.Updated, c.Unchanged)
+	}
+}
+
+// applyLabelChanges applies planned changes to target. Failures are reported
+// as warnings and counted.
+func applyLabelChanges(f *cmdutil.Factory, client *api.Client, target labelTarget, changes []labelChange) changeCounts {
+	var c changeCounts
+	for _, ch := range changes {
+		var err error
+		switch ch.Action {
+		case "create":
+			if err = createLabel(client, target, ch.Label); err == nil {
+				c.Created++
+			}
+		case "update":
+			if err = updateLabel(client, target, ch.Label); err == nil {
+				c.Updated++
+			}
+		case "delete":
+			if err = deleteLabel(client, target, ch.Label.Name); err == nil {
+				c.Deleted++
+			}
+		default:
+			c.Unchanged++
+		}
+		if err != nil {
+			c.Failed++
+			_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Warning: failed to %s label %q: %v\n", ch.Action, ch.Label.Name, err)
+		}
+	}
+	return c
+}
+
+// readLabelSpecs reads labels from a JSON file, when its name ends in
+// ".json", or a YAML file.
+func readLabelSpecs(path string) ([]*labelSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading file: %w", err)
+	}
+	var labels []*labelSpec
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		if err := json.Unmarshal(data, &labels); err != nil {
+			return nil, fmt.Errorf("parsing JSON: %w", err)
+		}
+	} else if err := yaml.Unmarshal(data, &labels); err != nil {
+		return nil, fmt.Errorf("parsing YAML: %w", err)
+	}
+
+	seen := make(map[string]bool, len(labels))
+	for i, l := range labels {
+		switch {
+		case l == nil || l.Name == "":
+			return nil, fmt.Errorf("label %d in %s has no name", i+1, path)
+		case l.Color == "":
+			return nil, fmt.Errorf("label %q in %s has no color", l.Name, path)
+		case seen[l.Name]:
+			return nil, fmt.Errorf("label %q is declared more than once in %s", l.Name, path)
+		}
+		seen[l.Name] = true
+	}
+	return labels, nil
+}
+
+// marshalLabelSpecs encodes labels as YAML or JSON.
+func marshalLabelSpecs(labels []*labelSpec, format string) ([]byte, error) {
+	if labels == nil {
+		labels = []*labelSpec{}
+	}
+	switch format {
+	case "json":
+		b, err := json.MarshalIndent(labels, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(b, '\n'), nil
+	case "yaml":
+		var buf bytes.Buffer
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+		if err := enc.Encode(labels); err != nil {
+			return nil, err
+		}
+		if err := enc.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q: use yaml or json", format)
+	}
+}
+
+func newLabelSyncCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		group  string
+		file   string
+		prune  bool
+		dryRun bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Make labels match a YAML or JSON file",
+		Long: `Make the labels of the current project, or of a group with --group, match
+a YAML or JSON file, such as one written by "glab label export". The file is
+a list of labels with a name, a color, and optionally a description and a
+priority:
+
+  - name: bug
+    color: "#d9534f"
+    description: Something isn't working
+    priority: 1
+  - name: feature
+    color: "#5cb85c"
+
+Labels are matched by name: missing labels are created, and labels whose
+color, description, or priority differ are updated. Priorities are only
+changed for labels that declare one. With --prune, labels the file does not
+have are deleted, so the target matches the file exactly. Labels inherited
+from parent groups are never changed.
+
+Always review the changes with --dry-run before pruning.`,
+		Example: `  $ glab label sync --file labels.yml --dry-run
+  $ glab label sync --file labels.yml --prune
+  $ glab label sync --file labels.json --group mygroup`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			incoming, err := readLabelSpecs(file)
+			if err != nil {
+				return err
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			target, err := resolveLabelTarget(f, group)
+			if err != nil {
+				return err
+			}
+
+			existing, err := listLabelSpecs(client, target)
+			if err != nil {
+				return err
+			}
+			changes := planLabelChanges(existing, incoming, prune)
+
+			if dryRun {
+				printLabelPlan(f.IOStreams.Out, changes)
+				return nil
+			}
+
+			counts := applyLabelChanges(f, client, target, changes)
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Synced labels of %s from %s (%s)\n", target, file, counts)
+			if counts.Failed > 0 {
+				return fmt.Errorf("failed to sync %d of %d label(s)", counts.Failed, len(changes))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&group, "group", "g", "", "Sync group labels (specify group path)")
+	cmd.Flags().StringVarP(&file, "file", "f", "", "YAML or JSON file of labels (required)")
+	cmd.Flags().BoolVar(&prune, "prune", false, "Delete labels that are not in the file")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show which labels would be created, updated, deleted, or left unchanged")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func newLabelExportCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		group  string
+		output string
+		format string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export labels as YAML or JSON",
+		Long: `Export the labels of the current project, or of a group with --group, in
+the form "glab label sync" reads. Labels inherited from parent groups are
+left out.
+
+When --output names a ".json" file, the format defaults to JSON.`,
+		Example: `  $ glab label export
+  $ glab label export --output labels.yml
+  $ glab label export --group mygroup --format json
+  $ glab label export --output labels.yml && glab label sync --file labels.yml --repo other/project`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("format") && strings.EqualFold(filepath.Ext(output), ".json") {
+				format = "json"
+			}
+			if format != "yaml" && format != "json" {
+				return fmt.Errorf("unsupported format %q: use yaml or json", format)
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			target, err := resolveLabelTarget(f, group)
+			if err != nil {
+				return err
+			}
+
+			labels, err := listLabelSpecs(client, target)
+			if err != nil {
+				return err
+			}
+			data, err := marshalLabelSpecs(labels, format)
+			if err != nil {
+				return fmt.Errorf("marshaling labels: %w", err)
+			}
+
+			if output == "" {
+				_, err := f.IOStreams.Out.Write(data)
+				return err
+			}
+			if err := os.WriteFile(output, data, 0644); err != nil {
+				return fmt.Errorf("writing to file: %w", err)
+			}
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Exported %d label(s) to %s\n", len(labels), output)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&group, "group", "g", "", "Export group labels (specify group path)")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file path (default: standard output)")
+	cmd.Flags().StringVarP(&format, "format", "F", "yaml", "Output format: yaml or json")
+
+	return cmd
+}
@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+// mockLabels serves labels for the labels API path prefix and records writes
+// as "METHOD name".
+func mockLabels(t *testing.T, prefix string, labels []map[string]any) *[]string {
+	t.Helper()
+	var writes []string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		rest, ok := strings.CutPrefix(r.URL.Path, prefix)
+		if !ok {
+			cmdtest.ErrorResponse(w, 404, "404 Not Found")
+			return
+		}
+		if r.Method == http.MethodGet {
+			cmdtest.JSONResponse(w, 200, labels)
+			return
+		}
+		name := strings.TrimPrefix(rest, "/")
+		body := map[string]any{}
+		if r.Method != http.MethodDelete {
+			body = decodeRequest(t, r)
+		}
+		if name == "" {
+			name, _ = body["name"].(string)
+		}
+		writes = append(writes, r.Method+" "+name)
+		body["id"] = 1
+		cmdtest.JSONResponse(w, 200, body)
+	})
+	return &writes
+}
+
+func writeLabelsFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+const testLabelsYAML = `- name: bug
+  color: "#d9534f"
+  description: Something isn't working
+- name: feature
+  color: "#5cb85c"
+  priority: 2
+- name: docs
+  color: "#0033cc"
+`
+
+func testExistingLabels() []map[string]any {
+	return []map[string]any{
+		{"id": 1, "name": "bug", "color": "#D9534F", "description": "Something isn't working", "is_project_label": true},
+		{"id": 2, "name": "feature", "color": "#5cb85c", "is_project_label": true},
+		{"id": 3, "name": "wontfix", "color": "#ffffff", "is_project_label": true},
+		{"id": 4, "name": "inherited", "color": "#000000", "is_project_label": false},
+	}
+}
+
+func TestReadLabelSpecs(t *testing.T) {
+	labels, err := readLabelSpecs(writeLabelsFile(t, "labels.yml", testLabelsYAML))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(labels) != 3 || labels[1].Name != "feature" || labels[1].Priority == nil || *labels[1].Priority != 2 {
+		t.Errorf("unexpected labels %+v", labels)
+	}
+
+	labels, err = readLabelSpecs(writeLabelsFile(t, "labels.json", `[{"name": "bug", "color": "#d9534f"}]`))
+	if err != nil || len(labels) != 1 || labels[0].Color != "#d9534f" {
+		t.Errorf("unexpected JSON result %+v, %v", labels, err)
+	}
+
+	tests := []struct{ content, want string }{
+		{"- color: red\n", "label 1 in"},
+		{"- name: bug\n", `label "bug" in`},
+		{"- {name: a, color: red}\n- {name: a, color: blue}\n", "declared more than once"},
+		{"name: bug\n", "parsing YAML"},
+	}
+	for _, tt := range tests {
+		if _, err := readLabelSpecs(writeLabelsFile(t, "labels.yml", tt.content)); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("readLabelSpecs(%q): expected an error containing %q, got %v", tt.content, tt.want, err)
+		}
+	}
+}
+
+func TestLabelSync_DryRun(t *testing.T) {
+	writes := mockLabels(t, "/api/v4/projects/test-owner/test-repo/labels", testExistingLabels())
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newLabelSyncCmd(f.Factory)
+	cmd.SetArgs([]string{"--file", writeLabelsFile(t, "labels.yml", testLabelsYAML), "--prune", "--dry-run"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(*writes) != 0 {
+		t.Errorf("expected no writes, got %v", *writes)
+	}
+	want := `  bug: unchanged
+~ feature: priority
++ docs (#0033cc)
+- wontfix
+Would create 1, update 1, delete 1, and leave 1 unchanged
+`
+	if got := f.IO.String(); got != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestLabelSync_Prune(t *testing.T) {
+	writes := mockLabels(t, "/api/v4/projects/test-owner/test-repo/labels", testExistingLabels())
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newLabelSyncCmd(f.Factory)
+	cmd.SetArgs([]string{"--file", writeLabelsFile(t, "labels.yml", testLabelsYAML), "--prune"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := strings.Join(*writes, "; "); got != "PUT feature; POST docs; DELETE wontfix" {
+		t.Errorf("writes = %s", got)
+	}
+	if !strings.Contains(f.IO.String(), "(1 created, 1 updated, 1 deleted, 1 unchanged)") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestLabelSync_Group(t *testing.T) {
+	writes := mockLabels(t, "/api/v4/groups/mygroup/labels", []map[string]any{
+		{"id": 1, "name": "bug", "color": "#ff0000"},
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newLabelSyncCmd(f.Factory)
+	cmd.SetArgs([]string{"--file", writeLabelsFile(t, "labels.yml", testLabelsYAML), "--group", "mygroup"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := strings.Join(*writes, "; "); got != "PUT bug; POST feature; POST docs" {
+		t.Errorf("writes = %s", got)
+	}
+	if !strings.Contains(f.IO.String(), "Synced labels of mygroup from") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestLabelExport(t *testing.T) {
+	mockLabels(t, "/api/v4/projects/test-owner/test-repo/labels", testExistingLabels())
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newLabelExportCmd(f.Factory)
+	cmd.SetArgs([]string{})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `- name: bug
+  color: '#D9534F'
+  description: Something isn't working
+- name: feature
+  color: '#5cb85c'
+- name: wontfix
+  color: '#ffffff'
+`
+	if got := f.IO.String(); got != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestLabelExport_JSONFile(t *testing.T) {
+	mockLabels(t, "/api/v4/projects/test-owner/test-repo/labels", testExistingLabels())
+
+	output := filepath.Join(t.TempDir(), "labels.json")
+	f := cmdtest.NewTestFactory(t)
+	cmd := newLabelExportCmd(f.Factory)
+	cmd.SetArgs([]string{"--output", output})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(f.IO.String(), "Exported 3 label(s) to "+output) {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+
+	// The export reads back as the labels it was made from.
+	labels, err := readLabelSpecs(output)
+	if err != nil {
+		t.Fatalf("reading export: %v", err)
+	}
+	if changes := planLabelChanges(labels, labels, true); len(changes) != 3 || changes[0].Action != "unchanged" {
+		t.Errorf("unexpected changes %+v", changes)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/spf13/cobra"
 )
 
 func TestNewLabelCmd(t *testing.T) {
@@ -28,7 +30,10 @@ func TestLabelCmd_HasSubcommands(t *testing.T) {
 	expectedSubcommands := []string{
 		"create",
 		"list",
+		"edit",
 		"delete",
+		"sync",
+		"export",
 	}
 
 	subcommands := cmd.Commands()
@@ -233,3 +238,89 @@ func TestLabelList_EmptyResult(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestLabelEdit(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut && r.URL.Path == "/api/v4/projects/test-owner/test-repo/labels/bug" {
+			body = decodeRequest(t, r)
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 1, "name": "defect", "color": "#CC0000"})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newLabelEditCmd(f.Factory)
+	cmd.SetArgs([]string{"bug", "--name", "defect", "--color", "#CC0000"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body["new_name"] != "defect" || body["color"] != "#CC0000" {
+		t.Errorf("unexpected request body %v", body)
+	}
+	if _, ok := body["description"]; ok {
+		t.Errorf("expected unchanged attributes to be left out, got %v", body)
+	}
+	if !strings.Contains(f.IO.String(), `Updated label "defect" (#CC0000)`) {
+		t.Errorf("unexpected output %q", f.IO.String())
+	}
+}
+
+func TestLabelEdit_NothingToChange(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newLabelEditCmd(f.Factory)
+	cmd.SetArgs([]string{"bug"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "specify at least one of") {
+		t.Errorf("expected an error without changes, got %v", err)
+	}
+}
+
+func TestLabel_Group(t *testing.T) {
+	var requests []string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if !strings.HasPrefix(r.URL.Path, "/api/v4/groups/my-group/labels") {
+			cmdtest.ErrorResponse(w, 404, "not found")
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			cmdtest.JSONResponse(w, 200, []map[string]any{{"id": 1, "name": "security", "color": "#D9534F"}})
+		case http.MethodDelete:
+			w.WriteHeader(204)
+		default:
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 1, "name": "security", "color": "#D9534F"})
+		}
+	})
+
+	tests := []struct {
+		cmd  func(*cmdutil.Factory) *cobra.Command
+		args []string
+		want string
+	}{
+		{newLabelListCmd, []string{"--group", "my-group"}, "security"},
+		{newLabelCreateCmd, []string{"--name", "security", "--color", "#D9534F", "--group", "my-group"}, `Created label "security" (#D9534F)`},
+		{newLabelEditCmd, []string{"security", "--priority", "1", "--group", "my-group"}, `Updated label "security" (#D9534F)`},
+		{newLabelDeleteCmd, []string{"security", "--group", "my-group"}, `Deleted label "security"`},
+	}
+	for _, tt := range tests {
+		f := cmdtest.NewTestFactory(t)
+		cmd := tt.cmd(f.Factory)
+		cmd.SetArgs(tt.args)
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("%s: unexpected error: %v", cmd.Name(), err)
+		}
+		if !strings.Contains(f.IO.String(), tt.want) {
+			t.Errorf("%s: expected output to contain %q, got %q", cmd.Name(), tt.want, f.IO.String())
+		}
+	}
+
+	want := "GET /api/v4/groups/my-group/labels; POST /api/v4/groups/my-group/labels; PUT /api/v4/groups/my-group/labels/security; DELETE /api/v4/groups/my-group/labels/security"
+	if got := strings.Join(requests, "; "); got != want {
+		t.Errorf("requests = %s", got)
+	}
+}
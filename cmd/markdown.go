@@ -0,0 +1,16 @@
+package cmd
+
+import (
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/pkg/iostreams"
+)
+
+// renderMarkdown formats a description or comment for display. Markdown is
+// rendered only when stdout is a terminal and raw is false; otherwise the
+// text is returned as written.
+func renderMarkdown(f *cmdutil.Factory, text string, raw bool) string {
+	if raw || !f.IOStreams.IsTerminal() {
+		return text
+	}
+	return iostreams.RenderMarkdown(text, f.IOStreams.TerminalWidth(), f.IOStreams.ColorScheme())
+}
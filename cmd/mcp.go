@@ -897,14 +897,14 @@ func newSessionStore() *mcpSessionStore {
 		pending:       make(map[string]*oauthAuthRequest),
 		codes:         make(map[string]*oauthAuthCode),
 		tokenEndpoint: defaultTokenEndpoint,
-		httpClient:    &http.Client{Timeout: 30 * time.Second},
+		httpClient:    api.NewHTTPClient(30 * time.Second),
 	}
 	s.loadFromDisk()
 	return s
 }
 
 func defaultTokenEndpoint(host string) string {
-	return fmt.Sprintf("https://%s/oauth/token", host)
+	return config.WebBaseURL(host) + "/oauth/token"
 }
 
 // loadFromDisk restores persisted sessions and clients.
@@ -1089,7 +1089,7 @@ func (s *mcpSessionStore) refreshSession(sess *mcpSession) error {
 
 	client := s.httpClient
 	if client == nil {
-		client = &http.Client{Timeout: 30 * time.Second}
+		client = api.NewHTTPClient(30 * time.Second)
 	}
 	resp, err := client.PostForm(tokenURL, data)
 	if err != nil {
@@ -0,0 +1,686 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/browser"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// NewMilestoneCmd creates the milestone command group.
+func NewMilestoneCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "milestone <command>",
+		Short: "Manage milestones",
+		Long: `Create, list, view, and manage project and group milestones.
+
+Milestones can be referred to by ID or by title. Use --group to operate on
+the milestones of a group instead of the current project.`,
+	}
+
+	cmd.AddCommand(newMilestoneListCmd(f))
+	cmd.AddCommand(newMilestoneCreateCmd(f))
+	cmd.AddCommand(newMilestoneViewCmd(f))
+	cmd.AddCommand(newMilestoneCloseCmd(f))
+	cmd.AddCommand(newMilestoneReopenCmd(f))
+	cmd.AddCommand(newMilestoneEditCmd(f))
+	cmd.AddCommand(newMilestoneDeleteCmd(f))
+
+	return cmd
+}
+
+func newMilestoneListCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		group    string
+		state    string
+		search   string
+		limit    int
+		format   string
+		jsonFlag bool
+		web      bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List milestones",
+		Aliases: []string{"ls"},
+		Example: `  $ glab milestone list
+  $ glab milestone list --state closed
+  $ glab milestone list --group my-group --search "Q3"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := milestoneProject(f, group)
+			if err != nil {
+				return err
+			}
+
+			if web {
+				return browser.Open(api.WebURL(client.Host(), milestoneScopePath(project, group)+"/-/milestones"))
+			}
+
+			if state != "" && state != "active" && state != "closed" && state != "all" {
+				return fmt.Errorf("invalid state %q (must be active, closed, or all)", state)
+			}
+			if state == "all" {
+				state = ""
+			}
+
+			var milestones []*gitlab.Milestone
+			if group != "" {
+				opts := &gitlab.ListGroupMilestonesOptions{
+					ListOptions: gitlab.ListOptions{PerPage: int64(limit)},
+				}
+				if state != "" {
+					opts.State = &state
+				}
+				if search != "" {
+					opts.Search = &search
+				}
+				groupMilestones, resp, err := client.GroupMilestones.ListGroupMilestones(group, opts)
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/groups/" + group + "/milestones"
+					return errors.NewAPIError("GET", url, statusCode, "Failed to list group milestones", err)
+				}
+				for _, gm := range groupMilestones {
+					milestones = append(milestones, fromGroupMilestone(client, group, gm))
+				}
+			} else {
+				opts := &gitlab.ListMilestonesOptions{
+					ListOptions: gitlab.ListOptions{PerPage: int64(limit)},
+				}
+				if state != "" {
+					opts.State = &state
+				}
+				if search != "" {
+					opts.Search = &search
+				}
+				milestones, _, err = listProjectMilestones(client, project, opts)
+				if err != nil {
+					return err
+				}
+			}
+
+			if len(milestones) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No milestones found.")
+				return nil
+			}
+
+			return f.FormatAndPrint(milestones, format, jsonFlag)
+		},
+	}
+
+	cmd.Flags().StringVarP(&group, "group", "g", "", "List milestones of a group")
+	cmd.Flags().StringVarP(&state, "state", "s", "active", "Filter by state: active, closed, or all")
+	cmd.Flags().StringVar(&search, "search", "", "Search milestones by title or description")
+	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open in browser")
+
+	return cmd
+}
+
+func newMilestoneCreateCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		group       string
+		title       string
+		description string
+		startDate   string
+		dueDate     string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a milestone",
+		Example: `  $ glab milestone create --title "v1.0" --due-date 2024-12-31
+  $ glab milestone create --title "Q3" --start-date 2024-07-01 --due-date 2024-09-30 --group my-group`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := milestoneProject(f, group)
+			if err != nil {
+				return err
+			}
+
+			start, err := parseMilestoneDate("start-date", startDate)
+			if err != nil {
+				return err
+			}
+			due, err := parseMilestoneDate("due-date", dueDate)
+			if err != nil {
+				return err
+			}
+
+			var milestone *gitlab.Milestone
+			if group != "" {
+				opts := &gitlab.CreateGroupMilestoneOptions{
+					Title:     &title,
+					StartDate: start,
+					DueDate:   due,
+				}
+				if description != "" {
+					opts.Description = &description
+				}
+				gm, resp, err := client.GroupMilestones.CreateGroupMilestone(group, opts)
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/groups/" + group + "/milestones"
+					return errors.NewAPIError("POST", url, statusCode, "Failed to create group milestone", err)
+				}
+				milestone = fromGroupMilestone(client, group, gm)
+			} else {
+				opts := &gitlab.CreateMilestoneOptions{
+					Title:     &title,
+					StartDate: start,
+					DueDate:   due,
+				}
+				if description != "" {
+					opts.Description = &description
+				}
+				var resp *gitlab.Response
+				milestone, resp, err = client.Milestones.CreateMilestone(project, opts)
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/projects/" + project + "/milestones"
+					return errors.NewAPIError("POST", url, statusCode, "Failed to create milestone", err)
+				}
+			}
+
+			out := f.IOStreams.Out
+			_, _ = fmt.Fprintf(out, "Created milestone %q (ID %d)\n", milestone.Title, milestone.ID)
+			if milestone.WebURL != "" {
+				_, _ = fmt.Fprintf(out, "%s\n", milestone.WebURL)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&group, "group", "g", "", "Create the milestone in a group")
+	cmd.Flags().StringVarP(&title, "title", "t", "", "Milestone title (required)")
+	cmd.Flags().StringVarP(&description, "description", "d", "", "Milestone description")
+	cmd.Flags().StringVar(&startDate, "start-date", "", "Start date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&dueDate, "due-date", "", "Due date (YYYY-MM-DD)")
+	_ = cmd.MarkFlagRequired("title")
+
+	return cmd
+}
+
+func newMilestoneViewCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		group    string
+		format   string
+		jsonFlag bool
+		web      bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "view <id-or-title>",
+		Short: "View a milestone",
+		Example: `  $ glab milestone view 12
+  $ glab milestone view "v1.0"
+  $ glab milestone view "Q3" --group my-group --web`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := milestoneProject(f, group)
+			if err != nil {
+				return err
+			}
+
+			milestone, err := getMilestone(client, project, group, args[0])
+			if err != nil {
+				return err
+			}
+
+			if web {
+				return browser.Open(milestone.WebURL)
+			}
+
+			if jsonFlag {
+				format = "json"
+			}
+			if format != "" && format != "table" {
+				return f.FormatAndPrint(milestone, format, false)
+			}
+
+			out := f.IOStreams.Out
+			_, _ = fmt.Fprintf(out, "%s\n", milestone.Title)
+			_, _ = fmt.Fprintf(out, "ID:      %d\n", milestone.ID)
+			_, _ = fmt.Fprintf(out, "State:   %s\n", milestone.State)
+			if milestone.StartDate != nil {
+				_, _ = fmt.Fprintf(out, "Start:   %s\n", milestone.StartDate.String())
+			}
+			if milestone.DueDate != nil {
+				due := milestone.DueDate.String()
+				if milestone.Expired != nil && *milestone.Expired && milestone.State == "active" {
+					due += " (expired)"
+				}
+				_, _ = fmt.Fprintf(out, "Due:     %s\n", due)
+			}
+			_, _ = fmt.Fprintf(out, "Created: %s\n", timeAgo(milestone.CreatedAt))
+			if milestone.WebURL != "" {
+				_, _ = fmt.Fprintf(out, "URL:     %s\n", milestone.WebURL)
+			}
+			if milestone.Description != "" {
+				_, _ = fmt.Fprintf(out, "\n%s\n", milestone.Description)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&group, "group", "g", "", "View a milestone of a group")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open in browser")
+
+	return cmd
+}
+
+func newMilestoneCloseCmd(f *cmdutil.Factory) *cobra.Command {
+	return newMilestoneStateCmd(f, "close", "Close a milestone", "Closed")
+}
+
+func newMilestoneReopenCmd(f *cmdutil.Factory) *cobra.Command {
+	return newMilestoneStateCmd(f, "reopen", "Reopen a closed milestone", "Reopened")
+}
+
+// newMilestoneStateCmd builds the close and reopen commands, which differ
+// only in the state event they send.
+func newMilestoneStateCmd(f *cmdutil.Factory, event, short, verb string) *cobra.Command {
+	var group string
+
+	cmd := &cobra.Command{
+		Use:   event + " <id-or-title>",
+		Short: short,
+		Example: fmt.Sprintf(`  $ glab milestone %s 12
+  $ glab milestone %s "v1.0" --group my-group`, event, event),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := milestoneProject(f, group)
+			if err != nil {
+				return err
+			}
+
+			id, err := resolveMilestoneArg(client, project, group, args[0])
+			if err != nil {
+				return err
+			}
+
+			milestone, err := updateMilestone(client, project, group, id, &gitlab.UpdateMilestoneOptions{
+				StateEvent: &event,
+			})
+			if err != nil {
+				return err
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "%s milestone %q\n", verb, milestone.Title)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&group, "group", "g", "", "Operate on a milestone of a group")
+
+	return cmd
+}
+
+func newMilestoneEditCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		group       string
+		title       string
+		description string
+		startDate   string
+		dueDate     string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "edit <id-or-title>",
+		Short: "Edit a milestone",
+		Example: `  $ glab milestone edit "v1.0" --due-date 2025-01-15
+  $ glab milestone edit 12 --title "v1.1" --description "Patch release"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := milestoneProject(f, group)
+			if err != nil {
+				return err
+			}
+
+			opts := &gitlab.UpdateMilestoneOptions{}
+			changed := false
+			if cmd.Flags().Changed("title") {
+				opts.Title = &title
+				changed = true
+			}
+			if cmd.Flags().Changed("description") {
+				opts.Description = &description
+				changed = true
+			}
+			if cmd.Flags().Changed("start-date") {
+				if opts.StartDate, err = parseMilestoneDate("start-date", startDate); err != nil {
+					return err
+				}
+				changed = true
+			}
+			if cmd.Flags().Changed("due-date") {
+				if opts.DueDate, err = parseMilestoneDate("due-date", dueDate); err != nil {
+					return err
+				}
+				changed = true
+			}
+			if !changed {
+				return fmt.Errorf("nothing to edit; specify at least one of --title, --description, --start-date, or --due-date")
+			}
+
+			id, err := resolveMilestoneArg(client, project, group, args[0])
+			if err != nil {
+				return err
+			}
+
+			milestone, err := updateMilestone(client, project, group, id, opts)
+			if err != nil {
+				return err
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Updated milestone %q\n", milestone.Title)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&group, "group", "g", "", "Edit a milestone of a group")
+	cmd.Flags().StringVarP(&title, "title", "t", "", "New title")
+	cmd.Flags().StringVarP(&description, "description", "d", "", "New description")
+	cmd.Flags().StringVar(&startDate, "start-date", "", "New start date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&dueDate, "due-date", "", "New due date (YYYY-MM-DD)")
+
+	return cmd
+}
+
+func newMilestoneDeleteCmd(f *cmdutil.Factory) *cobra.Command {
+	var group string
+
+	cmd := &cobra.Command{
+		Use:   "delete <id-or-title>",
+		Short: "Delete a milestone",
+		Example: `  $ glab milestone delete "v1.0"
+  $ glab milestone delete 12 --group my-group`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := milestoneProject(f, group)
+			if err != nil {
+				return err
+			}
+
+			id, err := resolveMilestoneArg(client, project, group, args[0])
+			if err != nil {
+				return err
+			}
+
+			var resp *gitlab.Response
+			if group != "" {
+				resp, err = client.GroupMilestones.DeleteGroupMilestone(group, id)
+			} else {
+				resp, err = client.Milestones.DeleteMilestone(project, id)
+			}
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/%s/milestones/%d", api.APIURL(client.Host()), milestoneAPIPath(project, group), id)
+				return errors.NewAPIError("DELETE", url, statusCode, "Failed to delete milestone", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Deleted milestone %s\n", args[0])
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&group, "group", "g", "", "Delete a milestone of a group")
+
+	return cmd
+}
+
+// milestoneProject returns the current project path, or an empty string when
+// the command operates on a group.
+func milestoneProject(f *cmdutil.Factory, group string) (string, error) {
+	if group != "" {
+		return "", nil
+	}
+	return f.FullProjectPath()
+}
+
+// milestoneAPIPath returns the API path prefix for project or group milestones.
+func milestoneAPIPath(project, group string) string {
+	if group != "" {
+		return "groups/" + group
+	}
+	return "projects/" + project
+}
+
+// milestoneScopePath returns the web path of the project or group.
+func milestoneScopePath(project, group string) string {
+	if group != "" {
+		return "groups/" + group
+	}
+	return project
+}
+
+// fromGroupMilestone converts a group milestone to the project milestone type
+// so both can share output code.
+func fromGroupMilestone(client *api.Client, group string, gm *gitlab.GroupMilestone) *gitlab.Milestone {
+	return &gitlab.Milestone{
+		ID:          gm.ID,
+		IID:         gm.IID,
+		GroupID:     gm.GroupID,
+		Title:       gm.Title,
+		Description: gm.Description,
+		StartDate:   gm.StartDate,
+		DueDate:     gm.DueDate,
+		State:       gm.State,
+		WebURL:      api.WebURL(client.Host(), fmt.Sprintf("groups/%s/-/milestones/%d", group, gm.IID)),
+		UpdatedAt:   gm.UpdatedAt,
+		CreatedAt:   gm.CreatedAt,
+		Expired:     gm.Expired,
+	}
+}
+
+// parseMilestoneDate parses a YYYY-MM-DD flag value. An empty value yields nil.
+func parseMilestoneDate(flag, value string) (*gitlab.ISOTime, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := gitlab.ParseISOTime(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s %q: expected YYYY-MM-DD", flag, value)
+	}
+	return &t, nil
+}
+
+func listProjectMilestones(client *api.Client, project string, opts *gitlab.ListMilestonesOptions) ([]*gitlab.Milestone, *gitlab.Response, error) {
+	milestones, resp, err := client.Milestones.ListMilestones(project, opts)
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := api.APIURL(client.Host()) + "/projects/" + project + "/milestones"
+		return nil, resp, errors.NewAPIError("GET", url, statusCode, "Failed to list milestones", err)
+	}
+	return milestones, resp, nil
+}
+
+// getMilestone fetches a project or group milestone by ID or title.
+func getMilestone(client *api.Client, project, group, value string) (*gitlab.Milestone, error) {
+	id, err := resolveMilestoneArg(client, project, group, value)
+	if err != nil {
+		return nil, err
+	}
+
+	if group != "" {
+		gm, resp, err := client.GroupMilestones.GetGroupMilestone(group, id)
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			url := fmt.Sprintf("%s/groups/%s/milestones/%d", api.APIURL(client.Host()), group, id)
+			return nil, errors.NewAPIError("GET", url, statusCode, "Failed to get group milestone", err)
+		}
+		return fromGroupMilestone(client, group, gm), nil
+	}
+
+	milestone, resp, err := client.Milestones.GetMilestone(project, id)
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := fmt.Sprintf("%s/projects/%s/milestones/%d", api.APIURL(client.Host()), project, id)
+		return nil, errors.NewAPIError("GET", url, statusCode, "Failed to get milestone", err)
+	}
+	return milestone, nil
+}
+
+// updateMilestone applies opts to a project or group milestone.
+func updateMilestone(client *api.Client, project, group string, id int64, opts *gitlab.UpdateMilestoneOptions) (*gitlab.Milestone, error) {
+	var (
+		milestone *gitlab.Milestone
+		resp      *gitlab.Response
+		err       error
+	)
+	if group != "" {
+		var gm *gitlab.GroupMilestone
+		gm, resp, err = client.GroupMilestones.UpdateGroupMilestone(group, id, &gitlab.UpdateGroupMilestoneOptions{
+			Title:       opts.Title,
+			Description: opts.Description,
+			StartDate:   opts.StartDate,
+			DueDate:     opts.DueDate,
+			StateEvent:  opts.StateEvent,
+		})
+		if err == nil {
+			milestone = fromGroupMilestone(client, group, gm)
+		}
+	} else {
+		milestone, resp, err = client.Milestones.UpdateMilestone(project, id, opts)
+	}
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := fmt.Sprintf("%s/%s/milestones/%d", api.APIURL(client.Host()), milestoneAPIPath(project, group), id)
+		return nil, errors.NewAPIError("PUT", url, statusCode, "Failed to update milestone", err)
+	}
+	return milestone, nil
+}
+
+// resolveMilestoneArg maps a milestone ID or title to its ID, looking in the
+// group when one is given and in the project otherwise.
+func resolveMilestoneArg(client *api.Client, project, group, value string) (int64, error) {
+	if group == "" {
+		return resolveMilestoneID(client, project, value)
+	}
+
+	title := trimMilestoneReference(value)
+	if id, err := strconv.ParseInt(title, 10, 64); err == nil {
+		return id, nil
+	}
+
+	milestones, resp, err := client.GroupMilestones.ListGroupMilestones(group, &gitlab.ListGroupMilestonesOptions{
+		Title: &title,
+	})
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := api.APIURL(client.Host()) + "/groups/" + group + "/milestones"
+		return 0, errors.NewAPIError("GET", url, statusCode, "Failed to look up milestone", err)
+	}
+	for _, m := range milestones {
+		if m.Title == title {
+			return m.ID, nil
+		}
+	}
+	return 0, fmt.Errorf("milestone %q not found in group %s", title, group)
+}
+
+// resolveMilestoneID maps a --milestone flag value to a milestone ID. Numeric
+// values are used as IDs as-is; anything else is matched against the titles
+// of the project's milestones, including those inherited from its groups.
+// GitLab's %"title" reference syntax is accepted as well.
+func resolveMilestoneID(client *api.Client, project, value string) (int64, error) {
+	title := trimMilestoneReference(value)
+	if id, err := strconv.ParseInt(title, 10, 64); err == nil {
+		return id, nil
+	}
+	if title == "" {
+		return 0, fmt.Errorf("milestone cannot be empty")
+	}
+
+	includeAncestors := true
+	milestones, _, err := listProjectMilestones(client, project, &gitlab.ListMilestonesOptions{
+		Title:            &title,
+		IncludeAncestors: &includeAncestors,
+	})
+	if err != nil {
+		return 0, err
+	}
+	for _, m := range milestones {
+		if m.Title == title {
+			return m.ID, nil
+		}
+	}
+	return 0, fmt.Errorf("milestone %q not found in %s or its groups", title, project)
+}
+
+// trimMilestoneReference strips GitLab's %"title" reference markers.
+func trimMilestoneReference(value string) string {
+	value = strings.TrimSpace(value)
+	value = strings.TrimPrefix(value, "%")
+	if len(value) >= 2 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+		value = value[1 : len(value)-1]
+	}
+	return value
+}
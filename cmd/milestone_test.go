@@ -0,0 +1,345 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestNewMilestoneCmd(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewMilestoneCmd(f)
+
+	if cmd.Use != "milestone <command>" {
+		t.Errorf("expected Use to be 'milestone <command>', got %q", cmd.Use)
+	}
+
+	if cmd.Short != "Manage milestones" {
+		t.Errorf("expected Short to be 'Manage milestones', got %q", cmd.Short)
+	}
+}
+
+func TestMilestoneCmd_HasSubcommands(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewMilestoneCmd(f)
+
+	expectedSubcommands := []string{
+		"list",
+		"create",
+		"view",
+		"close",
+		"reopen",
+		"edit",
+		"delete",
+	}
+
+	subcommands := cmd.Commands()
+	if len(subcommands) != len(expectedSubcommands) {
+		t.Errorf("expected %d subcommands, got %d", len(expectedSubcommands), len(subcommands))
+	}
+
+	foundSubcommands := make(map[string]bool)
+	for _, subcmd := range subcommands {
+		foundSubcommands[subcmd.Name()] = true
+	}
+
+	for _, expected := range expectedSubcommands {
+		if !foundSubcommands[expected] {
+			t.Errorf("expected subcommand %q not found", expected)
+		}
+	}
+
+	for _, subcmd := range subcommands {
+		if subcmd.Flags().Lookup("group") == nil {
+			t.Errorf("expected %q to have a --group flag", subcmd.Name())
+		}
+	}
+}
+
+var testMilestone = map[string]interface{}{
+	"id":          42,
+	"iid":         3,
+	"project_id":  1,
+	"title":       "v1.0",
+	"description": "First release",
+	"state":       "active",
+	"due_date":    "2024-12-31",
+	"web_url":     "https://gitlab.com/test-owner/test-repo/-/milestones/3",
+}
+
+func TestMilestoneList_Success(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/projects/test-owner/test-repo/milestones" {
+			if got := r.URL.Query().Get("state"); got != "active" {
+				t.Errorf("expected state=active, got %q", got)
+			}
+			cmdtest.JSONResponse(w, 200, []interface{}{testMilestone})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMilestoneListCmd(f.Factory)
+	cmd.SetArgs([]string{})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(f.IO.String(), "v1.0") {
+		t.Errorf("expected milestone in output, got: %s", f.IO.String())
+	}
+}
+
+func TestMilestoneList_Group(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/groups/my-group/milestones" {
+			if r.URL.Query().Get("state") != "" {
+				t.Errorf("expected no state filter for --state all, got %q", r.URL.Query().Get("state"))
+			}
+			cmdtest.JSONResponse(w, 200, []interface{}{
+				map[string]interface{}{"id": 7, "iid": 2, "group_id": 5, "title": "Q3", "state": "closed"},
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMilestoneListCmd(f.Factory)
+	cmd.SetArgs([]string{"--group", "my-group", "--state", "all", "--format", "json"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := f.IO.String()
+	if !strings.Contains(out, `"title": "Q3"`) {
+		t.Errorf("expected group milestone in output, got: %s", out)
+	}
+	if !strings.Contains(out, "https://gitlab.com/groups/my-group/-/milestones/2") {
+		t.Errorf("expected group milestone web URL, got: %s", out)
+	}
+}
+
+func TestMilestoneList_InvalidState(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMilestoneListCmd(f.Factory)
+	cmd.SetArgs([]string{"--state", "opened"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "invalid state") {
+		t.Fatalf("expected invalid state error, got %v", err)
+	}
+}
+
+func TestMilestoneCreate_Success(t *testing.T) {
+	var body map[string]interface{}
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" && r.URL.Path == "/api/v4/projects/test-owner/test-repo/milestones" {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 201, testMilestone)
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMilestoneCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"--title", "v1.0", "--due-date", "2024-12-31"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body["title"] != "v1.0" || body["due_date"] != "2024-12-31" {
+		t.Errorf("unexpected request body: %v", body)
+	}
+	if !strings.Contains(f.IO.String(), `Created milestone "v1.0" (ID 42)`) {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestMilestoneCreate_InvalidDate(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMilestoneCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"--title", "v1.0", "--due-date", "31/12/2024"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "expected YYYY-MM-DD") {
+		t.Fatalf("expected date error, got %v", err)
+	}
+}
+
+func TestMilestoneView_ByTitle(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v4/projects/test-owner/test-repo/milestones":
+			if got := r.URL.Query().Get("title"); got != "v1.0" {
+				t.Errorf("expected title=v1.0, got %q", got)
+			}
+			cmdtest.JSONResponse(w, 200, []interface{}{testMilestone})
+		case "/api/v4/projects/test-owner/test-repo/milestones/42":
+			cmdtest.JSONResponse(w, 200, testMilestone)
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMilestoneViewCmd(f.Factory)
+	cmd.SetArgs([]string{`%"v1.0"`})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := f.IO.String()
+	for _, want := range []string{"v1.0", "ID:      42", "Due:     2024-12-31", "First release"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got: %s", want, out)
+		}
+	}
+}
+
+func TestMilestoneClose_Success(t *testing.T) {
+	var body map[string]interface{}
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" && r.URL.Path == "/api/v4/projects/test-owner/test-repo/milestones/42" {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 200, testMilestone)
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMilestoneCloseCmd(f.Factory)
+	cmd.SetArgs([]string{"42"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body["state_event"] != "close" {
+		t.Errorf("expected state_event=close, got %v", body["state_event"])
+	}
+	if !strings.Contains(f.IO.String(), `Closed milestone "v1.0"`) {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestMilestoneEdit_NothingToEdit(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMilestoneEditCmd(f.Factory)
+	cmd.SetArgs([]string{"42"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "nothing to edit") {
+		t.Fatalf("expected nothing to edit error, got %v", err)
+	}
+}
+
+func TestMilestoneDelete_Group(t *testing.T) {
+	deleted := false
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v4/groups/my-group/milestones":
+			cmdtest.JSONResponse(w, 200, []interface{}{
+				map[string]interface{}{"id": 7, "iid": 2, "title": "Q3"},
+			})
+		case r.Method == "DELETE" && r.URL.Path == "/api/v4/groups/my-group/milestones/7":
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMilestoneDeleteCmd(f.Factory)
+	cmd.SetArgs([]string{"Q3", "--group", "my-group"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !deleted {
+		t.Error("expected group milestone to be deleted")
+	}
+}
+
+func TestResolveMilestoneID(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/projects/test-owner/test-repo/milestones" {
+			if r.URL.Query().Get("include_ancestors") != "true" {
+				t.Errorf("expected include_ancestors=true")
+			}
+			if r.URL.Query().Get("title") == "Group Q3" {
+				cmdtest.JSONResponse(w, 200, []interface{}{
+					map[string]interface{}{"id": 7, "iid": 2, "group_id": 5, "title": "Group Q3"},
+				})
+				return
+			}
+			cmdtest.JSONResponse(w, 200, []interface{}{})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	client, _ := f.Factory.Client()
+
+	tests := []struct {
+		value   string
+		want    int64
+		wantErr string
+	}{
+		{value: "12", want: 12},
+		{value: "Group Q3", want: 7},
+		{value: `%"Group Q3"`, want: 7},
+		{value: "missing", wantErr: `milestone "missing" not found`},
+		{value: "%", wantErr: "milestone cannot be empty"},
+	}
+
+	for _, tt := range tests {
+		got, err := resolveMilestoneID(client, "test-owner/test-repo", tt.value)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("%q: expected error %q, got %v", tt.value, tt.wantErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", tt.value, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%q: expected %d, got %d", tt.value, tt.want, got)
+		}
+	}
+}
+
+func TestMRCreate_MilestoneTitle(t *testing.T) {
+	var body map[string]interface{}
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && strings.HasSuffix(r.URL.Path, "/milestones"):
+			cmdtest.JSONResponse(w, 200, []interface{}{testMilestone})
+		case r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/merge_requests"):
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 201, cmdtest.FixtureMROpen)
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"--title", "Test MR", "--source-branch", "feature", "--target-branch", "main", "--milestone", "v1.0"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body["milestone_id"] != float64(42) {
+		t.Errorf("expected milestone_id 42, got %v", body["milestone_id"])
+	}
+}
@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"io"
 	"strconv"
 	"strings"
 	"time"
@@ -11,9 +9,10 @@ import (
 	"github.com/PhilipKram/gitlab-cli/internal/api"
 	"github.com/PhilipKram/gitlab-cli/internal/browser"
 	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/config"
 	"github.com/PhilipKram/gitlab-cli/internal/errors"
-	"github.com/PhilipKram/gitlab-cli/internal/formatter"
 	gitutil "github.com/PhilipKram/gitlab-cli/internal/git"
+	"github.com/PhilipKram/gitlab-cli/internal/prompt"
 	"github.com/spf13/cobra"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 )
@@ -21,9 +20,12 @@ import (
 // NewMRCmd creates the merge request command group.
 func NewMRCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "mr <command>",
-		Short:   "Manage merge requests",
-		Long:    "Create, view, and manage GitLab merge requests.",
+		Use:   "mr <command>",
+		Short: "Manage merge requests",
+		Long: `Create, view, and manage GitLab merge requests.
+
+Commands that take an optional merge request ID act on the open merge request
+for the current git branch when the ID is omitted.`,
 		Aliases: []string{"merge-request"},
 	}
 
@@ -31,18 +33,36 @@ func NewMRCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(newMRListCmd(f))
 	cmd.AddCommand(newMRViewCmd(f))
 	cmd.AddCommand(newMRMergeCmd(f))
+	cmd.AddCommand(newMRRebaseCmd(f))
 	cmd.AddCommand(newMRCloseCmd(f))
 	cmd.AddCommand(newMRReopenCmd(f))
 	cmd.AddCommand(newMRApproveCmd(f))
+	cmd.AddCommand(newMRUnapproveCmd(f))
+	cmd.AddCommand(newMRApproversCmd(f))
 	cmd.AddCommand(newMRCheckoutCmd(f))
 	cmd.AddCommand(newMRDiffCmd(f))
+	cmd.AddCommand(newMRApplyCmd(f))
+	cmd.AddCommand(newMRCherryPickCmd(f))
+	cmd.AddCommand(newMRChecksCmd(f))
+	cmd.AddCommand(newMRPipelinesCmd(f))
 	cmd.AddCommand(newMRCommentCmd(f))
 	cmd.AddCommand(newMRSuggestCmd(f))
 	cmd.AddCommand(newMRReplyCmd(f))
 	cmd.AddCommand(newMRResolveCmd(f))
 	cmd.AddCommand(newMRUnresolveCmd(f))
 	cmd.AddCommand(newMREditCmd(f))
+	cmd.AddCommand(newMRDraftCmd(f))
+	cmd.AddCommand(newMRReadyCmd(f))
 	cmd.AddCommand(newMRDiscussionsCmd(f))
+	cmd.AddCommand(newMRDiscussionCmd(f))
+	cmd.AddCommand(newMRRevertCmd(f))
+	cmd.AddCommand(newMRReviewCmd(f))
+	cmd.AddCommand(newMRSubscribeCmd(f))
+	cmd.AddCommand(newMRUnsubscribeCmd(f))
+	cmd.AddCommand(newMRTodoCmd(f))
+	cmd.AddCommand(newMRAttachCmd(f))
+
+	registerIssuableCompletions(f, cmd, fetchMergeRequests)
 
 	return cmd
 }
@@ -61,13 +81,36 @@ func newMRCreateCmd(f *cmdutil.Factory) *cobra.Command {
 		squash       bool
 		removeSource bool
 		web          bool
+		template     string
 	)
 
 	cmd := &cobra.Command{
 		Use:   "create",
 		Short: "Create a merge request",
-		Long:  "Create a new merge request on GitLab.",
-		Example: `  $ glab mr create --title "Add feature" --description "Details here"
+		Long: `Create a new merge request on GitLab.
+
+When run in a terminal without --title, and unless prompts are disabled with
+--prompt=disabled, GLAB_PROMPT_DISABLED, or a CI environment, an interactive
+wizard prompts for the title, opens your editor (the "editor" config key, $GIT_EDITOR, $VISUAL, or
+$EDITOR) for the description, and offers pickers for the target branch, labels,
+reviewers, and draft status. Values given as flags are not prompted for.
+
+If the project has merge request templates, in .gitlab/merge_request_templates/
+or configured on GitLab, the wizard offers them to pre-fill the description.
+Use --template to pick one by name non-interactively.
+
+Without --target-branch, --label, --reviewer, or --squash, the
+defaults.mr_target_branch, defaults.mr_labels, defaults.mr_reviewers, and
+defaults.mr_squash config keys are used. A .glab.yml at the root of the
+repository overrides them for the project.
+
+In a fork, with an "upstream" remote or a default set with "glab repo
+set-default" besides the configured git remote, the merge request is opened
+from the branch of the fork into the upstream project. Use --repo to open
+it within a single project instead.`,
+		Example: `  $ glab mr create
+  $ glab mr create --title "Add feature" --description "Details here"
+  $ glab mr create --title "Add feature" --template Default
   $ glab mr create --title "Fix bug" --target-branch main --draft
   $ glab mr create --title "Update" --assignee @user1 --label bug,urgent`,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -88,8 +131,36 @@ func newMRCreateCmd(f *cmdutil.Factory) *cobra.Command {
 				}
 			}
 
+			cfg, _ := f.Config()
+			if cfg != nil {
+				if targetBranch == "" {
+					targetBranch = cfg.Defaults.MRTargetBranch
+				}
+				if !cmd.Flags().Changed("label") {
+					labels = config.SplitList(cfg.Defaults.MRLabels)
+				}
+				if !cmd.Flags().Changed("reviewer") {
+					reviewers = config.SplitList(cfg.Defaults.MRReviewers)
+				}
+				if !cmd.Flags().Changed("squash") && cfg.Defaults.MRSquash != "" {
+					squash, _ = strconv.ParseBool(cfg.Defaults.MRSquash)
+				}
+			}
+
+			// In a fork, the branch is pushed to the fork (head) and the
+			// merge request proposed to the upstream project (base).
+			sourceProject := project
+			head, base, fork := f.ForkRemotes()
+			if fork {
+				sourceProject = head.Owner + "/" + head.Repo
+				project = base.Owner + "/" + base.Repo
+			}
+
+			remote, rerr := f.Remote()
+			if fork {
+				remote, rerr = base, nil
+			}
 			if targetBranch == "" {
-				remote, rerr := f.Remote()
 				if rerr == nil {
 					targetBranch, _ = gitutil.DefaultBranch(remote.Name)
 				}
@@ -98,6 +169,51 @@ func newMRCreateCmd(f *cmdutil.Factory) *cobra.Command {
 				}
 			}
 
+			if template != "" {
+				if cmd.Flags().Changed("description") {
+					return fmt.Errorf("--template and --description cannot be used together")
+				}
+				description, err = findDescriptionTemplate(f, client, project, mergeRequestTemplates, template)
+				if err != nil {
+					return err
+				}
+			}
+
+			if title == "" {
+				if !f.IOStreams.CanPrompt() {
+					return fmt.Errorf("--title is required when not running interactively")
+				}
+				survey := &mrCreateSurvey{
+					Description:      description,
+					SourceBranch:     sourceBranch,
+					TargetBranch:     targetBranch,
+					Labels:           labels,
+					Reviewers:        reviewers,
+					Draft:            draft,
+					SkipDescription:  cmd.Flags().Changed("description"),
+					SkipTargetBranch: cmd.Flags().Changed("target-branch"),
+					SkipDraft:        cmd.Flags().Changed("draft"),
+				}
+				submit, err := runMRCreateSurvey(f, client, project, survey)
+				if err != nil {
+					return err
+				}
+				if !submit {
+					_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "Discarded.")
+					return nil
+				}
+				title = survey.Title
+				description = survey.Description
+				targetBranch = survey.TargetBranch
+				labels = survey.Labels
+				reviewers = survey.Reviewers
+				draft = survey.Draft
+			}
+
+			if rerr == nil {
+				warnOnSecrets(f, remote.Name+"/"+targetBranch, sourceBranch)
+			}
+
 			opts := &gitlab.CreateMergeRequestOptions{
 				Title:        &title,
 				Description:  &description,
@@ -127,9 +243,9 @@ func newMRCreateCmd(f *cmdutil.Factory) *cobra.Command {
 			}
 
 			if milestone != "" {
-				mid, err := strconv.ParseInt(milestone, 10, 64)
+				mid, err := resolveMilestoneID(client, project, milestone)
 				if err != nil {
-					return fmt.Errorf("invalid milestone ID: %s", milestone)
+					return err
 				}
 				opts.MilestoneID = &mid
 			}
@@ -144,13 +260,27 @@ func newMRCreateCmd(f *cmdutil.Factory) *cobra.Command {
 			opts.Squash = &squash
 			opts.RemoveSourceBranch = &removeSource
 
-			mr, resp, err := client.MergeRequests.CreateMergeRequest(project, opts)
+			if fork {
+				target, resp, err := client.Projects.GetProject(project, nil)
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/projects/" + project
+					return errors.NewAPIError("GET", url, statusCode, "Failed to get target project", err)
+				}
+				opts.TargetProjectID = &target.ID
+				_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Creating merge request from %s:%s into %s:%s\n", sourceProject, sourceBranch, project, targetBranch)
+			}
+
+			mr, resp, err := client.MergeRequests.CreateMergeRequest(sourceProject, opts)
 			if err != nil {
 				statusCode := 0
 				if resp != nil {
 					statusCode = resp.StatusCode
 				}
-				url := api.APIURL(client.Host()) + "/projects/" + project + "/merge_requests"
+				url := api.APIURL(client.Host()) + "/projects/" + sourceProject + "/merge_requests"
 				return errors.NewAPIError("POST", url, statusCode, "Failed to create merge request", err)
 			}
 
@@ -166,36 +296,195 @@ func newMRCreateCmd(f *cmdutil.Factory) *cobra.Command {
 		},
 	}
 
-	cmd.Flags().StringVarP(&title, "title", "t", "", "Title of the merge request (required)")
+	cmd.Flags().StringVarP(&title, "title", "t", "", "Title of the merge request (prompted for when omitted in a terminal)")
 	cmd.Flags().StringVarP(&description, "description", "d", "", "Description of the merge request")
+	cmd.Flags().StringVar(&template, "template", "", "Use the named merge request template as the description")
 	cmd.Flags().StringVarP(&sourceBranch, "source-branch", "s", "", "Source branch (default: current branch)")
 	cmd.Flags().StringVarP(&targetBranch, "target-branch", "b", "", "Target branch (default: repository default)")
 	cmd.Flags().StringSliceVarP(&assignees, "assignee", "a", nil, "Assign users by username")
 	cmd.Flags().StringSliceVar(&reviewers, "reviewer", nil, "Request review from users by username")
 	cmd.Flags().StringSliceVarP(&labels, "label", "l", nil, "Add labels")
-	cmd.Flags().StringVarP(&milestone, "milestone", "m", "", "Milestone ID")
+	cmd.Flags().StringVarP(&milestone, "milestone", "m", "", "Milestone ID or title")
 	cmd.Flags().BoolVar(&draft, "draft", false, "Mark as draft")
 	cmd.Flags().BoolVar(&squash, "squash", false, "Squash commits on merge")
 	cmd.Flags().BoolVar(&removeSource, "remove-source-branch", false, "Remove source branch on merge")
 	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open in browser after creation")
-	_ = cmd.MarkFlagRequired("title")
 
 	return cmd
 }
 
+// mrCreateSurvey holds the merge request fields collected by the interactive
+// create wizard. Fields pre-filled from flags are not prompted for.
+type mrCreateSurvey struct {
+	Title        string
+	Description  string
+	SourceBranch string
+	TargetBranch string
+	Labels       []string
+	Reviewers    []string
+	Draft        bool
+
+	SkipDescription  bool
+	SkipTargetBranch bool
+	SkipDraft        bool
+}
+
+// runMRCreateSurvey interactively prompts for the merge request fields that
+// were not given as flags. It reports whether the user chose to submit.
+func runMRCreateSurvey(f *cmdutil.Factory, client *api.Client, project string, s *mrCreateSurvey) (bool, error) {
+	in := f.IOStreams.In
+	errOut := f.IOStreams.ErrOut
+
+	_, _ = fmt.Fprintf(errOut, "Creating merge request for %s in %s\n\n", s.SourceBranch, project)
+
+	title, err := prompt.Input(in, errOut, "Title:")
+	if err != nil {
+		return false, err
+	}
+	if title == "" {
+		return false, fmt.Errorf("title cannot be empty")
+	}
+	s.Title = title
+
+	if !s.SkipDescription {
+		if s.Description == "" {
+			s.Description, err = pickDescriptionTemplate(f, client, project, mergeRequestTemplates)
+			if err != nil {
+				return false, err
+			}
+		}
+		useEditor, err := prompt.Confirm(in, errOut, "Write a description in your editor?", true)
+		if err != nil {
+			return false, err
+		}
+		if useEditor {
+			cfg, _ := f.Config()
+			description, err := cmdutil.EditText(cmdutil.DetermineEditor(cfg), s.Description, in, f.IOStreams.Out, errOut)
+			if err != nil {
+				return false, err
+			}
+			s.Description = description
+		}
+	}
+
+	if !s.SkipTargetBranch {
+		branches, err := mrCreateTargetBranches(client, project, s.SourceBranch, s.TargetBranch)
+		if err != nil {
+			return false, err
+		}
+		if len(branches) > 1 {
+			idx, err := prompt.Select(in, errOut, "Target branch:", branches)
+			if err != nil {
+				return false, err
+			}
+			s.TargetBranch = branches[idx]
+		}
+	}
+
+	if len(s.Labels) == 0 {
+		labels, resp, err := client.Labels.ListLabels(project, &gitlab.ListLabelsOptions{
+			ListOptions: gitlab.ListOptions{PerPage: 100},
+		})
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			url := api.APIURL(client.Host()) + "/projects/" + project + "/labels"
+			return false, errors.NewAPIError("GET", url, statusCode, "Failed to list labels", err)
+		}
+		if len(labels) > 0 {
+			names := make([]string, len(labels))
+			for i, l := range labels {
+				names[i] = l.Name
+			}
+			picked, err := prompt.MultiSelect(in, errOut, "Labels:", names)
+			if err != nil {
+				return false, err
+			}
+			for _, i := range picked {
+				s.Labels = append(s.Labels, names[i])
+			}
+		}
+	}
+
+	if len(s.Reviewers) == 0 {
+		members, resp, err := client.ProjectMembers.ListAllProjectMembers(project, &gitlab.ListProjectMembersOptions{
+			ListOptions: gitlab.ListOptions{PerPage: 100},
+		})
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			url := api.APIURL(client.Host()) + "/projects/" + project + "/members/all"
+			return false, errors.NewAPIError("GET", url, statusCode, "Failed to list project members", err)
+		}
+		if len(members) > 0 {
+			usernames := make([]string, len(members))
+			for i, m := range members {
+				usernames[i] = m.Username
+			}
+			picked, err := prompt.MultiSelect(in, errOut, "Reviewers:", usernames)
+			if err != nil {
+				return false, err
+			}
+			for _, i := range picked {
+				s.Reviewers = append(s.Reviewers, usernames[i])
+			}
+		}
+	}
+
+	if !s.SkipDraft {
+		draft, err := prompt.Confirm(in, errOut, "Mark as draft?", false)
+		if err != nil {
+			return false, err
+		}
+		s.Draft = draft
+	}
+
+	return prompt.Confirm(in, errOut, "Submit merge request?", true)
+}
+
+// mrCreateTargetBranches returns the candidate target branches for a merge
+// request, with the default target first and the source branch excluded.
+func mrCreateTargetBranches(client *api.Client, project, sourceBranch, defaultTarget string) ([]string, error) {
+	branches, resp, err := client.Branches.ListBranches(project, &gitlab.ListBranchesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100},
+	})
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := api.APIURL(client.Host()) + "/projects/" + project + "/repository/branches"
+		return nil, errors.NewAPIError("GET", url, statusCode, "Failed to list branches", err)
+	}
+
+	names := []string{defaultTarget}
+	for _, b := range branches {
+		if b.Name != defaultTarget && b.Name != sourceBranch {
+			names = append(names, b.Name)
+		}
+	}
+	return names, nil
+}
+
 func newMRListCmd(f *cmdutil.Factory) *cobra.Command {
 	var (
-		state     string
-		author    string
-		assignee  string
-		labels    []string
-		milestone string
-		search    string
-		limit     int
-		jsonFlag  bool
-		format    string
-		web       bool
-		stream    bool
+		state       string
+		author      string
+		assignee    string
+		labels      []string
+		milestone   string
+		search      string
+		limit       int
+		jsonFlag    bool
+		format      string
+		web         bool
+		stream      bool
+		draft       bool
+		interactive bool
 	)
 
 	cmd := &cobra.Command{
@@ -206,8 +495,16 @@ func newMRListCmd(f *cmdutil.Factory) *cobra.Command {
 		Example: `  $ glab mr list
   $ glab mr list --state merged --author johndoe
   $ glab mr list --label bug --limit 50
+  $ glab mr list --draft=false
+  $ glab mr list --interactive
   $ glab mr list --json`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if interactive {
+				if err := checkInteractive(cmd); err != nil {
+					return err
+				}
+			}
+
 			client, err := f.Client()
 			if err != nil {
 				return err
@@ -248,6 +545,9 @@ func newMRListCmd(f *cmdutil.Factory) *cobra.Command {
 			if search != "" {
 				opts.Search = &search
 			}
+			if cmd.Flags().Changed("draft") {
+				opts.Draft = &draft
+			}
 
 			outputFormat, err := f.ResolveFormat(format, jsonFlag)
 			if err != nil {
@@ -256,14 +556,14 @@ func newMRListCmd(f *cmdutil.Factory) *cobra.Command {
 
 			// Use streaming mode if --stream flag is set
 			if stream {
-				ctx := context.Background()
+				ctx := cmd.Context()
 				fetchFunc := func(page int) ([]*gitlab.BasicMergeRequest, *gitlab.Response, error) {
 					pageOpts := *opts
 					pageOpts.Page = int64(page)
 					if pageOpts.PerPage == 0 {
 						pageOpts.PerPage = 100
 					}
-					return client.MergeRequests.ListProjectMergeRequests(project, &pageOpts)
+					return client.MergeRequests.ListProjectMergeRequests(project, &pageOpts, gitlab.WithContext(ctx))
 				}
 
 				paginateOpts := api.PaginateOptions{
@@ -295,6 +595,10 @@ func newMRListCmd(f *cmdutil.Factory) *cobra.Command {
 				return nil
 			}
 
+			if interactive {
+				return mrInteractiveList(f, project, mrs).Run(f.IOStreams.In, f.IOStreams.Out)
+			}
+
 			return f.FormatAndPrint(mrs, string(outputFormat), false)
 		},
 	}
@@ -305,17 +609,23 @@ func newMRListCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.Flags().StringSliceVarP(&labels, "label", "l", nil, "Filter by labels")
 	cmd.Flags().StringVarP(&milestone, "milestone", "m", "", "Filter by milestone")
 	cmd.Flags().StringVar(&search, "search", "", "Search in title and description")
+	cmd.Flags().BoolVar(&draft, "draft", false, "Only list drafts (--draft=false to exclude drafts)")
 	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	f.AddExportFlags(cmd)
 	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open in browser")
 	cmd.Flags().BoolVar(&stream, "stream", false, "Enable streaming mode")
+	cmd.Flags().BoolVarP(&interactive, "interactive", "i", false, "Browse the results interactively: filter, view, open, check out, or approve")
 
 	return cmd
 }
 
 func newMRViewCmd(f *cmdutil.Factory) *cobra.Command {
 	var web bool
+	var raw bool
+	var comments bool
+	var since string
 	var format string
 	var jsonFlag bool
 
@@ -324,7 +634,8 @@ func newMRViewCmd(f *cmdutil.Factory) *cobra.Command {
 		Short: "View a merge request",
 		Long:  "Display the details of a merge request.",
 		Example: `  $ glab mr view 123
-  $ glab mr view 123 --web`,
+  $ glab mr view 123 --web
+  $ glab mr view 123 --comments --since 2d`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := f.Client()
 			if err != nil {
@@ -336,7 +647,7 @@ func newMRViewCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			mrID, err := parseMRArg(args)
+			mrID, err := resolveMRArg(client, project, args)
 			if err != nil {
 				return err
 			}
@@ -355,20 +666,56 @@ func newMRViewCmd(f *cmdutil.Factory) *cobra.Command {
 				return browser.Open(mr.WebURL)
 			}
 
+			var discussions []*gitlab.Discussion
+			comments = comments || since != ""
+			if comments {
+				var sinceTime time.Time
+				if since != "" {
+					sinceTime, err = parseSince(since)
+					if err != nil {
+						return err
+					}
+				}
+				discussions, resp, err = listAllDiscussions(func(opts gitlab.ListOptions) ([]*gitlab.Discussion, *gitlab.Response, error) {
+					return client.Discussions.ListMergeRequestDiscussions(project, mrID, &gitlab.ListMergeRequestDiscussionsOptions{ListOptions: opts}, gitlab.WithContext(cmd.Context()))
+				})
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/merge_requests/%d/discussions", api.APIURL(client.Host()), project, mrID)
+					return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to list discussions for merge request !%d", mrID), err)
+				}
+				discussions = filterComments(discussions, sinceTime)
+			}
+
 			// Backward compatibility: --json flag sets format to json
 			if jsonFlag {
 				_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Warning: --json is deprecated, use --format=json instead\n")
 				format = "json"
 			}
 
-			if format != "" && format != "table" {
+			if f.ExportRequested() || (format != "" && format != "table") {
+				if comments {
+					// Embedding keeps the mr's own fields at the top level.
+					return f.FormatAndPrint(struct {
+						*gitlab.MergeRequest
+						Discussions []*gitlab.Discussion `json:"discussions"`
+					}{mr, discussions}, format, false)
+				}
 				return f.FormatAndPrint(mr, format, false)
 			}
 
 			// Default custom display
 			out := f.IOStreams.Out
-			_, _ = fmt.Fprintf(out, "!%d %s\n", mr.IID, mr.Title)
-			_, _ = fmt.Fprintf(out, "State:   %s\n", mr.State)
+			cs := f.IOStreams.ColorScheme()
+			_, _ = fmt.Fprintf(out, "%s %s\n", cs.Bold(fmt.Sprintf("!%d", mr.IID)), cs.Bold(mr.Title))
+			if mr.Draft && mr.State == "opened" {
+				_, _ = fmt.Fprintf(out, "State:   %s %s\n", cs.State(mr.State), cs.Bold(cs.Gray("(draft)")))
+			} else {
+				_, _ = fmt.Fprintf(out, "State:   %s\n", cs.State(mr.State))
+			}
 			_, _ = fmt.Fprintf(out, "Author:  %s\n", mr.Author.Username)
 			_, _ = fmt.Fprintf(out, "Branch:  %s -> %s\n", mr.SourceBranch, mr.TargetBranch)
 			if mr.Assignee != nil {
@@ -390,7 +737,10 @@ func newMRViewCmd(f *cmdutil.Factory) *cobra.Command {
 			_, _ = fmt.Fprintf(out, "Created: %s\n", timeAgo(mr.CreatedAt))
 			_, _ = fmt.Fprintf(out, "URL:     %s\n", mr.WebURL)
 			if mr.Description != "" {
-				_, _ = fmt.Fprintf(out, "\n%s\n", mr.Description)
+				_, _ = fmt.Fprintf(out, "\n%s\n", renderMarkdown(f, mr.Description, raw))
+			}
+			if comments {
+				printComments(f, discussions, raw)
 			}
 
 			return nil
@@ -398,8 +748,12 @@ func newMRViewCmd(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open in browser")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().BoolVar(&raw, "raw", false, "Print the description as raw markdown")
+	cmd.Flags().BoolVarP(&comments, "comments", "c", false, "Show discussion threads and comments")
+	cmd.Flags().StringVar(&since, "since", "", "Only show comments created since a date (YYYY-MM-DD) or duration (e.g. 24h, 7d); implies --comments")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
 
 	return cmd
 }
@@ -409,15 +763,23 @@ func newMRMergeCmd(f *cmdutil.Factory) *cobra.Command {
 		squash       bool
 		removeSource bool
 		message      string
-		whenPipeline bool
+		auto         bool
+		mergeTrain   bool
 	)
 
 	cmd := &cobra.Command{
 		Use:   "merge [<id>]",
 		Short: "Merge a merge request",
+		Long: `Merge a merge request.
+
+With --auto, the merge request is merged automatically once its pipeline
+succeeds. With --merge-train, it is added to the merge train of its target
+branch instead of being merged directly; combine it with --auto to join the
+train only after the pipeline succeeds.`,
 		Example: `  $ glab mr merge 123
   $ glab mr merge 123 --squash --remove-source-branch
-  $ glab mr merge 123 --when-pipeline-succeeds`,
+  $ glab mr merge 123 --auto
+  $ glab mr merge 123 --merge-train`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := f.Client()
 			if err != nil {
@@ -429,11 +791,39 @@ func newMRMergeCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			mrID, err := parseMRArg(args)
+			mrID, err := resolveMRArg(client, project, args)
 			if err != nil {
 				return err
 			}
 
+			if mergeTrain {
+				if cmd.Flags().Changed("remove-source-branch") || message != "" {
+					return fmt.Errorf("--remove-source-branch and --message cannot be used with --merge-train")
+				}
+				opts := &gitlab.AddMergeRequestToMergeTrainOptions{}
+				if squash {
+					opts.Squash = &squash
+				}
+				if auto {
+					opts.AutoMerge = &auto
+				}
+				_, resp, err := client.MergeTrains.AddMergeRequestToMergeTrain(project, mrID, opts, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/merge_trains/merge_requests/%d", api.APIURL(client.Host()), project, mrID)
+					return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to add merge request !%d to the merge train", mrID), err)
+				}
+				if auto {
+					_, _ = fmt.Fprintf(f.IOStreams.Out, "Merge request !%d will be added to the merge train when the pipeline succeeds\n", mrID)
+				} else {
+					_, _ = fmt.Fprintf(f.IOStreams.Out, "Added merge request !%d to the merge train\n", mrID)
+				}
+				return nil
+			}
+
 			opts := &gitlab.AcceptMergeRequestOptions{
 				Squash:                   &squash,
 				ShouldRemoveSourceBranch: &removeSource,
@@ -443,9 +833,8 @@ func newMRMergeCmd(f *cmdutil.Factory) *cobra.Command {
 				opts.MergeCommitMessage = &message
 			}
 
-			if whenPipeline {
-				autoMerge := true
-				opts.AutoMerge = &autoMerge
+			if auto {
+				opts.AutoMerge = &auto
 			}
 
 			mr, resp, err := client.MergeRequests.AcceptMergeRequest(project, mrID, opts)
@@ -458,6 +847,10 @@ func newMRMergeCmd(f *cmdutil.Factory) *cobra.Command {
 				return errors.NewAPIError("PUT", url, statusCode, fmt.Sprintf("Failed to merge merge request !%d", mrID), err)
 			}
 
+			if mr.State != "merged" && auto {
+				_, _ = fmt.Fprintf(f.IOStreams.Out, "Merge request !%d will be merged when the pipeline succeeds\n", mr.IID)
+				return nil
+			}
 			_, _ = fmt.Fprintf(f.IOStreams.Out, "Merged merge request !%d\n", mr.IID)
 			return nil
 		},
@@ -466,7 +859,10 @@ func newMRMergeCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.Flags().BoolVar(&squash, "squash", false, "Squash commits")
 	cmd.Flags().BoolVar(&removeSource, "remove-source-branch", false, "Remove source branch")
 	cmd.Flags().StringVar(&message, "message", "", "Custom merge commit message")
-	cmd.Flags().BoolVar(&whenPipeline, "when-pipeline-succeeds", false, "Merge automatically when pipeline succeeds")
+	cmd.Flags().BoolVar(&auto, "auto", false, "Merge automatically when the pipeline succeeds")
+	cmd.Flags().BoolVar(&mergeTrain, "merge-train", false, "Add to the merge train instead of merging directly")
+	cmd.Flags().BoolVar(&auto, "when-pipeline-succeeds", false, "Merge automatically when pipeline succeeds")
+	_ = cmd.Flags().MarkDeprecated("when-pipeline-succeeds", "use --auto instead")
 
 	return cmd
 }
@@ -487,7 +883,7 @@ func newMRCloseCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			mrID, err := parseMRArg(args)
+			mrID, err := resolveMRArg(client, project, args)
 			if err != nil {
 				return err
 			}
@@ -531,7 +927,7 @@ func newMRReopenCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			mrID, err := parseMRArg(args)
+			mrID, err := resolveMRArg(client, project, args)
 			if err != nil {
 				return err
 			}
@@ -575,7 +971,7 @@ func newMRApproveCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			mrID, err := parseMRArg(args)
+			mrID, err := resolveMRArg(client, project, args)
 			if err != nil {
 				return err
 			}
@@ -599,11 +995,21 @@ func newMRApproveCmd(f *cmdutil.Factory) *cobra.Command {
 }
 
 func newMRCheckoutCmd(f *cmdutil.Factory) *cobra.Command {
+	var branch string
+
 	cmd := &cobra.Command{
-		Use:     "checkout [<id>]",
-		Short:   "Check out a merge request branch locally",
+		Use:   "checkout [<id>]",
+		Short: "Check out a merge request branch locally",
+		Long: `Check out a merge request locally.
+
+The merge request's head is fetched from refs/merge-requests/<id>/head, so this
+works for merge requests from forks and for branches that have not been fetched
+yet. The local branch is named after the source branch (or
+mr-<id>-<source-branch> for forks) and set up to track it, so git pull picks up
+new pushes. An existing local branch is fast-forwarded.`,
 		Aliases: []string{"co"},
-		Example: `  $ glab mr checkout 123`,
+		Example: `  $ glab mr checkout 123
+  $ glab mr checkout 123 --branch review/login-fix`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := f.Client()
 			if err != nil {
@@ -615,7 +1021,7 @@ func newMRCheckoutCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			mrID, err := parseMRArg(args)
+			mrID, err := resolveMRArg(client, project, args)
 			if err != nil {
 				return err
 			}
@@ -630,59 +1036,18 @@ func newMRCheckoutCmd(f *cmdutil.Factory) *cobra.Command {
 				return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get merge request !%d", mrID), err)
 			}
 
-			if err := gitutil.CheckoutBranch(mr.SourceBranch); err != nil {
-				return fmt.Errorf("checking out branch %s: %w", mr.SourceBranch, err)
-			}
-
-			_, _ = fmt.Fprintf(f.IOStreams.Out, "Switched to branch '%s'\n", mr.SourceBranch)
-			return nil
-		},
-	}
-
-	return cmd
-}
-
-func newMRDiffCmd(f *cmdutil.Factory) *cobra.Command {
-	cmd := &cobra.Command{
-		Use:     "diff [<id>]",
-		Short:   "View changes in a merge request",
-		Example: `  $ glab mr diff 123`,
-		RunE: func(cmd *cobra.Command, args []string) error {
-			client, err := f.Client()
-			if err != nil {
-				return err
-			}
-
-			project, err := f.FullProjectPath()
-			if err != nil {
-				return err
-			}
-
-			mrID, err := parseMRArg(args)
-			if err != nil {
-				return err
-			}
-
-			diffs, resp, err := client.MergeRequests.ListMergeRequestDiffs(project, mrID, nil)
+			local, err := cmdutil.CheckoutMergeRequest(f, &mr.BasicMergeRequest, branch)
 			if err != nil {
-				statusCode := 0
-				if resp != nil {
-					statusCode = resp.StatusCode
-				}
-				url := fmt.Sprintf("%s/projects/%s/merge_requests/%d/diffs", api.APIURL(client.Host()), project, mrID)
-				return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get merge request diffs for !%d", mrID), err)
-			}
-
-			out := f.IOStreams.Out
-			for _, diff := range diffs {
-				_, _ = fmt.Fprintf(out, "--- a/%s\n+++ b/%s\n", diff.OldPath, diff.NewPath)
-				_, _ = fmt.Fprintln(out, diff.Diff)
+				return fmt.Errorf("checking out merge request !%d: %w", mrID, err)
 			}
 
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Switched to branch '%s'\n", local)
 			return nil
 		},
 	}
 
+	cmd.Flags().StringVarP(&branch, "branch", "b", "", "Name of the local branch (default: source branch)")
+
 	return cmd
 }
 
@@ -716,7 +1081,7 @@ inline diff comment on the specified file and line.`,
 				return err
 			}
 
-			mrID, err := parseMRArg(args)
+			mrID, err := resolveMRArg(client, project, args)
 			if err != nil {
 				return err
 			}
@@ -834,7 +1199,7 @@ directly from the merge request interface.`,
 				return err
 			}
 
-			mrID, err := parseMRArg(args)
+			mrID, err := resolveMRArg(client, project, args)
 			if err != nil {
 				return err
 			}
@@ -849,16 +1214,7 @@ directly from the merge request interface.`,
 				return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get merge request !%d", mrID), err)
 			}
 
-			posType := "text"
-			position := &gitlab.PositionOptions{
-				BaseSHA:      &mr.DiffRefs.BaseSha,
-				HeadSHA:      &mr.DiffRefs.HeadSha,
-				StartSHA:     &mr.DiffRefs.StartSha,
-				NewPath:      &file,
-				OldPath:      &file,
-				PositionType: &posType,
-				NewLine:      &line,
-			}
+			position := newDiffPosition(mr, file, line, 0)
 
 			// Format body as GitLab suggestion
 			suggestionBody := fmt.Sprintf("```suggestion\n%s\n```", body)
@@ -917,7 +1273,7 @@ func newMRReplyCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			mrID, err := parseMRArg(args)
+			mrID, err := resolveMRArg(client, project, args)
 			if err != nil {
 				return err
 			}
@@ -968,7 +1324,7 @@ func newMRResolveCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			mrID, err := parseMRArg(args)
+			mrID, err := resolveMRArg(client, project, args)
 			if err != nil {
 				return err
 			}
@@ -1018,7 +1374,7 @@ func newMRUnresolveCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			mrID, err := parseMRArg(args)
+			mrID, err := resolveMRArg(client, project, args)
 			if err != nil {
 				return err
 			}
@@ -1075,7 +1431,7 @@ func newMREditCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			mrID, err := parseMRArg(args)
+			mrID, err := resolveMRArg(client, project, args)
 			if err != nil {
 				return err
 			}
@@ -1107,9 +1463,9 @@ func newMREditCmd(f *cmdutil.Factory) *cobra.Command {
 				opts.Labels = &labelOpts
 			}
 			if cmd.Flags().Changed("milestone") {
-				mid, err := strconv.ParseInt(milestone, 10, 64)
+				mid, err := resolveMilestoneID(client, project, milestone)
 				if err != nil {
-					return fmt.Errorf("invalid milestone ID: %s", milestone)
+					return err
 				}
 				opts.MilestoneID = &mid
 			}
@@ -1134,145 +1490,23 @@ func newMREditCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.Flags().StringSliceVarP(&assignees, "assignee", "a", nil, "Assignees")
 	cmd.Flags().StringSliceVar(&reviewers, "reviewer", nil, "Reviewers")
 	cmd.Flags().StringSliceVarP(&labels, "label", "l", nil, "Labels")
-	cmd.Flags().StringVarP(&milestone, "milestone", "m", "", "Milestone ID")
+	cmd.Flags().StringVarP(&milestone, "milestone", "m", "", "Milestone ID or title")
 
 	return cmd
 }
 
+// newMRDiscussionsCmd returns "mr discussions", a shorthand for
+// "mr discussion list".
 func newMRDiscussionsCmd(f *cmdutil.Factory) *cobra.Command {
-	var (
-		format   string
-		jsonFlag bool
-	)
-
-	cmd := &cobra.Command{
-		Use:   "discussions [<id>]",
-		Short: "List discussion threads on a merge request",
-		Long:  "List all discussion threads on a merge request, showing their status and content.",
-		Example: `  $ glab mr discussions 123
-  $ glab mr discussions 123 --format json
-  $ glab mr discussions 123 --format plain`,
-		RunE: func(cmd *cobra.Command, args []string) error {
-			client, err := f.Client()
-			if err != nil {
-				return err
-			}
-
-			project, err := f.FullProjectPath()
-			if err != nil {
-				return err
-			}
-
-			mrID, err := parseMRArg(args)
-			if err != nil {
-				return err
-			}
-
-			discussions, resp, err := client.Discussions.ListMergeRequestDiscussions(project, mrID, nil)
-			if err != nil {
-				statusCode := 0
-				if resp != nil {
-					statusCode = resp.StatusCode
-				}
-				url := fmt.Sprintf("%s/projects/%s/merge_requests/%d/discussions", api.APIURL(client.Host()), project, mrID)
-				return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to list discussions for merge request !%d", mrID), err)
-			}
-
-			if len(discussions) == 0 {
-				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No discussions found on this merge request")
-				return nil
-			}
-
-			outputFormat, err := f.ResolveFormat(format, jsonFlag)
-			if err != nil {
-				return err
-			}
-
-			if outputFormat == formatter.JSONFormat {
-				return f.FormatAndPrint(discussions, format, jsonFlag)
-			}
-
-			return printDiscussions(f.IOStreams.Out, discussions)
-		},
-	}
-
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
-	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
-
+	cmd := newMRDiscussionListCmd(f)
+	cmd.Use = "discussions [<id>]"
+	cmd.Long = `List discussion threads on a merge request. This is the same as "glab mr discussion list".`
+	cmd.Example = `  $ glab mr discussions 123
+  $ glab mr discussions 123 --unresolved
+  $ glab mr discussions 123 --format json`
 	return cmd
 }
 
-// printDiscussions renders discussions in a human-readable format showing
-// inline comment context (file, line), author, resolution status, and threaded replies.
-func printDiscussions(out io.Writer, discussions []*gitlab.Discussion) error {
-	idx := 0
-	for _, d := range discussions {
-		if len(d.Notes) == 0 {
-			continue
-		}
-
-		// Skip system-only discussions
-		firstNote := d.Notes[0]
-		if firstNote.System {
-			continue
-		}
-
-		idx++
-		if idx > 1 {
-			_, _ = fmt.Fprintln(out)
-		}
-
-		// Header: #N (status) author [on file:line]
-		var header strings.Builder
-		_, _ = fmt.Fprintf(&header, "#%d", idx)
-		if firstNote.Resolvable {
-			if firstNote.Resolved {
-				header.WriteString(" (resolved)")
-			} else {
-				header.WriteString(" (unresolved)")
-			}
-		}
-		header.WriteString(" ")
-		header.WriteString(firstNote.Author.Username)
-		if pos := firstNote.Position; pos != nil {
-			path := pos.NewPath
-			line := pos.NewLine
-			if path == "" {
-				path = pos.OldPath
-			}
-			if line == 0 {
-				line = pos.OldLine
-			}
-			if path != "" {
-				_, _ = fmt.Fprintf(&header, " on %s", path)
-				if line != 0 {
-					_, _ = fmt.Fprintf(&header, ":%d", line)
-				}
-			}
-		}
-		_, _ = fmt.Fprintln(out, header.String())
-
-		// Body of the first note
-		for _, line := range strings.Split(strings.TrimRight(firstNote.Body, "\n"), "\n") {
-			_, _ = fmt.Fprintf(out, "  %s\n", line)
-		}
-
-		// Threaded replies
-		for _, reply := range d.Notes[1:] {
-			if reply.System {
-				continue
-			}
-			_, _ = fmt.Fprintf(out, "  └─ %s: ", reply.Author.Username)
-			lines := strings.Split(strings.TrimRight(reply.Body, "\n"), "\n")
-			_, _ = fmt.Fprintln(out, lines[0])
-			for _, l := range lines[1:] {
-				_, _ = fmt.Fprintf(out, "     %s\n", l)
-			}
-		}
-	}
-	return nil
-}
-
 // parseMRArg parses the merge request ID from command args.
 func parseMRArg(args []string) (int64, error) {
 	if len(args) == 0 {
@@ -1286,6 +1520,54 @@ func parseMRArg(args []string) (int64, error) {
 	return n, nil
 }
 
+// resolveMRArg parses the merge request ID from command args. When no ID is
+// given, it resolves the open merge request for the current git branch.
+func resolveMRArg(client *api.Client, project string, args []string) (int64, error) {
+	if len(args) > 0 {
+		return parseMRArg(args)
+	}
+
+	branch, err := gitutil.CurrentBranch()
+	if err != nil {
+		return 0, fmt.Errorf("merge request ID required: %w", err)
+	}
+	if branch == "HEAD" {
+		return 0, fmt.Errorf("merge request ID required: not on a branch")
+	}
+	return findMRForBranch(client, project, branch)
+}
+
+// findMRForBranch returns the IID of the open merge request whose source
+// branch is branch.
+func findMRForBranch(client *api.Client, project, branch string) (int64, error) {
+	state := "opened"
+	mrs, resp, err := client.MergeRequests.ListProjectMergeRequests(project, &gitlab.ListProjectMergeRequestsOptions{
+		State:        &state,
+		SourceBranch: &branch,
+	})
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := api.APIURL(client.Host()) + "/projects/" + project + "/merge_requests"
+		return 0, errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to find merge request for branch %s", branch), err)
+	}
+
+	switch len(mrs) {
+	case 0:
+		return 0, fmt.Errorf("no open merge request found for branch %q; specify a merge request ID", branch)
+	case 1:
+		return mrs[0].IID, nil
+	default:
+		refs := make([]string, len(mrs))
+		for i, mr := range mrs {
+			refs[i] = fmt.Sprintf("!%d (into %s)", mr.IID, mr.TargetBranch)
+		}
+		return 0, fmt.Errorf("multiple open merge requests found for branch %q: %s; specify a merge request ID", branch, strings.Join(refs, ", "))
+	}
+}
+
 // resolveUserIDs converts usernames to GitLab user IDs.
 func resolveUserIDs(client *api.Client, usernames []string) ([]int64, error) {
 	var ids []int64
@@ -0,0 +1,204 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/PhilipKram/gitlab-cli/internal/git"
+	"github.com/PhilipKram/gitlab-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func newMRApplyCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		index    bool
+		threeWay bool
+		check    bool
+		paths    []string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "apply [<id>]",
+		Short: "Apply the changes of a merge request to the working tree",
+		Long: `Apply the changes of a merge request to the working tree with git apply,
+without switching branches or creating a commit.
+
+The patch is built from the merge request's diff, as printed by
+"glab mr diff --patch". Files whose diff is too large for the API to return
+are skipped with a warning.`,
+		Example: `  $ glab mr apply 123
+  $ glab mr apply 123 --check
+  $ glab mr apply 123 --3way --index
+  $ glab mr apply 123 --path 'migrations/'`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, p := range paths {
+				if _, err := path.Match(p, ""); err != nil {
+					return fmt.Errorf("invalid --path pattern %q: %w", p, err)
+				}
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			mrID, err := resolveMRArg(client, project, args)
+			if err != nil {
+				return err
+			}
+
+			diffs, err := listMRDiffs(cmd, client, project, mrID)
+			if err != nil {
+				return err
+			}
+			if len(paths) > 0 {
+				diffs = filterDiffs(diffs, paths)
+			}
+
+			var patch bytes.Buffer
+			files := 0
+			noColor := iostreams.NewColorScheme(false)
+			for _, d := range diffs {
+				if d.Diff == "" && !d.NewFile && !d.DeletedFile && !d.RenamedFile && d.AMode == d.BMode {
+					_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Warning: skipping %s: its diff is too large to be returned by the API\n", d.NewPath)
+					continue
+				}
+				writeDiff(&patch, noColor, d)
+				files++
+			}
+			if files == 0 {
+				return fmt.Errorf("no changes to apply from !%d", mrID)
+			}
+
+			var gitArgs []string
+			if index {
+				gitArgs = append(gitArgs, "--index")
+			}
+			if threeWay {
+				gitArgs = append(gitArgs, "--3way")
+			}
+			if check {
+				gitArgs = append(gitArgs, "--check")
+			}
+			if err := git.Apply(&patch, gitArgs...); err != nil {
+				return fmt.Errorf("applying !%d: %w", mrID, err)
+			}
+
+			if check {
+				_, _ = fmt.Fprintf(f.IOStreams.Out, "Changes of !%d (%d files) apply cleanly\n", mrID, files)
+				return nil
+			}
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Applied changes of !%d (%d files) to the working tree\n", mrID, files)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&index, "index", false, "Also stage the changes")
+	cmd.Flags().BoolVarP(&threeWay, "3way", "3", false, "Fall back to a three-way merge when the patch does not apply cleanly")
+	cmd.Flags().BoolVar(&check, "check", false, "Only check whether the changes apply, without applying them")
+	cmd.Flags().StringArrayVar(&paths, "path", nil, "Only apply files matching this glob or directory (repeatable)")
+
+	return cmd
+}
+
+func newMRCherryPickCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cherry-pick [<id>]",
+		Short: "Cherry-pick a merge request onto the current branch",
+		Long: `Cherry-pick the changes of a merge request onto the current branch.
+
+For a merged merge request, its merge commit (or squash commit) is fetched
+from the target branch and picked. Otherwise the merge request's commits are
+fetched from refs/merge-requests/<id>/head and picked one by one. Conflicts
+are left for you to resolve with git cherry-pick --continue or --abort.`,
+		Example: `  $ glab mr cherry-pick 123
+  $ git switch release-1.2 && glab mr cherry-pick 123`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			mrID, err := resolveMRArg(client, project, args)
+			if err != nil {
+				return err
+			}
+
+			mr, resp, err := client.MergeRequests.GetMergeRequest(project, mrID, nil, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/merge_requests/%d", api.APIURL(client.Host()), project, mrID)
+				return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get merge request !%d", mrID), err)
+			}
+
+			remote, err := f.Remote()
+			if err != nil {
+				return fmt.Errorf("could not determine git remote: %w", err)
+			}
+
+			var picked string
+			switch commit := mergedCommitSHA(mr); {
+			case mr.State == "merged" && commit != "":
+				if err := git.FetchRef(remote.Name, "refs/heads/"+mr.TargetBranch); err != nil {
+					return err
+				}
+				if err := git.CherryPick(commit); err != nil {
+					return err
+				}
+				picked = shortSHA(commit)
+			default:
+				base, head := mr.DiffRefs.BaseSha, mr.DiffRefs.HeadSha
+				if base == "" || head == "" {
+					return fmt.Errorf("!%d has no commits to cherry-pick", mrID)
+				}
+				ref := git.MergeRequestRef(mr.IID)
+				if mr.State == "merged" {
+					// Fast-forward merges leave no merge or squash commit
+					ref = "refs/heads/" + mr.TargetBranch
+				}
+				if err := git.FetchRef(remote.Name, ref); err != nil {
+					return err
+				}
+				if err := git.CherryPickRange(base, head); err != nil {
+					return err
+				}
+				picked = shortSHA(base) + ".." + shortSHA(head)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Cherry-picked !%d (%s) onto the current branch\n", mrID, picked)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// mergedCommitSHA returns the commit a merge request was merged with: its
+// merge commit, or its squash commit when it was squashed and
+// fast-forwarded. It is empty for unmerged and plain fast-forward merges.
+func mergedCommitSHA(mr *gitlab.MergeRequest) string {
+	if mr.MergeCommitSHA != "" {
+		return mr.MergeCommitSHA
+	}
+	return mr.SquashCommitSHA
+}
@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+// setupCherryPickRepos creates a bare "origin" whose main branch has a
+// feature merged with a merge commit, and a clone on a branch that forked
+// before the merge. It returns the clone's path, the merge commit's SHA, and
+// a function that runs git in a directory.
+func setupCherryPickRepos(t *testing.T) (string, string, func(dir string, args ...string) string) {
+	t.Helper()
+	t.Setenv("GIT_AUTHOR_NAME", "Test")
+	t.Setenv("GIT_AUTHOR_EMAIL", "test@test.com")
+	t.Setenv("GIT_COMMITTER_NAME", "Test")
+	t.Setenv("GIT_COMMITTER_EMAIL", "test@test.com")
+
+	git := func(dir string, args ...string) string {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v failed: %v\n%s", args, err, out)
+		}
+		return strings.TrimSpace(string(out))
+	}
+
+	root := t.TempDir()
+	origin := filepath.Join(root, "origin.git")
+	seed := filepath.Join(root, "seed")
+	clone := filepath.Join(root, "clone")
+	git(root, "init", "--bare", "-b", "main", origin)
+	git(root, "clone", origin, seed)
+	if err := os.WriteFile(filepath.Join(seed, "README.md"), []byte("v1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	git(seed, "add", ".")
+	git(seed, "commit", "-m", "v1")
+	git(seed, "push", "origin", "HEAD:main")
+	git(root, "clone", origin, clone)
+	git(clone, "checkout", "-b", "release")
+
+	git(seed, "checkout", "-b", "feature")
+	if err := os.WriteFile(filepath.Join(seed, "feature.txt"), []byte("feature\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	git(seed, "add", ".")
+	git(seed, "commit", "-m", "Add feature")
+	git(seed, "checkout", "main")
+	git(seed, "merge", "--no-ff", "-m", "Merge feature", "feature")
+	git(seed, "push", "origin", "main")
+
+	return clone, git(seed, "rev-parse", "HEAD"), git
+}
+
+func TestMRCherryPick_MergeCommit(t *testing.T) {
+	clone, mergeSHA, git := setupCherryPickRepos(t)
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/projects/test-owner/test-repo/merge_requests/1" {
+			cmdtest.JSONResponse(w, 200, map[string]interface{}{
+				"iid": 1, "state": "merged", "target_branch": "main", "merge_commit_sha": mergeSHA,
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+	t.Chdir(clone)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRCherryPickCmd(f.Factory)
+	cmd.SetArgs([]string{"1"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(clone, "feature.txt")); err != nil {
+		t.Errorf("expected feature.txt to be picked: %v", err)
+	}
+	if got := git(clone, "rev-parse", "--abbrev-ref", "HEAD"); got != "release" {
+		t.Errorf("current branch = %q, want release", got)
+	}
+	if !strings.Contains(f.IO.String(), "Cherry-picked !1 ("+mergeSHA[:8]) {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestMRApply(t *testing.T) {
+	t.Setenv("GIT_AUTHOR_NAME", "Test")
+	t.Setenv("GIT_AUTHOR_EMAIL", "test@test.com")
+	t.Setenv("GIT_COMMITTER_NAME", "Test")
+	t.Setenv("GIT_COMMITTER_EMAIL", "test@test.com")
+	dir := t.TempDir()
+	for _, args := range [][]string{{"init", "-b", "main"}, {"add", "."}, {"commit", "-m", "init"}} {
+		if args[0] == "add" {
+			if err := os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\nold\n"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v\n%s", args, err, out)
+		}
+	}
+	t.Chdir(dir)
+
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/projects/test-owner/test-repo/merge_requests/1/diffs" {
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+				{
+					"old_path": "main.go", "new_path": "main.go", "a_mode": "100644", "b_mode": "100644",
+					"diff": "@@ -1,2 +1,2 @@\n package main\n-old\n+new\n",
+				},
+				{
+					"old_path": "NOTES.md", "new_path": "NOTES.md", "a_mode": "0", "b_mode": "100644",
+					"new_file": true, "diff": "@@ -0,0 +1 @@\n+notes\n",
+				},
+				{
+					"old_path": "big.json", "new_path": "big.json", "a_mode": "100644", "b_mode": "100644",
+					"diff": "",
+				},
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRApplyCmd(f.Factory)
+	cmd.SetArgs([]string{"1"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "main.go"))
+	if err != nil || string(data) != "package main\nnew\n" {
+		t.Errorf("main.go = %q, %v", data, err)
+	}
+	if data, err := os.ReadFile(filepath.Join(dir, "NOTES.md")); err != nil || string(data) != "notes\n" {
+		t.Errorf("NOTES.md = %q, %v", data, err)
+	}
+	if !strings.Contains(f.IO.ErrString(), "skipping big.json") {
+		t.Errorf("expected a warning for big.json, got: %s", f.IO.ErrString())
+	}
+	if !strings.Contains(f.IO.String(), "Applied changes of !1 (2 files)") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
@@ -0,0 +1,220 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mrApprovals is the approval state of a merge request as printed by
+// "mr approvers".
+type mrApprovals struct {
+	Approved          bool           `json:"approved"`
+	ApprovalsRequired int64          `json:"approvals_required"`
+	ApprovalsLeft     int64          `json:"approvals_left"`
+	ApprovedBy        []string       `json:"approved_by"`
+	Rules             []approvalRule `json:"rules"`
+}
+
+// approvalRule summarizes one approval rule. Eligible lists the approvers
+// who have not approved yet.
+type approvalRule struct {
+	Name       string   `json:"name"`
+	Type       string   `json:"type"`
+	Required   int64    `json:"approvals_required"`
+	Approved   bool     `json:"approved"`
+	ApprovedBy []string `json:"approved_by"`
+	Eligible   []string `json:"eligible"`
+}
+
+func newMRApproversCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "approvers [<id>]",
+		Short: "Show the approval state of a merge request",
+		Long: `Show how many approvals a merge request needs, who has approved it, and,
+for each approval rule, who is still eligible to approve.
+
+Approval rules require GitLab Premium; on other tiers only the overall
+approval count is shown.`,
+		Example: `  $ glab mr approvers 123
+  $ glab mr approvers --format json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			mrID, err := resolveMRArg(client, project, args)
+			if err != nil {
+				return err
+			}
+
+			config, resp, err := client.MergeRequestApprovals.GetConfiguration(project, mrID, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/merge_requests/%d/approvals", api.APIURL(client.Host()), project, mrID)
+				return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get approvals for merge request !%d", mrID), err)
+			}
+
+			approvals := mrApprovals{
+				Approved:          config.Approved,
+				ApprovalsRequired: config.ApprovalsRequired,
+				ApprovalsLeft:     config.ApprovalsLeft,
+				ApprovedBy:        []string{},
+				Rules:             []approvalRule{},
+			}
+			for _, a := range config.ApprovedBy {
+				if a.User != nil {
+					approvals.ApprovedBy = append(approvals.ApprovedBy, a.User.Username)
+				}
+			}
+
+			state, resp, err := client.MergeRequestApprovals.GetApprovalState(project, mrID, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				// Approval rules are a paid feature; without them the
+				// overall count above is all there is to show.
+				if resp == nil || (resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusNotFound) {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/merge_requests/%d/approval_state", api.APIURL(client.Host()), project, mrID)
+					return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get approval rules for merge request !%d", mrID), err)
+				}
+			} else {
+				for _, r := range state.Rules {
+					approvals.Rules = append(approvals.Rules, newApprovalRule(r))
+				}
+			}
+
+			if jsonFlag || f.ExportRequested() || (format != "" && format != "table") {
+				return f.FormatAndPrint(approvals, format, jsonFlag)
+			}
+
+			out := f.IOStreams.Out
+			cs := f.IOStreams.ColorScheme()
+			status := cs.Yellow(fmt.Sprintf("%d of %d approvals left", approvals.ApprovalsLeft, approvals.ApprovalsRequired))
+			if approvals.Approved {
+				status = cs.Green("approved")
+			}
+			_, _ = fmt.Fprintf(out, "%s %s\n", cs.Bold(fmt.Sprintf("!%d", mrID)), status)
+			if len(approvals.ApprovedBy) > 0 {
+				_, _ = fmt.Fprintf(out, "Approved by: %s\n", strings.Join(approvals.ApprovedBy, ", "))
+			} else {
+				_, _ = fmt.Fprintln(out, "Approved by: nobody yet")
+			}
+
+			if len(approvals.Rules) == 0 {
+				return nil
+			}
+			_, _ = fmt.Fprintln(out)
+
+			tp := f.NewTablePrinter()
+			tp.SetHeader("RULE", "STATUS", "REQUIRED", "APPROVED BY", "ELIGIBLE")
+			for _, r := range approvals.Rules {
+				ruleStatus := cs.Yellow("pending")
+				if r.Approved {
+					ruleStatus = cs.Green("approved")
+				}
+				tp.AddRow(r.Name, ruleStatus, fmt.Sprintf("%d", r.Required), joinOrDash(r.ApprovedBy), joinOrDash(r.Eligible))
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
+
+	return cmd
+}
+
+func newMRUnapproveCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unapprove [<id>]",
+		Short: "Remove your approval from a merge request",
+		Example: `  $ glab mr unapprove 123
+  $ glab mr unapprove   # merge request for the current branch`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			mrID, err := resolveMRArg(client, project, args)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.MergeRequestApprovals.UnapproveMergeRequest(project, mrID, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/merge_requests/%d/unapprove", api.APIURL(client.Host()), project, mrID)
+				return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to unapprove merge request !%d", mrID), err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Removed approval from merge request !%d\n", mrID)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newApprovalRule(r *gitlab.MergeRequestApprovalRule) approvalRule {
+	rule := approvalRule{
+		Name:       r.Name,
+		Type:       r.RuleType,
+		Required:   r.ApprovalsRequired,
+		Approved:   r.Approved,
+		ApprovedBy: []string{},
+		Eligible:   []string{},
+	}
+	approved := make(map[string]bool)
+	for _, u := range r.ApprovedBy {
+		approved[u.Username] = true
+		rule.ApprovedBy = append(rule.ApprovedBy, u.Username)
+	}
+	for _, u := range r.EligibleApprovers {
+		if !approved[u.Username] {
+			rule.Eligible = append(rule.Eligible, u.Username)
+		}
+	}
+	return rule
+}
+
+func joinOrDash(names []string) string {
+	if len(names) == 0 {
+		return "-"
+	}
+	return strings.Join(names, ", ")
+}
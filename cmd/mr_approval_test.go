@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func approvalsHandler(rulesStatus int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/merge_requests/8/approvals"):
+			cmdtest.JSONResponse(w, 200, map[string]any{
+				"iid": 8, "approved": false, "approvals_required": 2, "approvals_left": 1,
+				"approved_by": []map[string]any{{"user": map[string]any{"username": "alice"}}},
+			})
+		case strings.HasSuffix(r.URL.Path, "/merge_requests/8/approval_state"):
+			if rulesStatus != 200 {
+				cmdtest.ErrorResponse(w, rulesStatus, "forbidden")
+				return
+			}
+			cmdtest.JSONResponse(w, 200, map[string]any{"rules": []map[string]any{
+				{"name": "Backend", "rule_type": "regular", "approvals_required": 1, "approved": true,
+					"approved_by":        []map[string]any{{"username": "alice"}},
+					"eligible_approvers": []map[string]any{{"username": "alice"}, {"username": "bob"}}},
+				{"name": "Security", "rule_type": "regular", "approvals_required": 1, "approved": false,
+					"eligible_approvers": []map[string]any{{"username": "carol"}}},
+			}})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	}
+}
+
+func TestMRApprovers(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", approvalsHandler(200))
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRApproversCmd(f.Factory)
+	cmd.SetArgs([]string{"8"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := strings.Join(strings.Fields(f.IO.String()), " ")
+	for _, want := range []string{
+		"!8 1 of 2 approvals left",
+		"Approved by: alice",
+		"Backend approved 1 alice bob",
+		"Security pending 1 - carol",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestMRApprovers_NoRules(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", approvalsHandler(403))
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRApproversCmd(f.Factory)
+	cmd.SetArgs([]string{"8", "--format", "json"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got mrApprovals
+	if err := json.Unmarshal([]byte(f.IO.String()), &got); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if got.ApprovalsLeft != 1 || len(got.ApprovedBy) != 1 || len(got.Rules) != 0 {
+		t.Errorf("unexpected approvals: %+v", got)
+	}
+}
+
+func TestMRUnapprove(t *testing.T) {
+	called := false
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/merge_requests/8/unapprove") {
+			called = true
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRUnapproveCmd(f.Factory)
+	cmd.SetArgs([]string{"8"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !called || !strings.Contains(f.IO.String(), "Removed approval from merge request !8") {
+		t.Errorf("unexpected result: called=%v output=%q", called, f.IO.String())
+	}
+}
@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func newMRChecksCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		watch     bool
+		watchOpts pipelineWatchOptions
+		format    string
+		jsonFlag  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "checks [<id>]",
+		Short: "Show CI status for a merge request",
+		Long: `List the jobs of a merge request's head pipeline with their statuses.
+
+The command exits with a non-zero status if the pipeline failed. With
+--watch, it polls the pipeline until it finishes, so it can be used to wait
+for CI before merging.`,
+		Example: `  $ glab mr checks 123
+  $ glab mr checks --watch && glab mr merge
+  $ glab mr checks 123 --watch --fail-fast`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			mrID, err := resolveMRArg(client, project, args)
+			if err != nil {
+				return err
+			}
+
+			mr, resp, err := client.MergeRequests.GetMergeRequest(project, mrID, nil, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/merge_requests/%d", api.APIURL(client.Host()), project, mrID)
+				return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get merge request !%d", mrID), err)
+			}
+
+			if mr.HeadPipeline == nil {
+				_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "No pipeline found for merge request !%d\n", mrID)
+				return nil
+			}
+			pipeline := mr.HeadPipeline
+
+			if watch {
+				return watchPipeline(cmd.Context(), f, client, project, pipeline.ID, watchOpts)
+			}
+
+			jobs, err := listAllPipelineJobs(cmd.Context(), client, project, pipeline.ID)
+			if err != nil {
+				url := fmt.Sprintf("%s/projects/%s/pipelines/%d/jobs", api.APIURL(client.Host()), project, pipeline.ID)
+				return errors.NewAPIError("GET", url, 0, "Failed to list pipeline jobs", err)
+			}
+			stages := groupJobsByStage(jobs)
+
+			if jsonFlag || f.ExportRequested() || (format != "" && format != "table") {
+				var ordered []*gitlab.Job
+				for _, stage := range stages {
+					ordered = append(ordered, stage.Jobs...)
+				}
+				if err := f.FormatAndPrint(ordered, format, jsonFlag); err != nil {
+					return err
+				}
+				return checksResult(pipeline)
+			}
+
+			out := f.IOStreams.Out
+			cs := f.IOStreams.ColorScheme()
+			_, _ = fmt.Fprintf(out, "Pipeline #%d %s\n", pipeline.ID, cs.State(pipeline.Status))
+			if summary := summarizeJobs(jobs); summary != "" {
+				_, _ = fmt.Fprintln(out, summary)
+			}
+			_, _ = fmt.Fprintln(out)
+
+			tp := f.NewTablePrinter()
+			tp.SetHeader("STATUS", "NAME", "STAGE", "DURATION", "URL")
+			for _, stage := range stages {
+				for _, job := range stage.Jobs {
+					status := job.Status
+					if status == "failed" && job.AllowFailure {
+						status = "failed (allowed)"
+					}
+					duration := "-"
+					if job.Duration > 0 {
+						duration = (time.Duration(job.Duration) * time.Second).String()
+					}
+					tp.AddRow(cs.ForState(job.Status, status), job.Name, job.Stage, duration, job.WebURL)
+				}
+			}
+			if err := tp.Render(); err != nil {
+				return err
+			}
+			return checksResult(pipeline)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&watch, "watch", "w", false, "Watch the pipeline until it finishes")
+	cmd.Flags().DurationVarP(&watchOpts.Interval, "interval", "i", 5*time.Second, "Polling interval when using --watch")
+	cmd.Flags().BoolVar(&watchOpts.FailFast, "fail-fast", false, "Exit as soon as any job fails when using --watch")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
+
+	return cmd
+}
+
+// checksResult turns a failed or canceled pipeline into an error so that
+// "mr checks" exits non-zero.
+func checksResult(pipeline *gitlab.Pipeline) error {
+	switch pipeline.Status {
+	case "failed":
+		return fmt.Errorf("pipeline #%d failed", pipeline.ID)
+	case "canceled":
+		return fmt.Errorf("pipeline #%d was canceled", pipeline.ID)
+	}
+	return nil
+}
+
+// summarizeJobs counts jobs by outcome, e.g. "3 passed, 1 failed, 2 running".
+func summarizeJobs(jobs []*gitlab.Job) string {
+	counts := make(map[string]int)
+	for _, job := range jobs {
+		switch job.Status {
+		case "success":
+			counts["passed"]++
+		case "failed":
+			if job.AllowFailure {
+				counts["passed"]++
+			} else {
+				counts["failed"]++
+			}
+		case "running":
+			counts["running"]++
+		case "canceled", "skipped", "manual":
+			counts["skipped"]++
+		default:
+			counts["pending"]++
+		}
+	}
+
+	var parts []string
+	for _, key := range []string{"passed", "failed", "running", "pending", "skipped"} {
+		if counts[key] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[key], key))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
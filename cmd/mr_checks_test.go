@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func checksHandler(pipelineStatus string, pipelineGets *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/merge_requests/2"):
+			cmdtest.JSONResponse(w, 200, map[string]any{
+				"iid": 2, "head_pipeline": map[string]any{"id": 77, "status": pipelineStatus},
+			})
+		case strings.HasSuffix(r.URL.Path, "/pipelines/77/jobs"):
+			cmdtest.JSONResponse(w, 200, []map[string]any{
+				{"id": 2, "name": "test", "stage": "test", "status": pipelineStatus, "duration": 95},
+				{"id": 1, "name": "build", "stage": "build", "status": "success", "duration": 30},
+				{"id": 3, "name": "lint", "stage": "test", "status": "failed", "allow_failure": true},
+			})
+		case strings.HasSuffix(r.URL.Path, "/pipelines/77"):
+			*pipelineGets++
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 77, "status": pipelineStatus, "ref": "feature"})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	}
+}
+
+func TestMRChecks(t *testing.T) {
+	var gets int
+	cmdtest.MockGitLabServer(t, "gitlab.com", checksHandler("success", &gets))
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRChecksCmd(f.Factory)
+	cmd.SetArgs([]string{"2"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := f.IO.String()
+	if !strings.Contains(out, "Pipeline #77 success") || !strings.Contains(out, "3 passed") {
+		t.Errorf("expected pipeline summary, got:\n%s", out)
+	}
+	if strings.Index(out, "build") > strings.Index(out, "test") {
+		t.Errorf("expected jobs in stage order, got:\n%s", out)
+	}
+	if !strings.Contains(out, "failed (allowed)") || !strings.Contains(out, "1m35s") {
+		t.Errorf("expected allowed failure and duration, got:\n%s", out)
+	}
+}
+
+func TestMRChecks_Failed(t *testing.T) {
+	var gets int
+	cmdtest.MockGitLabServer(t, "gitlab.com", checksHandler("failed", &gets))
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRChecksCmd(f.Factory)
+	cmd.SetArgs([]string{"2"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "pipeline #77 failed") {
+		t.Fatalf("expected pipeline failure error, got %v", err)
+	}
+	if !strings.Contains(f.IO.String(), "2 passed, 1 failed") {
+		t.Errorf("expected summary, got:\n%s", f.IO.String())
+	}
+}
+
+func TestMRChecks_Watch(t *testing.T) {
+	var gets int
+	cmdtest.MockGitLabServer(t, "gitlab.com", checksHandler("failed", &gets))
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRChecksCmd(f.Factory)
+	cmd.SetArgs([]string{"2", "--watch", "--interval", "1ms"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "pipeline #77 failed") {
+		t.Fatalf("expected pipeline failure error, got %v", err)
+	}
+	if gets == 0 {
+		t.Error("expected the pipeline to be polled")
+	}
+}
+
+func TestMRChecks_NoPipeline(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		cmdtest.JSONResponse(w, 200, map[string]any{"iid": 2})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRChecksCmd(f.Factory)
+	cmd.SetArgs([]string{"2"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(f.IO.ErrString(), "No pipeline found for merge request !2") {
+		t.Errorf("unexpected error output: %q", f.IO.ErrString())
+	}
+}
@@ -0,0 +1,290 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/PhilipKram/gitlab-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// diffStatWidth is the widest the +/- bar of --stat gets.
+const diffStatWidth = 40
+
+func newMRDiffCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		nameOnly bool
+		stat     bool
+		patch    bool
+		paths    []string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "diff [<id>]",
+		Short: "View changes in a merge request",
+		Long: `View the changes of a merge request as a unified diff.
+
+On a terminal the diff is colored and shown in your pager (the GLAB_PAGER
+environment variable, the "pager" config key, or PAGER). --patch prints a
+plain patch that "git apply" accepts. --path limits the diff to files
+matching a glob; a pattern without a slash matches file names in any
+directory, and a directory matches everything below it.`,
+		Example: `  $ glab mr diff 123
+  $ glab mr diff 123 --stat
+  $ glab mr diff 123 --name-only
+  $ glab mr diff 123 --path '*.go' --path docs/
+  $ glab mr diff 123 --patch > mr-123.patch && git apply mr-123.patch`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, p := range paths {
+				if _, err := path.Match(p, ""); err != nil {
+					return fmt.Errorf("invalid --path pattern %q: %w", p, err)
+				}
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			mrID, err := resolveMRArg(client, project, args)
+			if err != nil {
+				return err
+			}
+
+			diffs, err := listMRDiffs(cmd, client, project, mrID)
+			if err != nil {
+				return err
+			}
+			if len(paths) > 0 {
+				diffs = filterDiffs(diffs, paths)
+			}
+			if len(diffs) == 0 {
+				_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "No changes in !%d match the given paths\n", mrID)
+				return nil
+			}
+
+			out := f.IOStreams.Out
+			switch {
+			case nameOnly:
+				for _, d := range diffs {
+					_, _ = fmt.Fprintln(out, diffPath(d))
+				}
+				return nil
+			case stat:
+				writeDiffStat(out, f.IOStreams.ColorScheme(), diffs)
+				return nil
+			case patch:
+				for _, d := range diffs {
+					writeDiff(out, iostreams.NewColorScheme(false), d)
+				}
+				return nil
+			}
+
+			cfg, _ := f.Config()
+			if err := f.IOStreams.StartPager(cmdutil.DeterminePager(cfg)); err != nil {
+				_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Warning: %v\n", err)
+			}
+			defer f.IOStreams.StopPager()
+
+			cs := f.IOStreams.ColorScheme()
+			for _, d := range diffs {
+				writeDiff(f.IOStreams.Out, cs, d)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&nameOnly, "name-only", false, "Only list the names of changed files")
+	cmd.Flags().BoolVar(&stat, "stat", false, "Show a summary of changed lines per file")
+	cmd.Flags().BoolVar(&patch, "patch", false, "Print an uncolored patch for git apply, without a pager")
+	cmd.Flags().StringArrayVar(&paths, "path", nil, "Only show files matching this glob or directory (repeatable)")
+	cmd.MarkFlagsMutuallyExclusive("name-only", "stat", "patch")
+
+	return cmd
+}
+
+// listMRDiffs returns the changed files of a merge request, following
+// pagination so large merge requests are complete.
+func listMRDiffs(cmd *cobra.Command, client *api.Client, project string, mrID int64) ([]*gitlab.MergeRequestDiff, error) {
+	opts := &gitlab.ListMergeRequestDiffsOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+
+	var all []*gitlab.MergeRequestDiff
+	for {
+		diffs, resp, err := client.MergeRequests.ListMergeRequestDiffs(project, mrID, opts, gitlab.WithContext(cmd.Context()))
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			url := fmt.Sprintf("%s/projects/%s/merge_requests/%d/diffs", api.APIURL(client.Host()), project, mrID)
+			return nil, errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get merge request diffs for !%d", mrID), err)
+		}
+		all = append(all, diffs...)
+
+		if resp == nil || resp.NextPage == 0 {
+			return all, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// filterDiffs keeps the diffs whose old or new path matches one of patterns.
+func filterDiffs(diffs []*gitlab.MergeRequestDiff, patterns []string) []*gitlab.MergeRequestDiff {
+	var kept []*gitlab.MergeRequestDiff
+	for _, d := range diffs {
+		for _, p := range patterns {
+			if matchDiffPath(p, d.NewPath) || matchDiffPath(p, d.OldPath) {
+				kept = append(kept, d)
+				break
+			}
+		}
+	}
+	return kept
+}
+
+// matchDiffPath reports whether file matches pattern: as a glob against the
+// whole path, against the file name when pattern has no slash, or as a
+// directory containing file.
+func matchDiffPath(pattern, file string) bool {
+	if ok, _ := path.Match(pattern, file); ok {
+		return true
+	}
+	if !strings.Contains(pattern, "/") {
+		if ok, _ := path.Match(pattern, path.Base(file)); ok {
+			return true
+		}
+	}
+	dir := strings.TrimSuffix(pattern, "/")
+	return dir != "" && strings.HasPrefix(file, dir+"/")
+}
+
+// diffPath returns the path a change is known by: the new path, or the old
+// one for a deleted file.
+func diffPath(d *gitlab.MergeRequestDiff) string {
+	if d.DeletedFile {
+		return d.OldPath
+	}
+	return d.NewPath
+}
+
+// writeDiff writes one file's change as a git-style patch, colored with cs.
+func writeDiff(w io.Writer, cs *iostreams.ColorScheme, d *gitlab.MergeRequestDiff) {
+	header := []string{fmt.Sprintf("diff --git a/%s b/%s", d.OldPath, d.NewPath)}
+	switch {
+	case d.NewFile:
+		header = append(header, "new file mode "+d.BMode)
+	case d.DeletedFile:
+		header = append(header, "deleted file mode "+d.AMode)
+	case d.AMode != "" && d.BMode != "" && d.AMode != d.BMode:
+		header = append(header, "old mode "+d.AMode, "new mode "+d.BMode)
+	}
+	if d.RenamedFile {
+		header = append(header, "rename from "+d.OldPath, "rename to "+d.NewPath)
+	}
+	// Binary files and pure renames have no hunks and no file header lines
+	if strings.HasPrefix(d.Diff, "@@") {
+		oldName, newName := "a/"+d.OldPath, "b/"+d.NewPath
+		if d.NewFile {
+			oldName = "/dev/null"
+		}
+		if d.DeletedFile {
+			newName = "/dev/null"
+		}
+		header = append(header, "--- "+oldName, "+++ "+newName)
+	}
+	for _, line := range header {
+		_, _ = fmt.Fprintln(w, cs.Bold(line))
+	}
+
+	body := strings.TrimSuffix(d.Diff, "\n")
+	if body == "" {
+		return
+	}
+	for _, line := range strings.Split(body, "\n") {
+		switch {
+		case strings.HasPrefix(line, "@@"):
+			line = cs.Cyan(line)
+		case strings.HasPrefix(line, "+"):
+			line = cs.Green(line)
+		case strings.HasPrefix(line, "-"):
+			line = cs.Red(line)
+		}
+		_, _ = fmt.Fprintln(w, line)
+	}
+}
+
+// countDiffLines returns the number of added and deleted lines of a diff.
+func countDiffLines(diff string) (added, deleted int) {
+	for _, line := range strings.Split(diff, "\n") {
+		switch {
+		case strings.HasPrefix(line, "+"):
+			added++
+		case strings.HasPrefix(line, "-"):
+			deleted++
+		}
+	}
+	return added, deleted
+}
+
+// writeDiffStat writes a "git diff --stat" style summary of diffs.
+func writeDiffStat(w io.Writer, cs *iostreams.ColorScheme, diffs []*gitlab.MergeRequestDiff) {
+	type fileStat struct {
+		name           string
+		added, deleted int
+	}
+	stats := make([]fileStat, 0, len(diffs))
+	nameWidth, maxChanges, totalAdded, totalDeleted := 0, 0, 0, 0
+	for _, d := range diffs {
+		name := diffPath(d)
+		if d.RenamedFile {
+			name = d.OldPath + " => " + d.NewPath
+		}
+		added, deleted := countDiffLines(d.Diff)
+		stats = append(stats, fileStat{name, added, deleted})
+		nameWidth = max(nameWidth, len(name))
+		maxChanges = max(maxChanges, added+deleted)
+		totalAdded += added
+		totalDeleted += deleted
+	}
+	countWidth := len(strconv.Itoa(maxChanges))
+
+	for _, s := range stats {
+		plus, minus := s.added, s.deleted
+		if maxChanges > diffStatWidth {
+			plus = scaleDiffStat(plus, maxChanges)
+			minus = scaleDiffStat(minus, maxChanges)
+		}
+		bar := cs.Green(strings.Repeat("+", plus)) + cs.Red(strings.Repeat("-", minus))
+		_, _ = fmt.Fprintf(w, " %-*s | %*d %s\n", nameWidth, s.name, countWidth, s.added+s.deleted, bar)
+	}
+	plural := func(n int, word string) string {
+		if n == 1 {
+			return fmt.Sprintf("%d %s", n, word)
+		}
+		return fmt.Sprintf("%d %ss", n, word)
+	}
+	_, _ = fmt.Fprintf(w, " %s changed, %s(+), %s(-)\n",
+		plural(len(stats), "file"), plural(totalAdded, "insertion"), plural(totalDeleted, "deletion"))
+}
+
+// scaleDiffStat scales n changes to the bar width, keeping at least one
+// character for any change.
+func scaleDiffStat(n, maxChanges int) int {
+	if n == 0 {
+		return 0
+	}
+	return max(1, n*diffStatWidth/maxChanges)
+}
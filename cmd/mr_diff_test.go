@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func mockMRDiffs(t *testing.T) {
+	t.Helper()
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v4/projects/test-owner/test-repo/merge_requests/1/diffs" {
+			cmdtest.ErrorResponse(w, 404, "not found")
+			return
+		}
+		// Two pages, to check that every changed file is shown
+		if r.URL.Query().Get("page") == "2" {
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+				{
+					"old_path": "docs/old.md", "new_path": "docs/old.md", "a_mode": "100644", "b_mode": "0",
+					"deleted_file": true, "diff": "@@ -1 +0,0 @@\n-gone\n",
+				},
+			})
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+			{
+				"old_path": "cmd/main.go", "new_path": "cmd/main.go", "a_mode": "100644", "b_mode": "100644",
+				"diff": "@@ -1,2 +1,2 @@\n package main\n-old\n+new\n",
+			},
+			{
+				"old_path": "README.md", "new_path": "README.md", "a_mode": "0", "b_mode": "100644",
+				"new_file": true, "diff": "@@ -0,0 +1,2 @@\n+# Title\n+text\n",
+			},
+		})
+	})
+}
+
+func TestMRDiff_Patch(t *testing.T) {
+	mockMRDiffs(t)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRDiffCmd(f.Factory)
+	cmd.SetArgs([]string{"1", "--patch"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `diff --git a/cmd/main.go b/cmd/main.go
+--- a/cmd/main.go
++++ b/cmd/main.go
+@@ -1,2 +1,2 @@
+ package main
+-old
++new
+diff --git a/README.md b/README.md
+new file mode 100644
+--- /dev/null
++++ b/README.md
+@@ -0,0 +1,2 @@
++# Title
++text
+diff --git a/docs/old.md b/docs/old.md
+deleted file mode 100644
+--- a/docs/old.md
++++ /dev/null
+@@ -1 +0,0 @@
+-gone
+`
+	if got := f.IO.String(); got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestMRDiff_NameOnlyWithPath(t *testing.T) {
+	mockMRDiffs(t)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRDiffCmd(f.Factory)
+	cmd.SetArgs([]string{"1", "--name-only", "--path", "*.md"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := f.IO.String(); got != "README.md\ndocs/old.md\n" {
+		t.Errorf("output = %q", got)
+	}
+}
+
+func TestMRDiff_Stat(t *testing.T) {
+	mockMRDiffs(t)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRDiffCmd(f.Factory)
+	cmd.SetArgs([]string{"1", "--stat"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := f.IO.String()
+	for _, want := range []string{
+		" cmd/main.go | 2 +-\n",
+		" README.md   | 2 ++\n",
+		" docs/old.md | 1 -\n",
+		" 3 files changed, 3 insertions(+), 2 deletions(-)\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestMRDiff_NoMatchingPath(t *testing.T) {
+	mockMRDiffs(t)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRDiffCmd(f.Factory)
+	cmd.SetArgs([]string{"1", "--path", "internal/"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if f.IO.String() != "" || !strings.Contains(f.IO.ErrString(), "No changes in !1") {
+		t.Errorf("stdout = %q, stderr = %q", f.IO.String(), f.IO.ErrString())
+	}
+}
+
+func TestMatchDiffPath(t *testing.T) {
+	tests := []struct {
+		pattern, file string
+		want          bool
+	}{
+		{"*.go", "cmd/main.go", true},
+		{"cmd/*.go", "cmd/main.go", true},
+		{"cmd/*.go", "internal/api/client.go", false},
+		{"internal", "internal/api/client.go", true},
+		{"internal/", "internal/api/client.go", true},
+		{"intern", "internal/api/client.go", false},
+	}
+	for _, tt := range tests {
+		if got := matchDiffPath(tt.pattern, tt.file); got != tt.want {
+			t.Errorf("matchDiffPath(%q, %q) = %v, want %v", tt.pattern, tt.file, got, tt.want)
+		}
+	}
+}
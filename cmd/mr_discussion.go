@@ -0,0 +1,415 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// shortDiscussionIDLen is the number of characters of a discussion ID shown in
+// tables. Any unambiguous prefix is accepted by --discussion-id.
+const shortDiscussionIDLen = 8
+
+// discussionRow is the structured form of a discussion thread printed by
+// "mr discussion list".
+type discussionRow struct {
+	ID       string `json:"id"`
+	Status   string `json:"status"`
+	Author   string `json:"author"`
+	Location string `json:"location"`
+	Notes    int    `json:"notes"`
+	Body     string `json:"body"`
+}
+
+func newMRDiscussionCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "discussion <command>",
+		Short: "Manage discussion threads on a merge request",
+		Long: `List, reply to, resolve, and unresolve discussion threads on a merge request.
+
+Threads are identified by the ID shown in "glab mr discussion list". Any
+unambiguous prefix of the ID can be passed to --discussion-id.`,
+		Example: `  $ glab mr discussion list 123 --unresolved
+  $ glab mr discussion reply 123 --discussion-id 3f2a9c1b --body "Fixed in the latest push"
+  $ glab mr discussion resolve 123 --discussion-id 3f2a9c1b
+  $ glab mr discussion resolve 123 --all`,
+	}
+
+	cmd.AddCommand(newMRDiscussionListCmd(f))
+	cmd.AddCommand(newMRDiscussionReplyCmd(f))
+	cmd.AddCommand(newMRDiscussionResolveCmd(f, true))
+	cmd.AddCommand(newMRDiscussionResolveCmd(f, false))
+
+	return cmd
+}
+
+func newMRDiscussionListCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		unresolved bool
+		format     string
+		jsonFlag   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list [<id>]",
+		Short: "List discussion threads on a merge request",
+		Example: `  $ glab mr discussion list 123
+  $ glab mr discussion list --unresolved
+  $ glab mr discussion list 123 --format json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			mrID, err := resolveMRArg(client, project, args)
+			if err != nil {
+				return err
+			}
+
+			discussions, err := listMRDiscussions(cmd, client, project, mrID)
+			if err != nil {
+				return err
+			}
+
+			var rows []discussionRow
+			for _, d := range discussions {
+				if len(d.Notes) == 0 || d.Notes[0].System {
+					continue
+				}
+				if unresolved && !discussionUnresolved(d) {
+					continue
+				}
+				rows = append(rows, newDiscussionRow(d))
+			}
+
+			if len(rows) == 0 {
+				if unresolved {
+					_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No unresolved discussions on this merge request")
+				} else {
+					_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No discussions found on this merge request")
+				}
+				return nil
+			}
+
+			if jsonFlag || f.ExportRequested() || (format != "" && format != "table") {
+				return f.FormatAndPrint(rows, format, jsonFlag)
+			}
+
+			cs := f.IOStreams.ColorScheme()
+			tp := f.NewTablePrinter()
+			tp.SetHeader("ID", "STATUS", "AUTHOR", "LOCATION", "NOTES", "COMMENT")
+			for _, r := range rows {
+				status := r.Status
+				switch status {
+				case "resolved":
+					status = cs.Green(status)
+				case "unresolved":
+					status = cs.Yellow(status)
+				}
+				tp.AddRow(shortDiscussionID(r.ID), status, r.Author, r.Location, fmt.Sprintf("%d", r.Notes), truncate(firstLine(r.Body), 60))
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().BoolVar(&unresolved, "unresolved", false, "Only show unresolved threads")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
+
+	return cmd
+}
+
+func newMRDiscussionReplyCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		discussionID string
+		body         string
+		resolve      bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "reply [<id>]",
+		Short: "Reply to a discussion thread",
+		Example: `  $ glab mr discussion reply 123 --discussion-id 3f2a9c1b --body "Done"
+  $ glab mr discussion reply --discussion-id 3f2a9c1b --body "Fixed" --resolve`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			mrID, err := resolveMRArg(client, project, args)
+			if err != nil {
+				return err
+			}
+
+			discussions, err := listMRDiscussions(cmd, client, project, mrID)
+			if err != nil {
+				return err
+			}
+			d, err := findDiscussion(discussions, discussionID)
+			if err != nil {
+				return err
+			}
+
+			_, resp, err := client.Discussions.AddMergeRequestDiscussionNote(project, mrID, d.ID, &gitlab.AddMergeRequestDiscussionNoteOptions{
+				Body: &body,
+			}, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/merge_requests/%d/discussions/%s/notes", api.APIURL(client.Host()), project, mrID, d.ID)
+				return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to reply to discussion on merge request !%d", mrID), err)
+			}
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Replied to discussion %s on !%d\n", shortDiscussionID(d.ID), mrID)
+
+			if resolve {
+				if err := setDiscussionResolved(cmd, client, project, mrID, d.ID, true); err != nil {
+					return err
+				}
+				_, _ = fmt.Fprintf(f.IOStreams.Out, "Resolved discussion %s on !%d\n", shortDiscussionID(d.ID), mrID)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&discussionID, "discussion-id", "", "ID or ID prefix of the thread to reply to (required)")
+	cmd.Flags().StringVarP(&body, "body", "b", "", "Reply body (required)")
+	cmd.Flags().BoolVar(&resolve, "resolve", false, "Resolve the thread after replying")
+	_ = cmd.MarkFlagRequired("discussion-id")
+	_ = cmd.MarkFlagRequired("body")
+
+	return cmd
+}
+
+// newMRDiscussionResolveCmd creates "resolve" when resolved is true and
+// "unresolve" otherwise.
+func newMRDiscussionResolveCmd(f *cmdutil.Factory, resolved bool) *cobra.Command {
+	var (
+		discussionID string
+		all          bool
+	)
+
+	verb, past := "resolve", "Resolved"
+	if !resolved {
+		verb, past = "unresolve", "Unresolved"
+	}
+
+	cmd := &cobra.Command{
+		Use:   verb + " [<id>]",
+		Short: strings.ToUpper(verb[:1]) + verb[1:] + " discussion threads",
+		Long: fmt.Sprintf(`%s a discussion thread, or every thread with --all.
+
+Only resolvable threads are affected; individual comments cannot be resolved.`, strings.ToUpper(verb[:1])+verb[1:]),
+		Example: fmt.Sprintf(`  $ glab mr discussion %s 123 --discussion-id 3f2a9c1b
+  $ glab mr discussion %s 123 --all`, verb, verb),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if all == (discussionID != "") {
+				return fmt.Errorf("specify either --discussion-id or --all")
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			mrID, err := resolveMRArg(client, project, args)
+			if err != nil {
+				return err
+			}
+
+			discussions, err := listMRDiscussions(cmd, client, project, mrID)
+			if err != nil {
+				return err
+			}
+
+			if !all {
+				d, err := findDiscussion(discussions, discussionID)
+				if err != nil {
+					return err
+				}
+				if err := setDiscussionResolved(cmd, client, project, mrID, d.ID, resolved); err != nil {
+					return err
+				}
+				_, _ = fmt.Fprintf(f.IOStreams.Out, "%s discussion %s on !%d\n", past, shortDiscussionID(d.ID), mrID)
+				return nil
+			}
+
+			var targets []*gitlab.Discussion
+			for _, d := range discussions {
+				if len(d.Notes) > 0 && d.Notes[0].Resolvable && discussionUnresolved(d) == resolved {
+					targets = append(targets, d)
+				}
+			}
+			if len(targets) == 0 {
+				_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "No threads to %s on !%d\n", verb, mrID)
+				return nil
+			}
+
+			errOut := f.IOStreams.ErrOut
+			done := 0
+			for i, d := range targets {
+				if err := setDiscussionResolved(cmd, client, project, mrID, d.ID, resolved); err != nil {
+					_, _ = fmt.Fprintf(errOut, "[%d/%d] Failed %s: %v\n", i+1, len(targets), shortDiscussionID(d.ID), err)
+					continue
+				}
+				done++
+				_, _ = fmt.Fprintf(errOut, "[%d/%d] %s %s\n", i+1, len(targets), past, shortDiscussionID(d.ID))
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "%s %d of %d thread(s) on !%d\n", past, done, len(targets), mrID)
+			if failed := len(targets) - done; failed > 0 {
+				return fmt.Errorf("failed to %s %d of %d thread(s)", verb, failed, len(targets))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&discussionID, "discussion-id", "", "ID or ID prefix of the thread to "+verb)
+	cmd.Flags().BoolVar(&all, "all", false, "Apply to every thread on the merge request")
+
+	return cmd
+}
+
+// listMRDiscussions fetches every discussion thread on a merge request.
+func listMRDiscussions(cmd *cobra.Command, client *api.Client, project string, mrID int64) ([]*gitlab.Discussion, error) {
+	discussions, resp, err := listAllDiscussions(func(opts gitlab.ListOptions) ([]*gitlab.Discussion, *gitlab.Response, error) {
+		return client.Discussions.ListMergeRequestDiscussions(project, mrID, &gitlab.ListMergeRequestDiscussionsOptions{ListOptions: opts}, gitlab.WithContext(cmd.Context()))
+	})
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := fmt.Sprintf("%s/projects/%s/merge_requests/%d/discussions", api.APIURL(client.Host()), project, mrID)
+		return nil, errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to list discussions for merge request !%d", mrID), err)
+	}
+	return discussions, nil
+}
+
+func setDiscussionResolved(cmd *cobra.Command, client *api.Client, project string, mrID int64, id string, resolved bool) error {
+	_, resp, err := client.Discussions.ResolveMergeRequestDiscussion(project, mrID, id, &gitlab.ResolveMergeRequestDiscussionOptions{
+		Resolved: &resolved,
+	}, gitlab.WithContext(cmd.Context()))
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		verb := "resolve"
+		if !resolved {
+			verb = "unresolve"
+		}
+		url := fmt.Sprintf("%s/projects/%s/merge_requests/%d/discussions/%s", api.APIURL(client.Host()), project, mrID, id)
+		return errors.NewAPIError("PUT", url, statusCode, fmt.Sprintf("Failed to %s discussion on merge request !%d", verb, mrID), err)
+	}
+	return nil
+}
+
+// findDiscussion returns the thread whose ID is id or starts with it.
+func findDiscussion(discussions []*gitlab.Discussion, id string) (*gitlab.Discussion, error) {
+	var matches []*gitlab.Discussion
+	for _, d := range discussions {
+		if d.ID == id {
+			return d, nil
+		}
+		if strings.HasPrefix(d.ID, id) {
+			matches = append(matches, d)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no discussion found with ID %q", id)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("discussion ID %q is ambiguous: matches %d threads", id, len(matches))
+	}
+}
+
+// discussionUnresolved reports whether a thread has resolvable notes that are
+// not yet resolved.
+func discussionUnresolved(d *gitlab.Discussion) bool {
+	for _, n := range d.Notes {
+		if n.Resolvable && !n.Resolved {
+			return true
+		}
+	}
+	return false
+}
+
+func newDiscussionRow(d *gitlab.Discussion) discussionRow {
+	first := d.Notes[0]
+	row := discussionRow{
+		ID:     d.ID,
+		Status: "-",
+		Author: first.Author.Username,
+		Body:   first.Body,
+	}
+	for _, n := range d.Notes {
+		if !n.System {
+			row.Notes++
+		}
+	}
+	if first.Resolvable {
+		row.Status = "resolved"
+		if discussionUnresolved(d) {
+			row.Status = "unresolved"
+		}
+	}
+	if pos := first.Position; pos != nil {
+		path, line := pos.NewPath, pos.NewLine
+		if path == "" {
+			path = pos.OldPath
+		}
+		if line == 0 {
+			line = pos.OldLine
+		}
+		row.Location = path
+		if path != "" && line != 0 {
+			row.Location = fmt.Sprintf("%s:%d", path, line)
+		}
+	}
+	return row
+}
+
+func shortDiscussionID(id string) string {
+	if len(id) > shortDiscussionIDLen {
+		return id[:shortDiscussionIDLen]
+	}
+	return id
+}
+
+func firstLine(s string) string {
+	s = strings.TrimSpace(s)
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
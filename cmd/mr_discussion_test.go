@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func discussionFixtures() []map[string]any {
+	return []map[string]any{
+		{"id": "3f2a9c1b00000000000000000000000000000001", "notes": []map[string]any{
+			{"id": 1, "body": "Please rename this\nIt is confusing", "author": map[string]any{"username": "alice"},
+				"resolvable": true, "resolved": false,
+				"position": map[string]any{"new_path": "main.go", "new_line": 12}},
+			{"id": 2, "body": "Will do", "author": map[string]any{"username": "bob"}, "resolvable": true, "resolved": false},
+		}},
+		{"id": "3f2a9c1c00000000000000000000000000000002", "notes": []map[string]any{
+			{"id": 3, "body": "Nit: typo", "author": map[string]any{"username": "carol"}, "resolvable": true, "resolved": true},
+		}},
+		{"id": "7d0e000000000000000000000000000000000003", "individual_note": true, "notes": []map[string]any{
+			{"id": 4, "body": "Looks good", "author": map[string]any{"username": "dave"}},
+		}},
+		{"id": "9a00000000000000000000000000000000000004", "individual_note": true, "notes": []map[string]any{
+			{"id": 5, "body": "added 1 commit", "system": true, "author": map[string]any{"username": "bob"}},
+		}},
+	}
+}
+
+func TestMRDiscussionCmd_Subcommands(t *testing.T) {
+	cmd := newMRDiscussionCmd(newTestFactory())
+
+	for _, name := range []string{"list", "reply", "resolve", "unresolve"} {
+		found := false
+		for _, sub := range cmd.Commands() {
+			if sub.Name() == name {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected subcommand %q", name)
+		}
+	}
+}
+
+func TestMRDiscussionList(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/merge_requests/1/discussions") {
+			cmdtest.JSONResponse(w, 200, discussionFixtures())
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRDiscussionListCmd(f.Factory)
+	cmd.SetArgs([]string{"1", "--format", "json"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var rows []discussionRow
+	if err := json.Unmarshal([]byte(f.IO.String()), &rows); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 threads without system notes, got %d: %+v", len(rows), rows)
+	}
+	if rows[0].Status != "unresolved" || rows[0].Location != "main.go:12" || rows[0].Notes != 2 {
+		t.Errorf("unexpected first row: %+v", rows[0])
+	}
+	if rows[1].Status != "resolved" || rows[2].Status != "-" {
+		t.Errorf("unexpected statuses: %q, %q", rows[1].Status, rows[2].Status)
+	}
+
+	f = cmdtest.NewTestFactory(t)
+	cmd = newMRDiscussionListCmd(f.Factory)
+	cmd.SetArgs([]string{"1", "--unresolved"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := f.IO.String()
+	if !strings.Contains(out, "3f2a9c1b\tunresolved\talice\tmain.go:12\t2\tPlease rename this") {
+		t.Errorf("expected unresolved thread row, got: %q", out)
+	}
+	if strings.Contains(out, "Nit: typo") || strings.Contains(out, "Looks good") {
+		t.Errorf("expected only unresolved threads, got: %q", out)
+	}
+}
+
+func TestMRDiscussionReply_Prefix(t *testing.T) {
+	var gotBody string
+	var resolvedPath string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/discussions"):
+			cmdtest.JSONResponse(w, 200, discussionFixtures())
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/discussions/3f2a9c1b00000000000000000000000000000001/notes"):
+			b, _ := io.ReadAll(r.Body)
+			gotBody = string(b)
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": 9, "body": "Done"})
+		case r.Method == http.MethodPut:
+			resolvedPath = r.URL.Path
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": "3f2a9c1b00000000000000000000000000000001"})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRDiscussionReplyCmd(f.Factory)
+	cmd.SetArgs([]string{"1", "--discussion-id", "3f2a9c1b", "--body", "Done", "--resolve"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(gotBody, `"body":"Done"`) {
+		t.Errorf("unexpected request body: %s", gotBody)
+	}
+	if !strings.HasSuffix(resolvedPath, "/discussions/3f2a9c1b00000000000000000000000000000001") {
+		t.Errorf("expected thread to be resolved, got PUT %q", resolvedPath)
+	}
+	out := f.IO.String()
+	if !strings.Contains(out, "Replied to discussion 3f2a9c1b on !1") || !strings.Contains(out, "Resolved discussion 3f2a9c1b on !1") {
+		t.Errorf("unexpected output: %q", out)
+	}
+}
+
+func TestMRDiscussionResolve_AmbiguousPrefix(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		cmdtest.JSONResponse(w, 200, discussionFixtures())
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRDiscussionResolveCmd(f.Factory, true)
+	cmd.SetArgs([]string{"1", "--discussion-id", "3f2a9c1"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Fatalf("expected ambiguous ID error, got %v", err)
+	}
+}
+
+func TestMRDiscussionResolve_All(t *testing.T) {
+	var puts []string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			puts = append(puts, r.URL.Path)
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": "x"})
+			return
+		}
+		cmdtest.JSONResponse(w, 200, discussionFixtures())
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRDiscussionResolveCmd(f.Factory, true)
+	cmd.SetArgs([]string{"1", "--all"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(puts) != 1 || !strings.HasSuffix(puts[0], "/3f2a9c1b00000000000000000000000000000001") {
+		t.Errorf("expected only the unresolved thread to be resolved, got %v", puts)
+	}
+	if !strings.Contains(f.IO.String(), "Resolved 1 of 1 thread(s) on !1") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestMRDiscussionResolve_RequiresTarget(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRDiscussionResolveCmd(f.Factory, false)
+	cmd.SetArgs([]string{"1"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "--discussion-id or --all") {
+		t.Fatalf("expected missing target error, got %v", err)
+	}
+}
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// draftPrefixRe matches the title prefixes GitLab treats as marking a merge
+// request as a draft, including the legacy WIP forms.
+var draftPrefixRe = regexp.MustCompile(`(?i)^\s*(draft:|\[draft\]|\(draft\)|draft\s+-|wip:|\[wip\])\s*`)
+
+func newMRDraftCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "draft [<id>]",
+		Short: "Mark a merge request as draft",
+		Long: `Mark a merge request as draft by adding the "Draft:" prefix to its title.
+
+Draft merge requests cannot be merged until they are marked as ready.`,
+		Example: `  $ glab mr draft 123
+  $ glab mr draft   # merge request for the current branch`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return setMRDraft(cmd, f, args, true)
+		},
+	}
+
+	return cmd
+}
+
+func newMRReadyCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ready [<id>]",
+		Short: "Mark a merge request as ready",
+		Long: `Mark a draft merge request as ready by removing the draft prefix
+("Draft:", "[Draft]", "(Draft)", or the legacy "WIP:") from its title.`,
+		Example: `  $ glab mr ready 123
+  $ glab mr ready   # merge request for the current branch`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return setMRDraft(cmd, f, args, false)
+		},
+	}
+
+	return cmd
+}
+
+// setMRDraft adds or removes the draft prefix of a merge request's title.
+func setMRDraft(cmd *cobra.Command, f *cmdutil.Factory, args []string, draft bool) error {
+	client, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	project, err := f.FullProjectPath()
+	if err != nil {
+		return err
+	}
+
+	mrID, err := resolveMRArg(client, project, args)
+	if err != nil {
+		return err
+	}
+
+	mr, resp, err := client.MergeRequests.GetMergeRequest(project, mrID, nil, gitlab.WithContext(cmd.Context()))
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := fmt.Sprintf("%s/projects/%s/merge_requests/%d", api.APIURL(client.Host()), project, mrID)
+		return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get merge request !%d", mrID), err)
+	}
+
+	if mr.Draft == draft {
+		if draft {
+			_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Merge request !%d is already a draft\n", mrID)
+		} else {
+			_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Merge request !%d is already ready\n", mrID)
+		}
+		return nil
+	}
+
+	title := draftPrefixRe.ReplaceAllString(mr.Title, "")
+	if draft {
+		title = "Draft: " + title
+	}
+
+	mr, resp, err = client.MergeRequests.UpdateMergeRequest(project, mrID, &gitlab.UpdateMergeRequestOptions{
+		Title: &title,
+	}, gitlab.WithContext(cmd.Context()))
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := fmt.Sprintf("%s/projects/%s/merge_requests/%d", api.APIURL(client.Host()), project, mrID)
+		return errors.NewAPIError("PUT", url, statusCode, fmt.Sprintf("Failed to update merge request !%d", mrID), err)
+	}
+
+	if draft {
+		_, _ = fmt.Fprintf(f.IOStreams.Out, "Marked merge request !%d as draft\n", mr.IID)
+	} else {
+		_, _ = fmt.Fprintf(f.IOStreams.Out, "Marked merge request !%d as ready\n", mr.IID)
+	}
+	return nil
+}
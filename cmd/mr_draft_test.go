@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestDraftPrefixRe(t *testing.T) {
+	tests := map[string]string{
+		"Draft: Add feature":   "Add feature",
+		"[Draft] Add feature":  "Add feature",
+		"(draft) Add feature":  "Add feature",
+		"Draft - Add feature":  "Add feature",
+		"WIP: Add feature":     "Add feature",
+		"Add draft: mode":      "Add draft: mode",
+		"Drafting the release": "Drafting the release",
+	}
+	for in, want := range tests {
+		if got := draftPrefixRe.ReplaceAllString(in, ""); got != want {
+			t.Errorf("stripping %q: got %q, want %q", in, got, want)
+		}
+	}
+}
+
+func draftServer(t *testing.T, title string, draft bool, gotTitle *string) {
+	t.Helper()
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/merge_requests/4") {
+			cmdtest.ErrorResponse(w, 404, "not found")
+			return
+		}
+		if r.Method == http.MethodPut {
+			var body struct {
+				Title string `json:"title"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			*gotTitle = body.Title
+			cmdtest.JSONResponse(w, 200, map[string]any{"iid": 4, "title": body.Title})
+			return
+		}
+		cmdtest.JSONResponse(w, 200, map[string]any{
+			"iid": 4, "title": title, "draft": draft, "state": "opened",
+			"author": map[string]any{"username": "alice"},
+		})
+	})
+}
+
+func TestMRDraft(t *testing.T) {
+	var gotTitle string
+	draftServer(t, "Add feature", false, &gotTitle)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRDraftCmd(f.Factory)
+	cmd.SetArgs([]string{"4"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotTitle != "Draft: Add feature" {
+		t.Errorf("unexpected title %q", gotTitle)
+	}
+	if !strings.Contains(f.IO.String(), "Marked merge request !4 as draft") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestMRReady(t *testing.T) {
+	var gotTitle string
+	draftServer(t, "[Draft] Add feature", true, &gotTitle)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRReadyCmd(f.Factory)
+	cmd.SetArgs([]string{"4"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotTitle != "Add feature" {
+		t.Errorf("unexpected title %q", gotTitle)
+	}
+	if !strings.Contains(f.IO.String(), "Marked merge request !4 as ready") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestMRReady_AlreadyReady(t *testing.T) {
+	var gotTitle string
+	draftServer(t, "Add feature", false, &gotTitle)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRReadyCmd(f.Factory)
+	cmd.SetArgs([]string{"4"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotTitle != "" {
+		t.Errorf("expected no update, got title %q", gotTitle)
+	}
+	if !strings.Contains(f.IO.ErrString(), "already ready") {
+		t.Errorf("unexpected error output: %q", f.IO.ErrString())
+	}
+}
+
+func TestMRView_Draft(t *testing.T) {
+	var gotTitle string
+	draftServer(t, "Draft: Add feature", true, &gotTitle)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRViewCmd(f.Factory)
+	cmd.SetArgs([]string{"4"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(f.IO.String(), "State:   opened (draft)") {
+		t.Errorf("expected draft state, got %q", f.IO.String())
+	}
+}
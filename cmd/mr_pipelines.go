@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/browser"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func newMRPipelinesCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		latest   bool
+		web      bool
+		limit    int
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "pipelines [<id>]",
+		Short: "List the pipelines of a merge request",
+		Long: `List the pipelines that ran for a merge request, newest first.
+
+Without an ID, the merge request of the current branch is used. --latest
+shows only the most recent pipeline; with --web it opens that pipeline,
+otherwise --web opens the merge request's pipelines tab.`,
+		Example: `  $ glab mr pipelines 123
+  $ glab mr pipelines --latest
+  $ glab mr pipelines 123 --latest --web`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			mrID, err := resolveMRArg(client, project, args)
+			if err != nil {
+				return err
+			}
+
+			if web && !latest {
+				return browser.Open(fmt.Sprintf("%s/-/merge_requests/%d/pipelines", api.WebURL(client.Host(), project), mrID))
+			}
+
+			pipelines, resp, err := client.MergeRequests.ListMergeRequestPipelines(project, mrID, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/merge_requests/%d/pipelines", api.APIURL(client.Host()), project, mrID)
+				return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to list pipelines of merge request !%d", mrID), err)
+			}
+
+			if len(pipelines) == 0 {
+				_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "No pipelines found for !%d\n", mrID)
+				return nil
+			}
+			if latest {
+				pipelines = pipelines[:1]
+				if web {
+					return browser.Open(pipelines[0].WebURL)
+				}
+			}
+			if limit > 0 && len(pipelines) > limit {
+				pipelines = pipelines[:limit]
+			}
+
+			if jsonFlag || f.ExportRequested() || (format != "" && format != "table") {
+				return f.FormatAndPrint(pipelines, format, jsonFlag)
+			}
+
+			cs := f.IOStreams.ColorScheme()
+			tp := f.NewTablePrinter()
+			tp.SetHeader("ID", "STATUS", "SOURCE", "REF", "SHA", "CREATED")
+			for _, p := range pipelines {
+				tp.AddRow(
+					strconv.FormatInt(p.ID, 10),
+					cs.ForState(p.Status, p.Status),
+					p.Source,
+					p.Ref,
+					shortSHA(p.SHA),
+					timeAgo(p.CreatedAt),
+				)
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().BoolVar(&latest, "latest", false, "Only show the most recent pipeline")
+	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open the pipelines tab (or the latest pipeline with --latest) in the browser")
+	cmd.Flags().IntVarP(&limit, "limit", "L", 20, "Maximum number of results")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
+
+	return cmd
+}
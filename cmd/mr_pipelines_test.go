@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func mrPipelinesServer(t *testing.T) {
+	t.Helper()
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/test-owner/test-repo/merge_requests/7/pipelines" {
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+				{"id": 302, "status": "failed", "source": "merge_request_event", "ref": "refs/merge-requests/7/head", "sha": "abcdef1234567890", "web_url": "https://gitlab.com/test-owner/test-repo/-/pipelines/302", "created_at": "2024-01-15T10:00:00Z"},
+				{"id": 301, "status": "success", "source": "push", "ref": "feature", "sha": "1234567890abcdef", "web_url": "https://gitlab.com/test-owner/test-repo/-/pipelines/301", "created_at": "2024-01-14T10:00:00Z"},
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+}
+
+func TestMRPipelines(t *testing.T) {
+	mrPipelinesServer(t)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRPipelinesCmd(f.Factory)
+	cmd.SetArgs([]string{"7"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := f.IO.String()
+	for _, want := range []string{"302", "failed", "refs/merge-requests/7/head", "abcdef12", "301", "success", "feature"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q: %s", want, out)
+		}
+	}
+}
+
+func TestMRPipelines_Latest(t *testing.T) {
+	mrPipelinesServer(t)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRPipelinesCmd(f.Factory)
+	cmd.SetArgs([]string{"7", "--latest"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := f.IO.String()
+	if !strings.Contains(out, "302") || strings.Contains(out, "301") {
+		t.Errorf("expected only the latest pipeline, got: %s", out)
+	}
+}
+
+func TestMRPipelines_Empty(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		cmdtest.JSONResponse(w, 200, []map[string]interface{}{})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRPipelinesCmd(f.Factory)
+	cmd.SetArgs([]string{"7"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(f.IO.ErrString(), "No pipelines found for !7") {
+		t.Errorf("unexpected stderr: %s", f.IO.ErrString())
+	}
+}
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func newMRRebaseCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		skipCI   bool
+		wait     bool
+		interval time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "rebase [<id>]",
+		Short: "Rebase a merge request onto its target branch",
+		Long: `Rebase the source branch of a merge request onto its target branch on the
+GitLab server.
+
+The rebase runs in the background. Use --wait to poll until it finishes and
+exit non-zero if it fails.`,
+		Example: `  $ glab mr rebase 123
+  $ glab mr rebase 123 --wait
+  $ glab mr rebase --skip-ci   # merge request for the current branch`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			mrID, err := resolveMRArg(client, project, args)
+			if err != nil {
+				return err
+			}
+
+			opts := &gitlab.RebaseMergeRequestOptions{}
+			if skipCI {
+				opts.SkipCI = &skipCI
+			}
+
+			resp, err := client.MergeRequests.RebaseMergeRequest(project, mrID, opts, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/merge_requests/%d/rebase", api.APIURL(client.Host()), project, mrID)
+				return errors.NewAPIError("PUT", url, statusCode, fmt.Sprintf("Failed to rebase merge request !%d", mrID), err)
+			}
+
+			if !wait {
+				_, _ = fmt.Fprintf(f.IOStreams.Out, "Started rebase of merge request !%d\n", mrID)
+				return nil
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Rebasing merge request !%d...\n", mrID)
+			if err := waitForRebase(cmd.Context(), client, project, mrID, interval); err != nil {
+				return err
+			}
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Rebased merge request !%d\n", mrID)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&skipCI, "skip-ci", false, "Do not create a pipeline for the rebased branch")
+	cmd.Flags().BoolVarP(&wait, "wait", "w", false, "Wait for the rebase to finish")
+	cmd.Flags().DurationVarP(&interval, "interval", "i", 2*time.Second, "Polling interval when using --wait")
+
+	return cmd
+}
+
+// waitForRebase polls a merge request until its rebase is no longer in
+// progress. It returns the merge error GitLab reports if the rebase failed.
+func waitForRebase(ctx context.Context, client *api.Client, project string, mrID int64, interval time.Duration) error {
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	opts := &gitlab.GetMergeRequestsOptions{IncludeRebaseInProgress: gitlab.Ptr(true)}
+	for {
+		mr, resp, err := client.MergeRequests.GetMergeRequest(project, mrID, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			url := fmt.Sprintf("%s/projects/%s/merge_requests/%d", api.APIURL(client.Host()), project, mrID)
+			return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get merge request !%d", mrID), err)
+		}
+		if !mr.RebaseInProgress {
+			if mr.MergeError != "" {
+				return fmt.Errorf("rebase of merge request !%d failed: %s", mrID, mr.MergeError)
+			}
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestMRRebase_Wait(t *testing.T) {
+	polls := 0
+	rebased := false
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && strings.HasSuffix(r.URL.Path, "/merge_requests/3/rebase"):
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body["skip_ci"] != true {
+				t.Errorf("expected skip_ci in request, got %v", body)
+			}
+			rebased = true
+			cmdtest.JSONResponse(w, 202, map[string]any{"rebase_in_progress": true})
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/merge_requests/3"):
+			polls++
+			cmdtest.JSONResponse(w, 200, map[string]any{"iid": 3, "rebase_in_progress": polls < 2})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRRebaseCmd(f.Factory)
+	cmd.SetArgs([]string{"3", "--skip-ci", "--wait", "--interval", "1ms"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !rebased || polls != 2 {
+		t.Errorf("expected rebase and two polls, got rebased=%v polls=%d", rebased, polls)
+	}
+	if !strings.Contains(f.IO.String(), "Rebased merge request !3") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestMRRebase_Failed(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			cmdtest.JSONResponse(w, 202, map[string]any{"rebase_in_progress": true})
+			return
+		}
+		cmdtest.JSONResponse(w, 200, map[string]any{"iid": 3, "merge_error": "Rebase failed: conflicts"})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRRebaseCmd(f.Factory)
+	cmd.SetArgs([]string{"3", "--wait"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "Rebase failed: conflicts") {
+		t.Fatalf("expected rebase failure, got %v", err)
+	}
+}
+
+func TestMRMerge_MergeTrain(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/merge_trains/merge_requests/1") {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 201, []map[string]any{{"id": 1, "status": "idle"}})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRMergeCmd(f.Factory)
+	cmd.SetArgs([]string{"1", "--merge-train", "--auto"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["auto_merge"] != true {
+		t.Errorf("expected auto_merge in request, got %v", body)
+	}
+	if !strings.Contains(f.IO.String(), "will be added to the merge train when the pipeline succeeds") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestMRMerge_Auto(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut && strings.HasSuffix(r.URL.Path, "/merge_requests/1/merge") {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 200, map[string]any{"iid": 1, "state": "opened"})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRMergeCmd(f.Factory)
+	cmd.SetArgs([]string{"1", "--auto"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["auto_merge"] != true {
+		t.Errorf("expected auto_merge in request, got %v", body)
+	}
+	if !strings.Contains(f.IO.String(), "will be merged when the pipeline succeeds") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
@@ -0,0 +1,235 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/browser"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func newMRRevertCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		branch       string
+		sourceBranch string
+		targetBranch string
+		title        string
+		draft        bool
+		web          bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "revert <id>",
+		Short: "Revert a merged merge request",
+		Long: `Revert a merged merge request and open a merge request with the revert.
+
+A revert branch is created from the original target branch, the merge commit
+(or squash commit) is reverted on it via the API, and a new merge request
+targeting the original target branch is opened. When the merge request was
+fast-forward merged without a merge commit, its commits are reverted one by
+one, newest first.`,
+		Example: `  $ glab mr revert 123
+  $ glab mr revert 123 --source-branch hotfix/revert-login --draft
+  $ glab mr revert 123 --target-branch release-1.2 --web`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			mrID, err := parseMRArg(args)
+			if err != nil {
+				return err
+			}
+
+			mr, resp, err := client.MergeRequests.GetMergeRequest(project, mrID, nil)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/merge_requests/%d", api.APIURL(client.Host()), project, mrID)
+				return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get merge request !%d", mrID), err)
+			}
+
+			if mr.State != "merged" {
+				return fmt.Errorf("merge request !%d is %s; only merged merge requests can be reverted", mrID, mr.State)
+			}
+
+			shas, err := mrRevertCommits(client, project, mr)
+			if err != nil {
+				return err
+			}
+
+			if branch != "" {
+				commits, err := revertCommits(client, project, shas, branch)
+				if err != nil {
+					return err
+				}
+				out := f.IOStreams.Out
+				_, _ = fmt.Fprintf(out, "Reverted !%d on branch %s\n", mr.IID, branch)
+				for _, c := range commits {
+					_, _ = fmt.Fprintf(out, "%s %s\n", c.ShortID, c.Title)
+				}
+				return nil
+			}
+
+			if targetBranch == "" {
+				targetBranch = mr.TargetBranch
+			}
+			if sourceBranch == "" {
+				sourceBranch = fmt.Sprintf("revert-mr-%d", mr.IID)
+			}
+
+			_, resp, err = client.Branches.CreateBranch(project, &gitlab.CreateBranchOptions{
+				Branch: &sourceBranch,
+				Ref:    &targetBranch,
+			})
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + project + "/repository/branches"
+				return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to create branch %s", sourceBranch), err)
+			}
+
+			// Remove the revert branch again when the revert cannot be
+			// completed, so that running the command again does not fail on
+			// the existing branch.
+			deleteBranch := func() {
+				_, _ = client.Branches.DeleteBranch(project, sourceBranch)
+			}
+
+			if _, err := revertCommits(client, project, shas, sourceBranch); err != nil {
+				deleteBranch()
+				return err
+			}
+
+			if title == "" {
+				title = fmt.Sprintf("Revert %q", mr.Title)
+			}
+			if draft {
+				title = "Draft: " + title
+			}
+			description := fmt.Sprintf("This reverts merge request !%d.", mr.IID)
+			removeSource := true
+
+			revertMR, resp, err := client.MergeRequests.CreateMergeRequest(project, &gitlab.CreateMergeRequestOptions{
+				Title:              &title,
+				Description:        &description,
+				SourceBranch:       &sourceBranch,
+				TargetBranch:       &targetBranch,
+				RemoveSourceBranch: &removeSource,
+			})
+			if err != nil {
+				deleteBranch()
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + project + "/merge_requests"
+				return errors.NewAPIError("POST", url, statusCode, "Failed to create revert merge request", err)
+			}
+
+			out := f.IOStreams.Out
+			_, _ = fmt.Fprintf(out, "Reverted !%d on branch %s\n", mr.IID, sourceBranch)
+			_, _ = fmt.Fprintf(out, "Created merge request !%d\n", revertMR.IID)
+			_, _ = fmt.Fprintf(out, "%s\n", revertMR.WebURL)
+
+			if web {
+				_ = browser.Open(revertMR.WebURL)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&branch, "branch", "", "Commit the revert directly on this branch instead of opening a merge request")
+	cmd.Flags().StringVarP(&sourceBranch, "source-branch", "s", "", "Name of the revert branch (default: revert-mr-<id>)")
+	cmd.Flags().StringVarP(&targetBranch, "target-branch", "b", "", "Branch to revert on (default: target branch of the merge request)")
+	cmd.Flags().StringVarP(&title, "title", "t", "", "Title of the revert merge request")
+	cmd.Flags().BoolVar(&draft, "draft", false, "Mark the revert merge request as draft")
+	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open the revert merge request in browser")
+	for _, name := range []string{"source-branch", "target-branch", "title", "draft", "web"} {
+		cmd.MarkFlagsMutuallyExclusive("branch", name)
+	}
+
+	return cmd
+}
+
+// revertCommits reverts shas in order on branch and returns the new
+// commits.
+func revertCommits(client *api.Client, project string, shas []string, branch string) ([]*gitlab.Commit, error) {
+	var commits []*gitlab.Commit
+	for _, sha := range shas {
+		c, resp, err := client.Commits.RevertCommit(project, sha, &gitlab.RevertCommitOptions{Branch: &branch})
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			url := fmt.Sprintf("%s/projects/%s/repository/commits/%s/revert", api.APIURL(client.Host()), project, sha)
+			return nil, errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to revert commit %s on %s", shortSHA(sha), branch), err)
+		}
+		commits = append(commits, c)
+	}
+	return commits, nil
+}
+
+// mrRevertCommits returns the commits to revert for a merged merge request,
+// in the order they must be reverted.
+func mrRevertCommits(client *api.Client, project string, mr *gitlab.MergeRequest) ([]string, error) {
+	if mr.MergeCommitSHA != "" {
+		return []string{mr.MergeCommitSHA}, nil
+	}
+	if mr.SquashCommitSHA != "" {
+		return []string{mr.SquashCommitSHA}, nil
+	}
+
+	// Fast-forward merge: revert the merge request's own commits, newest first.
+	var shas []string
+	opts := &gitlab.GetMergeRequestCommitsOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+	page := 1
+	for {
+		opts.Page = int64(page)
+		commits, resp, err := client.MergeRequests.GetMergeRequestCommits(project, mr.IID, opts)
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			url := fmt.Sprintf("%s/projects/%s/merge_requests/%d/commits", api.APIURL(client.Host()), project, mr.IID)
+			return nil, errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to list commits of merge request !%d", mr.IID), err)
+		}
+		for _, c := range commits {
+			shas = append(shas, c.ID)
+		}
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		page = int(resp.NextPage)
+	}
+
+	if len(shas) == 0 {
+		return nil, fmt.Errorf("merge request !%d has no commits to revert", mr.IID)
+	}
+	return shas, nil
+}
+
+// shortSHA abbreviates a commit SHA for display.
+func shortSHA(sha string) string {
+	if len(sha) > 8 {
+		return sha[:8]
+	}
+	return sha
+}
@@ -0,0 +1,176 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestMRRevertCmd_Flags(t *testing.T) {
+	f := newTestFactory()
+	cmd := newMRRevertCmd(f)
+
+	if cmd.Use != "revert <id>" {
+		t.Errorf("expected Use 'revert <id>', got %q", cmd.Use)
+	}
+	for _, name := range []string{"source-branch", "target-branch", "title", "draft", "web"} {
+		if cmd.Flags().Lookup(name) == nil {
+			t.Errorf("expected --%s flag", name)
+		}
+	}
+}
+
+func TestMRRevert_MergeCommit(t *testing.T) {
+	var reverted []string
+	var branchRef string
+	var createdMR map[string]any
+
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/merge_requests/42"):
+			cmdtest.JSONResponse(w, 200, map[string]any{
+				"id": 1042, "iid": 42, "title": "Add login", "state": "merged",
+				"target_branch": "main", "merge_commit_sha": "abcdef1234567890",
+			})
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/repository/branches"):
+			var body map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			branchRef = body["ref"]
+			cmdtest.JSONResponse(w, 201, map[string]any{"name": body["branch"]})
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/revert"):
+			reverted = append(reverted, r.URL.Path)
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": "fff"})
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/merge_requests"):
+			_ = json.NewDecoder(r.Body).Decode(&createdMR)
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": 1043, "iid": 43, "web_url": "https://gitlab.com/owner/repo/-/merge_requests/43"})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRRevertCmd(f.Factory)
+	cmd.SetArgs([]string{"42"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if branchRef != "main" {
+		t.Errorf("expected revert branch created from main, got %q", branchRef)
+	}
+	if len(reverted) != 1 || !strings.Contains(reverted[0], "abcdef1234567890") {
+		t.Errorf("expected merge commit to be reverted, got %v", reverted)
+	}
+	if createdMR["source_branch"] != "revert-mr-42" || createdMR["target_branch"] != "main" {
+		t.Errorf("unexpected revert MR options: %v", createdMR)
+	}
+	if createdMR["title"] != `Revert "Add login"` {
+		t.Errorf("unexpected revert MR title: %v", createdMR["title"])
+	}
+	if !strings.Contains(f.IO.String(), "Created merge request !43") {
+		t.Errorf("expected created MR in output, got: %s", f.IO.String())
+	}
+}
+
+func TestMRRevert_DeletesBranchOnFailure(t *testing.T) {
+	for _, failing := range []string{"/revert", "/merge_requests"} {
+		var deleted []string
+		cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/merge_requests/42"):
+				cmdtest.JSONResponse(w, 200, map[string]any{
+					"id": 1042, "iid": 42, "title": "Add login", "state": "merged",
+					"target_branch": "main", "merge_commit_sha": "abcdef1234567890",
+				})
+			case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, failing):
+				cmdtest.ErrorResponse(w, 409, "conflict")
+			case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/repository/branches"):
+				cmdtest.JSONResponse(w, 201, map[string]any{"name": "revert-mr-42"})
+			case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/revert"):
+				cmdtest.JSONResponse(w, 201, map[string]any{"id": "fff"})
+			case r.Method == http.MethodDelete:
+				deleted = append(deleted, r.URL.Path)
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				cmdtest.ErrorResponse(w, 404, "not found")
+			}
+		})
+
+		f := cmdtest.NewTestFactory(t)
+		cmd := newMRRevertCmd(f.Factory)
+		cmd.SetArgs([]string{"42"})
+		if err := cmd.Execute(); err == nil {
+			t.Fatalf("%s: expected an error", failing)
+		}
+		if len(deleted) != 1 || !strings.HasSuffix(deleted[0], "/repository/branches/revert-mr-42") {
+			t.Errorf("%s: expected the revert branch to be deleted, got %v", failing, deleted)
+		}
+	}
+}
+
+func TestMRRevert_NotMerged(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/merge_requests/7") {
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 1, "iid": 7, "state": "opened"})
+			return
+		}
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRRevertCmd(f.Factory)
+	cmd.SetArgs([]string{"7"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "only merged merge requests") {
+		t.Fatalf("expected not-merged error, got %v", err)
+	}
+}
+
+func TestMRRevert_Branch(t *testing.T) {
+	var revertBranch string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/merge_requests/42"):
+			cmdtest.JSONResponse(w, 200, map[string]any{
+				"id": 1042, "iid": 42, "title": "Add login", "state": "merged",
+				"target_branch": "main", "merge_commit_sha": "abcdef1234567890",
+			})
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/commits/abcdef1234567890/revert"):
+			var body map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			revertBranch = body["branch"]
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": "fff000", "short_id": "fff000", "title": `Revert "Merge branch 'login'"`})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRRevertCmd(f.Factory)
+	cmd.SetArgs([]string{"42", "--branch", "main"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if revertBranch != "main" {
+		t.Errorf("expected revert on main, got %q", revertBranch)
+	}
+	out := f.IO.String()
+	if !strings.Contains(out, "Reverted !42 on branch main") || !strings.Contains(out, "fff000") {
+		t.Errorf("unexpected output: %s", out)
+	}
+}
+
+func TestMRRevert_BranchExclusiveWithSourceBranch(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRRevertCmd(f.Factory)
+	cmd.SetArgs([]string{"42", "--branch", "main", "--source-branch", "revert"})
+	if err := cmd.Execute(); err == nil {
+		t.Fatal("expected --branch and --source-branch to be mutually exclusive")
+	}
+}
@@ -0,0 +1,181 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func newMRReviewCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		approve        bool
+		requestChanges bool
+		comment        bool
+		body           string
+		file           string
+		line           int64
+		oldLine        int64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "review [<id>]",
+		Short: "Review a merge request",
+		Long: `Review a merge request from the terminal.
+
+Use --approve to approve, --request-changes to revoke your approval and
+explain what needs to change, or --comment to leave a general review comment.
+
+With --file and --line (or --old-line for removed lines), --body is posted as
+an inline discussion on that line of the diff. Inline comments can be combined
+with --approve or --request-changes to finish the review in one step.`,
+		Example: `  $ glab mr review 123 --approve
+  $ glab mr review 123 --approve --body "LGTM"
+  $ glab mr review 123 --request-changes --body "Please add tests"
+  $ glab mr review 123 --comment --body "Looks good overall, a few nits inline"
+  $ glab mr review 123 --file cmd/mr.go --line 42 --body "This can be nil"
+  $ glab mr review --file old.go --old-line 7 --body "Why was this removed?"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inline := file != ""
+			if !approve && !requestChanges && !comment && !inline {
+				return fmt.Errorf("specify --approve, --request-changes, --comment, or --file with --line")
+			}
+			if inline && line == 0 && oldLine == 0 {
+				return fmt.Errorf("--line or --old-line is required with --file")
+			}
+			if !inline && (line != 0 || oldLine != 0) {
+				return fmt.Errorf("--file is required with --line and --old-line")
+			}
+			if body == "" && (comment || requestChanges || inline) {
+				return fmt.Errorf("--body is required for comments and change requests")
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			mrID, err := resolveMRArg(client, project, args)
+			if err != nil {
+				return err
+			}
+
+			out := f.IOStreams.Out
+
+			if inline {
+				mr, resp, err := client.MergeRequests.GetMergeRequest(project, mrID, nil)
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/merge_requests/%d", api.APIURL(client.Host()), project, mrID)
+					return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get merge request !%d", mrID), err)
+				}
+
+				_, resp, err = client.Discussions.CreateMergeRequestDiscussion(project, mrID, &gitlab.CreateMergeRequestDiscussionOptions{
+					Body:     &body,
+					Position: newDiffPosition(mr, file, line, oldLine),
+				})
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/merge_requests/%d/discussions", api.APIURL(client.Host()), project, mrID)
+					return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to add inline comment to merge request !%d", mrID), err)
+				}
+
+				target := fmt.Sprintf("%s:%d", file, line)
+				if line == 0 {
+					target = fmt.Sprintf("%s:%d (old)", file, oldLine)
+				}
+				_, _ = fmt.Fprintf(out, "Commented on %s in !%d\n", target, mrID)
+			} else if body != "" {
+				_, resp, err := client.Notes.CreateMergeRequestNote(project, mrID, &gitlab.CreateMergeRequestNoteOptions{
+					Body: &body,
+				})
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/merge_requests/%d/notes", api.APIURL(client.Host()), project, mrID)
+					return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to comment on merge request !%d", mrID), err)
+				}
+				if comment {
+					_, _ = fmt.Fprintf(out, "Commented on merge request !%d\n", mrID)
+				}
+			}
+
+			switch {
+			case approve:
+				_, resp, err := client.MergeRequestApprovals.ApproveMergeRequest(project, mrID, nil)
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/merge_requests/%d/approve", api.APIURL(client.Host()), project, mrID)
+					return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to approve merge request !%d", mrID), err)
+				}
+				_, _ = fmt.Fprintf(out, "Approved merge request !%d\n", mrID)
+			case requestChanges:
+				resp, err := client.MergeRequestApprovals.UnapproveMergeRequest(project, mrID)
+				// A 404 means there was no approval of ours to revoke.
+				if err != nil && (resp == nil || resp.StatusCode != 404) {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/merge_requests/%d/unapprove", api.APIURL(client.Host()), project, mrID)
+					return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to unapprove merge request !%d", mrID), err)
+				}
+				_, _ = fmt.Fprintf(out, "Requested changes on merge request !%d\n", mrID)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&approve, "approve", "a", false, "Approve the merge request")
+	cmd.Flags().BoolVarP(&requestChanges, "request-changes", "r", false, "Revoke your approval and request changes (requires --body)")
+	cmd.Flags().BoolVarP(&comment, "comment", "c", false, "Leave a general review comment (requires --body)")
+	cmd.Flags().StringVarP(&body, "body", "b", "", "Review comment text")
+	cmd.Flags().StringVar(&file, "file", "", "File path in the diff for an inline comment")
+	cmd.Flags().Int64Var(&line, "line", 0, "Line number in the new version of the file")
+	cmd.Flags().Int64Var(&oldLine, "old-line", 0, "Line number in the old version of the file, for removed lines")
+	cmd.MarkFlagsMutuallyExclusive("approve", "request-changes", "comment")
+	cmd.MarkFlagsMutuallyExclusive("line", "old-line")
+
+	return cmd
+}
+
+// newDiffPosition returns the position of a line in the merge request diff.
+// Set newLine for added or unchanged lines and oldLine for removed lines.
+func newDiffPosition(mr *gitlab.MergeRequest, file string, newLine, oldLine int64) *gitlab.PositionOptions {
+	posType := "text"
+	position := &gitlab.PositionOptions{
+		BaseSHA:      &mr.DiffRefs.BaseSha,
+		HeadSHA:      &mr.DiffRefs.HeadSha,
+		StartSHA:     &mr.DiffRefs.StartSha,
+		NewPath:      &file,
+		OldPath:      &file,
+		PositionType: &posType,
+	}
+	if newLine != 0 {
+		position.NewLine = &newLine
+	}
+	if oldLine != 0 {
+		position.OldLine = &oldLine
+	}
+	return position
+}
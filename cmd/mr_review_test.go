@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestMRReviewCmd_Flags(t *testing.T) {
+	f := newTestFactory()
+	cmd := newMRReviewCmd(f)
+
+	if cmd.Use != "review [<id>]" {
+		t.Errorf("expected Use 'review [<id>]', got %q", cmd.Use)
+	}
+	for _, name := range []string{"approve", "request-changes", "comment", "body", "file", "line", "old-line"} {
+		if cmd.Flags().Lookup(name) == nil {
+			t.Errorf("expected --%s flag", name)
+		}
+	}
+}
+
+func TestMRReview_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "no action", args: []string{"1"}, wantErr: "specify --approve"},
+		{name: "comment without body", args: []string{"1", "--comment"}, wantErr: "--body is required"},
+		{name: "request changes without body", args: []string{"1", "--request-changes"}, wantErr: "--body is required"},
+		{name: "file without line", args: []string{"1", "--file", "a.go", "--body", "x"}, wantErr: "--line or --old-line"},
+		{name: "line without file", args: []string{"1", "--approve", "--line", "3"}, wantErr: "--file is required"},
+		{name: "approve and request changes", args: []string{"1", "--approve", "--request-changes", "--body", "x"}, wantErr: "none of the others can be"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := cmdtest.NewTestFactory(t)
+			cmd := newMRReviewCmd(f.Factory)
+			cmd.SetArgs(tt.args)
+
+			err := cmd.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestMRReview_ApproveWithBody(t *testing.T) {
+	var note map[string]any
+	approved := false
+
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/merge_requests/5/notes"):
+			_ = json.NewDecoder(r.Body).Decode(&note)
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": 1, "body": note["body"]})
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/merge_requests/5/approve"):
+			approved = true
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": 1})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRReviewCmd(f.Factory)
+	cmd.SetArgs([]string{"5", "--approve", "--body", "LGTM"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if note["body"] != "LGTM" {
+		t.Errorf("expected review note, got %v", note)
+	}
+	if !approved {
+		t.Error("expected merge request to be approved")
+	}
+	if !strings.Contains(f.IO.String(), "Approved merge request !5") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestMRReview_RequestChangesWithoutPriorApproval(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/merge_requests/5/notes"):
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": 1})
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/merge_requests/5/unapprove"):
+			cmdtest.ErrorResponse(w, 404, "404 Not found")
+		default:
+			cmdtest.ErrorResponse(w, 500, "unexpected")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRReviewCmd(f.Factory)
+	cmd.SetArgs([]string{"5", "--request-changes", "--body", "Please add tests"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(f.IO.String(), "Requested changes on merge request !5") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestMRReview_InlineComment(t *testing.T) {
+	var discussion map[string]any
+
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/merge_requests/5"):
+			cmdtest.JSONResponse(w, 200, map[string]any{
+				"id": 105, "iid": 5,
+				"diff_refs": map[string]any{"base_sha": "base", "head_sha": "head", "start_sha": "start"},
+			})
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/merge_requests/5/discussions"):
+			_ = json.NewDecoder(r.Body).Decode(&discussion)
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": "d1", "notes": []any{map[string]any{"id": 1}}})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRReviewCmd(f.Factory)
+	cmd.SetArgs([]string{"5", "--file", "old.go", "--old-line", "7", "--body", "Why was this removed?"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	position, _ := discussion["position"].(map[string]any)
+	if position == nil {
+		t.Fatalf("expected position in request, got %v", discussion)
+	}
+	if position["old_line"] != float64(7) || position["new_line"] != nil {
+		t.Errorf("expected old_line 7 only, got %v", position)
+	}
+	if position["head_sha"] != "head" || position["new_path"] != "old.go" {
+		t.Errorf("unexpected position: %v", position)
+	}
+	if !strings.Contains(f.IO.String(), "Commented on old.go:7 (old) in !5") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
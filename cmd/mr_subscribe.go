@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func newMRSubscribeCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscribe [<id>]",
+		Short: "Subscribe to notifications for a merge request",
+		Example: `  $ glab mr subscribe 123
+  $ glab mr subscribe   # merge request for the current branch`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			mrID, err := resolveMRArg(client, project, args)
+			if err != nil {
+				return err
+			}
+
+			_, resp, err := client.MergeRequests.SubscribeToMergeRequest(project, mrID, gitlab.WithContext(cmd.Context()))
+			if resp != nil && resp.StatusCode == http.StatusNotModified {
+				_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Already subscribed to merge request !%d\n", mrID)
+				return nil
+			}
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/merge_requests/%d/subscribe", api.APIURL(client.Host()), project, mrID)
+				return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to subscribe to merge request !%d", mrID), err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Subscribed to merge request !%d\n", mrID)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newMRUnsubscribeCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unsubscribe [<id>]",
+		Short: "Unsubscribe from notifications for a merge request",
+		Example: `  $ glab mr unsubscribe 123
+  $ glab mr unsubscribe   # merge request for the current branch`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			mrID, err := resolveMRArg(client, project, args)
+			if err != nil {
+				return err
+			}
+
+			_, resp, err := client.MergeRequests.UnsubscribeFromMergeRequest(project, mrID, gitlab.WithContext(cmd.Context()))
+			if resp != nil && resp.StatusCode == http.StatusNotModified {
+				_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Not subscribed to merge request !%d\n", mrID)
+				return nil
+			}
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/merge_requests/%d/unsubscribe", api.APIURL(client.Host()), project, mrID)
+				return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to unsubscribe from merge request !%d", mrID), err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Unsubscribed from merge request !%d\n", mrID)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newMRTodoCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "todo [<id>]",
+		Short: "Add a merge request to your to-do list",
+		Example: `  $ glab mr todo 123
+  $ glab mr todo   # merge request for the current branch`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			mrID, err := resolveMRArg(client, project, args)
+			if err != nil {
+				return err
+			}
+
+			_, resp, err := client.MergeRequests.CreateTodo(project, mrID, gitlab.WithContext(cmd.Context()))
+			if resp != nil && resp.StatusCode == http.StatusNotModified {
+				_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Merge request !%d is already on your to-do list\n", mrID)
+				return nil
+			}
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/merge_requests/%d/todo", api.APIURL(client.Host()), project, mrID)
+				return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to add merge request !%d to your to-do list", mrID), err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Added merge request !%d to your to-do list\n", mrID)
+			return nil
+		},
+	}
+
+	return cmd
+}
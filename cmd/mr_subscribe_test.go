@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestMRSubscribe_AlreadySubscribed(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/projects/test-owner/test-repo/merge_requests/123/subscribe" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRSubscribeCmd(f.Factory)
+	cmd.SetArgs([]string{"!123"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(f.IO.ErrString(), "Already subscribed to merge request !123") {
+		t.Errorf("unexpected stderr: %q", f.IO.ErrString())
+	}
+}
+
+func TestMRUnsubscribe(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/merge_requests/123/unsubscribe" {
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": 1, "iid": 123, "subscribed": false})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRUnsubscribeCmd(f.Factory)
+	cmd.SetArgs([]string{"123"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(f.IO.String(), "Unsubscribed from merge request !123") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestMRTodo_APIError(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		cmdtest.ErrorResponse(w, 403, "forbidden")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRTodoCmd(f.Factory)
+	cmd.SetArgs([]string{"123"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "to-do list") {
+		t.Errorf("expected API error, got %v", err)
+	}
+}
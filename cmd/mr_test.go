@@ -2,9 +2,11 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
 	"net/http"
 	"strings"
 	"testing"
+	"testing/iotest"
 	"time"
 
 	"github.com/PhilipKram/gitlab-cli/internal/api"
@@ -65,18 +67,34 @@ func TestMRCmd_HasSubcommands(t *testing.T) {
 		"list",
 		"view",
 		"merge",
+		"rebase",
 		"close",
 		"reopen",
 		"approve",
+		"unapprove",
+		"approvers",
 		"checkout",
 		"diff",
+		"apply",
+		"cherry-pick",
+		"checks",
+		"pipelines",
 		"comment",
 		"edit",
+		"draft",
+		"ready",
 		"discussions",
+		"discussion",
 		"reply",
 		"suggest",
 		"resolve",
 		"unresolve",
+		"revert",
+		"review",
+		"subscribe",
+		"unsubscribe",
+		"todo",
+		"attach",
 	}
 
 	subcommands := cmd.Commands()
@@ -175,7 +193,7 @@ func TestMRViewCmd_Flags(t *testing.T) {
 	f := newTestFactory()
 	cmd := newMRViewCmd(f)
 
-	expectedFlags := []string{"web", "json"}
+	expectedFlags := []string{"web", "raw", "comments", "since", "json"}
 
 	for _, flagName := range expectedFlags {
 		flag := cmd.Flags().Lookup(flagName)
@@ -197,6 +215,8 @@ func TestMRMergeCmd_Flags(t *testing.T) {
 		"squash",
 		"remove-source-branch",
 		"message",
+		"auto",
+		"merge-train",
 		"when-pipeline-succeeds",
 	}
 
@@ -316,6 +336,7 @@ func TestMRDiscussionsCmd_Flags(t *testing.T) {
 	expectedFlags := []string{
 		"format",
 		"json",
+		"unresolved",
 	}
 
 	for _, flagName := range expectedFlags {
@@ -654,6 +675,85 @@ func TestMRCreate_Success(t *testing.T) {
 	}
 }
 
+func TestMRCreate_ConfigDefaults(t *testing.T) {
+	var body map[string]interface{}
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" && strings.Contains(r.URL.Path, "/merge_requests") {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 201, cmdtest.FixtureMROpen)
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	f.Config.Defaults.MRTargetBranch = "develop"
+	f.Config.Defaults.MRLabels = "backend,review"
+	f.Config.Defaults.MRSquash = "true"
+	cmd := newMRCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"--title", "Test MR", "--source-branch", "feature"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body["target_branch"] != "develop" {
+		t.Errorf("expected target_branch develop, got %v", body["target_branch"])
+	}
+	if body["labels"] != "backend,review" {
+		t.Errorf("expected labels backend,review, got %v", body["labels"])
+	}
+	if body["squash"] != true {
+		t.Errorf("expected squash from defaults.mr_squash, got %v", body["squash"])
+	}
+
+	// Flags take precedence over the defaults.
+	cmd = newMRCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"--title", "Test MR", "--source-branch", "feature", "--target-branch", "main", "--label", "urgent", "--squash=false"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body["target_branch"] != "main" || body["labels"] != "urgent" || body["squash"] != false {
+		t.Errorf("expected flags to override defaults, got target_branch %v, labels %v, and squash %v", body["target_branch"], body["labels"], body["squash"])
+	}
+}
+
+func TestMRCreate_Fork(t *testing.T) {
+	var createPath string
+	var body map[string]interface{}
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.EscapedPath() == "/api/v4/projects/upstream-owner%2Ftest-repo":
+			cmdtest.JSONResponse(w, 200, map[string]interface{}{"id": 42, "path_with_namespace": "upstream-owner/test-repo"})
+		case r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/merge_requests"):
+			createPath = r.URL.EscapedPath()
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 201, cmdtest.FixtureMROpen)
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	upstream := git.Remote{Name: "upstream", Host: "gitlab.com", Owner: "upstream-owner", Repo: "test-repo"}
+	f.Factory.Remotes = func() ([]git.Remote, error) {
+		return []git.Remote{*f.Remote, upstream}, nil
+	}
+
+	cmd := newMRCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"--title", "Test MR", "--source-branch", "feature", "--target-branch", "main"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if createPath != "/api/v4/projects/test-owner%2Ftest-repo/merge_requests" {
+		t.Errorf("expected the merge request to be created from the fork, got %s", createPath)
+	}
+	if body["target_project_id"] != float64(42) {
+		t.Errorf("expected target_project_id 42, got %v", body["target_project_id"])
+	}
+	if !strings.Contains(f.IO.ErrString(), "into upstream-owner/test-repo:main") {
+		t.Errorf("expected a note about the upstream target, got %q", f.IO.ErrString())
+	}
+}
+
 func TestMRMerge_Success(t *testing.T) {
 	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
 		if r.Method == "PUT" && strings.Contains(r.URL.Path, "/merge_requests/1/merge") {
@@ -1154,8 +1254,8 @@ func TestMRDiscussions_Success(t *testing.T) {
 	}
 
 	output := f.IO.String()
-	if !strings.Contains(output, "#1 (unresolved) test-user") {
-		t.Errorf("expected header with author and status, got: %s", output)
+	if !strings.Contains(output, "unresolved\ttest-user") {
+		t.Errorf("expected row with status and author, got: %s", output)
 	}
 	if !strings.Contains(output, "This is a discussion note") {
 		t.Errorf("expected note body in output, got: %s", output)
@@ -1211,14 +1311,41 @@ func TestMRDiscussions_InlineComment(t *testing.T) {
 	}
 
 	output := f.IO.String()
-	if !strings.Contains(output, "pkramer on src/devices/DevicesController.kt:47") {
-		t.Errorf("expected file:line context in output, got: %s", output)
+	if !strings.Contains(output, "pkramer\tsrc/devices/DevicesController.kt:47\t2\t") {
+		t.Errorf("expected file:line context and reply count in output, got: %s", output)
 	}
 	if !strings.Contains(output, "Is this correct?") {
 		t.Errorf("expected note body, got: %s", output)
 	}
-	if !strings.Contains(output, "reviewer: Yes, the return type changed") {
-		t.Errorf("expected threaded reply, got: %s", output)
+}
+
+func TestMRDiscussions_AllPages(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/merge_requests/1/discussions") {
+			cmdtest.ErrorResponse(w, 404, "not found")
+			return
+		}
+		note := func(body string) []interface{} {
+			return []interface{}{map[string]interface{}{"id": 1, "body": body, "author": map[string]interface{}{"username": "alice"}}}
+		}
+		if r.URL.Query().Get("page") == "2" {
+			cmdtest.JSONResponse(w, 200, []interface{}{map[string]interface{}{"id": "disc002", "notes": note("Second page")}})
+			return
+		}
+		w.Header().Set("X-Next-Page", "2")
+		cmdtest.JSONResponse(w, 200, []interface{}{map[string]interface{}{"id": "disc001", "notes": note("First page")}})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRDiscussionsCmd(f.Factory)
+	cmd.SetArgs([]string{"1"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output := f.IO.String()
+	if !strings.Contains(output, "First page") || !strings.Contains(output, "Second page") {
+		t.Errorf("expected threads from both pages, got: %s", output)
 	}
 }
 
@@ -1457,3 +1584,197 @@ func TestResolveUserIDs_WithAtPrefix(t *testing.T) {
 		t.Errorf("expected [456], got %v", ids)
 	}
 }
+
+func TestMRCreate_NoTitleNonInteractive(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"--source-branch", "feature", "--target-branch", "main"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "--title is required") {
+		t.Fatalf("expected --title required error, got %v", err)
+	}
+}
+
+func mrCreateSurveyServer(t *testing.T) {
+	t.Helper()
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/repository/branches"):
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+				{"name": "main"}, {"name": "feature"}, {"name": "release-1.0"},
+			})
+		case strings.HasSuffix(r.URL.Path, "/labels"):
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+				{"id": 1, "name": "bug"}, {"id": 2, "name": "docs"},
+			})
+		case strings.HasSuffix(r.URL.Path, "/members/all"):
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+				{"id": 10, "username": "alice"}, {"id": 11, "username": "bob"},
+			})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+}
+
+func TestRunMRCreateSurvey(t *testing.T) {
+	mrCreateSurveyServer(t)
+
+	f := cmdtest.NewTestFactory(t)
+	// Title, target branch #2 (release-1.0), labels 1,2, reviewer 2, draft, submit
+	f.IOStreams.In = iotest.OneByteReader(strings.NewReader("Add feature\n2\n1,2\n2\ny\ny\n"))
+
+	client, _ := f.Factory.Client()
+	s := &mrCreateSurvey{
+		Description:     "From flag",
+		SourceBranch:    "feature",
+		TargetBranch:    "main",
+		SkipDescription: true,
+	}
+	submit, err := runMRCreateSurvey(f.Factory, client, "test-owner/test-repo", s)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !submit {
+		t.Fatal("expected submit")
+	}
+	if s.Title != "Add feature" {
+		t.Errorf("expected title %q, got %q", "Add feature", s.Title)
+	}
+	if s.Description != "From flag" {
+		t.Errorf("expected description to be kept, got %q", s.Description)
+	}
+	if s.TargetBranch != "release-1.0" {
+		t.Errorf("expected target release-1.0, got %q", s.TargetBranch)
+	}
+	if strings.Join(s.Labels, ",") != "bug,docs" {
+		t.Errorf("expected labels bug,docs, got %v", s.Labels)
+	}
+	if strings.Join(s.Reviewers, ",") != "bob" {
+		t.Errorf("expected reviewer bob, got %v", s.Reviewers)
+	}
+	if !s.Draft {
+		t.Error("expected draft")
+	}
+
+	prompts := f.IO.ErrString()
+	if strings.Contains(prompts, "[2] feature") {
+		t.Errorf("source branch should not be offered as target, got:\n%s", prompts)
+	}
+	if strings.Contains(prompts, "editor") {
+		t.Errorf("editor should not be offered when --description is set, got:\n%s", prompts)
+	}
+}
+
+func TestRunMRCreateSurvey_SkipsFlaggedFields(t *testing.T) {
+	mrCreateSurveyServer(t)
+
+	f := cmdtest.NewTestFactory(t)
+	// Title, then decline submission
+	f.IOStreams.In = iotest.OneByteReader(strings.NewReader("Fix bug\nn\n"))
+
+	client, _ := f.Factory.Client()
+	s := &mrCreateSurvey{
+		SourceBranch:     "feature",
+		TargetBranch:     "main",
+		Labels:           []string{"bug"},
+		Reviewers:        []string{"alice"},
+		SkipDescription:  true,
+		SkipTargetBranch: true,
+		SkipDraft:        true,
+	}
+	submit, err := runMRCreateSurvey(f.Factory, client, "test-owner/test-repo", s)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if submit {
+		t.Error("expected submission to be declined")
+	}
+	if s.TargetBranch != "main" || len(s.Labels) != 1 || len(s.Reviewers) != 1 {
+		t.Errorf("flagged fields should be untouched, got %+v", s)
+	}
+}
+
+func TestRunMRCreateSurvey_EmptyTitle(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	f.IOStreams.In = strings.NewReader("\n")
+
+	client, _ := f.Factory.Client()
+	_, err := runMRCreateSurvey(f.Factory, client, "test-owner/test-repo", &mrCreateSurvey{SourceBranch: "feature"})
+	if err == nil || !strings.Contains(err.Error(), "title cannot be empty") {
+		t.Fatalf("expected empty title error, got %v", err)
+	}
+}
+
+func TestFindMRForBranch(t *testing.T) {
+	tests := []struct {
+		name    string
+		mrs     []map[string]interface{}
+		want    int64
+		wantErr string
+	}{
+		{
+			name: "single match",
+			mrs:  []map[string]interface{}{{"id": 100, "iid": 7, "target_branch": "main"}},
+			want: 7,
+		},
+		{
+			name:    "no match",
+			mrs:     []map[string]interface{}{},
+			wantErr: `no open merge request found for branch "feature"`,
+		},
+		{
+			name: "multiple matches",
+			mrs: []map[string]interface{}{
+				{"id": 100, "iid": 7, "target_branch": "main"},
+				{"id": 101, "iid": 8, "target_branch": "release-1.0"},
+			},
+			wantErr: "!7 (into main), !8 (into release-1.0)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+				if strings.HasSuffix(r.URL.Path, "/merge_requests") {
+					if got := r.URL.Query().Get("source_branch"); got != "feature" {
+						t.Errorf("expected source_branch=feature, got %q", got)
+					}
+					if got := r.URL.Query().Get("state"); got != "opened" {
+						t.Errorf("expected state=opened, got %q", got)
+					}
+					cmdtest.JSONResponse(w, 200, tt.mrs)
+					return
+				}
+				cmdtest.ErrorResponse(w, 404, "not found")
+			})
+
+			f := cmdtest.NewTestFactory(t)
+			client, _ := f.Factory.Client()
+			got, err := findMRForBranch(client, "test-owner/test-repo", "feature")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected !%d, got !%d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestResolveMRArg_ExplicitID(t *testing.T) {
+	got, err := resolveMRArg(nil, "test-owner/test-repo", []string{"!42"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != 42 {
+		t.Errorf("expected 42, got %d", got)
+	}
+}
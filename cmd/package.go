@@ -1,7 +1,10 @@
 package cmd
 
 import (
+	"bytes"
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/PhilipKram/gitlab-cli/internal/api"
 	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
@@ -22,6 +25,7 @@ func NewPackageCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(newPackageViewCmd(f))
 	cmd.AddCommand(newPackageDeleteCmd(f))
 	cmd.AddCommand(newPackageDownloadCmd(f))
+	cmd.AddCommand(newPackageUploadCmd(f))
 
 	return cmd
 }
@@ -116,7 +120,7 @@ func newPackageListCmd(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
 	cmd.Flags().StringVarP(&packageType, "type", "t", "", "Filter by package type: npm, maven, pypi, nuget, conan, composer, helm, generic")
 	cmd.Flags().StringVarP(&groupPath, "group", "g", "", "List packages for a specific group")
@@ -250,7 +254,7 @@ func newPackageViewCmd(f *cmdutil.Factory) *cobra.Command {
 		},
 	}
 
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
 	cmd.Flags().StringVarP(&groupPath, "group", "g", "", "View package in a specific group")
 
@@ -408,16 +412,23 @@ func newPackageDownloadCmd(f *cmdutil.Factory) *cobra.Command {
 	var (
 		version   string
 		output    string
+		fileName  string
 		groupPath string
 	)
 
 	cmd := &cobra.Command{
 		Use:   "download <package-name>",
 		Short: "Download a package",
-		Long:  "List downloadable package files or download a specific package version. Works with both project and group package registries.",
+		Long: `List downloadable package files or download a specific package version.
+Works with both project and group package registries.
+
+Files of generic packages are downloaded when --output or --file is given.
+Other package types are listed only; use the client for your package type to
+install them.`,
 		Example: `  $ glab package download my-package
   $ glab package download my-package --version 1.0.0
   $ glab package download my-package --version 1.0.0 --output ./downloads
+  $ glab package download my-package --version 1.0.0 --file app.tar.gz
   $ glab package download my-package --group mygroup`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -492,9 +503,10 @@ func newPackageDownloadCmd(f *cmdutil.Factory) *cobra.Command {
 						_, _ = fmt.Fprintln(out, "No package files found")
 					}
 
-					if output != "" {
-						_, _ = fmt.Fprintf(out, "\nNote: Automatic download to %s is not yet implemented.\n", output)
-						_, _ = fmt.Fprintln(out, "Please use the GitLab package registry API or CLI tools for your package type.")
+					if output != "" || fileName != "" {
+						if err := downloadPackageFiles(f, client, projectID, pkg.Name, pkg.Version, pkg.PackageType, packageFiles, output, fileName); err != nil {
+							return err
+						}
 					}
 
 					if len(matchingPackages) > 1 {
@@ -569,9 +581,10 @@ func newPackageDownloadCmd(f *cmdutil.Factory) *cobra.Command {
 						_, _ = fmt.Fprintln(out, "No package files found")
 					}
 
-					if output != "" {
-						_, _ = fmt.Fprintf(out, "\nNote: Automatic download to %s is not yet implemented.\n", output)
-						_, _ = fmt.Fprintln(out, "Please use the GitLab package registry API or CLI tools for your package type.")
+					if output != "" || fileName != "" {
+						if err := downloadPackageFiles(f, client, project, pkg.Name, pkg.Version, pkg.PackageType, packageFiles, output, fileName); err != nil {
+							return err
+						}
 					}
 
 					if len(matchingPackages) > 1 {
@@ -586,21 +599,139 @@ func newPackageDownloadCmd(f *cmdutil.Factory) *cobra.Command {
 
 	cmd.Flags().StringVar(&version, "version", "", "Download a specific package version")
 	cmd.Flags().StringVarP(&output, "output", "o", "", "Output directory for downloaded files")
+	cmd.Flags().StringVar(&fileName, "file", "", "Download only this file of a generic package")
 	cmd.Flags().StringVarP(&groupPath, "group", "g", "", "Download package from a specific group")
 
 	return cmd
 }
 
-// byteCountSI converts bytes to human-readable format using SI units.
-func byteCountSI(b int64) string {
-	const unit = 1000
-	if b < unit {
-		return fmt.Sprintf("%d B", b)
+// newPackageUploadCmd creates the package upload command.
+func newPackageUploadCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		name     string
+		version  string
+		fileName string
+		hidden   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "upload <file>...",
+		Short: "Upload files to a generic package",
+		Long: `Upload one or more files to a generic package in the project's package
+registry. The package is created if it does not exist yet.`,
+		Example: `  $ glab package upload dist/app.tar.gz --name my-app --version 1.2.0
+  $ glab package upload build/* --name my-app --version 1.2.0-rc1 --hidden
+  $ glab package upload out.bin --name tools --version 0.1.0 --file-name tool-linux-amd64`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if fileName != "" && len(args) > 1 {
+				return fmt.Errorf("--file-name can only be used when uploading a single file")
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			opts := &gitlab.PublishPackageFileOptions{}
+			if hidden {
+				opts.Status = gitlab.Ptr(gitlab.PackageHidden)
+			}
+
+			out := f.IOStreams.Out
+			for _, path := range args {
+				target := fileName
+				if target == "" {
+					target = filepath.Base(path)
+				}
+
+				file, err := os.Open(path)
+				if err != nil {
+					return err
+				}
+				pkgFile, resp, err := client.GenericPackages.PublishPackageFile(project, name, version, target, file, opts, gitlab.WithContext(cmd.Context()))
+				_ = file.Close()
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/packages/generic/%s/%s/%s", api.APIURL(client.Host()), project, name, version, target)
+					return errors.NewAPIError("PUT", url, statusCode, fmt.Sprintf("Failed to upload %s", path), err)
+				}
+
+				size := ""
+				if pkgFile != nil && pkgFile.Size > 0 {
+					size = " (" + byteCountSI(pkgFile.Size) + ")"
+				}
+				_, _ = fmt.Fprintf(out, "Uploaded %s to %s %s%s\n", target, name, version, size)
+			}
+			return nil
+		},
 	}
-	div, exp := int64(unit), 0
-	for n := b / unit; n >= unit; n /= unit {
-		div *= unit
-		exp++
+
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Package name (required)")
+	cmd.Flags().StringVar(&version, "version", "", "Package version (required)")
+	cmd.Flags().StringVar(&fileName, "file-name", "", "Name of the file in the package (default: the local file's base name)")
+	cmd.Flags().BoolVar(&hidden, "hidden", false, "Hide the package from the package registry UI")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("version")
+
+	return cmd
+}
+
+// downloadPackageFiles saves the files of a generic package into dir. When
+// only is set, just that file is downloaded.
+func downloadPackageFiles(f *cmdutil.Factory, client *api.Client, project, name, version, packageType string, files []*gitlab.PackageFile, dir, only string) error {
+	out := f.IOStreams.Out
+	if packageType != "generic" {
+		_, _ = fmt.Fprintf(out, "\nNote: only generic packages can be downloaded; use your %s client to install %s.\n", packageType, name)
+		return nil
+	}
+
+	if dir == "" {
+		dir = "."
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	found := false
+	for _, file := range files {
+		if only != "" && file.FileName != only {
+			continue
+		}
+		found = true
+
+		data, resp, err := client.GenericPackages.DownloadPackageFile(project, name, version, file.FileName)
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			url := fmt.Sprintf("%s/projects/%s/packages/generic/%s/%s/%s", api.APIURL(client.Host()), project, name, version, file.FileName)
+			return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to download %s", file.FileName), err)
+		}
+
+		dest := filepath.Join(dir, filepath.Base(file.FileName))
+		if _, err := cmdutil.WriteFileAtomic(dest, bytes.NewReader(data), 0o644); err != nil {
+			return fmt.Errorf("writing %s: %w", dest, err)
+		}
+		_, _ = fmt.Fprintf(out, "Downloaded %s to %s\n", file.FileName, dest)
 	}
-	return fmt.Sprintf("%.1f %cB", float64(b)/float64(div), "kMGTPE"[exp])
+
+	if only != "" && !found {
+		return fmt.Errorf("file %q not found in package %s %s", only, name, version)
+	}
+	return nil
+}
+
+// byteCountSI converts bytes to human-readable format using SI units.
+func byteCountSI(b int64) string {
+	return cmdutil.FormatBytes(b)
 }
@@ -1,7 +1,11 @@
 package cmd
 
 import (
+	"io"
 	"net/http"
+	"os"
+	"path"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -30,6 +34,7 @@ func TestPackageCmd_HasSubcommands(t *testing.T) {
 		"view",
 		"delete",
 		"download",
+		"upload",
 	}
 
 	subcommands := cmd.Commands()
@@ -466,3 +471,113 @@ func TestPackageDownload_GroupLevel(t *testing.T) {
 		t.Errorf("expected output to contain package name, got: %s", output)
 	}
 }
+
+func TestPackageDownload_GenericFiles(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v4/projects/test-owner/test-repo/packages":
+			cmdtest.JSONResponse(w, 200, []map[string]any{
+				{"id": 1, "name": "my-app", "version": "1.2.0", "package_type": "generic"},
+			})
+		case "/api/v4/projects/test-owner/test-repo/packages/1/package_files":
+			cmdtest.JSONResponse(w, 200, []map[string]any{
+				{"id": 10, "file_name": "app.tar.gz", "size": 7},
+				{"id": 11, "file_name": "checksums.txt", "size": 4},
+			})
+		case "/api/v4/projects/test-owner/test-repo/packages/generic/my-app/1.2.0/app.tar.gz":
+			_, _ = w.Write([]byte("tarball"))
+		case "/api/v4/projects/test-owner/test-repo/packages/generic/my-app/1.2.0/checksums.txt":
+			_, _ = w.Write([]byte("sums"))
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	t.Run("all files", func(t *testing.T) {
+		dir := t.TempDir()
+		f := cmdtest.NewTestFactory(t)
+		cmd := newPackageDownloadCmd(f.Factory)
+		cmd.SetArgs([]string{"my-app", "--version", "1.2.0", "--output", dir})
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for name, want := range map[string]string{"app.tar.gz": "tarball", "checksums.txt": "sums"} {
+			data, err := os.ReadFile(filepath.Join(dir, name))
+			if err != nil || string(data) != want {
+				t.Errorf("expected %s to contain %q, got %q (err %v)", name, want, data, err)
+			}
+		}
+	})
+
+	t.Run("single file", func(t *testing.T) {
+		dir := t.TempDir()
+		f := cmdtest.NewTestFactory(t)
+		cmd := newPackageDownloadCmd(f.Factory)
+		cmd.SetArgs([]string{"my-app", "--version", "1.2.0", "--file", "checksums.txt", "--output", dir})
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		entries, _ := os.ReadDir(dir)
+		if len(entries) != 1 || entries[0].Name() != "checksums.txt" {
+			t.Errorf("expected only checksums.txt, got %v", entries)
+		}
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		f := cmdtest.NewTestFactory(t)
+		cmd := newPackageDownloadCmd(f.Factory)
+		cmd.SetArgs([]string{"my-app", "--version", "1.2.0", "--file", "nope.zip", "--output", t.TempDir()})
+		err := cmd.Execute()
+		if err == nil || !strings.Contains(err.Error(), `file "nope.zip" not found`) {
+			t.Errorf("expected missing file error, got %v", err)
+		}
+	})
+}
+
+func TestPackageUpload(t *testing.T) {
+	uploads := map[string]string{}
+	var status string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || !strings.HasPrefix(r.URL.Path, "/api/v4/projects/test-owner/test-repo/packages/generic/my-app/1.2.0/") {
+			cmdtest.ErrorResponse(w, 404, "not found")
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		uploads[path.Base(r.URL.Path)] = string(body)
+		status = r.URL.Query().Get("status")
+		cmdtest.JSONResponse(w, 201, map[string]any{"size": len(body)})
+	})
+
+	dir := t.TempDir()
+	a := filepath.Join(dir, "a.txt")
+	b := filepath.Join(dir, "b.txt")
+	_ = os.WriteFile(a, []byte("alpha"), 0o644)
+	_ = os.WriteFile(b, []byte("beta"), 0o644)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newPackageUploadCmd(f.Factory)
+	cmd.SetArgs([]string{a, b, "--name", "my-app", "--version", "1.2.0", "--hidden"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if uploads["a.txt"] != "alpha" || uploads["b.txt"] != "beta" {
+		t.Errorf("unexpected uploads: %v", uploads)
+	}
+	if status != "hidden" {
+		t.Errorf("expected status=hidden, got %q", status)
+	}
+	if !strings.Contains(f.IO.String(), "Uploaded a.txt to my-app 1.2.0 (5 B)") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestPackageUpload_FileNameWithMultipleFiles(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newPackageUploadCmd(f.Factory)
+	cmd.SetArgs([]string{"a", "b", "--name", "x", "--version", "1", "--file-name", "c"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "single file") {
+		t.Errorf("expected --file-name error, got %v", err)
+	}
+}
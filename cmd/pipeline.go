@@ -1,13 +1,9 @@
 package cmd
 
 import (
-	"archive/zip"
-	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"os"
-	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -16,7 +12,6 @@ import (
 	"github.com/PhilipKram/gitlab-cli/internal/browser"
 	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
 	"github.com/PhilipKram/gitlab-cli/internal/errors"
-	"github.com/PhilipKram/gitlab-cli/internal/tableprinter"
 	"github.com/spf13/cobra"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 )
@@ -32,7 +27,9 @@ func NewPipelineCmd(f *cmdutil.Factory) *cobra.Command {
 
 	cmd.AddCommand(newPipelineListCmd(f))
 	cmd.AddCommand(newPipelineViewCmd(f))
+	cmd.AddCommand(newPipelineMRCmd(f))
 	cmd.AddCommand(newPipelineRunCmd(f))
+	cmd.AddCommand(newPipelineTriggerCmd(f))
 	cmd.AddCommand(newPipelineCancelCmd(f))
 	cmd.AddCommand(newPipelineRetryCmd(f))
 	cmd.AddCommand(newPipelineDeleteCmd(f))
@@ -40,28 +37,34 @@ func NewPipelineCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(newPipelineJobLogCmd(f))
 	cmd.AddCommand(newPipelineRetryJobCmd(f))
 	cmd.AddCommand(newPipelineCancelJobCmd(f))
+	cmd.AddCommand(newPipelinePlayJobCmd(f))
 	cmd.AddCommand(newPipelineArtifactsCmd(f))
+	cmd.AddCommand(newPipelineFailedJobsCmd(f))
 	cmd.AddCommand(newPipelineStatsCmd(f))
 	cmd.AddCommand(newPipelineSlowestJobsCmd(f))
 	cmd.AddCommand(newPipelineTrendsCmd(f))
 	cmd.AddCommand(newPipelineFlakyCmd(f))
 	cmd.AddCommand(newPipelineWatchCmd(f))
 	cmd.AddCommand(newCILintCmd(f))
+	cmd.AddCommand(newCIInitCmd(f))
+	cmd.AddCommand(newCIExecCmd(f))
+	cmd.AddCommand(newPipelineSecretsCmd(f))
 
 	return cmd
 }
 
 func newPipelineListCmd(f *cmdutil.Factory) *cobra.Command {
 	var (
-		status   string
-		ref      string
-		limit    int
-		format   string
-		jsonFlag bool
-		web      bool
-		stream   bool
-		orderBy  string
-		sort     string
+		status      string
+		ref         string
+		limit       int
+		format      string
+		jsonFlag    bool
+		web         bool
+		stream      bool
+		orderBy     string
+		sort        string
+		interactive bool
 	)
 
 	cmd := &cobra.Command{
@@ -71,8 +74,15 @@ func newPipelineListCmd(f *cmdutil.Factory) *cobra.Command {
 		Example: `  $ glab pipeline list
   $ glab pipeline list --status success --ref main
   $ glab pipeline list --limit 50
-  $ glab pipeline list --order-by id --sort desc`,
+  $ glab pipeline list --order-by id --sort desc
+  $ glab pipeline list --interactive`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if interactive {
+				if err := checkInteractive(cmd); err != nil {
+					return err
+				}
+			}
+
 			client, err := f.Client()
 			if err != nil {
 				return err
@@ -89,7 +99,7 @@ func newPipelineListCmd(f *cmdutil.Factory) *cobra.Command {
 				if remote != nil {
 					host = remote.Host
 				}
-				return browser.Open(fmt.Sprintf("https://%s/%s/-/pipelines", host, project))
+				return browser.Open(api.WebURL(host, project+"/-/pipelines"))
 			}
 
 			opts := &gitlab.ListProjectPipelinesOptions{
@@ -118,7 +128,7 @@ func newPipelineListCmd(f *cmdutil.Factory) *cobra.Command {
 			// Use streaming mode if --stream flag is set
 			if stream {
 				// Create context for pagination
-				ctx := context.Background()
+				ctx := cmd.Context()
 
 				// Create fetch function for pagination
 				fetchFunc := func(page int) ([]*gitlab.PipelineInfo, *gitlab.Response, error) {
@@ -127,7 +137,7 @@ func newPipelineListCmd(f *cmdutil.Factory) *cobra.Command {
 					if pageOpts.PerPage == 0 {
 						pageOpts.PerPage = 100
 					}
-					return client.Pipelines.ListProjectPipelines(project, &pageOpts)
+					return client.Pipelines.ListProjectPipelines(project, &pageOpts, gitlab.WithContext(ctx))
 				}
 
 				// Configure pagination options
@@ -162,6 +172,10 @@ func newPipelineListCmd(f *cmdutil.Factory) *cobra.Command {
 				return nil
 			}
 
+			if interactive {
+				return pipelineInteractiveList(f, project, pipelines).Run(f.IOStreams.In, f.IOStreams.Out)
+			}
+
 			return f.FormatAndPrint(pipelines, format, jsonFlag)
 		},
 	}
@@ -169,12 +183,14 @@ func newPipelineListCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.Flags().StringVar(&status, "status", "", "Filter by status: running, pending, success, failed, canceled, skipped")
 	cmd.Flags().StringVar(&ref, "ref", "", "Filter by branch or tag")
 	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
 	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open in browser")
 	cmd.Flags().BoolVar(&stream, "stream", false, "Enable streaming mode")
 	cmd.Flags().StringVar(&orderBy, "order-by", "", "Order by: id, status, ref, updated_at, user_id")
 	cmd.Flags().StringVar(&sort, "sort", "", "Sort order: asc or desc")
+	cmd.Flags().BoolVarP(&interactive, "interactive", "i", false, "Browse the results interactively: filter, view, open, or retry")
 
 	return cmd
 }
@@ -220,14 +236,15 @@ func newPipelineViewCmd(f *cmdutil.Factory) *cobra.Command {
 			}
 
 			// If non-default format requested, use formatter
-			if jsonFlag || (format != "" && format != "table") {
+			if jsonFlag || f.ExportRequested() || (format != "" && format != "table") {
 				return f.FormatAndPrint(pipeline, format, jsonFlag)
 			}
 
 			// Default custom display
 			out := f.IOStreams.Out
-			_, _ = fmt.Fprintf(out, "Pipeline #%d\n", pipeline.ID)
-			_, _ = fmt.Fprintf(out, "Status:   %s\n", pipeline.Status)
+			cs := f.IOStreams.ColorScheme()
+			_, _ = fmt.Fprintf(out, "%s\n", cs.Bold(fmt.Sprintf("Pipeline #%d", pipeline.ID)))
+			_, _ = fmt.Fprintf(out, "Status:   %s\n", cs.State(pipeline.Status))
 			_, _ = fmt.Fprintf(out, "Ref:      %s\n", pipeline.Ref)
 			_, _ = fmt.Fprintf(out, "SHA:      %s\n", pipeline.SHA)
 			_, _ = fmt.Fprintf(out, "Source:   %s\n", pipeline.Source)
@@ -248,13 +265,13 @@ func newPipelineViewCmd(f *cmdutil.Factory) *cobra.Command {
 			jobs, _, err := client.Jobs.ListPipelineJobs(project, pipelineID, nil)
 			if err == nil && len(jobs) > 0 {
 				_, _ = fmt.Fprintln(out, "\nJobs:")
-				tp := tableprinter.New(out)
+				tp := f.NewTablePrinter()
 				for _, j := range jobs {
 					tp.AddRow(
 						fmt.Sprintf("  %d", j.ID),
 						j.Name,
 						j.Stage,
-						j.Status,
+						cs.ForState(j.Status, j.Status),
 						fmt.Sprintf("%ds", int(j.Duration)),
 					)
 				}
@@ -266,8 +283,9 @@ func newPipelineViewCmd(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open in browser")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
 
 	return cmd
 }
@@ -278,16 +296,19 @@ func newPipelineRunCmd(f *cmdutil.Factory) *cobra.Command {
 		branch        string
 		variables     []string
 		cancelRunning bool
+		wait          bool
+		watchOpts     pipelineWatchOptions
 	)
 
 	cmd := &cobra.Command{
 		Use:     "run",
 		Short:   "Run a new pipeline",
-		Aliases: []string{"create", "trigger"},
+		Aliases: []string{"create"},
 		Example: `  $ glab pipeline run --branch main
   $ glab pipeline run --ref develop --variables KEY1=value1,KEY2=value2
   $ glab pipeline run --ref feature/my-branch --variables "HOTFIX_IMAGES=a,b,c"
-  $ glab pipeline run --ref main --cancel-running`,
+  $ glab pipeline run --ref main --cancel-running
+  $ glab pipeline run --ref main --wait`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// --branch is an alias for --ref
 			if branch != "" && ref == "" {
@@ -307,14 +328,9 @@ func newPipelineRunCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			// Parse variables
-			varsMap := make(map[string]string)
-			for _, v := range variables {
-				parts := strings.SplitN(v, "=", 2)
-				if len(parts) != 2 {
-					return fmt.Errorf("invalid variable format: %s (use KEY=value)", v)
-				}
-				varsMap[parts[0]] = parts[1]
+			varsMap, err := parsePipelineVariables(variables)
+			if err != nil {
+				return err
 			}
 
 			out := f.IOStreams.Out
@@ -349,6 +365,11 @@ func newPipelineRunCmd(f *cmdutil.Factory) *cobra.Command {
 			_, _ = fmt.Fprintf(out, "Created pipeline #%d\n", pipeline.ID)
 			_, _ = fmt.Fprintf(out, "Status: %s\n", pipeline.Status)
 			_, _ = fmt.Fprintf(out, "%s\n", pipeline.WebURL)
+
+			if wait {
+				_, _ = fmt.Fprintln(out)
+				return watchPipeline(cmd.Context(), f, client, project, pipeline.ID, watchOpts)
+			}
 			return nil
 		},
 	}
@@ -358,10 +379,26 @@ func newPipelineRunCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.Flags().Lookup("branch").Hidden = true
 	cmd.Flags().StringArrayVar(&variables, "variables", nil, "Pipeline variables (KEY=value)")
 	cmd.Flags().BoolVar(&cancelRunning, "cancel-running", false, "Cancel running/pending pipelines on the same ref before triggering")
+	cmd.Flags().BoolVarP(&wait, "wait", "w", false, "Watch the pipeline until it finishes and exit non-zero if it fails")
+	cmd.Flags().DurationVarP(&watchOpts.Interval, "interval", "i", 5*time.Second, "Polling interval when using --wait")
+	cmd.Flags().BoolVar(&watchOpts.FailFast, "fail-fast", false, "Exit as soon as any job fails when using --wait")
 
 	return cmd
 }
 
+// parsePipelineVariables parses KEY=value pairs into a variables map.
+func parsePipelineVariables(variables []string) (map[string]string, error) {
+	varsMap := make(map[string]string)
+	for _, v := range variables {
+		parts := strings.SplitN(v, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid variable format: %s (use KEY=value)", v)
+		}
+		varsMap[parts[0]] = parts[1]
+	}
+	return varsMap, nil
+}
+
 // getOrCreateTriggerToken returns an existing pipeline trigger token for the project,
 // or creates one if none exist.
 func getOrCreateTriggerToken(client *api.Client, project string) (string, error) {
@@ -592,13 +629,15 @@ func newPipelineJobsCmd(f *cmdutil.Factory) *cobra.Command {
 				return nil
 			}
 
-			tp := tableprinter.New(f.IOStreams.Out)
+			cs := f.IOStreams.ColorScheme()
+			tp := f.NewTablePrinter()
+			tp.SetHeader("ID", "NAME", "STAGE", "STATUS", "DURATION")
 			for _, j := range jobs {
 				tp.AddRow(
 					fmt.Sprintf("%d", j.ID),
 					j.Name,
 					j.Stage,
-					j.Status,
+					cs.ForState(j.Status, j.Status),
 					fmt.Sprintf("%.0fs", j.Duration),
 				)
 			}
@@ -633,41 +672,16 @@ func newPipelineJobLogCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			if len(args) == 0 {
-				return fmt.Errorf("job ID required")
-			}
-
-			jobID, err := strconv.ParseInt(args[0], 10, 64)
+			jobID, err := parseJobArg(args)
 			if err != nil {
-				return fmt.Errorf("invalid job ID: %s", args[0])
+				return err
 			}
 
 			if follow {
-				return followJobLog(f, client, project, int(jobID))
-			}
-
-			reader, resp, err := client.Jobs.GetTraceFile(project, jobID)
-			if err != nil {
-				statusCode := 0
-				if resp != nil {
-					statusCode = resp.StatusCode
-				}
-				url := api.APIURL(client.Host()) + "/projects/" + project + "/jobs/" + strconv.FormatInt(jobID, 10) + "/trace"
-				return errors.NewAPIError("GET", url, statusCode, "Failed to get job trace", err)
-			}
-
-			buf := make([]byte, 4096)
-			for {
-				n, readErr := reader.Read(buf)
-				if n > 0 {
-					_, _ = fmt.Fprint(f.IOStreams.Out, string(buf[:n]))
-				}
-				if readErr != nil {
-					break
-				}
+				return followJobLog(cmd.Context(), f, client, project, int(jobID))
 			}
 
-			return nil
+			return printJobTrace(cmd, f, client, project, jobID)
 		},
 	}
 
@@ -676,55 +690,20 @@ func newPipelineJobLogCmd(f *cmdutil.Factory) *cobra.Command {
 	return cmd
 }
 
-func followJobLog(f *cmdutil.Factory, client *api.Client, project string, jobID int) error {
-	var lastBytePos int64
-	jobIDInt64 := int64(jobID)
-
-	for {
-		// Get job status to check if still running
-		job, _, err := client.Jobs.GetJob(project, jobIDInt64)
-		if err != nil {
-			return fmt.Errorf("getting job status: %w", err)
-		}
-
-		// Fetch trace from last position
-		reader, _, err := client.Jobs.GetTraceFile(project, jobIDInt64)
-		if err != nil {
-			return fmt.Errorf("getting job trace: %w", err)
-		}
-
-		// Skip to last position
-		if lastBytePos > 0 {
-			buf := make([]byte, lastBytePos)
-			_, _ = reader.Read(buf)
-		}
-
-		// Read and print new content
-		buf := make([]byte, 4096)
-		for {
-			n, readErr := reader.Read(buf)
-			if n > 0 {
-				_, _ = fmt.Fprint(f.IOStreams.Out, string(buf[:n]))
-				lastBytePos += int64(n)
-			}
-			if readErr != nil {
-				break
-			}
-		}
-
-		// Check if job is finished
-		jobFinished := job.Status == "success" || job.Status == "failed" ||
-			job.Status == "canceled" || job.Status == "skipped"
-
-		if jobFinished {
-			break
+// printJobTrace writes the full trace of a job to stdout.
+func printJobTrace(cmd *cobra.Command, f *cmdutil.Factory, client *api.Client, project string, jobID int64) error {
+	reader, resp, err := client.Jobs.GetTraceFile(project, jobID, gitlab.WithContext(cmd.Context()))
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
 		}
-
-		// Wait before next poll
-		time.Sleep(2 * time.Second)
+		url := api.APIURL(client.Host()) + "/projects/" + project + "/jobs/" + strconv.FormatInt(jobID, 10) + "/trace"
+		return errors.NewAPIError("GET", url, statusCode, "Failed to get job trace", err)
 	}
 
-	return nil
+	_, err = io.Copy(f.IOStreams.Out, reader)
+	return err
 }
 
 func newPipelineRetryJobCmd(f *cmdutil.Factory) *cobra.Command {
@@ -745,13 +724,9 @@ func newPipelineRetryJobCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			if len(args) == 0 {
-				return fmt.Errorf("job ID required")
-			}
-
-			jobID, err := strconv.ParseInt(args[0], 10, 64)
+			jobID, err := parseJobArg(args)
 			if err != nil {
-				return fmt.Errorf("invalid job ID: %s", args[0])
+				return err
 			}
 
 			job, _, err := client.Jobs.RetryJob(project, jobID)
@@ -796,13 +771,9 @@ func newPipelineCancelJobCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			if len(args) == 0 {
-				return fmt.Errorf("job ID required")
-			}
-
-			jobID, err := strconv.ParseInt(args[0], 10, 64)
+			jobID, err := parseJobArg(args)
 			if err != nil {
-				return fmt.Errorf("invalid job ID: %s", args[0])
+				return err
 			}
 
 			job, _, err := client.Jobs.CancelJob(project, jobID)
@@ -829,135 +800,6 @@ func newPipelineCancelJobCmd(f *cmdutil.Factory) *cobra.Command {
 	return cmd
 }
 
-func newPipelineArtifactsCmd(f *cmdutil.Factory) *cobra.Command {
-	var outputPath string
-	var filePath string
-
-	cmd := &cobra.Command{
-		Use:   "artifacts [<job-id>]",
-		Short: "Download job artifacts as a zip file",
-		Example: `  $ glab pipeline artifacts 67890
-  $ glab pipeline artifacts 67890 --output my-artifacts.zip
-  $ glab pipeline artifacts 67890 --path path/to/file.txt`,
-		RunE: func(cmd *cobra.Command, args []string) error {
-			client, err := f.Client()
-			if err != nil {
-				return err
-			}
-
-			project, err := f.FullProjectPath()
-			if err != nil {
-				return err
-			}
-
-			if len(args) == 0 {
-				return fmt.Errorf("job ID required")
-			}
-
-			jobID, err := strconv.ParseInt(args[0], 10, 64)
-			if err != nil {
-				return fmt.Errorf("invalid job ID: %s", args[0])
-			}
-
-			reader, _, err := client.Jobs.GetJobArtifacts(project, jobID)
-			if err != nil {
-				return fmt.Errorf("downloading job artifacts: %w", err)
-			}
-
-			// If --path is specified, extract only that file
-			if filePath != "" {
-				return extractFileFromArtifacts(f, reader, filePath, outputPath)
-			}
-
-			// Use default output path if not specified
-			if outputPath == "" {
-				outputPath = "artifacts.zip"
-			}
-
-			// Create output file
-			outFile, err := os.OpenFile(outputPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
-			if err != nil {
-				return fmt.Errorf("creating output file: %w", err)
-			}
-			defer func() { _ = outFile.Close() }()
-
-			// Copy artifacts to file
-			written, err := io.Copy(outFile, reader)
-			if err != nil {
-				return fmt.Errorf("writing artifacts to file: %w", err)
-			}
-
-			_, _ = fmt.Fprintf(f.IOStreams.Out, "Downloaded artifacts to %s (%d bytes)\n", outputPath, written)
-			return nil
-		},
-	}
-
-	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Output file path (default: artifacts.zip)")
-	cmd.Flags().StringVar(&filePath, "path", "", "Extract a specific file from artifacts")
-
-	return cmd
-}
-
-func extractFileFromArtifacts(f *cmdutil.Factory, reader io.Reader, filePath string, outputPath string) error {
-	// Create a temporary file to store the zip
-	tmpFile, err := os.CreateTemp("", "glab-artifacts-*.zip")
-	if err != nil {
-		return fmt.Errorf("creating temporary file: %w", err)
-	}
-	tmpPath := tmpFile.Name()
-	defer func() { _ = os.Remove(tmpPath) }()
-
-	// Copy artifacts to temp file
-	_, err = io.Copy(tmpFile, reader)
-	if err != nil {
-		_ = tmpFile.Close()
-		return fmt.Errorf("writing artifacts to temporary file: %w", err)
-	}
-	_ = tmpFile.Close()
-
-	// Open the zip file
-	zipReader, err := zip.OpenReader(tmpPath)
-	if err != nil {
-		return fmt.Errorf("opening zip file: %w", err)
-	}
-	defer func() { _ = zipReader.Close() }()
-
-	// Find and extract the specified file
-	for _, zipFile := range zipReader.File {
-		if zipFile.Name == filePath {
-			// Determine output path
-			if outputPath == "" {
-				outputPath = filepath.Base(filePath)
-			}
-
-			// Open the file in the zip
-			rc, err := zipFile.Open()
-			if err != nil {
-				return fmt.Errorf("opening file in zip: %w", err)
-			}
-			defer func() { _ = rc.Close() }()
-
-			// Create output file
-			outFile, err := os.OpenFile(outputPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
-			if err != nil {
-				return fmt.Errorf("creating output file: %w", err)
-			}
-			defer func() { _ = outFile.Close() }()
-
-			// Copy the file
-			written, err := io.Copy(outFile, rc)
-			if err != nil {
-				return fmt.Errorf("extracting file: %w", err)
-			}
-
-			_, _ = fmt.Fprintf(f.IOStreams.Out, "Extracted %s to %s (%d bytes)\n", filePath, outputPath, written)
-			return nil
-		}
-	}
-
-	return fmt.Errorf("file %s not found in artifacts", filePath)
-}
-
 func parsePipelineArg(args []string) (int64, error) {
 	if len(args) == 0 {
 		return 0, fmt.Errorf("pipeline ID required")
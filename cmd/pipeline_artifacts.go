@@ -0,0 +1,204 @@
+package cmd
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func newPipelineArtifactsCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		outputPath string
+		filePath   string
+		ref        string
+		jobName    string
+		extract    bool
+		dir        string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "artifacts [<job-id>]",
+		Short: "Download job artifacts as a zip file",
+		Long: `Download the artifacts archive of a job.
+
+Instead of a job ID, --ref and --job select the latest successful job with
+that name on a branch or tag. With --extract the archive is unpacked into
+--dir instead of being saved. With --path only that file is downloaded from
+the archive, without fetching the whole zip.`,
+		Example: `  $ glab pipeline artifacts 67890
+  $ glab pipeline artifacts 67890 --output my-artifacts.zip
+  $ glab pipeline artifacts 67890 --path path/to/file.txt
+  $ glab pipeline artifacts --ref main --job build --extract --dir dist
+  $ glab pipeline artifacts --ref v1.2.0 --job build --path dist/app.tar.gz`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().Changed("dir") && !extract {
+				return fmt.Errorf("--dir requires --extract")
+			}
+
+			var jobID int64
+			if ref != "" {
+				if len(args) > 0 {
+					return fmt.Errorf("a job ID cannot be used with --ref and --job")
+				}
+			} else {
+				id, err := parseJobArg(args)
+				if err != nil {
+					return err
+				}
+				jobID = id
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			// If --path is specified, download only that file
+			if filePath != "" {
+				var (
+					reader *bytes.Reader
+					resp   *gitlab.Response
+					url    string
+				)
+				if ref != "" {
+					reader, resp, err = client.Jobs.DownloadSingleArtifactsFileByTagOrBranch(project, ref, filePath, &gitlab.DownloadArtifactsFileOptions{Job: &jobName}, gitlab.WithContext(cmd.Context()))
+					url = fmt.Sprintf("%s/projects/%s/jobs/artifacts/%s/raw/%s?job=%s", api.APIURL(client.Host()), project, ref, filePath, jobName)
+				} else {
+					reader, resp, err = client.Jobs.DownloadSingleArtifactsFile(project, jobID, filePath, gitlab.WithContext(cmd.Context()))
+					url = fmt.Sprintf("%s/projects/%s/jobs/%d/artifacts/%s", api.APIURL(client.Host()), project, jobID, filePath)
+				}
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to download artifact %s", filePath), err)
+				}
+
+				if outputPath == "" {
+					outputPath = filepath.Base(filePath)
+				}
+				written, err := cmdutil.WriteFileAtomic(outputPath, reader, 0o644)
+				if err != nil {
+					return fmt.Errorf("extracting file: %w", err)
+				}
+
+				_, _ = fmt.Fprintf(f.IOStreams.Out, "Extracted %s to %s (%d bytes)\n", filePath, outputPath, written)
+				return nil
+			}
+
+			var (
+				reader *bytes.Reader
+				resp   *gitlab.Response
+				url    string
+			)
+			if ref != "" {
+				reader, resp, err = client.Jobs.DownloadArtifactsFile(project, ref, &gitlab.DownloadArtifactsFileOptions{Job: &jobName}, gitlab.WithContext(cmd.Context()))
+				url = fmt.Sprintf("%s/projects/%s/jobs/artifacts/%s/download?job=%s", api.APIURL(client.Host()), project, ref, jobName)
+			} else {
+				reader, resp, err = client.Jobs.GetJobArtifacts(project, jobID, gitlab.WithContext(cmd.Context()))
+				url = fmt.Sprintf("%s/projects/%s/jobs/%d/artifacts", api.APIURL(client.Host()), project, jobID)
+			}
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				return errors.NewAPIError("GET", url, statusCode, "Failed to download job artifacts", err)
+			}
+
+			if extract {
+				n, err := extractArtifacts(reader, dir)
+				if err != nil {
+					return err
+				}
+				_, _ = fmt.Fprintf(f.IOStreams.Out, "Extracted %d files to %s\n", n, dir)
+				return nil
+			}
+
+			// Use default output path if not specified
+			if outputPath == "" {
+				outputPath = "artifacts.zip"
+			}
+
+			// Copy artifacts to file
+			written, err := cmdutil.WriteFileAtomic(outputPath, reader, 0o644)
+			if err != nil {
+				return fmt.Errorf("writing artifacts to file: %w", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Downloaded artifacts to %s (%d bytes)\n", outputPath, written)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Output file path (default: artifacts.zip, or the file's base name with --path)")
+	cmd.Flags().StringVar(&filePath, "path", "", "Download only this file from the artifacts")
+	cmd.Flags().StringVar(&ref, "ref", "", "Branch or tag to take the latest successful artifacts from (requires --job)")
+	cmd.Flags().StringVar(&jobName, "job", "", "Name of the job whose artifacts to download (requires --ref)")
+	cmd.Flags().BoolVarP(&extract, "extract", "x", false, "Unpack the archive instead of saving it")
+	cmd.Flags().StringVarP(&dir, "dir", "d", ".", "Directory to unpack the archive into (with --extract)")
+	cmd.MarkFlagsRequiredTogether("ref", "job")
+	cmd.MarkFlagsMutuallyExclusive("extract", "path")
+	cmd.MarkFlagsMutuallyExclusive("extract", "output")
+
+	return cmd
+}
+
+// extractArtifacts unpacks a zip archive into dir and returns the number of
+// files written. Entries that would land outside dir are rejected, and
+// symbolic links are skipped.
+func extractArtifacts(r *bytes.Reader, dir string) (int, error) {
+	zr, err := zip.NewReader(r, r.Size())
+	if err != nil {
+		return 0, fmt.Errorf("opening zip file: %w", err)
+	}
+
+	n := 0
+	for _, zf := range zr.File {
+		name := filepath.FromSlash(zf.Name)
+		if !filepath.IsLocal(name) {
+			return n, fmt.Errorf("refusing to extract %s: path escapes %s", zf.Name, dir)
+		}
+		dest := filepath.Join(dir, name)
+
+		mode := zf.Mode()
+		switch {
+		case mode.IsDir():
+			if err := os.MkdirAll(dest, 0o755); err != nil {
+				return n, fmt.Errorf("creating directory: %w", err)
+			}
+			continue
+		case mode&os.ModeSymlink != 0:
+			continue
+		}
+
+		if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+			return n, fmt.Errorf("creating directory: %w", err)
+		}
+		rc, err := zf.Open()
+		if err != nil {
+			return n, fmt.Errorf("opening file in zip: %w", err)
+		}
+		_, err = cmdutil.WriteFileAtomic(dest, rc, mode.Perm()|0o600)
+		_ = rc.Close()
+		if err != nil {
+			return n, fmt.Errorf("extracting %s: %w", zf.Name, err)
+		}
+		n++
+	}
+	return n, nil
+}
@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"archive/zip"
+	"bytes"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func artifactsZip(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _ = w.Write([]byte(content))
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestPipelineArtifacts_RefExtract(t *testing.T) {
+	archive := artifactsZip(t, map[string]string{
+		"dist/app.js":  "console.log(1)",
+		"dist/app.css": "body{}",
+	})
+	var query string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v4/projects/test-owner/test-repo/jobs/artifacts/main/download" {
+			cmdtest.ErrorResponse(w, 404, "not found")
+			return
+		}
+		query = r.URL.RawQuery
+		_, _ = w.Write(archive)
+	})
+
+	dir := t.TempDir()
+	f := cmdtest.NewTestFactory(t)
+	cmd := newPipelineArtifactsCmd(f.Factory)
+	cmd.SetArgs([]string{"--ref", "main", "--job", "build", "--extract", "--dir", dir})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if query != "job=build" {
+		t.Errorf("unexpected query: %s", query)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "dist", "app.js"))
+	if err != nil || string(data) != "console.log(1)" {
+		t.Errorf("extracted app.js = %q, %v", data, err)
+	}
+	if !strings.Contains(f.IO.String(), "Extracted 2 files to "+dir) {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestPipelineArtifacts_RefSingleFile(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v4/projects/test-owner/test-repo/jobs/artifacts/main/raw/coverage/report.xml" || r.URL.Query().Get("job") != "test" {
+			cmdtest.ErrorResponse(w, 404, "not found")
+			return
+		}
+		_, _ = w.Write([]byte("<coverage/>"))
+	})
+
+	output := filepath.Join(t.TempDir(), "report.xml")
+	f := cmdtest.NewTestFactory(t)
+	cmd := newPipelineArtifactsCmd(f.Factory)
+	cmd.SetArgs([]string{"--ref", "main", "--job", "test", "--path", "coverage/report.xml", "--output", output})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := os.ReadFile(output)
+	if err != nil || string(data) != "<coverage/>" {
+		t.Errorf("downloaded %q, %v", data, err)
+	}
+}
+
+func TestPipelineArtifacts_RefRequiresJob(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newPipelineArtifactsCmd(f.Factory)
+	cmd.SetArgs([]string{"--ref", "main"})
+
+	if err := cmd.Execute(); err == nil {
+		t.Error("expected error when --ref is used without --job")
+	}
+}
+
+func TestExtractArtifacts_RejectsEscapingPaths(t *testing.T) {
+	archive := artifactsZip(t, map[string]string{"../evil.sh": "rm -rf /"})
+
+	dir := t.TempDir()
+	_, err := extractArtifacts(bytes.NewReader(archive), dir)
+	if err == nil || !strings.Contains(err.Error(), "path escapes") {
+		t.Errorf("expected path escape error, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(filepath.Dir(dir), "evil.sh")); err == nil {
+		t.Error("file outside the target directory was written")
+	}
+}
@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/PhilipKram/gitlab-cli/internal/joblog"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// failedJob is a failed job of a pipeline with the end of its log.
+type failedJob struct {
+	ID            int64    `json:"id"`
+	Name          string   `json:"name"`
+	Stage         string   `json:"stage"`
+	FailureReason string   `json:"failure_reason"`
+	AllowFailure  bool     `json:"allow_failure"`
+	WebURL        string   `json:"web_url"`
+	Log           []string `json:"log"`
+}
+
+func newPipelineFailedJobsCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		lines    int
+		grep     string
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "failed-jobs [<id>]",
+		Short: "Show failed jobs of a pipeline with log excerpts",
+		Long: `List the failed jobs of a pipeline and print the last lines of each job's
+log, so a red pipeline can be triaged without opening every job.
+
+Without an ID, the latest pipeline for the current branch is used. --grep
+keeps only log lines matching a regular expression before the last --lines
+are taken. Color codes and section markers are removed from the log.`,
+		Example: `  $ glab pipeline failed-jobs
+  $ glab pipeline failed-jobs 12345 --lines 50
+  $ glab pipeline failed-jobs --grep 'error|FAIL'
+  $ glab pipeline failed-jobs 12345 --format json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var pattern *regexp.Regexp
+			if grep != "" {
+				var err error
+				pattern, err = regexp.Compile(grep)
+				if err != nil {
+					return fmt.Errorf("invalid --grep pattern: %w", err)
+				}
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			pipelineID, err := resolvePipelineArg(client, project, args)
+			if err != nil {
+				return err
+			}
+
+			jobs, err := listFailedJobs(cmd, client, project, pipelineID, lines, pattern)
+			if err != nil {
+				return err
+			}
+
+			if len(jobs) == 0 {
+				_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "No failed jobs in pipeline #%d\n", pipelineID)
+				return nil
+			}
+
+			if jsonFlag || f.ExportRequested() || (format != "" && format != "table") {
+				return f.FormatAndPrint(jobs, format, jsonFlag)
+			}
+
+			out := f.IOStreams.Out
+			cs := f.IOStreams.ColorScheme()
+			for i, j := range jobs {
+				if i > 0 {
+					_, _ = fmt.Fprintln(out)
+				}
+				title := fmt.Sprintf("%s %s (%s, job #%d)", cs.Red("✗"), cs.Bold(j.Name), j.Stage, j.ID)
+				if j.FailureReason != "" {
+					title += ": " + j.FailureReason
+				}
+				if j.AllowFailure {
+					title += cs.Gray(" [allowed to fail]")
+				}
+				_, _ = fmt.Fprintln(out, title)
+				_, _ = fmt.Fprintln(out, cs.Gray(j.WebURL))
+				for _, line := range j.Log {
+					_, _ = fmt.Fprintf(out, "  %s\n", line)
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVarP(&lines, "lines", "n", 20, "Number of log lines to show per job (0 to skip logs)")
+	cmd.Flags().StringVarP(&grep, "grep", "g", "", "Only show log lines matching this regular expression")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
+
+	return cmd
+}
+
+// listFailedJobs returns the failed jobs of a pipeline with the last lines
+// of each log, filtered by pattern when it is not nil.
+func listFailedJobs(cmd *cobra.Command, client *api.Client, project string, pipelineID int64, lines int, pattern *regexp.Regexp) ([]failedJob, error) {
+	scope := []gitlab.BuildStateValue{gitlab.Failed}
+	opts := &gitlab.ListJobsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100},
+		Scope:       &scope,
+	}
+
+	var failed []failedJob
+	for {
+		jobs, resp, err := client.Jobs.ListPipelineJobs(project, pipelineID, opts, gitlab.WithContext(cmd.Context()))
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			url := api.APIURL(client.Host()) + "/projects/" + project + "/pipelines/" + strconv.FormatInt(pipelineID, 10) + "/jobs"
+			return nil, errors.NewAPIError("GET", url, statusCode, "Failed to list pipeline jobs", err)
+		}
+
+		for _, j := range jobs {
+			fj := failedJob{
+				ID:            j.ID,
+				Name:          j.Name,
+				Stage:         j.Stage,
+				FailureReason: j.FailureReason,
+				AllowFailure:  j.AllowFailure,
+				WebURL:        j.WebURL,
+			}
+			if lines > 0 {
+				reader, resp, err := client.Jobs.GetTraceFile(project, j.ID, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/projects/" + project + "/jobs/" + strconv.FormatInt(j.ID, 10) + "/trace"
+					return nil, errors.NewAPIError("GET", url, statusCode, "Failed to get job trace", err)
+				}
+				fj.Log, err = joblog.Tail(reader, lines, pattern)
+				if err != nil {
+					return nil, fmt.Errorf("reading log of job #%d: %w", j.ID, err)
+				}
+			}
+			failed = append(failed, fj)
+		}
+
+		if resp == nil || resp.NextPage == 0 {
+			return failed, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func mockFailedJobs(t *testing.T) {
+	t.Helper()
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v4/projects/test-owner/test-repo/pipelines/100/jobs":
+			if got := r.URL.Query()["scope[]"]; len(got) != 1 || got[0] != "failed" {
+				t.Errorf("unexpected scope: %v", got)
+			}
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+				{"id": 1, "name": "test:unit", "stage": "test", "status": "failed", "failure_reason": "script_failure", "web_url": "https://gitlab.com/test-owner/test-repo/-/jobs/1"},
+				{"id": 2, "name": "lint", "stage": "test", "status": "failed", "allow_failure": true, "web_url": "https://gitlab.com/test-owner/test-repo/-/jobs/2"},
+			})
+		case "/api/v4/projects/test-owner/test-repo/jobs/1/trace":
+			_, _ = io.WriteString(w, "$ go test ./...\n\x1b[31m--- FAIL: TestParse\x1b[0m\nparse_test.go:12: unexpected token\nFAIL\n")
+		case "/api/v4/projects/test-owner/test-repo/jobs/2/trace":
+			_, _ = io.WriteString(w, "$ golangci-lint run\nmain.go:3: unused import\n")
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+}
+
+func TestPipelineFailedJobs(t *testing.T) {
+	mockFailedJobs(t)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newPipelineFailedJobsCmd(f.Factory)
+	cmd.SetArgs([]string{"100", "--lines", "2"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := f.IO.String()
+	for _, want := range []string{
+		"test:unit (test, job #1): script_failure",
+		"  parse_test.go:12: unexpected token\n  FAIL\n",
+		"lint (test, job #2) [allowed to fail]",
+		"  $ golangci-lint run\n  main.go:3: unused import\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "TestParse") {
+		t.Errorf("expected only the last 2 lines:\n%s", out)
+	}
+}
+
+func TestPipelineFailedJobs_GrepJSON(t *testing.T) {
+	mockFailedJobs(t)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newPipelineFailedJobsCmd(f.Factory)
+	cmd.SetArgs([]string{"100", "--grep", "FAIL", "--format", "json"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var jobs []failedJob
+	if err := json.Unmarshal([]byte(f.IO.String()), &jobs); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, f.IO.String())
+	}
+	if len(jobs) != 2 {
+		t.Fatalf("expected 2 jobs, got %d", len(jobs))
+	}
+	if got := strings.Join(jobs[0].Log, "|"); got != "--- FAIL: TestParse|FAIL" {
+		t.Errorf("log = %q", got)
+	}
+	if len(jobs[1].Log) != 0 {
+		t.Errorf("expected no matching lines for lint, got %q", jobs[1].Log)
+	}
+}
+
+func TestPipelineFailedJobs_InvalidGrep(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newPipelineFailedJobsCmd(f.Factory)
+	cmd.SetArgs([]string{"100", "--grep", "("})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "invalid --grep pattern") {
+		t.Errorf("expected pattern error, got %v", err)
+	}
+}
@@ -219,7 +219,7 @@ func newPipelineFlakyCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.Flags().IntVarP(&days, "days", "d", 30, "Number of days to analyze")
 	cmd.Flags().Float64VarP(&threshold, "threshold", "t", 0.0, "Minimum flakiness rate threshold (0-100, 0 shows all flaky jobs)")
 	cmd.Flags().IntVarP(&limit, "limit", "L", 20, "Maximum number of flaky jobs to display")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
 
 	return cmd
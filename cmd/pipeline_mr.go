@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/browser"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// mrPipelineRefRe matches the refs of merge request (detached, merged
+// results, and merge train) pipelines.
+var mrPipelineRefRe = regexp.MustCompile(`^refs/merge-requests/(\d+)/(?:head|merge|train)$`)
+
+func newPipelineMRCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		web      bool
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "mr [<id>]",
+		Short: "Show the merge request of a pipeline",
+		Long: `Show the merge request a pipeline belongs to.
+
+Merge request pipelines are resolved from their ref; branch pipelines from
+the merge requests containing the pipeline's commit, preferring the one
+whose source branch is the pipeline's ref. Without an ID, the latest
+pipeline for the current branch is used.`,
+		Example: `  $ glab pipeline mr 12345
+  $ glab pipeline mr --web`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			pipelineID, err := resolvePipelineArg(client, project, args)
+			if err != nil {
+				return err
+			}
+
+			pipeline, resp, err := client.Pipelines.GetPipeline(project, pipelineID, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + project + "/pipelines/" + strconv.FormatInt(pipelineID, 10)
+				return errors.NewAPIError("GET", url, statusCode, "Failed to get pipeline", err)
+			}
+
+			mrID, err := pipelineMergeRequestIID(cmd, client, project, pipeline)
+			if err != nil {
+				return err
+			}
+
+			mr, resp, err := client.MergeRequests.GetMergeRequest(project, mrID, nil, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/merge_requests/%d", api.APIURL(client.Host()), project, mrID)
+				return errors.NewAPIError("GET", url, statusCode, "Failed to get merge request", err)
+			}
+
+			if web {
+				return browser.Open(mr.WebURL)
+			}
+
+			if jsonFlag || f.ExportRequested() || (format != "" && format != "table") {
+				return f.FormatAndPrint(mr, format, jsonFlag)
+			}
+
+			out := f.IOStreams.Out
+			cs := f.IOStreams.ColorScheme()
+			_, _ = fmt.Fprintf(out, "%s %s\n", cs.Bold(fmt.Sprintf("!%d", mr.IID)), mr.Title)
+			_, _ = fmt.Fprintf(out, "State:    %s\n", cs.State(mr.State))
+			_, _ = fmt.Fprintf(out, "Branch:   %s → %s\n", mr.SourceBranch, mr.TargetBranch)
+			_, _ = fmt.Fprintf(out, "Pipeline: #%d (%s)\n", pipeline.ID, cs.State(pipeline.Status))
+			_, _ = fmt.Fprintf(out, "\n%s\n", mr.WebURL)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open the merge request in the browser")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
+
+	return cmd
+}
+
+// pipelineMergeRequestIID returns the IID of the merge request pipeline ran
+// for.
+func pipelineMergeRequestIID(cmd *cobra.Command, client *api.Client, project string, pipeline *gitlab.Pipeline) (int64, error) {
+	if m := mrPipelineRefRe.FindStringSubmatch(pipeline.Ref); m != nil {
+		return strconv.ParseInt(m[1], 10, 64)
+	}
+
+	mrs, resp, err := client.Commits.ListMergeRequestsByCommit(project, pipeline.SHA, gitlab.WithContext(cmd.Context()))
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := api.APIURL(client.Host()) + "/projects/" + project + "/repository/commits/" + pipeline.SHA + "/merge_requests"
+		return 0, errors.NewAPIError("GET", url, statusCode, "Failed to list merge requests of commit", err)
+	}
+
+	var fallback *gitlab.BasicMergeRequest
+	for _, mr := range mrs {
+		if mr.SourceBranch == pipeline.Ref {
+			return mr.IID, nil
+		}
+		if fallback == nil {
+			fallback = mr
+		}
+	}
+	if fallback == nil || pipeline.Tag {
+		return 0, fmt.Errorf("no merge request found for pipeline #%d (ref %s)", pipeline.ID, pipeline.Ref)
+	}
+	return fallback.IID, nil
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func pipelineMRServer(t *testing.T, ref string) {
+	t.Helper()
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/test-owner/test-repo/pipelines/500":
+			cmdtest.JSONResponse(w, 200, map[string]interface{}{"id": 500, "status": "running", "ref": ref, "sha": "abc123"})
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/test-owner/test-repo/repository/commits/abc123/merge_requests":
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+				{"iid": 3, "source_branch": "other"},
+				{"iid": 9, "source_branch": "feature"},
+			})
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/api/v4/projects/test-owner/test-repo/merge_requests/"):
+			iid := strings.TrimPrefix(r.URL.Path, "/api/v4/projects/test-owner/test-repo/merge_requests/")
+			n, _ := strconv.Atoi(iid)
+			cmdtest.JSONResponse(w, 200, map[string]interface{}{
+				"iid": n, "title": "MR " + iid, "state": "opened", "source_branch": "feature", "target_branch": "main",
+				"web_url": "https://gitlab.com/test-owner/test-repo/-/merge_requests/" + iid,
+			})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+}
+
+func TestPipelineMR_MergeRequestRef(t *testing.T) {
+	pipelineMRServer(t, "refs/merge-requests/42/merge")
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newPipelineMRCmd(f.Factory)
+	cmd.SetArgs([]string{"500"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := f.IO.String()
+	for _, want := range []string{"!42 MR 42", "feature → main", "#500", "merge_requests/42"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q: %s", want, out)
+		}
+	}
+}
+
+func TestPipelineMR_BranchPipeline(t *testing.T) {
+	pipelineMRServer(t, "feature")
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newPipelineMRCmd(f.Factory)
+	cmd.SetArgs([]string{"500"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out := f.IO.String(); !strings.Contains(out, "!9 MR 9") {
+		t.Errorf("expected the MR whose source branch is the ref, got: %s", out)
+	}
+}
+
+func TestPipelineMRRefPattern(t *testing.T) {
+	tests := map[string]bool{
+		"refs/merge-requests/12/head":  true,
+		"refs/merge-requests/12/merge": true,
+		"refs/merge-requests/12/train": true,
+		"main":                         false,
+		"refs/merge-requests/x/head":   false,
+	}
+	for ref, want := range tests {
+		if got := mrPipelineRefRe.MatchString(ref); got != want {
+			t.Errorf("mrPipelineRefRe.MatchString(%q) = %v, want %v", ref, got, want)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/formatter"
+	gitutil "github.com/PhilipKram/gitlab-cli/internal/git"
+	"github.com/PhilipKram/gitlab-cli/internal/secrets"
+	"github.com/spf13/cobra"
+)
+
+// SecretsScanResult represents the result of a secrets scan.
+type SecretsScanResult struct {
+	Source   string            `json:"source"`
+	Findings []secrets.Finding `json:"findings"`
+}
+
+func newPipelineSecretsCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "secrets <command>",
+		Short: "Detect secrets before they are pushed",
+		Long:  "Scan local changes or merge request diffs for leaked credentials.",
+	}
+
+	cmd.AddCommand(newPipelineSecretsScanCmd(f))
+
+	return cmd
+}
+
+func newPipelineSecretsScanCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		mrID     int64
+		base     string
+		strict   bool
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "scan",
+		Short: "Scan a diff for high-entropy strings and known token patterns",
+		Long: `Scan the changes about to be pushed (or a merge request's diff) for
+potential secrets such as access tokens, private keys, and high-entropy strings.
+
+By default the commits on the current branch that are not yet on its upstream
+are scanned. Use --mr to scan a merge request instead. With --strict the command
+exits with a non-zero status when anything is found, which makes it suitable
+for a git pre-push hook.
+
+Add "glab:allow-secret" to a line to suppress findings for it.`,
+		Example: `  $ glab ci secrets scan
+  $ glab ci secrets scan --base origin/main --strict
+  $ glab ci secrets scan --mr 123 --format json
+  $ echo 'exec glab ci secrets scan --strict' > .git/hooks/pre-push`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFormat, err := f.ResolveFormat(format, jsonFlag)
+			if err != nil {
+				return err
+			}
+
+			var diff, source string
+			if cmd.Flags().Changed("mr") {
+				client, err := f.Client()
+				if err != nil {
+					return err
+				}
+
+				project, err := f.FullProjectPath()
+				if err != nil {
+					return err
+				}
+
+				diff, err = fetchMRUnifiedDiff(cmd, client, project, mrID)
+				if err != nil {
+					return err
+				}
+				source = fmt.Sprintf("!%d", mrID)
+			} else {
+				if base == "" {
+					base = defaultScanBase(f)
+				}
+				if base == "" {
+					return fmt.Errorf("could not determine the base to compare against\nUse --base <ref> to specify it")
+				}
+				diff, err = gitutil.Diff(base, "HEAD")
+				if err != nil {
+					return err
+				}
+				source = base + "...HEAD"
+			}
+
+			result := SecretsScanResult{
+				Source:   source,
+				Findings: secrets.Scan(diff),
+			}
+			if result.Findings == nil {
+				result.Findings = []secrets.Finding{}
+			}
+
+			if outputFormat == formatter.JSONFormat || outputFormat == formatter.YAMLFormat {
+				if err := f.FormatAndPrint(result, string(outputFormat), false); err != nil {
+					return err
+				}
+			} else {
+				printSecretFindings(f, result)
+			}
+
+			if strict && len(result.Findings) > 0 {
+				return fmt.Errorf("found %d potential secret(s) in %s", len(result.Findings), source)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64Var(&mrID, "mr", 0, "Scan the diff of a merge request instead of local changes")
+	cmd.Flags().StringVar(&base, "base", "", "Base ref to compare HEAD against (default: upstream of the current branch)")
+	cmd.Flags().BoolVar(&strict, "strict", false, "Exit with an error when potential secrets are found")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, yaml, table, or plain")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+// defaultScanBase returns the upstream of the current branch, falling back to
+// the default branch of the configured remote.
+func defaultScanBase(f *cmdutil.Factory) string {
+	if upstream, err := gitutil.UpstreamBranch(); err == nil && upstream != "" {
+		return upstream
+	}
+	remote, err := f.Remote()
+	if err != nil {
+		return ""
+	}
+	branch, err := gitutil.DefaultBranch(remote.Name)
+	if err != nil {
+		return ""
+	}
+	return remote.Name + "/" + branch
+}
+
+// fetchMRUnifiedDiff downloads the diffs of a merge request, across all
+// pages, and joins them into a single unified diff.
+func fetchMRUnifiedDiff(cmd *cobra.Command, client *api.Client, project string, mrID int64) (string, error) {
+	diffs, err := listMRDiffs(cmd, client, project, mrID)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	for _, d := range diffs {
+		_, _ = fmt.Fprintf(&sb, "--- a/%s\n+++ b/%s\n", d.OldPath, d.NewPath)
+		sb.WriteString(d.Diff)
+		if !strings.HasSuffix(d.Diff, "\n") {
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String(), nil
+}
+
+// printSecretFindings renders scan findings as a table on stdout with a summary on stderr.
+func printSecretFindings(f *cmdutil.Factory, result SecretsScanResult) {
+	if len(result.Findings) == 0 {
+		_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "No potential secrets found in %s\n", result.Source)
+		return
+	}
+
+	tp := f.NewTablePrinter()
+	for _, finding := range result.Findings {
+		tp.AddRow(fmt.Sprintf("%s:%d", finding.File, finding.Line), finding.Rule, finding.Match)
+	}
+	_ = tp.Render()
+
+	_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "\nWarning: found %d potential secret(s) in %s\n", len(result.Findings), result.Source)
+}
+
+// warnOnSecrets scans the local diff between base and head and prints a warning
+// when potential secrets are found. Failures to compute the diff are ignored.
+func warnOnSecrets(f *cmdutil.Factory, base, head string) {
+	diff, err := gitutil.Diff(base, head)
+	if err != nil {
+		return
+	}
+	findings := secrets.Scan(diff)
+	if len(findings) == 0 {
+		return
+	}
+	errOut := f.IOStreams.ErrOut
+	_, _ = fmt.Fprintf(errOut, "Warning: found %d potential secret(s) in %s...%s:\n", len(findings), base, head)
+	for _, finding := range findings {
+		_, _ = fmt.Fprintf(errOut, "  %s:%d  %s (%s)\n", finding.File, finding.Line, finding.Rule, finding.Match)
+	}
+	_, _ = fmt.Fprintln(errOut, "Run 'glab ci secrets scan' for details.")
+}
@@ -194,7 +194,7 @@ func newPipelineSlowestJobsCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.Flags().StringVarP(&branch, "branch", "b", "", "Filter by branch or tag")
 	cmd.Flags().IntVarP(&days, "days", "d", 30, "Number of days to analyze")
 	cmd.Flags().IntVarP(&limit, "limit", "L", 10, "Maximum number of jobs to display")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
 
 	return cmd
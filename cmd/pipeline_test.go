@@ -38,7 +38,9 @@ func TestPipelineCmd_HasSubcommands(t *testing.T) {
 	expectedSubcommands := []string{
 		"list",
 		"view",
+		"mr",
 		"run",
+		"trigger",
 		"cancel",
 		"retry",
 		"delete",
@@ -47,12 +49,17 @@ func TestPipelineCmd_HasSubcommands(t *testing.T) {
 		"retry-job",
 		"cancel-job",
 		"artifacts",
+		"failed-jobs",
 		"stats",
 		"slowest-jobs",
 		"trends",
 		"flaky",
 		"watch",
 		"lint",
+		"init",
+		"exec",
+		"play-job",
+		"secrets",
 	}
 
 	subcommands := cmd.Commands()
@@ -141,7 +148,7 @@ func TestPipelineRunCmd_Flags(t *testing.T) {
 	}
 
 	// Verify run has aliases
-	expectedAliases := []string{"create", "trigger"}
+	expectedAliases := []string{"create"}
 	if len(cmd.Aliases) != len(expectedAliases) {
 		t.Errorf("expected %d aliases, got %d", len(expectedAliases), len(cmd.Aliases))
 	}
@@ -797,3 +804,34 @@ func TestPipelineJobs_MissingID(t *testing.T) {
 		t.Fatal("expected error for missing pipeline ID")
 	}
 }
+
+func TestPipelineRun_Wait(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && strings.Contains(r.URL.Path, "/triggers"):
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+				{"id": 1, "token": "test-trigger-token", "description": "glab-cli"},
+			})
+		case r.Method == "POST" && strings.Contains(r.URL.Path, "/trigger/pipeline"):
+			cmdtest.JSONResponse(w, 201, cmdtest.FixturePipelineFailed)
+		case r.Method == "GET" && strings.HasSuffix(r.URL.Path, "/pipelines/301/jobs"):
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{})
+		case r.Method == "GET" && strings.HasSuffix(r.URL.Path, "/pipelines/301"):
+			cmdtest.JSONResponse(w, 200, cmdtest.FixturePipelineFailed)
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newPipelineRunCmd(f.Factory)
+	cmd.SetArgs([]string{"--ref", "main", "--wait"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "pipeline #301 failed") {
+		t.Fatalf("expected pipeline failure error, got %v", err)
+	}
+	if !strings.Contains(f.IO.String(), "Created pipeline #301") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
@@ -227,7 +227,7 @@ func newPipelineTrendsCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.Flags().StringVarP(&branch, "branch", "b", "", "Filter by branch or tag")
 	cmd.Flags().IntVarP(&days, "days", "d", 30, "Number of days to analyze")
 	cmd.Flags().IntVar(&bucketSize, "bucket-size", 7, "Size of time buckets in days (default: 7 for weekly buckets)")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
 
 	return cmd
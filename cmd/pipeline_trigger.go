@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// triggerTokenEnv names the environment variable read when --token is not given.
+const triggerTokenEnv = "GITLAB_TRIGGER_TOKEN"
+
+func newPipelineTriggerCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		ref       string
+		token     string
+		variables []string
+		format    string
+		jsonFlag  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "trigger",
+		Short: "Trigger a pipeline with a trigger token",
+		Long: `Trigger a pipeline through the pipeline trigger API.
+
+The trigger token is taken from --token or the GITLAB_TRIGGER_TOKEN
+environment variable. With a token no login is needed, so scripts can start
+pipelines in other projects with only that project's trigger token. Use
+--repo to select the project to trigger.
+
+Without a token, glab uses your stored credentials and one of the project's
+trigger tokens, creating one if needed, like "glab pipeline run".`,
+		Example: `  $ glab pipeline trigger --ref main --token glptt-xxxxxxxx
+  $ GITLAB_TRIGGER_TOKEN=glptt-xxxxxxxx glab pipeline trigger -R gitlab.com/group/deploy --ref main --variables ENV=prod
+  $ glab pipeline trigger --ref v1.2.0 --format json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if token == "" {
+				token = os.Getenv(triggerTokenEnv)
+			}
+
+			varsMap, err := parsePipelineVariables(variables)
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			var pipeline *gitlab.Pipeline
+			if token == "" {
+				client, err := f.Client()
+				if err != nil {
+					return err
+				}
+				pipeline, err = runPipelineWithTrigger(client, project, ref, varsMap)
+				if err != nil {
+					return err
+				}
+			} else {
+				// The trigger token authenticates the request on its own.
+				client, err := api.NewClientWithToken(f.Host(), "")
+				if err != nil {
+					return err
+				}
+				opts := &gitlab.RunPipelineTriggerOptions{
+					Ref:   &ref,
+					Token: &token,
+				}
+				if len(varsMap) > 0 {
+					opts.Variables = varsMap
+				}
+				var resp *gitlab.Response
+				pipeline, resp, err = client.PipelineTriggers.RunPipelineTrigger(project, opts, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/projects/" + project + "/trigger/pipeline"
+					return errors.NewAPIError("POST", url, statusCode, "Failed to trigger pipeline", err)
+				}
+			}
+
+			if jsonFlag || f.ExportRequested() || (format != "" && format != "table") {
+				return f.FormatAndPrint(pipeline, format, jsonFlag)
+			}
+
+			out := f.IOStreams.Out
+			_, _ = fmt.Fprintf(out, "Created pipeline #%d\n", pipeline.ID)
+			_, _ = fmt.Fprintf(out, "Status: %s\n", pipeline.Status)
+			_, _ = fmt.Fprintf(out, "%s\n", pipeline.WebURL)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&ref, "ref", "b", "", "Branch or tag to run the pipeline on (required)")
+	cmd.Flags().StringVarP(&token, "token", "t", "", "Pipeline trigger token (default: $"+triggerTokenEnv+")")
+	cmd.Flags().StringArrayVar(&variables, "variables", nil, "Pipeline variables (KEY=value)")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
+	_ = cmd.MarkFlagRequired("ref")
+
+	return cmd
+}
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestPipelineTrigger_WithToken(t *testing.T) {
+	var body struct {
+		Token     string            `json:"token"`
+		Ref       string            `json:"ref"`
+		Variables map[string]string `json:"variables"`
+	}
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/trigger/pipeline" {
+			if got := r.Header.Get("PRIVATE-TOKEN"); got != "" {
+				t.Errorf("PRIVATE-TOKEN = %q, want none", got)
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 201, cmdtest.FixturePipelineRunning)
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	// The stored credentials must not be needed.
+	f.Factory.Client = func() (*api.Client, error) {
+		t.Fatal("Client() should not be called with --token")
+		return nil, nil
+	}
+	cmd := newPipelineTriggerCmd(f.Factory)
+	cmd.SetArgs([]string{"--ref", "main", "--token", "glptt-abc", "--variables", "ENV=prod"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body.Token != "glptt-abc" || body.Ref != "main" || body.Variables["ENV"] != "prod" {
+		t.Errorf("unexpected request body: %+v", body)
+	}
+	if !strings.Contains(f.IO.String(), "Created pipeline #") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestPipelineTrigger_TokenFromEnv(t *testing.T) {
+	var token string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/trigger/pipeline") {
+			var body struct {
+				Token string `json:"token"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			token = body.Token
+			cmdtest.JSONResponse(w, 201, cmdtest.FixturePipelineRunning)
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+	t.Setenv("GITLAB_TRIGGER_TOKEN", "glptt-env")
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newPipelineTriggerCmd(f.Factory)
+	cmd.SetArgs([]string{"--ref", "main", "--format", "json"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token != "glptt-env" {
+		t.Errorf("token = %q, want %q", token, "glptt-env")
+	}
+	if !strings.Contains(f.IO.String(), `"status"`) {
+		t.Errorf("expected JSON output, got: %s", f.IO.String())
+	}
+}
+
+func TestPipelineTrigger_WithoutToken(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/triggers"):
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+				{"id": 1, "token": "test-trigger-token", "description": "glab-cli"},
+			})
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/trigger/pipeline"):
+			cmdtest.JSONResponse(w, 201, cmdtest.FixturePipelineRunning)
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+	t.Setenv("GITLAB_TRIGGER_TOKEN", "")
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newPipelineTriggerCmd(f.Factory)
+	cmd.SetArgs([]string{"--ref", "main"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(f.IO.String(), "Created pipeline #") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestPipelineTrigger_RequiresRef(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newPipelineTriggerCmd(f.Factory)
+	cmd.SetArgs([]string{"--token", "glptt-abc"})
+	cmd.SilenceUsage = true
+
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "ref") {
+		t.Fatalf("expected missing ref error, got %v", err)
+	}
+}
@@ -3,31 +3,39 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
-	"os/signal"
-	"strconv"
+	"io"
+	"sort"
 	"strings"
-	"syscall"
 	"time"
 
+	"github.com/PhilipKram/gitlab-cli/internal/api"
 	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
 	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	gitutil "github.com/PhilipKram/gitlab-cli/internal/git"
 	"github.com/spf13/cobra"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 )
 
+// spinnerFrames animate running and pending jobs across polls.
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
 func statusColor(status string) string {
+	return colorizeStatus(status, status)
+}
+
+// colorizeStatus wraps s in the color associated with a pipeline or job status.
+func colorizeStatus(status, s string) string {
 	switch status {
 	case "success":
-		return "\033[32m" + status + "\033[0m" // green
+		return "\033[32m" + s + "\033[0m" // green
 	case "failed":
-		return "\033[31m" + status + "\033[0m" // red
+		return "\033[31m" + s + "\033[0m" // red
 	case "running", "pending":
-		return "\033[33m" + status + "\033[0m" // yellow
+		return "\033[33m" + s + "\033[0m" // yellow
 	case "canceled":
-		return "\033[90m" + status + "\033[0m" // gray
+		return "\033[90m" + s + "\033[0m" // gray
 	default:
-		return status
+		return s
 	}
 }
 
@@ -40,26 +48,56 @@ func isTerminalStatus(status string) bool {
 	}
 }
 
+// statusIcon returns the symbol shown next to a stage or job in the watch tree.
+func statusIcon(status string, frame int) string {
+	switch status {
+	case "success":
+		return "✓"
+	case "failed":
+		return "✗"
+	case "canceled":
+		return "⊘"
+	case "skipped":
+		return "»"
+	case "manual":
+		return "▶"
+	case "running", "pending", "created", "preparing", "waiting_for_resource", "scheduled":
+		return spinnerFrames[frame%len(spinnerFrames)]
+	default:
+		return "•"
+	}
+}
+
+// pipelineWatchOptions controls how watchPipeline polls and reports.
+type pipelineWatchOptions struct {
+	Interval time.Duration
+	FailFast bool
+	JobsOnly []string
+}
+
 func newPipelineWatchCmd(f *cmdutil.Factory) *cobra.Command {
-	var (
-		interval time.Duration
-		failFast bool
-		jobsOnly []string
-	)
+	var opts pipelineWatchOptions
 
 	cmd := &cobra.Command{
-		Use:   "watch <pipeline-id>",
+		Use:   "watch [<id>]",
 		Short: "Watch a pipeline in real-time",
-		Long:  "Poll a pipeline and its jobs at a regular interval, displaying status updates until the pipeline reaches a terminal state.",
-		Example: `  $ glab pipeline watch 12345
+		Long: `Poll a pipeline and its jobs at a regular interval, displaying a live
+stage/job tree until the pipeline reaches a terminal state.
+
+Without an ID, the latest pipeline for the current branch is watched.
+The command exits with a non-zero status if the pipeline fails or is
+canceled, so it can be used to wait for CI in scripts.`,
+		Example: `  $ glab pipeline watch
+  $ glab pipeline watch 12345
   $ glab pipeline watch 12345 --interval 10s
   $ glab pipeline watch 12345 --fail-fast
-  $ glab pipeline watch 12345 --jobs-only build,test`,
-		Args: cobra.ExactArgs(1),
+  $ glab pipeline watch 12345 --jobs-only build,test
+  $ git push && glab pipeline watch && glab mr merge`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			pipelineID, err := strconv.ParseInt(args[0], 10, 64)
+			client, err := f.Client()
 			if err != nil {
-				return fmt.Errorf("invalid pipeline ID %q: must be an integer", args[0])
+				return err
 			}
 
 			project, err := f.FullProjectPath()
@@ -67,139 +105,277 @@ func newPipelineWatchCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			client, err := f.Client()
+			pipelineID, err := resolvePipelineArg(client, project, args)
 			if err != nil {
 				return err
 			}
 
-			out := f.IOStreams.Out
-
-			// Set up signal handling for graceful shutdown
-			ctx, cancel := context.WithCancel(context.Background())
-			defer cancel()
-
-			sigCh := make(chan os.Signal, 1)
-			signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
-			go func() {
-				<-sigCh
-				cancel()
-			}()
-
-			ticker := time.NewTicker(interval)
-			defer ticker.Stop()
-
-			// Poll immediately on first iteration, then on ticker
-			first := true
-			for {
-				if !first {
-					select {
-					case <-ctx.Done():
-						_, _ = fmt.Fprintln(out, "\nWatch canceled.")
-						return nil
-					case <-ticker.C:
-					}
-				}
-				first = false
-
-				pipeline, resp, err := client.Pipelines.GetPipeline(project, pipelineID)
-				if err != nil {
-					statusCode := 0
-					if resp != nil {
-						statusCode = resp.StatusCode
-					}
-					return errors.NewAPIError(
-						"GET",
-						fmt.Sprintf("projects/%s/pipelines/%d", project, pipelineID),
-						statusCode,
-						"Failed to get pipeline",
-						err,
-					)
-				}
+			// The command context is canceled on Ctrl-C for graceful shutdown
+			return watchPipeline(cmd.Context(), f, client, project, pipelineID, opts)
+		},
+	}
 
-				jobs, _, err := client.Jobs.ListPipelineJobs(project, pipelineID, nil)
-				if err != nil {
-					// Non-fatal: continue without jobs
-					jobs = nil
-				}
+	cmd.Flags().DurationVarP(&opts.Interval, "interval", "i", 5*time.Second, "Polling interval (e.g. 5s, 10s)")
+	cmd.Flags().BoolVar(&opts.FailFast, "fail-fast", false, "Exit immediately when any job fails")
+	cmd.Flags().StringSliceVar(&opts.JobsOnly, "jobs-only", nil, "Filter displayed/watched jobs by substring match (comma-separated)")
 
-				// Clear screen
-				_, _ = fmt.Fprint(out, "\033[2J\033[H")
+	return cmd
+}
 
-				// Pipeline header
-				_, _ = fmt.Fprintf(out, "Pipeline #%d  %s\n", pipeline.ID, statusColor(pipeline.Status))
-				_, _ = fmt.Fprintf(out, "Ref:       %s\n", pipeline.Ref)
-				_, _ = fmt.Fprintf(out, "Source:    %s\n", pipeline.Source)
-				if pipeline.CreatedAt != nil {
-					_, _ = fmt.Fprintf(out, "Created:   %s\n", pipeline.CreatedAt.Format(time.RFC3339))
-				}
-				if pipeline.Duration > 0 {
-					_, _ = fmt.Fprintf(out, "Duration:  %ds\n", pipeline.Duration)
-				}
-				_, _ = fmt.Fprintln(out)
-
-				// Filter jobs if --jobs-only is set
-				displayJobs := jobs
-				if len(jobsOnly) > 0 && len(jobs) > 0 {
-					var filtered []*gitlab.Job
-					for _, job := range jobs {
-						for _, pattern := range jobsOnly {
-							if strings.Contains(job.Name, pattern) {
-								filtered = append(filtered, job)
-								break
-							}
-						}
-					}
-					displayJobs = filtered
-				}
+// resolvePipelineArg parses the pipeline ID from command args. When no ID is
+// given, it resolves the latest pipeline for the current git branch.
+func resolvePipelineArg(client *api.Client, project string, args []string) (int64, error) {
+	if len(args) > 0 {
+		return parsePipelineArg(args)
+	}
 
-				// Jobs table
-				if len(displayJobs) > 0 {
-					_, _ = fmt.Fprintf(out, "%-30s %-20s %-12s %s\n", "NAME", "STAGE", "STATUS", "DURATION")
-					_, _ = fmt.Fprintf(out, "%-30s %-20s %-12s %s\n", "----", "-----", "------", "--------")
-					for _, job := range displayJobs {
-						duration := ""
-						if job.Duration > 0 {
-							duration = fmt.Sprintf("%.0fs", job.Duration)
-						}
-						_, _ = fmt.Fprintf(out, "%-30s %-20s %-12s %s\n",
-							truncateWatch(job.Name, 30),
-							truncateWatch(job.Stage, 20),
-							statusColor(job.Status),
-							duration,
-						)
-					}
-				}
+	branch, err := gitutil.CurrentBranch()
+	if err != nil {
+		return 0, fmt.Errorf("pipeline ID required: %w", err)
+	}
+	if branch == "HEAD" {
+		return 0, fmt.Errorf("pipeline ID required: not on a branch")
+	}
+	return findLatestPipelineForRef(client, project, branch)
+}
+
+// findLatestPipelineForRef returns the ID of the most recent pipeline for ref.
+func findLatestPipelineForRef(client *api.Client, project, ref string) (int64, error) {
+	orderBy := "id"
+	sortDesc := "desc"
+	pipelines, resp, err := client.Pipelines.ListProjectPipelines(project, &gitlab.ListProjectPipelinesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 1},
+		Ref:         &ref,
+		OrderBy:     &orderBy,
+		Sort:        &sortDesc,
+	})
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := api.APIURL(client.Host()) + "/projects/" + project + "/pipelines"
+		return 0, errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to find pipeline for branch %s", ref), err)
+	}
+	if len(pipelines) == 0 {
+		return 0, fmt.Errorf("no pipelines found for branch %q; specify a pipeline ID", ref)
+	}
+	return pipelines[0].ID, nil
+}
+
+// watchPipeline polls a pipeline until it reaches a terminal status. On a
+// terminal it redraws a stage/job tree on every poll; otherwise it prints a
+// line for each job status change so the output stays readable in logs.
+// It returns an error if the pipeline fails or is canceled.
+func watchPipeline(ctx context.Context, f *cmdutil.Factory, client *api.Client, project string, pipelineID int64, opts pipelineWatchOptions) error {
+	out := f.IOStreams.Out
+	live := f.IOStreams.IsTerminal()
+
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
 
-				// Check for early exit on job failure
-				if failFast {
-					watchedJobs := displayJobs
-					if len(jobsOnly) == 0 {
-						watchedJobs = jobs
-					}
-					for _, job := range watchedJobs {
-						if job.Status == "failed" {
-							_, _ = fmt.Fprintf(out, "\nJob %q failed — exiting (--fail-fast)\n", job.Name)
-							return fmt.Errorf("job %q in pipeline #%d failed", job.Name, pipeline.ID)
-						}
-					}
+	seen := make(map[int64]string)
+	// Poll immediately on first iteration, then on ticker
+	for frame := 0; ; frame++ {
+		if frame > 0 {
+			select {
+			case <-ctx.Done():
+				_, _ = fmt.Fprintln(out, "\nWatch canceled.")
+				return nil
+			case <-ticker.C:
+			}
+		}
+
+		pipeline, resp, err := client.Pipelines.GetPipeline(project, pipelineID, gitlab.WithContext(ctx))
+		if err != nil {
+			if ctx.Err() != nil {
+				_, _ = fmt.Fprintln(out, "\nWatch canceled.")
+				return nil
+			}
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			url := fmt.Sprintf("%s/projects/%s/pipelines/%d", api.APIURL(client.Host()), project, pipelineID)
+			return errors.NewAPIError("GET", url, statusCode, "Failed to get pipeline", err)
+		}
+
+		jobs, err := listAllPipelineJobs(ctx, client, project, pipelineID)
+		if err != nil {
+			// Non-fatal: continue without jobs
+			jobs = nil
+		}
+		jobs = filterWatchedJobs(jobs, opts.JobsOnly)
+
+		if live {
+			// Clear screen
+			_, _ = fmt.Fprint(out, "\033[2J\033[H")
+			renderPipelineHeader(out, pipeline)
+			renderPipelineTree(out, jobs, frame)
+		} else {
+			if frame == 0 {
+				renderPipelineHeader(out, pipeline)
+			}
+			for _, job := range jobs {
+				if seen[job.ID] != job.Status {
+					seen[job.ID] = job.Status
+					_, _ = fmt.Fprintf(out, "%s %s (%s): %s\n", statusIcon(job.Status, 0), job.Name, job.Stage, job.Status)
 				}
+			}
+		}
 
-				if isTerminalStatus(pipeline.Status) {
-					_, _ = fmt.Fprintf(out, "\nPipeline finished with status: %s\n", statusColor(pipeline.Status))
-					if pipeline.Status == "failed" {
-						return fmt.Errorf("pipeline #%d failed", pipeline.ID)
-					}
-					return nil
+		// Check for early exit on job failure
+		if opts.FailFast {
+			for _, job := range jobs {
+				if job.Status == "failed" && !job.AllowFailure {
+					_, _ = fmt.Fprintf(out, "\nJob %q failed — exiting (--fail-fast)\n", job.Name)
+					return fmt.Errorf("job %q in pipeline #%d failed", job.Name, pipeline.ID)
 				}
 			}
-		},
+		}
+
+		if isTerminalStatus(pipeline.Status) {
+			_, _ = fmt.Fprintf(out, "\nPipeline finished with status: %s\n", statusColor(pipeline.Status))
+			switch pipeline.Status {
+			case "failed":
+				return fmt.Errorf("pipeline #%d failed", pipeline.ID)
+			case "canceled":
+				return fmt.Errorf("pipeline #%d was canceled", pipeline.ID)
+			}
+			return nil
+		}
 	}
+}
 
-	cmd.Flags().DurationVarP(&interval, "interval", "i", 5*time.Second, "Polling interval (e.g. 5s, 10s)")
-	cmd.Flags().BoolVar(&failFast, "fail-fast", false, "Exit immediately when any job fails")
-	cmd.Flags().StringSliceVar(&jobsOnly, "jobs-only", nil, "Filter displayed/watched jobs by substring match (comma-separated)")
+// listAllPipelineJobs fetches every job of a pipeline across all pages.
+func listAllPipelineJobs(ctx context.Context, client *api.Client, project string, pipelineID int64) ([]*gitlab.Job, error) {
+	opts := &gitlab.ListJobsOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+	var all []*gitlab.Job
+	for {
+		jobs, resp, err := client.Jobs.ListPipelineJobs(project, pipelineID, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, jobs...)
+		if resp == nil || resp.NextPage == 0 {
+			return all, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
 
-	return cmd
+// filterWatchedJobs keeps the jobs whose names contain one of the patterns.
+func filterWatchedJobs(jobs []*gitlab.Job, patterns []string) []*gitlab.Job {
+	if len(patterns) == 0 {
+		return jobs
+	}
+	var filtered []*gitlab.Job
+	for _, job := range jobs {
+		for _, pattern := range patterns {
+			if strings.Contains(job.Name, pattern) {
+				filtered = append(filtered, job)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+func renderPipelineHeader(out io.Writer, pipeline *gitlab.Pipeline) {
+	_, _ = fmt.Fprintf(out, "Pipeline #%d  %s\n", pipeline.ID, statusColor(pipeline.Status))
+	_, _ = fmt.Fprintf(out, "Ref:       %s\n", pipeline.Ref)
+	_, _ = fmt.Fprintf(out, "Source:    %s\n", pipeline.Source)
+	if pipeline.CreatedAt != nil {
+		_, _ = fmt.Fprintf(out, "Created:   %s\n", pipeline.CreatedAt.Format(time.RFC3339))
+	}
+	if pipeline.Duration > 0 {
+		_, _ = fmt.Fprintf(out, "Duration:  %ds\n", pipeline.Duration)
+	}
+	if pipeline.WebURL != "" {
+		_, _ = fmt.Fprintf(out, "URL:       %s\n", pipeline.WebURL)
+	}
+	_, _ = fmt.Fprintln(out)
+}
+
+// pipelineStage is a stage and its jobs, in pipeline order.
+type pipelineStage struct {
+	Name string
+	Jobs []*gitlab.Job
+}
+
+// groupJobsByStage groups jobs by stage, ordering stages by their first job.
+func groupJobsByStage(jobs []*gitlab.Job) []pipelineStage {
+	sorted := make([]*gitlab.Job, len(jobs))
+	copy(sorted, jobs)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	var stages []pipelineStage
+	index := make(map[string]int)
+	for _, job := range sorted {
+		i, ok := index[job.Stage]
+		if !ok {
+			i = len(stages)
+			index[job.Stage] = i
+			stages = append(stages, pipelineStage{Name: job.Stage})
+		}
+		stages[i].Jobs = append(stages[i].Jobs, job)
+	}
+	return stages
+}
+
+// stageStatus summarizes the status of a stage from its jobs.
+func stageStatus(jobs []*gitlab.Job) string {
+	has := make(map[string]bool)
+	for _, job := range jobs {
+		if job.Status == "failed" && job.AllowFailure {
+			has["success"] = true
+			continue
+		}
+		has[job.Status] = true
+	}
+	for _, status := range []string{"running", "failed", "pending", "created", "preparing", "waiting_for_resource", "scheduled", "canceled", "manual", "success"} {
+		if has[status] {
+			switch status {
+			case "created", "preparing", "waiting_for_resource", "scheduled":
+				return "pending"
+			}
+			return status
+		}
+	}
+	return "skipped"
+}
+
+// renderPipelineTree prints jobs grouped under their stages.
+func renderPipelineTree(out io.Writer, jobs []*gitlab.Job, frame int) {
+	for _, stage := range groupJobsByStage(jobs) {
+		status := stageStatus(stage.Jobs)
+		_, _ = fmt.Fprintf(out, "%s %s\n", colorizeStatus(status, statusIcon(status, frame)), stage.Name)
+		for i, job := range stage.Jobs {
+			branch := "├─"
+			if i == len(stage.Jobs)-1 {
+				branch = "└─"
+			}
+			duration := ""
+			if job.Duration > 0 {
+				duration = fmt.Sprintf("%.0fs", job.Duration)
+			}
+			status := job.Status
+			if status == "failed" && job.AllowFailure {
+				status = "failed (allowed)"
+			}
+			_, _ = fmt.Fprintf(out, "  %s %s %-30s %s %s\n",
+				branch,
+				colorizeStatus(job.Status, statusIcon(job.Status, frame)),
+				truncateWatch(job.Name, 30),
+				colorizeStatus(job.Status, fmt.Sprintf("%-16s", status)),
+				duration,
+			)
+		}
+	}
 }
 
 func truncateWatch(s string, maxLen int) string {
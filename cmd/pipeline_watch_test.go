@@ -1,16 +1,22 @@
 package cmd
 
 import (
+	"bytes"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
 )
 
 func TestPipelineWatchCmd_Structure(t *testing.T) {
 	f := newTestFactory()
 	cmd := newPipelineWatchCmd(f)
 
-	if cmd.Use != "watch <pipeline-id>" {
-		t.Errorf("expected Use to be 'watch <pipeline-id>', got %q", cmd.Use)
+	if cmd.Use != "watch [<id>]" {
+		t.Errorf("expected Use to be 'watch [<id>]', got %q", cmd.Use)
 	}
 
 	if cmd.Short != "Watch a pipeline in real-time" {
@@ -30,15 +36,15 @@ func TestPipelineWatchCmd_Args(t *testing.T) {
 	f := newTestFactory()
 	cmd := newPipelineWatchCmd(f)
 
-	// Should require exactly 1 argument
+	// Should accept at most 1 argument
 	if cmd.Args == nil {
 		t.Fatal("expected Args validator to be set")
 	}
 
-	// Validate that 0 args fails
+	// Validate that 0 args succeeds (latest pipeline for the current branch)
 	err := cmd.Args(cmd, []string{})
-	if err == nil {
-		t.Error("expected error with 0 args")
+	if err != nil {
+		t.Errorf("expected no error with 0 args, got %v", err)
 	}
 
 	// Validate that 1 arg succeeds
@@ -168,6 +174,155 @@ func TestPipelineWatchCmd_IntervalParsing(t *testing.T) {
 	}
 }
 
+func TestPipelineWatch_SuccessPrintsJobChanges(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/pipelines/300/jobs"):
+			cmdtest.JSONResponse(w, 200, []map[string]any{
+				{"id": 2, "name": "unit", "stage": "test", "status": "success"},
+				{"id": 1, "name": "compile", "stage": "build", "status": "success"},
+			})
+		case strings.HasSuffix(r.URL.Path, "/pipelines/300"):
+			cmdtest.JSONResponse(w, 200, cmdtest.FixturePipelineSuccess)
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newPipelineWatchCmd(f.Factory)
+	cmd.SetArgs([]string{"300"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := f.IO.String()
+	if strings.Contains(out, "\033[2J") {
+		t.Error("expected no screen clearing when not attached to a terminal")
+	}
+	for _, want := range []string{"Pipeline #300", "compile (build): success", "unit (test): success", "Pipeline finished with status"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestPipelineWatch_FailedPipelineReturnsError(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/pipelines/301/jobs"):
+			cmdtest.JSONResponse(w, 200, []map[string]any{
+				{"id": 1, "name": "unit", "stage": "test", "status": "failed"},
+			})
+		case strings.HasSuffix(r.URL.Path, "/pipelines/301"):
+			cmdtest.JSONResponse(w, 200, cmdtest.FixturePipelineFailed)
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newPipelineWatchCmd(f.Factory)
+	cmd.SetArgs([]string{"301"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "pipeline #301 failed") {
+		t.Fatalf("expected pipeline failure error, got %v", err)
+	}
+}
+
+func TestFindLatestPipelineForRef(t *testing.T) {
+	var query string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/pipelines") {
+			query = r.URL.RawQuery
+			if r.URL.Query().Get("ref") == "empty" {
+				cmdtest.JSONResponse(w, 200, []any{})
+				return
+			}
+			cmdtest.JSONResponse(w, 200, []any{cmdtest.FixturePipelineRunning})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	client, err := f.Factory.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id, err := findLatestPipelineForRef(client, "test-owner/test-repo", "develop")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != 302 {
+		t.Errorf("expected pipeline 302, got %d", id)
+	}
+	if !strings.Contains(query, "ref=develop") || !strings.Contains(query, "sort=desc") {
+		t.Errorf("unexpected query: %s", query)
+	}
+
+	_, err = findLatestPipelineForRef(client, "test-owner/test-repo", "empty")
+	if err == nil || !strings.Contains(err.Error(), `no pipelines found for branch "empty"`) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestRenderPipelineTree(t *testing.T) {
+	jobs := []*gitlab.Job{
+		{ID: 3, Name: "deploy", Stage: "deploy", Status: "manual"},
+		{ID: 2, Name: "lint", Stage: "test", Status: "failed", AllowFailure: true},
+		{ID: 1, Name: "unit", Stage: "test", Status: "running", Duration: 12},
+		{ID: 0, Name: "compile", Stage: "build", Status: "success"},
+	}
+
+	var buf bytes.Buffer
+	renderPipelineTree(&buf, jobs, 0)
+	out := buf.String()
+
+	build := strings.Index(out, "build\n")
+	test := strings.Index(out, "test\n")
+	deploy := strings.Index(out, "deploy\n")
+	if build < 0 || test < build || deploy < test {
+		t.Fatalf("expected stages in pipeline order, got:\n%s", out)
+	}
+	if !strings.Contains(out, "├─") || !strings.Contains(out, "└─") {
+		t.Errorf("expected tree branches, got:\n%s", out)
+	}
+	if !strings.Contains(out, "failed (allowed)") {
+		t.Errorf("expected allowed failure to be marked, got:\n%s", out)
+	}
+	if !strings.Contains(out, spinnerFrames[0]) || !strings.Contains(out, "12s") {
+		t.Errorf("expected spinner and duration for running job, got:\n%s", out)
+	}
+}
+
+func TestStageStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []string
+		want     string
+	}{
+		{"all success", []string{"success", "success"}, "success"},
+		{"running wins", []string{"success", "running", "failed"}, "running"},
+		{"failed", []string{"success", "failed"}, "failed"},
+		{"created is pending", []string{"success", "created"}, "pending"},
+		{"all skipped", []string{"skipped"}, "skipped"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var jobs []*gitlab.Job
+			for _, s := range tt.statuses {
+				jobs = append(jobs, &gitlab.Job{Status: s})
+			}
+			if got := stageStatus(jobs); got != tt.want {
+				t.Errorf("stageStatus(%v) = %q, want %q", tt.statuses, got, tt.want)
+			}
+		})
+	}
+}
+
 // containsStr checks if s contains substr.
 func containsStr(s, substr string) bool {
 	return len(s) >= len(substr) && searchStr(s, substr)
@@ -3,11 +3,12 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/PhilipKram/gitlab-cli/internal/api"
 	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
 	"github.com/PhilipKram/gitlab-cli/internal/errors"
-	"github.com/PhilipKram/gitlab-cli/internal/tableprinter"
 	"github.com/spf13/cobra"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 )
@@ -17,12 +18,14 @@ func NewProjectCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "project <command>",
 		Short: "Manage projects",
-		Long:  "List and view GitLab projects and groups.",
+		Long:  "List and view GitLab projects and manage their members.",
 	}
 
 	cmd.AddCommand(newProjectListCmd(f))
 	cmd.AddCommand(newProjectViewCmd(f))
 	cmd.AddCommand(newProjectMembersCmd(f))
+	cmd.AddCommand(newProjectMemberCmd(f))
+	cmd.AddCommand(newProjectInvitationsCmd(f))
 
 	return cmd
 }
@@ -99,7 +102,7 @@ func newProjectListCmd(f *cmdutil.Factory) *cobra.Command {
 
 	cmd.Flags().StringVar(&group, "group", "", "Filter by group")
 	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
 	cmd.Flags().StringVar(&search, "search", "", "Search projects")
 
@@ -169,7 +172,7 @@ func newProjectViewCmd(f *cmdutil.Factory) *cobra.Command {
 		},
 	}
 
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
 
 	return cmd
@@ -230,7 +233,7 @@ func newProjectMembersCmd(f *cmdutil.Factory) *cobra.Command {
 				return nil
 			}
 
-			tp := tableprinter.New(f.IOStreams.Out)
+			tp := f.NewTablePrinter()
 			for _, m := range members {
 				tp.AddRow(
 					m.Username,
@@ -268,3 +271,32 @@ func accessLevelName(level gitlab.AccessLevelValue) string {
 		return fmt.Sprintf("Level %d", level)
 	}
 }
+
+// memberAccessLevels lists the access level names accepted for members.
+var memberAccessLevels = []string{"guest", "reporter", "developer", "maintainer", "owner"}
+
+// parseMemberAccessLevel maps a member role name or number to its API value.
+func parseMemberAccessLevel(s string) (gitlab.AccessLevelValue, error) {
+	switch strings.ToLower(s) {
+	case "minimal", "minimal-access", "minimal_access":
+		return gitlab.MinimalAccessPermissions, nil
+	case "guest":
+		return gitlab.GuestPermissions, nil
+	case "reporter":
+		return gitlab.ReporterPermissions, nil
+	case "developer":
+		return gitlab.DeveloperPermissions, nil
+	case "maintainer":
+		return gitlab.MaintainerPermissions, nil
+	case "owner":
+		return gitlab.OwnerPermissions, nil
+	}
+	if n, err := strconv.Atoi(s); err == nil {
+		switch level := gitlab.AccessLevelValue(n); level {
+		case gitlab.MinimalAccessPermissions, gitlab.GuestPermissions, gitlab.ReporterPermissions,
+			gitlab.DeveloperPermissions, gitlab.MaintainerPermissions, gitlab.OwnerPermissions:
+			return level, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown access level %q: must be one of %s", s, strings.Join(memberAccessLevels, ", "))
+}
@@ -0,0 +1,291 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func newProjectMemberCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "member <command>",
+		Short: "Add, update, and remove project members",
+		Long: `Manage the direct members of the current project.
+
+Use "glab project members" to list members, including inherited ones.`,
+	}
+
+	cmd.AddCommand(newProjectMemberAddCmd(f))
+	cmd.AddCommand(newProjectMemberUpdateCmd(f))
+	cmd.AddCommand(newProjectMemberRemoveCmd(f))
+
+	return cmd
+}
+
+func newProjectMemberAddCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		accessLevel string
+		expiresAt   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "add <username>...",
+		Short: "Add members to the project",
+		Example: `  $ glab project member add alice --access-level developer
+  $ glab project member add bob carol --access-level reporter --expires-at 2025-12-31
+  $ glab project member add dave --access-level maintainer -R my-group/my-project`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			level, err := parseMemberAccessLevel(accessLevel)
+			if err != nil {
+				return err
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			ids, err := resolveUserIDs(client, args)
+			if err != nil {
+				return err
+			}
+
+			for i, id := range ids {
+				opts := &gitlab.AddProjectMemberOptions{
+					UserID:      id,
+					AccessLevel: &level,
+				}
+				if expiresAt != "" {
+					opts.ExpiresAt = &expiresAt
+				}
+				_, resp, err := client.ProjectMembers.AddProjectMember(project, opts, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/projects/" + project + "/members"
+					return errors.NewAPIError("POST", url, statusCode, "Failed to add "+args[i]+" to project", err)
+				}
+				_, _ = fmt.Fprintf(f.IOStreams.Out, "Added %s to %s as %s\n", strings.TrimPrefix(args[i], "@"), project, accessLevelName(level))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&accessLevel, "access-level", "a", "", "Access level: "+strings.Join(memberAccessLevels, ", ")+" (required)")
+	cmd.Flags().StringVar(&expiresAt, "expires-at", "", "Date the membership expires (YYYY-MM-DD)")
+	_ = cmd.MarkFlagRequired("access-level")
+
+	return cmd
+}
+
+func newProjectMemberUpdateCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		accessLevel string
+		expiresAt   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "update <username>...",
+		Short: "Change the access level or expiry of project members",
+		Example: `  $ glab project member update alice --access-level maintainer
+  $ glab project member update bob --expires-at 2026-06-30`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if accessLevel == "" && expiresAt == "" {
+				return fmt.Errorf("nothing to update: use --access-level or --expires-at")
+			}
+
+			opts := &gitlab.EditProjectMemberOptions{}
+			if accessLevel != "" {
+				level, err := parseMemberAccessLevel(accessLevel)
+				if err != nil {
+					return err
+				}
+				opts.AccessLevel = &level
+			}
+			if expiresAt != "" {
+				opts.ExpiresAt = &expiresAt
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			ids, err := resolveUserIDs(client, args)
+			if err != nil {
+				return err
+			}
+
+			for i, id := range ids {
+				member, resp, err := client.ProjectMembers.EditProjectMember(project, id, opts, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/members/%d", api.APIURL(client.Host()), project, id)
+					return errors.NewAPIError("PUT", url, statusCode, "Failed to update "+args[i], err)
+				}
+				_, _ = fmt.Fprintf(f.IOStreams.Out, "Updated %s in %s: %s\n", member.Username, project, accessLevelName(member.AccessLevel))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&accessLevel, "access-level", "a", "", "New access level: "+strings.Join(memberAccessLevels, ", "))
+	cmd.Flags().StringVar(&expiresAt, "expires-at", "", "New date the membership expires (YYYY-MM-DD)")
+
+	return cmd
+}
+
+func newProjectMemberRemoveCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove <username>...",
+		Short: "Remove members from the project",
+		Example: `  $ glab project member remove alice
+  $ glab project member remove bob carol -R my-group/my-project`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			ids, err := resolveUserIDs(client, args)
+			if err != nil {
+				return err
+			}
+
+			for i, id := range ids {
+				resp, err := client.ProjectMembers.DeleteProjectMember(project, id, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/members/%d", api.APIURL(client.Host()), project, id)
+					return errors.NewAPIError("DELETE", url, statusCode, "Failed to remove "+args[i]+" from project", err)
+				}
+				_, _ = fmt.Fprintf(f.IOStreams.Out, "Removed %s from %s\n", strings.TrimPrefix(args[i], "@"), project)
+			}
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newProjectInvitationsCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invitations <command>",
+		Short: "Manage pending project invitations",
+	}
+
+	cmd.AddCommand(newProjectInvitationsListCmd(f))
+
+	return cmd
+}
+
+func newProjectInvitationsListCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		query    string
+		limit    int
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List pending invitations to the project",
+		Aliases: []string{"ls"},
+		Example: `  $ glab project invitations list
+  $ glab project invitations list --query example.com --format json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			opts := &gitlab.ListPendingInvitationsOptions{
+				ListOptions: gitlab.ListOptions{PerPage: int64(limit)},
+			}
+			if query != "" {
+				opts.Query = &query
+			}
+
+			invites, resp, err := client.Invites.ListPendingProjectInvitations(project, opts, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + project + "/invitations"
+				return errors.NewAPIError("GET", url, statusCode, "Failed to list invitations", err)
+			}
+
+			if len(invites) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No pending invitations")
+				return nil
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(invites, format, jsonFlag)
+			}
+
+			tp := f.NewTablePrinter()
+			for _, inv := range invites {
+				invitee := inv.InviteEmail
+				if invitee == "" {
+					invitee = inv.UserName
+				}
+				invitedBy := ""
+				if inv.CreatedByName != "" {
+					invitedBy = "by " + inv.CreatedByName
+				}
+				expires := ""
+				if inv.ExpiresAt != nil {
+					expires = "expires " + inv.ExpiresAt.Format("2006-01-02")
+				}
+				tp.AddRow(invitee, accessLevelName(inv.AccessLevel), invitedBy, timeAgo(inv.CreatedAt), expires)
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().StringVarP(&query, "query", "q", "", "Filter invitations by email")
+	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
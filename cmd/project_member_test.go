@@ -0,0 +1,201 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestProjectMemberCmd_HasSubcommands(t *testing.T) {
+	f := newTestFactory()
+	cmd := newProjectMemberCmd(f)
+
+	expectedSubcommands := []string{
+		"add",
+		"update",
+		"remove",
+	}
+
+	subcommands := cmd.Commands()
+	if len(subcommands) != len(expectedSubcommands) {
+		t.Errorf("expected %d subcommands, got %d", len(expectedSubcommands), len(subcommands))
+	}
+
+	foundSubcommands := make(map[string]bool)
+	for _, subcmd := range subcommands {
+		foundSubcommands[subcmd.Name()] = true
+	}
+
+	for _, expected := range expectedSubcommands {
+		if !foundSubcommands[expected] {
+			t.Errorf("expected subcommand %q not found", expected)
+		}
+	}
+}
+
+func TestProjectMemberAdd(t *testing.T) {
+	var bodies []map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v4/users":
+			id := map[string]int{"alice": 1, "bob": 2}[r.URL.Query().Get("username")]
+			cmdtest.JSONResponse(w, 200, []any{map[string]any{"id": id, "username": r.URL.Query().Get("username")}})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/members":
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			bodies = append(bodies, body)
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": body["user_id"], "access_level": body["access_level"]})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newProjectMemberAddCmd(f.Factory)
+	cmd.SetArgs([]string{"alice", "bob", "--access-level", "reporter"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(bodies) != 2 || bodies[0]["user_id"] != float64(1) || bodies[1]["user_id"] != float64(2) || bodies[1]["access_level"] != float64(20) {
+		t.Errorf("unexpected requests: %v", bodies)
+	}
+	if !strings.Contains(f.IO.String(), "Added bob to test-owner/test-repo as Reporter") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestProjectMemberUpdate(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v4/users":
+			cmdtest.JSONResponse(w, 200, []any{map[string]any{"id": 1, "username": "alice"}})
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v4/projects/test-owner/test-repo/members/1":
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 1, "username": "alice", "access_level": 40})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newProjectMemberUpdateCmd(f.Factory)
+	cmd.SetArgs([]string{"alice", "--access-level", "maintainer"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["access_level"] != float64(40) {
+		t.Errorf("unexpected request body: %v", body)
+	}
+	if _, ok := body["expires_at"]; ok {
+		t.Errorf("expected expires_at to be omitted, got %v", body)
+	}
+	if !strings.Contains(f.IO.String(), "Updated alice in test-owner/test-repo: Maintainer") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestProjectMemberUpdate_NothingToUpdate(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newProjectMemberUpdateCmd(f.Factory)
+	cmd.SetArgs([]string{"alice"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "nothing to update") {
+		t.Errorf("expected nothing to update error, got %v", err)
+	}
+}
+
+func TestProjectMemberRemove_UnknownUser(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/users" {
+			cmdtest.JSONResponse(w, 200, []any{})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newProjectMemberRemoveCmd(f.Factory)
+	cmd.SetArgs([]string{"ghost"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "user not found: ghost") {
+		t.Errorf("expected user not found error, got %v", err)
+	}
+}
+
+func TestProjectMemberRemove(t *testing.T) {
+	removed := false
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v4/users":
+			cmdtest.JSONResponse(w, 200, []any{map[string]any{"id": 5, "username": "alice"}})
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v4/projects/test-owner/test-repo/members/5":
+			removed = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newProjectMemberRemoveCmd(f.Factory)
+	cmd.SetArgs([]string{"alice"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !removed {
+		t.Error("expected the member to be removed")
+	}
+}
+
+func TestProjectInvitationsList(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/projects/test-owner/test-repo/invitations" {
+			cmdtest.JSONResponse(w, 200, []any{
+				map[string]any{
+					"id": 1, "invite_email": "new@example.com", "access_level": 30,
+					"created_by_name": "Alice", "created_at": "2024-01-01T00:00:00Z", "expires_at": "2024-02-01T00:00:00Z",
+				},
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newProjectInvitationsListCmd(f.Factory)
+	cmd.SetArgs([]string{})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := f.IO.String()
+	for _, want := range []string{"new@example.com", "Developer", "by Alice", "expires 2024-02-01"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got %q", want, out)
+		}
+	}
+}
+
+func TestProjectInvitationsList_Empty(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		cmdtest.JSONResponse(w, 200, []any{})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newProjectInvitationsListCmd(f.Factory)
+	cmd.SetArgs([]string{})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(f.IO.ErrString(), "No pending invitations") {
+		t.Errorf("unexpected stderr: %q", f.IO.ErrString())
+	}
+}
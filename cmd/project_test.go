@@ -30,6 +30,8 @@ func TestProjectCmd_HasSubcommands(t *testing.T) {
 		"list",
 		"view",
 		"members",
+		"member",
+		"invitations",
 	}
 
 	subcommands := cmd.Commands()
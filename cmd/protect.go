@@ -0,0 +1,334 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// NewProtectCmd creates the protect command group.
+func NewProtectCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "protect <command>",
+		Short: "Manage protected branches, tags, and environments",
+		Long:  "Protect branches, tags, and environments, and list a project's protection rules.",
+	}
+
+	branch := newBranchProtectCmd(f)
+	branch.Use = "branch <branch>"
+	branch.Example = `  $ glab protect branch main --push-access-level no-access --merge-access-level developer
+  $ glab protect branch "release/*" --code-owner-approval`
+
+	tag := newTagProtectCmd(f)
+	tag.Use = "tag <pattern>"
+	tag.Example = `  $ glab protect tag "v*"
+  $ glab protect tag "release-*" --create-access-level developer`
+
+	cmd.AddCommand(branch)
+	cmd.AddCommand(tag)
+	cmd.AddCommand(newProtectEnvironmentCmd(f))
+	cmd.AddCommand(newProtectListCmd(f))
+
+	return cmd
+}
+
+// NewUnprotectCmd creates the unprotect command group.
+func NewUnprotectCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unprotect <command>",
+		Short: "Remove protection from branches, tags, and environments",
+	}
+
+	branch := newBranchUnprotectCmd(f)
+	branch.Use = "branch <branch>"
+	branch.Example = `  $ glab unprotect branch "release/*"`
+
+	tag := newTagUnprotectCmd(f)
+	tag.Use = "tag <pattern>"
+	tag.Example = `  $ glab unprotect tag "v*"`
+
+	cmd.AddCommand(branch)
+	cmd.AddCommand(tag)
+	cmd.AddCommand(newUnprotectEnvironmentCmd(f))
+
+	return cmd
+}
+
+func newProtectEnvironmentCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		deployLevels      []string
+		requiredApprovals int
+	)
+
+	cmd := &cobra.Command{
+		Use:     "environment <name>",
+		Short:   "Protect an environment",
+		Aliases: []string{"env"},
+		Long: `Protect an environment, or a wildcard pattern such as "review/*", so that
+only users with the given access level can deploy to it.
+
+Access levels can be one of: developer, maintainer, or admin. Defaults to
+maintainer. Repeat --deploy-access-level to allow several levels.
+
+Protected environments require GitLab Premium.`,
+		Example: `  $ glab protect environment production
+  $ glab protect environment staging --deploy-access-level developer
+  $ glab protect environment production --required-approvals 2`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if requiredApprovals < 0 {
+				return fmt.Errorf("--required-approvals cannot be negative")
+			}
+
+			access := make([]*gitlab.EnvironmentAccessOptions, 0, len(deployLevels))
+			for _, l := range deployLevels {
+				level, err := parseAccessLevel(l)
+				if err != nil {
+					return fmt.Errorf("invalid --deploy-access-level: %w", err)
+				}
+				access = append(access, &gitlab.EnvironmentAccessOptions{AccessLevel: gitlab.Ptr(level)})
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			name := args[0]
+			opts := &gitlab.ProtectRepositoryEnvironmentsOptions{
+				Name:               &name,
+				DeployAccessLevels: &access,
+			}
+			if cmd.Flags().Changed("required-approvals") {
+				opts.RequiredApprovalCount = gitlab.Ptr(int64(requiredApprovals))
+			}
+
+			pe, resp, err := client.ProtectedEnvironments.ProtectRepositoryEnvironments(project, opts, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + project + "/protected_environments"
+				return errors.NewAPIError("POST", url, statusCode, "Failed to protect environment", err)
+			}
+
+			out := f.IOStreams.Out
+			_, _ = fmt.Fprintf(out, "Protected environment %q\n", pe.Name)
+			_, _ = fmt.Fprintf(out, "Deploy: %s\n", environmentAccessDescription(pe.DeployAccessLevels))
+			if pe.RequiredApprovalCount > 0 {
+				_, _ = fmt.Fprintf(out, "Required approvals: %d\n", pe.RequiredApprovalCount)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&deployLevels, "deploy-access-level", []string{"maintainer"}, "Access level allowed to deploy: developer, maintainer, or admin")
+	cmd.Flags().IntVar(&requiredApprovals, "required-approvals", 0, "Number of approvals required before deploying")
+
+	return cmd
+}
+
+func newUnprotectEnvironmentCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "environment <name>",
+		Short:   "Remove protection from an environment",
+		Aliases: []string{"env"},
+		Example: `  $ glab unprotect environment staging`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			name := args[0]
+
+			resp, err := client.ProtectedEnvironments.UnprotectEnvironment(project, name, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + project + "/protected_environments/" + name
+				return errors.NewAPIError("DELETE", url, statusCode, "Failed to unprotect environment", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Unprotected environment %q\n", name)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// protectionRule is a single row of "glab protect list".
+type protectionRule struct {
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Access string `json:"access"`
+}
+
+func newProtectListCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		ruleType string
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List protected branches, tags, and environments",
+		Aliases: []string{"ls"},
+		Long: `List the protection rules of a project.
+
+Protected environments are skipped when they are not available on the
+project's GitLab tier, unless --type environment is given.`,
+		Example: `  $ glab protect list
+  $ glab protect list --type tag
+  $ glab protect list --format json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch ruleType {
+			case "", "branch", "tag", "environment":
+			default:
+				return fmt.Errorf("invalid --type %q: must be branch, tag, or environment", ruleType)
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			baseURL := api.APIURL(client.Host()) + "/projects/" + project
+			listOpts := gitlab.ListOptions{PerPage: 100}
+			var rules []protectionRule
+
+			if ruleType == "" || ruleType == "branch" {
+				branches, resp, err := client.ProtectedBranches.ListProtectedBranches(project, &gitlab.ListProtectedBranchesOptions{ListOptions: listOpts}, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					return errors.NewAPIError("GET", baseURL+"/protected_branches", statusCode, "Failed to list protected branches", err)
+				}
+				for _, b := range branches {
+					access := fmt.Sprintf("push: %s; merge: %s", branchAccessDescription(b.PushAccessLevels), branchAccessDescription(b.MergeAccessLevels))
+					rules = append(rules, protectionRule{Type: "branch", Name: b.Name, Access: access})
+				}
+			}
+
+			if ruleType == "" || ruleType == "tag" {
+				tags, resp, err := client.ProtectedTags.ListProtectedTags(project, &gitlab.ListProtectedTagsOptions{ListOptions: listOpts}, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					return errors.NewAPIError("GET", baseURL+"/protected_tags", statusCode, "Failed to list protected tags", err)
+				}
+				for _, t := range tags {
+					rules = append(rules, protectionRule{Type: "tag", Name: t.Name, Access: "create: " + tagAccessDescription(t.CreateAccessLevels)})
+				}
+			}
+
+			if ruleType == "" || ruleType == "environment" {
+				envs, resp, err := client.ProtectedEnvironments.ListProtectedEnvironments(project, &gitlab.ListProtectedEnvironmentsOptions{ListOptions: listOpts}, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					// Protected environments are a Premium feature.
+					unavailable := statusCode == http.StatusForbidden || statusCode == http.StatusNotFound
+					if ruleType == "environment" || !unavailable {
+						return errors.NewAPIError("GET", baseURL+"/protected_environments", statusCode, "Failed to list protected environments", err)
+					}
+				}
+				for _, e := range envs {
+					access := "deploy: " + environmentAccessDescription(e.DeployAccessLevels)
+					if e.RequiredApprovalCount > 0 {
+						access += fmt.Sprintf("; approvals: %d", e.RequiredApprovalCount)
+					}
+					rules = append(rules, protectionRule{Type: "environment", Name: e.Name, Access: access})
+				}
+			}
+
+			if len(rules) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No protection rules found")
+				return nil
+			}
+
+			if jsonFlag || f.ExportRequested() || (format != "" && format != "table") {
+				return f.FormatAndPrint(rules, format, jsonFlag)
+			}
+
+			tp := f.NewTablePrinter()
+			tp.SetHeader("TYPE", "NAME", "ACCESS")
+			for _, r := range rules {
+				tp.AddRow(r.Type, r.Name, r.Access)
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().StringVarP(&ruleType, "type", "t", "", "Only list rules of this type: branch, tag, or environment")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
+
+	return cmd
+}
+
+// tagAccessDescription renders the create access levels of a protected tag rule.
+func tagAccessDescription(levels []*gitlab.TagAccessDescription) string {
+	if len(levels) == 0 {
+		return "No one"
+	}
+	names := make([]string, len(levels))
+	for i, l := range levels {
+		names[i] = l.AccessLevelDescription
+		if names[i] == "" {
+			names[i] = accessLevelName(l.AccessLevel)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// environmentAccessDescription renders the deploy access levels of a
+// protected environment.
+func environmentAccessDescription(levels []*gitlab.EnvironmentAccessDescription) string {
+	if len(levels) == 0 {
+		return "No one"
+	}
+	names := make([]string, len(levels))
+	for i, l := range levels {
+		names[i] = l.AccessLevelDescription
+		if names[i] == "" {
+			names[i] = accessLevelName(l.AccessLevel)
+		}
+	}
+	return strings.Join(names, ", ")
+}
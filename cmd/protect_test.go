@@ -0,0 +1,202 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+	"github.com/spf13/cobra"
+)
+
+func TestProtectCmd_HasSubcommands(t *testing.T) {
+	f := newTestFactory()
+
+	tests := []struct {
+		name string
+		cmd  *cobra.Command
+		want []string
+	}{
+		{"protect", NewProtectCmd(f), []string{"branch", "environment", "list", "tag"}},
+		{"unprotect", NewUnprotectCmd(f), []string{"branch", "environment", "tag"}},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, c := range tt.cmd.Commands() {
+			got = append(got, c.Name())
+		}
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("%s subcommands = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestProtectEnvironment(t *testing.T) {
+	var body map[string]interface{}
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || !strings.HasSuffix(r.URL.Path, "/protected_environments") {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		cmdtest.JSONResponse(w, 201, map[string]interface{}{
+			"name": "production",
+			"deploy_access_levels": []map[string]interface{}{
+				{"access_level": 30, "access_level_description": "Developers + Maintainers"},
+			},
+			"required_approval_count": 2,
+		})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewProtectCmd(f.Factory)
+	cmd.SetArgs([]string{"environment", "production", "--deploy-access-level", "developer", "--required-approvals", "2"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	levels, _ := body["deploy_access_levels"].([]interface{})
+	if len(levels) != 1 || levels[0].(map[string]interface{})["access_level"] != float64(30) {
+		t.Errorf("unexpected deploy_access_levels: %v", body["deploy_access_levels"])
+	}
+	if body["required_approval_count"] != float64(2) {
+		t.Errorf("unexpected required_approval_count: %v", body["required_approval_count"])
+	}
+	out := f.IO.String()
+	for _, want := range []string{`Protected environment "production"`, "Deploy: Developers + Maintainers", "Required approvals: 2"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q: %s", want, out)
+		}
+	}
+}
+
+func TestUnprotectEnvironment(t *testing.T) {
+	var method, path string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewUnprotectCmd(f.Factory)
+	cmd.SetArgs([]string{"environment", "staging"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if method != "DELETE" || !strings.HasSuffix(path, "/protected_environments/staging") {
+		t.Errorf("unexpected request: %s %s", method, path)
+	}
+	if !strings.Contains(f.IO.String(), `Unprotected environment "staging"`) {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestProtectTag_ViaProtectCmd(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		cmdtest.JSONResponse(w, 201, map[string]interface{}{
+			"name":                 "v*",
+			"create_access_levels": []map[string]interface{}{{"access_level": 40}},
+		})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewProtectCmd(f.Factory)
+	cmd.SetArgs([]string{"tag", "v*"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(f.IO.String(), "Create: Maintainer") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestProtectList(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		envStatus  int
+		wantErr    bool
+		wantOut    []string
+		notWantOut []string
+	}{
+		{
+			name:      "all",
+			envStatus: 200,
+			wantOut:   []string{"branch main push: Maintainers; merge: Developers", "tag v* create: Maintainers", "environment production deploy: Maintainers; approvals: 1"},
+		},
+		{
+			name:       "environments unavailable",
+			envStatus:  403,
+			wantOut:    []string{"branch main", "tag v*"},
+			notWantOut: []string{"environment"},
+		},
+		{
+			name:      "environments unavailable with --type",
+			args:      []string{"--type", "environment"},
+			envStatus: 403,
+			wantErr:   true,
+		},
+		{
+			name:       "tags only",
+			args:       []string{"--type", "tag"},
+			envStatus:  200,
+			wantOut:    []string{"tag v*"},
+			notWantOut: []string{"branch", "environment"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case strings.HasSuffix(r.URL.Path, "/protected_branches"):
+					cmdtest.JSONResponse(w, 200, []map[string]interface{}{{
+						"name":                "main",
+						"push_access_levels":  []map[string]interface{}{{"access_level_description": "Maintainers"}},
+						"merge_access_levels": []map[string]interface{}{{"access_level_description": "Developers"}},
+					}})
+				case strings.HasSuffix(r.URL.Path, "/protected_tags"):
+					cmdtest.JSONResponse(w, 200, []map[string]interface{}{{
+						"name":                 "v*",
+						"create_access_levels": []map[string]interface{}{{"access_level_description": "Maintainers"}},
+					}})
+				case strings.HasSuffix(r.URL.Path, "/protected_environments"):
+					if tt.envStatus != 200 {
+						cmdtest.ErrorResponse(w, tt.envStatus, "403 Forbidden")
+						return
+					}
+					cmdtest.JSONResponse(w, 200, []map[string]interface{}{{
+						"name":                    "production",
+						"deploy_access_levels":    []map[string]interface{}{{"access_level_description": "Maintainers"}},
+						"required_approval_count": 1,
+					}})
+				default:
+					t.Errorf("unexpected path: %s", r.URL.Path)
+				}
+			})
+
+			f := cmdtest.NewTestFactory(t)
+			cmd := newProtectListCmd(f.Factory)
+			cmd.SetArgs(tt.args)
+
+			err := cmd.Execute()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			out := strings.Join(strings.Fields(f.IO.String()), " ")
+			for _, want := range tt.wantOut {
+				if !strings.Contains(out, want) {
+					t.Errorf("output missing %q: %s", want, out)
+				}
+			}
+			for _, notWant := range tt.notWantOut {
+				if strings.Contains(out, notWant) {
+					t.Errorf("output should not contain %q: %s", notWant, out)
+				}
+			}
+		})
+	}
+}
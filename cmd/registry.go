@@ -2,12 +2,15 @@ package cmd
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/PhilipKram/gitlab-cli/internal/api"
 	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
 	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/PhilipKram/gitlab-cli/internal/prompt"
 	"github.com/spf13/cobra"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 )
@@ -24,6 +27,8 @@ func NewRegistryCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(newRegistryTagsCmd(f))
 	cmd.AddCommand(newRegistryViewCmd(f))
 	cmd.AddCommand(newRegistryDeleteCmd(f))
+	cmd.AddCommand(newRegistryDeleteTagCmd(f))
+	cmd.AddCommand(newRegistryCleanupCmd(f))
 
 	return cmd
 }
@@ -83,7 +88,7 @@ func newRegistryListCmd(f *cmdutil.Factory) *cobra.Command {
 
 	cmd.Flags().StringVar(&project, "project", "", "Project to list repositories from (uses current project if not specified)")
 	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
 
 	return cmd
@@ -150,7 +155,7 @@ func newRegistryTagsCmd(f *cmdutil.Factory) *cobra.Command {
 
 	cmd.Flags().StringVar(&project, "project", "", "Project to list tags from (uses current project if not specified)")
 	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
 
 	return cmd
@@ -285,7 +290,7 @@ func newRegistryViewCmd(f *cmdutil.Factory) *cobra.Command {
 
 	cmd.Flags().StringVar(&tag, "tag", "", "View specific tag details")
 	cmd.Flags().StringVar(&project, "project", "", "Project to get tag from (uses current project if not specified, required with --tag)")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
 
 	return cmd
@@ -464,6 +469,197 @@ func newRegistryDeleteCmd(f *cmdutil.Factory) *cobra.Command {
 	return cmd
 }
 
+func newRegistryDeleteTagCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		yes     bool
+		project string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "delete-tag <repository-id> <tag>...",
+		Short: "Delete one or more image tags",
+		Example: `  $ glab registry delete-tag 123 v1.0.0
+  $ glab registry delete-tag 123 dev-1 dev-2 dev-3 --yes`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			repositoryIDStr := args[0]
+			repositoryID, err := strconv.ParseInt(repositoryIDStr, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid repository ID: %s", repositoryIDStr)
+			}
+			tags := args[1:]
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			projectPath := project
+			if projectPath == "" {
+				projectPath, err = f.FullProjectPath()
+				if err != nil {
+					return err
+				}
+			}
+
+			if !yes {
+				confirmed, err := prompt.Confirm(f.IOStreams.In, f.IOStreams.ErrOut,
+					fmt.Sprintf("Delete %d tag(s) from repository %s: %s?", len(tags), repositoryIDStr, strings.Join(tags, ", ")), false)
+				if err != nil {
+					return err
+				}
+				if !confirmed {
+					_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "Deletion cancelled")
+					return nil
+				}
+			}
+
+			out := f.IOStreams.Out
+			failed := 0
+			for _, tag := range tags {
+				resp, err := client.ContainerRegistry.DeleteRegistryRepositoryTag(projectPath, repositoryID, tag)
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/projects/" + projectPath + "/registry/repositories/" + repositoryIDStr + "/tags/" + tag
+					apiErr := errors.NewAPIError("DELETE", url, statusCode, fmt.Sprintf("Failed to delete tag '%s'", tag), err)
+					if len(tags) == 1 {
+						return apiErr
+					}
+					_, _ = fmt.Fprintln(f.IOStreams.ErrOut, apiErr.Error())
+					failed++
+					continue
+				}
+				_, _ = fmt.Fprintf(out, "Deleted tag '%s'\n", tag)
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("failed to delete %d of %d tag(s)", failed, len(tags))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Skip confirmation prompt")
+	cmd.Flags().StringVar(&project, "project", "", "Project to delete tags from (uses current project if not specified)")
+
+	return cmd
+}
+
+func newRegistryCleanupCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		nameRegex string
+		keepRegex string
+		keepN     int64
+		olderThan string
+		yes       bool
+		project   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "cleanup <repository-id>",
+		Short: "Delete image tags in bulk",
+		Long: `Delete image tags in bulk using the registry's delete-in-bulk API.
+
+Tags whose names match --name-regex are deleted, except those that match
+--keep-regex, the --keep-n most recent tags, and tags newer than --older-than.
+The deletion runs asynchronously on the GitLab server, and GitLab allows it
+at most once per hour for each repository.`,
+		Example: `  $ glab registry cleanup 123 --name-regex '.*' --keep-n 5
+  $ glab registry cleanup 123 --name-regex 'dev-.*' --older-than 7d --yes
+  $ glab registry cleanup 123 --name-regex '.*' --keep-regex '^(main|v\d+\.\d+\.\d+)$' --older-than 1month`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			repositoryIDStr := args[0]
+			repositoryID, err := strconv.ParseInt(repositoryIDStr, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid repository ID: %s", repositoryIDStr)
+			}
+
+			if nameRegex == "" {
+				return fmt.Errorf("--name-regex is required; use '.*' to match all tags")
+			}
+			if _, err := regexp.Compile(nameRegex); err != nil {
+				return fmt.Errorf("invalid --name-regex: %w", err)
+			}
+			if keepRegex != "" {
+				if _, err := regexp.Compile(keepRegex); err != nil {
+					return fmt.Errorf("invalid --keep-regex: %w", err)
+				}
+			}
+			if keepN < 0 {
+				return fmt.Errorf("--keep-n must not be negative")
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			projectPath := project
+			if projectPath == "" {
+				projectPath, err = f.FullProjectPath()
+				if err != nil {
+					return err
+				}
+			}
+
+			opts := &gitlab.DeleteRegistryRepositoryTagsOptions{
+				NameRegexpDelete: &nameRegex,
+			}
+			criteria := []string{fmt.Sprintf("matching %q", nameRegex)}
+			if keepRegex != "" {
+				opts.NameRegexpKeep = &keepRegex
+				criteria = append(criteria, fmt.Sprintf("except those matching %q", keepRegex))
+			}
+			if cmd.Flags().Changed("keep-n") {
+				opts.KeepN = &keepN
+				criteria = append(criteria, fmt.Sprintf("keeping the %d most recent", keepN))
+			}
+			if olderThan != "" {
+				opts.OlderThan = &olderThan
+				criteria = append(criteria, "older than "+olderThan)
+			}
+
+			if !yes {
+				confirmed, err := prompt.Confirm(f.IOStreams.In, f.IOStreams.ErrOut,
+					fmt.Sprintf("Delete tags in repository %s %s?", repositoryIDStr, strings.Join(criteria, ", ")), false)
+				if err != nil {
+					return err
+				}
+				if !confirmed {
+					_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "Cleanup cancelled")
+					return nil
+				}
+			}
+
+			resp, err := client.ContainerRegistry.DeleteRegistryRepositoryTags(projectPath, repositoryID, opts)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + projectPath + "/registry/repositories/" + repositoryIDStr + "/tags"
+				return errors.NewAPIError("DELETE", url, statusCode, "Failed to schedule tag cleanup", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Scheduled cleanup of tags in repository %s %s\n", repositoryIDStr, strings.Join(criteria, ", "))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&nameRegex, "name-regex", "", "Delete tags whose names match this regex (required)")
+	cmd.Flags().StringVar(&keepRegex, "keep-regex", "", "Keep tags whose names match this regex")
+	cmd.Flags().Int64Var(&keepN, "keep-n", 0, "Keep this many of the most recent matching tags")
+	cmd.Flags().StringVar(&olderThan, "older-than", "", "Only delete tags older than this (e.g., '7d', '1month', '24h')")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Skip confirmation prompt")
+	cmd.Flags().StringVar(&project, "project", "", "Project that owns the repository (uses current project if not specified)")
+
+	return cmd
+}
+
 // parseDuration parses a duration string like "30d", "7d", "24h" into a time.Duration
 func parseDuration(s string) (time.Duration, error) {
 	if len(s) < 2 {
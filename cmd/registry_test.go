@@ -32,6 +32,8 @@ func TestRegistryCmd_HasSubcommands(t *testing.T) {
 		"tags",
 		"view",
 		"delete",
+		"delete-tag",
+		"cleanup",
 	}
 
 	subcommands := cmd.Commands()
@@ -517,3 +519,107 @@ var fixtureRegistryTagDetail = map[string]interface{}{
 	"digest":     "sha256:abc123def456",
 	"total_size": 123456789,
 }
+
+func TestRegistryDeleteTag_Multiple(t *testing.T) {
+	var deleted []string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			cmdtest.ErrorResponse(w, 404, "not found")
+			return
+		}
+		deleted = append(deleted, r.URL.Path)
+		w.WriteHeader(200)
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRegistryDeleteTagCmd(f.Factory)
+	cmd.SetArgs([]string{"123", "dev-1", "dev-2", "--yes"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{
+		"/api/v4/projects/test-owner/test-repo/registry/repositories/123/tags/dev-1",
+		"/api/v4/projects/test-owner/test-repo/registry/repositories/123/tags/dev-2",
+	}
+	if strings.Join(deleted, ",") != strings.Join(want, ",") {
+		t.Errorf("unexpected deletions: %v", deleted)
+	}
+}
+
+func TestRegistryDeleteTag_Cancelled(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	f.IO.In.WriteString("n\n")
+	cmd := newRegistryDeleteTagCmd(f.Factory)
+	cmd.SetArgs([]string{"123", "latest"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(f.IO.ErrString(), "Deletion cancelled") {
+		t.Errorf("expected cancellation message, got %q", f.IO.ErrString())
+	}
+}
+
+func TestRegistryCleanup(t *testing.T) {
+	var method, path string
+	var query map[string][]string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		query = r.URL.Query()
+		w.WriteHeader(202)
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRegistryCleanupCmd(f.Factory)
+	cmd.SetArgs([]string{"123", "--name-regex", ".*", "--keep-regex", "^main$", "--keep-n", "5", "--older-than", "7d", "--yes"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if method != http.MethodDelete || path != "/api/v4/projects/test-owner/test-repo/registry/repositories/123/tags" {
+		t.Errorf("unexpected request: %s %s", method, path)
+	}
+	want := map[string]string{
+		"name_regex_delete": ".*",
+		"name_regex_keep":   "^main$",
+		"keep_n":            "5",
+		"older_than":        "7d",
+	}
+	for k, v := range want {
+		if got := strings.Join(query[k], ","); got != v {
+			t.Errorf("expected %s=%q, got %q", k, v, got)
+		}
+	}
+	if !strings.Contains(f.IO.String(), "Scheduled cleanup of tags in repository 123") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestRegistryCleanup_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"missing name regex", []string{"123", "--yes"}, "--name-regex is required"},
+		{"invalid name regex", []string{"123", "--name-regex", "(", "--yes"}, "invalid --name-regex"},
+		{"invalid keep regex", []string{"123", "--name-regex", ".*", "--keep-regex", "[", "--yes"}, "invalid --keep-regex"},
+		{"invalid repository", []string{"abc", "--name-regex", ".*"}, "invalid repository ID"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := cmdtest.NewTestFactory(t)
+			cmd := newRegistryCleanupCmd(f.Factory)
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -1,9 +1,14 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
+	"io"
+	"net/url"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/PhilipKram/gitlab-cli/internal/api"
 	"github.com/PhilipKram/gitlab-cli/internal/browser"
@@ -39,15 +44,47 @@ func newReleaseCreateCmd(f *cmdutil.Factory) *cobra.Command {
 		milestones  []string
 		assets      []string
 		web         bool
+		notesFile   string
+		fromTag     bool
+		generate    bool
+		startTag    string
 	)
 
 	cmd := &cobra.Command{
 		Use:   "create <tag>",
 		Short: "Create a release",
+		Long: `Create a release for a tag, creating the tag from --ref if it does not exist.
+
+Release notes can be given with --description, read from a file with
+--notes-file, or taken from the annotated tag's message with --notes-from-tag.
+Use --generate-notes to append a changelog of the merge requests merged into
+the default branch since the previous release (or since --notes-start-tag).`,
 		Example: `  $ glab release create v1.0.0 --name "Version 1.0" --description "First release"
-  $ glab release create v2.0.0 --ref main --name "Version 2.0"`,
+  $ glab release create v2.0.0 --ref main --name "Version 2.0"
+  $ glab release create v2.1.0 --notes-file CHANGELOG.md
+  $ glab release create v2.1.0 --notes-from-tag
+  $ glab release create v2.1.0 --generate-notes
+  $ glab release create v2.1.0 --generate-notes --notes-start-tag v2.0.0`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if startTag != "" && !generate {
+				return fmt.Errorf("--notes-start-tag requires --generate-notes")
+			}
+
+			if notesFile != "" {
+				var data []byte
+				var err error
+				if notesFile == "-" {
+					data, err = io.ReadAll(f.IOStreams.In)
+				} else {
+					data, err = os.ReadFile(notesFile)
+				}
+				if err != nil {
+					return fmt.Errorf("failed to read notes file: %w", err)
+				}
+				description = strings.TrimRight(string(data), "\n")
+			}
+
 			client, err := f.Client()
 			if err != nil {
 				return err
@@ -59,6 +96,34 @@ func newReleaseCreateCmd(f *cmdutil.Factory) *cobra.Command {
 			}
 
 			tag := args[0]
+
+			if fromTag {
+				t, resp, err := client.Tags.GetTag(project, tag, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/projects/" + project + "/repository/tags/" + tag
+					return errors.NewAPIError("GET", url, statusCode, "Failed to get tag "+tag, err)
+				}
+				if strings.TrimSpace(t.Message) == "" {
+					return fmt.Errorf("tag %s has no message; is it an annotated tag?", tag)
+				}
+				description = strings.TrimSpace(t.Message)
+			}
+
+			if generate {
+				notes, err := generateReleaseNotes(cmd, client, project, tag, startTag)
+				if err != nil {
+					return err
+				}
+				if description != "" {
+					description += "\n\n"
+				}
+				description += notes
+			}
+
 			opts := &gitlab.CreateReleaseOptions{
 				TagName:     &tag,
 				Name:        &name,
@@ -123,10 +188,126 @@ func newReleaseCreateCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.Flags().StringSliceVar(&milestones, "milestone", nil, "Associated milestones")
 	cmd.Flags().StringSliceVar(&assets, "asset", nil, "Release asset URLs")
 	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open in browser after creation")
+	cmd.Flags().StringVar(&notesFile, "notes-file", "", `Read the release description from a file ("-" for standard input)`)
+	cmd.Flags().BoolVar(&fromTag, "notes-from-tag", false, "Use the annotated tag's message as the release description")
+	cmd.Flags().BoolVar(&generate, "generate-notes", false, "Append a changelog of merge requests merged since the previous release")
+	cmd.Flags().StringVar(&startTag, "notes-start-tag", "", "Tag to generate notes from (default: the previous release)")
+	cmd.MarkFlagsMutuallyExclusive("description", "notes-file", "notes-from-tag")
 
 	return cmd
 }
 
+// generateReleaseNotes builds a Markdown changelog of the merge requests
+// merged into the default branch since startTag, or since the latest release
+// other than tag when startTag is empty.
+func generateReleaseNotes(cmd *cobra.Command, client *api.Client, project, tag, startTag string) (string, error) {
+	var since *time.Time
+	if startTag != "" {
+		t, resp, err := client.Tags.GetTag(project, startTag, gitlab.WithContext(cmd.Context()))
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			url := api.APIURL(client.Host()) + "/projects/" + project + "/repository/tags/" + startTag
+			return "", errors.NewAPIError("GET", url, statusCode, "Failed to get tag "+startTag, err)
+		}
+		if t.Commit != nil {
+			since = t.Commit.CommittedDate
+		}
+	} else {
+		releases, resp, err := client.Releases.ListReleases(project, &gitlab.ListReleasesOptions{
+			ListOptions: gitlab.ListOptions{PerPage: 20},
+			OrderBy:     gitlab.Ptr("released_at"),
+			Sort:        gitlab.Ptr("desc"),
+		}, gitlab.WithContext(cmd.Context()))
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			url := api.APIURL(client.Host()) + "/projects/" + project + "/releases"
+			return "", errors.NewAPIError("GET", url, statusCode, "Failed to list releases", err)
+		}
+		for _, r := range releases {
+			if r.TagName == tag {
+				continue
+			}
+			startTag = r.TagName
+			since = r.ReleasedAt
+			if since == nil {
+				since = r.CreatedAt
+			}
+			break
+		}
+	}
+
+	branch, err := projectDefaultBranch(client, project, nil)
+	if err != nil {
+		return "", err
+	}
+
+	opts := &gitlab.ListProjectMergeRequestsOptions{
+		ListOptions:  gitlab.ListOptions{PerPage: 100},
+		State:        gitlab.Ptr("merged"),
+		TargetBranch: &branch,
+		OrderBy:      gitlab.Ptr("updated_at"),
+		Sort:         gitlab.Ptr("asc"),
+		UpdatedAfter: since,
+	}
+	var merged []*gitlab.BasicMergeRequest
+	for {
+		mrs, resp, err := client.MergeRequests.ListProjectMergeRequests(project, opts, gitlab.WithContext(cmd.Context()))
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			url := api.APIURL(client.Host()) + "/projects/" + project + "/merge_requests"
+			return "", errors.NewAPIError("GET", url, statusCode, "Failed to list merged merge requests", err)
+		}
+		for _, mr := range mrs {
+			// updated_after is only an approximation; an MR merged before the
+			// previous release may have been updated since.
+			if since != nil && (mr.MergedAt == nil || !mr.MergedAt.After(*since)) {
+				continue
+			}
+			merged = append(merged, mr)
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		if merged[i].MergedAt == nil || merged[j].MergedAt == nil {
+			return merged[j].MergedAt == nil && merged[i].MergedAt != nil
+		}
+		return merged[i].MergedAt.Before(*merged[j].MergedAt)
+	})
+
+	var b strings.Builder
+	b.WriteString("## What's Changed\n\n")
+	if len(merged) == 0 {
+		b.WriteString("No merge requests were merged")
+		if startTag != "" {
+			b.WriteString(" since " + startTag)
+		}
+		b.WriteString(".\n")
+	}
+	for _, mr := range merged {
+		author := ""
+		if mr.Author != nil && mr.Author.Username != "" {
+			author = " by @" + mr.Author.Username
+		}
+		_, _ = fmt.Fprintf(&b, "* %s%s in !%d\n", mr.Title, author, mr.IID)
+	}
+	if startTag != "" {
+		_, _ = fmt.Fprintf(&b, "\n**Full Changelog**: %s\n", api.WebURL(client.Host(), project+"/-/compare/"+startTag+"..."+tag))
+	}
+	return strings.TrimRight(b.String(), "\n"), nil
+}
+
 func newReleaseListCmd(f *cmdutil.Factory) *cobra.Command {
 	var (
 		limit    int
@@ -163,7 +344,7 @@ func newReleaseListCmd(f *cmdutil.Factory) *cobra.Command {
 			// Use streaming mode if --stream flag is set
 			if stream {
 				// Create context for pagination
-				ctx := context.Background()
+				ctx := cmd.Context()
 
 				// Create fetch function for pagination
 				fetchFunc := func(page int) ([]*gitlab.Release, *gitlab.Response, error) {
@@ -172,7 +353,7 @@ func newReleaseListCmd(f *cmdutil.Factory) *cobra.Command {
 					if pageOpts.PerPage == 0 {
 						pageOpts.PerPage = 100
 					}
-					return client.Releases.ListReleases(project, &pageOpts)
+					return client.Releases.ListReleases(project, &pageOpts, gitlab.WithContext(ctx))
 				}
 
 				// Configure pagination options
@@ -212,8 +393,9 @@ func newReleaseListCmd(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
 	cmd.Flags().BoolVar(&stream, "stream", false, "Enable streaming mode")
 
 	return cmd
@@ -221,6 +403,7 @@ func newReleaseListCmd(f *cmdutil.Factory) *cobra.Command {
 
 func newReleaseViewCmd(f *cmdutil.Factory) *cobra.Command {
 	var web bool
+	var raw bool
 	var format string
 	var jsonFlag bool
 
@@ -267,7 +450,7 @@ func newReleaseViewCmd(f *cmdutil.Factory) *cobra.Command {
 			}
 
 			// Use formatter for non-default formats
-			if format != "" && format != "table" {
+			if f.ExportRequested() || (format != "" && format != "table") {
 				return f.FormatAndPrint(release, format, false)
 			}
 
@@ -286,7 +469,7 @@ func newReleaseViewCmd(f *cmdutil.Factory) *cobra.Command {
 			}
 
 			if release.Description != "" {
-				_, _ = fmt.Fprintf(out, "\n%s\n", release.Description)
+				_, _ = fmt.Fprintf(out, "\n%s\n", renderMarkdown(f, release.Description, raw))
 			}
 
 			return nil
@@ -294,8 +477,10 @@ func newReleaseViewCmd(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open in browser")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().BoolVar(&raw, "raw", false, "Print the description as raw markdown")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
 
 	return cmd
 }
@@ -391,16 +576,47 @@ func newReleaseDownloadCmd(f *cmdutil.Factory) *cobra.Command {
 
 func newReleaseUploadCmd(f *cmdutil.Factory) *cobra.Command {
 	var (
-		name     string
-		linkType string
+		name           string
+		linkType       string
+		usePackage     bool
+		packageName    string
+		packageVersion string
 	)
 
 	cmd := &cobra.Command{
-		Use:     "upload <tag> <file>",
-		Short:   "Upload an asset to a release",
-		Example: `  $ glab release upload v1.0.0 ./build/app.tar.gz --name "Application binary"`,
-		Args:    cobra.ExactArgs(2),
+		Use:   "upload <tag> <file>...",
+		Short: "Upload assets to a release",
+		Long: `Upload one or more files and attach them to a release as asset links.
+
+By default each file is uploaded to the project's uploads, the same storage
+used for attachments in issues and merge requests. With --package the files
+are published to the generic package registry instead, under a package named
+after the project and versioned with the tag, which suits larger binaries.`,
+		Example: `  $ glab release upload v1.0.0 ./build/app.tar.gz --name "Application binary"
+  $ glab release upload v1.0.0 dist/*
+  $ glab release upload v1.0.0 dist/* --package
+  $ glab release upload v1.0.0 dist/* --package --package-name my-app --package-version 1.0.0`,
+		Args: cobra.MinimumNArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			tag := args[0]
+			files := args[1:]
+
+			if name != "" && len(files) > 1 {
+				return fmt.Errorf("--name can only be used when uploading a single file")
+			}
+			if !usePackage && (packageName != "" || packageVersion != "") {
+				return fmt.Errorf("--package-name and --package-version require --package")
+			}
+			for _, path := range files {
+				info, err := os.Stat(path)
+				if err != nil {
+					return fmt.Errorf("file not found: %w", err)
+				}
+				if info.IsDir() {
+					return fmt.Errorf("%s is a directory", path)
+				}
+			}
+
 			client, err := f.Client()
 			if err != nil {
 				return err
@@ -411,51 +627,115 @@ func newReleaseUploadCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			tag := args[0]
-			filePath := args[1]
-
-			// Verify file exists
-			if _, err := os.Stat(filePath); err != nil {
-				return fmt.Errorf("file not found: %w", err)
-			}
-
-			// Create a release link (user must host the file externally or use GitLab package registry)
-			if name == "" {
-				name = filePath
-			}
-
-			// For direct asset links, user should provide a URL; for local files, use a placeholder
-			fileURL := filePath
-
 			lt := gitlab.OtherLinkType
+			if usePackage {
+				lt = gitlab.PackageLinkType
+				if packageName == "" {
+					packageName = project[strings.LastIndex(project, "/")+1:]
+				}
+				if packageVersion == "" {
+					packageVersion = tag
+				}
+			}
 			if linkType != "" {
 				lt = gitlab.LinkTypeValue(linkType)
 			}
 
-			linkOpts := &gitlab.CreateReleaseLinkOptions{
-				Name:     &name,
-				URL:      &fileURL,
-				LinkType: &lt,
-			}
+			out := f.IOStreams.Out
+			for _, path := range files {
+				fileName := filepath.Base(path)
+
+				var assetURL string
+				if usePackage {
+					assetURL, err = uploadReleasePackageFile(cmd, client, project, packageName, packageVersion, path)
+				} else {
+					assetURL, err = uploadReleaseProjectFile(cmd, client, project, path)
+				}
+				if err != nil {
+					return err
+				}
 
-			link, resp, err := client.ReleaseLinks.CreateReleaseLink(project, tag, linkOpts)
-			if err != nil {
-				statusCode := 0
-				if resp != nil {
-					statusCode = resp.StatusCode
+				linkName := name
+				if linkName == "" {
+					linkName = fileName
+				}
+				link, resp, err := client.ReleaseLinks.CreateReleaseLink(project, tag, &gitlab.CreateReleaseLinkOptions{
+					Name:     &linkName,
+					URL:      &assetURL,
+					LinkType: &lt,
+				}, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/projects/" + project + "/releases/" + tag + "/assets/links"
+					return errors.NewAPIError("POST", url, statusCode, "Failed to create release link for "+fileName, err)
 				}
-				url := api.APIURL(client.Host()) + "/projects/" + project + "/releases/" + tag + "/assets/links"
-				return errors.NewAPIError("POST", url, statusCode, "Failed to create release link", err)
-			}
 
-			_, _ = fmt.Fprintf(f.IOStreams.Out, "Uploaded %s to release %s\n", link.Name, tag)
-			_, _ = fmt.Fprintf(f.IOStreams.Out, "%s\n", link.DirectAssetURL)
+				_, _ = fmt.Fprintf(out, "Uploaded %s to release %s\n", link.Name, tag)
+				_, _ = fmt.Fprintf(out, "%s\n", link.URL)
+			}
 			return nil
 		},
 	}
 
-	cmd.Flags().StringVarP(&name, "name", "n", "", "Display name for the asset")
-	cmd.Flags().StringVar(&linkType, "type", "", "Link type: other, runbook, image, package")
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Display name for the asset (default: the file's base name)")
+	cmd.Flags().StringVar(&linkType, "type", "", "Link type: other, runbook, image, package (default: other, or package with --package)")
+	cmd.Flags().BoolVar(&usePackage, "package", false, "Upload to the generic package registry instead of project uploads")
+	cmd.Flags().StringVar(&packageName, "package-name", "", "Generic package name (default: the project name)")
+	cmd.Flags().StringVar(&packageVersion, "package-version", "", "Generic package version (default: the tag)")
 
 	return cmd
 }
+
+// uploadReleaseProjectFile uploads path to the project's uploads and returns
+// the absolute URL of the uploaded file.
+func uploadReleaseProjectFile(cmd *cobra.Command, client *api.Client, project, path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = file.Close() }()
+
+	uploaded, resp, err := client.ProjectMarkdownUploads.UploadProjectMarkdown(project, file, filepath.Base(path), gitlab.WithContext(cmd.Context()))
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := api.APIURL(client.Host()) + "/projects/" + project + "/uploads"
+		return "", errors.NewAPIError("POST", url, statusCode, "Failed to upload "+path, err)
+	}
+
+	// Newer GitLab versions return a full path rooted at the instance;
+	// older ones only return a URL relative to the project.
+	if strings.HasPrefix(uploaded.FullPath, "/") {
+		return api.WebURL(client.Host(), strings.TrimPrefix(uploaded.FullPath, "/")), nil
+	}
+	return api.WebURL(client.Host(), project+uploaded.URL), nil
+}
+
+// uploadReleasePackageFile publishes path to a generic package and returns
+// the API URL the file can be downloaded from.
+func uploadReleasePackageFile(cmd *cobra.Command, client *api.Client, project, name, version, path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = file.Close() }()
+
+	fileName := filepath.Base(path)
+	packagePath := fmt.Sprintf("/projects/%s/packages/generic/%s/%s/%s",
+		url.PathEscape(project), url.PathEscape(name), url.PathEscape(version), url.PathEscape(fileName))
+
+	_, resp, err := client.GenericPackages.PublishPackageFile(project, name, version, fileName, file, &gitlab.PublishPackageFileOptions{}, gitlab.WithContext(cmd.Context()))
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		return "", errors.NewAPIError("PUT", api.APIURL(client.Host())+packagePath, statusCode, "Failed to upload "+path, err)
+	}
+	return api.APIURL(client.Host()) + packagePath, nil
+}
@@ -1,7 +1,11 @@
 package cmd
 
 import (
+	"encoding/json"
+	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -62,6 +66,10 @@ func TestReleaseCreateCmd_Flags(t *testing.T) {
 		"milestone",
 		"asset",
 		"web",
+		"notes-file",
+		"notes-from-tag",
+		"generate-notes",
+		"notes-start-tag",
 	}
 
 	for _, flagName := range expectedFlags {
@@ -111,7 +119,7 @@ func TestReleaseViewCmd_Flags(t *testing.T) {
 	f := newTestFactory()
 	cmd := newReleaseViewCmd(f)
 
-	expectedFlags := []string{"web", "json"}
+	expectedFlags := []string{"web", "raw", "json"}
 
 	for _, flagName := range expectedFlags {
 		flag := cmd.Flags().Lookup(flagName)
@@ -150,6 +158,9 @@ func TestReleaseUploadCmd_Flags(t *testing.T) {
 	expectedFlags := []string{
 		"name",
 		"type",
+		"package",
+		"package-name",
+		"package-version",
 	}
 
 	for _, flagName := range expectedFlags {
@@ -159,8 +170,8 @@ func TestReleaseUploadCmd_Flags(t *testing.T) {
 		}
 	}
 
-	if cmd.Use != "upload <tag> <file>" {
-		t.Errorf("expected Use to be 'upload <tag> <file>', got %q", cmd.Use)
+	if cmd.Use != "upload <tag> <file>..." {
+		t.Errorf("expected Use to be 'upload <tag> <file>...', got %q", cmd.Use)
 	}
 }
 
@@ -227,6 +238,118 @@ func TestReleaseCreate_Success(t *testing.T) {
 	}
 }
 
+func TestReleaseCreate_NotesFile(t *testing.T) {
+	var description string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/releases" {
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			description, _ = body["description"].(string)
+			cmdtest.JSONResponse(w, 201, cmdtest.FixtureRelease)
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	notes := filepath.Join(t.TempDir(), "NOTES.md")
+	_ = os.WriteFile(notes, []byte("Highlights\n\n- faster builds\n"), 0o644)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newReleaseCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"v1.0.0", "--notes-file", notes})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if description != "Highlights\n\n- faster builds" {
+		t.Errorf("unexpected description: %q", description)
+	}
+}
+
+func TestReleaseCreate_NotesFromTag(t *testing.T) {
+	var description string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/test-owner/test-repo/repository/tags/v1.0.0":
+			cmdtest.JSONResponse(w, 200, map[string]any{"name": "v1.0.0", "message": "Release 1.0\n\nFirst stable release.\n"})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/releases":
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			description, _ = body["description"].(string)
+			cmdtest.JSONResponse(w, 201, cmdtest.FixtureRelease)
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newReleaseCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"v1.0.0", "--notes-from-tag"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if description != "Release 1.0\n\nFirst stable release." {
+		t.Errorf("unexpected description: %q", description)
+	}
+}
+
+func TestReleaseCreate_GenerateNotes(t *testing.T) {
+	var description, updatedAfter string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/test-owner/test-repo/releases":
+			cmdtest.JSONResponse(w, 200, []any{
+				map[string]any{"tag_name": "v1.1.0", "released_at": "2024-03-01T00:00:00Z"},
+				map[string]any{"tag_name": "v1.0.0", "released_at": "2024-01-01T00:00:00Z"},
+			})
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/test-owner/test-repo":
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 1, "default_branch": "main"})
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/test-owner/test-repo/merge_requests":
+			updatedAfter = r.URL.Query().Get("updated_after")
+			cmdtest.JSONResponse(w, 200, []any{
+				map[string]any{"id": 1, "iid": 10, "title": "Old change", "merged_at": "2024-02-01T00:00:00Z", "author": map[string]any{"username": "bob"}},
+				map[string]any{"id": 3, "iid": 12, "title": "Fix crash", "merged_at": "2024-03-05T00:00:00Z", "author": map[string]any{"username": "carol"}},
+				map[string]any{"id": 2, "iid": 11, "title": "Add export", "merged_at": "2024-03-02T00:00:00Z", "author": map[string]any{"username": "alice"}},
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/releases":
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			description, _ = body["description"].(string)
+			cmdtest.JSONResponse(w, 201, cmdtest.FixtureRelease)
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newReleaseCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"v1.2.0", "--description", "Spring release", "--generate-notes"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.HasPrefix(updatedAfter, "2024-03-01") {
+		t.Errorf("expected updated_after to be the previous release date, got %q", updatedAfter)
+	}
+	want := "Spring release\n\n## What's Changed\n\n" +
+		"* Add export by @alice in !11\n" +
+		"* Fix crash by @carol in !12\n\n" +
+		"**Full Changelog**: https://gitlab.com/test-owner/test-repo/-/compare/v1.1.0...v1.2.0"
+	if description != want {
+		t.Errorf("unexpected description:\n%s\nwant:\n%s", description, want)
+	}
+}
+
+func TestReleaseCreate_NotesFlagsExclusive(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newReleaseCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"v1.0.0", "--description", "x", "--notes-from-tag"})
+	if err := cmd.Execute(); err == nil {
+		t.Error("expected error when combining --description and --notes-from-tag")
+	}
+}
+
 func TestReleaseView_NotFound(t *testing.T) {
 	_ = cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
 		cmdtest.ErrorResponse(w, 404, "404 Not Found")
@@ -287,6 +410,106 @@ func TestReleaseUpload_ValidationError(t *testing.T) {
 	}
 }
 
+func TestReleaseUpload_ProjectUploads(t *testing.T) {
+	var links []map[string]string
+	uploaded := map[string]bool{}
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/uploads":
+			file, header, err := r.FormFile("file")
+			if err != nil {
+				cmdtest.ErrorResponse(w, 400, "missing file")
+				return
+			}
+			_ = file.Close()
+			uploaded[header.Filename] = true
+			cmdtest.JSONResponse(w, 201, map[string]any{
+				"url":       "/uploads/abc123/" + header.Filename,
+				"full_path": "/-/project/1/uploads/abc123/" + header.Filename,
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/releases/v1.0.0/assets/links":
+			var body map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			links = append(links, body)
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": len(links), "name": body["name"], "url": body["url"], "link_type": body["link_type"]})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	dir := t.TempDir()
+	a := filepath.Join(dir, "app-linux.tar.gz")
+	b := filepath.Join(dir, "app-darwin.tar.gz")
+	_ = os.WriteFile(a, []byte("linux"), 0o644)
+	_ = os.WriteFile(b, []byte("darwin"), 0o644)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newReleaseUploadCmd(f.Factory)
+	cmd.SetArgs([]string{"v1.0.0", a, b})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !uploaded["app-linux.tar.gz"] || !uploaded["app-darwin.tar.gz"] {
+		t.Errorf("expected both files to be uploaded, got %v", uploaded)
+	}
+	if len(links) != 2 {
+		t.Fatalf("expected 2 release links, got %d", len(links))
+	}
+	if links[0]["name"] != "app-linux.tar.gz" || links[0]["url"] != "https://gitlab.com/-/project/1/uploads/abc123/app-linux.tar.gz" || links[0]["link_type"] != "other" {
+		t.Errorf("unexpected link: %v", links[0])
+	}
+	if !strings.Contains(f.IO.String(), "Uploaded app-darwin.tar.gz to release v1.0.0") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestReleaseUpload_Package(t *testing.T) {
+	var link map[string]string
+	var published string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v4/projects/test-owner/test-repo/packages/generic/test-repo/v1.0.0/app.bin":
+			body, _ := io.ReadAll(r.Body)
+			published = string(body)
+			cmdtest.JSONResponse(w, 201, map[string]any{"size": len(body)})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/releases/v1.0.0/assets/links":
+			_ = json.NewDecoder(r.Body).Decode(&link)
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": 1, "name": link["name"], "url": link["url"], "link_type": link["link_type"]})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	path := filepath.Join(t.TempDir(), "app.bin")
+	_ = os.WriteFile(path, []byte("binary"), 0o644)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newReleaseUploadCmd(f.Factory)
+	cmd.SetArgs([]string{"v1.0.0", path, "--package", "--name", "Application binary"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if published != "binary" {
+		t.Errorf("expected file contents to be published, got %q", published)
+	}
+	wantURL := "https://gitlab.com/api/v4/projects/test-owner%2Ftest-repo/packages/generic/test-repo/v1.0.0/app.bin"
+	if link["url"] != wantURL || link["name"] != "Application binary" || link["link_type"] != "package" {
+		t.Errorf("unexpected link: %v", link)
+	}
+}
+
+func TestReleaseUpload_NameWithMultipleFiles(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newReleaseUploadCmd(f.Factory)
+	cmd.SetArgs([]string{"v1.0.0", "a", "b", "--name", "asset"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "single file") {
+		t.Errorf("expected --name error, got %v", err)
+	}
+}
+
 func TestReleaseDownload_ValidationError(t *testing.T) {
 	f := cmdtest.NewTestFactory(t)
 	cmd := newReleaseDownloadCmd(f.Factory)
@@ -2,7 +2,9 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"os/exec"
+	"path"
 	"strings"
 
 	"github.com/PhilipKram/gitlab-cli/internal/api"
@@ -24,12 +26,26 @@ func NewRepoCmd(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmd.AddCommand(newRepoCloneCmd(f))
+	cmd.AddCommand(newRepoCloneGroupCmd(f))
 	cmd.AddCommand(newRepoCreateCmd(f))
 	cmd.AddCommand(newRepoForkCmd(f))
 	cmd.AddCommand(newRepoViewCmd(f))
 	cmd.AddCommand(newRepoListCmd(f))
 	cmd.AddCommand(newRepoArchiveCmd(f))
+	cmd.AddCommand(newRepoDownloadCmd(f))
 	cmd.AddCommand(newRepoDeleteCmd(f))
+	cmd.AddCommand(newRepoEnvFileCmd(f))
+	cmd.AddCommand(newRepoFileCmd(f))
+	cmd.AddCommand(newRepoEditCmd(f))
+	cmd.AddCommand(newRepoTransferCmd(f))
+	cmd.AddCommand(newRepoMirrorCmd(f))
+	cmd.AddCommand(newRepoHousekeepingCmd(f))
+	cmd.AddCommand(newRepoSyncCmd(f))
+	cmd.AddCommand(newRepoSetDefaultCmd(f))
+	cmd.AddCommand(newRepoStarCmd(f))
+	cmd.AddCommand(newRepoUnstarCmd(f))
+	cmd.AddCommand(newRepoStarsCmd(f))
+	cmd.AddCommand(newRepoNotificationsCmd(f))
 
 	return cmd
 }
@@ -38,12 +54,24 @@ func newRepoCloneCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "clone <owner/repo>",
 		Short: "Clone a repository",
+		Long: `Clone a repository. The repository is given like --repo: as
+[HOST/]GROUP/PROJECT, with any number of subgroups, or as a project URL.`,
 		Example: `  $ glab repo clone owner/repo
+  $ glab repo clone group/subgroup/repo
+  $ glab repo clone https://gitlab.example.com/group/repo
   $ glab repo clone owner/repo -- --depth 1`,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			repoPath := args[0]
-			host := config.DefaultHost()
+			host, repoPath, err := cmdutil.ParseRepo(args[0])
+			if err != nil {
+				return err
+			}
+			if !strings.Contains(repoPath, "/") {
+				return fmt.Errorf("cloning by project ID is not supported; use the project path")
+			}
+			if host == "" {
+				host = config.DefaultHost()
+			}
 
 			// Build clone URL
 			cfg, _ := f.Config()
@@ -54,9 +82,11 @@ func newRepoCloneCmd(f *cmdutil.Factory) *cobra.Command {
 
 			var cloneURL string
 			if protocol == "ssh" {
-				cloneURL = fmt.Sprintf("git@%s:%s.git", host, repoPath)
+				// The SSH port is not the web port, so drop the latter.
+				sshHost, _, _ := strings.Cut(host, ":")
+				cloneURL = fmt.Sprintf("git@%s:%s.git", sshHost, repoPath)
 			} else {
-				cloneURL = fmt.Sprintf("https://%s/%s.git", host, repoPath)
+				cloneURL = api.WebURL(host, repoPath+".git")
 			}
 
 			gitArgs := []string{"clone", cloneURL}
@@ -202,23 +232,14 @@ func newRepoForkCmd(f *cmdutil.Factory) *cobra.Command {
 		Short: "Fork a repository",
 		Example: `  $ glab repo fork
   $ glab repo fork owner/repo
+  $ glab repo fork https://gitlab.com/group/subgroup/repo
   $ glab repo fork owner/repo --namespace my-group --clone`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			client, err := f.Client()
+			client, project, err := repoArgClient(f, args)
 			if err != nil {
 				return err
 			}
 
-			var project string
-			if len(args) > 0 {
-				project = args[0]
-			} else {
-				project, err = f.FullProjectPath()
-				if err != nil {
-					return err
-				}
-			}
-
 			opts := &gitlab.ForkProjectOptions{}
 			if targetNamespace != "" {
 				opts.NamespacePath = &targetNamespace
@@ -261,6 +282,23 @@ func newRepoForkCmd(f *cmdutil.Factory) *cobra.Command {
 	return cmd
 }
 
+// repoArgClient returns the API client and project for the optional
+// repository argument of a repo command, or for the current project.
+func repoArgClient(f *cmdutil.Factory, args []string) (*api.Client, string, error) {
+	if len(args) > 0 {
+		return f.RepoClient(args[0])
+	}
+	client, err := f.Client()
+	if err != nil {
+		return nil, "", err
+	}
+	project, err := f.FullProjectPath()
+	if err != nil {
+		return nil, "", err
+	}
+	return client, project, nil
+}
+
 func newRepoViewCmd(f *cmdutil.Factory) *cobra.Command {
 	var web bool
 	var format string
@@ -271,23 +309,14 @@ func newRepoViewCmd(f *cmdutil.Factory) *cobra.Command {
 		Short: "View a repository",
 		Example: `  $ glab repo view
   $ glab repo view owner/repo
+  $ glab repo view 12345
   $ glab repo view --web`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			client, err := f.Client()
+			client, projectPath, err := repoArgClient(f, args)
 			if err != nil {
 				return err
 			}
 
-			var projectPath string
-			if len(args) > 0 {
-				projectPath = args[0]
-			} else {
-				projectPath, err = f.FullProjectPath()
-				if err != nil {
-					return err
-				}
-			}
-
 			project, resp, err := client.Projects.GetProject(projectPath, nil)
 			if err != nil {
 				statusCode := 0
@@ -308,7 +337,7 @@ func newRepoViewCmd(f *cmdutil.Factory) *cobra.Command {
 			}
 
 			// Validate format flag
-			if format != "" && format != "table" {
+			if f.ExportRequested() || (format != "" && format != "table") {
 				return f.FormatAndPrint(project, format, false)
 			}
 
@@ -335,8 +364,9 @@ func newRepoViewCmd(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open in browser")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
 
 	return cmd
 }
@@ -423,8 +453,9 @@ func newRepoListCmd(f *cmdutil.Factory) *cobra.Command {
 
 	cmd.Flags().StringVarP(&owner, "owner", "o", "", "Filter by group/user")
 	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
 	cmd.Flags().BoolVar(&archived, "archived", false, "Include archived repositories")
 	cmd.Flags().StringVar(&search, "search", "", "Search repositories")
 
@@ -471,6 +502,129 @@ func newRepoArchiveCmd(f *cmdutil.Factory) *cobra.Command {
 	return cmd
 }
 
+// repoArchiveFormats lists the archive formats supported by the repository
+// archive endpoint.
+var repoArchiveFormats = []string{"tar.gz", "tar.bz2", "tbz", "tbz2", "tb2", "bz2", "tar", "zip"}
+
+func newRepoDownloadCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		ref           string
+		archiveFormat string
+		subPath       string
+		output        string
+	)
+
+	cmd := &cobra.Command{
+		Use:     "download [<owner/repo>]",
+		Short:   "Download a repository archive",
+		Aliases: []string{"archive-download"},
+		Long: `Download an archive of the repository at a given ref.
+
+The archive is streamed to disk. By default it is saved in the current
+directory as <repo>-<ref>.<format>. Use --path to download only a
+subdirectory of the repository.
+
+To archive (make read-only) a project instead, use "glab repo archive".`,
+		Example: `  $ glab repo download
+  $ glab repo download owner/repo --ref v1.2.0 --format zip
+  $ glab repo download --path docs --output docs.tar.gz`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			valid := false
+			for _, format := range repoArchiveFormats {
+				if archiveFormat == format {
+					valid = true
+					break
+				}
+			}
+			if !valid {
+				return fmt.Errorf("invalid format %q: must be one of %s", archiveFormat, strings.Join(repoArchiveFormats, ", "))
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			var projectPath string
+			if len(args) > 0 {
+				projectPath = args[0]
+			} else {
+				projectPath, err = f.FullProjectPath()
+				if err != nil {
+					return err
+				}
+			}
+
+			opts := &gitlab.ArchiveOptions{Format: &archiveFormat}
+			if ref != "" {
+				opts.SHA = &ref
+			}
+			if subPath != "" {
+				opts.Path = &subPath
+			}
+
+			if output == "" {
+				name := ref
+				if name == "" {
+					name, err = projectDefaultBranch(client, projectPath, nil)
+					if err != nil {
+						return err
+					}
+				}
+				output = fmt.Sprintf("%s-%s.%s", path.Base(projectPath), strings.ReplaceAll(name, "/", "-"), archiveFormat)
+			}
+
+			// Stream the archive through a pipe so it is written to disk as it
+			// arrives instead of being buffered in memory.
+			pr, pw := io.Pipe()
+			var resp *gitlab.Response
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				var streamErr error
+				resp, streamErr = client.Repositories.StreamArchive(projectPath, pw, opts, gitlab.WithContext(cmd.Context()))
+				_ = pw.CloseWithError(streamErr)
+			}()
+
+			var reader io.Reader = pr
+			var progress *cmdutil.ProgressReader
+			if f.IOStreams.IsTerminal() {
+				progress = cmdutil.NewProgressReader(pr, f.IOStreams.ErrOut, "Downloading "+output, 0)
+				reader = progress
+			}
+
+			written, err := cmdutil.WriteFileAtomic(output, reader, 0o644)
+			_ = pr.Close()
+			<-done
+			if progress != nil {
+				progress.Done()
+			}
+			if err != nil {
+				if resp == nil || resp.StatusCode >= 300 {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/repository/archive.%s", api.APIURL(client.Host()), projectPath, archiveFormat)
+					return errors.NewAPIError("GET", url, statusCode, "Failed to download repository archive", err)
+				}
+				return fmt.Errorf("writing %s: %w", output, err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Downloaded %s (%s)\n", output, byteCountSI(written))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&ref, "ref", "r", "", "Branch, tag, or commit to download (default: default branch)")
+	cmd.Flags().StringVar(&archiveFormat, "format", "tar.gz", "Archive format: "+strings.Join(repoArchiveFormats, ", "))
+	cmd.Flags().StringVarP(&subPath, "path", "p", "", "Only include this subdirectory of the repository")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file path (default: <repo>-<ref>.<format>)")
+
+	return cmd
+}
+
 func newRepoDeleteCmd(f *cmdutil.Factory) *cobra.Command {
 	var confirm bool
 
@@ -0,0 +1,253 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// cloneGroupRepo is the data available to the --path-template of
+// "repo clone-group".
+type cloneGroupRepo struct {
+	Name         string // project name
+	Path         string // project path, e.g. "api"
+	Namespace    string // full namespace path, e.g. "org/backend"
+	FullPath     string // full project path, e.g. "org/backend/api"
+	RelativePath string // project path relative to the cloned group, e.g. "backend/api"
+}
+
+func newRepoCloneGroupCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		subgroups       bool
+		includeArchived bool
+		workers         int
+		pathTemplate    string
+		dir             string
+		dryRun          bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "clone-group <group>",
+		Short: "Clone every repository in a group",
+		Long: `Clone all repositories of a group concurrently.
+
+Each repository is cloned into a directory given by --path-template, a Go
+template relative to --dir. The template can use:
+
+  {{.Name}}          project name
+  {{.Path}}          project path
+  {{.Namespace}}     full namespace path, e.g. org/backend
+  {{.FullPath}}      full project path, e.g. org/backend/api
+  {{.RelativePath}}  project path relative to the group, e.g. backend/api
+
+Repositories whose target directory already exists are skipped, so the
+command can be re-run to pick up new repositories. Clones use the "protocol"
+config key (https or ssh).`,
+		Example: `  $ glab repo clone-group my-org
+  $ glab repo clone-group my-org --subgroups --workers 8
+  $ glab repo clone-group my-org --subgroups --path-template "{{.Path}}" --dir ~/src
+  $ glab repo clone-group my-org --subgroups --dry-run`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			group := strings.Trim(args[0], "/")
+			if workers < 1 {
+				return fmt.Errorf("--workers must be at least 1")
+			}
+			tmpl, err := template.New("path").Option("missingkey=error").Parse(pathTemplate)
+			if err != nil {
+				return fmt.Errorf("invalid --path-template: %w", err)
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			projects, err := listGroupProjectsToClone(cmd.Context(), client, group, subgroups, includeArchived)
+			if err != nil {
+				return err
+			}
+			if len(projects) == 0 {
+				_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "No repositories found in %s\n", group)
+				return nil
+			}
+
+			protocol := "https"
+			if cfg, _ := f.Config(); cfg != nil && cfg.Protocol != "" {
+				protocol = cfg.Protocol
+			}
+
+			type job struct {
+				index int
+				url   string
+				dest  string
+				name  string
+			}
+			jobs := make([]job, 0, len(projects))
+			seen := make(map[string]string)
+			for i, p := range projects {
+				var buf bytes.Buffer
+				if err := tmpl.Execute(&buf, newCloneGroupRepo(p, group)); err != nil {
+					return fmt.Errorf("invalid --path-template: %w", err)
+				}
+				dest := filepath.Join(dir, filepath.FromSlash(buf.String()))
+				if other, ok := seen[dest]; ok {
+					return fmt.Errorf("--path-template maps both %s and %s to %s", other, p.PathWithNamespace, dest)
+				}
+				seen[dest] = p.PathWithNamespace
+
+				url := p.HTTPURLToRepo
+				if protocol == "ssh" {
+					url = p.SSHURLToRepo
+				}
+				jobs = append(jobs, job{index: i + 1, url: url, dest: dest, name: p.PathWithNamespace})
+			}
+
+			out := f.IOStreams.Out
+			errOut := f.IOStreams.ErrOut
+			total := len(jobs)
+
+			if dryRun {
+				for _, j := range jobs {
+					_, _ = fmt.Fprintf(out, "%s -> %s\n", j.name, j.dest)
+				}
+				return nil
+			}
+
+			var (
+				mu      sync.Mutex
+				cloned  int
+				skipped int
+				failed  int
+				wg      sync.WaitGroup
+			)
+			queue := make(chan job)
+			for range min(workers, total) {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for j := range queue {
+						if _, err := os.Stat(j.dest); err == nil {
+							mu.Lock()
+							skipped++
+							_, _ = fmt.Fprintf(errOut, "[%d/%d] Skipped %s: %s already exists\n", j.index, total, j.name, j.dest)
+							mu.Unlock()
+							continue
+						}
+
+						err := cloneRepo(cmd.Context(), j.url, j.dest)
+
+						mu.Lock()
+						if err != nil {
+							failed++
+							_, _ = fmt.Fprintf(errOut, "[%d/%d] Failed to clone %s: %v\n", j.index, total, j.name, err)
+						} else {
+							cloned++
+							_, _ = fmt.Fprintf(errOut, "[%d/%d] Cloned %s into %s\n", j.index, total, j.name, j.dest)
+						}
+						mu.Unlock()
+					}
+				}()
+			}
+			for _, j := range jobs {
+				queue <- j
+			}
+			close(queue)
+			wg.Wait()
+
+			summary := fmt.Sprintf("Cloned %d of %d repositories", cloned, total)
+			if skipped > 0 {
+				summary += fmt.Sprintf(" (%d already present)", skipped)
+			}
+			_, _ = fmt.Fprintln(out, summary)
+			if failed > 0 {
+				return fmt.Errorf("failed to clone %d of %d repositories", failed, total)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&subgroups, "subgroups", "s", false, "Include repositories in subgroups")
+	cmd.Flags().BoolVar(&includeArchived, "include-archived", false, "Include archived repositories")
+	cmd.Flags().IntVarP(&workers, "workers", "p", 4, "Number of repositories to clone in parallel")
+	cmd.Flags().StringVar(&pathTemplate, "path-template", "{{.RelativePath}}", "Go template for each clone's directory, relative to --dir")
+	cmd.Flags().StringVarP(&dir, "dir", "d", ".", "Directory to clone into")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Print where each repository would be cloned without cloning")
+
+	return cmd
+}
+
+// listGroupProjectsToClone returns all projects of a group, sorted by path.
+// Projects shared with the group from elsewhere are not included.
+func listGroupProjectsToClone(ctx context.Context, client *api.Client, group string, subgroups, includeArchived bool) ([]*gitlab.Project, error) {
+	opts := &gitlab.ListGroupProjectsOptions{
+		ListOptions:      gitlab.ListOptions{PerPage: 100},
+		IncludeSubGroups: gitlab.Ptr(subgroups),
+		WithShared:       gitlab.Ptr(false),
+		OrderBy:          gitlab.Ptr("path"),
+		Sort:             gitlab.Ptr("asc"),
+	}
+	if !includeArchived {
+		opts.Archived = gitlab.Ptr(false)
+	}
+
+	var all []*gitlab.Project
+	for {
+		projects, resp, err := client.Groups.ListGroupProjects(group, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			url := api.APIURL(client.Host()) + "/groups/" + group + "/projects"
+			return nil, errors.NewAPIError("GET", url, statusCode, "Failed to list group repositories", err)
+		}
+		all = append(all, projects...)
+		if resp == nil || resp.NextPage == 0 {
+			return all, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func newCloneGroupRepo(p *gitlab.Project, group string) cloneGroupRepo {
+	repo := cloneGroupRepo{
+		Name:         p.Name,
+		Path:         p.Path,
+		FullPath:     p.PathWithNamespace,
+		RelativePath: p.PathWithNamespace,
+	}
+	if p.Namespace != nil {
+		repo.Namespace = p.Namespace.FullPath
+	}
+	if len(p.PathWithNamespace) > len(group) && strings.EqualFold(p.PathWithNamespace[:len(group)+1], group+"/") {
+		repo.RelativePath = p.PathWithNamespace[len(group)+1:]
+	}
+	return repo
+}
+
+// cloneRepo clones url into dest, returning git's error output on failure.
+func cloneRepo(ctx context.Context, url, dest string) error {
+	var stderr bytes.Buffer
+	gitCmd := exec.CommandContext(ctx, "git", "clone", "--quiet", url, dest)
+	gitCmd.Stderr = &stderr
+	if err := gitCmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("%w: %s", err, msg)
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"net/http"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+// mockGroupProject returns a group project whose HTTP clone URL points at a
+// local path.
+func mockGroupProject(id int, fullPath, cloneURL string) map[string]interface{} {
+	p := cmdtest.MockProject(id, path.Base(fullPath), fullPath)
+	p["path"] = path.Base(fullPath)
+	p["path_with_namespace"] = fullPath
+	p["http_url_to_repo"] = cloneURL
+	p["namespace"] = map[string]interface{}{"full_path": path.Dir(fullPath)}
+	return p
+}
+
+func TestRepoCloneGroup(t *testing.T) {
+	root := t.TempDir()
+	bare := filepath.Join(root, "bare.git")
+	if out, err := exec.Command("git", "init", "--bare", bare).CombinedOutput(); err != nil {
+		t.Fatalf("git init failed: %v\n%s", err, out)
+	}
+	dest := filepath.Join(root, "out")
+	if err := os.MkdirAll(filepath.Join(dest, "existing"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	var query string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v4/groups/my-org/projects" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		query = r.URL.RawQuery
+		cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+			mockGroupProject(1, "my-org/api", bare),
+			mockGroupProject(2, "my-org/backend/worker", bare),
+			mockGroupProject(3, "my-org/existing", bare),
+			mockGroupProject(4, "my-org/broken", filepath.Join(root, "missing.git")),
+		})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRepoCloneGroupCmd(f.Factory)
+	cmd.SetArgs([]string{"my-org", "--subgroups", "--dir", dest, "--workers", "2"})
+
+	err := cmd.Execute()
+	if err == nil || err.Error() != "failed to clone 1 of 4 repositories" {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	for _, q := range []string{"include_subgroups=true", "archived=false", "with_shared=false"} {
+		if !strings.Contains(query, q) {
+			t.Errorf("query %q missing %s", query, q)
+		}
+	}
+	for _, dir := range []string{"api", filepath.Join("backend", "worker")} {
+		if _, err := os.Stat(filepath.Join(dest, dir, ".git")); err != nil {
+			t.Errorf("expected %s to be cloned: %v", dir, err)
+		}
+	}
+	if !strings.Contains(f.IO.String(), "Cloned 2 of 4 repositories (1 already present)") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+	errOut := f.IO.ErrString()
+	for _, want := range []string{"Skipped my-org/existing", "Failed to clone my-org/broken"} {
+		if !strings.Contains(errOut, want) {
+			t.Errorf("stderr missing %q: %s", want, errOut)
+		}
+	}
+}
+
+func TestRepoCloneGroup_DryRunTemplate(t *testing.T) {
+	var query string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+			mockGroupProject(1, "my-org/backend/api", "https://gitlab.com/my-org/backend/api.git"),
+		})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRepoCloneGroupCmd(f.Factory)
+	cmd.SetArgs([]string{"my-org", "--include-archived", "--dry-run", "--dir", "src", "--path-template", "{{.Namespace}}_{{.Path}}"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(query, "archived=") {
+		t.Errorf("archived filter should not be sent with --include-archived: %s", query)
+	}
+	want := "my-org/backend/api -> " + filepath.Join("src", "my-org", "backend_api")
+	if !strings.Contains(f.IO.String(), want) {
+		t.Errorf("output %q missing %q", f.IO.String(), want)
+	}
+}
+
+func TestRepoCloneGroup_PathCollision(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+			mockGroupProject(1, "my-org/a/api", "a"),
+			mockGroupProject(2, "my-org/b/api", "b"),
+		})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRepoCloneGroupCmd(f.Factory)
+	cmd.SetArgs([]string{"my-org", "--dry-run", "--path-template", "{{.Path}}"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "maps both my-org/a/api and my-org/b/api") {
+		t.Errorf("expected collision error, got %v", err)
+	}
+}
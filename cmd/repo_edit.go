@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func newRepoEditCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		description       string
+		visibility        string
+		defaultBranch     string
+		addTopics         []string
+		removeTopics      []string
+		mergeMethod       string
+		squashOption      string
+		removeSource      bool
+		issues            bool
+		wiki              bool
+		containerRegistry bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "edit [<owner/repo>]",
+		Short: "Edit repository settings",
+		Long: `Change the settings of a repository. Only the settings given as flags are
+changed.
+
+Feature toggles take a boolean, so --issues=false disables the issue tracker.`,
+		Example: `  $ glab repo edit --description "Command-line client for GitLab"
+  $ glab repo edit owner/repo --visibility internal --default-branch main
+  $ glab repo edit --add-topic cli,go --remove-topic draft
+  $ glab repo edit --merge-method ff --squash-option default_on --remove-source-branch
+  $ glab repo edit --wiki=false --container-registry=false`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			opts := &gitlab.EditProjectOptions{}
+			changed := false
+
+			if flags.Changed("description") {
+				opts.Description = &description
+				changed = true
+			}
+			if flags.Changed("visibility") {
+				switch visibility {
+				case "public", "internal", "private":
+					opts.Visibility = gitlab.Ptr(gitlab.VisibilityValue(visibility))
+				default:
+					return fmt.Errorf("invalid visibility: %s (use public, internal, or private)", visibility)
+				}
+				changed = true
+			}
+			if flags.Changed("default-branch") {
+				opts.DefaultBranch = &defaultBranch
+				changed = true
+			}
+			if flags.Changed("merge-method") {
+				switch mergeMethod {
+				case "merge", "rebase_merge", "ff":
+					opts.MergeMethod = gitlab.Ptr(gitlab.MergeMethodValue(mergeMethod))
+				default:
+					return fmt.Errorf("invalid merge method: %s (use merge, rebase_merge, or ff)", mergeMethod)
+				}
+				changed = true
+			}
+			if flags.Changed("squash-option") {
+				switch squashOption {
+				case "never", "always", "default_on", "default_off":
+					opts.SquashOption = gitlab.Ptr(gitlab.SquashOptionValue(squashOption))
+				default:
+					return fmt.Errorf("invalid squash option: %s (use never, always, default_on, or default_off)", squashOption)
+				}
+				changed = true
+			}
+			if flags.Changed("remove-source-branch") {
+				opts.RemoveSourceBranchAfterMerge = &removeSource
+				changed = true
+			}
+			if flags.Changed("issues") {
+				opts.IssuesAccessLevel = accessControl(issues)
+				changed = true
+			}
+			if flags.Changed("wiki") {
+				opts.WikiAccessLevel = accessControl(wiki)
+				changed = true
+			}
+			if flags.Changed("container-registry") {
+				opts.ContainerRegistryAccessLevel = accessControl(containerRegistry)
+				changed = true
+			}
+			if len(addTopics) > 0 || len(removeTopics) > 0 {
+				changed = true
+			}
+			if !changed {
+				return fmt.Errorf("no settings to change; see --help for the available flags")
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			var projectPath string
+			if len(args) > 0 {
+				projectPath = args[0]
+			} else {
+				projectPath, err = f.FullProjectPath()
+				if err != nil {
+					return err
+				}
+			}
+
+			if len(addTopics) > 0 || len(removeTopics) > 0 {
+				project, resp, err := client.Projects.GetProject(projectPath, nil, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/projects/" + projectPath
+					return errors.NewAPIError("GET", url, statusCode, "Failed to get repository", err)
+				}
+				topics := editTopics(project.Topics, addTopics, removeTopics)
+				opts.Topics = &topics
+			}
+
+			project, resp, err := client.Projects.EditProject(projectPath, opts, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + projectPath
+				return errors.NewAPIError("PUT", url, statusCode, "Failed to edit repository", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Updated repository %s\n", project.PathWithNamespace)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&description, "description", "d", "", "Repository description")
+	cmd.Flags().StringVar(&visibility, "visibility", "", "Visibility: public, internal, private")
+	cmd.Flags().StringVar(&defaultBranch, "default-branch", "", "Default branch name")
+	cmd.Flags().StringSliceVar(&addTopics, "add-topic", nil, "Add topics")
+	cmd.Flags().StringSliceVar(&removeTopics, "remove-topic", nil, "Remove topics")
+	cmd.Flags().StringVar(&mergeMethod, "merge-method", "", "Merge method: merge, rebase_merge, ff")
+	cmd.Flags().StringVar(&squashOption, "squash-option", "", "Squash commits on merge: never, always, default_on, default_off")
+	cmd.Flags().BoolVar(&removeSource, "remove-source-branch", false, "Delete source branches after merge by default")
+	cmd.Flags().BoolVar(&issues, "issues", false, "Enable the issue tracker")
+	cmd.Flags().BoolVar(&wiki, "wiki", false, "Enable the wiki")
+	cmd.Flags().BoolVar(&containerRegistry, "container-registry", false, "Enable the container registry")
+
+	return cmd
+}
+
+// accessControl maps a feature toggle to a project feature access level.
+func accessControl(enabled bool) *gitlab.AccessControlValue {
+	if enabled {
+		return gitlab.Ptr(gitlab.EnabledAccessControl)
+	}
+	return gitlab.Ptr(gitlab.DisabledAccessControl)
+}
+
+// editTopics returns topics with add appended and remove dropped, keeping
+// the existing order and skipping duplicates.
+func editTopics(topics, add, remove []string) []string {
+	result := []string{}
+	for _, t := range append(slices.Clone(topics), add...) {
+		if !slices.Contains(remove, t) && !slices.Contains(result, t) {
+			result = append(result, t)
+		}
+	}
+	return result
+}
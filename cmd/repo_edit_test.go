@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestRepoEdit_Settings(t *testing.T) {
+	var body map[string]interface{}
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" && strings.HasSuffix(r.URL.Path, "/projects/owner/repo") {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 200, map[string]interface{}{"id": 1, "path_with_namespace": "owner/repo"})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "404 Not Found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRepoEditCmd(f.Factory)
+	cmd.SetArgs([]string{"owner/repo",
+		"--description", "New description",
+		"--visibility", "internal",
+		"--merge-method", "ff",
+		"--squash-option", "default_on",
+		"--remove-source-branch",
+		"--wiki=false",
+		"--issues",
+	})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]interface{}{
+		"description":                      "New description",
+		"visibility":                       "internal",
+		"merge_method":                     "ff",
+		"squash_option":                    "default_on",
+		"remove_source_branch_after_merge": true,
+		"wiki_access_level":                "disabled",
+		"issues_access_level":              "enabled",
+	}
+	if !reflect.DeepEqual(body, want) {
+		t.Errorf("request body = %v, want %v", body, want)
+	}
+	if !strings.Contains(f.IO.String(), "Updated repository owner/repo") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestRepoEdit_Topics(t *testing.T) {
+	var topics []interface{}
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			cmdtest.JSONResponse(w, 200, map[string]interface{}{
+				"id": 1, "path_with_namespace": "test-owner/test-repo", "topics": []string{"go", "draft"},
+			})
+		case "PUT":
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			topics, _ = body["topics"].([]interface{})
+			cmdtest.JSONResponse(w, 200, map[string]interface{}{"id": 1, "path_with_namespace": "test-owner/test-repo"})
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRepoEditCmd(f.Factory)
+	cmd.SetArgs([]string{"--add-topic", "cli,go", "--remove-topic", "draft"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []interface{}{"go", "cli"}; !reflect.DeepEqual(topics, want) {
+		t.Errorf("topics = %v, want %v", topics, want)
+	}
+}
+
+func TestRepoEdit_Validation(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"no flags", nil, "no settings to change"},
+		{"bad visibility", []string{"--visibility", "secret"}, "invalid visibility"},
+		{"bad merge method", []string{"--merge-method", "squash"}, "invalid merge method"},
+		{"bad squash option", []string{"--squash-option", "sometimes"}, "invalid squash option"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := cmdtest.NewTestFactory(t)
+			cmd := newRepoEditCmd(f.Factory)
+			cmd.SetArgs(tt.args)
+
+			err := cmd.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,372 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/dotenv"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/PhilipKram/gitlab-cli/internal/formatter"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"gopkg.in/yaml.v3"
+)
+
+// EnvFileKey describes a configuration key declared for a project's CI.
+type EnvFileKey struct {
+	Key         string `json:"key"`
+	Source      string `json:"source"`
+	Description string `json:"description,omitempty"`
+	Default     string `json:"default,omitempty"`
+	Masked      bool   `json:"masked"`
+}
+
+// EnvFileCheckResult represents the comparison of a local .env file with CI.
+type EnvFileCheckResult struct {
+	File         string   `json:"file"`
+	MissingInCI  []string `json:"missing_in_ci"`
+	MissingLocal []string `json:"missing_locally"`
+}
+
+func newRepoEnvFileCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "env-file <command>",
+		Short: "Keep local .env files in sync with CI/CD variables",
+		Long: `Generate a .env.example scaffold from a project's declared CI/CD
+configuration, or check a local .env file for keys that CI does not know about.
+
+Keys are collected from the project's CI/CD variables and from the top-level
+variables section of .gitlab-ci.yml.`,
+	}
+
+	cmd.AddCommand(newRepoEnvFileGenerateCmd(f))
+	cmd.AddCommand(newRepoEnvFileCheckCmd(f))
+
+	return cmd
+}
+
+func newRepoEnvFileGenerateCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		output     string
+		ciFile     string
+		withValues bool
+		force      bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Generate a .env.example from declared CI variables",
+		Long: `Generate a .env.example scaffold listing every key declared in the
+project's CI/CD variables and .gitlab-ci.yml, with descriptions as comments.
+
+Values of project variables are left empty unless --with-values is set, and
+masked, hidden, or protected values are never written. Defaults from
+.gitlab-ci.yml are always included since they are already committed.`,
+		Example: `  $ glab repo env-file generate
+  $ glab repo env-file generate --output .env.example
+  $ glab repo env-file generate --ci-file ci/main.yml --with-values`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			keys, err := collectEnvFileKeys(client, project, ciFile, withValues)
+			if err != nil {
+				return err
+			}
+
+			if len(keys) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No CI/CD variables declared for this project")
+				return nil
+			}
+
+			content := renderEnvExample(project, keys)
+
+			if output == "" {
+				_, _ = fmt.Fprint(f.IOStreams.Out, content)
+				return nil
+			}
+
+			if _, err := os.Stat(output); err == nil && !force {
+				return fmt.Errorf("%s already exists; use --force to overwrite", output)
+			}
+			if err := os.WriteFile(output, []byte(content), 0o644); err != nil {
+				return fmt.Errorf("writing to file: %w", err)
+			}
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Wrote %d key(s) to %s\n", len(keys), output)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Write to file instead of stdout")
+	cmd.Flags().StringVar(&ciFile, "ci-file", ".gitlab-ci.yml", "CI configuration file to read variables from")
+	cmd.Flags().BoolVar(&withValues, "with-values", false, "Include values of unmasked, unprotected project variables")
+	cmd.Flags().BoolVar(&force, "force", false, "Overwrite the output file if it exists")
+
+	return cmd
+}
+
+func newRepoEnvFileCheckCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		file     string
+		ciFile   string
+		strict   bool
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Check a local .env file against declared CI variables",
+		Long: `Compare the keys in a local .env file with the keys declared in the
+project's CI/CD variables and .gitlab-ci.yml.
+
+Keys only present locally are reported as missing in CI, and declared keys
+absent from the file are reported as missing locally. With --strict the
+command exits with an error when any key is missing in CI.`,
+		Example: `  $ glab repo env-file check
+  $ glab repo env-file check --file .env.local --strict
+  $ glab repo env-file check --format json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFormat, err := f.ResolveFormat(format, jsonFlag)
+			if err != nil {
+				return err
+			}
+
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return fmt.Errorf("reading file: %w", err)
+			}
+			entries, err := dotenv.Parse(bytes.NewReader(data))
+			if err != nil {
+				return fmt.Errorf("parsing %s: %w", file, err)
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			keys, err := collectEnvFileKeys(client, project, ciFile, false)
+			if err != nil {
+				return err
+			}
+
+			result := compareEnvFileKeys(file, dotenv.Keys(entries), keys)
+
+			if outputFormat == formatter.JSONFormat || outputFormat == formatter.YAMLFormat {
+				if err := f.FormatAndPrint(result, string(outputFormat), false); err != nil {
+					return err
+				}
+			} else {
+				out := f.IOStreams.Out
+				if len(result.MissingInCI) == 0 && len(result.MissingLocal) == 0 {
+					_, _ = fmt.Fprintf(out, "%s is in sync with CI/CD variables\n", file)
+				}
+				if len(result.MissingInCI) > 0 {
+					_, _ = fmt.Fprintf(out, "Keys in %s missing in CI:\n", file)
+					for _, k := range result.MissingInCI {
+						_, _ = fmt.Fprintf(out, "  - %s\n", k)
+					}
+				}
+				if len(result.MissingLocal) > 0 {
+					_, _ = fmt.Fprintf(out, "Keys declared in CI missing from %s:\n", file)
+					for _, k := range result.MissingLocal {
+						_, _ = fmt.Fprintf(out, "  - %s\n", k)
+					}
+				}
+			}
+
+			if strict && len(result.MissingInCI) > 0 {
+				return fmt.Errorf("%d key(s) in %s are missing in CI", len(result.MissingInCI), file)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&file, "file", ".env", "Local .env file to check")
+	cmd.Flags().StringVar(&ciFile, "ci-file", ".gitlab-ci.yml", "CI configuration file to read variables from")
+	cmd.Flags().BoolVar(&strict, "strict", false, "Exit with an error when keys are missing in CI")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, yaml, table, or plain")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+// collectEnvFileKeys gathers keys from the project's CI/CD variables and the
+// local CI configuration file, sorted by key.
+func collectEnvFileKeys(client *api.Client, project, ciFile string, withValues bool) ([]EnvFileKey, error) {
+	byKey := map[string]*EnvFileKey{}
+
+	if ciFile != "" {
+		data, err := os.ReadFile(ciFile)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("reading %s: %w", ciFile, err)
+		}
+		if err == nil {
+			ciKeys, err := parseCIFileVariables(data)
+			if err != nil {
+				return nil, fmt.Errorf("parsing %s: %w", ciFile, err)
+			}
+			for i := range ciKeys {
+				byKey[ciKeys[i].Key] = &ciKeys[i]
+			}
+		}
+	}
+
+	opts := &gitlab.ListProjectVariablesOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+	page := 1
+	for {
+		opts.Page = int64(page)
+		variables, resp, err := client.ProjectVariables.ListVariables(project, opts)
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			url := api.APIURL(client.Host()) + "/projects/" + project + "/variables"
+			return nil, errors.NewAPIError("GET", url, statusCode, "Failed to list project variables", err)
+		}
+
+		for _, v := range variables {
+			if v.VariableType == gitlab.FileVariableType {
+				continue
+			}
+			k, ok := byKey[v.Key]
+			if !ok {
+				k = &EnvFileKey{Key: v.Key, Source: "project"}
+				byKey[v.Key] = k
+			}
+			if v.Description != "" {
+				k.Description = v.Description
+			}
+			secret := v.Masked || v.Hidden || v.Protected
+			k.Masked = k.Masked || secret
+			if withValues && !secret && k.Default == "" {
+				k.Default = v.Value
+			}
+		}
+
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		page = int(resp.NextPage)
+	}
+
+	keys := make([]EnvFileKey, 0, len(byKey))
+	for _, k := range byKey {
+		keys = append(keys, *k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Key < keys[j].Key })
+	return keys, nil
+}
+
+// parseCIFileVariables returns the top-level variables declared in a
+// .gitlab-ci.yml file. Variables may be plain values or maps with value and
+// description fields.
+func parseCIFileVariables(data []byte) ([]EnvFileKey, error) {
+	var doc struct {
+		Variables map[string]yaml.Node `yaml:"variables"`
+	}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	var keys []EnvFileKey
+	for name, node := range doc.Variables {
+		k := EnvFileKey{Key: name, Source: "ci-file"}
+		switch node.Kind {
+		case yaml.ScalarNode:
+			k.Default = node.Value
+		case yaml.MappingNode:
+			var v struct {
+				Value       string `yaml:"value"`
+				Description string `yaml:"description"`
+			}
+			if err := node.Decode(&v); err != nil {
+				return nil, fmt.Errorf("variable %s: %w", name, err)
+			}
+			k.Default = v.Value
+			k.Description = v.Description
+		}
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Key < keys[j].Key })
+	return keys, nil
+}
+
+// renderEnvExample formats keys as a .env.example file.
+func renderEnvExample(project string, keys []EnvFileKey) string {
+	var sb strings.Builder
+	_, _ = fmt.Fprintf(&sb, "# Generated by glab from the CI/CD configuration of %s.\n", project)
+	sb.WriteString("# Copy to .env and fill in the values. Do not commit secrets.\n")
+
+	for _, k := range keys {
+		sb.WriteString("\n")
+		if k.Description != "" {
+			for _, line := range strings.Split(strings.TrimSpace(k.Description), "\n") {
+				_, _ = fmt.Fprintf(&sb, "# %s\n", line)
+			}
+		}
+		if k.Masked {
+			sb.WriteString("# (secret: masked or protected in CI)\n")
+		}
+		_, _ = fmt.Fprintf(&sb, "%s=%s\n", k.Key, envFileValue(k.Default))
+	}
+	return sb.String()
+}
+
+// envFileValue quotes a value when it contains characters that would not
+// survive an unquoted .env assignment.
+func envFileValue(v string) string {
+	if v == "" || !strings.ContainsAny(v, " \t\n#\"'\\$") {
+		return v
+	}
+	return strconv.Quote(v)
+}
+
+// compareEnvFileKeys reports keys present on only one side.
+func compareEnvFileKeys(file string, localKeys []string, declared []EnvFileKey) EnvFileCheckResult {
+	result := EnvFileCheckResult{
+		File:         file,
+		MissingInCI:  []string{},
+		MissingLocal: []string{},
+	}
+
+	local := map[string]bool{}
+	for _, k := range localKeys {
+		local[k] = true
+	}
+	ci := map[string]bool{}
+	for _, k := range declared {
+		ci[k.Key] = true
+		if !local[k.Key] {
+			result.MissingLocal = append(result.MissingLocal, k.Key)
+		}
+	}
+	for _, k := range localKeys {
+		if !ci[k] {
+			result.MissingInCI = append(result.MissingInCI, k)
+		}
+	}
+	sort.Strings(result.MissingInCI)
+	sort.Strings(result.MissingLocal)
+	return result
+}
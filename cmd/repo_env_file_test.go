@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestRepoEnvFileCmd_Subcommands(t *testing.T) {
+	f := newTestFactory()
+	cmd := newRepoEnvFileCmd(f)
+
+	for _, name := range []string{"generate", "check"} {
+		sub, _, err := cmd.Find([]string{name})
+		if err != nil || sub.Name() != name {
+			t.Errorf("expected %q subcommand", name)
+		}
+	}
+}
+
+func TestParseCIFileVariables(t *testing.T) {
+	data := []byte(`
+variables:
+  LOG_LEVEL: info
+  DEPLOY_ENV:
+    value: staging
+    description: "Target environment"
+build:
+  script: make
+`)
+	keys, err := parseCIFileVariables(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(keys) != 2 {
+		t.Fatalf("expected 2 keys, got %+v", keys)
+	}
+	if keys[0].Key != "DEPLOY_ENV" || keys[0].Default != "staging" || keys[0].Description != "Target environment" {
+		t.Errorf("unexpected DEPLOY_ENV key: %+v", keys[0])
+	}
+	if keys[1].Key != "LOG_LEVEL" || keys[1].Default != "info" {
+		t.Errorf("unexpected LOG_LEVEL key: %+v", keys[1])
+	}
+}
+
+func mockProjectVariablesServer(t *testing.T) {
+	t.Helper()
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/variables") {
+			cmdtest.JSONResponse(w, 200, []map[string]any{
+				{"key": "API_TOKEN", "value": "s3cret", "masked": true, "variable_type": "env_var", "description": "Token for the upstream API"},
+				{"key": "REGION", "value": "eu-west-1", "variable_type": "env_var"},
+				{"key": "KUBECONFIG", "value": "...", "variable_type": "file"},
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+}
+
+func TestRepoEnvFileGenerate(t *testing.T) {
+	mockProjectVariablesServer(t)
+	dir := t.TempDir()
+	ciFile := filepath.Join(dir, ".gitlab-ci.yml")
+	if err := os.WriteFile(ciFile, []byte("variables:\n  LOG_LEVEL: \"debug mode\"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRepoEnvFileGenerateCmd(f.Factory)
+	cmd.SetArgs([]string{"--ci-file", ciFile, "--with-values"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := f.IO.String()
+	for _, want := range []string{
+		"# Token for the upstream API\n# (secret: masked or protected in CI)\nAPI_TOKEN=\n",
+		`LOG_LEVEL="debug mode"`,
+		"REGION=eu-west-1\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "s3cret") || strings.Contains(out, "KUBECONFIG") {
+		t.Errorf("expected masked values and file variables to be omitted, got:\n%s", out)
+	}
+}
+
+func TestRepoEnvFileCheck(t *testing.T) {
+	mockProjectVariablesServer(t)
+	dir := t.TempDir()
+	envFile := filepath.Join(dir, ".env")
+	if err := os.WriteFile(envFile, []byte("API_TOKEN=local\nDEBUG=1\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRepoEnvFileCheckCmd(f.Factory)
+	cmd.SetArgs([]string{"--file", envFile, "--ci-file", filepath.Join(dir, "missing.yml"), "--format", "json", "--strict"})
+	if err := cmd.Execute(); err == nil {
+		t.Fatal("expected --strict to fail when keys are missing in CI")
+	}
+
+	var result EnvFileCheckResult
+	if err := json.Unmarshal([]byte(f.IO.String()), &result); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, f.IO.String())
+	}
+	if strings.Join(result.MissingInCI, ",") != "DEBUG" {
+		t.Errorf("MissingInCI = %v, want [DEBUG]", result.MissingInCI)
+	}
+	if strings.Join(result.MissingLocal, ",") != "REGION" {
+		t.Errorf("MissingLocal = %v, want [REGION]", result.MissingLocal)
+	}
+}
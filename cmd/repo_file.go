@@ -0,0 +1,328 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func newRepoFileCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "file <command>",
+		Short: "Read and change repository files without cloning",
+		Long: `Read a file at a given ref, or create, update, and delete files by
+committing directly to a branch through the Repository Files API.`,
+	}
+
+	cmd.AddCommand(newRepoFileGetCmd(f))
+	cmd.AddCommand(newRepoFilePutCmd(f))
+	cmd.AddCommand(newRepoFileDeleteCmd(f))
+
+	return cmd
+}
+
+func newRepoFileGetCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		ref      string
+		raw      bool
+		output   string
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "get <path>",
+		Short: "Print a repository file",
+		Long: `Print a file from the repository at the given ref.
+
+By default a short header with the file's ref, size, and last commit is
+printed before the contents. Use --raw to print only the contents, which is
+suitable for piping, or --output to save them to a local file.`,
+		Example: `  $ glab repo file get README.md
+  $ glab repo file get .gitlab-ci.yml --ref develop --raw
+  $ glab repo file get assets/logo.png --output logo.png
+  $ glab repo file get go.mod --format json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			path := strings.TrimPrefix(args[0], "/")
+			if ref == "" {
+				ref, err = projectDefaultBranch(client, project, nil)
+				if err != nil {
+					return err
+				}
+			}
+
+			file, resp, err := client.RepositoryFiles.GetFile(project, path, &gitlab.GetFileOptions{Ref: &ref})
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/repository/files/%s?ref=%s", api.APIURL(client.Host()), project, path, ref)
+				return errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get file %s at %s", path, ref), err)
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(file, format, jsonFlag)
+			}
+
+			content, err := decodeRepoFile(file)
+			if err != nil {
+				return err
+			}
+
+			if output != "" {
+				written, err := cmdutil.WriteFileAtomic(output, bytes.NewReader(content), 0o644)
+				if err != nil {
+					return fmt.Errorf("failed to write %s: %w", output, err)
+				}
+				_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Saved %s (%s) to %s\n", path, byteCountSI(written), output)
+				return nil
+			}
+
+			out := f.IOStreams.Out
+			if !raw {
+				_, _ = fmt.Fprintf(out, "File:         %s\n", file.FilePath)
+				_, _ = fmt.Fprintf(out, "Ref:          %s\n", file.Ref)
+				_, _ = fmt.Fprintf(out, "Size:         %s\n", byteCountSI(file.Size))
+				_, _ = fmt.Fprintf(out, "Last commit:  %s\n\n", shortSHA(file.LastCommitID))
+			}
+			_, _ = out.Write(content)
+			if !raw && len(content) > 0 && content[len(content)-1] != '\n' {
+				_, _ = fmt.Fprintln(out)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&ref, "ref", "r", "", "Branch, tag, or commit to read from (default: default branch)")
+	cmd.Flags().BoolVar(&raw, "raw", false, "Print only the file contents")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Write the file contents to a local path")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	cmd.MarkFlagsMutuallyExclusive("raw", "output")
+
+	return cmd
+}
+
+func newRepoFilePutCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		branch      string
+		startBranch string
+		message     string
+		source      string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "put <path>",
+		Short: "Create or update a repository file",
+		Long: `Create or update a file by committing it directly to a branch.
+
+The new contents are read from --file, or from standard input when --file is
+"-" or omitted. The file is created if it does not exist on the branch and
+updated otherwise. Use --start-branch to commit to a new branch created from
+an existing one.`,
+		Example: `  $ glab repo file put config/app.yml --file app.yml --message "Bump timeout"
+  $ echo "v2" | glab repo file put VERSION --branch release
+  $ glab repo file put docs/new.md --file new.md --branch docs-update --start-branch main`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := strings.TrimPrefix(args[0], "/")
+
+			var (
+				content []byte
+				err     error
+			)
+			if source == "" || source == "-" {
+				content, err = io.ReadAll(f.IOStreams.In)
+			} else {
+				content, err = os.ReadFile(source)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to read file contents: %w", err)
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			if branch == "" {
+				branch, err = projectDefaultBranch(client, project, nil)
+				if err != nil {
+					return err
+				}
+			}
+
+			// The file is looked up on the branch the commit is based on.
+			lookupRef := branch
+			if startBranch != "" {
+				lookupRef = startBranch
+			}
+			exists := true
+			_, resp, err := client.RepositoryFiles.GetFileMetaData(project, path, &gitlab.GetFileMetaDataOptions{Ref: &lookupRef})
+			if err != nil {
+				if resp == nil || resp.StatusCode != 404 {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/projects/%s/repository/files/%s?ref=%s", api.APIURL(client.Host()), project, path, lookupRef)
+					return errors.NewAPIError("HEAD", url, statusCode, fmt.Sprintf("Failed to check file %s", path), err)
+				}
+				exists = false
+			}
+
+			encoded := base64.StdEncoding.EncodeToString(content)
+			url := fmt.Sprintf("%s/projects/%s/repository/files/%s", api.APIURL(client.Host()), project, path)
+			var start *string
+			if startBranch != "" {
+				start = &startBranch
+			}
+
+			if exists {
+				if message == "" {
+					message = "Update " + path
+				}
+				_, resp, err = client.RepositoryFiles.UpdateFile(project, path, &gitlab.UpdateFileOptions{
+					Branch:        &branch,
+					StartBranch:   start,
+					Encoding:      gitlab.Ptr("base64"),
+					Content:       &encoded,
+					CommitMessage: &message,
+				})
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					return errors.NewAPIError("PUT", url, statusCode, fmt.Sprintf("Failed to update file %s", path), err)
+				}
+				_, _ = fmt.Fprintf(f.IOStreams.Out, "Updated %s on %s\n", path, branch)
+				return nil
+			}
+
+			if message == "" {
+				message = "Add " + path
+			}
+			_, resp, err = client.RepositoryFiles.CreateFile(project, path, &gitlab.CreateFileOptions{
+				Branch:        &branch,
+				StartBranch:   start,
+				Encoding:      gitlab.Ptr("base64"),
+				Content:       &encoded,
+				CommitMessage: &message,
+			})
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to create file %s", path), err)
+			}
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Created %s on %s\n", path, branch)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&branch, "branch", "b", "", "Branch to commit to (default: default branch)")
+	cmd.Flags().StringVar(&startBranch, "start-branch", "", "Create --branch from this branch before committing")
+	cmd.Flags().StringVarP(&message, "message", "m", "", `Commit message (default: "Add <path>" or "Update <path>")`)
+	cmd.Flags().StringVarP(&source, "file", "f", "", `Local file with the new contents, or "-" for standard input`)
+
+	return cmd
+}
+
+func newRepoFileDeleteCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		branch  string
+		message string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "delete <path>",
+		Short: "Delete a repository file",
+		Example: `  $ glab repo file delete old-config.yml
+  $ glab repo file delete docs/draft.md --branch docs-cleanup --message "Remove draft"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			path := strings.TrimPrefix(args[0], "/")
+			if branch == "" {
+				branch, err = projectDefaultBranch(client, project, nil)
+				if err != nil {
+					return err
+				}
+			}
+			if message == "" {
+				message = "Delete " + path
+			}
+
+			resp, err := client.RepositoryFiles.DeleteFile(project, path, &gitlab.DeleteFileOptions{
+				Branch:        &branch,
+				CommitMessage: &message,
+			})
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/repository/files/%s", api.APIURL(client.Host()), project, path)
+				return errors.NewAPIError("DELETE", url, statusCode, fmt.Sprintf("Failed to delete file %s", path), err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Deleted %s on %s\n", path, branch)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&branch, "branch", "b", "", "Branch to commit to (default: default branch)")
+	cmd.Flags().StringVarP(&message, "message", "m", "", `Commit message (default: "Delete <path>")`)
+
+	return cmd
+}
+
+// decodeRepoFile returns the decoded contents of a file fetched from the
+// Repository Files API.
+func decodeRepoFile(file *gitlab.File) ([]byte, error) {
+	if file.Encoding != "base64" {
+		return []byte(file.Content), nil
+	}
+	content, err := base64.StdEncoding.DecodeString(file.Content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", file.FilePath, err)
+	}
+	return content, nil
+}
@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+const repoFilesPath = "/api/v4/projects/test-owner/test-repo/repository/files/"
+
+func TestRepoFileCmd_HasSubcommands(t *testing.T) {
+	f := newTestFactory()
+	cmd := newRepoFileCmd(f)
+
+	expectedSubcommands := []string{"get", "put", "delete"}
+	subcommands := cmd.Commands()
+	if len(subcommands) != len(expectedSubcommands) {
+		t.Errorf("expected %d subcommands, got %d", len(expectedSubcommands), len(subcommands))
+	}
+	names := map[string]bool{}
+	for _, sub := range subcommands {
+		names[sub.Name()] = true
+	}
+	for _, expected := range expectedSubcommands {
+		if !names[expected] {
+			t.Errorf("expected subcommand %q not found", expected)
+		}
+	}
+}
+
+func mockRepoFileGet(t *testing.T, wantRef string) {
+	t.Helper()
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v4/projects/test-owner/test-repo":
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 1, "default_branch": "main"})
+		case repoFilesPath + "config/app.yml":
+			if got := r.URL.Query().Get("ref"); got != wantRef {
+				t.Errorf("expected ref %q, got %q", wantRef, got)
+			}
+			cmdtest.JSONResponse(w, 200, map[string]any{
+				"file_path":      "config/app.yml",
+				"ref":            wantRef,
+				"size":           12,
+				"encoding":       "base64",
+				"content":        base64.StdEncoding.EncodeToString([]byte("timeout: 30\n")),
+				"last_commit_id": "1a2b3c4d5e6f7a8b",
+			})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+}
+
+func TestRepoFileGet(t *testing.T) {
+	mockRepoFileGet(t, "main")
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRepoFileGetCmd(f.Factory)
+	cmd.SetArgs([]string{"config/app.yml"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := f.IO.String()
+	for _, want := range []string{"Ref:          main", "Last commit:  1a2b3c4d", "timeout: 30"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got %q", want, out)
+		}
+	}
+}
+
+func TestRepoFileGet_Raw(t *testing.T) {
+	mockRepoFileGet(t, "develop")
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRepoFileGetCmd(f.Factory)
+	cmd.SetArgs([]string{"config/app.yml", "--ref", "develop", "--raw"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if out := f.IO.String(); out != "timeout: 30\n" {
+		t.Errorf("expected raw contents only, got %q", out)
+	}
+}
+
+func TestRepoFileGet_Output(t *testing.T) {
+	mockRepoFileGet(t, "main")
+
+	dest := filepath.Join(t.TempDir(), "app.yml")
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRepoFileGetCmd(f.Factory)
+	cmd.SetArgs([]string{"config/app.yml", "--output", dest})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := os.ReadFile(dest)
+	if err != nil {
+		t.Fatalf("failed to read output: %v", err)
+	}
+	if string(data) != "timeout: 30\n" {
+		t.Errorf("unexpected file contents: %q", data)
+	}
+}
+
+func TestRepoFilePut(t *testing.T) {
+	tests := []struct {
+		name        string
+		exists      bool
+		wantMethod  string
+		wantMessage string
+		wantOut     string
+	}{
+		{"creates missing file", false, http.MethodPost, "Add VERSION", "Created VERSION on release"},
+		{"updates existing file", true, http.MethodPut, "Update VERSION", "Updated VERSION on release"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var method string
+			var body map[string]any
+			cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != repoFilesPath+"VERSION" {
+					cmdtest.ErrorResponse(w, 404, "not found")
+					return
+				}
+				if r.Method == http.MethodHead {
+					if !tt.exists {
+						w.WriteHeader(404)
+						return
+					}
+					w.Header().Set("X-Gitlab-File-Path", "VERSION")
+					w.WriteHeader(200)
+					return
+				}
+				method = r.Method
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				cmdtest.JSONResponse(w, 200, map[string]any{"file_path": "VERSION", "branch": "release"})
+			})
+
+			f := cmdtest.NewTestFactory(t)
+			f.IO.In.WriteString("v2\n")
+			cmd := newRepoFilePutCmd(f.Factory)
+			cmd.SetArgs([]string{"VERSION", "--branch", "release"})
+			if err := cmd.Execute(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if method != tt.wantMethod {
+				t.Errorf("expected %s, got %s", tt.wantMethod, method)
+			}
+			if body["commit_message"] != tt.wantMessage || body["branch"] != "release" || body["encoding"] != "base64" {
+				t.Errorf("unexpected request body: %v", body)
+			}
+			if body["content"] != base64.StdEncoding.EncodeToString([]byte("v2\n")) {
+				t.Errorf("unexpected content: %v", body["content"])
+			}
+			if !strings.Contains(f.IO.String(), tt.wantOut) {
+				t.Errorf("expected output %q, got %q", tt.wantOut, f.IO.String())
+			}
+		})
+	}
+}
+
+func TestRepoFileDelete(t *testing.T) {
+	var query url.Values
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != repoFilesPath+"docs/draft.md" {
+			cmdtest.ErrorResponse(w, 404, "not found")
+			return
+		}
+		query = r.URL.Query()
+		w.WriteHeader(204)
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRepoFileDeleteCmd(f.Factory)
+	cmd.SetArgs([]string{"docs/draft.md", "--branch", "cleanup"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if query.Get("branch") != "cleanup" || query.Get("commit_message") != "Delete docs/draft.md" {
+		t.Errorf("unexpected request body: %v", query)
+	}
+	if !strings.Contains(f.IO.String(), "Deleted docs/draft.md on cleanup") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func newRepoHousekeepingCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "housekeeping [<owner/repo>]",
+		Short: "Start housekeeping for a repository",
+		Long: `Start a housekeeping task that compresses and prunes the repository's Git
+objects. The task runs in the background on the GitLab server.`,
+		Example: `  $ glab repo housekeeping
+  $ glab repo housekeeping owner/repo`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			var projectPath string
+			if len(args) > 0 {
+				projectPath = args[0]
+			} else {
+				projectPath, err = f.FullProjectPath()
+				if err != nil {
+					return err
+				}
+			}
+
+			resp, err := client.Projects.StartHousekeepingProject(projectPath, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + projectPath + "/housekeeping"
+				return errors.NewAPIError("POST", url, statusCode, "Failed to start housekeeping", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Started housekeeping for %s\n", projectPath)
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestRepoHousekeeping(t *testing.T) {
+	var called bool
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/housekeeping") {
+			called = true
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "404 Not Found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRepoHousekeepingCmd(f.Factory)
+	cmd.SetArgs([]string{})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !called {
+		t.Error("expected housekeeping request")
+	}
+	if !strings.Contains(f.IO.String(), "Started housekeeping for test-owner/test-repo") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
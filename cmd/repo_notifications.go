@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// notificationLevels maps the accepted --level values to GitLab
+// notification levels. "mute" is an alias for "disabled".
+var notificationLevels = map[string]gitlab.NotificationLevelValue{
+	"global":        gitlab.GlobalNotificationLevel,
+	"watch":         gitlab.WatchNotificationLevel,
+	"participating": gitlab.ParticipatingNotificationLevel,
+	"mention":       gitlab.MentionNotificationLevel,
+	"disabled":      gitlab.DisabledNotificationLevel,
+	"mute":          gitlab.DisabledNotificationLevel,
+}
+
+// parseNotificationLevel converts a --level value to a notification level.
+func parseNotificationLevel(s string) (gitlab.NotificationLevelValue, error) {
+	level, ok := notificationLevels[strings.ToLower(s)]
+	if !ok {
+		return 0, fmt.Errorf("invalid notification level: %s (use global, watch, participating, mention, or mute)", s)
+	}
+	return level, nil
+}
+
+func newRepoNotificationsCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		level    string
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "notifications [<owner/repo>]",
+		Short: "Show or set your notification level for a repository",
+		Long: `Show your notification level for a repository, or change it with --level.
+
+Levels:
+  global         use your global notification settings
+  watch          notify about all activity
+  participating  notify about threads you participate in
+  mention        notify only when you are mentioned
+  mute           never notify (also accepted as "disabled")`,
+		Example: `  $ glab repo notifications
+  $ glab repo notifications --level watch
+  $ glab repo notifications owner/repo --level mute`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var newLevel gitlab.NotificationLevelValue
+			if level != "" {
+				var err error
+				newLevel, err = parseNotificationLevel(level)
+				if err != nil {
+					return err
+				}
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			var projectPath string
+			if len(args) > 0 {
+				projectPath = args[0]
+			} else {
+				projectPath, err = f.FullProjectPath()
+				if err != nil {
+					return err
+				}
+			}
+			url := api.APIURL(client.Host()) + "/projects/" + projectPath + "/notification_settings"
+
+			if level == "" {
+				settings, resp, err := client.NotificationSettings.GetSettingsForProject(projectPath, gitlab.WithContext(cmd.Context()))
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					return errors.NewAPIError("GET", url, statusCode, "Failed to get notification settings", err)
+				}
+
+				if jsonFlag || f.ExportRequested() || (format != "" && format != "table") {
+					return f.FormatAndPrint(settings, format, jsonFlag)
+				}
+				_, _ = fmt.Fprintf(f.IOStreams.Out, "Notification level for %s: %s\n", projectPath, settings.Level)
+				return nil
+			}
+
+			settings, resp, err := client.NotificationSettings.UpdateSettingsForProject(projectPath, &gitlab.NotificationSettingsOptions{
+				Level: &newLevel,
+			}, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				return errors.NewAPIError("PUT", url, statusCode, "Failed to update notification settings", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Set notification level for %s to %s\n", projectPath, settings.Level)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&level, "level", "", "Set the notification level: global, watch, participating, mention, or mute")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
+
+	return cmd
+}
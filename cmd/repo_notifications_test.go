@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestRepoNotifications_Show(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || !strings.HasSuffix(r.URL.Path, "/notification_settings") {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		cmdtest.JSONResponse(w, 200, map[string]interface{}{"level": "participating"})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRepoNotificationsCmd(f.Factory)
+	cmd.SetArgs([]string{})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(f.IO.String(), "Notification level for test-owner/test-repo: participating") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestRepoNotifications_SetMute(t *testing.T) {
+	var level interface{}
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		level = body["level"]
+		cmdtest.JSONResponse(w, 200, map[string]interface{}{"level": "disabled"})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRepoNotificationsCmd(f.Factory)
+	cmd.SetArgs([]string{"owner/repo", "--level", "mute"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if level != "disabled" {
+		t.Errorf("level = %v, want disabled", level)
+	}
+	if !strings.Contains(f.IO.String(), "Set notification level for owner/repo to disabled") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestRepoNotifications_InvalidLevel(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRepoNotificationsCmd(f.Factory)
+	cmd.SetArgs([]string{"--level", "loud"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "invalid notification level") {
+		t.Errorf("expected invalid level error, got %v", err)
+	}
+}
@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	gitutil "github.com/PhilipKram/gitlab-cli/internal/git"
+	"github.com/PhilipKram/gitlab-cli/internal/prompt"
+	"github.com/spf13/cobra"
+)
+
+func newRepoSetDefaultCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		view  bool
+		unset bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "set-default [<remote> | <repo>]",
+		Short: "Set the default remote of the current repository",
+		Long: `Pin the git remote whose project glab commands operate on in the current
+repository, such as the upstream project of a fork. The remote is given by
+name or by its project, in any form --repo accepts. Without an argument, it
+is picked interactively.
+
+The choice is stored in the repository's git config. With a default set,
+"glab mr create" opens merge requests from the branch pushed to the
+configured git remote (your fork) into the default remote's project.`,
+		Example: `  $ glab repo set-default upstream
+  $ glab repo set-default group/project
+  $ glab repo set-default --view
+  $ glab repo set-default --unset`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if view && unset {
+				return fmt.Errorf("--view and --unset cannot be used together")
+			}
+			if (view || unset) && len(args) > 0 {
+				return fmt.Errorf("no argument is accepted with --view or --unset")
+			}
+
+			out := f.IOStreams.Out
+			cs := f.IOStreams.ColorScheme()
+
+			if unset {
+				if err := gitutil.UnsetDefaultRemote(); err != nil {
+					return err
+				}
+				_, _ = fmt.Fprintf(out, "%s Unset the default remote\n", cs.Green("✓"))
+				return nil
+			}
+
+			remotes, err := f.Remotes()
+			if err != nil {
+				return err
+			}
+
+			if view {
+				name, err := gitutil.DefaultRemote()
+				if err != nil {
+					return err
+				}
+				if name == "" {
+					_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No default remote set; run 'glab repo set-default' to pin one")
+					return nil
+				}
+				_, _ = fmt.Fprintln(out, describeRemote(findRemoteByName(remotes, name), name))
+				return nil
+			}
+
+			var remote *gitutil.Remote
+			switch {
+			case len(args) > 0:
+				remote, err = matchRemote(remotes, args[0])
+				if err != nil {
+					return err
+				}
+			case len(remotes) == 0:
+				return fmt.Errorf("no git remotes found")
+			case !f.IOStreams.CanPrompt():
+				return fmt.Errorf("a remote is required when not running interactively")
+			default:
+				options := make([]string, len(remotes))
+				for i := range remotes {
+					options[i] = describeRemote(&remotes[i], remotes[i].Name)
+				}
+				idx, err := prompt.Select(f.IOStreams.In, f.IOStreams.ErrOut, "Which remote should glab use by default?", options)
+				if err != nil {
+					return err
+				}
+				remote = &remotes[idx]
+			}
+
+			if err := gitutil.SetDefaultRemote(remote.Name); err != nil {
+				return err
+			}
+			_, _ = fmt.Fprintf(out, "%s Set %s as the default remote\n", cs.Green("✓"), describeRemote(remote, remote.Name))
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&view, "view", false, "Show the default remote")
+	cmd.Flags().BoolVarP(&unset, "unset", "u", false, "Remove the default remote")
+
+	return cmd
+}
+
+// matchRemote returns the remote named arg, or the remote of the project arg
+// names.
+func matchRemote(remotes []gitutil.Remote, arg string) (*gitutil.Remote, error) {
+	if r := findRemoteByName(remotes, arg); r != nil {
+		return r, nil
+	}
+	host, path, err := cmdutil.ParseRepo(arg)
+	if err != nil {
+		return nil, fmt.Errorf("no remote named %s", arg)
+	}
+	for i, r := range remotes {
+		if r.Owner+"/"+r.Repo == path && (host == "" || host == r.Host) {
+			return &remotes[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no remote for %s", arg)
+}
+
+func findRemoteByName(remotes []gitutil.Remote, name string) *gitutil.Remote {
+	for i := range remotes {
+		if remotes[i].Name == name {
+			return &remotes[i]
+		}
+	}
+	return nil
+}
+
+// describeRemote formats a remote as its name and project.
+func describeRemote(r *gitutil.Remote, name string) string {
+	if r == nil || r.Owner == "" {
+		return name
+	}
+	return fmt.Sprintf("%s (%s/%s/%s)", name, r.Host, r.Owner, r.Repo)
+}
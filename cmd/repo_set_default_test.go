@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+	gitutil "github.com/PhilipKram/gitlab-cli/internal/git"
+)
+
+func TestRepoSetDefault(t *testing.T) {
+	dir := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "-q", dir},
+		{"-C", dir, "remote", "add", "origin", "git@gitlab.com:me/app.git"},
+		{"-C", dir, "remote", "add", "upstream", "https://gitlab.com/team/app.git"},
+	} {
+		if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+			t.Skipf("git %v failed: %v: %s", args, err, out)
+		}
+	}
+	t.Chdir(dir)
+
+	f := cmdtest.NewTestFactory(t)
+	f.Factory.Remotes = gitutil.Remotes
+	run := func(args ...string) error {
+		f.IO.Out.Reset()
+		cmd := newRepoSetDefaultCmd(f.Factory)
+		cmd.SetArgs(args)
+		return cmd.Execute()
+	}
+
+	if err := run("https://gitlab.com/team/app"); err != nil {
+		t.Fatalf("set-default by URL: %v", err)
+	}
+	if name, _ := gitutil.DefaultRemote(); name != "upstream" {
+		t.Errorf("DefaultRemote = %q, want upstream", name)
+	}
+
+	if err := run("--view"); err != nil {
+		t.Fatalf("set-default --view: %v", err)
+	}
+	if got := f.IO.String(); got != "upstream (gitlab.com/team/app)\n" {
+		t.Errorf("--view output = %q", got)
+	}
+
+	if err := run("origin"); err != nil {
+		t.Fatalf("set-default by name: %v", err)
+	}
+	if name, _ := gitutil.DefaultRemote(); name != "origin" {
+		t.Errorf("DefaultRemote = %q, want origin", name)
+	}
+
+	if err := run("--unset"); err != nil {
+		t.Fatalf("set-default --unset: %v", err)
+	}
+	if name, _ := gitutil.DefaultRemote(); name != "" {
+		t.Errorf("DefaultRemote = %q after --unset, want none", name)
+	}
+
+	if err := run("other/project"); err == nil || !strings.Contains(err.Error(), "no remote") {
+		t.Errorf("expected an unknown remote error, got %v", err)
+	}
+	if err := run(); err == nil || !strings.Contains(err.Error(), "not running interactively") {
+		t.Errorf("expected a non-interactive error, got %v", err)
+	}
+}
@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func newRepoStarCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "star [<owner/repo>]",
+		Short: "Star a repository",
+		Example: `  $ glab repo star
+  $ glab repo star owner/repo`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return setRepoStarred(cmd, f, args, true)
+		},
+	}
+
+	return cmd
+}
+
+func newRepoUnstarCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unstar [<owner/repo>]",
+		Short: "Remove the star from a repository",
+		Example: `  $ glab repo unstar
+  $ glab repo unstar owner/repo`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return setRepoStarred(cmd, f, args, false)
+		},
+	}
+
+	return cmd
+}
+
+// setRepoStarred stars or unstars a repository. GitLab answers 304 Not
+// Modified when the repository is already in the requested state.
+func setRepoStarred(cmd *cobra.Command, f *cmdutil.Factory, args []string, star bool) error {
+	client, err := f.Client()
+	if err != nil {
+		return err
+	}
+
+	var projectPath string
+	if len(args) > 0 {
+		projectPath = args[0]
+	} else {
+		projectPath, err = f.FullProjectPath()
+		if err != nil {
+			return err
+		}
+	}
+
+	var resp *gitlab.Response
+	action := "star"
+	if star {
+		_, resp, err = client.Projects.StarProject(projectPath, gitlab.WithContext(cmd.Context()))
+	} else {
+		action = "unstar"
+		_, resp, err = client.Projects.UnstarProject(projectPath, gitlab.WithContext(cmd.Context()))
+	}
+	if resp != nil && resp.StatusCode == http.StatusNotModified {
+		if star {
+			_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Already starred %s\n", projectPath)
+		} else {
+			_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "%s is not starred\n", projectPath)
+		}
+		return nil
+	}
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := api.APIURL(client.Host()) + "/projects/" + projectPath + "/" + action
+		return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to %s repository", action), err)
+	}
+
+	if star {
+		_, _ = fmt.Fprintf(f.IOStreams.Out, "Starred %s\n", projectPath)
+	} else {
+		_, _ = fmt.Fprintf(f.IOStreams.Out, "Unstarred %s\n", projectPath)
+	}
+	return nil
+}
+
+func newRepoStarsCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		user     string
+		limit    int
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "stars",
+		Short: "List starred repositories",
+		Example: `  $ glab repo stars
+  $ glab repo stars --user johndoe --format json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			opts := &gitlab.ListProjectsOptions{
+				ListOptions: gitlab.ListOptions{PerPage: int64(limit)},
+			}
+
+			var (
+				projects []*gitlab.Project
+				resp     *gitlab.Response
+				url      string
+			)
+			if user != "" {
+				projects, resp, err = client.Projects.ListUserStarredProjects(user, opts, gitlab.WithContext(cmd.Context()))
+				url = api.APIURL(client.Host()) + "/users/" + user + "/starred_projects"
+			} else {
+				opts.Starred = gitlab.Ptr(true)
+				projects, resp, err = client.Projects.ListProjects(opts, gitlab.WithContext(cmd.Context()))
+				url = api.APIURL(client.Host()) + "/projects"
+			}
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				return errors.NewAPIError("GET", url, statusCode, "Failed to list starred repositories", err)
+			}
+
+			if len(projects) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No starred repositories found")
+				return nil
+			}
+
+			return f.FormatAndPrint(projects, format, jsonFlag)
+		},
+	}
+
+	cmd.Flags().StringVarP(&user, "user", "u", "", "List the repositories starred by this user instead of you")
+	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
+
+	return cmd
+}
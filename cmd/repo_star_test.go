@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestRepoStar(t *testing.T) {
+	var path string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		cmdtest.JSONResponse(w, 201, map[string]interface{}{"id": 1, "path_with_namespace": "owner/repo"})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRepoStarCmd(f.Factory)
+	cmd.SetArgs([]string{"owner/repo"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasSuffix(path, "/projects/owner/repo/star") {
+		t.Errorf("unexpected path: %s", path)
+	}
+	if !strings.Contains(f.IO.String(), "Starred owner/repo") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestRepoUnstar_NotStarred(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/unstar") {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNotModified)
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRepoUnstarCmd(f.Factory)
+	cmd.SetArgs([]string{})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(f.IO.ErrString(), "test-owner/test-repo is not starred") {
+		t.Errorf("unexpected stderr: %s", f.IO.ErrString())
+	}
+}
+
+func TestRepoStars(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		wantPath string
+		wantQ    string
+	}{
+		{"mine", []string{"--format", "json"}, "/api/v4/projects", "starred=true"},
+		{"user", []string{"--user", "johndoe", "--format", "json"}, "/api/v4/users/johndoe/starred_projects", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != tt.wantPath || !strings.Contains(r.URL.RawQuery, tt.wantQ) {
+					t.Errorf("unexpected request: %s?%s", r.URL.Path, r.URL.RawQuery)
+				}
+				cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+					cmdtest.MockProject(1, "starred-repo", "group/starred-repo"),
+				})
+			})
+
+			f := cmdtest.NewTestFactory(t)
+			cmd := newRepoStarsCmd(f.Factory)
+			cmd.SetArgs(tt.args)
+
+			if err := cmd.Execute(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !strings.Contains(f.IO.String(), "starred-repo") {
+				t.Errorf("unexpected output: %s", f.IO.String())
+			}
+		})
+	}
+}
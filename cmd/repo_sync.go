@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	gitutil "github.com/PhilipKram/gitlab-cli/internal/git"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func newRepoSyncCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		upstream string
+		branch   string
+		push     bool
+		prune    bool
+		dryRun   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Update the local clone and fork from upstream",
+		Long: `Fetch the upstream remote and fast-forward the local default branch to it.
+
+The upstream remote is the remote named "upstream" if there is one, and the
+configured git remote otherwise. With --push, the updated branch is also
+pushed to the configured git remote, which in a fork-based workflow is your
+fork.
+
+With --prune, local branches whose merge requests have been merged are
+deleted. A branch is only deleted if its tip is the head commit of a merged
+merge request, so commits added after the merge are never lost. Use
+--dry-run to see which branches would be deleted.`,
+		Example: `  $ glab repo sync
+  $ glab repo sync --push --prune
+  $ glab repo sync --upstream origin --branch develop
+  $ glab repo sync --prune --dry-run`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			remote, err := f.Remote()
+			if err != nil {
+				return err
+			}
+
+			upstreamRemote, err := syncUpstreamRemote(upstream, remote)
+			if err != nil {
+				return err
+			}
+			if push && upstreamRemote.Name == remote.Name {
+				return fmt.Errorf("--push needs a fork: the upstream and configured remote are both %q", remote.Name)
+			}
+
+			out := f.IOStreams.Out
+			errOut := f.IOStreams.ErrOut
+
+			_, _ = fmt.Fprintf(errOut, "Fetching %s...\n", upstreamRemote.Name)
+			if err := gitutil.Fetch(upstreamRemote.Name); err != nil {
+				return err
+			}
+
+			if branch == "" {
+				branch, err = gitutil.DefaultBranch(upstreamRemote.Name)
+				if err != nil {
+					return fmt.Errorf("could not determine the default branch; use --branch: %w", err)
+				}
+			}
+
+			if err := gitutil.FastForwardBranch(upstreamRemote.Name, branch); err != nil {
+				return err
+			}
+			_, _ = fmt.Fprintf(out, "Synced %s with %s/%s\n", branch, upstreamRemote.Name, branch)
+
+			if push {
+				if err := gitutil.Push(remote.Name, branch); err != nil {
+					return err
+				}
+				_, _ = fmt.Fprintf(out, "Pushed %s to %s\n", branch, remote.Name)
+			}
+
+			if !prune {
+				return nil
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			// Merge requests from forks are opened against the upstream
+			// project, so look them up there.
+			project := upstreamRemote.Owner + "/" + upstreamRemote.Repo
+			if upstreamRemote.Owner == "" || upstreamRemote.Repo == "" {
+				project, err = f.FullProjectPath()
+				if err != nil {
+					return err
+				}
+			}
+
+			return pruneMergedBranches(cmd, f, client, project, branch, dryRun)
+		},
+	}
+
+	cmd.Flags().StringVar(&upstream, "upstream", "", "Remote to sync from (default: \"upstream\" if it exists, else the configured remote)")
+	cmd.Flags().StringVarP(&branch, "branch", "b", "", "Branch to sync (default: the upstream default branch)")
+	cmd.Flags().BoolVar(&push, "push", false, "Push the synced branch to the configured remote (your fork)")
+	cmd.Flags().BoolVar(&prune, "prune", false, "Delete local branches whose merge requests are merged")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show which branches --prune would delete without deleting them")
+
+	return cmd
+}
+
+// syncUpstreamRemote returns the remote "repo sync" fetches from: the named
+// remote, the "upstream" remote, or the configured remote, in that order.
+func syncUpstreamRemote(name string, configured *gitutil.Remote) (*gitutil.Remote, error) {
+	remotes, err := gitutil.Remotes()
+	if err != nil {
+		return nil, err
+	}
+
+	want := name
+	if want == "" {
+		want = "upstream"
+	}
+	for _, r := range remotes {
+		if r.Name == want {
+			return &r, nil
+		}
+	}
+	if name != "" {
+		return nil, fmt.Errorf("no git remote named %q", name)
+	}
+	return configured, nil
+}
+
+// pruneMergedBranches deletes the local branches, other than keep and the
+// checked-out branch, whose tip is the head of a merged merge request.
+func pruneMergedBranches(cmd *cobra.Command, f *cmdutil.Factory, client *api.Client, project, keep string, dryRun bool) error {
+	branches, err := gitutil.LocalBranches()
+	if err != nil {
+		return err
+	}
+	current, _ := gitutil.CurrentBranch()
+
+	out := f.IOStreams.Out
+	pruned := 0
+	for _, b := range branches {
+		if b == keep || b == current {
+			continue
+		}
+		sha, err := gitutil.BranchSHA(b)
+		if err != nil {
+			return err
+		}
+
+		mrs, resp, err := client.MergeRequests.ListProjectMergeRequests(project, &gitlab.ListProjectMergeRequestsOptions{
+			SourceBranch: gitlab.Ptr(b),
+			State:        gitlab.Ptr("merged"),
+			ListOptions:  gitlab.ListOptions{PerPage: 20},
+		}, gitlab.WithContext(cmd.Context()))
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			url := api.APIURL(client.Host()) + "/projects/" + project + "/merge_requests"
+			return errors.NewAPIError("GET", url, statusCode, "Failed to list merge requests", err)
+		}
+
+		var merged *gitlab.BasicMergeRequest
+		for _, mr := range mrs {
+			if mr.SHA == sha {
+				merged = mr
+				break
+			}
+		}
+		if merged == nil {
+			continue
+		}
+
+		if dryRun {
+			_, _ = fmt.Fprintf(out, "Would delete branch %s (!%d merged)\n", b, merged.IID)
+		} else {
+			if err := gitutil.DeleteBranch(b); err != nil {
+				return err
+			}
+			_, _ = fmt.Fprintf(out, "Deleted branch %s (!%d merged)\n", b, merged.IID)
+		}
+		pruned++
+	}
+
+	if pruned == 0 {
+		_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No merged branches to prune")
+	}
+	return nil
+}
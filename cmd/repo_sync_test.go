@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+	gitutil "github.com/PhilipKram/gitlab-cli/internal/git"
+)
+
+// setupSyncRepos creates an upstream and a fork bare repository and a clone
+// with both as remotes, checked out on main. It returns the clone's path and
+// a function that runs git in a directory.
+func setupSyncRepos(t *testing.T) (string, func(dir string, args ...string) string) {
+	t.Helper()
+	t.Setenv("GIT_AUTHOR_NAME", "Test")
+	t.Setenv("GIT_AUTHOR_EMAIL", "test@test.com")
+	t.Setenv("GIT_COMMITTER_NAME", "Test")
+	t.Setenv("GIT_COMMITTER_EMAIL", "test@test.com")
+
+	git := func(dir string, args ...string) string {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v failed: %v\n%s", args, err, out)
+		}
+		return strings.TrimSpace(string(out))
+	}
+
+	root := t.TempDir()
+	upstream := filepath.Join(root, "upstream.git")
+	fork := filepath.Join(root, "fork.git")
+	clone := filepath.Join(root, "clone")
+	git(root, "init", "--bare", "-b", "main", upstream)
+	git(root, "init", "--bare", "-b", "main", fork)
+
+	seed := filepath.Join(root, "seed")
+	git(root, "clone", upstream, seed)
+	if err := os.WriteFile(filepath.Join(seed, "README.md"), []byte("v1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	git(seed, "add", ".")
+	git(seed, "commit", "-m", "v1")
+	git(seed, "push", "origin", "HEAD:main")
+
+	git(root, "clone", "-o", "upstream", upstream, clone)
+	git(clone, "remote", "add", "origin", fork)
+
+	// Move upstream ahead of the clone.
+	if err := os.WriteFile(filepath.Join(seed, "README.md"), []byte("v2\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	git(seed, "commit", "-am", "v2")
+	git(seed, "push", "origin", "HEAD:main")
+
+	return clone, git
+}
+
+func TestRepoSync_PushAndPrune(t *testing.T) {
+	clone, git := setupSyncRepos(t)
+	git(clone, "branch", "merged-feature")
+	git(clone, "checkout", "-b", "wip")
+	git(clone, "commit", "--allow-empty", "-m", "wip")
+	git(clone, "checkout", "main")
+	mergedSHA := git(clone, "rev-parse", "merged-feature")
+
+	var queried []string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		source := r.URL.Query().Get("source_branch")
+		queried = append(queried, source)
+		if r.URL.Query().Get("state") != "merged" {
+			t.Errorf("expected state=merged, got %q", r.URL.RawQuery)
+		}
+		switch source {
+		case "merged-feature":
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{{"iid": 12, "sha": mergedSHA}})
+		case "wip":
+			// A merged merge request from another fork's branch of the
+			// same name must not delete the local branch.
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{{"iid": 13, "sha": "0000000"}})
+		default:
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{})
+		}
+	})
+
+	t.Chdir(clone)
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRepoSyncCmd(f.Factory)
+	cmd.SetArgs([]string{"--push", "--prune"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := f.IO.String()
+	for _, want := range []string{
+		"Synced main with upstream/main",
+		"Pushed main to origin",
+		"Deleted branch merged-feature (!12 merged)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in output, got: %s", want, out)
+		}
+	}
+
+	upstreamMain := git(clone, "rev-parse", "upstream/main")
+	if got := git(clone, "rev-parse", "main"); got != upstreamMain {
+		t.Errorf("main = %s, want upstream/main %s", got, upstreamMain)
+	}
+	if got := git(clone, "ls-remote", "origin", "refs/heads/main"); !strings.HasPrefix(got, upstreamMain) {
+		t.Errorf("fork main = %q, want %s", got, upstreamMain)
+	}
+	if gitutil.BranchExists("merged-feature") {
+		t.Error("expected merged-feature to be deleted")
+	}
+	if !gitutil.BranchExists("wip") {
+		t.Error("expected wip to be kept")
+	}
+	if strings.Join(queried, ",") != "merged-feature,wip" {
+		t.Errorf("unexpected branches queried: %v", queried)
+	}
+}
+
+func TestRepoSync_NotOnDefaultBranch(t *testing.T) {
+	clone, git := setupSyncRepos(t)
+	git(clone, "checkout", "-b", "topic")
+
+	t.Chdir(clone)
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRepoSyncCmd(f.Factory)
+	cmd.SetArgs([]string{})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := git(clone, "rev-parse", "main"), git(clone, "rev-parse", "upstream/main"); got != want {
+		t.Errorf("main = %s, want %s", got, want)
+	}
+	if got := git(clone, "rev-parse", "--abbrev-ref", "HEAD"); got != "topic" {
+		t.Errorf("checked-out branch changed to %s", got)
+	}
+}
+
+func TestRepoSync_PushWithoutFork(t *testing.T) {
+	clone, git := setupSyncRepos(t)
+	git(clone, "remote", "remove", "origin")
+	git(clone, "remote", "rename", "upstream", "origin")
+
+	t.Chdir(clone)
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRepoSyncCmd(f.Factory)
+	cmd.SetArgs([]string{"--push"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "needs a fork") {
+		t.Errorf("expected fork error, got %v", err)
+	}
+}
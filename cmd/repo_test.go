@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -36,7 +38,21 @@ func TestRepoCmd_HasSubcommands(t *testing.T) {
 		"view",
 		"list",
 		"archive",
+		"download",
 		"delete",
+		"env-file",
+		"file",
+		"edit",
+		"transfer",
+		"mirror",
+		"housekeeping",
+		"sync",
+		"set-default",
+		"star",
+		"unstar",
+		"stars",
+		"notifications",
+		"clone-group",
 	}
 
 	subcommands := cmd.Commands()
@@ -431,3 +447,90 @@ func TestRepoList_EmptyResult(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestRepoDownload(t *testing.T) {
+	var gotPath, gotSHA, gotSubPath string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotSHA = r.URL.Query().Get("sha")
+		gotSubPath = r.URL.Query().Get("path")
+		_, _ = w.Write([]byte("archive-bytes"))
+	})
+
+	dest := filepath.Join(t.TempDir(), "out.zip")
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRepoDownloadCmd(f.Factory)
+	cmd.SetArgs([]string{"--ref", "v1.2.0", "--format", "zip", "--path", "docs", "--output", dest})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotPath != "/api/v4/projects/test-owner/test-repo/repository/archive.zip" {
+		t.Errorf("unexpected path: %s", gotPath)
+	}
+	if gotSHA != "v1.2.0" || gotSubPath != "docs" {
+		t.Errorf("unexpected query: sha=%q path=%q", gotSHA, gotSubPath)
+	}
+	data, err := os.ReadFile(dest)
+	if err != nil {
+		t.Fatalf("failed to read archive: %v", err)
+	}
+	if string(data) != "archive-bytes" {
+		t.Errorf("unexpected archive contents: %q", data)
+	}
+	if !strings.Contains(f.IO.String(), "Downloaded "+dest+" (13 B)") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestRepoDownload_DefaultName(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/projects/test-owner/test-repo" {
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 1, "default_branch": "feature/x"})
+			return
+		}
+		_, _ = w.Write([]byte("tarball"))
+	})
+
+	t.Chdir(t.TempDir())
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRepoDownloadCmd(f.Factory)
+	cmd.SetArgs([]string{})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := os.Stat("test-repo-feature-x.tar.gz"); err != nil {
+		t.Errorf("expected default archive name: %v", err)
+	}
+}
+
+func TestRepoDownload_Errors(t *testing.T) {
+	t.Run("invalid format", func(t *testing.T) {
+		f := cmdtest.NewTestFactory(t)
+		cmd := newRepoDownloadCmd(f.Factory)
+		cmd.SetArgs([]string{"--format", "rar"})
+		err := cmd.Execute()
+		if err == nil || !strings.Contains(err.Error(), "invalid format") {
+			t.Errorf("expected invalid format error, got %v", err)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+			cmdtest.ErrorResponse(w, 404, "404 Not Found")
+		})
+
+		dir := t.TempDir()
+		f := cmdtest.NewTestFactory(t)
+		cmd := newRepoDownloadCmd(f.Factory)
+		cmd.SetArgs([]string{"--ref", "missing", "--output", filepath.Join(dir, "out.tar.gz")})
+		err := cmd.Execute()
+		if err == nil || !strings.Contains(err.Error(), "Failed to download repository archive") {
+			t.Errorf("expected API error, got %v", err)
+		}
+		if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+			t.Errorf("expected no files to be left behind, found %d", len(entries))
+		}
+	})
+}
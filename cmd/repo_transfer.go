@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func newRepoTransferCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		namespace string
+		confirm   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "transfer <owner/repo>",
+		Short: "Transfer a repository to another namespace",
+		Long: `Move a repository to another group or user namespace.
+
+The repository's URLs change, so existing clones need their remotes updated.
+GitLab redirects the old paths until they are reused.`,
+		Example: `  $ glab repo transfer owner/repo --to-namespace new-group --confirm
+  $ glab repo transfer owner/repo --to-namespace new-group/subgroup --confirm`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !confirm {
+				return fmt.Errorf("use --confirm to transfer repository %s to %s", args[0], namespace)
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, resp, err := client.Projects.TransferProject(args[0], &gitlab.TransferProjectOptions{
+				Namespace: namespace,
+			}, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + args[0] + "/transfer"
+				return errors.NewAPIError("PUT", url, statusCode, "Failed to transfer repository", err)
+			}
+
+			out := f.IOStreams.Out
+			_, _ = fmt.Fprintf(out, "Transferred %s to %s\n", args[0], project.PathWithNamespace)
+			_, _ = fmt.Fprintf(out, "%s\n", project.WebURL)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&namespace, "to-namespace", "", "Path or ID of the group or user namespace to move the repository to (required)")
+	cmd.Flags().BoolVar(&confirm, "confirm", false, "Confirm the transfer")
+	_ = cmd.MarkFlagRequired("to-namespace")
+
+	return cmd
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestRepoTransfer(t *testing.T) {
+	var namespace interface{}
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" && strings.HasSuffix(r.URL.Path, "/projects/owner/repo/transfer") {
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			namespace = body["namespace"]
+			cmdtest.JSONResponse(w, 200, map[string]interface{}{
+				"id": 1, "path_with_namespace": "new-group/repo", "web_url": "https://gitlab.com/new-group/repo",
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "404 Not Found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRepoTransferCmd(f.Factory)
+	cmd.SetArgs([]string{"owner/repo", "--to-namespace", "new-group", "--confirm"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if namespace != "new-group" {
+		t.Errorf("namespace = %v, want new-group", namespace)
+	}
+	if !strings.Contains(f.IO.String(), "Transferred owner/repo to new-group/repo") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestRepoTransfer_RequiresConfirm(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRepoTransferCmd(f.Factory)
+	cmd.SetArgs([]string{"owner/repo", "--to-namespace", "new-group"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "--confirm") {
+		t.Errorf("expected confirm error, got %v", err)
+	}
+}
@@ -1,8 +1,13 @@
 package cmd
 
 import (
+	"fmt"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
 	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/config"
 	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/PhilipKram/gitlab-cli/internal/prompt"
 	"github.com/PhilipKram/gitlab-cli/internal/update"
 	"github.com/spf13/cobra"
 )
@@ -11,9 +16,12 @@ import (
 func NewRootCmd(version string) *cobra.Command {
 	f := cmdutil.NewFactory()
 	f.Version = version
+	api.SetVersion(version)
 
 	var repoOverride string
 	var verbose bool
+	var noColor bool
+	var promptMode string
 
 	cmd := &cobra.Command{
 		Use:   "glab <command> <subcommand> [flags]",
@@ -27,14 +35,33 @@ func NewRootCmd(version string) *cobra.Command {
 		SilenceErrors: true,
 		SilenceUsage:  true,
 		Version:       version,
-		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			// Enable verbose mode if flag is set or GLAB_DEBUG is set
 			if verbose {
 				errors.SetVerboseMode(true)
 			}
 			if repoOverride != "" {
-				f.SetRepoOverride(repoOverride)
+				if err := f.SetRepoOverride(repoOverride); err != nil {
+					return fmt.Errorf("invalid value for --repo: %w", err)
+				}
+			} else if pc, err := f.ProjectConfig(); err != nil {
+				return err
+			} else if pc != nil && pc.Repo != "" {
+				if err := f.SetRepoOverride(pc.Repo); err != nil {
+					return fmt.Errorf("invalid repo in %s: %w", config.ProjectConfigFile, err)
+				}
+			}
+			if noColor {
+				f.IOStreams.DisableColor()
+			}
+			switch promptMode {
+			case "":
+			case "enabled", "disabled":
+				f.IOStreams.SetPromptDisabled(promptMode == "disabled")
+			default:
+				return fmt.Errorf("invalid value for --prompt: %q (must be enabled or disabled)", promptMode)
 			}
+			prompt.SetDisabled(f.IOStreams.PromptDisabled())
 
 			// Detect format flag for error formatting
 			// Check if --format=json or --json is set on any command in the chain
@@ -52,11 +79,14 @@ func NewRootCmd(version string) *cobra.Command {
 			if version != "dev" {
 				go update.CheckAndCache(version)
 			}
+			return nil
 		},
 	}
 
-	cmd.PersistentFlags().StringVarP(&repoOverride, "repo", "R", "", "Select a GitLab repository using the HOST/OWNER/REPO format")
+	cmd.PersistentFlags().StringVarP(&repoOverride, "repo", "R", "", "Select a GitLab repository as [HOST/]GROUP/PROJECT, a project URL, or a project ID")
 	cmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Enable verbose output with detailed request/response information (can also set GLAB_DEBUG=1)")
+	cmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "Disable colored output (can also set NO_COLOR=1)")
+	cmd.PersistentFlags().StringVar(&promptMode, "prompt", "", "Set to disabled to fail instead of prompting for input (can also set GLAB_PROMPT_DISABLED=1; disabled in CI)")
 	cmd.SetVersionTemplate("glab version {{.Version}}\n")
 
 	// Core commands
@@ -64,28 +94,52 @@ func NewRootCmd(version string) *cobra.Command {
 	cmd.AddCommand(NewMRCmd(f))
 	cmd.AddCommand(NewIssueCmd(f))
 	cmd.AddCommand(NewRepoCmd(f))
+	cmd.AddCommand(NewStatusCmd(f))
 
 	// CI/CD commands
 	cmd.AddCommand(NewPipelineCmd(f))
+	cmd.AddCommand(NewJobCmd(f))
+	cmd.AddCommand(NewScheduleCmd(f))
+	cmd.AddCommand(NewTriggerTokenCmd(f))
+	cmd.AddCommand(NewRunnerCmd(f))
 	cmd.AddCommand(NewReleaseCmd(f))
 	cmd.AddCommand(NewVariableCmd(f))
+	cmd.AddCommand(NewSecureFileCmd(f))
 	cmd.AddCommand(NewPackageCmd(f))
 	cmd.AddCommand(NewRegistryCmd(f))
 	cmd.AddCommand(NewEnvironmentCmd(f))
 	cmd.AddCommand(NewDeploymentCmd(f))
+	cmd.AddCommand(NewDeployKeyCmd(f))
+	cmd.AddCommand(NewDeployTokenCmd(f))
 
 	// Additional commands
 	cmd.AddCommand(NewSnippetCmd(f))
+	cmd.AddCommand(NewWikiCmd(f))
 	cmd.AddCommand(NewLabelCmd(f))
+	cmd.AddCommand(NewMilestoneCmd(f))
+	cmd.AddCommand(NewBoardCmd(f))
 	cmd.AddCommand(NewProjectCmd(f))
+	cmd.AddCommand(NewGroupCmd(f))
+	cmd.AddCommand(NewHookCmd(f))
 	cmd.AddCommand(NewBranchCmd(f))
 	cmd.AddCommand(NewTagCmd(f))
+	cmd.AddCommand(NewProtectCmd(f))
+	cmd.AddCommand(NewUnprotectCmd(f))
+	cmd.AddCommand(NewCommitCmd(f))
 	cmd.AddCommand(NewUserCmd(f))
+	cmd.AddCommand(NewSSHKeyCmd(f))
+	cmd.AddCommand(NewGPGKeyCmd(f))
+	cmd.AddCommand(NewTokenCmd(f))
+	cmd.AddCommand(NewTodoCmd(f))
+	cmd.AddCommand(NewActivityCmd(f))
+	cmd.AddCommand(NewAuditCmd(f))
 
 	// Utility commands
 	cmd.AddCommand(NewAPICmd(f))
 	cmd.AddCommand(NewBrowseCmd(f))
+	cmd.AddCommand(NewSearchCmd(f))
 	cmd.AddCommand(NewConfigCmd(f))
+	cmd.AddCommand(NewAliasCmd(f))
 	cmd.AddCommand(NewCompletionCmd())
 	cmd.AddCommand(NewMCPCmd(f))
 	cmd.AddCommand(NewUpgradeCmd(f))
@@ -115,28 +169,52 @@ Core Commands:
   mr          Manage merge requests
   issue       Manage issues
   repo        Manage repositories
+  status      Show what needs your attention
 
 CI/CD Commands:
-  pipeline     Manage pipelines and CI/CD
-  release      Manage releases
-  variable     Manage CI/CD variables
-  package      Manage package registries
-  registry     Manage container registries
-  environment  Manage environments
-  deployment   Manage deployments
+  pipeline      Manage pipelines and CI/CD
+  job           Manage CI/CD jobs
+  schedule      Manage pipeline schedules
+  trigger-token Manage pipeline trigger tokens
+  runner        Manage CI/CD runners
+  release       Manage releases
+  variable      Manage CI/CD variables
+  securefile    Manage project secure files
+  package       Manage package registries
+  registry      Manage container registries
+  environment   Manage environments
+  deployment    Manage deployments
+  deploy-key    Manage deploy keys
+  deploy-token  Manage deploy tokens
 
 Additional Commands:
   snippet     Manage snippets
+  wiki        Manage wiki pages
   label       Manage labels
+  milestone   Manage milestones
+  board       Manage issue boards
   project     Manage projects
+  group       Manage groups
+  hook        Manage project webhooks
   branch      Manage branches
   tag         Manage tags
+  protect     Manage protected branches, tags, and environments
+  unprotect   Remove protection from branches, tags, and environments
+  commit      Inspect repository commits
   user        Manage users and user information
+  ssh-key     Manage SSH keys
+  gpg-key     Manage GPG keys
+  token       Manage personal access tokens
+  todo        Manage your to-do list
+  activity    Show recent project activity
+  audit       Inspect audit events
 
 Utility Commands:
   api         Make authenticated API requests
   browse      Open project in browser
+  search      Search across GitLab
   config      Manage configuration
+  alias       Create command shortcuts
   completion  Generate shell completion scripts
   mcp         Model Context Protocol server
   upgrade     Upgrade glab to the latest version
@@ -1,7 +1,10 @@
 package cmd
 
 import (
+	"strings"
 	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/prompt"
 )
 
 func TestNewRootCmd(t *testing.T) {
@@ -44,3 +47,58 @@ func TestRootCmd_Version(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestRootCmd_PromptFlag(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+	t.Cleanup(func() { prompt.SetDisabled(false) })
+
+	cmd := NewRootCmd("dev")
+	cmd.SetArgs([]string{"--prompt", "never", "config", "get", "git_remote"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "--prompt") {
+		t.Fatalf("expected an invalid --prompt error, got %v", err)
+	}
+
+	cmd = NewRootCmd("dev")
+	cmd.SetArgs([]string{"--prompt=disabled", "config", "get", "git_remote"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !prompt.Disabled() {
+		t.Error("expected --prompt=disabled to disable prompts")
+	}
+
+	cmd = NewRootCmd("dev")
+	cmd.SetArgs([]string{"--prompt=enabled", "config", "get", "git_remote"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if prompt.Disabled() {
+		t.Error("expected --prompt=enabled to enable prompts")
+	}
+}
+
+func TestUsageTemplate_SectionsAligned(t *testing.T) {
+	var section string
+	column := 0
+	for _, line := range strings.Split(usageTemplate, "\n") {
+		if strings.HasSuffix(line, "Commands:") {
+			section, column = line, 0
+			continue
+		}
+		if section == "" || !strings.HasPrefix(line, "  ") || strings.Contains(line, "{{") {
+			section = ""
+			continue
+		}
+		name := strings.Fields(line)[0]
+		rest := strings.TrimPrefix(line, "  "+name)
+		desc := len(line) - len(strings.TrimLeft(rest, " "))
+		if desc == len(line)-len(rest) {
+			t.Errorf("%s no space after %q", section, name)
+		}
+		if column == 0 {
+			column = desc
+		} else if desc != column {
+			t.Errorf("%s description of %q starts at column %d, want %d", section, name, desc, column)
+		}
+	}
+}
@@ -0,0 +1,422 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// NewRunnerCmd creates the runner command group.
+func NewRunnerCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "runner <command>",
+		Short: "Manage CI/CD runners",
+		Long: `List, inspect, pause, and remove CI/CD runners.
+
+Runners are listed for the current project by default. Use --group to list
+the runners of a group, or --instance to list every runner on the instance
+(requires administrator access).`,
+	}
+
+	cmd.AddCommand(newRunnerListCmd(f))
+	cmd.AddCommand(newRunnerViewCmd(f))
+	cmd.AddCommand(newRunnerPauseCmd(f, true))
+	cmd.AddCommand(newRunnerPauseCmd(f, false))
+	cmd.AddCommand(newRunnerDeleteCmd(f))
+	cmd.AddCommand(newRunnerRegisterTokenCmd(f))
+
+	return cmd
+}
+
+func newRunnerListCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		group      string
+		instance   bool
+		runnerType string
+		status     string
+		tags       []string
+		limit      int
+		format     string
+		jsonFlag   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List runners",
+		Aliases: []string{"ls"},
+		Example: `  $ glab runner list
+  $ glab runner list --group my-group --status offline
+  $ glab runner list --instance --type instance_type
+  $ glab runner list --tag docker,linux`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			opts := gitlab.ListRunnersOptions{
+				ListOptions: gitlab.ListOptions{PerPage: int64(limit)},
+			}
+			if runnerType != "" {
+				opts.Type = &runnerType
+			}
+			if status != "" {
+				opts.Status = &status
+			}
+			if len(tags) > 0 {
+				opts.TagList = &tags
+			}
+
+			var (
+				runners []*gitlab.Runner
+				resp    *gitlab.Response
+				url     string
+			)
+			switch {
+			case instance:
+				url = api.APIURL(client.Host()) + "/runners/all"
+				runners, resp, err = client.Runners.ListAllRunners(&opts)
+			case group != "":
+				url = api.APIURL(client.Host()) + "/groups/" + group + "/runners"
+				runners, resp, err = client.Runners.ListGroupsRunners(group, &gitlab.ListGroupsRunnersOptions{
+					ListOptions: opts.ListOptions,
+					Type:        opts.Type,
+					Status:      opts.Status,
+					TagList:     opts.TagList,
+				})
+			default:
+				project, projErr := f.FullProjectPath()
+				if projErr != nil {
+					return projErr
+				}
+				url = api.APIURL(client.Host()) + "/projects/" + project + "/runners"
+				projectOpts := gitlab.ListProjectRunnersOptions(opts)
+				runners, resp, err = client.Runners.ListProjectRunners(project, &projectOpts)
+			}
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				return errors.NewAPIError("GET", url, statusCode, "Failed to list runners", err)
+			}
+
+			if len(runners) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No runners found")
+				return nil
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(runners, format, jsonFlag)
+			}
+
+			tp := f.NewTablePrinter()
+			for _, r := range runners {
+				state := r.Status
+				if r.Paused {
+					state += " (paused)"
+				}
+				tp.AddRow(
+					fmt.Sprintf("%d", r.ID),
+					r.Description,
+					r.RunnerType,
+					state,
+				)
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().StringVarP(&group, "group", "g", "", "List runners of a group")
+	cmd.Flags().BoolVar(&instance, "instance", false, "List all runners on the instance (administrators only)")
+	cmd.Flags().StringVar(&runnerType, "type", "", "Filter by type: instance_type, group_type, or project_type")
+	cmd.Flags().StringVar(&status, "status", "", "Filter by status: online, offline, stale, or never_contacted")
+	cmd.Flags().StringSliceVar(&tags, "tag", nil, "Filter by runner tags (comma-separated)")
+	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	cmd.MarkFlagsMutuallyExclusive("group", "instance")
+
+	return cmd
+}
+
+func newRunnerViewCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "view <id>",
+		Short:   "View a runner",
+		Example: `  $ glab runner view 42`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			runnerID, err := parseRunnerArg(args)
+			if err != nil {
+				return err
+			}
+
+			runner, resp, err := client.Runners.GetRunnerDetails(runnerID)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/runners/%d", api.APIURL(client.Host()), runnerID)
+				return errors.NewAPIError("GET", url, statusCode, "Failed to get runner", err)
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(runner, format, jsonFlag)
+			}
+
+			out := f.IOStreams.Out
+			_, _ = fmt.Fprintf(out, "Runner #%d %s\n", runner.ID, runner.Description)
+			_, _ = fmt.Fprintf(out, "Status:     %s\n", runner.Status)
+			_, _ = fmt.Fprintf(out, "Paused:     %t\n", runner.Paused)
+			_, _ = fmt.Fprintf(out, "Type:       %s\n", runner.RunnerType)
+			if len(runner.TagList) > 0 {
+				_, _ = fmt.Fprintf(out, "Tags:       %s\n", strings.Join(runner.TagList, ", "))
+			}
+			_, _ = fmt.Fprintf(out, "Untagged:   %t\n", runner.RunUntagged)
+			_, _ = fmt.Fprintf(out, "Locked:     %t\n", runner.Locked)
+			if runner.AccessLevel != "" {
+				_, _ = fmt.Fprintf(out, "Access:     %s\n", runner.AccessLevel)
+			}
+			if runner.MaximumTimeout > 0 {
+				_, _ = fmt.Fprintf(out, "Timeout:    %ds\n", runner.MaximumTimeout)
+			}
+			if runner.Version != "" {
+				_, _ = fmt.Fprintf(out, "Version:    %s\n", runner.Version)
+			}
+			if runner.ContactedAt != nil {
+				_, _ = fmt.Fprintf(out, "Contacted:  %s\n", timeAgo(runner.ContactedAt))
+			}
+			if runner.MaintenanceNote != "" {
+				_, _ = fmt.Fprintf(out, "Note:       %s\n", runner.MaintenanceNote)
+			}
+			if len(runner.Projects) > 0 {
+				_, _ = fmt.Fprintln(out, "Projects:")
+				for _, p := range runner.Projects {
+					_, _ = fmt.Fprintf(out, "  %s\n", p.PathWithNamespace)
+				}
+			}
+			if len(runner.Groups) > 0 {
+				_, _ = fmt.Fprintln(out, "Groups:")
+				for _, g := range runner.Groups {
+					_, _ = fmt.Fprintf(out, "  %s\n", g.Name)
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+// newRunnerPauseCmd creates the pause command, or resume when paused is false.
+func newRunnerPauseCmd(f *cmdutil.Factory, paused bool) *cobra.Command {
+	use, short, done := "pause", "Pause a runner so it picks up no new jobs", "Paused"
+	if !paused {
+		use, short, done = "resume", "Resume a paused runner", "Resumed"
+	}
+
+	cmd := &cobra.Command{
+		Use:     use + " <id>",
+		Short:   short,
+		Example: fmt.Sprintf(`  $ glab runner %s 42`, use),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			runnerID, err := parseRunnerArg(args)
+			if err != nil {
+				return err
+			}
+
+			_, resp, err := client.Runners.UpdateRunnerDetails(runnerID, &gitlab.UpdateRunnerDetailsOptions{
+				Paused: &paused,
+			})
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/runners/%d", api.APIURL(client.Host()), runnerID)
+				return errors.NewAPIError("PUT", url, statusCode, fmt.Sprintf("Failed to %s runner", use), err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "%s runner #%d\n", done, runnerID)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newRunnerDeleteCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete <id>",
+		Short:   "Delete a runner",
+		Example: `  $ glab runner delete 42`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			runnerID, err := parseRunnerArg(args)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Runners.RemoveRunner(runnerID)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/runners/%d", api.APIURL(client.Host()), runnerID)
+				return errors.NewAPIError("DELETE", url, statusCode, "Failed to delete runner", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Deleted runner #%d\n", runnerID)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newRunnerRegisterTokenCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		group    string
+		instance bool
+		reset    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "register-token",
+		Short: "Print the runner registration token",
+		Long: `Print the runner registration token of the current project or of a group.
+
+Only the token is written to stdout so it can be piped into
+"gitlab-runner register". Use --reset to rotate the token first; the
+instance-wide token can only be obtained by resetting it.`,
+		Example: `  $ glab runner register-token
+  $ glab runner register-token --group my-group
+  $ glab runner register-token --reset
+  $ glab runner register-token --instance --reset`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if instance && !reset {
+				return fmt.Errorf("the instance registration token can only be retrieved with --reset")
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			var (
+				token  string
+				resp   *gitlab.Response
+				method = "GET"
+				url    string
+			)
+			switch {
+			case instance:
+				method, url = "POST", api.APIURL(client.Host())+"/runners/reset_registration_token"
+				var t *gitlab.RunnerRegistrationToken
+				t, resp, err = client.Runners.ResetInstanceRunnerRegistrationToken()
+				if err == nil && t.Token != nil {
+					token = *t.Token
+				}
+			case group != "" && reset:
+				method, url = "POST", api.APIURL(client.Host())+"/groups/"+group+"/runners/reset_registration_token"
+				var t *gitlab.RunnerRegistrationToken
+				t, resp, err = client.Runners.ResetGroupRunnerRegistrationToken(group)
+				if err == nil && t.Token != nil {
+					token = *t.Token
+				}
+			case group != "":
+				url = api.APIURL(client.Host()) + "/groups/" + group
+				var g *gitlab.Group
+				g, resp, err = client.Groups.GetGroup(group, nil)
+				if err == nil {
+					token = g.RunnersToken
+				}
+			default:
+				project, projErr := f.FullProjectPath()
+				if projErr != nil {
+					return projErr
+				}
+				if reset {
+					method, url = "POST", api.APIURL(client.Host())+"/projects/"+project+"/runners/reset_registration_token"
+					var t *gitlab.RunnerRegistrationToken
+					t, resp, err = client.Runners.ResetProjectRunnerRegistrationToken(project)
+					if err == nil && t.Token != nil {
+						token = *t.Token
+					}
+				} else {
+					url = api.APIURL(client.Host()) + "/projects/" + project
+					var p *gitlab.Project
+					p, resp, err = client.Projects.GetProject(project, nil)
+					if err == nil {
+						token = p.RunnersToken
+					}
+				}
+			}
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				return errors.NewAPIError(method, url, statusCode, "Failed to get runner registration token", err)
+			}
+
+			if token == "" {
+				return fmt.Errorf("no runner registration token available; it requires at least the Maintainer role, and may be disabled in favor of runner authentication tokens")
+			}
+
+			_, _ = fmt.Fprintln(f.IOStreams.Out, token)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&group, "group", "g", "", "Get the registration token of a group")
+	cmd.Flags().BoolVar(&instance, "instance", false, "Reset and print the instance registration token (administrators only)")
+	cmd.Flags().BoolVar(&reset, "reset", false, "Reset the registration token before printing it")
+	cmd.MarkFlagsMutuallyExclusive("group", "instance")
+
+	return cmd
+}
+
+func parseRunnerArg(args []string) (int64, error) {
+	if len(args) == 0 {
+		return 0, fmt.Errorf("runner ID required")
+	}
+	id, err := strconv.ParseInt(strings.TrimPrefix(args[0], "#"), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid runner ID: %s", args[0])
+	}
+	return id, nil
+}
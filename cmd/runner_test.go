@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestNewRunnerCmd(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewRunnerCmd(f)
+
+	if cmd.Use != "runner <command>" {
+		t.Errorf("expected Use to be 'runner <command>', got %q", cmd.Use)
+	}
+
+	if cmd.Short != "Manage CI/CD runners" {
+		t.Errorf("expected Short to be 'Manage CI/CD runners', got %q", cmd.Short)
+	}
+}
+
+func TestRunnerCmd_HasSubcommands(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewRunnerCmd(f)
+
+	expectedSubcommands := []string{
+		"list",
+		"view",
+		"pause",
+		"resume",
+		"delete",
+		"register-token",
+	}
+
+	subcommands := cmd.Commands()
+	if len(subcommands) != len(expectedSubcommands) {
+		t.Errorf("expected %d subcommands, got %d", len(expectedSubcommands), len(subcommands))
+	}
+
+	foundSubcommands := make(map[string]bool)
+	for _, subcmd := range subcommands {
+		foundSubcommands[subcmd.Name()] = true
+	}
+
+	for _, expected := range expectedSubcommands {
+		if !foundSubcommands[expected] {
+			t.Errorf("expected subcommand %q not found", expected)
+		}
+	}
+}
+
+func TestRunnerList_Scopes(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		path string
+	}{
+		{name: "project", args: nil, path: "/api/v4/projects/test-owner/test-repo/runners"},
+		{name: "group", args: []string{"--group", "my-group"}, path: "/api/v4/groups/my-group/runners"},
+		{name: "instance", args: []string{"--instance"}, path: "/api/v4/runners/all"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var path string
+			cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+				path = r.URL.Path
+				cmdtest.JSONResponse(w, 200, []map[string]any{
+					{"id": 42, "description": "docker-runner", "runner_type": "project_type", "status": "online", "paused": true},
+				})
+			})
+
+			f := cmdtest.NewTestFactory(t)
+			cmd := newRunnerListCmd(f.Factory)
+			cmd.SetArgs(tt.args)
+			if err := cmd.Execute(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if path != tt.path {
+				t.Errorf("expected request to %s, got %s", tt.path, path)
+			}
+			if !strings.Contains(f.IO.String(), "online (paused)") {
+				t.Errorf("unexpected output: %s", f.IO.String())
+			}
+		})
+	}
+}
+
+func TestRunnerPauseResume(t *testing.T) {
+	for _, tt := range []struct {
+		sub    string
+		paused bool
+		output string
+	}{
+		{"pause", true, "Paused runner #42"},
+		{"resume", false, "Resumed runner #42"},
+	} {
+		t.Run(tt.sub, func(t *testing.T) {
+			var body map[string]any
+			cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPut && strings.HasSuffix(r.URL.Path, "/runners/42") {
+					_ = json.NewDecoder(r.Body).Decode(&body)
+					cmdtest.JSONResponse(w, 200, map[string]any{"id": 42})
+					return
+				}
+				cmdtest.ErrorResponse(w, 404, "not found")
+			})
+
+			f := cmdtest.NewTestFactory(t)
+			cmd := NewRunnerCmd(f.Factory)
+			cmd.SetArgs([]string{tt.sub, "42"})
+			if err := cmd.Execute(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if body["paused"] != tt.paused {
+				t.Errorf("expected paused=%t, got %v", tt.paused, body)
+			}
+			if !strings.Contains(f.IO.String(), tt.output) {
+				t.Errorf("unexpected output: %s", f.IO.String())
+			}
+		})
+	}
+}
+
+func TestRunnerRegisterToken_Project(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/projects/test-owner/test-repo" {
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 1, "runners_token": "GR1348941abc"})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRunnerRegisterTokenCmd(f.Factory)
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if f.IO.String() != "GR1348941abc\n" {
+		t.Errorf("expected only the token on stdout, got %q", f.IO.String())
+	}
+}
+
+func TestRunnerRegisterToken_InstanceRequiresReset(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newRunnerRegisterTokenCmd(f.Factory)
+	cmd.SetArgs([]string{"--instance"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "--reset") {
+		t.Fatalf("expected --reset error, got %v", err)
+	}
+}
@@ -0,0 +1,620 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// NewScheduleCmd creates the schedule command group.
+func NewScheduleCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "schedule <command>",
+		Short:   "Manage pipeline schedules",
+		Long:    "Create, edit, run, and delete pipeline schedules and their variables.",
+		Aliases: []string{"sched"},
+	}
+
+	cmd.AddCommand(newScheduleListCmd(f))
+	cmd.AddCommand(newScheduleViewCmd(f))
+	cmd.AddCommand(newScheduleCreateCmd(f))
+	cmd.AddCommand(newScheduleEditCmd(f))
+	cmd.AddCommand(newScheduleDeleteCmd(f))
+	cmd.AddCommand(newScheduleRunCmd(f))
+	cmd.AddCommand(newScheduleTakeOwnershipCmd(f))
+	cmd.AddCommand(newScheduleVariableCmd(f))
+
+	return cmd
+}
+
+func newScheduleListCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		scope    string
+		limit    int
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List pipeline schedules",
+		Aliases: []string{"ls"},
+		Example: `  $ glab schedule list
+  $ glab schedule list --scope active`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			opts := &gitlab.ListPipelineSchedulesOptions{
+				ListOptions: gitlab.ListOptions{PerPage: int64(limit)},
+			}
+			if scope != "" {
+				opts.Scope = gitlab.Ptr(gitlab.PipelineScheduleScopeValue(scope))
+			}
+
+			schedules, resp, err := client.PipelineSchedules.ListPipelineSchedules(project, opts)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + project + "/pipeline_schedules"
+				return errors.NewAPIError("GET", url, statusCode, "Failed to list pipeline schedules", err)
+			}
+
+			if len(schedules) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No pipeline schedules found")
+				return nil
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(schedules, format, jsonFlag)
+			}
+
+			tp := f.NewTablePrinter()
+			for _, s := range schedules {
+				state := "active"
+				if !s.Active {
+					state = "inactive"
+				}
+				nextRun := ""
+				if s.Active && s.NextRunAt != nil {
+					nextRun = s.NextRunAt.Format("2006-01-02 15:04 MST")
+				}
+				tp.AddRow(
+					fmt.Sprintf("%d", s.ID),
+					s.Description,
+					s.Ref,
+					s.Cron,
+					nextRun,
+					state,
+				)
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().StringVar(&scope, "scope", "", "Filter by scope: active or inactive")
+	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+func newScheduleViewCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "view <id>",
+		Short:   "View a pipeline schedule",
+		Example: `  $ glab schedule view 12`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			scheduleID, err := parseScheduleArg(args)
+			if err != nil {
+				return err
+			}
+
+			schedule, resp, err := client.PipelineSchedules.GetPipelineSchedule(project, scheduleID)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := scheduleAPIURL(client, project, scheduleID)
+				return errors.NewAPIError("GET", url, statusCode, "Failed to get pipeline schedule", err)
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(schedule, format, jsonFlag)
+			}
+
+			out := f.IOStreams.Out
+			_, _ = fmt.Fprintf(out, "Schedule #%d %s\n", schedule.ID, schedule.Description)
+			_, _ = fmt.Fprintf(out, "Ref:       %s\n", schedule.Ref)
+			_, _ = fmt.Fprintf(out, "Cron:      %s (%s)\n", schedule.Cron, schedule.CronTimezone)
+			_, _ = fmt.Fprintf(out, "Active:    %t\n", schedule.Active)
+			if schedule.Active && schedule.NextRunAt != nil {
+				_, _ = fmt.Fprintf(out, "Next run:  %s\n", schedule.NextRunAt.Format("2006-01-02 15:04 MST"))
+			}
+			if schedule.Owner != nil {
+				_, _ = fmt.Fprintf(out, "Owner:     %s\n", schedule.Owner.Username)
+			}
+			if schedule.LastPipeline != nil {
+				_, _ = fmt.Fprintf(out, "Last run:  #%d (%s)\n", schedule.LastPipeline.ID, schedule.LastPipeline.Status)
+			}
+			if len(schedule.Variables) > 0 {
+				_, _ = fmt.Fprintln(out, "Variables:")
+				for _, v := range schedule.Variables {
+					_, _ = fmt.Fprintf(out, "  %s=%s\n", v.Key, v.Value)
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+func newScheduleCreateCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		description string
+		ref         string
+		cron        string
+		timezone    string
+		active      bool
+		variables   []string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a pipeline schedule",
+		Example: `  $ glab schedule create --description "Nightly build" --ref main --cron "0 2 * * *"
+  $ glab schedule create -d "Weekly cleanup" -r main --cron "0 4 * * 0" --timezone "Europe/Berlin"
+  $ glab schedule create -d "Nightly e2e" -r main --cron "0 1 * * *" --variable SUITE=e2e`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vars, err := parseScheduleVariables(variables)
+			if err != nil {
+				return err
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			opts := &gitlab.CreatePipelineScheduleOptions{
+				Description: &description,
+				Ref:         &ref,
+				Cron:        &cron,
+				Active:      &active,
+			}
+			if timezone != "" {
+				opts.CronTimezone = &timezone
+			}
+
+			schedule, resp, err := client.PipelineSchedules.CreatePipelineSchedule(project, opts)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + project + "/pipeline_schedules"
+				return errors.NewAPIError("POST", url, statusCode, "Failed to create pipeline schedule", err)
+			}
+
+			for _, v := range vars {
+				if err := setScheduleVariable(client, project, schedule.ID, v[0], v[1]); err != nil {
+					return err
+				}
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Created pipeline schedule #%d %q\n", schedule.ID, schedule.Description)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&description, "description", "d", "", "Schedule description (required)")
+	cmd.Flags().StringVarP(&ref, "ref", "r", "", "Branch or tag to run the pipeline on (required)")
+	cmd.Flags().StringVar(&cron, "cron", "", "Cron expression, e.g. \"0 2 * * *\" (required)")
+	cmd.Flags().StringVar(&timezone, "timezone", "", "Cron timezone, e.g. UTC or Europe/Berlin (default: UTC)")
+	cmd.Flags().BoolVar(&active, "active", true, "Activate the schedule")
+	cmd.Flags().StringArrayVar(&variables, "variable", nil, "Schedule variable as KEY=value (repeatable)")
+	_ = cmd.MarkFlagRequired("description")
+	_ = cmd.MarkFlagRequired("ref")
+	_ = cmd.MarkFlagRequired("cron")
+
+	return cmd
+}
+
+func newScheduleEditCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		description string
+		ref         string
+		cron        string
+		timezone    string
+		active      bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "edit <id>",
+		Short: "Edit a pipeline schedule",
+		Example: `  $ glab schedule edit 12 --cron "0 3 * * *"
+  $ glab schedule edit 12 --active=false`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			scheduleID, err := parseScheduleArg(args)
+			if err != nil {
+				return err
+			}
+
+			opts := &gitlab.EditPipelineScheduleOptions{}
+			changed := false
+			if cmd.Flags().Changed("description") {
+				opts.Description = &description
+				changed = true
+			}
+			if cmd.Flags().Changed("ref") {
+				opts.Ref = &ref
+				changed = true
+			}
+			if cmd.Flags().Changed("cron") {
+				opts.Cron = &cron
+				changed = true
+			}
+			if cmd.Flags().Changed("timezone") {
+				opts.CronTimezone = &timezone
+				changed = true
+			}
+			if cmd.Flags().Changed("active") {
+				opts.Active = &active
+				changed = true
+			}
+			if !changed {
+				return fmt.Errorf("no changes specified; use --description, --ref, --cron, --timezone, or --active")
+			}
+
+			schedule, resp, err := client.PipelineSchedules.EditPipelineSchedule(project, scheduleID, opts)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := scheduleAPIURL(client, project, scheduleID)
+				return errors.NewAPIError("PUT", url, statusCode, "Failed to edit pipeline schedule", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Updated pipeline schedule #%d\n", schedule.ID)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&description, "description", "d", "", "Schedule description")
+	cmd.Flags().StringVarP(&ref, "ref", "r", "", "Branch or tag to run the pipeline on")
+	cmd.Flags().StringVar(&cron, "cron", "", "Cron expression")
+	cmd.Flags().StringVar(&timezone, "timezone", "", "Cron timezone")
+	cmd.Flags().BoolVar(&active, "active", true, "Activate or deactivate the schedule")
+
+	return cmd
+}
+
+func newScheduleDeleteCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete <id>",
+		Short:   "Delete a pipeline schedule",
+		Example: `  $ glab schedule delete 12`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			scheduleID, err := parseScheduleArg(args)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.PipelineSchedules.DeletePipelineSchedule(project, scheduleID)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := scheduleAPIURL(client, project, scheduleID)
+				return errors.NewAPIError("DELETE", url, statusCode, "Failed to delete pipeline schedule", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Deleted pipeline schedule #%d\n", scheduleID)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newScheduleRunCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "run <id>",
+		Short:   "Run a pipeline schedule now",
+		Example: `  $ glab schedule run 12`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			scheduleID, err := parseScheduleArg(args)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.PipelineSchedules.RunPipelineSchedule(project, scheduleID)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := scheduleAPIURL(client, project, scheduleID) + "/play"
+				return errors.NewAPIError("POST", url, statusCode, "Failed to run pipeline schedule", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Started pipeline schedule #%d\n", scheduleID)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newScheduleTakeOwnershipCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "take-ownership <id>",
+		Short: "Take ownership of a pipeline schedule",
+		Long: `Become the owner of a pipeline schedule.
+
+Scheduled pipelines run with the permissions of the schedule owner, so take
+ownership when the current owner leaves the project or loses access.`,
+		Example: `  $ glab schedule take-ownership 12`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			scheduleID, err := parseScheduleArg(args)
+			if err != nil {
+				return err
+			}
+
+			schedule, resp, err := client.PipelineSchedules.TakeOwnershipOfPipelineSchedule(project, scheduleID)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := scheduleAPIURL(client, project, scheduleID) + "/take_ownership"
+				return errors.NewAPIError("POST", url, statusCode, "Failed to take ownership of pipeline schedule", err)
+			}
+
+			owner := ""
+			if schedule.Owner != nil {
+				owner = " (owner: " + schedule.Owner.Username + ")"
+			}
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Took ownership of pipeline schedule #%d%s\n", schedule.ID, owner)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newScheduleVariableCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "variable <command>",
+		Short: "Manage pipeline schedule variables",
+	}
+
+	cmd.AddCommand(newScheduleVariableSetCmd(f))
+	cmd.AddCommand(newScheduleVariableDeleteCmd(f))
+
+	return cmd
+}
+
+func newScheduleVariableSetCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set <id> <key> <value>",
+		Short: "Create or update a schedule variable",
+		Example: `  $ glab schedule variable set 12 SUITE e2e
+  $ glab schedule variable set 12 DEPLOY_TARGET staging`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			scheduleID, err := parseScheduleArg(args)
+			if err != nil {
+				return err
+			}
+
+			if err := setScheduleVariable(client, project, scheduleID, args[1], args[2]); err != nil {
+				return err
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Set variable %s on pipeline schedule #%d\n", args[1], scheduleID)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newScheduleVariableDeleteCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete <id> <key>",
+		Short:   "Delete a schedule variable",
+		Example: `  $ glab schedule variable delete 12 SUITE`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			scheduleID, err := parseScheduleArg(args)
+			if err != nil {
+				return err
+			}
+
+			_, resp, err := client.PipelineSchedules.DeletePipelineScheduleVariable(project, scheduleID, args[1])
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := scheduleAPIURL(client, project, scheduleID) + "/variables/" + args[1]
+				return errors.NewAPIError("DELETE", url, statusCode, "Failed to delete schedule variable", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Deleted variable %s from pipeline schedule #%d\n", args[1], scheduleID)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// setScheduleVariable updates a schedule variable, creating it if it does not
+// exist yet.
+func setScheduleVariable(client *api.Client, project string, scheduleID int64, key, value string) error {
+	_, resp, err := client.PipelineSchedules.EditPipelineScheduleVariable(project, scheduleID, key, &gitlab.EditPipelineScheduleVariableOptions{
+		Value: &value,
+	})
+	if err == nil {
+		return nil
+	}
+	if resp == nil || resp.StatusCode != 404 {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := scheduleAPIURL(client, project, scheduleID) + "/variables/" + key
+		return errors.NewAPIError("PUT", url, statusCode, fmt.Sprintf("Failed to update schedule variable %s", key), err)
+	}
+
+	_, resp, err = client.PipelineSchedules.CreatePipelineScheduleVariable(project, scheduleID, &gitlab.CreatePipelineScheduleVariableOptions{
+		Key:   &key,
+		Value: &value,
+	})
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := scheduleAPIURL(client, project, scheduleID) + "/variables"
+		return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to create schedule variable %s", key), err)
+	}
+	return nil
+}
+
+// parseScheduleVariables splits KEY=value flags into key/value pairs.
+func parseScheduleVariables(values []string) ([][2]string, error) {
+	vars := make([][2]string, 0, len(values))
+	for _, v := range values {
+		key, value, ok := strings.Cut(v, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid variable format: %s (use KEY=value)", v)
+		}
+		vars = append(vars, [2]string{key, value})
+	}
+	return vars, nil
+}
+
+func parseScheduleArg(args []string) (int64, error) {
+	if len(args) == 0 {
+		return 0, fmt.Errorf("schedule ID required")
+	}
+	id, err := strconv.ParseInt(strings.TrimPrefix(args[0], "#"), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid schedule ID: %s", args[0])
+	}
+	return id, nil
+}
+
+func scheduleAPIURL(client *api.Client, project string, scheduleID int64) string {
+	return fmt.Sprintf("%s/projects/%s/pipeline_schedules/%d", api.APIURL(client.Host()), project, scheduleID)
+}
@@ -0,0 +1,190 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestNewScheduleCmd(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewScheduleCmd(f)
+
+	if cmd.Use != "schedule <command>" {
+		t.Errorf("expected Use to be 'schedule <command>', got %q", cmd.Use)
+	}
+
+	if cmd.Short != "Manage pipeline schedules" {
+		t.Errorf("expected Short to be 'Manage pipeline schedules', got %q", cmd.Short)
+	}
+}
+
+func TestScheduleCmd_HasSubcommands(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewScheduleCmd(f)
+
+	expectedSubcommands := []string{
+		"list",
+		"view",
+		"create",
+		"edit",
+		"delete",
+		"run",
+		"take-ownership",
+		"variable",
+	}
+
+	subcommands := cmd.Commands()
+	if len(subcommands) != len(expectedSubcommands) {
+		t.Errorf("expected %d subcommands, got %d", len(expectedSubcommands), len(subcommands))
+	}
+
+	foundSubcommands := make(map[string]bool)
+	for _, subcmd := range subcommands {
+		foundSubcommands[subcmd.Name()] = true
+	}
+
+	for _, expected := range expectedSubcommands {
+		if !foundSubcommands[expected] {
+			t.Errorf("expected subcommand %q not found", expected)
+		}
+	}
+}
+
+func TestScheduleList(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/pipeline_schedules") {
+			cmdtest.JSONResponse(w, 200, []map[string]any{
+				{"id": 12, "description": "Nightly build", "ref": "main", "cron": "0 2 * * *", "active": true},
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newScheduleListCmd(f.Factory)
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := f.IO.String()
+	if !strings.Contains(out, "Nightly build") || !strings.Contains(out, "0 2 * * *") {
+		t.Errorf("unexpected output: %s", out)
+	}
+}
+
+func TestScheduleCreate_WithVariables(t *testing.T) {
+	var created map[string]any
+	var variables []map[string]any
+
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/pipeline_schedules"):
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": 12, "description": created["description"]})
+		case r.Method == http.MethodPut && strings.HasSuffix(r.URL.Path, "/pipeline_schedules/12/variables/SUITE"):
+			cmdtest.ErrorResponse(w, 404, "404 Not found")
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/pipeline_schedules/12/variables"):
+			var v map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&v)
+			variables = append(variables, v)
+			cmdtest.JSONResponse(w, 201, v)
+		default:
+			cmdtest.ErrorResponse(w, 500, "unexpected "+r.Method+" "+r.URL.Path)
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newScheduleCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"-d", "Nightly e2e", "-r", "main", "--cron", "0 1 * * *", "--variable", "SUITE=e2e"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if created["cron"] != "0 1 * * *" || created["ref"] != "main" || created["active"] != true {
+		t.Errorf("unexpected create request: %v", created)
+	}
+	if len(variables) != 1 || variables[0]["key"] != "SUITE" || variables[0]["value"] != "e2e" {
+		t.Errorf("unexpected variables: %v", variables)
+	}
+	if !strings.Contains(f.IO.String(), `Created pipeline schedule #12 "Nightly e2e"`) {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestScheduleCreate_InvalidVariable(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newScheduleCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"-d", "x", "-r", "main", "--cron", "* * * * *", "--variable", "BROKEN"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "invalid variable format") {
+		t.Fatalf("expected invalid variable error, got %v", err)
+	}
+}
+
+func TestScheduleEdit_OnlyChangedFields(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut && strings.HasSuffix(r.URL.Path, "/pipeline_schedules/12") {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 12})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newScheduleEditCmd(f.Factory)
+	cmd.SetArgs([]string{"12", "--active=false"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(body) != 1 || body["active"] != false {
+		t.Errorf("expected only active=false to be sent, got %v", body)
+	}
+}
+
+func TestScheduleEdit_NoChanges(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newScheduleEditCmd(f.Factory)
+	cmd.SetArgs([]string{"12"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "no changes specified") {
+		t.Fatalf("expected no changes error, got %v", err)
+	}
+}
+
+func TestScheduleRun(t *testing.T) {
+	played := false
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/pipeline_schedules/12/play") {
+			played = true
+			cmdtest.JSONResponse(w, 201, map[string]any{"message": "201 Created"})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newScheduleRunCmd(f.Factory)
+	cmd.SetArgs([]string{"12"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !played {
+		t.Error("expected schedule to be played")
+	}
+}
+
+func TestParseScheduleArg(t *testing.T) {
+	if id, err := parseScheduleArg([]string{"#12"}); err != nil || id != 12 {
+		t.Errorf("parseScheduleArg(#12) = %d, %v", id, err)
+	}
+	if _, err := parseScheduleArg([]string{"nightly"}); err == nil {
+		t.Error("expected error for non-numeric ID")
+	}
+}
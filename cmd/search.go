@@ -0,0 +1,244 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// searchScopes lists the scopes supported by glab search.
+var searchScopes = []string{"projects", "issues", "merge_requests", "commits", "blobs", "users"}
+
+// NewSearchCmd creates the search command.
+func NewSearchCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		scope    string
+		group    string
+		project  string
+		ref      string
+		limit    int
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "search <query>",
+		Short: "Search across GitLab",
+		Long: `Search projects, issues, merge requests, commits, code, and users.
+
+Searches the whole instance by default. Use --group or --project to narrow
+the search. Searching commits and code (blobs) across the instance or a
+group requires advanced search to be enabled.`,
+		Example: `  $ glab search "rate limit"
+  $ glab search --scope issues "timeout" --project my-group/my-project
+  $ glab search -s merge_requests "refactor" --group my-group
+  $ glab search -s blobs "func main" --project my-group/my-project --ref main
+  $ glab search -s users alice --format json`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			query := strings.Join(args, " ")
+
+			if scope == "mr" || scope == "mrs" {
+				scope = "merge_requests"
+			}
+			if !isSearchScope(scope) {
+				return fmt.Errorf("invalid scope %q: must be one of %s", scope, strings.Join(searchScopes, ", "))
+			}
+			if scope == "projects" && project != "" {
+				return fmt.Errorf("the projects scope cannot be narrowed with --project")
+			}
+			if ref != "" && scope != "commits" && scope != "blobs" {
+				return fmt.Errorf("--ref is only supported with the commits and blobs scopes")
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			opts := &gitlab.SearchOptions{
+				ListOptions: gitlab.ListOptions{PerPage: int64(limit)},
+			}
+			if ref != "" {
+				opts.Ref = &ref
+			}
+
+			var (
+				results any
+				rows    [][]string
+				resp    *gitlab.Response
+			)
+
+			switch scope {
+			case "projects":
+				var items []*gitlab.Project
+				if group != "" {
+					items, resp, err = client.Search.ProjectsByGroup(group, query, opts)
+				} else {
+					items, resp, err = client.Search.Projects(query, opts)
+				}
+				results = items
+				for _, p := range items {
+					rows = append(rows, []string{p.PathWithNamespace, truncate(p.Description, 60), string(p.Visibility)})
+				}
+			case "issues":
+				var items []*gitlab.Issue
+				switch {
+				case project != "":
+					items, resp, err = client.Search.IssuesByProject(project, query, opts)
+				case group != "":
+					items, resp, err = client.Search.IssuesByGroup(group, query, opts)
+				default:
+					items, resp, err = client.Search.Issues(query, opts)
+				}
+				results = items
+				for _, i := range items {
+					ref := fmt.Sprintf("#%d", i.IID)
+					if i.References != nil && i.References.Full != "" {
+						ref = i.References.Full
+					}
+					rows = append(rows, []string{ref, truncate(i.Title, 60), i.State, timeAgo(i.UpdatedAt)})
+				}
+			case "merge_requests":
+				var items []*gitlab.MergeRequest
+				switch {
+				case project != "":
+					items, resp, err = client.Search.MergeRequestsByProject(project, query, opts)
+				case group != "":
+					items, resp, err = client.Search.MergeRequestsByGroup(group, query, opts)
+				default:
+					items, resp, err = client.Search.MergeRequests(query, opts)
+				}
+				results = items
+				for _, mr := range items {
+					ref := fmt.Sprintf("!%d", mr.IID)
+					if mr.References != nil && mr.References.Full != "" {
+						ref = mr.References.Full
+					}
+					rows = append(rows, []string{ref, truncate(mr.Title, 60), mr.State, timeAgo(mr.UpdatedAt)})
+				}
+			case "commits":
+				var items []*gitlab.Commit
+				switch {
+				case project != "":
+					items, resp, err = client.Search.CommitsByProject(project, query, opts)
+				case group != "":
+					items, resp, err = client.Search.CommitsByGroup(group, query, opts)
+				default:
+					items, resp, err = client.Search.Commits(query, opts)
+				}
+				results = items
+				for _, c := range items {
+					rows = append(rows, []string{c.ShortID, truncate(c.Title, 60), c.AuthorName, timeAgo(c.CreatedAt)})
+				}
+			case "blobs":
+				var items []*gitlab.Blob
+				switch {
+				case project != "":
+					items, resp, err = client.Search.BlobsByProject(project, query, opts)
+				case group != "":
+					items, resp, err = client.Search.BlobsByGroup(group, query, opts)
+				default:
+					items, resp, err = client.Search.Blobs(query, opts)
+				}
+				results = items
+				for _, b := range items {
+					rows = append(rows, []string{fmt.Sprintf("%s:%d", b.Path, b.Startline), truncate(firstMatchingLine(b.Data, query), 80)})
+				}
+			case "users":
+				var items []*gitlab.User
+				switch {
+				case project != "":
+					items, resp, err = client.Search.UsersByProject(project, query, opts)
+				case group != "":
+					items, resp, err = client.Search.UsersByGroup(group, query, opts)
+				default:
+					items, resp, err = client.Search.Users(query, opts)
+				}
+				results = items
+				for _, u := range items {
+					rows = append(rows, []string{u.Username, u.Name, u.State})
+				}
+			}
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + searchAPIPath(group, project) + "?scope=" + scope
+				return errors.NewAPIError("GET", url, statusCode, "Failed to search "+strings.ReplaceAll(scope, "_", " "), err)
+			}
+
+			if len(rows) == 0 {
+				_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "No %s found matching %q\n", strings.ReplaceAll(scope, "_", " "), query)
+				return nil
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(results, format, jsonFlag)
+			}
+
+			tp := f.NewTablePrinter()
+			for _, row := range rows {
+				tp.AddRow(row...)
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().StringVarP(&scope, "scope", "s", "projects", "What to search: "+strings.Join(searchScopes, ", "))
+	cmd.Flags().StringVarP(&group, "group", "g", "", "Search within a group")
+	cmd.Flags().StringVarP(&project, "project", "p", "", "Search within a project")
+	cmd.Flags().StringVar(&ref, "ref", "", "Branch or tag to search in (commits and blobs only)")
+	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	cmd.MarkFlagsMutuallyExclusive("group", "project")
+
+	return cmd
+}
+
+func isSearchScope(scope string) bool {
+	for _, s := range searchScopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// searchAPIPath returns the path of the search endpoint, narrowed to a
+// project or group when one is given.
+func searchAPIPath(group, project string) string {
+	switch {
+	case project != "":
+		return "/projects/" + project + "/-/search"
+	case group != "":
+		return "/groups/" + group + "/-/search"
+	default:
+		return "/search"
+	}
+}
+
+// firstMatchingLine returns the first line of data that contains query, or
+// the first non-empty line if none does.
+func firstMatchingLine(data, query string) string {
+	lines := strings.Split(data, "\n")
+	lowerQuery := strings.ToLower(query)
+	for _, line := range lines {
+		if strings.Contains(strings.ToLower(line), lowerQuery) {
+			return strings.TrimSpace(line)
+		}
+	}
+	for _, line := range lines {
+		if strings.TrimSpace(line) != "" {
+			return strings.TrimSpace(line)
+		}
+	}
+	return ""
+}
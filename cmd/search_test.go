@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestNewSearchCmd(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewSearchCmd(f)
+
+	if cmd.Use != "search <query>" {
+		t.Errorf("expected Use to be 'search <query>', got %q", cmd.Use)
+	}
+	for _, name := range []string{"scope", "group", "project", "ref", "limit", "format", "json"} {
+		if cmd.Flags().Lookup(name) == nil {
+			t.Errorf("expected flag %q not found", name)
+		}
+	}
+}
+
+func TestSearch_Endpoints(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		wantPath  string
+		wantScope string
+		response  any
+		wantOut   string
+	}{
+		{
+			name:      "global projects",
+			args:      []string{"cli"},
+			wantPath:  "/api/v4/search",
+			wantScope: "projects",
+			response:  []map[string]any{{"id": 1, "path_with_namespace": "tools/cli", "description": "A CLI"}},
+			wantOut:   "tools/cli",
+		},
+		{
+			name:      "group issues",
+			args:      []string{"timeout", "--scope", "issues", "--group", "my-group"},
+			wantPath:  "/api/v4/groups/my-group/-/search",
+			wantScope: "issues",
+			response: []map[string]any{{
+				"id": 70, "iid": 7, "title": "Request timeout", "state": "opened",
+				"references": map[string]any{"full": "my-group/api#7"},
+			}},
+			wantOut: "my-group/api#7",
+		},
+		{
+			name:      "project merge requests alias",
+			args:      []string{"refactor", "-s", "mrs", "--project", "my-group/my-project"},
+			wantPath:  "/api/v4/projects/my-group/my-project/-/search",
+			wantScope: "merge_requests",
+			response:  []map[string]any{{"id": 30, "iid": 3, "title": "Refactor client", "state": "merged"}},
+			wantOut:   "!3",
+		},
+		{
+			name:      "project blobs",
+			args:      []string{"main", "-s", "blobs", "--project", "my-group/my-project", "--ref", "develop"},
+			wantPath:  "/api/v4/projects/my-group/my-project/-/search",
+			wantScope: "blobs",
+			response:  []map[string]any{{"path": "cmd/main.go", "startline": 10, "data": "package main\nfunc main() {\n"}},
+			wantOut:   "cmd/main.go:10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotPath, gotScope string
+			cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+				gotPath = r.URL.Path
+				gotScope = r.URL.Query().Get("scope")
+				cmdtest.JSONResponse(w, 200, tt.response)
+			})
+
+			f := cmdtest.NewTestFactory(t)
+			cmd := NewSearchCmd(f.Factory)
+			cmd.SetArgs(tt.args)
+			if err := cmd.Execute(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if gotPath != tt.wantPath {
+				t.Errorf("expected path %q, got %q", tt.wantPath, gotPath)
+			}
+			if gotScope != tt.wantScope {
+				t.Errorf("expected scope %q, got %q", tt.wantScope, gotScope)
+			}
+			if out := f.IO.String(); !strings.Contains(out, tt.wantOut) {
+				t.Errorf("expected output to contain %q, got %q", tt.wantOut, out)
+			}
+		})
+	}
+}
+
+func TestSearch_BlobsShowsMatchingLine(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("ref") != "develop" {
+			t.Errorf("expected ref=develop, got %q", r.URL.Query().Get("ref"))
+		}
+		cmdtest.JSONResponse(w, 200, []map[string]any{
+			{"path": "main.go", "startline": 1, "data": "package main\n\nfunc main() {\n"},
+		})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewSearchCmd(f.Factory)
+	cmd.SetArgs([]string{"func main", "-s", "blobs", "-p", "my-group/my-project", "--ref", "develop"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out := f.IO.String(); !strings.Contains(out, "func main() {") {
+		t.Errorf("expected matching line in output, got %q", out)
+	}
+}
+
+func TestSearch_NoResults(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		cmdtest.JSONResponse(w, 200, []map[string]any{})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewSearchCmd(f.Factory)
+	cmd.SetArgs([]string{"nothing", "-s", "users"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(f.IO.ErrString(), "No users found") {
+		t.Errorf("expected no-results message, got %q", f.IO.ErrString())
+	}
+}
+
+func TestSearch_InvalidFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"invalid scope", []string{"x", "--scope", "wikis"}, "invalid scope"},
+		{"projects in project", []string{"x", "--project", "a/b"}, "cannot be narrowed with --project"},
+		{"ref with issues", []string{"x", "-s", "issues", "--ref", "main"}, "--ref is only supported"},
+		{"group and project", []string{"x", "-s", "issues", "-g", "a", "-p", "a/b"}, "none of the others can be"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := cmdtest.NewTestFactory(t)
+			cmd := NewSearchCmd(f.Factory)
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSearch_APIError(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		cmdtest.ErrorResponse(w, 403, "advanced search is disabled")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewSearchCmd(f.Factory)
+	cmd.SetArgs([]string{"x", "-s", "commits"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "Failed to search commits") {
+		t.Errorf("expected search error, got %v", err)
+	}
+}
@@ -0,0 +1,348 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/PhilipKram/gitlab-cli/internal/prompt"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// NewSecureFileCmd creates the securefile command group.
+func NewSecureFileCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "securefile <command>",
+		Short: "Manage project secure files",
+		Long: `List, upload, download, and delete the secure files of a project.
+
+Secure files hold signing keys, provisioning profiles, and other files that
+pipelines need but that should not be committed to the repository.`,
+	}
+
+	cmd.AddCommand(newSecureFileListCmd(f))
+	cmd.AddCommand(newSecureFileUploadCmd(f))
+	cmd.AddCommand(newSecureFileDownloadCmd(f))
+	cmd.AddCommand(newSecureFileDeleteCmd(f))
+
+	return cmd
+}
+
+func newSecureFileListCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		limit    int
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List secure files",
+		Aliases: []string{"ls"},
+		Example: `  $ glab securefile list
+  $ glab securefile list --format json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			files, resp, err := client.SecureFiles.ListProjectSecureFiles(project, &gitlab.ListProjectSecureFilesOptions{
+				ListOptions: gitlab.ListOptions{PerPage: int64(limit)},
+			}, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + project + "/secure_files"
+				return errors.NewAPIError("GET", url, statusCode, "Failed to list secure files", err)
+			}
+
+			if len(files) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No secure files found")
+				return nil
+			}
+
+			if jsonFlag || f.ExportRequested() || (format != "" && format != "table") {
+				return f.FormatAndPrint(files, format, jsonFlag)
+			}
+
+			tp := f.NewTablePrinter()
+			tp.SetHeader("ID", "NAME", "EXPIRES", "CREATED")
+			for _, sf := range files {
+				tp.AddRow(strconv.FormatInt(sf.ID, 10), sf.Name, secureFileExpiry(sf), timeAgo(sf.CreatedAt))
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
+
+	return cmd
+}
+
+func newSecureFileUploadCmd(f *cmdutil.Factory) *cobra.Command {
+	var name string
+
+	cmd := &cobra.Command{
+		Use:   "upload <file>",
+		Short: "Upload a secure file",
+		Long: `Upload a file to the project's secure files.
+
+The name defaults to the file's base name and must be unique in the project.`,
+		Example: `  $ glab securefile upload release.keystore
+  $ glab securefile upload ./build/AppStore.mobileprovision --name ios-distribution.mobileprovision`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file, err := os.Open(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to open file: %w", err)
+			}
+			defer func() { _ = file.Close() }()
+
+			if name == "" {
+				name = filepath.Base(args[0])
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			sf, resp, err := client.SecureFiles.CreateSecureFile(project, file, &gitlab.CreateSecureFileOptions{
+				Name: &name,
+			}, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + project + "/secure_files"
+				return errors.NewAPIError("POST", url, statusCode, "Failed to upload secure file", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Uploaded secure file %d (%s) to %s\n", sf.ID, sf.Name, project)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Name of the secure file (default: the file's base name)")
+
+	return cmd
+}
+
+func newSecureFileDownloadCmd(f *cmdutil.Factory) *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "download <id|name>",
+		Short: "Download a secure file",
+		Long: `Download a secure file by ID or name.
+
+The file is saved under its own name unless --output is given; use
+"--output -" to write it to standard output. Its SHA-256 checksum is
+verified before anything is written.`,
+		Example: `  $ glab securefile download release.keystore
+  $ glab securefile download 42 --output ~/keys/release.keystore
+  $ glab securefile download signing.p12 --output - | base64`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			sf, err := findSecureFile(cmd, client, project, args[0])
+			if err != nil {
+				return err
+			}
+
+			content, resp, err := client.SecureFiles.DownloadSecureFile(project, sf.ID, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/secure_files/%d/download", api.APIURL(client.Host()), project, sf.ID)
+				return errors.NewAPIError("GET", url, statusCode, "Failed to download secure file", err)
+			}
+
+			data, err := io.ReadAll(content)
+			if err != nil {
+				return fmt.Errorf("failed to read secure file: %w", err)
+			}
+			if err := verifySecureFileChecksum(sf, data); err != nil {
+				return err
+			}
+
+			if output == "-" {
+				_, err = io.Copy(f.IOStreams.Out, bytes.NewReader(data))
+				return err
+			}
+			if output == "" {
+				output = sf.Name
+			}
+			if err := os.WriteFile(output, data, 0600); err != nil {
+				return fmt.Errorf("failed to write file: %w", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Downloaded %s to %s\n", sf.Name, output)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file path, or \"-\" for standard output (default: the secure file's name)")
+
+	return cmd
+}
+
+func newSecureFileDeleteCmd(f *cmdutil.Factory) *cobra.Command {
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:     "delete <id|name>",
+		Short:   "Delete a secure file",
+		Aliases: []string{"remove"},
+		Example: `  $ glab securefile delete release.keystore
+  $ glab securefile delete 42 --yes`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			sf, err := findSecureFile(cmd, client, project, args[0])
+			if err != nil {
+				return err
+			}
+
+			if !yes {
+				confirmed, err := prompt.Confirm(f.IOStreams.In, f.IOStreams.ErrOut, fmt.Sprintf("Delete secure file %s from %s?", sf.Name, project), false)
+				if err != nil {
+					return err
+				}
+				if !confirmed {
+					_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "Deletion cancelled")
+					return nil
+				}
+			}
+
+			resp, err := client.SecureFiles.RemoveSecureFile(project, sf.ID, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/secure_files/%d", api.APIURL(client.Host()), project, sf.ID)
+				return errors.NewAPIError("DELETE", url, statusCode, "Failed to delete secure file", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Deleted secure file %d (%s) from %s\n", sf.ID, sf.Name, project)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+// findSecureFile looks up a secure file by numeric ID, or by name when ref
+// is not a number.
+func findSecureFile(cmd *cobra.Command, client *api.Client, project, ref string) (*gitlab.SecureFile, error) {
+	if id, err := strconv.ParseInt(ref, 10, 64); err == nil {
+		sf, resp, err := client.SecureFiles.ShowSecureFileDetails(project, id, gitlab.WithContext(cmd.Context()))
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			url := fmt.Sprintf("%s/projects/%s/secure_files/%d", api.APIURL(client.Host()), project, id)
+			return nil, errors.NewAPIError("GET", url, statusCode, "Failed to get secure file", err)
+		}
+		return sf, nil
+	}
+
+	opts := &gitlab.ListProjectSecureFilesOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+	for {
+		files, resp, err := client.SecureFiles.ListProjectSecureFiles(project, opts, gitlab.WithContext(cmd.Context()))
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			url := api.APIURL(client.Host()) + "/projects/" + project + "/secure_files"
+			return nil, errors.NewAPIError("GET", url, statusCode, "Failed to list secure files", err)
+		}
+		for _, sf := range files {
+			if sf.Name == ref {
+				return sf, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return nil, fmt.Errorf("no secure file named %q in %s", ref, project)
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// verifySecureFileChecksum checks downloaded data against the SHA-256
+// checksum GitLab reports for the file.
+func verifySecureFileChecksum(sf *gitlab.SecureFile, data []byte) error {
+	if sf.Checksum == "" || (sf.ChecksumAlgorithm != "" && sf.ChecksumAlgorithm != "sha256") {
+		return nil
+	}
+	sum := sha256.Sum256(data)
+	if got := hex.EncodeToString(sum[:]); got != sf.Checksum {
+		return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", sf.Name, sf.Checksum, got)
+	}
+	return nil
+}
+
+// secureFileExpiry returns when a certificate or provisioning profile
+// expires, if GitLab could read it from the file.
+func secureFileExpiry(sf *gitlab.SecureFile) string {
+	expires := sf.ExpiresAt
+	if expires == nil && sf.Metadata != nil {
+		expires = sf.Metadata.ExpiresAt
+	}
+	if expires == nil {
+		return ""
+	}
+	return expires.Format("2006-01-02")
+}
@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestSecureFileCmd_HasSubcommands(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewSecureFileCmd(f)
+
+	expectedSubcommands := []string{
+		"list",
+		"upload",
+		"download",
+		"delete",
+	}
+
+	foundSubcommands := make(map[string]bool)
+	for _, subcmd := range cmd.Commands() {
+		foundSubcommands[subcmd.Name()] = true
+	}
+	for _, expected := range expectedSubcommands {
+		if !foundSubcommands[expected] {
+			t.Errorf("expected subcommand %q not found", expected)
+		}
+	}
+}
+
+func mockSecureFiles(t *testing.T, content string, deleted *bool) {
+	t.Helper()
+	sum := sha256.Sum256([]byte(content))
+	file := map[string]interface{}{
+		"id":                 7,
+		"name":               "release.keystore",
+		"checksum":           hex.EncodeToString(sum[:]),
+		"checksum_algorithm": "sha256",
+		"created_at":         "2024-01-15T10:00:00Z",
+		"expires_at":         "2026-03-01T00:00:00Z",
+	}
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/test-owner/test-repo/secure_files":
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{file})
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/test-owner/test-repo/secure_files/7":
+			cmdtest.JSONResponse(w, 200, file)
+		case r.URL.Path == "/api/v4/projects/test-owner/test-repo/secure_files/7/download":
+			_, _ = io.WriteString(w, "keystore-bytes")
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v4/projects/test-owner/test-repo/secure_files/7":
+			*deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+}
+
+func TestSecureFileList(t *testing.T) {
+	mockSecureFiles(t, "keystore-bytes", nil)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newSecureFileListCmd(f.Factory)
+	cmd.SetArgs([]string{})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := strings.Join(strings.Fields(f.IO.String()), " ")
+	if !strings.Contains(out, "7 release.keystore 2026-03-01") {
+		t.Errorf("unexpected output: %s", out)
+	}
+}
+
+func TestSecureFileUpload(t *testing.T) {
+	var body string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v4/projects/test-owner/test-repo/secure_files" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("expected a file upload: %v", err)
+		}
+		data, _ := io.ReadAll(file)
+		body = header.Filename + ":" + string(data)
+		cmdtest.JSONResponse(w, 201, map[string]interface{}{"id": 8, "name": r.FormValue("name")})
+	})
+
+	path := filepath.Join(t.TempDir(), "app.keystore")
+	if err := os.WriteFile(path, []byte("secret"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newSecureFileUploadCmd(f.Factory)
+	cmd.SetArgs([]string{path, "--name", "release.keystore"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body != "release.keystore:secret" {
+		t.Errorf("unexpected upload: %q", body)
+	}
+	if !strings.Contains(f.IO.String(), "Uploaded secure file 8 (release.keystore)") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestSecureFileDownload(t *testing.T) {
+	mockSecureFiles(t, "keystore-bytes", nil)
+	output := filepath.Join(t.TempDir(), "out.keystore")
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newSecureFileDownloadCmd(f.Factory)
+	cmd.SetArgs([]string{"release.keystore", "--output", output})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "keystore-bytes" {
+		t.Errorf("downloaded %q", data)
+	}
+}
+
+func TestSecureFileDownload_ChecksumMismatch(t *testing.T) {
+	mockSecureFiles(t, "other-bytes", nil)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newSecureFileDownloadCmd(f.Factory)
+	cmd.SetArgs([]string{"7", "--output", "-"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Errorf("expected checksum error, got %v", err)
+	}
+	if f.IO.String() != "" {
+		t.Errorf("nothing should be written on mismatch, got %q", f.IO.String())
+	}
+}
+
+func TestSecureFileDelete(t *testing.T) {
+	deleted := false
+	mockSecureFiles(t, "keystore-bytes", &deleted)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newSecureFileDeleteCmd(f.Factory)
+	cmd.SetArgs([]string{"release.keystore", "--yes"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !deleted {
+		t.Error("expected the secure file to be deleted")
+	}
+}
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 	"os"
 	"strconv"
@@ -151,7 +150,7 @@ func newSnippetListCmd(f *cmdutil.Factory) *cobra.Command {
 			// Use streaming mode if --stream flag is set
 			if stream {
 				// Create context for pagination
-				ctx := context.Background()
+				ctx := cmd.Context()
 
 				// Create fetch function for pagination
 				fetchFunc := func(page int) ([]*gitlab.Snippet, *gitlab.Response, error) {
@@ -160,7 +159,7 @@ func newSnippetListCmd(f *cmdutil.Factory) *cobra.Command {
 					if pageOpts.PerPage == 0 {
 						pageOpts.PerPage = 100
 					}
-					return client.Snippets.ListSnippets(&pageOpts)
+					return client.Snippets.ListSnippets(&pageOpts, gitlab.WithContext(ctx))
 				}
 
 				// Configure pagination options
@@ -200,7 +199,7 @@ func newSnippetListCmd(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
 	cmd.Flags().BoolVar(&stream, "stream", false, "Enable streaming mode")
 	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open snippets in browser")
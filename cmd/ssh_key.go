@@ -0,0 +1,237 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/PhilipKram/gitlab-cli/internal/prompt"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// NewSSHKeyCmd creates the ssh-key command group.
+func NewSSHKeyCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ssh-key <command>",
+		Short: "Manage SSH keys",
+		Long:  "List, add, and delete the SSH keys of the authenticated user.",
+	}
+
+	cmd.AddCommand(newSSHKeyListCmd(f))
+	cmd.AddCommand(newSSHKeyAddCmd(f))
+	cmd.AddCommand(newSSHKeyDeleteCmd(f))
+
+	return cmd
+}
+
+func newSSHKeyListCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List your SSH keys",
+		Aliases: []string{"ls"},
+		Example: `  $ glab ssh-key list
+  $ glab ssh-key list --format json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			keys, resp, err := client.Users.ListSSHKeys(&gitlab.ListSSHKeysOptions{
+				ListOptions: gitlab.ListOptions{PerPage: 100},
+			}, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/user/keys"
+				return errors.NewAPIError("GET", url, statusCode, "Failed to list SSH keys", err)
+			}
+
+			if len(keys) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No SSH keys found")
+				return nil
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(keys, format, jsonFlag)
+			}
+
+			tp := f.NewTablePrinter()
+			for _, k := range keys {
+				expires := ""
+				if k.ExpiresAt != nil {
+					expires = "expires " + k.ExpiresAt.Format("2006-01-02")
+				}
+				tp.AddRow(strconv.FormatInt(k.ID, 10), k.Title, sshKeyFingerprint(k.Key), k.UsageType, timeAgo(k.CreatedAt), expires)
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+func newSSHKeyAddCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		title     string
+		expiresAt string
+		usageType string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "add <key-file>",
+		Short: "Add an SSH key to your account",
+		Long: `Add an SSH public key to the authenticated user's account.
+
+The key is read from the given file, or from standard input when the file is
+"-". The title defaults to the key's comment.`,
+		Example: `  $ glab ssh-key add ~/.ssh/id_ed25519.pub
+  $ glab ssh-key add ~/.ssh/id_ed25519.pub --title "work laptop" --expires-at 2026-01-01
+  $ glab ssh-key add ~/.ssh/signing.pub --usage-type signing
+  $ cat key.pub | glab ssh-key add -`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch usageType {
+			case "", "auth", "signing", "auth_and_signing":
+			default:
+				return fmt.Errorf("invalid --usage-type %q: must be auth, signing, or auth_and_signing", usageType)
+			}
+
+			key, err := readPublicKey(f, args[0])
+			if err != nil {
+				return err
+			}
+			if title == "" {
+				title = publicKeyComment(key)
+			}
+			if title == "" {
+				return fmt.Errorf("--title required: the key has no comment to use as a title")
+			}
+
+			opts := &gitlab.AddSSHKeyOptions{
+				Key:   &key,
+				Title: &title,
+			}
+			if usageType != "" {
+				opts.UsageType = &usageType
+			}
+			if expiresAt != "" {
+				t, err := parseDate(expiresAt)
+				if err != nil {
+					return fmt.Errorf("invalid --expires-at: %w", err)
+				}
+				iso := gitlab.ISOTime(t)
+				opts.ExpiresAt = &iso
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			sshKey, resp, err := client.Users.AddSSHKey(opts, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/user/keys"
+				return errors.NewAPIError("POST", url, statusCode, "Failed to add SSH key", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Added SSH key %d (%s)\n", sshKey.ID, sshKey.Title)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&title, "title", "t", "", "Key title (default: the key's comment)")
+	cmd.Flags().StringVar(&expiresAt, "expires-at", "", "Date the key expires (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&usageType, "usage-type", "", "Key usage: auth, signing, or auth_and_signing (default: auth_and_signing)")
+
+	return cmd
+}
+
+func newSSHKeyDeleteCmd(f *cmdutil.Factory) *cobra.Command {
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:     "delete <id>",
+		Short:   "Delete an SSH key from your account",
+		Aliases: []string{"remove"},
+		Example: `  $ glab ssh-key delete 42
+  $ glab ssh-key delete 42 --yes`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid SSH key ID: %s", args[0])
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			if !yes {
+				confirmed, err := prompt.Confirm(f.IOStreams.In, f.IOStreams.ErrOut, fmt.Sprintf("Delete SSH key %d?", id), false)
+				if err != nil {
+					return err
+				}
+				if !confirmed {
+					_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "Deletion cancelled")
+					return nil
+				}
+			}
+
+			resp, err := client.Users.DeleteSSHKey(id, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/user/keys/%d", api.APIURL(client.Host()), id)
+				return errors.NewAPIError("DELETE", url, statusCode, "Failed to delete SSH key", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Deleted SSH key %d\n", id)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+// sshKeyFingerprint returns the SHA256 fingerprint of an OpenSSH public key in
+// the format printed by ssh-keygen -l, or an empty string if the key cannot be
+// decoded.
+func sshKeyFingerprint(key string) string {
+	fields := strings.Fields(key)
+	if len(fields) < 2 {
+		return ""
+	}
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(blob)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestSSHKeyCmd_HasSubcommands(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewSSHKeyCmd(f)
+
+	expectedSubcommands := []string{
+		"list",
+		"add",
+		"delete",
+	}
+
+	subcommands := cmd.Commands()
+	if len(subcommands) != len(expectedSubcommands) {
+		t.Errorf("expected %d subcommands, got %d", len(expectedSubcommands), len(subcommands))
+	}
+
+	foundSubcommands := make(map[string]bool)
+	for _, subcmd := range subcommands {
+		foundSubcommands[subcmd.Name()] = true
+	}
+
+	for _, expected := range expectedSubcommands {
+		if !foundSubcommands[expected] {
+			t.Errorf("expected subcommand %q not found", expected)
+		}
+	}
+}
+
+func TestSSHKeyList(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/user/keys" {
+			cmdtest.JSONResponse(w, 200, []any{
+				map[string]any{
+					"id": 3, "title": "laptop", "key": "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5 me@laptop",
+					"usage_type": "auth", "created_at": "2024-01-01T00:00:00Z", "expires_at": "2026-01-01T00:00:00Z",
+				},
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newSSHKeyListCmd(f.Factory)
+	cmd.SetArgs([]string{})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := f.IO.String()
+	for _, want := range []string{"3", "laptop", "SHA256:", "auth", "expires 2026-01-01"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got %q", want, out)
+		}
+	}
+}
+
+func TestSSHKeyAdd_FromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "id_ed25519.pub")
+	if err := os.WriteFile(path, []byte("ssh-ed25519 AAAAC3Nza me@laptop\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/api/v4/user/keys" {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 201, map[string]any{"id": 4, "title": body["title"]})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newSSHKeyAddCmd(f.Factory)
+	cmd.SetArgs([]string{path, "--usage-type", "signing", "--expires-at", "2026-01-01"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["key"] != "ssh-ed25519 AAAAC3Nza me@laptop" || body["title"] != "me@laptop" || body["usage_type"] != "signing" || body["expires_at"] != "2026-01-01" {
+		t.Errorf("unexpected request body: %v", body)
+	}
+	if !strings.Contains(f.IO.String(), "Added SSH key 4 (me@laptop)") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestSSHKeyAdd_InvalidUsageType(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newSSHKeyAddCmd(f.Factory)
+	cmd.SetArgs([]string{"-", "--usage-type", "deploy"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "invalid --usage-type") {
+		t.Errorf("expected usage type error, got %v", err)
+	}
+}
+
+func TestSSHKeyDelete(t *testing.T) {
+	deleted := false
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete && r.URL.Path == "/api/v4/user/keys/3" {
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newSSHKeyDeleteCmd(f.Factory)
+	cmd.SetArgs([]string{"3", "--yes"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !deleted {
+		t.Error("expected the SSH key to be deleted")
+	}
+}
+
+func TestSSHKeyFingerprint(t *testing.T) {
+	// Fingerprint of an all-zero 32-byte blob, matching ssh-keygen's encoding.
+	key := "ssh-ed25519 AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA= test"
+	if got := sshKeyFingerprint(key); got != "SHA256:Zmh6rfhivXdsj8GLjp+OIAiXFIVu4jOzkCpZHQ1fKSU" {
+		t.Errorf("unexpected fingerprint: %q", got)
+	}
+	if got := sshKeyFingerprint("not-a-key"); got != "" {
+		t.Errorf("expected empty fingerprint, got %q", got)
+	}
+}
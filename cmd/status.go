@@ -0,0 +1,351 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/PhilipKram/gitlab-cli/internal/formatter"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// statusPipelineWorkers bounds the concurrent requests for the pipelines of
+// your merge requests.
+const statusPipelineWorkers = 5
+
+// statusMaxMergeRequests bounds how many of your open merge requests are
+// checked for failed pipelines.
+const statusMaxMergeRequests = 500
+
+// statusReport is what glab status shows.
+type statusReport struct {
+	AssignedMergeRequests []*gitlab.BasicMergeRequest `json:"assigned_merge_requests"`
+	ReviewRequests        []*gitlab.BasicMergeRequest `json:"review_requests"`
+	AssignedIssues        []*gitlab.Issue             `json:"assigned_issues"`
+	FailingPipelines      []statusPipeline            `json:"failing_pipelines"`
+	Todos                 []*gitlab.Todo              `json:"todos"`
+}
+
+// statusPipeline is the failed latest pipeline of one of your merge requests.
+type statusPipeline struct {
+	MergeRequest *gitlab.BasicMergeRequest `json:"merge_request"`
+	Pipeline     *gitlab.PipelineInfo      `json:"pipeline"`
+}
+
+// NewStatusCmd creates the status command.
+func NewStatusCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		limit    int
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show what needs your attention",
+		Long: `Show an overview of your work across the GitLab host: open merge requests
+assigned to you or awaiting your review, open issues assigned to you, failed
+pipelines of the merge requests you opened, and pending to-do items.`,
+		Example: `  $ glab status
+  $ glab status --limit 5
+  $ glab status --format json
+  $ glab status --jq '.failing_pipelines[].merge_request.web_url'`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if limit <= 0 {
+				return fmt.Errorf("--limit must be greater than 0")
+			}
+			outputFormat, err := f.ResolveFormat(format, jsonFlag)
+			if err != nil {
+				return err
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			user, resp, err := client.Users.CurrentUser()
+			if err != nil {
+				return statusAPIError(client, "/user", "Failed to get current user", resp, err)
+			}
+
+			report, err := fetchStatus(client, user.Username, limit)
+			if err != nil {
+				return err
+			}
+
+			if outputFormat != formatter.TableFormat {
+				return f.FormatAndPrint(report, string(outputFormat), false)
+			}
+			return printStatus(f, report)
+		},
+	}
+
+	cmd.Flags().IntVarP(&limit, "limit", "L", 10, "Maximum number of items per section")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated, use --format=json)")
+	f.AddExportFlags(cmd)
+
+	return cmd
+}
+
+// fetchStatus gathers the sections of the status report concurrently.
+func fetchStatus(client *api.Client, username string, limit int) (*statusReport, error) {
+	report := &statusReport{}
+	listOpts := gitlab.ListOptions{PerPage: int64(min(limit, 100))}
+	opened := "opened"
+
+	fetches := []func() error{
+		func() error {
+			mrs, resp, err := client.MergeRequests.ListMergeRequests(&gitlab.ListMergeRequestsOptions{
+				ListOptions: listOpts,
+				State:       &opened,
+				Scope:       gitlab.Ptr("assigned_to_me"),
+			})
+			if err != nil {
+				return statusAPIError(client, "/merge_requests", "Failed to list assigned merge requests", resp, err)
+			}
+			report.AssignedMergeRequests = mrs
+			return nil
+		},
+		func() error {
+			mrs, resp, err := client.MergeRequests.ListMergeRequests(&gitlab.ListMergeRequestsOptions{
+				ListOptions:      listOpts,
+				State:            &opened,
+				Scope:            gitlab.Ptr("all"),
+				ReviewerUsername: &username,
+			})
+			if err != nil {
+				return statusAPIError(client, "/merge_requests", "Failed to list merge requests awaiting review", resp, err)
+			}
+			report.ReviewRequests = mrs
+			return nil
+		},
+		func() error {
+			issues, resp, err := client.Issues.ListIssues(&gitlab.ListIssuesOptions{
+				ListOptions: listOpts,
+				State:       &opened,
+				Scope:       gitlab.Ptr("assigned_to_me"),
+			})
+			if err != nil {
+				return statusAPIError(client, "/issues", "Failed to list assigned issues", resp, err)
+			}
+			report.AssignedIssues = issues
+			return nil
+		},
+		func() error {
+			pipelines, err := fetchFailingPipelines(client, limit)
+			report.FailingPipelines = pipelines
+			return err
+		},
+		func() error {
+			todos, resp, err := client.Todos.ListTodos(&gitlab.ListTodosOptions{
+				ListOptions: listOpts,
+				State:       gitlab.Ptr("pending"),
+			})
+			if err != nil {
+				return statusAPIError(client, "/todos", "Failed to list to-do items", resp, err)
+			}
+			report.Todos = todos
+			return nil
+		},
+	}
+
+	errs := make([]error, len(fetches))
+	var wg sync.WaitGroup
+	for i, fetch := range fetches {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = fetch()
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
+
+// fetchFailingPipelines returns up to limit of your open merge requests whose
+// latest pipeline failed, looking through at most statusMaxMergeRequests of
+// them.
+func fetchFailingPipelines(client *api.Client, limit int) ([]statusPipeline, error) {
+	opts := &gitlab.ListMergeRequestsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100},
+		State:       gitlab.Ptr("opened"),
+		Scope:       gitlab.Ptr("created_by_me"),
+	}
+
+	var failing []statusPipeline
+	for checked := 0; checked < statusMaxMergeRequests; {
+		mrs, resp, err := client.MergeRequests.ListMergeRequests(opts)
+		if err != nil {
+			return nil, statusAPIError(client, "/merge_requests", "Failed to list your merge requests", resp, err)
+		}
+		mrs = mrs[:min(len(mrs), statusMaxMergeRequests-checked)]
+		checked += len(mrs)
+
+		latest, err := latestPipelines(client, mrs)
+		if err != nil {
+			return nil, err
+		}
+		for i, mr := range mrs {
+			if p := latest[i]; p != nil && p.Status == "failed" {
+				failing = append(failing, statusPipeline{MergeRequest: mr, Pipeline: p})
+				if len(failing) == limit {
+					return failing, nil
+				}
+			}
+		}
+
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return failing, nil
+}
+
+// latestPipelines returns the latest pipeline of each merge request, or nil
+// for merge requests without pipelines.
+func latestPipelines(client *api.Client, mrs []*gitlab.BasicMergeRequest) ([]*gitlab.PipelineInfo, error) {
+	latest := make([]*gitlab.PipelineInfo, len(mrs))
+	errs := make([]error, len(mrs))
+	queue := make(chan int)
+	var wg sync.WaitGroup
+	for range min(statusPipelineWorkers, len(mrs)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				mr := mrs[i]
+				pipelines, resp, err := client.MergeRequests.ListMergeRequestPipelines(mr.ProjectID, mr.IID)
+				if err != nil {
+					path := fmt.Sprintf("/projects/%d/merge_requests/%d/pipelines", mr.ProjectID, mr.IID)
+					errs[i] = statusAPIError(client, path, "Failed to list merge request pipelines", resp, err)
+					continue
+				}
+				if len(pipelines) > 0 {
+					latest[i] = pipelines[0]
+				}
+			}
+		}()
+	}
+	for i := range mrs {
+		queue <- i
+	}
+	close(queue)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return latest, nil
+}
+
+// printStatus prints the status report as a section per kind of item.
+func printStatus(f *cmdutil.Factory, report *statusReport) error {
+	out := f.IOStreams.Out
+	cs := f.IOStreams.ColorScheme()
+
+	sections := []struct {
+		title string
+		empty string
+		rows  [][]string
+	}{
+		{"Merge requests assigned to you", "No merge requests assigned to you", mergeRequestRows(report.AssignedMergeRequests)},
+		{"Merge requests awaiting your review", "No merge requests awaiting your review", mergeRequestRows(report.ReviewRequests)},
+		{"Issues assigned to you", "No issues assigned to you", issueRows(report.AssignedIssues)},
+		{"Failing pipelines", "No failing pipelines on your merge requests", failingPipelineRows(report.FailingPipelines, cs.Red)},
+		{"To-do items", "No pending to-do items", todoRows(report.Todos)},
+	}
+
+	for i, s := range sections {
+		if i > 0 {
+			_, _ = fmt.Fprintln(out)
+		}
+		_, _ = fmt.Fprintln(out, cs.Bold(s.title))
+		if len(s.rows) == 0 {
+			_, _ = fmt.Fprintln(out, cs.Gray(s.empty))
+			continue
+		}
+		tp := f.NewTablePrinter()
+		for _, row := range s.rows {
+			tp.AddRow(row...)
+		}
+		if err := tp.Render(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func mergeRequestRows(mrs []*gitlab.BasicMergeRequest) [][]string {
+	rows := make([][]string, 0, len(mrs))
+	for _, mr := range mrs {
+		rows = append(rows, []string{referenceOf(mr.References, mr.WebURL), truncate(mr.Title, 60), timeAgo(mr.UpdatedAt)})
+	}
+	return rows
+}
+
+func issueRows(issues []*gitlab.Issue) [][]string {
+	rows := make([][]string, 0, len(issues))
+	for _, issue := range issues {
+		rows = append(rows, []string{referenceOf(issue.References, issue.WebURL), truncate(issue.Title, 60), timeAgo(issue.UpdatedAt)})
+	}
+	return rows
+}
+
+func failingPipelineRows(pipelines []statusPipeline, red func(string) string) [][]string {
+	rows := make([][]string, 0, len(pipelines))
+	for _, p := range pipelines {
+		rows = append(rows, []string{
+			referenceOf(p.MergeRequest.References, p.MergeRequest.WebURL),
+			p.MergeRequest.SourceBranch,
+			red(fmt.Sprintf("#%d %s", p.Pipeline.ID, p.Pipeline.Status)),
+			timeAgo(p.Pipeline.UpdatedAt),
+		})
+	}
+	return rows
+}
+
+func todoRows(todos []*gitlab.Todo) [][]string {
+	rows := make([][]string, 0, len(todos))
+	for _, t := range todos {
+		title := ""
+		if t.Target != nil {
+			title = truncate(t.Target.Title, 60)
+		}
+		rows = append(rows, []string{todoReference(t), strings.ReplaceAll(string(t.ActionName), "_", " "), title, timeAgo(t.CreatedAt)})
+	}
+	return rows
+}
+
+// referenceOf returns the full reference of an issue or merge request, such
+// as group/project!12, or its URL when the reference is unknown.
+func referenceOf(refs *gitlab.IssueReferences, webURL string) string {
+	if refs != nil && refs.Full != "" {
+		return refs.Full
+	}
+	return webURL
+}
+
+// statusAPIError wraps a failed request of glab status.
+func statusAPIError(client *api.Client, path, message string, resp *gitlab.Response, err error) error {
+	statusCode := 0
+	if resp != nil {
+		statusCode = resp.StatusCode
+	}
+	return errors.NewAPIError("GET", api.APIURL(client.Host())+path, statusCode, message, err)
+}
@@ -0,0 +1,196 @@
+package cmd
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestStatus(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch r.URL.Path {
+		case "/api/v4/user":
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 1, "username": "alice"})
+		case "/api/v4/merge_requests":
+			switch {
+			case q.Get("scope") == "assigned_to_me":
+				cmdtest.JSONResponse(w, 200, []map[string]any{
+					{"iid": 3, "title": "Assigned MR", "references": map[string]any{"full": "g/p!3"}},
+				})
+			case q.Get("reviewer_username") == "alice":
+				cmdtest.JSONResponse(w, 200, []map[string]any{
+					{"iid": 4, "title": "Review me", "references": map[string]any{"full": "g/p!4"}},
+				})
+			case q.Get("scope") == "created_by_me":
+				cmdtest.JSONResponse(w, 200, []map[string]any{
+					{"iid": 5, "project_id": 9, "source_branch": "broken", "references": map[string]any{"full": "g/p!5"}},
+					{"iid": 6, "project_id": 9, "source_branch": "green", "references": map[string]any{"full": "g/p!6"}},
+				})
+			default:
+				t.Errorf("unexpected merge request query: %s", r.URL.RawQuery)
+			}
+		case "/api/v4/projects/9/merge_requests/5/pipelines":
+			cmdtest.JSONResponse(w, 200, []map[string]any{{"id": 501, "status": "failed"}, {"id": 500, "status": "success"}})
+		case "/api/v4/projects/9/merge_requests/6/pipelines":
+			cmdtest.JSONResponse(w, 200, []map[string]any{{"id": 601, "status": "success"}})
+		case "/api/v4/issues":
+			cmdtest.JSONResponse(w, 200, []map[string]any{})
+		case "/api/v4/todos":
+			if q.Get("state") != "pending" {
+				t.Errorf("expected state=pending, got %q", q.Get("state"))
+			}
+			cmdtest.JSONResponse(w, 200, []map[string]any{
+				{
+					"id":          1,
+					"action_name": "mentioned",
+					"target_type": "Issue",
+					"project":     map[string]any{"path_with_namespace": "g/p"},
+					"target":      map[string]any{"iid": 7, "title": "Mentioned here"},
+				},
+			})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewStatusCmd(f.Factory)
+	cmd.SetArgs([]string{})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := f.IO.String()
+	for _, want := range []string{
+		"Merge requests assigned to you\ng/p!3\tAssigned MR",
+		"Merge requests awaiting your review\ng/p!4\tReview me",
+		"No issues assigned to you",
+		"Failing pipelines\ng/p!5\tbroken\t#501 failed",
+		"To-do items\ng/p#7\tmentioned\tMentioned here",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "g/p!6") {
+		t.Errorf("expected no failing pipeline for g/p!6, got:\n%s", out)
+	}
+}
+
+func TestStatus_APIError(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/user" {
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 1, "username": "alice"})
+			return
+		}
+		if r.URL.Path == "/api/v4/todos" {
+			cmdtest.ErrorResponse(w, 500, "boom")
+			return
+		}
+		cmdtest.JSONResponse(w, 200, []map[string]any{})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewStatusCmd(f.Factory)
+	cmd.SetArgs([]string{})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "to-do items") {
+		t.Errorf("expected a to-do items error, got %v", err)
+	}
+}
+
+func TestFetchFailingPipelines_Pages(t *testing.T) {
+	var (
+		mu               sync.Mutex
+		pipelineRequests []string
+	)
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v4/merge_requests":
+			if r.URL.Query().Get("page") == "2" {
+				cmdtest.JSONResponse(w, 200, []map[string]any{
+					{"iid": 3, "project_id": 9},
+					{"iid": 4, "project_id": 9},
+				})
+				return
+			}
+			w.Header().Set("X-Next-Page", "2")
+			cmdtest.JSONResponse(w, 200, []map[string]any{
+				{"iid": 1, "project_id": 9},
+				{"iid": 2, "project_id": 9},
+			})
+		case strings.HasSuffix(r.URL.Path, "/pipelines"):
+			mu.Lock()
+			pipelineRequests = append(pipelineRequests, r.URL.Path)
+			mu.Unlock()
+			status := "success"
+			if !strings.Contains(r.URL.Path, "/merge_requests/1/") && !strings.Contains(r.URL.Path, "/merge_requests/2/") {
+				status = "failed"
+			}
+			cmdtest.JSONResponse(w, 200, []map[string]any{{"id": 1, "status": status}})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	client, err := f.Factory.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	failing, err := fetchFailingPipelines(client, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(failing) != 1 || failing[0].MergeRequest.IID != 3 {
+		t.Errorf("expected the failing pipeline of !3 from the second page, got %+v", failing)
+	}
+	if len(pipelineRequests) != 4 {
+		t.Errorf("expected the pipelines of both pages to be checked, got %v", pipelineRequests)
+	}
+}
+
+func TestStatus_InvalidFlags(t *testing.T) {
+	for _, tt := range []struct {
+		args []string
+		want string
+	}{
+		{[]string{"--limit", "0"}, "--limit must be greater than 0"},
+		{[]string{"--format", "xml"}, "invalid format: xml"},
+	} {
+		f := cmdtest.NewTestFactory(t)
+		cmd := NewStatusCmd(f.Factory)
+		cmd.SetArgs(tt.args)
+		if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%v: expected an error containing %q, got %v", tt.args, tt.want, err)
+		}
+	}
+}
+
+func TestStatus_JQ(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v4/user":
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 1, "username": "alice"})
+		case r.URL.Path == "/api/v4/merge_requests" && r.URL.Query().Get("scope") == "assigned_to_me":
+			cmdtest.JSONResponse(w, 200, []map[string]any{{"iid": 3, "title": "Assigned MR"}})
+		default:
+			cmdtest.JSONResponse(w, 200, []map[string]any{})
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := NewStatusCmd(f.Factory)
+	cmd.SetArgs([]string{"--jq", ".assigned_merge_requests[].title"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := strings.TrimSpace(f.IO.String()); got != "Assigned MR" {
+		t.Errorf("unexpected output %q", got)
+	}
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/PhilipKram/gitlab-cli/internal/api"
 	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
@@ -15,12 +16,14 @@ func NewTagCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "tag <command>",
 		Short: "Manage tags",
-		Long:  "List, create, and delete repository tags.",
+		Long:  "List, create, delete, and protect repository tags.",
 	}
 
 	cmd.AddCommand(newTagListCmd(f))
 	cmd.AddCommand(newTagCreateCmd(f))
 	cmd.AddCommand(newTagDeleteCmd(f))
+	cmd.AddCommand(newTagProtectCmd(f))
+	cmd.AddCommand(newTagUnprotectCmd(f))
 
 	return cmd
 }
@@ -69,12 +72,29 @@ func newTagListCmd(f *cmdutil.Factory) *cobra.Command {
 				return nil
 			}
 
-			return f.FormatAndPrint(tags, format, jsonFlag)
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(tags, format, jsonFlag)
+			}
+
+			tp := f.NewTablePrinter()
+			for _, t := range tags {
+				status := ""
+				if t.Protected {
+					status = "protected"
+				}
+				commit, age := "", ""
+				if t.Commit != nil {
+					commit = t.Commit.ShortID
+					age = timeAgo(t.Commit.CommittedDate)
+				}
+				tp.AddRow(t.Name, status, commit, truncate(strings.TrimSpace(t.Message), 50), age)
+			}
+			return tp.Render()
 		},
 	}
 
 	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
 
 	return cmd
@@ -88,11 +108,26 @@ func newTagCreateCmd(f *cmdutil.Factory) *cobra.Command {
 	)
 
 	cmd := &cobra.Command{
-		Use:   "create",
+		Use:   "create <name>",
 		Short: "Create a tag",
-		Example: `  $ glab tag create --name v1.0.0
-  $ glab tag create --name v1.0.0 --ref develop --message "Release v1.0.0"`,
+		Long: `Create a tag from a branch or commit.
+
+The tag name can be given as an argument or with --name. With --message,
+an annotated tag is created; otherwise the tag is lightweight.`,
+		Example: `  $ glab tag create v1.0.0
+  $ glab tag create v1.0.0 --ref develop --message "Release v1.0.0"`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				if name != "" {
+					return fmt.Errorf("specify the tag name as an argument or with --name, not both")
+				}
+				name = args[0]
+			}
+			if name == "" {
+				return fmt.Errorf("tag name required")
+			}
+
 			client, err := f.Client()
 			if err != nil {
 				return err
@@ -127,10 +162,9 @@ func newTagCreateCmd(f *cmdutil.Factory) *cobra.Command {
 		},
 	}
 
-	cmd.Flags().StringVarP(&name, "name", "n", "", "Tag name (required)")
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Tag name (alternative to the argument)")
 	cmd.Flags().StringVarP(&ref, "ref", "r", "main", "Source branch or commit SHA")
 	cmd.Flags().StringVarP(&message, "message", "m", "", "Tag message (creates annotated tag)")
-	_ = cmd.MarkFlagRequired("name")
 
 	return cmd
 }
@@ -171,3 +205,98 @@ func newTagDeleteCmd(f *cmdutil.Factory) *cobra.Command {
 
 	return cmd
 }
+
+func newTagProtectCmd(f *cmdutil.Factory) *cobra.Command {
+	var createLevel string
+
+	cmd := &cobra.Command{
+		Use:   "protect <tag>",
+		Short: "Protect a tag",
+		Long: `Protect a tag, or a wildcard pattern such as "v*", so that only users with
+the given access level can create matching tags.
+
+Access levels can be one of: no-access, developer, maintainer, or admin.
+Defaults to maintainer.`,
+		Example: `  $ glab tag protect "v*"
+  $ glab tag protect "release-*" --create-access-level developer`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			level, err := parseAccessLevel(createLevel)
+			if err != nil {
+				return fmt.Errorf("invalid --create-access-level: %w", err)
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			name := args[0]
+			opts := &gitlab.ProtectRepositoryTagsOptions{
+				Name:              &name,
+				CreateAccessLevel: gitlab.Ptr(level),
+			}
+
+			pt, resp, err := client.ProtectedTags.ProtectRepositoryTags(project, opts)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + project + "/protected_tags"
+				return errors.NewAPIError("POST", url, statusCode, "Failed to protect tag", err)
+			}
+
+			out := f.IOStreams.Out
+			_, _ = fmt.Fprintf(out, "Protected tag %q\n", pt.Name)
+			_, _ = fmt.Fprintf(out, "Create: %s\n", tagAccessDescription(pt.CreateAccessLevels))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&createLevel, "create-access-level", "maintainer", "Access level allowed to create matching tags: no-access, developer, maintainer, or admin")
+
+	return cmd
+}
+
+func newTagUnprotectCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unprotect <tag>",
+		Short:   "Remove protection from a tag",
+		Example: `  $ glab tag unprotect "v*"`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			name := args[0]
+
+			resp, err := client.ProtectedTags.UnprotectRepositoryTags(project, name)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + project + "/protected_tags/" + name
+				return errors.NewAPIError("DELETE", url, statusCode, "Failed to unprotect tag", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Unprotected tag %q\n", name)
+			return nil
+		},
+	}
+
+	return cmd
+}
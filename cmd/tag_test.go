@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"encoding/json"
 	"net/http"
 	"strings"
 	"testing"
@@ -29,6 +30,8 @@ func TestTagCmd_HasSubcommands(t *testing.T) {
 		"list",
 		"create",
 		"delete",
+		"protect",
+		"unprotect",
 	}
 
 	subcommands := cmd.Commands()
@@ -109,8 +112,8 @@ func TestTagCreateCmd_Flags(t *testing.T) {
 		t.Errorf("expected default ref to be 'main', got %q", refFlag.DefValue)
 	}
 
-	if cmd.Use != "create" {
-		t.Errorf("expected Use to be 'create', got %q", cmd.Use)
+	if cmd.Use != "create <name>" {
+		t.Errorf("expected Use to be 'create <name>', got %q", cmd.Use)
 	}
 }
 
@@ -205,7 +208,7 @@ func TestTagDelete_Success(t *testing.T) {
 func TestTagCreate_ValidationError(t *testing.T) {
 	f := cmdtest.NewTestFactory(t)
 	cmd := newTagCreateCmd(f.Factory)
-	// Missing required --name flag
+	// Missing tag name
 	cmd.SetArgs([]string{})
 
 	err := cmd.Execute()
@@ -256,3 +259,84 @@ func TestTagDelete_NotFound(t *testing.T) {
 		t.Fatal("expected error for not found tag")
 	}
 }
+
+func TestTagCreate_PositionalName(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		cmdtest.JSONResponse(w, 201, map[string]any{"name": body["tag_name"]})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newTagCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"v2.0.0", "--ref", "develop", "--message", "Release v2.0.0"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["tag_name"] != "v2.0.0" || body["ref"] != "develop" || body["message"] != "Release v2.0.0" {
+		t.Errorf("unexpected request body: %v", body)
+	}
+	if !strings.Contains(f.IO.String(), `Created tag "v2.0.0" from "develop"`) {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestTagCreate_NameTwice(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newTagCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"v1.0.0", "--name", "v1.0.0"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "not both") {
+		t.Errorf("expected error for name given twice, got %v", err)
+	}
+}
+
+func TestTagProtect(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v4/projects/test-owner/test-repo/protected_tags" {
+			cmdtest.ErrorResponse(w, 404, "not found")
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		cmdtest.JSONResponse(w, 201, map[string]any{
+			"name":                 "v*",
+			"create_access_levels": []map[string]any{{"access_level": 30, "access_level_description": "Developers + Maintainers"}},
+		})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newTagProtectCmd(f.Factory)
+	cmd.SetArgs([]string{"v*", "--create-access-level", "developer"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["name"] != "v*" || body["create_access_level"] != float64(30) {
+		t.Errorf("unexpected request body: %v", body)
+	}
+	out := f.IO.String()
+	if !strings.Contains(out, `Protected tag "v*"`) || !strings.Contains(out, "Create: Developers + Maintainers") {
+		t.Errorf("unexpected output: %q", out)
+	}
+}
+
+func TestTagUnprotect(t *testing.T) {
+	var method, path string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		w.WriteHeader(204)
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newTagUnprotectCmd(f.Factory)
+	cmd.SetArgs([]string{"v*"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if method != http.MethodDelete || path != "/api/v4/projects/test-owner/test-repo/protected_tags/v*" {
+		t.Errorf("unexpected request: %s %s", method, path)
+	}
+}
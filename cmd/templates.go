@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	gitutil "github.com/PhilipKram/gitlab-cli/internal/git"
+	"github.com/PhilipKram/gitlab-cli/internal/prompt"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// Template kinds, as named by the project templates API.
+const (
+	mergeRequestTemplates = "merge_requests"
+	issueTemplates        = "issues"
+)
+
+// templateDirs maps a template kind to its directory in a repository.
+var templateDirs = map[string]string{
+	mergeRequestTemplates: filepath.Join(".gitlab", "merge_request_templates"),
+	issueTemplates:        filepath.Join(".gitlab", "issue_templates"),
+}
+
+// descriptionTemplate is a merge request or issue description template.
+type descriptionTemplate struct {
+	Name    string
+	Content string
+}
+
+// listDescriptionTemplates returns the description templates of a kind. When
+// the current checkout is the project, templates in its .gitlab directory are
+// read from disk, so unpushed templates can be used. Templates from GitLab,
+// which include group and instance templates, are added after them.
+func listDescriptionTemplates(f *cmdutil.Factory, client *api.Client, project, kind string) ([]descriptionTemplate, error) {
+	var templates []descriptionTemplate
+	seen := make(map[string]bool)
+
+	if remote, err := f.Remote(); err == nil && remote.Owner+"/"+remote.Repo == project {
+		if root, err := gitutil.TopLevelDir(); err == nil {
+			for _, t := range readLocalTemplates(filepath.Join(root, templateDirs[kind])) {
+				seen[strings.ToLower(t.Name)] = true
+				templates = append(templates, t)
+			}
+		}
+	}
+
+	remote, resp, err := client.ProjectTemplates.ListTemplates(project, kind, &gitlab.ListProjectTemplatesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100},
+	})
+	if err != nil {
+		// Older GitLab versions lack the templates API; local templates
+		// are still usable.
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return templates, nil
+		}
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := fmt.Sprintf("%s/projects/%s/templates/%s", api.APIURL(client.Host()), project, kind)
+		return nil, errors.NewAPIError("GET", url, statusCode, "Failed to list templates", err)
+	}
+	for _, t := range remote {
+		name := t.Name
+		if name == "" {
+			name = t.Key
+		}
+		if seen[strings.ToLower(name)] {
+			continue
+		}
+		seen[strings.ToLower(name)] = true
+		templates = append(templates, descriptionTemplate{Name: name})
+	}
+	return templates, nil
+}
+
+// readLocalTemplates reads the markdown templates in dir, sorted by name.
+func readLocalTemplates(dir string) []descriptionTemplate {
+	matches, _ := filepath.Glob(filepath.Join(dir, "*.md"))
+	sort.Strings(matches)
+
+	var templates []descriptionTemplate
+	for _, path := range matches {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		templates = append(templates, descriptionTemplate{
+			Name:    strings.TrimSuffix(filepath.Base(path), ".md"),
+			Content: string(content),
+		})
+	}
+	return templates
+}
+
+// templateContent returns the content of a template, fetching it from GitLab
+// when it was not read from disk.
+func templateContent(client *api.Client, project, kind string, t descriptionTemplate) (string, error) {
+	if t.Content != "" {
+		return t.Content, nil
+	}
+	tmpl, resp, err := client.ProjectTemplates.GetProjectTemplate(project, kind, t.Name)
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := fmt.Sprintf("%s/projects/%s/templates/%s/%s", api.APIURL(client.Host()), project, kind, t.Name)
+		return "", errors.NewAPIError("GET", url, statusCode, fmt.Sprintf("Failed to get template %q", t.Name), err)
+	}
+	return tmpl.Content, nil
+}
+
+// findDescriptionTemplate returns the content of the template called name,
+// matched case-insensitively, as requested with --template.
+func findDescriptionTemplate(f *cmdutil.Factory, client *api.Client, project, kind, name string) (string, error) {
+	templates, err := listDescriptionTemplates(f, client, project, kind)
+	if err != nil {
+		return "", err
+	}
+
+	var names []string
+	for _, t := range templates {
+		if strings.EqualFold(t.Name, name) {
+			return templateContent(client, project, kind, t)
+		}
+		names = append(names, t.Name)
+	}
+	if len(names) == 0 {
+		return "", fmt.Errorf("template %q not found: the project has no templates", name)
+	}
+	return "", fmt.Errorf("template %q not found; available templates: %s", name, strings.Join(names, ", "))
+}
+
+// pickDescriptionTemplate offers the project's templates in a picker and
+// returns the content of the chosen one, or "" if there are none or the user
+// picks none.
+func pickDescriptionTemplate(f *cmdutil.Factory, client *api.Client, project, kind string) (string, error) {
+	templates, err := listDescriptionTemplates(f, client, project, kind)
+	if err != nil || len(templates) == 0 {
+		return "", err
+	}
+
+	options := []string{"Open a blank description"}
+	for _, t := range templates {
+		options = append(options, t.Name)
+	}
+	idx, err := prompt.Select(f.IOStreams.In, f.IOStreams.ErrOut, "Choose a template:", options)
+	if err != nil || idx == 0 {
+		return "", err
+	}
+	return templateContent(client, project, kind, templates[idx-1])
+}
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestReadLocalTemplates(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"Feature.md": "## Feature\n",
+		"Bug.md":     "## Bug\n",
+		"notes.txt":  "ignored",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	templates := readLocalTemplates(dir)
+	if len(templates) != 2 {
+		t.Fatalf("expected 2 templates, got %d: %+v", len(templates), templates)
+	}
+	if templates[0].Name != "Bug" || templates[0].Content != "## Bug\n" {
+		t.Errorf("unexpected first template: %+v", templates[0])
+	}
+	if templates[1].Name != "Feature" {
+		t.Errorf("unexpected second template: %+v", templates[1])
+	}
+
+	if got := readLocalTemplates(filepath.Join(dir, "missing")); len(got) != 0 {
+		t.Errorf("expected no templates for missing dir, got %+v", got)
+	}
+}
+
+func TestIssueCreate_Template(t *testing.T) {
+	var description string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && strings.HasSuffix(r.URL.Path, "/templates/issues"):
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+				{"key": "Bug", "name": "Bug"},
+				{"key": "Feature", "name": "Feature"},
+			})
+		case r.Method == "GET" && strings.HasSuffix(r.URL.Path, "/templates/issues/Bug"):
+			cmdtest.JSONResponse(w, 200, map[string]interface{}{
+				"name":    "Bug",
+				"content": "## Steps to reproduce\n",
+			})
+		case r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/issues"):
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			description, _ = body["description"].(string)
+			cmdtest.JSONResponse(w, 201, cmdtest.FixtureIssueOpen)
+		default:
+			cmdtest.ErrorResponse(w, 404, "404 Not Found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"--title", "Crash", "--template", "bug"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if description != "## Steps to reproduce\n" {
+		t.Errorf("expected template description, got %q", description)
+	}
+}
+
+func TestIssueCreate_TemplateNotFound(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" && strings.HasSuffix(r.URL.Path, "/templates/issues") {
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+				{"key": "Bug", "name": "Bug"},
+			})
+			return
+		}
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		cmdtest.ErrorResponse(w, 404, "404 Not Found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newIssueCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"--title", "Crash", "--template", "Security"})
+
+	err := cmd.Execute()
+	if err == nil {
+		t.Fatal("expected error for unknown template")
+	}
+	if !strings.Contains(err.Error(), `"Security" not found`) || !strings.Contains(err.Error(), "Bug") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestMRCreate_TemplateWithDescription(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newMRCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"--title", "Add", "--source-branch", "feature", "--target-branch", "main", "--template", "Default", "--description", "x"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "cannot be used together") {
+		t.Errorf("expected mutually exclusive error, got %v", err)
+	}
+}
@@ -0,0 +1,253 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/browser"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// NewTodoCmd creates the todo command group.
+func NewTodoCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "todo <command>",
+		Short: "Manage your to-do list",
+		Long:  "List your pending to-do items and mark them as done.",
+	}
+
+	cmd.AddCommand(newTodoListCmd(f))
+	cmd.AddCommand(newTodoDoneCmd(f))
+
+	return cmd
+}
+
+func newTodoListCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		action     string
+		targetType string
+		project    string
+		author     string
+		state      string
+		limit      int
+		format     string
+		jsonFlag   bool
+		web        bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List to-do items",
+		Aliases: []string{"ls"},
+		Example: `  $ glab todo list
+  $ glab todo list --action review_requested
+  $ glab todo list --type mr --project my-group/my-project
+  $ glab todo list --state done --limit 50`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			if web {
+				return browser.Open(api.WebURL(client.Host(), "dashboard/todos"))
+			}
+
+			opts := &gitlab.ListTodosOptions{
+				ListOptions: gitlab.ListOptions{PerPage: int64(limit)},
+			}
+			if action != "" {
+				opts.Action = gitlab.Ptr(gitlab.TodoAction(action))
+			}
+			if targetType != "" {
+				t, err := todoTargetType(targetType)
+				if err != nil {
+					return err
+				}
+				opts.Type = &t
+			}
+			if state != "" {
+				opts.State = &state
+			}
+			if project != "" {
+				p, resp, err := client.Projects.GetProject(project, nil)
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/projects/" + project
+					return errors.NewAPIError("GET", url, statusCode, "Failed to get project", err)
+				}
+				opts.ProjectID = &p.ID
+			}
+			if author != "" {
+				ids, err := resolveUserIDs(client, []string{author})
+				if err != nil {
+					return err
+				}
+				opts.AuthorID = &ids[0]
+			}
+
+			todos, resp, err := client.Todos.ListTodos(opts)
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/todos"
+				return errors.NewAPIError("GET", url, statusCode, "Failed to list to-do items", err)
+			}
+
+			if len(todos) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No to-do items found")
+				return nil
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(todos, format, jsonFlag)
+			}
+
+			tp := f.NewTablePrinter()
+			for _, t := range todos {
+				title := ""
+				if t.Target != nil {
+					title = truncate(t.Target.Title, 60)
+				}
+				tp.AddRow(
+					fmt.Sprintf("%d", t.ID),
+					strings.ReplaceAll(string(t.ActionName), "_", " "),
+					todoReference(t),
+					title,
+					timeAgo(t.CreatedAt),
+				)
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().StringVar(&action, "action", "", "Filter by action: assigned, mentioned, build_failed, marked, approval_required, directly_addressed, review_requested")
+	cmd.Flags().StringVar(&targetType, "type", "", "Filter by target type: issue, mr, epic, commit, design, or alert")
+	cmd.Flags().StringVarP(&project, "project", "p", "", "Filter by project path")
+	cmd.Flags().StringVar(&author, "author", "", "Filter by the username of the user who created the to-do item")
+	cmd.Flags().StringVar(&state, "state", "pending", "Filter by state: pending or done")
+	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open your to-do list in the browser")
+
+	return cmd
+}
+
+func newTodoDoneCmd(f *cmdutil.Factory) *cobra.Command {
+	var all bool
+
+	cmd := &cobra.Command{
+		Use:   "done [<id>...]",
+		Short: "Mark to-do items as done",
+		Example: `  $ glab todo done 123
+  $ glab todo done 123 124 125
+  $ glab todo done --all`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if all && len(args) > 0 {
+				return fmt.Errorf("specify to-do IDs or --all, not both")
+			}
+			if !all && len(args) == 0 {
+				return fmt.Errorf("specify one or more to-do IDs, or --all")
+			}
+
+			ids := make([]int64, len(args))
+			for i, arg := range args {
+				id, err := strconv.ParseInt(strings.TrimPrefix(arg, "#"), 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid to-do ID: %s", arg)
+				}
+				ids[i] = id
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			out := f.IOStreams.Out
+
+			if all {
+				resp, err := client.Todos.MarkAllTodosAsDone()
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/todos/mark_as_done"
+					return errors.NewAPIError("POST", url, statusCode, "Failed to mark all to-do items as done", err)
+				}
+				_, _ = fmt.Fprintln(out, "Marked all to-do items as done")
+				return nil
+			}
+
+			for _, id := range ids {
+				resp, err := client.Todos.MarkTodoAsDone(id)
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := fmt.Sprintf("%s/todos/%d/mark_as_done", api.APIURL(client.Host()), id)
+					return errors.NewAPIError("POST", url, statusCode, fmt.Sprintf("Failed to mark to-do item %d as done", id), err)
+				}
+				_, _ = fmt.Fprintf(out, "Marked to-do item %d as done\n", id)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&all, "all", "a", false, "Mark all pending to-do items as done")
+
+	return cmd
+}
+
+// todoTargetType maps a user-friendly target type to the Todos API value.
+func todoTargetType(s string) (string, error) {
+	switch strings.ToLower(s) {
+	case "issue":
+		return string(gitlab.TodoTargetIssue), nil
+	case "mr", "merge_request", "mergerequest":
+		return string(gitlab.TodoTargetMergeRequest), nil
+	case "epic":
+		return "Epic", nil
+	case "commit":
+		return "Commit", nil
+	case "design":
+		return string(gitlab.TodoTargetDesignManagement), nil
+	case "alert":
+		return string(gitlab.TodoTargetAlertManagement), nil
+	default:
+		return "", fmt.Errorf("invalid type %q: must be issue, mr, epic, commit, design, or alert", s)
+	}
+}
+
+// todoReference returns a short reference to the target of a to-do item,
+// such as "group/project!12".
+func todoReference(t *gitlab.Todo) string {
+	project := ""
+	if t.Project != nil {
+		project = t.Project.PathWithNamespace
+	}
+	if t.Target == nil {
+		return project
+	}
+	switch t.TargetType {
+	case gitlab.TodoTargetMergeRequest:
+		return fmt.Sprintf("%s!%d", project, t.Target.IID)
+	case gitlab.TodoTargetIssue:
+		return fmt.Sprintf("%s#%d", project, t.Target.IID)
+	default:
+		return project
+	}
+}
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestNewTodoCmd(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewTodoCmd(f)
+
+	if cmd.Use != "todo <command>" {
+		t.Errorf("expected Use to be 'todo <command>', got %q", cmd.Use)
+	}
+
+	names := map[string]bool{}
+	for _, sub := range cmd.Commands() {
+		names[sub.Name()] = true
+	}
+	for _, expected := range []string{"list", "done"} {
+		if !names[expected] {
+			t.Errorf("expected subcommand %q not found", expected)
+		}
+	}
+}
+
+func TestTodoList_Filters(t *testing.T) {
+	var query string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v4/projects/my-group/my-project":
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 55})
+		case "/api/v4/todos":
+			query = r.URL.RawQuery
+			cmdtest.JSONResponse(w, 200, []map[string]any{
+				{
+					"id":          1,
+					"action_name": "review_requested",
+					"target_type": "MergeRequest",
+					"project":     map[string]any{"path_with_namespace": "my-group/my-project"},
+					"target":      map[string]any{"iid": 12, "title": "Add caching"},
+				},
+			})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newTodoListCmd(f.Factory)
+	cmd.SetArgs([]string{"--action", "review_requested", "--type", "mr", "--project", "my-group/my-project"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{"action=review_requested", "type=MergeRequest", "project_id=55", "state=pending"} {
+		if !strings.Contains(query, want) {
+			t.Errorf("expected query to contain %q, got %s", want, query)
+		}
+	}
+	out := f.IO.String()
+	if !strings.Contains(out, "my-group/my-project!12") || !strings.Contains(out, "review requested") {
+		t.Errorf("unexpected output: %s", out)
+	}
+}
+
+func TestTodoDone(t *testing.T) {
+	var paths []string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			paths = append(paths, r.URL.Path)
+			cmdtest.JSONResponse(w, 200, map[string]any{})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newTodoDoneCmd(f.Factory)
+	cmd.SetArgs([]string{"1", "#2"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(paths) != 2 || paths[0] != "/api/v4/todos/1/mark_as_done" || paths[1] != "/api/v4/todos/2/mark_as_done" {
+		t.Errorf("unexpected requests: %v", paths)
+	}
+
+	paths = nil
+	f = cmdtest.NewTestFactory(t)
+	cmd = newTodoDoneCmd(f.Factory)
+	cmd.SetArgs([]string{"--all"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(paths) != 1 || paths[0] != "/api/v4/todos/mark_as_done" {
+		t.Errorf("unexpected requests: %v", paths)
+	}
+}
+
+func TestTodoDone_Validation(t *testing.T) {
+	for _, args := range [][]string{{}, {"1", "--all"}, {"abc"}} {
+		f := cmdtest.NewTestFactory(t)
+		cmd := newTodoDoneCmd(f.Factory)
+		cmd.SetArgs(args)
+		if err := cmd.Execute(); err == nil {
+			t.Errorf("expected error for args %v", args)
+		}
+	}
+}
+
+func TestTodoTargetType(t *testing.T) {
+	if got, _ := todoTargetType("MR"); got != string(gitlab.TodoTargetMergeRequest) {
+		t.Errorf("expected MergeRequest, got %q", got)
+	}
+	if _, err := todoTargetType("wiki"); err == nil {
+		t.Error("expected error for unknown type")
+	}
+}
@@ -0,0 +1,434 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/config"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/PhilipKram/gitlab-cli/internal/prompt"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// NewTokenCmd creates the token command group.
+func NewTokenCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token <command>",
+		Short: "Manage personal access tokens",
+		Long: `List, create, revoke, and rotate personal access tokens.
+
+New and rotated tokens can be stored as the credentials for the current host
+with --store, replacing the token glab is currently using.`,
+	}
+
+	cmd.AddCommand(newTokenListCmd(f))
+	cmd.AddCommand(newTokenCreateCmd(f))
+	cmd.AddCommand(newTokenRevokeCmd(f))
+	cmd.AddCommand(newTokenRotateCmd(f))
+
+	return cmd
+}
+
+func newTokenListCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		user     string
+		state    string
+		limit    int
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List personal access tokens",
+		Aliases: []string{"ls"},
+		Example: `  $ glab token list
+  $ glab token list --state inactive
+  $ glab token list --user alice --format json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch state {
+			case "", "active", "inactive":
+			default:
+				return fmt.Errorf("invalid --state %q: must be active or inactive", state)
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			userID, _, err := tokenUser(cmd, client, user)
+			if err != nil {
+				return err
+			}
+
+			opts := &gitlab.ListPersonalAccessTokensOptions{
+				ListOptions: gitlab.ListOptions{PerPage: int64(limit)},
+				UserID:      &userID,
+			}
+			if state != "" {
+				opts.State = &state
+			}
+
+			tokens, resp, err := client.PersonalAccessTokens.ListPersonalAccessTokens(opts, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/personal_access_tokens"
+				return errors.NewAPIError("GET", url, statusCode, "Failed to list personal access tokens", err)
+			}
+
+			if len(tokens) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No personal access tokens found")
+				return nil
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(tokens, format, jsonFlag)
+			}
+
+			tp := f.NewTablePrinter()
+			for _, t := range tokens {
+				status := "active"
+				switch {
+				case t.Revoked:
+					status = "revoked"
+				case !t.Active:
+					status = "expired"
+				}
+				expires := "never expires"
+				if t.ExpiresAt != nil {
+					expires = "expires " + t.ExpiresAt.String()
+				}
+				lastUsed := "never used"
+				if t.LastUsedAt != nil {
+					lastUsed = "used " + timeAgo(t.LastUsedAt)
+				}
+				tp.AddRow(strconv.FormatInt(t.ID, 10), t.Name, strings.Join(t.Scopes, ","), status, expires, lastUsed)
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().StringVarP(&user, "user", "u", "", "List the tokens of another user (administrators only)")
+	cmd.Flags().StringVarP(&state, "state", "s", "active", "Filter by state: active or inactive (empty for all)")
+	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+func newTokenCreateCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		user        string
+		scopes      []string
+		description string
+		expiresAt   string
+		store       bool
+		format      string
+		jsonFlag    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a personal access token",
+		Long: `Create a personal access token with the given scopes.
+
+Creating tokens through the API requires administrator access on most GitLab
+instances. The token value is only shown once; use --store to save it as the
+credentials for the current host, or --format json to capture it in scripts.`,
+		Example: `  $ glab token create ci-bot --scopes api --expires-at 2025-12-31
+  $ glab token create laptop --scopes api,write_repository --store
+  $ glab token create reader --scopes read_api --user alice --format json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			opts := &gitlab.CreatePersonalAccessTokenOptions{
+				Name:   &name,
+				Scopes: &scopes,
+			}
+			if description != "" {
+				opts.Description = &description
+			}
+			if expiresAt != "" {
+				t, err := parseDate(expiresAt)
+				if err != nil {
+					return fmt.Errorf("invalid --expires-at: %w", err)
+				}
+				iso := gitlab.ISOTime(t)
+				opts.ExpiresAt = &iso
+			}
+			if store && user != "" {
+				return fmt.Errorf("--store cannot be used with --user")
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			userID, username, err := tokenUser(cmd, client, user)
+			if err != nil {
+				return err
+			}
+
+			token, resp, err := client.Users.CreatePersonalAccessToken(userID, opts, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/users/%d/personal_access_tokens", api.APIURL(client.Host()), userID)
+				return errors.NewAPIError("POST", url, statusCode, "Failed to create personal access token", err)
+			}
+
+			if store {
+				if err := storeToken(f, client.Host(), token.Token, username); err != nil {
+					return err
+				}
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(token, format, jsonFlag)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Created personal access token %d (%s)\n", token.ID, token.Name)
+			printNewToken(f, token, store)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&user, "user", "u", "", "Create the token for another user (administrators only)")
+	cmd.Flags().StringSliceVarP(&scopes, "scopes", "s", nil, "Token scopes, e.g. api,read_repository (required)")
+	cmd.Flags().StringVarP(&description, "description", "d", "", "Token description")
+	cmd.Flags().StringVar(&expiresAt, "expires-at", "", "Date the token expires (YYYY-MM-DD)")
+	cmd.Flags().BoolVar(&store, "store", false, "Store the new token as the credentials for the current host")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	_ = cmd.MarkFlagRequired("scopes")
+
+	return cmd
+}
+
+func newTokenRevokeCmd(f *cmdutil.Factory) *cobra.Command {
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:     "revoke <id>",
+		Short:   "Revoke a personal access token",
+		Aliases: []string{"delete"},
+		Example: `  $ glab token revoke 42
+  $ glab token revoke 42 --yes`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid token ID: %s", args[0])
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			if !yes {
+				confirmed, err := prompt.Confirm(f.IOStreams.In, f.IOStreams.ErrOut, fmt.Sprintf("Revoke personal access token %d?", id), false)
+				if err != nil {
+					return err
+				}
+				if !confirmed {
+					_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "Revocation cancelled")
+					return nil
+				}
+			}
+
+			resp, err := client.PersonalAccessTokens.RevokePersonalAccessTokenByID(id, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/personal_access_tokens/%d", api.APIURL(client.Host()), id)
+				return errors.NewAPIError("DELETE", url, statusCode, "Failed to revoke personal access token", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Revoked personal access token %d\n", id)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func newTokenRotateCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		expiresAt string
+		store     bool
+		format    string
+		jsonFlag  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "rotate <id>",
+		Short: "Rotate a personal access token",
+		Long: `Revoke a personal access token and create a new one with the same name and
+scopes.
+
+Use "self" as the ID to rotate the token glab is authenticated with; combine it
+with --store so glab keeps working with the new token.`,
+		Example: `  $ glab token rotate 42
+  $ glab token rotate 42 --expires-at 2025-12-31
+  $ glab token rotate self --store`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var id int64
+			if args[0] != "self" {
+				var err error
+				id, err = strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid token ID: %s", args[0])
+				}
+			}
+
+			opts := &gitlab.RotatePersonalAccessTokenOptions{}
+			if expiresAt != "" {
+				t, err := parseDate(expiresAt)
+				if err != nil {
+					return fmt.Errorf("invalid --expires-at: %w", err)
+				}
+				iso := gitlab.ISOTime(t)
+				opts.ExpiresAt = &iso
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			// Look up the owner first: rotating "self" revokes the token the
+			// client is authenticated with.
+			var username string
+			if store {
+				_, username, err = tokenUser(cmd, client, "")
+				if err != nil {
+					return err
+				}
+			}
+
+			var token *gitlab.PersonalAccessToken
+			var resp *gitlab.Response
+			if id == 0 {
+				token, resp, err = client.PersonalAccessTokens.RotatePersonalAccessTokenSelf(opts, gitlab.WithContext(cmd.Context()))
+			} else {
+				token, resp, err = client.PersonalAccessTokens.RotatePersonalAccessTokenByID(id, opts, gitlab.WithContext(cmd.Context()))
+			}
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/personal_access_tokens/%s/rotate", api.APIURL(client.Host()), args[0])
+				return errors.NewAPIError("POST", url, statusCode, "Failed to rotate personal access token", err)
+			}
+
+			if store {
+				if err := storeToken(f, client.Host(), token.Token, username); err != nil {
+					return err
+				}
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(token, format, jsonFlag)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Rotated personal access token %s; new token %d (%s)\n", args[0], token.ID, token.Name)
+			printNewToken(f, token, store)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&expiresAt, "expires-at", "", "Date the new token expires (YYYY-MM-DD, default: one week)")
+	cmd.Flags().BoolVar(&store, "store", false, "Store the new token as the credentials for the current host")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+// tokenUser returns the ID and username of the given user, or of the
+// authenticated user when username is empty.
+func tokenUser(cmd *cobra.Command, client *api.Client, username string) (int64, string, error) {
+	if username != "" {
+		ids, err := resolveUserIDs(client, []string{username})
+		if err != nil {
+			return 0, "", err
+		}
+		return ids[0], strings.TrimPrefix(username, "@"), nil
+	}
+
+	user, resp, err := client.Users.CurrentUser(gitlab.WithContext(cmd.Context()))
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		url := api.APIURL(client.Host()) + "/user"
+		return 0, "", errors.NewAPIError("GET", url, statusCode, "Failed to get current user", err)
+	}
+	return user.ID, user.Username, nil
+}
+
+// storeToken saves token as the personal access token for host, replacing
+// any OAuth credentials stored for it.
+func storeToken(f *cmdutil.Factory, host, token, username string) error {
+	hosts, err := config.LoadHosts()
+	if err != nil {
+		hosts = make(config.HostsConfig)
+	}
+	hc, ok := hosts[host]
+	if !ok {
+		hc = &config.HostConfig{}
+		hosts[host] = hc
+	}
+	hc.Token = token
+	hc.User = username
+	hc.AuthMethod = "pat"
+	hc.RefreshToken = ""
+	hc.TokenExpiresAt = 0
+	hc.TokenCreatedAt = 0
+	if err := config.SaveHosts(hosts); err != nil {
+		return fmt.Errorf("saving credentials: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "✓ Stored the new token for %s as %s\n", host, username)
+	if _, source := config.TokenForHost(host); source != host {
+		_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "! %s is set and takes precedence over the stored token\n", source)
+	}
+	return nil
+}
+
+// printNewToken prints the value of a newly created token along with its
+// scopes and expiry.
+func printNewToken(f *cmdutil.Factory, token *gitlab.PersonalAccessToken, stored bool) {
+	out := f.IOStreams.Out
+	if len(token.Scopes) > 0 {
+		_, _ = fmt.Fprintf(out, "Scopes:  %s\n", strings.Join(token.Scopes, ", "))
+	}
+	if token.ExpiresAt != nil {
+		_, _ = fmt.Fprintf(out, "Expires: %s\n", token.ExpiresAt.String())
+	}
+	_, _ = fmt.Fprintf(out, "Token:   %s\n", token.Token)
+	if !stored {
+		_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "Store the token now; it will not be shown again.")
+	}
+}
@@ -0,0 +1,190 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+	"github.com/PhilipKram/gitlab-cli/internal/config"
+)
+
+func TestTokenCmd_HasSubcommands(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewTokenCmd(f)
+
+	expectedSubcommands := []string{
+		"list",
+		"create",
+		"revoke",
+		"rotate",
+	}
+
+	subcommands := cmd.Commands()
+	if len(subcommands) != len(expectedSubcommands) {
+		t.Errorf("expected %d subcommands, got %d", len(expectedSubcommands), len(subcommands))
+	}
+
+	foundSubcommands := make(map[string]bool)
+	for _, subcmd := range subcommands {
+		foundSubcommands[subcmd.Name()] = true
+	}
+
+	for _, expected := range expectedSubcommands {
+		if !foundSubcommands[expected] {
+			t.Errorf("expected subcommand %q not found", expected)
+		}
+	}
+}
+
+func TestTokenList(t *testing.T) {
+	var query map[string]string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v4/user":
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 5, "username": "me"})
+		case "/api/v4/personal_access_tokens":
+			query = map[string]string{"user_id": r.URL.Query().Get("user_id"), "state": r.URL.Query().Get("state")}
+			cmdtest.JSONResponse(w, 200, []any{
+				map[string]any{
+					"id": 42, "name": "ci-bot", "scopes": []string{"api", "read_repository"},
+					"active": true, "expires_at": "2025-12-31",
+				},
+			})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newTokenListCmd(f.Factory)
+	cmd.SetArgs([]string{})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if query["user_id"] != "5" || query["state"] != "active" {
+		t.Errorf("unexpected query: %v", query)
+	}
+	out := f.IO.String()
+	for _, want := range []string{"42", "ci-bot", "api,read_repository", "active", "expires 2025-12-31", "never used"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got %q", want, out)
+		}
+	}
+}
+
+func TestTokenCreate(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v4/user":
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 5, "username": "me"})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v4/users/5/personal_access_tokens":
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 201, map[string]any{
+				"id": 43, "name": body["name"], "scopes": body["scopes"], "expires_at": body["expires_at"], "token": "glpat-new",
+			})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newTokenCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"ci-bot", "--scopes", "api", "--expires-at", "2025-12-31"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["name"] != "ci-bot" || body["expires_at"] != "2025-12-31" {
+		t.Errorf("unexpected request body: %v", body)
+	}
+	out := f.IO.String()
+	for _, want := range []string{"Created personal access token 43 (ci-bot)", "Scopes:  api", "Expires: 2025-12-31", "Token:   glpat-new"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got %q", want, out)
+		}
+	}
+	if !strings.Contains(f.IO.ErrString(), "will not be shown again") {
+		t.Errorf("unexpected stderr: %q", f.IO.ErrString())
+	}
+}
+
+func TestTokenCreate_StoreWithUser(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newTokenCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"ci-bot", "--scopes", "api", "--user", "alice", "--store"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "--store cannot be used with --user") {
+		t.Errorf("expected --store/--user error, got %v", err)
+	}
+}
+
+func TestTokenRotate_SelfStore(t *testing.T) {
+	var rotatePath string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/rotate"):
+			rotatePath = r.URL.Path
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 44, "name": "laptop", "token": "glpat-rotated"})
+		case r.URL.Path == "/api/v4/user":
+			if rotatePath != "" {
+				cmdtest.ErrorResponse(w, 401, "token revoked")
+				return
+			}
+			cmdtest.JSONResponse(w, 200, map[string]any{"id": 5, "username": "me"})
+		default:
+			cmdtest.ErrorResponse(w, 404, "not found")
+		}
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newTokenRotateCmd(f.Factory)
+	cmd.SetArgs([]string{"self", "--store"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if rotatePath != "/api/v4/personal_access_tokens/self/rotate" {
+		t.Errorf("unexpected rotate path: %q", rotatePath)
+	}
+	hosts, err := config.LoadHosts()
+	if err != nil {
+		t.Fatalf("loading hosts: %v", err)
+	}
+	if hc := hosts["gitlab.com"]; hc == nil || hc.Token != "glpat-rotated" || hc.User != "me" || hc.AuthMethod != "pat" {
+		t.Errorf("expected the rotated token to be stored, got %+v", hc)
+	}
+	stderr := f.IO.ErrString()
+	if !strings.Contains(stderr, "Stored the new token for gitlab.com as me") {
+		t.Errorf("unexpected stderr: %q", stderr)
+	}
+	if !strings.Contains(stderr, "GITLAB_TOKEN is set and takes precedence") {
+		t.Errorf("expected a GITLAB_TOKEN warning, got %q", stderr)
+	}
+}
+
+func TestTokenRevoke(t *testing.T) {
+	revoked := false
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete && r.URL.Path == "/api/v4/personal_access_tokens/42" {
+			revoked = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newTokenRevokeCmd(f.Factory)
+	cmd.SetArgs([]string{"42", "--yes"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !revoked {
+		t.Error("expected the token to be revoked")
+	}
+}
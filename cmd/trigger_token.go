@@ -0,0 +1,217 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/PhilipKram/gitlab-cli/internal/prompt"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// NewTriggerTokenCmd creates the trigger-token command group.
+func NewTriggerTokenCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trigger-token <command>",
+		Short: "Manage pipeline trigger tokens",
+		Long: `List, create, and revoke the pipeline trigger tokens of a project.
+
+A trigger token lets scripts and other projects start pipelines with
+"glab pipeline trigger --token" without any other credentials.`,
+	}
+
+	cmd.AddCommand(newTriggerTokenListCmd(f))
+	cmd.AddCommand(newTriggerTokenCreateCmd(f))
+	cmd.AddCommand(newTriggerTokenRevokeCmd(f))
+
+	return cmd
+}
+
+func newTriggerTokenListCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		limit    int
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List trigger tokens",
+		Aliases: []string{"ls"},
+		Example: `  $ glab trigger-token list
+  $ glab trigger-token list --format json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			triggers, resp, err := client.PipelineTriggers.ListPipelineTriggers(project, &gitlab.ListPipelineTriggersOptions{
+				ListOptions: gitlab.ListOptions{PerPage: int64(limit)},
+			}, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + project + "/triggers"
+				return errors.NewAPIError("GET", url, statusCode, "Failed to list trigger tokens", err)
+			}
+
+			if len(triggers) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No trigger tokens found")
+				return nil
+			}
+
+			if jsonFlag || f.ExportRequested() || (format != "" && format != "table") {
+				return f.FormatAndPrint(triggers, format, jsonFlag)
+			}
+
+			tp := f.NewTablePrinter()
+			tp.SetHeader("ID", "DESCRIPTION", "TOKEN", "OWNER", "LAST USED", "CREATED")
+			for _, t := range triggers {
+				owner := ""
+				if t.Owner != nil {
+					owner = t.Owner.Username
+				}
+				lastUsed := "never"
+				if t.LastUsed != nil {
+					lastUsed = timeAgo(t.LastUsed)
+				}
+				tp.AddRow(strconv.FormatInt(t.ID, 10), t.Description, t.Token, owner, lastUsed, timeAgo(t.CreatedAt))
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
+
+	return cmd
+}
+
+func newTriggerTokenCreateCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		description string
+		format      string
+		jsonFlag    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a trigger token",
+		Long: `Create a pipeline trigger token for the project.
+
+The new token is printed on standard output so it can be captured by a
+script; store it as a secret, it grants permission to run pipelines.`,
+		Example: `  $ glab trigger-token create --description "deploy from infra repo"
+  $ TOKEN=$(glab trigger-token create -d nightly)`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			trigger, resp, err := client.PipelineTriggers.AddPipelineTrigger(project, &gitlab.AddPipelineTriggerOptions{
+				Description: &description,
+			}, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + "/projects/" + project + "/triggers"
+				return errors.NewAPIError("POST", url, statusCode, "Failed to create trigger token", err)
+			}
+
+			if jsonFlag || f.ExportRequested() || (format != "" && format != "table") {
+				return f.FormatAndPrint(trigger, format, jsonFlag)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Created trigger token %d (%s) for %s\n", trigger.ID, trigger.Description, project)
+			_, _ = fmt.Fprintln(f.IOStreams.Out, trigger.Token)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&description, "description", "d", "glab-cli", "Description of the trigger token")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
+
+	return cmd
+}
+
+func newTriggerTokenRevokeCmd(f *cmdutil.Factory) *cobra.Command {
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:     "revoke <id>",
+		Short:   "Revoke a trigger token",
+		Aliases: []string{"delete"},
+		Example: `  $ glab trigger-token revoke 42
+  $ glab trigger-token revoke 42 --yes`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid trigger token ID: %s", args[0])
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			project, err := f.FullProjectPath()
+			if err != nil {
+				return err
+			}
+
+			if !yes {
+				confirmed, err := prompt.Confirm(f.IOStreams.In, f.IOStreams.ErrOut, fmt.Sprintf("Revoke trigger token %d of %s? Pipelines triggered with it will stop working.", id, project), false)
+				if err != nil {
+					return err
+				}
+				if !confirmed {
+					_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "Revocation cancelled")
+					return nil
+				}
+			}
+
+			resp, err := client.PipelineTriggers.DeletePipelineTrigger(project, id, gitlab.WithContext(cmd.Context()))
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := fmt.Sprintf("%s/projects/%s/triggers/%d", api.APIURL(client.Host()), project, id)
+				return errors.NewAPIError("DELETE", url, statusCode, "Failed to revoke trigger token", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Revoked trigger token %d of %s\n", id, project)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestTriggerTokenCmd_HasSubcommands(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewTriggerTokenCmd(f)
+
+	expectedSubcommands := []string{
+		"list",
+		"create",
+		"revoke",
+	}
+
+	foundSubcommands := make(map[string]bool)
+	for _, subcmd := range cmd.Commands() {
+		foundSubcommands[subcmd.Name()] = true
+	}
+	for _, expected := range expectedSubcommands {
+		if !foundSubcommands[expected] {
+			t.Errorf("expected subcommand %q not found", expected)
+		}
+	}
+}
+
+func TestTriggerTokenList(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/test-owner/test-repo/triggers" {
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+				{
+					"id": 10, "description": "infra repo", "token": "glptt-abc",
+					"created_at": "2024-01-15T10:00:00Z", "owner": map[string]interface{}{"username": "alice"},
+				},
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newTriggerTokenListCmd(f.Factory)
+	cmd.SetArgs([]string{})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := f.IO.String()
+	for _, want := range []string{"10", "infra repo", "glptt-abc", "alice", "never"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q: %s", want, out)
+		}
+	}
+}
+
+func TestTriggerTokenCreate(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/triggers" {
+			cmdtest.JSONResponse(w, 201, map[string]interface{}{"id": 11, "description": "nightly", "token": "glptt-new"})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newTriggerTokenCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"--description", "nightly"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := f.IO.String(); got != "glptt-new\n" {
+		t.Errorf("stdout = %q, want only the token", got)
+	}
+	if !strings.Contains(f.IO.ErrString(), "Created trigger token 11 (nightly)") {
+		t.Errorf("unexpected stderr: %s", f.IO.ErrString())
+	}
+}
+
+func TestTriggerTokenRevoke(t *testing.T) {
+	revoked := false
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete && r.URL.Path == "/api/v4/projects/test-owner/test-repo/triggers/11" {
+			revoked = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newTriggerTokenRevokeCmd(f.Factory)
+	cmd.SetArgs([]string{"11", "--yes"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !revoked {
+		t.Error("expected trigger token to be revoked")
+	}
+}
+
+func TestTriggerTokenRevoke_InvalidID(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newTriggerTokenRevokeCmd(f.Factory)
+	cmd.SetArgs([]string{"abc", "--yes"})
+
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "invalid trigger token ID") {
+		t.Fatalf("expected invalid ID error, got %v", err)
+	}
+}
@@ -1,12 +1,16 @@
 package cmd
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 
 	"github.com/PhilipKram/gitlab-cli/internal/api"
 	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/dotenv"
 	"github.com/PhilipKram/gitlab-cli/internal/errors"
 	"github.com/spf13/cobra"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
@@ -17,7 +21,7 @@ func NewVariableCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "variable <command>",
 		Short: "Manage CI/CD variables",
-		Long:  "Create, list, update, and delete CI/CD variables at project and group levels.",
+		Long:  "Create, list, update, and delete CI/CD variables at project, group, and instance levels.",
 	}
 
 	cmd.AddCommand(newVariableListCmd(f))
@@ -27,6 +31,8 @@ func NewVariableCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(newVariableDeleteCmd(f))
 	cmd.AddCommand(newVariableExportCmd(f))
 	cmd.AddCommand(newVariableImportCmd(f))
+	cmd.AddCommand(newVariableCopyCmd(f))
+	cmd.AddCommand(newVariableSyncCmd(f))
 
 	return cmd
 }
@@ -37,6 +43,7 @@ func newVariableListCmd(f *cmdutil.Factory) *cobra.Command {
 		format   string
 		jsonFlag bool
 		group    string
+		instance bool
 	)
 
 	cmd := &cobra.Command{
@@ -45,6 +52,7 @@ func newVariableListCmd(f *cmdutil.Factory) *cobra.Command {
 		Aliases: []string{"ls"},
 		Example: `  $ glab variable list
   $ glab variable list --group mygroup
+  $ glab variable list --instance
   $ glab variable list --json`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := f.Client()
@@ -56,6 +64,26 @@ func newVariableListCmd(f *cmdutil.Factory) *cobra.Command {
 			var groupVariables []*gitlab.GroupVariable
 			var resp *gitlab.Response
 
+			if instance {
+				// List instance-level variables
+				instanceVariables, resp, err := client.InstanceVariables.ListVariables(nil)
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/admin/ci/variables"
+					return errors.NewAPIError("GET", url, statusCode, "Failed to list instance variables", err)
+				}
+
+				if len(instanceVariables) == 0 {
+					_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No variables found")
+					return nil
+				}
+
+				return f.FormatAndPrint(instanceVariables, format, jsonFlag)
+			}
+
 			if group != "" {
 				// List group-level variables
 				groupVariables, resp, err = client.GroupVariables.ListVariables(group, nil)
@@ -102,9 +130,12 @@ func newVariableListCmd(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmd.Flags().IntVarP(&limit, "limit", "L", 30, "Maximum number of results")
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
 	cmd.Flags().StringVarP(&group, "group", "g", "", "List group-level variables (specify group path)")
+	cmd.Flags().BoolVar(&instance, "instance", false, "List instance-level variables (administrators only)")
+	cmd.MarkFlagsMutuallyExclusive("group", "instance")
 
 	return cmd
 }
@@ -114,13 +145,21 @@ func newVariableGetCmd(f *cmdutil.Factory) *cobra.Command {
 		format   string
 		jsonFlag bool
 		group    string
+		instance bool
+		reveal   bool
 	)
 
 	cmd := &cobra.Command{
 		Use:   "get <key>",
 		Short: "Get a CI/CD variable",
+		Long: `Get a CI/CD variable.
+
+The values of masked and protected variables are shown as [REDACTED] unless
+--reveal is passed.`,
 		Example: `  $ glab variable get MY_VAR
   $ glab variable get MY_VAR --group mygroup
+  $ glab variable get MY_VAR --instance
+  $ glab variable get DEPLOY_TOKEN --reveal
   $ glab variable get MY_VAR --json`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -131,6 +170,27 @@ func newVariableGetCmd(f *cmdutil.Factory) *cobra.Command {
 
 			key := args[0]
 
+			if instance {
+				// Get instance-level variable
+				variable, resp, err := client.InstanceVariables.GetVariable(key)
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/admin/ci/variables/" + key
+					return errors.NewAPIError("GET", url, statusCode, "Failed to get instance variable", err)
+				}
+
+				if !reveal && isSensitiveVariable(variable.Masked, variable.Protected) {
+					redacted := *variable
+					redacted.Value = redactedValue
+					variable = &redacted
+					printRedactedNote(f, 1)
+				}
+				return f.FormatAndPrint(variable, format, jsonFlag)
+			}
+
 			if group != "" {
 				// Get group-level variable
 				variable, resp, err := client.GroupVariables.GetVariable(group, key, nil)
@@ -143,6 +203,12 @@ func newVariableGetCmd(f *cmdutil.Factory) *cobra.Command {
 					return errors.NewAPIError("GET", url, statusCode, "Failed to get group variable", err)
 				}
 
+				if !reveal && isSensitiveVariable(variable.Masked, variable.Protected) {
+					redacted := *variable
+					redacted.Value = redactedValue
+					variable = &redacted
+					printRedactedNote(f, 1)
+				}
 				return f.FormatAndPrint(variable, format, jsonFlag)
 			}
 
@@ -162,13 +228,23 @@ func newVariableGetCmd(f *cmdutil.Factory) *cobra.Command {
 				return errors.NewAPIError("GET", url, statusCode, "Failed to get project variable", err)
 			}
 
+			if !reveal && isSensitiveVariable(variable.Masked, variable.Protected) {
+				redacted := *variable
+				redacted.Value = redactedValue
+				variable = &redacted
+				printRedactedNote(f, 1)
+			}
 			return f.FormatAndPrint(variable, format, jsonFlag)
 		},
 	}
 
-	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, or plain")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	f.AddExportFlags(cmd)
 	cmd.Flags().StringVarP(&group, "group", "g", "", "Get group-level variable (specify group path)")
+	cmd.Flags().BoolVar(&instance, "instance", false, "Get instance-level variable (administrators only)")
+	cmd.MarkFlagsMutuallyExclusive("group", "instance")
+	cmd.Flags().BoolVar(&reveal, "reveal", false, "Show the value of a masked or protected variable")
 
 	return cmd
 }
@@ -181,7 +257,9 @@ func newVariableSetCmd(f *cmdutil.Factory) *cobra.Command {
 		scope     string
 		filePath  string
 		group     string
+		instance  bool
 		varType   string
+		stdin     bool
 	)
 
 	cmd := &cobra.Command{
@@ -190,7 +268,9 @@ func newVariableSetCmd(f *cmdutil.Factory) *cobra.Command {
 		Example: `  $ glab variable set MY_VAR --value "my-value"
   $ glab variable set MY_VAR --value "secret" --masked --protected
   $ glab variable set MY_VAR --file ./config.json --scope production
-  $ glab variable set MY_VAR --value "group-secret" --group mygroup`,
+  $ pass show deploy/token | glab variable set DEPLOY_TOKEN --stdin --masked
+  $ glab variable set MY_VAR --value "group-secret" --group mygroup
+  $ glab variable set MY_VAR --value "shared" --instance`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := f.Client()
@@ -200,7 +280,7 @@ func newVariableSetCmd(f *cmdutil.Factory) *cobra.Command {
 
 			key := args[0]
 
-			// Get value from file or flag
+			// Get value from file, stdin, or flag
 			varValue := value
 			if filePath != "" {
 				data, err := os.ReadFile(filePath)
@@ -209,15 +289,26 @@ func newVariableSetCmd(f *cmdutil.Factory) *cobra.Command {
 				}
 				varValue = string(data)
 			}
+			if stdin {
+				data, err := io.ReadAll(f.IOStreams.In)
+				if err != nil {
+					return fmt.Errorf("reading stdin: %w", err)
+				}
+				// Drop the newline that "echo" and heredocs add.
+				varValue = strings.TrimSuffix(strings.TrimSuffix(string(data), "\n"), "\r")
+			}
 
 			if varValue == "" {
-				return fmt.Errorf("either --value or --file flag is required")
+				return fmt.Errorf("one of --value, --file, or --stdin is required")
 			}
 
 			// Default scope
 			if scope == "" {
 				scope = "*"
 			}
+			if instance && cmd.Flags().Changed("scope") {
+				return fmt.Errorf("instance variables cannot have an environment scope")
+			}
 
 			// Default variable type
 			variableType := gitlab.EnvVariableType
@@ -225,6 +316,45 @@ func newVariableSetCmd(f *cmdutil.Factory) *cobra.Command {
 				variableType = gitlab.FileVariableType
 			}
 
+			if instance {
+				// Set instance-level variable
+				// Try to update first, if it fails (not found), create it
+				updateOpts := &gitlab.UpdateInstanceVariableOptions{
+					Value:        &varValue,
+					Protected:    &protected,
+					Masked:       &masked,
+					VariableType: &variableType,
+				}
+
+				variable, _, err := client.InstanceVariables.UpdateVariable(key, updateOpts)
+				if err != nil {
+					// If variable doesn't exist, create it
+					createOpts := &gitlab.CreateInstanceVariableOptions{
+						Key:          &key,
+						Value:        &varValue,
+						Protected:    &protected,
+						Masked:       &masked,
+						VariableType: &variableType,
+					}
+
+					variable, resp, err := client.InstanceVariables.CreateVariable(createOpts)
+					if err != nil {
+						statusCode := 0
+						if resp != nil {
+							statusCode = resp.StatusCode
+						}
+						url := api.APIURL(client.Host()) + "/admin/ci/variables"
+						return errors.NewAPIError("POST", url, statusCode, "Failed to set instance variable", err)
+					}
+
+					_, _ = fmt.Fprintf(f.IOStreams.Out, "Created instance variable %q\n", variable.Key)
+					return nil
+				}
+
+				_, _ = fmt.Fprintf(f.IOStreams.Out, "Updated instance variable %q\n", variable.Key)
+				return nil
+			}
+
 			if group != "" {
 				// Set group-level variable
 				// Try to update first, if it fails (not found), create it
@@ -312,13 +442,17 @@ func newVariableSetCmd(f *cmdutil.Factory) *cobra.Command {
 		},
 	}
 
-	cmd.Flags().StringVarP(&value, "value", "v", "", "Variable value")
+	cmd.Flags().StringVar(&value, "value", "", "Variable value")
 	cmd.Flags().BoolVar(&masked, "masked", false, "Mask variable value in logs")
 	cmd.Flags().BoolVar(&protected, "protected", false, "Protect variable (only available in protected branches/tags)")
 	cmd.Flags().StringVar(&scope, "scope", "*", "Environment scope (default: *)")
 	cmd.Flags().StringVarP(&filePath, "file", "f", "", "Read variable value from file")
 	cmd.Flags().StringVarP(&group, "group", "g", "", "Set group-level variable (specify group path)")
+	cmd.Flags().BoolVar(&instance, "instance", false, "Set instance-level variable (administrators only)")
 	cmd.Flags().StringVar(&varType, "type", "env_var", "Variable type: env_var or file")
+	cmd.Flags().BoolVar(&stdin, "stdin", false, "Read variable value from standard input")
+	cmd.MarkFlagsMutuallyExclusive("value", "file", "stdin")
+	cmd.MarkFlagsMutuallyExclusive("group", "instance")
 
 	return cmd
 }
@@ -331,7 +465,9 @@ func newVariableUpdateCmd(f *cmdutil.Factory) *cobra.Command {
 		scope     string
 		filePath  string
 		group     string
+		instance  bool
 		varType   string
+		stdin     bool
 	)
 
 	cmd := &cobra.Command{
@@ -340,7 +476,8 @@ func newVariableUpdateCmd(f *cmdutil.Factory) *cobra.Command {
 		Example: `  $ glab variable update MY_VAR --value "new-value"
   $ glab variable update MY_VAR --masked --protected
   $ glab variable update MY_VAR --file ./config.json --scope production
-  $ glab variable update MY_VAR --value "updated-secret" --group mygroup`,
+  $ glab variable update MY_VAR --value "updated-secret" --group mygroup
+  $ glab variable update MY_VAR --value "shared" --instance`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := f.Client()
@@ -350,7 +487,7 @@ func newVariableUpdateCmd(f *cmdutil.Factory) *cobra.Command {
 
 			key := args[0]
 
-			// Get value from file or flag
+			// Get value from file, stdin, or flag
 			varValue := value
 			if filePath != "" {
 				data, err := os.ReadFile(filePath)
@@ -359,15 +496,26 @@ func newVariableUpdateCmd(f *cmdutil.Factory) *cobra.Command {
 				}
 				varValue = string(data)
 			}
+			if stdin {
+				data, err := io.ReadAll(f.IOStreams.In)
+				if err != nil {
+					return fmt.Errorf("reading stdin: %w", err)
+				}
+				// Drop the newline that "echo" and heredocs add.
+				varValue = strings.TrimSuffix(strings.TrimSuffix(string(data), "\n"), "\r")
+			}
 
 			if varValue == "" {
-				return fmt.Errorf("either --value or --file flag is required")
+				return fmt.Errorf("one of --value, --file, or --stdin is required")
 			}
 
 			// Default scope
 			if scope == "" {
 				scope = "*"
 			}
+			if instance && cmd.Flags().Changed("scope") {
+				return fmt.Errorf("instance variables cannot have an environment scope")
+			}
 
 			// Default variable type
 			variableType := gitlab.EnvVariableType
@@ -375,6 +523,29 @@ func newVariableUpdateCmd(f *cmdutil.Factory) *cobra.Command {
 				variableType = gitlab.FileVariableType
 			}
 
+			if instance {
+				// Update instance-level variable
+				updateOpts := &gitlab.UpdateInstanceVariableOptions{
+					Value:        &varValue,
+					Protected:    &protected,
+					Masked:       &masked,
+					VariableType: &variableType,
+				}
+
+				variable, resp, err := client.InstanceVariables.UpdateVariable(key, updateOpts)
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/admin/ci/variables/" + key
+					return errors.NewAPIError("PUT", url, statusCode, "Failed to update instance variable", err)
+				}
+
+				_, _ = fmt.Fprintf(f.IOStreams.Out, "Updated instance variable %q\n", variable.Key)
+				return nil
+			}
+
 			if group != "" {
 				// Update group-level variable
 				updateOpts := &gitlab.UpdateGroupVariableOptions{
@@ -428,25 +599,33 @@ func newVariableUpdateCmd(f *cmdutil.Factory) *cobra.Command {
 		},
 	}
 
-	cmd.Flags().StringVarP(&value, "value", "v", "", "Variable value")
+	cmd.Flags().StringVar(&value, "value", "", "Variable value")
 	cmd.Flags().BoolVar(&masked, "masked", false, "Mask variable value in logs")
 	cmd.Flags().BoolVar(&protected, "protected", false, "Protect variable (only available in protected branches/tags)")
 	cmd.Flags().StringVar(&scope, "scope", "*", "Environment scope (default: *)")
 	cmd.Flags().StringVarP(&filePath, "file", "f", "", "Read variable value from file")
 	cmd.Flags().StringVarP(&group, "group", "g", "", "Update group-level variable (specify group path)")
+	cmd.Flags().BoolVar(&instance, "instance", false, "Update instance-level variable (administrators only)")
 	cmd.Flags().StringVar(&varType, "type", "env_var", "Variable type: env_var or file")
+	cmd.Flags().BoolVar(&stdin, "stdin", false, "Read variable value from standard input")
+	cmd.MarkFlagsMutuallyExclusive("value", "file", "stdin")
+	cmd.MarkFlagsMutuallyExclusive("group", "instance")
 
 	return cmd
 }
 
 func newVariableDeleteCmd(f *cmdutil.Factory) *cobra.Command {
-	var group string
+	var (
+		group    string
+		instance bool
+	)
 
 	cmd := &cobra.Command{
 		Use:   "delete <key>",
 		Short: "Delete a CI/CD variable",
 		Example: `  $ glab variable delete MY_VAR
-  $ glab variable delete MY_VAR --group mygroup`,
+  $ glab variable delete MY_VAR --group mygroup
+  $ glab variable delete MY_VAR --instance`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := f.Client()
@@ -456,6 +635,22 @@ func newVariableDeleteCmd(f *cmdutil.Factory) *cobra.Command {
 
 			key := args[0]
 
+			if instance {
+				// Delete instance-level variable
+				resp, err := client.InstanceVariables.RemoveVariable(key)
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/admin/ci/variables/" + key
+					return errors.NewAPIError("DELETE", url, statusCode, "Failed to delete instance variable", err)
+				}
+
+				_, _ = fmt.Fprintf(f.IOStreams.Out, "Deleted instance variable %q\n", key)
+				return nil
+			}
+
 			if group != "" {
 				// Delete group-level variable
 				resp, err := client.GroupVariables.RemoveVariable(group, key, nil)
@@ -494,6 +689,8 @@ func newVariableDeleteCmd(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&group, "group", "g", "", "Delete group-level variable (specify group path)")
+	cmd.Flags().BoolVar(&instance, "instance", false, "Delete instance-level variable (administrators only)")
+	cmd.MarkFlagsMutuallyExclusive("group", "instance")
 
 	return cmd
 }
@@ -501,26 +698,66 @@ func newVariableDeleteCmd(f *cmdutil.Factory) *cobra.Command {
 func newVariableExportCmd(f *cmdutil.Factory) *cobra.Command {
 	var (
 		group    string
+		instance bool
 		output   string
 		format   string
 		jsonFlag bool
+		reveal   bool
 	)
 
 	cmd := &cobra.Command{
 		Use:   "export",
 		Short: "Export CI/CD variables",
+		Long: `Export CI/CD variables as JSON, YAML, or a .env file that shells can source.
+
+The values of masked and protected variables are shown as [REDACTED] unless
+--reveal is passed. In env format they are written as comments instead, so
+sourcing the output never sets a placeholder value.
+
+Variables defined for several environment scopes appear once per scope.`,
 		Example: `  $ glab variable export
   $ glab variable export --group mygroup
-  $ glab variable export --output variables.json
-  $ glab variable export --group mygroup --output group-vars.json
-  $ glab variable export --format json`,
+  $ glab variable export --reveal --output variables.json
+  $ glab variable export --group mygroup --reveal --output group-vars.json
+  $ glab variable export --instance --reveal --output instance-vars.json
+  $ eval "$(glab variable export --format env --reveal)"
+  $ glab variable export --format yaml`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := f.Client()
 			if err != nil {
 				return err
 			}
 
-			if group != "" {
+			var (
+				data     interface{}
+				entries  []dotenv.Entry
+				redacted int
+			)
+
+			switch {
+			case instance:
+				// Export instance-level variables
+				instanceVariables, resp, err := client.InstanceVariables.ListVariables(nil)
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
+					}
+					url := api.APIURL(client.Host()) + "/admin/ci/variables"
+					return errors.NewAPIError("GET", url, statusCode, "Failed to list instance variables", err)
+				}
+
+				for i, v := range instanceVariables {
+					if !reveal && isSensitiveVariable(v.Masked, v.Protected) {
+						c := *v
+						c.Value = redactedValue
+						instanceVariables[i] = &c
+						redacted++
+					}
+					entries = append(entries, dotenv.Entry{Key: v.Key, Value: instanceVariables[i].Value})
+				}
+				data = instanceVariables
+			case group != "":
 				// Export group-level variables
 				groupVariables, resp, err := client.GroupVariables.ListVariables(group, nil)
 				if err != nil {
@@ -532,200 +769,111 @@ func newVariableExportCmd(f *cmdutil.Factory) *cobra.Command {
 					return errors.NewAPIError("GET", url, statusCode, "Failed to list group variables", err)
 				}
 
-				// Write to file or stdout
-				if output != "" {
-					// Always use JSON for file output (for import compatibility)
-					data, err := json.MarshalIndent(groupVariables, "", "  ")
-					if err != nil {
-						return fmt.Errorf("marshaling variables: %w", err)
+				for i, v := range groupVariables {
+					if !reveal && isSensitiveVariable(v.Masked, v.Protected) {
+						c := *v
+						c.Value = redactedValue
+						groupVariables[i] = &c
+						redacted++
 					}
-					err = os.WriteFile(output, data, 0600)
-					if err != nil {
-						return fmt.Errorf("writing to file: %w", err)
+					entries = append(entries, dotenv.Entry{Key: v.Key, Value: groupVariables[i].Value})
+				}
+				data = groupVariables
+			default:
+				// Export project-level variables
+				project, err := f.FullProjectPath()
+				if err != nil {
+					return err
+				}
+
+				variables, resp, err := client.ProjectVariables.ListVariables(project, nil)
+				if err != nil {
+					statusCode := 0
+					if resp != nil {
+						statusCode = resp.StatusCode
 					}
-					_, _ = fmt.Fprintf(f.IOStreams.Out, "Exported variables to %s\n", output)
-				} else {
-					return f.FormatAndPrint(groupVariables, format, jsonFlag)
+					url := api.APIURL(client.Host()) + "/projects/" + project + "/variables"
+					return errors.NewAPIError("GET", url, statusCode, "Failed to list project variables", err)
 				}
 
-				return nil
+				for i, v := range variables {
+					if !reveal && isSensitiveVariable(v.Masked, v.Protected) {
+						c := *v
+						c.Value = redactedValue
+						variables[i] = &c
+						redacted++
+					}
+					entries = append(entries, dotenv.Entry{Key: v.Key, Value: variables[i].Value})
+				}
+				data = variables
 			}
 
-			// Export project-level variables
-			project, err := f.FullProjectPath()
-			if err != nil {
-				return err
-			}
+			printRedactedNote(f, redacted)
 
-			variables, resp, err := client.ProjectVariables.ListVariables(project, nil)
-			if err != nil {
-				statusCode := 0
-				if resp != nil {
-					statusCode = resp.StatusCode
+			envFormat := format == "env" && !jsonFlag && !f.ExportRequested()
+			if output == "" {
+				if envFormat {
+					writeEnvVariables(f.IOStreams.Out, entries)
+					return nil
 				}
-				url := api.APIURL(client.Host()) + "/projects/" + project + "/variables"
-				return errors.NewAPIError("GET", url, statusCode, "Failed to list project variables", err)
+				return f.FormatAndPrint(data, format, jsonFlag)
 			}
 
-			// Write to file or stdout
-			if output != "" {
-				// Always use JSON for file output (for import compatibility)
-				data, err := json.MarshalIndent(variables, "", "  ")
+			// Write JSON to the file for import compatibility, unless env
+			// format was asked for.
+			var buf bytes.Buffer
+			if envFormat {
+				writeEnvVariables(&buf, entries)
+			} else {
+				b, err := json.MarshalIndent(data, "", "  ")
 				if err != nil {
 					return fmt.Errorf("marshaling variables: %w", err)
 				}
-				err = os.WriteFile(output, data, 0600)
-				if err != nil {
-					return fmt.Errorf("writing to file: %w", err)
-				}
-				_, _ = fmt.Fprintf(f.IOStreams.Out, "Exported variables to %s\n", output)
-			} else {
-				return f.FormatAndPrint(variables, format, jsonFlag)
+				buf.Write(b)
 			}
-
+			if err := os.WriteFile(output, buf.Bytes(), 0600); err != nil {
+				return fmt.Errorf("writing to file: %w", err)
+			}
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Exported variables to %s\n", output)
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVarP(&group, "group", "g", "", "Export group-level variables (specify group path)")
-	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file path (always JSON format for import compatibility)")
-	cmd.Flags().StringVarP(&format, "format", "F", "json", "Output format for stdout: json, table, or plain (ignored when --output is used)")
+	cmd.Flags().BoolVar(&instance, "instance", false, "Export instance-level variables (administrators only)")
+	cmd.MarkFlagsMutuallyExclusive("group", "instance")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file path (JSON for import compatibility, or .env with --format env)")
+	cmd.Flags().StringVarP(&format, "format", "F", "json", "Output format: json, env, table, plain, yaml, or csv (only json and env apply to --output)")
 	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+	cmd.Flags().BoolVar(&reveal, "reveal", false, "Show the values of masked and protected variables")
+	f.AddExportFlags(cmd)
 
 	return cmd
 }
 
-func newVariableImportCmd(f *cmdutil.Factory) *cobra.Command {
-	var (
-		group string
-		file  string
-	)
-
-	cmd := &cobra.Command{
-		Use:   "import",
-		Short: "Import CI/CD variables from JSON",
-		Example: `  $ glab variable import --file variables.json
-  $ glab variable import --file group-vars.json --group mygroup`,
-		RunE: func(cmd *cobra.Command, args []string) error {
-			client, err := f.Client()
-			if err != nil {
-				return err
-			}
-
-			if file == "" {
-				return fmt.Errorf("--file flag is required")
-			}
+// redactedValue replaces the values of masked and protected variables in
+// output unless --reveal is passed.
+const redactedValue = "[REDACTED]"
 
-			// Read the JSON file
-			data, err := os.ReadFile(file)
-			if err != nil {
-				return fmt.Errorf("reading file: %w", err)
-			}
-
-			if group != "" {
-				// Import group-level variables
-				var variables []*gitlab.GroupVariable
-				err = json.Unmarshal(data, &variables)
-				if err != nil {
-					return fmt.Errorf("parsing JSON: %w", err)
-				}
-
-				imported := 0
-				for _, v := range variables {
-					// Try to update first, if it fails, create it
-					updateOpts := &gitlab.UpdateGroupVariableOptions{
-						Value:            &v.Value,
-						Protected:        &v.Protected,
-						Masked:           &v.Masked,
-						EnvironmentScope: &v.EnvironmentScope,
-						VariableType:     &v.VariableType,
-					}
-
-					_, _, err := client.GroupVariables.UpdateVariable(group, v.Key, updateOpts)
-					if err != nil {
-						// Variable doesn't exist, create it
-						createOpts := &gitlab.CreateGroupVariableOptions{
-							Key:              &v.Key,
-							Value:            &v.Value,
-							Protected:        &v.Protected,
-							Masked:           &v.Masked,
-							EnvironmentScope: &v.EnvironmentScope,
-							VariableType:     &v.VariableType,
-						}
-
-						_, _, err = client.GroupVariables.CreateVariable(group, createOpts)
-						if err != nil {
-							_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Warning: failed to import variable %q: %v\n", v.Key, err)
-							continue
-						}
-					}
-					imported++
-				}
-
-				failed := len(variables) - imported
-				_, _ = fmt.Fprintf(f.IOStreams.Out, "Imported %d of %d group variable(s)\n", imported, len(variables))
-				if failed > 0 {
-					return fmt.Errorf("failed to import %d of %d group variable(s)", failed, len(variables))
-				}
-				return nil
-			}
-
-			// Import project-level variables
-			project, err := f.FullProjectPath()
-			if err != nil {
-				return err
-			}
-
-			var variables []*gitlab.ProjectVariable
-			err = json.Unmarshal(data, &variables)
-			if err != nil {
-				return fmt.Errorf("parsing JSON: %w", err)
-			}
-
-			imported := 0
-			for _, v := range variables {
-				// Try to update first, if it fails, create it
-				updateOpts := &gitlab.UpdateProjectVariableOptions{
-					Value:            &v.Value,
-					Protected:        &v.Protected,
-					Masked:           &v.Masked,
-					EnvironmentScope: &v.EnvironmentScope,
-					VariableType:     &v.VariableType,
-				}
-
-				_, _, err := client.ProjectVariables.UpdateVariable(project, v.Key, updateOpts)
-				if err != nil {
-					// Variable doesn't exist, create it
-					createOpts := &gitlab.CreateProjectVariableOptions{
-						Key:              &v.Key,
-						Value:            &v.Value,
-						Protected:        &v.Protected,
-						Masked:           &v.Masked,
-						EnvironmentScope: &v.EnvironmentScope,
-						VariableType:     &v.VariableType,
-					}
-
-					_, _, err = client.ProjectVariables.CreateVariable(project, createOpts)
-					if err != nil {
-						_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Warning: failed to import variable %q: %v\n", v.Key, err)
-						continue
-					}
-				}
-				imported++
-			}
+func isSensitiveVariable(masked, protected bool) bool {
+	return masked || protected
+}
 
-			failed := len(variables) - imported
-			_, _ = fmt.Fprintf(f.IOStreams.Out, "Imported %d of %d variable(s)\n", imported, len(variables))
-			if failed > 0 {
-				return fmt.Errorf("failed to import %d of %d variable(s)", failed, len(variables))
-			}
-			return nil
-		},
+func printRedactedNote(f *cmdutil.Factory, n int) {
+	if n == 0 {
+		return
 	}
+	_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Note: %d masked or protected value(s) hidden; use --reveal to show them\n", n)
+}
 
-	cmd.Flags().StringVarP(&group, "group", "g", "", "Import group-level variables (specify group path)")
-	cmd.Flags().StringVarP(&file, "file", "f", "", "Input JSON file path (required)")
-	_ = cmd.MarkFlagRequired("file")
-
-	return cmd
+// writeEnvVariables writes entries as sourceable export lines. Redacted
+// values are written as comments.
+func writeEnvVariables(w io.Writer, entries []dotenv.Entry) {
+	for _, e := range entries {
+		if e.Value == redactedValue {
+			_, _ = fmt.Fprintf(w, "# %s is masked or protected; use --reveal to export it\n", e.Key)
+			continue
+		}
+		_, _ = fmt.Fprintln(w, dotenv.Format(e.Key, e.Value))
+	}
 }
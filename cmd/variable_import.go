@@ -0,0 +1,549 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/dotenv"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ciVariable is a CI/CD variable of a project, a group, or the instance. Its JSON
+// form matches the API, so "variable export --output" files decode into it.
+type ciVariable struct {
+	Key              string                   `json:"key"`
+	Value            string                   `json:"value"`
+	VariableType     gitlab.VariableTypeValue `json:"variable_type"`
+	Protected        bool                     `json:"protected"`
+	Masked           bool                     `json:"masked"`
+	EnvironmentScope string                   `json:"environment_scope"`
+	Hidden           bool                     `json:"hidden,omitempty"`
+}
+
+// variableChange is the planned action for one imported variable.
+type variableChange struct {
+	Variable *ciVariable
+	Action   string   // "create", "update", "delete", or "unchanged"
+	Changed  []string // attributes that differ, for updates
+}
+
+// variableTarget is where CI/CD variables live: a project, a group, or the
+// whole instance.
+type variableTarget struct {
+	project  string
+	group    string
+	instance bool
+}
+
+// resolveVariableTarget returns the instance or group target when asked for,
+// and the current project otherwise.
+func resolveVariableTarget(f *cmdutil.Factory, group string, instance bool) (variableTarget, error) {
+	if instance || group != "" {
+		return variableTarget{group: group, instance: instance}, nil
+	}
+	project, err := f.FullProjectPath()
+	if err != nil {
+		return variableTarget{}, err
+	}
+	return variableTarget{project: project}, nil
+}
+
+// String returns the project or group path, or "instance".
+func (t variableTarget) String() string {
+	switch {
+	case t.instance:
+		return "instance"
+	case t.group != "":
+		return t.group
+	default:
+		return t.project
+	}
+}
+
+// kind describes the target's variables in messages.
+func (t variableTarget) kind() string {
+	switch {
+	case t.instance:
+		return "instance variable(s)"
+	case t.group != "":
+		return "group variable(s)"
+	default:
+		return "variable(s)"
+	}
+}
+
+// path returns the API URL of the target's variables.
+func (t variableTarget) path(client *api.Client) string {
+	switch {
+	case t.instance:
+		return api.APIURL(client.Host()) + "/admin/ci/variables"
+	case t.group != "":
+		return api.APIURL(client.Host()) + "/groups/" + t.group + "/variables"
+	default:
+		return api.APIURL(client.Host()) + "/projects/" + t.project + "/variables"
+	}
+}
+
+// checkScopes rejects variables limited to an environment scope when the
+// target is the instance, whose variables apply to every environment.
+func (t variableTarget) checkScopes(variables []*ciVariable) error {
+	if !t.instance {
+		return nil
+	}
+	for _, v := range variables {
+		if v.EnvironmentScope != "*" {
+			return fmt.Errorf("instance variables cannot have an environment scope: %q has %q", v.Key, v.EnvironmentScope)
+		}
+	}
+	return nil
+}
+
+// listCIVariables returns all variables of target. Instance variables are
+// given the "*" environment scope.
+func listCIVariables(client *api.Client, target variableTarget) ([]*ciVariable, error) {
+	var all []*ciVariable
+	listOpts := gitlab.ListOptions{PerPage: 100}
+	for {
+		var (
+			resp *gitlab.Response
+			err  error
+		)
+		switch {
+		case target.instance:
+			var vars []*gitlab.InstanceVariable
+			vars, resp, err = client.InstanceVariables.ListVariables(&gitlab.ListInstanceVariablesOptions{ListOptions: listOpts})
+			for _, v := range vars {
+				all = append(all, &ciVariable{Key: v.Key, Value: v.Value, VariableType: v.VariableType, Protected: v.Protected, Masked: v.Masked, EnvironmentScope: "*"})
+			}
+		case target.group != "":
+			var vars []*gitlab.GroupVariable
+			vars, resp, err = client.GroupVariables.ListVariables(target.group, &gitlab.ListGroupVariablesOptions{ListOptions: listOpts})
+			for _, v := range vars {
+				all = append(all, &ciVariable{Key: v.Key, Value: v.Value, VariableType: v.VariableType, Protected: v.Protected, Masked: v.Masked, EnvironmentScope: v.EnvironmentScope, Hidden: v.Hidden})
+			}
+		default:
+			var vars []*gitlab.ProjectVariable
+			vars, resp, err = client.ProjectVariables.ListVariables(target.project, &gitlab.ListProjectVariablesOptions{ListOptions: listOpts})
+			for _, v := range vars {
+				all = append(all, &ciVariable{Key: v.Key, Value: v.Value, VariableType: v.VariableType, Protected: v.Protected, Masked: v.Masked, EnvironmentScope: v.EnvironmentScope, Hidden: v.Hidden})
+			}
+		}
+		if err != nil {
+			statusCode := 0
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			return nil, errors.NewAPIError("GET", target.path(client), statusCode, "Failed to list variables", err)
+		}
+		if resp == nil || resp.NextPage == 0 {
+			return all, nil
+		}
+		listOpts.Page = resp.NextPage
+	}
+}
+
+// createCIVariable creates v in target.
+func createCIVariable(client *api.Client, target variableTarget, v *ciVariable) error {
+	var (
+		resp *gitlab.Response
+		err  error
+	)
+	switch {
+	case target.instance:
+		_, resp, err = client.InstanceVariables.CreateVariable(&gitlab.CreateInstanceVariableOptions{
+			Key:          &v.Key,
+			Value:        &v.Value,
+			Protected:    &v.Protected,
+			Masked:       &v.Masked,
+			VariableType: &v.VariableType,
+		})
+	case target.group != "":
+		_, resp, err = client.GroupVariables.CreateVariable(target.group, &gitlab.CreateGroupVariableOptions{
+			Key:              &v.Key,
+			Value:            &v.Value,
+			Protected:        &v.Protected,
+			Masked:           &v.Masked,
+			EnvironmentScope: &v.EnvironmentScope,
+			VariableType:     &v.VariableType,
+		})
+	default:
+		_, resp, err = client.ProjectVariables.CreateVariable(target.project, &gitlab.CreateProjectVariableOptions{
+			Key:              &v.Key,
+			Value:            &v.Value,
+			Protected:        &v.Protected,
+			Masked:           &v.Masked,
+			EnvironmentScope: &v.EnvironmentScope,
+			VariableType:     &v.VariableType,
+		})
+	}
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		return errors.NewAPIError("POST", target.path(client), statusCode, fmt.Sprintf("Failed to create variable %q", v.Key), err)
+	}
+	return nil
+}
+
+// updateCIVariable updates the variable with v's key and environment scope.
+func updateCIVariable(client *api.Client, target variableTarget, v *ciVariable) error {
+	filter := &gitlab.VariableFilter{EnvironmentScope: v.EnvironmentScope}
+	var (
+		resp *gitlab.Response
+		err  error
+	)
+	switch {
+	case target.instance:
+		_, resp, err = client.InstanceVariables.UpdateVariable(v.Key, &gitlab.UpdateInstanceVariableOptions{
+			Value:        &v.Value,
+			Protected:    &v.Protected,
+			Masked:       &v.Masked,
+			VariableType: &v.VariableType,
+		})
+	case target.group != "":
+		_, resp, err = client.GroupVariables.UpdateVariable(target.group, v.Key, &gitlab.UpdateGroupVariableOptions{
+			Value:            &v.Value,
+			Protected:        &v.Protected,
+			Masked:           &v.Masked,
+			EnvironmentScope: &v.EnvironmentScope,
+			VariableType:     &v.VariableType,
+			Filter:           filter,
+		})
+	default:
+		_, resp, err = client.ProjectVariables.UpdateVariable(target.project, v.Key, &gitlab.UpdateProjectVariableOptions{
+			Value:            &v.Value,
+			Protected:        &v.Protected,
+			Masked:           &v.Masked,
+			EnvironmentScope: &v.EnvironmentScope,
+			VariableType:     &v.VariableType,
+			Filter:           filter,
+		})
+	}
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		return errors.NewAPIError("PUT", target.path(client)+"/"+v.Key, statusCode, fmt.Sprintf("Failed to update variable %q", v.Key), err)
+	}
+	return nil
+}
+
+// deleteCIVariable deletes the variable with v's key and environment scope.
+func deleteCIVariable(client *api.Client, target variableTarget, v *ciVariable) error {
+	filter := &gitlab.VariableFilter{EnvironmentScope: v.EnvironmentScope}
+	var (
+		resp *gitlab.Response
+		err  error
+	)
+	switch {
+	case target.instance:
+		resp, err = client.InstanceVariables.RemoveVariable(v.Key)
+	case target.group != "":
+		resp, err = client.GroupVariables.RemoveVariable(target.group, v.Key, &gitlab.RemoveGroupVariableOptions{Filter: filter})
+	default:
+		resp, err = client.ProjectVariables.RemoveVariable(target.project, v.Key, &gitlab.RemoveProjectVariableOptions{Filter: filter})
+	}
+	if err != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		return errors.NewAPIError("DELETE", target.path(client)+"/"+v.Key, statusCode, fmt.Sprintf("Failed to delete variable %q", v.Key), err)
+	}
+	return nil
+}
+
+// planVariableChanges compares incoming variables with the existing ones,
+// matching them by key and environment scope. With prune, existing variables
+// missing from incoming are deleted, except those matching keep; a non-empty
+// pruneScope limits that to variables in one environment scope.
+func planVariableChanges(existing, incoming, keep []*ciVariable, prune bool, pruneScope string) []variableChange {
+	byKey := make(map[string]*ciVariable, len(existing))
+	for _, v := range existing {
+		byKey[v.Key+"\x00"+v.EnvironmentScope] = v
+	}
+
+	changes := make([]variableChange, 0, len(incoming))
+	for _, v := range incoming {
+		old, ok := byKey[v.Key+"\x00"+v.EnvironmentScope]
+		if !ok {
+			changes = append(changes, variableChange{Variable: v, Action: "create"})
+			continue
+		}
+		var changed []string
+		if old.Value != v.Value {
+			changed = append(changed, "value")
+		}
+		if old.VariableType != v.VariableType {
+			changed = append(changed, "type")
+		}
+		if old.Protected != v.Protected {
+			changed = append(changed, "protected")
+		}
+		if old.Masked != v.Masked {
+			changed = append(changed, "masked")
+		}
+		action := "update"
+		if len(changed) == 0 {
+			action = "unchanged"
+		}
+		changes = append(changes, variableChange{Variable: v, Action: action, Changed: changed})
+	}
+
+	if prune {
+		wanted := make(map[string]bool, len(incoming)+len(keep))
+		for _, v := range incoming {
+			wanted[v.Key+"\x00"+v.EnvironmentScope] = true
+		}
+		for _, v := range keep {
+			wanted[v.Key+"\x00"+v.EnvironmentScope] = true
+		}
+		for _, v := range existing {
+			if wanted[v.Key+"\x00"+v.EnvironmentScope] || (pruneScope != "" && v.EnvironmentScope != pruneScope) {
+				continue
+			}
+			changes = append(changes, variableChange{Variable: v, Action: "delete"})
+		}
+	}
+	return changes
+}
+
+// changeCounts tallies the outcome of applying planned changes.
+type changeCounts struct {
+	Created, Updated, Deleted, Unchanged, Failed int
+}
+
+// String summarizes the counts, e.g. "1 created, 2 updated, 0 unchanged".
+// Deletions are only mentioned when there were any.
+func (c changeCounts) String() string {
+	parts := []string{fmt.Sprintf("%d created", c.Created), fmt.Sprintf("%d updated", c.Updated)}
+	if c.Deleted > 0 {
+		parts = append(parts, fmt.Sprintf("%d deleted", c.Deleted))
+	}
+	parts = append(parts, fmt.Sprintf("%d unchanged", c.Unchanged))
+	return strings.Join(parts, ", ")
+}
+
+// printVariablePlan prints the planned changes without values, followed by
+// a summary line.
+func printVariablePlan(out io.Writer, changes []variableChange) {
+	var c changeCounts
+	for _, ch := range changes {
+		v := ch.Variable
+		switch ch.Action {
+		case "create":
+			c.Created++
+			_, _ = fmt.Fprintf(out, "+ %s (%s)\n", v.Key, v.EnvironmentScope)
+		case "update":
+			c.Updated++
+			_, _ = fmt.Fprintf(out, "~ %s (%s): %s\n", v.Key, v.EnvironmentScope, strings.Join(ch.Changed, ", "))
+		case "delete":
+			c.Deleted++
+			_, _ = fmt.Fprintf(out, "- %s (%s)\n", v.Key, v.EnvironmentScope)
+		default:
+			c.Unchanged++
+			_, _ = fmt.Fprintf(out, "  %s (%s): unchanged\n", v.Key, v.EnvironmentScope)
+		}
+	}
+	if c.Deleted > 0 {
+		_, _ = fmt.Fprintf(out, "Would create %d, update %d, delete %d, and leave %d unchanged\n", c.Created, c.Updated, c.Deleted, c.Unchanged)
+	} else {
+		_, _ = fmt.Fprintf(out, "Would create %d, update %d, and leave %d unchanged\n", c.Created, c.Updated, c.Unchanged)
+	}
+}
+
+// applyVariableChanges applies planned changes to target. Failures are
+// reported as warnings and counted.
+func applyVariableChanges(f *cmdutil.Factory, client *api.Client, target variableTarget, changes []variableChange) changeCounts {
+	var c changeCounts
+	for _, ch := range changes {
+		var err error
+		switch ch.Action {
+		case "create":
+			if err = createCIVariable(client, target, ch.Variable); err == nil {
+				c.Created++
+			}
+		case "update":
+			if err = updateCIVariable(client, target, ch.Variable); err == nil {
+				c.Updated++
+			}
+		case "delete":
+			if err = deleteCIVariable(client, target, ch.Variable); err == nil {
+				c.Deleted++
+			}
+		default:
+			c.Unchanged++
+		}
+		if err != nil {
+			c.Failed++
+			_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Warning: failed to %s variable %q: %v\n", ch.Action, ch.Variable.Key, err)
+		}
+	}
+	return c
+}
+
+// readVariablesSource reads variables from a JSON file or, when envFile is
+// set, a .env file whose variables get scope, protected, and masked.
+func readVariablesSource(cmd *cobra.Command, file, envFile, scope string, protected, masked bool) ([]*ciVariable, error) {
+	if envFile != "" {
+		return readEnvFileVariables(envFile, scope, protected, masked)
+	}
+	for _, name := range []string{"scope", "masked", "protected"} {
+		if cmd.Flags().Changed(name) {
+			return nil, fmt.Errorf("--%s can only be used with --env-file", name)
+		}
+	}
+	return readJSONVariables(file)
+}
+
+// readEnvFileVariables reads variables from a .env file. When a key is
+// assigned more than once, the last assignment wins.
+func readEnvFileVariables(path string, scope string, protected, masked bool) ([]*ciVariable, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	entries, err := dotenv.Parse(file)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	values := make(map[string]string, len(entries))
+	for _, e := range entries {
+		values[e.Key] = e.Value
+	}
+	keys := dotenv.Keys(entries)
+	variables := make([]*ciVariable, len(keys))
+	for i, key := range keys {
+		variables[i] = &ciVariable{
+			Key:              key,
+			Value:            values[key],
+			VariableType:     gitlab.EnvVariableType,
+			Protected:        protected,
+			Masked:           masked,
+			EnvironmentScope: scope,
+		}
+	}
+	return variables, nil
+}
+
+// readJSONVariables reads variables from a file written by
+// "variable export --output".
+func readJSONVariables(path string) ([]*ciVariable, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading file: %w", err)
+	}
+	var variables []*ciVariable
+	if err := json.Unmarshal(data, &variables); err != nil {
+		return nil, fmt.Errorf("parsing JSON: %w", err)
+	}
+	for _, v := range variables {
+		if v.Value == redactedValue {
+			return nil, fmt.Errorf("variable %q has a redacted value: export it again with --reveal", v.Key)
+		}
+		if v.EnvironmentScope == "" {
+			v.EnvironmentScope = "*"
+		}
+		if v.VariableType == "" {
+			v.VariableType = gitlab.EnvVariableType
+		}
+	}
+	return variables, nil
+}
+
+func newVariableImportCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		group     string
+		instance  bool
+		file      string
+		envFile   string
+		scope     string
+		masked    bool
+		protected bool
+		dryRun    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import CI/CD variables from JSON or a .env file",
+		Long: `Import CI/CD variables from a JSON file written by "glab variable export
+--output", or from a .env file of KEY=VALUE lines.
+
+Variables are matched to existing ones by key and environment scope: missing
+variables are created, differing ones are updated, and identical ones are
+left alone. Use --dry-run to see what would change without changing it.
+
+Variables from a .env file get the --scope, --masked, and --protected
+settings; JSON files carry their own.`,
+		Example: `  $ glab variable import --file variables.json
+  $ glab variable import --file group-vars.json --group mygroup
+  $ glab variable import --env-file .env --instance
+  $ glab variable import --env-file .env --dry-run
+  $ glab variable import --env-file .env.production --scope production --masked --protected`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			incoming, err := readVariablesSource(cmd, file, envFile, scope, protected, masked)
+			if err != nil {
+				return err
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			target, err := resolveVariableTarget(f, group, instance)
+			if err != nil {
+				return err
+			}
+			if err := target.checkScopes(incoming); err != nil {
+				return err
+			}
+
+			existing, err := listCIVariables(client, target)
+			if err != nil {
+				return err
+			}
+			changes := planVariableChanges(existing, incoming, nil, false, "")
+
+			if dryRun {
+				printVariablePlan(f.IOStreams.Out, changes)
+				return nil
+			}
+
+			counts := applyVariableChanges(f, client, target, changes)
+			imported := len(changes) - counts.Failed
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Imported %d of %d %s (%s)\n", imported, len(changes), target.kind(), counts)
+			if counts.Failed > 0 {
+				return fmt.Errorf("failed to import %d of %d %s", counts.Failed, len(changes), target.kind())
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&group, "group", "g", "", "Import group-level variables (specify group path)")
+	cmd.Flags().BoolVar(&instance, "instance", false, "Import instance-level variables (administrators only)")
+	cmd.Flags().StringVarP(&file, "file", "f", "", "Input JSON file path")
+	cmd.Flags().StringVar(&envFile, "env-file", "", "Input .env file path")
+	cmd.Flags().StringVar(&scope, "scope", "*", "Environment scope for variables from --env-file")
+	cmd.Flags().BoolVar(&masked, "masked", false, "Mask variables from --env-file in job logs")
+	cmd.Flags().BoolVar(&protected, "protected", false, "Protect variables from --env-file (only available in protected branches/tags)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show which variables would be created, updated, or left unchanged")
+	cmd.MarkFlagsOneRequired("file", "env-file")
+	cmd.MarkFlagsMutuallyExclusive("file", "env-file")
+	cmd.MarkFlagsMutuallyExclusive("group", "instance")
+
+	return cmd
+}
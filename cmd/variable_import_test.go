@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func writeEnvFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), ".env")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// mockExistingVariables serves the variable list and records writes.
+func mockExistingVariables(t *testing.T) *[]string {
+	t.Helper()
+	var (
+		mu     sync.Mutex
+		writes []string
+	)
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+				{"key": "SAME", "value": "1", "variable_type": "env_var", "environment_scope": "*"},
+				{"key": "CHANGED", "value": "old", "variable_type": "env_var", "environment_scope": "*"},
+			})
+			return
+		}
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		writes = append(writes, r.Method+" "+filepath.Base(r.URL.Path)+" "+body["value"].(string))
+		mu.Unlock()
+		cmdtest.JSONResponse(w, 200, body)
+	})
+	return &writes
+}
+
+func TestVariableImport_EnvFileDryRun(t *testing.T) {
+	writes := mockExistingVariables(t)
+	path := writeEnvFile(t, "SAME=1\nCHANGED=new\nexport NEW=\"hello world\"\n")
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newVariableImportCmd(f.Factory)
+	cmd.SetArgs([]string{"--env-file", path, "--dry-run"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(*writes) != 0 {
+		t.Errorf("dry run should not write, got %v", *writes)
+	}
+
+	out := f.IO.String()
+	for _, want := range []string{
+		"  SAME (*): unchanged",
+		"~ CHANGED (*): value",
+		"+ NEW (*)",
+		"Would create 1, update 1, and leave 1 unchanged",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "hello world") {
+		t.Errorf("dry run should not print values:\n%s", out)
+	}
+}
+
+func TestVariableImport_EnvFile(t *testing.T) {
+	writes := mockExistingVariables(t)
+	path := writeEnvFile(t, "SAME=1\nCHANGED=new\nNEW=hello\n")
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newVariableImportCmd(f.Factory)
+	cmd.SetArgs([]string{"--env-file", path})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := strings.Join(*writes, "; ")
+	if got != "PUT CHANGED new; POST variables hello" {
+		t.Errorf("writes = %s", got)
+	}
+	if !strings.Contains(f.IO.String(), "Imported 3 of 3 variable(s) (1 created, 1 updated, 1 unchanged)") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestVariableImport_EnvFileSettings(t *testing.T) {
+	var body map[string]interface{}
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{})
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		cmdtest.JSONResponse(w, 201, body)
+	})
+	path := writeEnvFile(t, "TOKEN=abcdefgh12345678\n")
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newVariableImportCmd(f.Factory)
+	cmd.SetArgs([]string{"--env-file", path, "--group", "my-group", "--scope", "production", "--masked", "--protected"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body["environment_scope"] != "production" || body["masked"] != true || body["protected"] != true {
+		t.Errorf("unexpected body: %v", body)
+	}
+	if !strings.Contains(f.IO.String(), "Imported 1 of 1 group variable(s)") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestVariableImport_EnvFileFlagsWithJSON(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newVariableImportCmd(f.Factory)
+	cmd.SetArgs([]string{"--file", "testdata/variables.json", "--masked"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "--masked can only be used with --env-file") {
+		t.Errorf("expected flag error, got %v", err)
+	}
+}
+
+func TestVariableSet_Stdin(t *testing.T) {
+	var value interface{}
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		value = body["value"]
+		cmdtest.JSONResponse(w, 200, map[string]interface{}{"key": "TOKEN", "value": body["value"]})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	f.IO.In.WriteString("s3cret\n")
+	cmd := newVariableSetCmd(f.Factory)
+	cmd.SetArgs([]string{"TOKEN", "--stdin"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value != "s3cret" {
+		t.Errorf("value = %q, want %q", value, "s3cret")
+	}
+}
+
+func TestPlanVariableChanges_MatchesScope(t *testing.T) {
+	existing := []*ciVariable{{Key: "A", Value: "1", EnvironmentScope: "staging"}}
+	incoming := []*ciVariable{{Key: "A", Value: "1", EnvironmentScope: "production"}}
+
+	changes := planVariableChanges(existing, incoming, nil, false, "")
+	if len(changes) != 1 || changes[0].Action != "create" {
+		t.Errorf("expected a variable in another scope to be created, got %+v", changes)
+	}
+}
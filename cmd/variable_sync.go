@@ -0,0 +1,248 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// listSourceVariables returns the variables of project to copy elsewhere,
+// limited to one environment scope when scope is set. Hidden variables are
+// skipped with a warning because their values cannot be read back; they are
+// returned separately so that they are not pruned from the target.
+func listSourceVariables(f *cmdutil.Factory, client *api.Client, project, scope string) (variables, hidden []*ciVariable, err error) {
+	all, err := listCIVariables(client, variableTarget{project: project})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, v := range all {
+		if scope != "" && v.EnvironmentScope != scope {
+			continue
+		}
+		if v.Hidden {
+			_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Warning: skipping hidden variable %q: its value cannot be read\n", v.Key)
+			hidden = append(hidden, v)
+			continue
+		}
+		variables = append(variables, v)
+	}
+	return variables, hidden, nil
+}
+
+func newVariableCopyCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		from    string
+		to      string
+		scope   string
+		toScope string
+		dryRun  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "copy",
+		Short: "Copy CI/CD variables between projects or environment scopes",
+		Long: `Copy CI/CD variables from one project to another, or from one environment
+scope to another.
+
+Variables missing from the target are created and differing ones are
+updated; other variables in the target are left alone. Use "glab variable
+sync --from" to also delete variables the source does not have.
+
+Hidden variables are skipped because their values cannot be read.`,
+		Example: `  $ glab variable copy --from group/reference --to group/new-service
+  $ glab variable copy --from group/reference --to group/new-service --scope production
+  $ glab variable copy --scope staging --to-scope production --dry-run`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if toScope != "" && scope == "" {
+				return fmt.Errorf("--to-scope requires --scope")
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			if from == "" {
+				from, err = f.FullProjectPath()
+				if err != nil {
+					return err
+				}
+			}
+			if to == "" {
+				to = from
+			}
+			if to == from && (toScope == "" || toScope == scope) {
+				return fmt.Errorf("source and target are the same: use --to or --to-scope")
+			}
+
+			source, _, err := listSourceVariables(f, client, from, scope)
+			if err != nil {
+				return err
+			}
+			if len(source) == 0 {
+				_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "No variables to copy from %s\n", from)
+				return nil
+			}
+			incoming := make([]*ciVariable, len(source))
+			for i, v := range source {
+				c := *v
+				if toScope != "" {
+					c.EnvironmentScope = toScope
+				}
+				incoming[i] = &c
+			}
+
+			existing, err := listCIVariables(client, variableTarget{project: to})
+			if err != nil {
+				return err
+			}
+			changes := planVariableChanges(existing, incoming, nil, false, "")
+
+			if dryRun {
+				printVariablePlan(f.IOStreams.Out, changes)
+				return nil
+			}
+
+			counts := applyVariableChanges(f, client, variableTarget{project: to}, changes)
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Copied %d of %d variable(s) from %s to %s (%s)\n", len(changes)-counts.Failed, len(changes), from, to, counts)
+			if counts.Failed > 0 {
+				return fmt.Errorf("failed to copy %d of %d variable(s)", counts.Failed, len(changes))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&from, "from", "", "Project to copy variables from (default: current repository)")
+	cmd.Flags().StringVar(&to, "to", "", "Project to copy variables to (default: the source project)")
+	cmd.Flags().StringVar(&scope, "scope", "", "Only copy variables in this environment scope")
+	cmd.Flags().StringVar(&toScope, "to-scope", "", "Environment scope to give the copies (requires --scope)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show which variables would be created, updated, or left unchanged")
+
+	return cmd
+}
+
+func newVariableSyncCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		group     string
+		instance  bool
+		file      string
+		envFile   string
+		from      string
+		scope     string
+		masked    bool
+		protected bool
+		prune     bool
+		dryRun    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Make CI/CD variables match a file or another project",
+		Long: `Make the variables of the current project, of a group with --group, or of
+the instance with --instance match a source: a JSON file written by "glab variable export --output", a .env
+file, or another project.
+
+Missing variables are created and differing ones are updated. With --prune,
+variables the source does not have are deleted, so the target matches the
+source exactly. When --scope is given, or the source is a .env file, only
+variables in that environment scope are pruned.
+
+Always review the changes with --dry-run before pruning.`,
+		Example: `  $ glab variable sync --file vars.json --prune --dry-run
+  $ glab variable sync --file vars.json --prune
+  $ glab variable sync --env-file .env.production --scope production --masked --prune
+  $ glab variable sync --from group/reference --scope production --prune`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if from != "" && (group != "" || instance) {
+				return fmt.Errorf("--from can only sync project variables")
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			var (
+				incoming   []*ciVariable
+				keep       []*ciVariable
+				source     string
+				pruneScope string
+			)
+			switch {
+			case from != "":
+				for _, name := range []string{"masked", "protected"} {
+					if cmd.Flags().Changed(name) {
+						return fmt.Errorf("--%s can only be used with --env-file", name)
+					}
+				}
+				if cmd.Flags().Changed("scope") {
+					pruneScope = scope
+				} else {
+					scope = ""
+				}
+				incoming, keep, err = listSourceVariables(f, client, from, scope)
+				source = from
+			case envFile != "":
+				incoming, err = readEnvFileVariables(envFile, scope, protected, masked)
+				source = envFile
+				pruneScope = scope
+			default:
+				incoming, err = readVariablesSource(cmd, file, "", scope, protected, masked)
+				source = file
+			}
+			if err != nil {
+				return err
+			}
+
+			target, err := resolveVariableTarget(f, group, instance)
+			if err != nil {
+				return err
+			}
+			if from != "" && from == target.project {
+				return fmt.Errorf("source and target are the same project")
+			}
+			if err := target.checkScopes(incoming); err != nil {
+				return err
+			}
+
+			existing, err := listCIVariables(client, target)
+			if err != nil {
+				return err
+			}
+			changes := planVariableChanges(existing, incoming, keep, prune, pruneScope)
+
+			if dryRun {
+				printVariablePlan(f.IOStreams.Out, changes)
+				return nil
+			}
+
+			counts := applyVariableChanges(f, client, target, changes)
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Synced %s from %s (%s)\n", target, source, counts)
+			if counts.Failed > 0 {
+				return fmt.Errorf("failed to sync %d of %d variable(s)", counts.Failed, len(changes))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&group, "group", "g", "", "Sync group-level variables (specify group path)")
+	cmd.Flags().BoolVar(&instance, "instance", false, "Sync instance-level variables (administrators only)")
+	cmd.Flags().StringVarP(&file, "file", "f", "", "Source JSON file path")
+	cmd.Flags().StringVar(&envFile, "env-file", "", "Source .env file path")
+	cmd.Flags().StringVar(&from, "from", "", "Source project")
+	cmd.Flags().StringVar(&scope, "scope", "*", "Environment scope for variables from --env-file, or the only scope to sync with --from")
+	cmd.Flags().BoolVar(&masked, "masked", false, "Mask variables from --env-file in job logs")
+	cmd.Flags().BoolVar(&protected, "protected", false, "Protect variables from --env-file (only available in protected branches/tags)")
+	cmd.Flags().BoolVar(&prune, "prune", false, "Delete variables that are not in the source")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show which variables would be created, updated, deleted, or left unchanged")
+	cmd.MarkFlagsOneRequired("file", "env-file", "from")
+	cmd.MarkFlagsMutuallyExclusive("file", "env-file", "from")
+	cmd.MarkFlagsMutuallyExclusive("group", "instance")
+
+	return cmd
+}
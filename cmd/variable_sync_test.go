@@ -0,0 +1,201 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+// mockVariableProjects serves variable lists per project path and records
+// writes as "METHOD project KEY scope".
+func mockVariableProjects(t *testing.T, projects map[string][]map[string]interface{}) *[]string {
+	t.Helper()
+	var (
+		mu     sync.Mutex
+		writes []string
+	)
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/api/v4/projects/")
+		project, rest, _ := strings.Cut(path, "/variables")
+		if r.Method == "GET" {
+			vars, ok := projects[project]
+			if !ok {
+				cmdtest.ErrorResponse(w, 404, "404 Project Not Found")
+				return
+			}
+			cmdtest.JSONResponse(w, 200, vars)
+			return
+		}
+
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		key := strings.TrimPrefix(rest, "/")
+		if key == "" {
+			key, _ = body["key"].(string)
+		}
+		scope, _ := body["environment_scope"].(string)
+		if scope == "" {
+			scope = r.URL.Query().Get("filter[environment_scope]")
+		}
+		if filter, ok := body["filter"].(map[string]interface{}); ok && scope == "" {
+			scope, _ = filter["environment_scope"].(string)
+		}
+		mu.Lock()
+		writes = append(writes, strings.Join([]string{r.Method, project, key, scope}, " "))
+		mu.Unlock()
+		cmdtest.JSONResponse(w, 200, body)
+	})
+	return &writes
+}
+
+func TestVariableCopy(t *testing.T) {
+	writes := mockVariableProjects(t, map[string][]map[string]interface{}{
+		"group/src": {
+			{"key": "DB_URL", "value": "postgres://prod", "environment_scope": "production"},
+			{"key": "DB_URL", "value": "postgres://staging", "environment_scope": "staging"},
+			{"key": "SECRET", "value": "", "hidden": true, "masked": true, "environment_scope": "production"},
+		},
+		"group/dst": {
+			{"key": "DB_URL", "value": "postgres://old", "environment_scope": "production"},
+		},
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newVariableCopyCmd(f.Factory)
+	cmd.SetArgs([]string{"--from", "group/src", "--to", "group/dst", "--scope", "production"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := strings.Join(*writes, "; "); got != "PUT group/dst DB_URL production" {
+		t.Errorf("writes = %s", got)
+	}
+	if !strings.Contains(f.IO.ErrString(), `skipping hidden variable "SECRET"`) {
+		t.Errorf("expected hidden variable warning, got: %s", f.IO.ErrString())
+	}
+	if !strings.Contains(f.IO.String(), "Copied 1 of 1 variable(s) from group/src to group/dst (0 created, 1 updated, 0 unchanged)") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+}
+
+func TestVariableCopy_BetweenScopes(t *testing.T) {
+	writes := mockVariableProjects(t, map[string][]map[string]interface{}{
+		"test-owner/test-repo": {
+			{"key": "API_URL", "value": "https://staging", "environment_scope": "staging"},
+		},
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newVariableCopyCmd(f.Factory)
+	cmd.SetArgs([]string{"--scope", "staging", "--to-scope", "review/*"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := strings.Join(*writes, "; "); got != "POST test-owner/test-repo API_URL review/*" {
+		t.Errorf("writes = %s", got)
+	}
+}
+
+func TestVariableCopy_SameSource(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newVariableCopyCmd(f.Factory)
+	cmd.SetArgs([]string{"--to", "test-owner/test-repo"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "source and target are the same") {
+		t.Errorf("expected same source error, got %v", err)
+	}
+}
+
+func TestVariableSync_Prune(t *testing.T) {
+	existing := map[string][]map[string]interface{}{
+		"test-owner/test-repo": {
+			{"key": "IMPORT_VAR", "value": "import-value", "variable_type": "env_var", "environment_scope": "*"},
+			{"key": "STALE", "value": "x", "variable_type": "env_var", "environment_scope": "*"},
+			{"key": "OTHER", "value": "y", "variable_type": "env_var", "environment_scope": "staging"},
+		},
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		writes := mockVariableProjects(t, existing)
+		f := cmdtest.NewTestFactory(t)
+		cmd := newVariableSyncCmd(f.Factory)
+		cmd.SetArgs([]string{"--file", "testdata/variables.json", "--prune", "--dry-run"})
+
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(*writes) != 0 {
+			t.Errorf("dry run should not write, got %v", *writes)
+		}
+		out := f.IO.String()
+		for _, want := range []string{"+ IMPORT_VAR2 (production)", "- STALE (*)", "- OTHER (staging)", "Would create 1, update 0, delete 2, and leave 1 unchanged"} {
+			if !strings.Contains(out, want) {
+				t.Errorf("output missing %q:\n%s", want, out)
+			}
+		}
+	})
+
+	t.Run("env file prunes only its scope", func(t *testing.T) {
+		writes := mockVariableProjects(t, existing)
+		path := writeEnvFile(t, "IMPORT_VAR=import-value\n")
+
+		f := cmdtest.NewTestFactory(t)
+		cmd := newVariableSyncCmd(f.Factory)
+		cmd.SetArgs([]string{"--env-file", path, "--prune"})
+
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := strings.Join(*writes, "; "); got != "DELETE test-owner/test-repo STALE *" {
+			t.Errorf("writes = %s", got)
+		}
+		if !strings.Contains(f.IO.String(), "(0 created, 0 updated, 1 deleted, 1 unchanged)") {
+			t.Errorf("unexpected output: %s", f.IO.String())
+		}
+	})
+}
+
+func TestVariableSync_FromKeepsHiddenVariables(t *testing.T) {
+	writes := mockVariableProjects(t, map[string][]map[string]interface{}{
+		"group/src": {
+			{"key": "API_URL", "value": "https://prod", "environment_scope": "production"},
+			{"key": "SECRET", "value": "", "hidden": true, "masked": true, "environment_scope": "production"},
+		},
+		"test-owner/test-repo": {
+			{"key": "API_URL", "value": "https://prod", "environment_scope": "production"},
+			{"key": "SECRET", "value": "", "hidden": true, "masked": true, "environment_scope": "production"},
+			{"key": "STALE", "value": "x", "environment_scope": "production"},
+		},
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newVariableSyncCmd(f.Factory)
+	cmd.SetArgs([]string{"--from", "group/src", "--scope", "production", "--prune"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := strings.Join(*writes, "; "); got != "DELETE test-owner/test-repo STALE production" {
+		t.Errorf("writes = %s", got)
+	}
+	if !strings.Contains(f.IO.ErrString(), `skipping hidden variable "SECRET"`) {
+		t.Errorf("expected hidden variable warning, got: %s", f.IO.ErrString())
+	}
+}
+
+func TestVariableSync_RequiresSource(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newVariableSyncCmd(f.Factory)
+	cmd.SetArgs([]string{"--prune"})
+
+	if err := cmd.Execute(); err == nil {
+		t.Error("expected error without a source")
+	}
+}
@@ -35,6 +35,8 @@ func TestVariableCmd_HasSubcommands(t *testing.T) {
 		"delete",
 		"export",
 		"import",
+		"copy",
+		"sync",
 	}
 
 	subcommands := cmd.Commands()
@@ -551,8 +553,8 @@ func TestVariableImportCmd_Structure(t *testing.T) {
 		t.Errorf("expected Use to be 'import', got %q", cmd.Use)
 	}
 
-	if cmd.Short != "Import CI/CD variables from JSON" {
-		t.Errorf("expected Short to be 'Import CI/CD variables from JSON', got %q", cmd.Short)
+	if cmd.Short != "Import CI/CD variables from JSON or a .env file" {
+		t.Errorf("expected Short to be 'Import CI/CD variables from JSON or a .env file', got %q", cmd.Short)
 	}
 }
 
@@ -1325,6 +1327,10 @@ func TestVariableExport_WithFile(t *testing.T) {
 
 func TestVariableImport_WithFile(t *testing.T) {
 	_ = cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" && strings.HasSuffix(r.URL.Path, "/variables") {
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{})
+			return
+		}
 		if r.Method == "POST" && strings.Contains(r.URL.Path, "/api/v4/projects") && strings.Contains(r.URL.Path, "/variables") {
 			cmdtest.JSONResponse(w, 201, map[string]interface{}{
 				"key":              "IMPORT_VAR",
@@ -1360,6 +1366,13 @@ func TestVariableImport_WithFile(t *testing.T) {
 
 func TestVariableImport_UpdateExisting(t *testing.T) {
 	_ = cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" && strings.HasSuffix(r.URL.Path, "/variables") {
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+				{"key": "IMPORT_VAR", "value": "old-value", "variable_type": "env_var", "environment_scope": "*"},
+				{"key": "IMPORT_VAR2", "value": "import-value2", "variable_type": "env_var", "protected": true, "masked": true, "environment_scope": "production"},
+			})
+			return
+		}
 		// Mock successful UPDATE (variable already exists)
 		if r.Method == "PUT" && strings.Contains(r.URL.Path, "/api/v4/projects") && strings.Contains(r.URL.Path, "/variables/IMPORT_VAR") {
 			cmdtest.JSONResponse(w, 200, map[string]interface{}{
@@ -1413,3 +1426,233 @@ func TestVariableList_GroupEmptyResponse(t *testing.T) {
 		t.Errorf("expected error output to contain 'No variables found', got: %s", errOutput)
 	}
 }
+
+func mockSensitiveVariables(t *testing.T) {
+	t.Helper()
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		secret := map[string]interface{}{"key": "DEPLOY_TOKEN", "value": "s3cret", "masked": true, "environment_scope": "*"}
+		if strings.HasSuffix(r.URL.Path, "/variables/DEPLOY_TOKEN") {
+			cmdtest.JSONResponse(w, 200, secret)
+			return
+		}
+		cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+			{"key": "APP_NAME", "value": "it's mine", "environment_scope": "*"},
+			secret,
+		})
+	})
+}
+
+func TestVariableGet_Redacted(t *testing.T) {
+	mockSensitiveVariables(t)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newVariableGetCmd(f.Factory)
+	cmd.SetArgs([]string{"DEPLOY_TOKEN", "--format", "json"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(f.IO.String(), "s3cret") || !strings.Contains(f.IO.String(), "[REDACTED]") {
+		t.Errorf("expected redacted value, got: %s", f.IO.String())
+	}
+	if !strings.Contains(f.IO.ErrString(), "use --reveal") {
+		t.Errorf("expected reveal note, got: %s", f.IO.ErrString())
+	}
+
+	f = cmdtest.NewTestFactory(t)
+	cmd = newVariableGetCmd(f.Factory)
+	cmd.SetArgs([]string{"DEPLOY_TOKEN", "--format", "json", "--reveal"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(f.IO.String(), "s3cret") {
+		t.Errorf("expected revealed value, got: %s", f.IO.String())
+	}
+}
+
+func TestVariableExport_EnvFormat(t *testing.T) {
+	mockSensitiveVariables(t)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newVariableExportCmd(f.Factory)
+	cmd.SetArgs([]string{"--format", "env"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "export APP_NAME='it'\\''s mine'\n# DEPLOY_TOKEN is masked or protected; use --reveal to export it\n"
+	if got := f.IO.String(); got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+
+	f = cmdtest.NewTestFactory(t)
+	cmd = newVariableExportCmd(f.Factory)
+	cmd.SetArgs([]string{"--format", "env", "--reveal"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(f.IO.String(), "export DEPLOY_TOKEN='s3cret'") {
+		t.Errorf("expected revealed value, got: %s", f.IO.String())
+	}
+}
+
+func TestVariableImport_RedactedExport(t *testing.T) {
+	mockSensitiveVariables(t)
+	output := filepath.Join(t.TempDir(), "vars.json")
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newVariableExportCmd(f.Factory)
+	cmd.SetArgs([]string{"--output", output})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	f = cmdtest.NewTestFactory(t)
+	cmd = newVariableImportCmd(f.Factory)
+	cmd.SetArgs([]string{"--file", output})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), `"DEPLOY_TOKEN" has a redacted value`) {
+		t.Errorf("expected redacted value error, got %v", err)
+	}
+}
+
+func TestVariableList_Instance(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v4/admin/ci/variables" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+			{"key": "GLOBAL_VAR", "value": "shared", "variable_type": "env_var"},
+		})
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newVariableListCmd(f.Factory)
+	cmd.SetArgs([]string{"--instance", "--format", "json"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(f.IO.String(), "GLOBAL_VAR") {
+		t.Errorf("expected output to contain GLOBAL_VAR, got: %s", f.IO.String())
+	}
+}
+
+func TestVariableSet_InstanceScope(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newVariableSetCmd(f.Factory)
+	cmd.SetArgs([]string{"MY_VAR", "--value", "x", "--instance", "--scope", "production"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "instance variables cannot have an environment scope") {
+		t.Errorf("expected scope error, got %v", err)
+	}
+}
+
+func TestVariableImport_Instance(t *testing.T) {
+	var writes []string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/api/v4/admin/ci/variables") {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if r.Method == "GET" {
+			cmdtest.JSONResponse(w, 200, []map[string]interface{}{
+				{"key": "SHARED", "value": "old", "variable_type": "env_var"},
+			})
+			return
+		}
+		writes = append(writes, r.Method+" "+r.URL.Path)
+		cmdtest.JSONResponse(w, 200, map[string]interface{}{})
+	})
+
+	path := filepath.Join(t.TempDir(), ".env")
+	if err := os.WriteFile(path, []byte("SHARED=new\nADDED=1\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newVariableImportCmd(f.Factory)
+	cmd.SetArgs([]string{"--env-file", path, "--instance"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := strings.Join(writes, "; "); got != "PUT /api/v4/admin/ci/variables/SHARED; POST /api/v4/admin/ci/variables" {
+		t.Errorf("writes = %s", got)
+	}
+	if !strings.Contains(f.IO.String(), "Imported 2 of 2 instance variable(s)") {
+		t.Errorf("unexpected output: %s", f.IO.String())
+	}
+
+	f = cmdtest.NewTestFactory(t)
+	cmd = newVariableImportCmd(f.Factory)
+	cmd.SetArgs([]string{"--file", "testdata/variables.json", "--instance"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), `"IMPORT_VAR2" has "production"`) {
+		t.Errorf("expected scope error, got %v", err)
+	}
+}
+
+// runRootWithStdin runs args through the root command with stdin as input,
+// the way the glab binary does.
+func runRootWithStdin(t *testing.T, stdin string, args ...string) error {
+	t.Helper()
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+	t.Setenv("GITLAB_TOKEN", "test-token-12345")
+
+	in, err := os.Create(filepath.Join(t.TempDir(), "stdin"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = in.Close() }()
+	if _, err := in.WriteString(stdin); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := in.Seek(0, 0); err != nil {
+		t.Fatal(err)
+	}
+	orig := os.Stdin
+	os.Stdin = in
+	t.Cleanup(func() { os.Stdin = orig })
+
+	root := NewRootCmd("dev")
+	root.SetArgs(args)
+	return root.Execute()
+}
+
+func TestVariableSet_StdinThroughRoot(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/api/v4/projects/test-owner/test-repo/variables/DEPLOY_TOKEN" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body = decodeRequest(t, r)
+		cmdtest.JSONResponse(w, 200, map[string]any{"key": "DEPLOY_TOKEN"})
+	})
+
+	if err := runRootWithStdin(t, "s3cret\n", "variable", "set", "DEPLOY_TOKEN", "--stdin", "-R", "test-owner/test-repo"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body["value"] != "s3cret" {
+		t.Errorf("unexpected request body: %v", body)
+	}
+}
+
+func TestVariableSetUpdate_InstanceThroughRoot(t *testing.T) {
+	var requests []string
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		cmdtest.JSONResponse(w, 200, map[string]any{"key": "SHARED"})
+	})
+
+	if err := runRootWithStdin(t, "", "variable", "set", "SHARED", "--value", "x", "--instance"); err != nil {
+		t.Fatalf("variable set: %v", err)
+	}
+	if err := runRootWithStdin(t, "", "variable", "update", "SHARED", "--value", "y", "--instance"); err != nil {
+		t.Fatalf("variable update: %v", err)
+	}
+	for _, req := range requests {
+		if !strings.HasPrefix(req, "PUT /api/v4/admin/ci/variables/SHARED") {
+			t.Errorf("unexpected request %s", req)
+		}
+	}
+	if len(requests) != 2 {
+		t.Errorf("expected 2 requests, got %v", requests)
+	}
+}
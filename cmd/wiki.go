@@ -0,0 +1,493 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/browser"
+	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+	"github.com/PhilipKram/gitlab-cli/internal/prompt"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// wikiMarkups lists the markup formats accepted for wiki pages.
+var wikiMarkups = []string{"markdown", "rdoc", "asciidoc", "org"}
+
+// NewWikiCmd creates the wiki command group.
+func NewWikiCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wiki <command>",
+		Short: "Manage wiki pages",
+		Long: `List, view, create, edit, and delete wiki pages.
+
+Commands work on the current project's wiki by default. Use --group to work
+on a group wiki instead (GitLab Premium).`,
+	}
+
+	cmd.AddCommand(newWikiListCmd(f))
+	cmd.AddCommand(newWikiViewCmd(f))
+	cmd.AddCommand(newWikiCreateCmd(f))
+	cmd.AddCommand(newWikiEditCmd(f))
+	cmd.AddCommand(newWikiDeleteCmd(f))
+
+	return cmd
+}
+
+func newWikiListCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		group    string
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List wiki pages",
+		Example: `  $ glab wiki list
+  $ glab wiki list --group my-group
+  $ glab wiki list --format json`,
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			var pages []*gitlab.Wiki
+			var project string
+			var resp *gitlab.Response
+			if group != "" {
+				var groupPages []*gitlab.GroupWiki
+				groupPages, resp, err = client.GroupWikis.ListGroupWikis(group, nil, gitlab.WithContext(cmd.Context()))
+				for _, p := range groupPages {
+					pages = append(pages, (*gitlab.Wiki)(p))
+				}
+			} else {
+				project, err = f.FullProjectPath()
+				if err != nil {
+					return err
+				}
+				pages, resp, err = client.Wikis.ListWikis(project, nil, gitlab.WithContext(cmd.Context()))
+			}
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + wikiAPIPath(group, project, "")
+				return errors.NewAPIError("GET", url, statusCode, "Failed to list wiki pages", err)
+			}
+
+			if len(pages) == 0 {
+				_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "No wiki pages found")
+				return nil
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(pages, format, jsonFlag)
+			}
+
+			tp := f.NewTablePrinter()
+			for _, p := range pages {
+				tp.AddRow(p.Slug, p.Title, string(p.Format))
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().StringVarP(&group, "group", "g", "", "List the pages of a group wiki")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+func newWikiViewCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		group    string
+		raw      bool
+		html     bool
+		version  string
+		web      bool
+		format   string
+		jsonFlag bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "view <slug>",
+		Short: "View a wiki page",
+		Long: `Print a wiki page.
+
+By default a short header with the page's title, markup format, and URL is
+printed before the page source. Use --raw to print only the source, or --html
+to print the page as rendered by GitLab.`,
+		Example: `  $ glab wiki view home
+  $ glab wiki view guides/onboarding --raw > onboarding.md
+  $ glab wiki view home --html
+  $ glab wiki view home --group my-group --web`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			slug := args[0]
+			opts := &gitlab.GetWikiPageOptions{}
+			if html {
+				opts.RenderHTML = gitlab.Ptr(true)
+			}
+			if version != "" {
+				opts.Version = &version
+			}
+
+			var page *gitlab.Wiki
+			var project string
+			var resp *gitlab.Response
+			if group != "" {
+				var groupPage *gitlab.GroupWiki
+				groupPage, resp, err = client.GroupWikis.GetGroupWikiPage(group, slug, (*gitlab.GetGroupWikiPageOptions)(opts), gitlab.WithContext(cmd.Context()))
+				page = (*gitlab.Wiki)(groupPage)
+			} else {
+				project, err = f.FullProjectPath()
+				if err != nil {
+					return err
+				}
+				page, resp, err = client.Wikis.GetWikiPage(project, slug, opts, gitlab.WithContext(cmd.Context()))
+			}
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + wikiAPIPath(group, project, slug)
+				return errors.NewAPIError("GET", url, statusCode, "Failed to get wiki page "+slug, err)
+			}
+
+			webURL := wikiWebURL(client.Host(), group, project, page.Slug)
+			if web {
+				return browser.Open(webURL)
+			}
+
+			if jsonFlag || (format != "" && format != "table") {
+				return f.FormatAndPrint(page, format, jsonFlag)
+			}
+
+			out := f.IOStreams.Out
+			if !raw {
+				_, _ = fmt.Fprintf(out, "Title:   %s\n", page.Title)
+				_, _ = fmt.Fprintf(out, "Slug:    %s\n", page.Slug)
+				_, _ = fmt.Fprintf(out, "Format:  %s\n", page.Format)
+				_, _ = fmt.Fprintf(out, "URL:     %s\n\n", webURL)
+			}
+			_, _ = fmt.Fprint(out, page.Content)
+			if !strings.HasSuffix(page.Content, "\n") {
+				_, _ = fmt.Fprintln(out)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&group, "group", "g", "", "View a page of a group wiki")
+	cmd.Flags().BoolVar(&raw, "raw", false, "Print only the page source")
+	cmd.Flags().BoolVar(&html, "html", false, "Print the page rendered as HTML")
+	cmd.Flags().StringVar(&version, "version", "", "Page version (commit SHA) to view")
+	cmd.Flags().BoolVarP(&web, "web", "w", false, "Open the page in the browser")
+	cmd.Flags().StringVarP(&format, "format", "F", "table", "Output format: json, table, plain, yaml, or csv")
+	cmd.Flags().BoolVar(&jsonFlag, "json", false, "Output as JSON (deprecated: use --format=json)")
+
+	return cmd
+}
+
+func newWikiCreateCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		group   string
+		source  string
+		content string
+		markup  string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create <title>",
+		Short: "Create a wiki page",
+		Long: `Create a wiki page with the given title.
+
+The page content is taken from --content, read from --file, or read from
+standard input when --file is "-". The page slug is derived from the title
+by GitLab; titles containing "/" create nested pages.`,
+		Example: `  $ glab wiki create "Release process" --file docs/release.md
+  $ echo "# Runbook" | glab wiki create "Ops/Runbook" --file -
+  $ glab wiki create Home --content "Welcome!" --group my-group`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			body, err := readWikiContent(f, cmd, source, content)
+			if err != nil {
+				return err
+			}
+			if body == nil {
+				return fmt.Errorf("page content required: use --content or --file")
+			}
+			markupValue, err := parseWikiMarkup(markup)
+			if err != nil {
+				return err
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			title := args[0]
+			opts := &gitlab.CreateWikiPageOptions{
+				Title:   &title,
+				Content: body,
+				Format:  markupValue,
+			}
+
+			var page *gitlab.Wiki
+			var project string
+			var resp *gitlab.Response
+			if group != "" {
+				var groupPage *gitlab.GroupWiki
+				groupPage, resp, err = client.GroupWikis.CreateGroupWikiPage(group, (*gitlab.CreateGroupWikiPageOptions)(opts), gitlab.WithContext(cmd.Context()))
+				page = (*gitlab.Wiki)(groupPage)
+			} else {
+				project, err = f.FullProjectPath()
+				if err != nil {
+					return err
+				}
+				page, resp, err = client.Wikis.CreateWikiPage(project, opts, gitlab.WithContext(cmd.Context()))
+			}
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + wikiAPIPath(group, project, "")
+				return errors.NewAPIError("POST", url, statusCode, "Failed to create wiki page", err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Created wiki page %s\n", page.Slug)
+			_, _ = fmt.Fprintln(f.IOStreams.Out, wikiWebURL(client.Host(), group, project, page.Slug))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&group, "group", "g", "", "Create the page in a group wiki")
+	cmd.Flags().StringVarP(&source, "file", "f", "", `Read the page content from a file, or "-" for standard input`)
+	cmd.Flags().StringVarP(&content, "content", "c", "", "Page content")
+	cmd.Flags().StringVar(&markup, "markup", "markdown", "Markup format: "+strings.Join(wikiMarkups, ", "))
+	cmd.MarkFlagsMutuallyExclusive("file", "content")
+
+	return cmd
+}
+
+func newWikiEditCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		group   string
+		title   string
+		source  string
+		content string
+		markup  string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "edit <slug>",
+		Short: "Edit a wiki page",
+		Long: `Replace the content of a wiki page, or change its title or markup format.
+
+Only the given fields are changed. Changing the title also changes the
+page's slug.`,
+		Example: `  $ glab wiki edit release-process --file docs/release.md
+  $ cat notes.md | glab wiki edit home --file -
+  $ glab wiki edit old-name --title "New name"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			body, err := readWikiContent(f, cmd, source, content)
+			if err != nil {
+				return err
+			}
+			var markupValue *gitlab.WikiFormatValue
+			if cmd.Flags().Changed("markup") {
+				markupValue, err = parseWikiMarkup(markup)
+				if err != nil {
+					return err
+				}
+			}
+			if body == nil && title == "" && markupValue == nil {
+				return fmt.Errorf("nothing to change: use --content, --file, --title, or --markup")
+			}
+
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			slug := args[0]
+			opts := &gitlab.EditWikiPageOptions{
+				Content: body,
+				Format:  markupValue,
+			}
+			if title != "" {
+				opts.Title = &title
+			}
+
+			var page *gitlab.Wiki
+			var project string
+			var resp *gitlab.Response
+			if group != "" {
+				var groupPage *gitlab.GroupWiki
+				groupPage, resp, err = client.GroupWikis.EditGroupWikiPage(group, slug, (*gitlab.EditGroupWikiPageOptions)(opts), gitlab.WithContext(cmd.Context()))
+				page = (*gitlab.Wiki)(groupPage)
+			} else {
+				project, err = f.FullProjectPath()
+				if err != nil {
+					return err
+				}
+				page, resp, err = client.Wikis.EditWikiPage(project, slug, opts, gitlab.WithContext(cmd.Context()))
+			}
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + wikiAPIPath(group, project, slug)
+				return errors.NewAPIError("PUT", url, statusCode, "Failed to edit wiki page "+slug, err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Updated wiki page %s\n", page.Slug)
+			_, _ = fmt.Fprintln(f.IOStreams.Out, wikiWebURL(client.Host(), group, project, page.Slug))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&group, "group", "g", "", "Edit a page of a group wiki")
+	cmd.Flags().StringVarP(&title, "title", "t", "", "New page title")
+	cmd.Flags().StringVarP(&source, "file", "f", "", `Read the new content from a file, or "-" for standard input`)
+	cmd.Flags().StringVarP(&content, "content", "c", "", "New page content")
+	cmd.Flags().StringVar(&markup, "markup", "markdown", "Markup format: "+strings.Join(wikiMarkups, ", "))
+	cmd.MarkFlagsMutuallyExclusive("file", "content")
+
+	return cmd
+}
+
+func newWikiDeleteCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		group string
+		yes   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "delete <slug>",
+		Short: "Delete a wiki page",
+		Example: `  $ glab wiki delete drafts/old-plan
+  $ glab wiki delete home --group my-group --yes`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.Client()
+			if err != nil {
+				return err
+			}
+
+			slug := args[0]
+			if !yes {
+				confirmed, err := prompt.Confirm(f.IOStreams.In, f.IOStreams.ErrOut, fmt.Sprintf("Delete wiki page %s?", slug), false)
+				if err != nil {
+					return err
+				}
+				if !confirmed {
+					_, _ = fmt.Fprintln(f.IOStreams.ErrOut, "Deletion cancelled")
+					return nil
+				}
+			}
+
+			var project string
+			var resp *gitlab.Response
+			if group != "" {
+				resp, err = client.GroupWikis.DeleteGroupWikiPage(group, slug, gitlab.WithContext(cmd.Context()))
+			} else {
+				project, err = f.FullProjectPath()
+				if err != nil {
+					return err
+				}
+				resp, err = client.Wikis.DeleteWikiPage(project, slug, gitlab.WithContext(cmd.Context()))
+			}
+			if err != nil {
+				statusCode := 0
+				if resp != nil {
+					statusCode = resp.StatusCode
+				}
+				url := api.APIURL(client.Host()) + wikiAPIPath(group, project, slug)
+				return errors.NewAPIError("DELETE", url, statusCode, "Failed to delete wiki page "+slug, err)
+			}
+
+			_, _ = fmt.Fprintf(f.IOStreams.Out, "Deleted wiki page %s\n", slug)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&group, "group", "g", "", "Delete a page of a group wiki")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+// readWikiContent returns the page content given with --content or read from
+// --file, or nil when neither flag was used.
+func readWikiContent(f *cmdutil.Factory, cmd *cobra.Command, source, content string) (*string, error) {
+	if cmd.Flags().Changed("content") {
+		return &content, nil
+	}
+	if source == "" {
+		return nil, nil
+	}
+
+	var data []byte
+	var err error
+	if source == "-" {
+		data, err = io.ReadAll(f.IOStreams.In)
+	} else {
+		data, err = os.ReadFile(source)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read page content: %w", err)
+	}
+	body := string(data)
+	return &body, nil
+}
+
+func parseWikiMarkup(s string) (*gitlab.WikiFormatValue, error) {
+	for _, m := range wikiMarkups {
+		if s == m {
+			return gitlab.Ptr(gitlab.WikiFormatValue(s)), nil
+		}
+	}
+	return nil, fmt.Errorf("invalid markup %q: must be one of %s", s, strings.Join(wikiMarkups, ", "))
+}
+
+// wikiAPIPath returns the API path of a group wiki, or of a project wiki when
+// group is empty, or of one of its pages when slug is set.
+func wikiAPIPath(group, project, slug string) string {
+	path := "/projects/" + project + "/wikis"
+	if group != "" {
+		path = "/groups/" + group + "/wikis"
+	}
+	if slug != "" {
+		path += "/" + slug
+	}
+	return path
+}
+
+// wikiWebURL returns the web URL of a group or project wiki page.
+func wikiWebURL(host, group, project, slug string) string {
+	if group != "" {
+		return api.WebURL(host, "groups/"+group+"/-/wikis/"+slug)
+	}
+	return api.WebURL(host, project+"/-/wikis/"+slug)
+}
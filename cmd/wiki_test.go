@@ -0,0 +1,252 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/cmdtest"
+)
+
+func TestNewWikiCmd(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewWikiCmd(f)
+
+	if cmd.Use != "wiki <command>" {
+		t.Errorf("expected Use to be 'wiki <command>', got %q", cmd.Use)
+	}
+
+	if cmd.Short != "Manage wiki pages" {
+		t.Errorf("expected Short to be 'Manage wiki pages', got %q", cmd.Short)
+	}
+}
+
+func TestWikiCmd_HasSubcommands(t *testing.T) {
+	f := newTestFactory()
+	cmd := NewWikiCmd(f)
+
+	expectedSubcommands := []string{
+		"list",
+		"view",
+		"create",
+		"edit",
+		"delete",
+	}
+
+	subcommands := cmd.Commands()
+	if len(subcommands) != len(expectedSubcommands) {
+		t.Errorf("expected %d subcommands, got %d", len(expectedSubcommands), len(subcommands))
+	}
+
+	foundSubcommands := make(map[string]bool)
+	for _, subcmd := range subcommands {
+		foundSubcommands[subcmd.Name()] = true
+	}
+
+	for _, expected := range expectedSubcommands {
+		if !foundSubcommands[expected] {
+			t.Errorf("expected subcommand %q not found", expected)
+		}
+	}
+}
+
+func TestWikiList(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/projects/test-owner/test-repo/wikis" {
+			cmdtest.JSONResponse(w, 200, []any{
+				map[string]any{"slug": "home", "title": "Home", "format": "markdown"},
+				map[string]any{"slug": "guides/setup", "title": "Setup", "format": "asciidoc"},
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newWikiListCmd(f.Factory)
+	cmd.SetArgs([]string{})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := f.IO.String()
+	if !strings.Contains(out, "home") || !strings.Contains(out, "guides/setup") || !strings.Contains(out, "asciidoc") {
+		t.Errorf("unexpected output: %q", out)
+	}
+}
+
+func TestWikiList_Group(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/groups/my-group/wikis" {
+			cmdtest.JSONResponse(w, 200, []any{
+				map[string]any{"slug": "handbook", "title": "Handbook", "format": "markdown"},
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newWikiListCmd(f.Factory)
+	cmd.SetArgs([]string{"--group", "my-group"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(f.IO.String(), "handbook") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestWikiView(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/projects/test-owner/test-repo/wikis/home" {
+			cmdtest.JSONResponse(w, 200, map[string]any{
+				"slug": "home", "title": "Home", "format": "markdown", "content": "# Welcome",
+			})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newWikiViewCmd(f.Factory)
+	cmd.SetArgs([]string{"home"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := f.IO.String()
+	if !strings.Contains(out, "Title:   Home") || !strings.Contains(out, "https://gitlab.com/test-owner/test-repo/-/wikis/home") {
+		t.Errorf("expected header in output, got %q", out)
+	}
+	if !strings.HasSuffix(out, "# Welcome\n") {
+		t.Errorf("expected page content, got %q", out)
+	}
+}
+
+func TestWikiView_Raw(t *testing.T) {
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/projects/test-owner/test-repo/wikis/home" {
+			cmdtest.JSONResponse(w, 200, map[string]any{"slug": "home", "title": "Home", "content": "# Welcome\n"})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newWikiViewCmd(f.Factory)
+	cmd.SetArgs([]string{"home", "--raw"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if f.IO.String() != "# Welcome\n" {
+		t.Errorf("expected raw content only, got %q", f.IO.String())
+	}
+}
+
+func TestWikiCreate_FromFile(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/test-owner/test-repo/wikis" {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 201, map[string]any{"slug": "Release-process", "title": body["title"]})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	path := filepath.Join(t.TempDir(), "release.md")
+	_ = os.WriteFile(path, []byte("1. Tag\n2. Release\n"), 0o644)
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newWikiCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"Release process", "--file", path})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["title"] != "Release process" || body["content"] != "1. Tag\n2. Release\n" || body["format"] != "markdown" {
+		t.Errorf("unexpected request body: %v", body)
+	}
+	if !strings.Contains(f.IO.String(), "Created wiki page Release-process") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestWikiCreate_RequiresContent(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newWikiCreateCmd(f.Factory)
+	cmd.SetArgs([]string{"Empty"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "content required") {
+		t.Errorf("expected content error, got %v", err)
+	}
+}
+
+func TestWikiEdit_Stdin(t *testing.T) {
+	var body map[string]any
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut && r.URL.Path == "/api/v4/groups/my-group/wikis/handbook" {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			cmdtest.JSONResponse(w, 200, map[string]any{"slug": "handbook", "title": "Handbook"})
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	f.IO.In.WriteString("Updated content")
+	cmd := newWikiEditCmd(f.Factory)
+	cmd.SetArgs([]string{"handbook", "--file", "-", "--group", "my-group"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if body["content"] != "Updated content" {
+		t.Errorf("unexpected request body: %v", body)
+	}
+	if _, ok := body["format"]; ok {
+		t.Errorf("expected format to be left unchanged, got %v", body["format"])
+	}
+	if !strings.Contains(f.IO.String(), "https://gitlab.com/groups/my-group/-/wikis/handbook") {
+		t.Errorf("unexpected output: %q", f.IO.String())
+	}
+}
+
+func TestWikiEdit_NothingToChange(t *testing.T) {
+	f := cmdtest.NewTestFactory(t)
+	cmd := newWikiEditCmd(f.Factory)
+	cmd.SetArgs([]string{"home"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "nothing to change") {
+		t.Errorf("expected nothing to change error, got %v", err)
+	}
+}
+
+func TestWikiDelete(t *testing.T) {
+	deleted := false
+	cmdtest.MockGitLabServer(t, "gitlab.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete && r.URL.Path == "/api/v4/projects/test-owner/test-repo/wikis/drafts/old" {
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		cmdtest.ErrorResponse(w, 404, "not found")
+	})
+
+	f := cmdtest.NewTestFactory(t)
+	cmd := newWikiDeleteCmd(f.Factory)
+	cmd.SetArgs([]string{"drafts/old", "--yes"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !deleted {
+		t.Error("expected the page to be deleted")
+	}
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -23,8 +24,8 @@ type Client struct {
 // It automatically selects the correct client type based on the stored auth method.
 func NewClient(host string) (*Client, error) {
 	// Reject hosts with scheme, path, or credential characters to prevent SSRF.
-	if strings.ContainsAny(host, "/:@?#") {
-		return nil, fmt.Errorf("invalid host %q: must be a plain hostname (e.g. gitlab.example.com)", host)
+	if !validHost(host) {
+		return nil, fmt.Errorf("invalid host %q: must be a plain hostname with an optional port (e.g. gitlab.example.com or gitlab.local:8080)", host)
 	}
 	token, tokenSource := config.TokenForHost(host)
 	if token == "" {
@@ -58,21 +59,9 @@ func NewClient(host string) (*Client, error) {
 // Optional gitlab.ClientOptionFunc values are appended after the defaults.
 func NewClientWithToken(host, token string, opts ...gitlab.ClientOptionFunc) (*Client, error) {
 	baseURL := APIURL(host)
-	// Create client with appropriate HTTP client
-	// Use http.DefaultTransport as base to allow test mocking via InterceptTransport
-	var client *gitlab.Client
-	var err error
-	if errors.IsVerboseMode() {
-		httpClient := errors.NewLoggingHTTPClient()
-		httpClient.Transport = &RateLimitTransport{Base: httpClient.Transport}
-		baseOpts := []gitlab.ClientOptionFunc{gitlab.WithBaseURL(baseURL), gitlab.WithHTTPClient(httpClient)}
-		client, err = gitlab.NewClient(token, append(baseOpts, opts...)...)
-	} else {
-		httpClient := &http.Client{Transport: &RateLimitTransport{Base: http.DefaultTransport}}
-		baseOpts := []gitlab.ClientOptionFunc{gitlab.WithBaseURL(baseURL), gitlab.WithHTTPClient(httpClient)}
-		client, err = gitlab.NewClient(token, append(baseOpts, opts...)...)
-	}
-
+	httpClient := NewHTTPClient(0)
+	baseOpts := clientOptions(baseURL, httpClient)
+	client, err := gitlab.NewClient(token, append(baseOpts, opts...)...)
 	if err != nil {
 		return nil, errors.NewAPIError(
 			"",
@@ -95,20 +84,9 @@ func NewOAuthClient(host, token string, opts ...gitlab.ClientOptionFunc) (*Clien
 	baseURL := APIURL(host)
 	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
 
-	// Create client with logging HTTP client if verbose mode is enabled
-	var client *gitlab.Client
-	var err error
-	if errors.IsVerboseMode() {
-		httpClient := errors.NewLoggingHTTPClient()
-		httpClient.Transport = &RateLimitTransport{Base: httpClient.Transport}
-		baseOpts := []gitlab.ClientOptionFunc{gitlab.WithBaseURL(baseURL), gitlab.WithHTTPClient(httpClient)}
-		client, err = gitlab.NewAuthSourceClient(gitlab.OAuthTokenSource{TokenSource: ts}, append(baseOpts, opts...)...)
-	} else {
-		httpClient := &http.Client{Transport: &RateLimitTransport{Base: http.DefaultTransport}}
-		baseOpts := []gitlab.ClientOptionFunc{gitlab.WithBaseURL(baseURL), gitlab.WithHTTPClient(httpClient)}
-		client, err = gitlab.NewAuthSourceClient(gitlab.OAuthTokenSource{TokenSource: ts}, append(baseOpts, opts...)...)
-	}
-
+	httpClient := NewHTTPClient(0)
+	baseOpts := clientOptions(baseURL, httpClient)
+	client, err := gitlab.NewAuthSourceClient(gitlab.OAuthTokenSource{TokenSource: ts}, append(baseOpts, opts...)...)
 	if err != nil {
 		return nil, errors.NewAuthError(
 			host,
@@ -126,6 +104,18 @@ func NewOAuthClient(host, token string, opts ...gitlab.ClientOptionFunc) (*Clien
 	}, nil
 }
 
+// clientOptions returns the options every client starts with. The retries of
+// client-go are turned off, because the shared transport already retries
+// rate-limited requests, and only retries gateway errors when the request is
+// safe to send again.
+func clientOptions(baseURL string, httpClient *http.Client) []gitlab.ClientOptionFunc {
+	return []gitlab.ClientOptionFunc{
+		gitlab.WithBaseURL(baseURL),
+		gitlab.WithHTTPClient(httpClient),
+		gitlab.WithoutRetries(),
+	}
+}
+
 // NewClientFromHosts creates a client using the first authenticated host found in hosts.json.
 func NewClientFromHosts() (*Client, error) {
 	hosts, err := config.LoadHosts()
@@ -155,6 +145,23 @@ func NewClientFromHosts() (*Client, error) {
 	)
 }
 
+// validHost reports whether host is a host name with an optional numeric
+// port and nothing else.
+func validHost(host string) bool {
+	if host == "" || strings.ContainsAny(host, "/@?#") {
+		return false
+	}
+	name, port, found := strings.Cut(host, ":")
+	if !found {
+		return true
+	}
+	if name == "" || port == "" {
+		return false
+	}
+	n, err := strconv.Atoi(port)
+	return err == nil && n > 0 && n < 65536
+}
+
 // Host returns the hostname of the GitLab instance.
 func (c *Client) Host() string {
 	return c.host
@@ -174,17 +181,20 @@ func (c *Client) GetVersion() string {
 	return hc.GitLabVersion
 }
 
-// APIURL returns the API base URL for a given host.
+// APIURL returns the API base URL for a given host, honoring its api_host
+// and api_protocol settings.
 func APIURL(host string) string {
-	if host == "gitlab.com" {
-		return "https://gitlab.com/api/v4"
-	}
-	return fmt.Sprintf("https://%s/api/v4", host)
+	return config.APIBaseURL(host) + "/api/v4"
+}
+
+// GraphQLURL returns the GraphQL endpoint for a given host.
+func GraphQLURL(host string) string {
+	return config.APIBaseURL(host) + "/api/graphql"
 }
 
 // WebURL returns the web URL for a given host and path.
 func WebURL(host, path string) string {
-	return fmt.Sprintf("https://%s/%s", host, path)
+	return config.WebBaseURL(host) + "/" + path
 }
 
 // RefreshOAuthTokenIfNeeded checks if the OAuth token is expired (or about to expire)
@@ -22,7 +22,8 @@ func TestNewClient_SSRFProtection(t *testing.T) {
 		{"host with https scheme", "https://gitlab.com", "invalid host"},
 		{"host with http scheme", "http://gitlab.com", "invalid host"},
 		{"host with path separator", "gitlab.com/api", "invalid host"},
-		{"host with port colon", "gitlab.com:443", "invalid host"},
+		{"host with non-numeric port", "gitlab.com:abc", "invalid host"},
+		{"host with empty port", "gitlab.com:", "invalid host"},
 		{"host with at sign", "user@gitlab.com", "invalid host"},
 		{"host with query", "gitlab.com?foo=bar", "invalid host"},
 		{"host with fragment", "gitlab.com#section", "invalid host"},
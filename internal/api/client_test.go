@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/PhilipKram/gitlab-cli/internal/config"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
 )
 
 var testConfigDir string
@@ -270,6 +271,36 @@ func TestAPIURL(t *testing.T) {
 	}
 }
 
+func TestAPIURL_HostSettings(t *testing.T) {
+	writeTestHosts(t, config.HostsConfig{
+		"gitlab.local:8080": {APIProtocol: "http"},
+		"gitlab.corp":       {APIHost: "http://10.0.0.5:8080/"},
+		"gitlab.internal":   {APIHost: "api.gitlab.internal"},
+	})
+	t.Cleanup(func() { clearTestHosts(t) })
+
+	tests := []struct {
+		host string
+		want string
+	}{
+		{"gitlab.local:8080", "http://gitlab.local:8080/api/v4"},
+		{"gitlab.corp", "http://10.0.0.5:8080/api/v4"},
+		{"gitlab.internal", "https://api.gitlab.internal/api/v4"},
+	}
+	for _, tt := range tests {
+		if got := APIURL(tt.host); got != tt.want {
+			t.Errorf("APIURL(%q) = %q, want %q", tt.host, got, tt.want)
+		}
+	}
+
+	if got := WebURL("gitlab.local:8080", "group/project"); got != "http://gitlab.local:8080/group/project" {
+		t.Errorf("WebURL() = %q", got)
+	}
+	if got := WebURL("gitlab.corp", "group/project"); got != "https://gitlab.corp/group/project" {
+		t.Errorf("WebURL() should not use api_host, got %q", got)
+	}
+}
+
 func TestWebURL(t *testing.T) {
 	tests := []struct {
 		host string
@@ -342,6 +373,39 @@ func TestNewOAuthClient(t *testing.T) {
 	}
 }
 
+func TestNewClient_RetriesOnlyInTransport(t *testing.T) {
+	attempts := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	testHost := "gitlab.retrytest.local"
+	interceptTransport(t, testHost, srv)
+
+	for _, newClient := range []func(host, token string, opts ...gitlab.ClientOptionFunc) (*Client, error){NewClientWithToken, NewOAuthClient} {
+		client, err := newClient(testHost, "token")
+		if err != nil {
+			t.Fatalf("creating client: %v", err)
+		}
+		for _, method := range []string{http.MethodGet, http.MethodPost} {
+			attempts = 0
+			req, err := client.NewRequest(method, "projects", nil, nil)
+			if err != nil {
+				t.Fatalf("NewRequest: %v", err)
+			}
+			if _, err := client.Do(req, nil); err == nil {
+				t.Fatalf("%s: expected an error", method)
+			}
+			// client-go would retry a 500 by itself; the transport does not.
+			if attempts != 1 {
+				t.Errorf("%s: expected 1 attempt, got %d", method, attempts)
+			}
+		}
+	}
+}
+
 func TestGetVersion_NoHostsFile(t *testing.T) {
 	clearTestHosts(t)
 	c := &Client{host: "nonexistent.host"}
@@ -424,7 +488,7 @@ func TestNewClient_InvalidHost(t *testing.T) {
 	}{
 		{"host with scheme", "https://gitlab.com"},
 		{"host with path", "gitlab.com/api"},
-		{"host with port", "gitlab.com:443"},
+		{"host with invalid port", "gitlab.com:99999"},
 		{"host with at sign", "user@gitlab.com"},
 		{"host with query", "gitlab.com?foo=bar"},
 		{"host with fragment", "gitlab.com#section"},
@@ -12,15 +12,22 @@ import (
 const (
 	maxRetries       = 3
 	defaultRetryWait = 5 * time.Second
+	serverRetryWait  = 1 * time.Second
 	maxRetryWait     = 60 * time.Second
+
+	// unixTimestampThreshold separates delays in seconds from Unix
+	// timestamps (September 2001) in rate limit headers.
+	unixTimestampThreshold = 1_000_000_000
 )
 
-// RateLimitTransport wraps an http.RoundTripper with automatic retry on HTTP 429 responses.
+// RateLimitTransport wraps an http.RoundTripper with automatic retry on HTTP
+// 429 responses, and on gateway errors (502, 503, 504) for idempotent
+// requests.
 type RateLimitTransport struct {
 	Base http.RoundTripper
 }
 
-// RoundTrip executes the request and retries on HTTP 429 with exponential backoff.
+// RoundTrip executes the request and retries with exponential backoff.
 func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	base := t.Base
 	if base == nil {
@@ -28,12 +35,23 @@ func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error
 	}
 
 	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			// The previous attempt consumed the body; send it again.
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
 		resp, err := base.RoundTrip(req)
 		if err != nil {
 			return resp, err
 		}
 
-		if resp.StatusCode != http.StatusTooManyRequests {
+		rateLimited := resp.StatusCode == http.StatusTooManyRequests
+		if !rateLimited && !retryableServerError(req, resp) {
 			return resp, nil
 		}
 
@@ -41,26 +59,58 @@ func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error
 			return resp, nil
 		}
 
-		// Determine wait time from Retry-After header or use exponential backoff
-		wait := retryAfterDuration(resp.Header)
-		if wait == 0 {
-			wait = defaultRetryWait * time.Duration(1<<uint(attempt))
-		}
-		if wait > maxRetryWait {
-			wait = maxRetryWait
-		}
+		wait := retryWait(resp.Header, attempt, rateLimited)
 
-		// Close the 429 response body before retrying
+		// Close the response body before retrying
 		_ = resp.Body.Close()
 
-		fmt.Fprintf(os.Stderr, "Rate limited by GitLab API, retrying in %s...\n", wait)
-		time.Sleep(wait)
+		if rateLimited {
+			fmt.Fprintf(os.Stderr, "Rate limited by GitLab API, retrying in %s...\n", wait)
+		} else {
+			fmt.Fprintf(os.Stderr, "GitLab API returned %s, retrying in %s...\n", resp.Status, wait)
+		}
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
 	}
 
 	// Unreachable, but satisfy the compiler
 	return nil, fmt.Errorf("rate limit: max retries exceeded")
 }
 
+// retryableServerError reports whether resp is a gateway error for a
+// request that is safe to send again.
+func retryableServerError(req *http.Request, resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+	default:
+		return false
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryWait returns how long to wait before retrying after the given
+// attempt (starting at 0): the time given by the Retry-After or
+// RateLimit-Reset header, or exponential backoff, capped at one minute.
+// Server errors back off from a shorter base than rate limiting.
+func retryWait(h http.Header, attempt int, rateLimited bool) time.Duration {
+	wait := retryAfterDuration(h)
+	if wait == 0 {
+		base := serverRetryWait
+		if rateLimited {
+			base = defaultRetryWait
+		}
+		wait = base * time.Duration(1<<uint(attempt))
+	}
+	return min(wait, maxRetryWait)
+}
+
 // retryAfterDuration parses the Retry-After header value as seconds.
 func retryAfterDuration(h http.Header) time.Duration {
 	val := h.Get("Retry-After")
@@ -71,9 +121,10 @@ func retryAfterDuration(h http.Header) time.Duration {
 		return 0
 	}
 
-	// Try parsing as seconds
+	// Try parsing as seconds. GitLab sends RateLimit-Reset as a Unix
+	// timestamp, so values that large are treated as one.
 	seconds, err := strconv.Atoi(val)
-	if err == nil && seconds > 0 {
+	if err == nil && seconds > 0 && seconds < unixTimestampThreshold {
 		return time.Duration(seconds) * time.Second
 	}
 
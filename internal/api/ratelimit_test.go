@@ -1,6 +1,8 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -66,6 +68,36 @@ func TestRateLimitTransport_RetriesOn429(t *testing.T) {
 	}
 }
 
+func TestRateLimitTransport_ContextCanceledDuringWait(t *testing.T) {
+	calls := 0
+	transport := &RateLimitTransport{
+		Base: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			calls++
+			return &http.Response{
+				StatusCode: 429,
+				Header:     http.Header{"Retry-After": []string{"60"}},
+				Body:       io.NopCloser(strings.NewReader("rate limited")),
+			}, nil
+		}),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, "GET", "https://example.com", nil)
+	start := time.Now()
+	_, err := transport.RoundTrip(req)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context deadline error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected wait to be interrupted, took %v", elapsed)
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+}
+
 func TestRetryAfterDuration_Seconds(t *testing.T) {
 	h := http.Header{}
 	h.Set("Retry-After", "10")
@@ -259,3 +291,80 @@ func TestRetryAfterDuration_FutureUnixTimestamp(t *testing.T) {
 		t.Errorf("expected positive duration for large Retry-After, got %v", d)
 	}
 }
+
+func TestRateLimitTransport_ResendsBody(t *testing.T) {
+	var bodies []string
+	transport := &RateLimitTransport{
+		Base: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			b, _ := io.ReadAll(req.Body)
+			bodies = append(bodies, string(b))
+			status := 200
+			if len(bodies) == 1 {
+				status = 429
+			}
+			return &http.Response{
+				StatusCode: status,
+				Header:     http.Header{"Retry-After": []string{"1"}},
+				Body:       io.NopCloser(strings.NewReader("")),
+			}, nil
+		}),
+	}
+
+	req, _ := http.NewRequest("POST", "https://example.com", strings.NewReader(`{"a":1}`))
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(bodies) != 2 || bodies[1] != `{"a":1}` {
+		t.Errorf("bodies = %q, want the body sent twice", bodies)
+	}
+}
+
+func TestRetryWait_RateLimitResetTimestamp(t *testing.T) {
+	h := http.Header{}
+	h.Set("RateLimit-Reset", fmt.Sprintf("%d", time.Now().Add(20*time.Second).Unix()))
+	if d := retryWait(h, 0, true); d <= 15*time.Second || d > 20*time.Second {
+		t.Errorf("expected about 20s until the reset time, got %v", d)
+	}
+
+	if d := retryWait(http.Header{}, 1, true); d != 2*defaultRetryWait {
+		t.Errorf("expected exponential backoff, got %v", d)
+	}
+	if d := retryWait(http.Header{}, 1, false); d != 2*serverRetryWait {
+		t.Errorf("expected shorter backoff for server errors, got %v", d)
+	}
+}
+
+func TestRateLimitTransport_RetriesGatewayErrors(t *testing.T) {
+	for _, tt := range []struct {
+		method string
+		calls  int
+	}{
+		{http.MethodGet, 2},
+		{http.MethodPost, 1},
+	} {
+		calls := 0
+		transport := &RateLimitTransport{
+			Base: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				calls++
+				status := http.StatusOK
+				if calls == 1 {
+					status = http.StatusBadGateway
+				}
+				return &http.Response{
+					StatusCode: status,
+					Status:     http.StatusText(status),
+					Header:     http.Header{"Retry-After": []string{"1"}},
+					Body:       io.NopCloser(strings.NewReader("")),
+				}, nil
+			}),
+		}
+
+		req, _ := http.NewRequest(tt.method, "https://example.com", nil)
+		if _, err := transport.RoundTrip(req); err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.method, err)
+		}
+		if calls != tt.calls {
+			t.Errorf("%s: expected %d calls, got %d", tt.method, tt.calls, calls)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/PhilipKram/gitlab-cli/internal/config"
+)
+
+// hostTransport sends requests with http.DefaultTransport unless the
+// request's host has TLS settings (ca_cert, skip_tls_verify) or a proxy is
+// configured with the proxy config key. Those requests use a transport
+// built from http.DefaultTransport with the settings applied, cached per
+// host.
+type hostTransport struct{}
+
+var (
+	hostTransportsMu sync.Mutex
+	hostTransports   = map[string]http.RoundTripper{}
+)
+
+func (hostTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt, err := transportForHost(req.URL.Host)
+	if err != nil {
+		return nil, err
+	}
+	if rt == nil {
+		return http.DefaultTransport.RoundTrip(req)
+	}
+	return rt.RoundTrip(req)
+}
+
+// transportForHost returns the transport for host, or nil when the default
+// transport applies.
+func transportForHost(host string) (http.RoundTripper, error) {
+	hostTransportsMu.Lock()
+	defer hostTransportsMu.Unlock()
+
+	if rt, ok := hostTransports[host]; ok {
+		return rt, nil
+	}
+
+	var proxy string
+	if cfg, err := config.Load(); err == nil {
+		proxy = cfg.Proxy
+	}
+	hc := lookupHostConfig(host)
+	if proxy == "" && (hc == nil || (hc.CACert == "" && !hc.SkipTLSVerify)) {
+		hostTransports[host] = nil
+		return nil, nil
+	}
+
+	base, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		base = &http.Transport{Proxy: http.ProxyFromEnvironment}
+	}
+	t := base.Clone()
+
+	if proxy != "" {
+		proxyFunc, err := proxyFunc(proxy)
+		if err != nil {
+			return nil, err
+		}
+		t.Proxy = proxyFunc
+	}
+
+	if hc != nil && (hc.CACert != "" || hc.SkipTLSVerify) {
+		tlsConfig, err := hostTLSConfig(hc)
+		if err != nil {
+			return nil, fmt.Errorf("configuring TLS for %s: %w", host, err)
+		}
+		t.TLSClientConfig = tlsConfig
+	}
+
+	hostTransports[host] = t
+	return t, nil
+}
+
+// lookupHostConfig returns the configuration of the GitLab host whose name
+// or API host matches host, which may include a port.
+func lookupHostConfig(host string) *config.HostConfig {
+	hosts, err := config.LoadHosts()
+	if err != nil {
+		return nil
+	}
+	if hc, ok := hosts[host]; ok {
+		return hc
+	}
+	hostname := host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		hostname = h
+	}
+	for name, hc := range hosts {
+		if name == hostname || (hc.APIHost != "" && (hc.APIHost == host || hc.APIHost == hostname)) {
+			return hc
+		}
+	}
+	return nil
+}
+
+// hostTLSConfig returns the TLS configuration for a host: the system roots
+// plus the certificates in ca_cert, or no verification at all with
+// skip_tls_verify.
+func hostTLSConfig(hc *config.HostConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if hc.SkipTLSVerify {
+		tlsConfig.InsecureSkipVerify = true // #nosec G402 -- explicitly requested with skip_tls_verify
+		return tlsConfig, nil
+	}
+
+	pem, err := os.ReadFile(hc.CACert)
+	if err != nil {
+		return nil, fmt.Errorf("reading ca_cert: %w", err)
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no PEM certificates found in %s", hc.CACert)
+	}
+	tlsConfig.RootCAs = pool
+	return tlsConfig, nil
+}
+
+// proxyFunc returns the proxy selection for the proxy config key. The
+// HTTPS_PROXY and HTTP_PROXY environment variables take precedence, and
+// hosts listed in NO_PROXY are always reached directly.
+func proxyFunc(proxy string) (func(*http.Request) (*url.URL, error), error) {
+	proxyURL, err := url.Parse(proxy)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy %q: %w", proxy, err)
+	}
+	return func(req *http.Request) (*url.URL, error) {
+		if envProxy, err := http.ProxyFromEnvironment(req); err != nil || envProxy != nil {
+			return envProxy, err
+		}
+		if proxyEnvSet() || bypassProxy(req.URL.Host, getenvAny("NO_PROXY", "no_proxy")) {
+			return nil, nil
+		}
+		return proxyURL, nil
+	}, nil
+}
+
+// proxyEnvSet reports whether a proxy is configured in the environment for
+// either scheme, in which case the environment fully decides.
+func proxyEnvSet() bool {
+	return getenvAny("HTTPS_PROXY", "https_proxy", "HTTP_PROXY", "http_proxy") != ""
+}
+
+func getenvAny(names ...string) string {
+	for _, name := range names {
+		if v := os.Getenv(name); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// bypassProxy reports whether host matches an entry of noProxy, a
+// comma-separated list of host names, domain suffixes (".example.com" or
+// "example.com"), IP addresses, CIDR ranges, or "*".
+func bypassProxy(host, noProxy string) bool {
+	hostname, port := host, ""
+	if h, p, err := net.SplitHostPort(host); err == nil {
+		hostname, port = h, p
+	}
+	hostname = strings.ToLower(hostname)
+	ip := net.ParseIP(hostname)
+
+	for _, entry := range strings.Split(noProxy, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if entry == "*" {
+			return true
+		}
+		if _, cidr, err := net.ParseCIDR(entry); err == nil {
+			if ip != nil && cidr.Contains(ip) {
+				return true
+			}
+			continue
+		}
+		if h, p, err := net.SplitHostPort(entry); err == nil {
+			if p != port {
+				continue
+			}
+			entry = h
+		}
+		if ip != nil {
+			if entryIP := net.ParseIP(entry); entryIP != nil && entryIP.Equal(ip) {
+				return true
+			}
+			continue
+		}
+		domain := strings.TrimPrefix(entry, ".")
+		if hostname == domain || strings.HasSuffix(hostname, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package api
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/config"
+)
+
+// resetHostTransports points the config at an empty directory and clears
+// the per-host transport cache.
+func resetHostTransports(t *testing.T) {
+	t.Helper()
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+	hostTransports = map[string]http.RoundTripper{}
+	t.Cleanup(func() { hostTransports = map[string]http.RoundTripper{} })
+}
+
+func TestHostTransport_CACert(t *testing.T) {
+	resetHostTransports(t)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "https://")
+
+	client := &http.Client{Transport: hostTransport{}}
+	if _, err := client.Get(server.URL); err == nil {
+		t.Fatal("expected a certificate error without ca_cert")
+	}
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caFile, certPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := config.SaveHosts(config.HostsConfig{host: {CACert: caFile}}); err != nil {
+		t.Fatal(err)
+	}
+	hostTransports = map[string]http.RoundTripper{}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("expected the ca_cert to be trusted: %v", err)
+	}
+	_ = resp.Body.Close()
+}
+
+func TestHostTransport_SkipTLSVerify(t *testing.T) {
+	resetHostTransports(t)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	if err := config.SaveHosts(config.HostsConfig{
+		"gitlab.corp": {APIHost: strings.TrimPrefix(server.URL, "https://"), SkipTLSVerify: true},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := (&http.Client{Transport: hostTransport{}}).Get(server.URL)
+	if err != nil {
+		t.Fatalf("expected verification to be skipped for the API host: %v", err)
+	}
+	_ = resp.Body.Close()
+}
+
+func TestHostTransport_InvalidCACert(t *testing.T) {
+	resetHostTransports(t)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := config.SaveHosts(config.HostsConfig{"gitlab.corp": {CACert: caFile}}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := transportForHost("gitlab.corp")
+	if err == nil || !strings.Contains(err.Error(), "no PEM certificates") {
+		t.Errorf("expected a PEM error, got %v", err)
+	}
+}
+
+func TestBypassProxy(t *testing.T) {
+	tests := []struct {
+		host, noProxy string
+		want          bool
+	}{
+		{"gitlab.corp", "", false},
+		{"gitlab.corp", "*", true},
+		{"gitlab.corp", "other.com, gitlab.corp", true},
+		{"api.gitlab.corp", ".gitlab.corp", true},
+		{"api.gitlab.corp", "gitlab.corp", true},
+		{"notgitlab.corp", "gitlab.corp", false},
+		{"gitlab.corp:8443", "gitlab.corp:8443", true},
+		{"gitlab.corp:8443", "gitlab.corp:443", false},
+		{"10.1.2.3", "10.0.0.0/8", true},
+		{"192.168.1.1", "10.0.0.0/8", false},
+		{"127.0.0.1:3000", "127.0.0.1", true},
+	}
+	for _, tt := range tests {
+		if got := bypassProxy(tt.host, tt.noProxy); got != tt.want {
+			t.Errorf("bypassProxy(%q, %q) = %v, want %v", tt.host, tt.noProxy, got, tt.want)
+		}
+	}
+}
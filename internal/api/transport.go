@@ -0,0 +1,119 @@
+package api
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/PhilipKram/gitlab-cli/internal/auth"
+	"github.com/PhilipKram/gitlab-cli/internal/config"
+	"github.com/PhilipKram/gitlab-cli/internal/errors"
+)
+
+// defaultConcurrency is the number of requests allowed in flight at once
+// when neither GLAB_HTTP_CONCURRENCY nor the http_concurrency config key is
+// set.
+const defaultConcurrency = 10
+
+// version is the CLI version sent in the User-Agent header.
+var version = "dev"
+
+// SetVersion sets the CLI version sent in the User-Agent header.
+func SetVersion(v string) {
+	version = v
+}
+
+// UserAgent returns the User-Agent header sent with every request.
+func UserAgent() string {
+	return "glab/" + version + " (github.com/PhilipKram/gitlab-cli)"
+}
+
+func init() {
+	// OAuth token exchanges cannot import this package, so hand them the
+	// shared transport.
+	auth.Transport = NewTransport()
+}
+
+// NewTransport returns the transport shared by every HTTP request glab makes
+// to GitLab. From the outside in, it retries rate-limited requests (and
+// idempotent requests that hit a gateway error) with backoff, limits the
+// number of requests in flight, sets the User-Agent, and logs requests and
+// responses in verbose mode (--verbose or GLAB_DEBUG=1).
+//
+// The innermost transport applies the host's TLS settings and the proxy
+// config key; otherwise it is http.DefaultTransport, looked up on every
+// request so tests can replace it.
+func NewTransport() http.RoundTripper {
+	return &RateLimitTransport{
+		Base: &limitTransport{
+			Base: &userAgentTransport{
+				Base: errors.NewLoggingTransport(hostTransport{}),
+			},
+		},
+	}
+}
+
+// NewHTTPClient returns an HTTP client using the shared transport. A zero
+// timeout means no timeout.
+func NewHTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{Transport: NewTransport(), Timeout: timeout}
+}
+
+// userAgentTransport sets the User-Agent header unless the caller chose
+// one. The default of client-go is replaced too, so the server sees which
+// CLI and version made the request.
+type userAgentTransport struct {
+	Base http.RoundTripper
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if ua := req.Header.Get("User-Agent"); ua == "" || strings.HasPrefix(ua, "go-gitlab") {
+		req = req.Clone(req.Context())
+		req.Header.Set("User-Agent", UserAgent())
+	}
+	return t.Base.RoundTrip(req)
+}
+
+// limitTransport bounds the number of requests waiting for a response.
+type limitTransport struct {
+	Base http.RoundTripper
+}
+
+var (
+	limiterOnce sync.Once
+	limiter     chan struct{}
+)
+
+// concurrencyLimit returns the configured maximum number of requests in
+// flight: GLAB_HTTP_CONCURRENCY, then the http_concurrency config key, then
+// defaultConcurrency.
+func concurrencyLimit() int {
+	value := os.Getenv("GLAB_HTTP_CONCURRENCY")
+	if value == "" {
+		if cfg, err := config.Load(); err == nil {
+			value = cfg.HTTPConcurrency
+		}
+	}
+	if n, err := strconv.Atoi(value); err == nil && n > 0 {
+		return n
+	}
+	return defaultConcurrency
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	limiterOnce.Do(func() {
+		limiter = make(chan struct{}, concurrencyLimit())
+	})
+
+	select {
+	case limiter <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	defer func() { <-limiter }()
+
+	return t.Base.RoundTrip(req)
+}
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewHTTPClient_UserAgent(t *testing.T) {
+	SetVersion("1.2.3")
+	defer SetVersion("dev")
+
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("User-Agent")
+	}))
+	defer server.Close()
+
+	resp, err := NewHTTPClient(0).Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if got != "glab/1.2.3 (github.com/PhilipKram/gitlab-cli)" {
+		t.Errorf("unexpected User-Agent: %q", got)
+	}
+}
+
+func TestUserAgentTransport_KeepsCallerHeader(t *testing.T) {
+	var got string
+	transport := &userAgentTransport{
+		Base: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			got = req.Header.Get("User-Agent")
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		}),
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	req.Header.Set("User-Agent", "my-script/1.0")
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "my-script/1.0" {
+		t.Errorf("expected the caller's User-Agent, got %q", got)
+	}
+}
+
+func TestLimitTransport_BoundsConcurrency(t *testing.T) {
+	t.Setenv("GLAB_HTTP_CONCURRENCY", "2")
+	limiterOnce = sync.Once{}
+	defer func() { limiterOnce = sync.Once{} }()
+
+	var inFlight, peak int32
+	transport := &limitTransport{
+		Base: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			n := atomic.AddInt32(&inFlight, 1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			atomic.AddInt32(&inFlight, -1)
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		}),
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+			_, _ = transport.RoundTrip(req)
+		}()
+	}
+	wg.Wait()
+
+	if peak > 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", peak)
+	}
+}
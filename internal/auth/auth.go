@@ -21,10 +21,13 @@ type Status struct {
 	Token          string
 	Source         string
 	GitLabVersion  string
-	AuthMethod     string // "pat", "oauth", or ""
-	TokenExpiresAt int64  // Unix timestamp; 0 if not set
-	Scopes         string // OAuth scopes; empty for PAT
+	AuthMethod     string   // "pat", "oauth", or ""
+	TokenExpiresAt int64    // Unix timestamp; 0 if not set
+	Scopes         string   // OAuth scopes; empty for PAT
+	TokenScopes    []string `json:",omitempty"` // scopes reported by GitLab; set by VerifyScopes
+	MissingScopes  []string `json:",omitempty"` // required scopes the token lacks
 	Active         bool
+	Validated      bool `json:",omitempty"` // token accepted by GitLab; set by ValidateStatus
 	HasError       bool
 	Error          string
 }
@@ -53,7 +56,7 @@ func Login(host, token string, stdin io.Reader) (*Status, error) {
 	}
 
 	// Validate the token by making an API call
-	client, err := gitlab.NewClient(token, gitlab.WithBaseURL(apiURL(host)))
+	client, err := gitlab.NewClient(token, gitlab.WithBaseURL(apiURL(host)), gitlab.WithHTTPClient(httpClient()))
 	if err != nil {
 		return nil, errors.NewAuthError(
 			host,
@@ -69,7 +72,7 @@ func Login(host, token string, stdin io.Reader) (*Status, error) {
 	if err != nil {
 		// Check if this is a 401 Unauthorized
 		if resp != nil && resp.StatusCode == http.StatusUnauthorized {
-			return nil, fmt.Errorf("authentication failed: invalid or expired token for %s\n\nThe token may be invalid, expired, or revoked.\nPlease generate a new token at: https://%s/-/profile/personal_access_tokens", host, host)
+			return nil, fmt.Errorf("authentication failed: invalid or expired token for %s\n\nThe token may be invalid, expired, or revoked.\nPlease generate a new token at: %s/-/profile/personal_access_tokens", host, config.WebBaseURL(host))
 		}
 		// Use enhanced error formatting
 		return nil, formatAuthError(host, err)
@@ -154,7 +157,7 @@ func GetStatus() ([]Status, error) {
 			Active: true,
 		}
 		// Try to get user info
-		client, err := gitlab.NewClient(t, gitlab.WithBaseURL(apiURL(host)))
+		client, err := gitlab.NewClient(t, gitlab.WithBaseURL(apiURL(host)), gitlab.WithHTTPClient(httpClient()))
 		if err == nil {
 			user, resp, err := client.Users.CurrentUser()
 			if err == nil {
@@ -254,10 +257,7 @@ func GetToken(host string) (string, error) {
 }
 
 func apiURL(host string) string {
-	if host == "gitlab.com" {
-		return "https://gitlab.com/api/v4"
-	}
-	return fmt.Sprintf("https://%s/api/v4", host)
+	return config.APIBaseURL(host) + "/api/v4"
 }
 
 func maskToken(token string) string {
@@ -20,6 +20,15 @@ import (
 	"golang.org/x/oauth2"
 )
 
+// Transport sends the token requests of the OAuth flow. The api package sets
+// it to the shared GitLab transport; nil uses http.DefaultTransport.
+var Transport http.RoundTripper
+
+// httpClient returns a client for GitLab API requests using Transport.
+func httpClient() *http.Client {
+	return &http.Client{Transport: Transport}
+}
+
 const (
 	defaultScopes      = "openid profile api read_user write_repository"
 	defaultRedirectURI = "http://localhost:7171/auth/redirect"
@@ -113,7 +122,7 @@ func OAuthFlow(host, clientID, redirectURI, scopes string, out io.Writer, openBr
 
 	// Validate the token
 	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: tokenResp.AccessToken})
-	client, err := gitlab.NewAuthSourceClient(gitlab.OAuthTokenSource{TokenSource: ts}, gitlab.WithBaseURL(apiURL(host)))
+	client, err := gitlab.NewAuthSourceClient(gitlab.OAuthTokenSource{TokenSource: ts}, gitlab.WithBaseURL(apiURL(host)), gitlab.WithHTTPClient(httpClient()))
 	if err != nil {
 		return nil, fmt.Errorf("creating GitLab client: %w", err)
 	}
@@ -172,7 +181,7 @@ func OAuthFlow(host, clientID, redirectURI, scopes string, out io.Writer, openBr
 }
 
 func buildAuthURL(host, clientID, redirectURI, state, codeChallenge, scopes string) string {
-	baseURL := fmt.Sprintf("https://%s/oauth/authorize", host)
+	baseURL := config.WebBaseURL(host) + "/oauth/authorize"
 	params := url.Values{
 		"client_id":             {clientID},
 		"redirect_uri":          {redirectURI},
@@ -242,7 +251,7 @@ func waitForCallback(listener net.Listener, expectedState, callbackPath string)
 }
 
 func exchangeCode(host, clientID, code, redirectURI, codeVerifier string) (*OAuthTokenResponse, error) {
-	tokenURL := fmt.Sprintf("https://%s/oauth/token", host)
+	tokenURL := config.WebBaseURL(host) + "/oauth/token"
 
 	data := url.Values{
 		"client_id":     {clientID},
@@ -252,7 +261,7 @@ func exchangeCode(host, clientID, code, redirectURI, codeVerifier string) (*OAut
 		"code_verifier": {codeVerifier},
 	}
 
-	client := &http.Client{Timeout: 30 * time.Second}
+	client := &http.Client{Transport: Transport, Timeout: 30 * time.Second}
 	resp, err := client.PostForm(tokenURL, data)
 	if err != nil {
 		return nil, fmt.Errorf("requesting token: %w", err)
@@ -342,7 +351,7 @@ func RefreshOAuthToken(host string) (string, error) {
 		return "", fmt.Errorf("no refresh token stored for %s; run 'glab auth login' to re-authenticate", host)
 	}
 
-	tokenURL := fmt.Sprintf("https://%s/oauth/token", host)
+	tokenURL := config.WebBaseURL(host) + "/oauth/token"
 	data := url.Values{
 		"client_id":     {hc.ClientID},
 		"refresh_token": {hc.RefreshToken},
@@ -350,7 +359,7 @@ func RefreshOAuthToken(host string) (string, error) {
 		"redirect_uri":  {hc.RedirectURI},
 	}
 
-	client := &http.Client{Timeout: 30 * time.Second}
+	client := &http.Client{Transport: Transport, Timeout: 30 * time.Second}
 	resp, err := client.PostForm(tokenURL, data)
 	if err != nil {
 		return "", fmt.Errorf("requesting token refresh: %w", err)
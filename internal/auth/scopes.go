@@ -0,0 +1,122 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/PhilipKram/gitlab-cli/internal/config"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// RequiredScopes are the token scopes glab needs for all of its commands.
+var RequiredScopes = []string{"api", "read_user", "write_repository"}
+
+// VerifyScopes asks GitLab which scopes the token of s actually has and
+// records them in s.TokenScopes, along with the required scopes it lacks in
+// s.MissingScopes. Personal access tokens are checked with
+// /personal_access_tokens/self and OAuth tokens with the token info
+// endpoint.
+func VerifyScopes(s *Status) error {
+	token := statusToken(s)
+	if token == "" {
+		return fmt.Errorf("no token for %s", s.Host)
+	}
+
+	var (
+		scopes []string
+		err    error
+	)
+	if s.AuthMethod == "oauth" {
+		scopes, err = oauthTokenScopes(s.Host, token)
+	} else {
+		scopes, err = personalAccessTokenScopes(s.Host, token)
+	}
+	if err != nil {
+		return err
+	}
+
+	sort.Strings(scopes)
+	s.TokenScopes = scopes
+	s.MissingScopes = MissingScopes(scopes)
+	return nil
+}
+
+// MissingScopes returns the required scopes that are not in scopes.
+func MissingScopes(scopes []string) []string {
+	have := map[string]bool{}
+	for _, scope := range scopes {
+		have[scope] = true
+	}
+	var missing []string
+	for _, scope := range RequiredScopes {
+		if !have[scope] {
+			missing = append(missing, scope)
+		}
+	}
+	return missing
+}
+
+// statusToken returns the unmasked token a status describes.
+func statusToken(s *Status) string {
+	if s.Source == "GITLAB_TOKEN" {
+		return os.Getenv("GITLAB_TOKEN")
+	}
+	hosts, err := config.LoadHosts()
+	if err != nil {
+		return ""
+	}
+	if hc, ok := hosts[s.Host]; ok {
+		return hc.Token
+	}
+	return ""
+}
+
+func personalAccessTokenScopes(host, token string) ([]string, error) {
+	client, err := gitlab.NewClient(token, gitlab.WithBaseURL(apiURL(host)), gitlab.WithHTTPClient(httpClient()))
+	if err != nil {
+		return nil, fmt.Errorf("creating GitLab client: %w", err)
+	}
+	pat, _, err := client.PersonalAccessTokens.GetSinglePersonalAccessToken()
+	if err != nil {
+		return nil, fmt.Errorf("reading token scopes from %s: %w", host, err)
+	}
+	return pat.Scopes, nil
+}
+
+func oauthTokenScopes(host, token string) ([]string, error) {
+	req, err := http.NewRequest(http.MethodGet, config.WebBaseURL(host)+"/oauth/token/info", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	client := httpClient()
+	client.Timeout = 30 * time.Second
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("reading token scopes from %s: %w", host, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading token info response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("reading token scopes from %s failed (HTTP %d): %s", host, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var info struct {
+		Scope []string `json:"scope"`
+	}
+	if err := json.Unmarshal(body, &info); err != nil {
+		return nil, fmt.Errorf("parsing token info response: %w", err)
+	}
+	return info.Scope, nil
+}
@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/config"
+)
+
+func TestVerifyScopes_PersonalAccessToken(t *testing.T) {
+	t.Setenv("GITLAB_TOKEN", "")
+	testHost := "gitlab.pat-scopes.local"
+	writeTestHosts(t, config.HostsConfig{
+		testHost: &config.HostConfig{Token: "pat-token", User: "u", AuthMethod: "pat"},
+	})
+	t.Cleanup(func() { clearTestHosts(t) })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v4/personal_access_tokens/self" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Header.Get("PRIVATE-TOKEN") != "pat-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":1,"name":"glab","scopes":["read_user","api"]}`))
+	}))
+	defer srv.Close()
+	interceptTransport(t, testHost, srv)
+
+	s := &Status{Host: testHost, Source: testHost, AuthMethod: "pat"}
+	if err := VerifyScopes(s); err != nil {
+		t.Fatalf("VerifyScopes: %v", err)
+	}
+	if want := []string{"api", "read_user"}; !reflect.DeepEqual(s.TokenScopes, want) {
+		t.Errorf("TokenScopes = %v, want %v", s.TokenScopes, want)
+	}
+	if want := []string{"write_repository"}; !reflect.DeepEqual(s.MissingScopes, want) {
+		t.Errorf("MissingScopes = %v, want %v", s.MissingScopes, want)
+	}
+}
+
+func TestVerifyScopes_OAuth(t *testing.T) {
+	t.Setenv("GITLAB_TOKEN", "")
+	testHost := "gitlab.oauth-scopes.local"
+	writeTestHosts(t, config.HostsConfig{
+		testHost: &config.HostConfig{Token: "oauth-token", User: "u", AuthMethod: "oauth"},
+	})
+	t.Cleanup(func() { clearTestHosts(t) })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/oauth/token/info" || r.Header.Get("Authorization") != "Bearer oauth-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"resource_owner_id":1,"scope":["api","read_user","write_repository","openid"]}`))
+	}))
+	defer srv.Close()
+	interceptTransport(t, testHost, srv)
+
+	s := &Status{Host: testHost, Source: testHost, AuthMethod: "oauth"}
+	if err := VerifyScopes(s); err != nil {
+		t.Fatalf("VerifyScopes: %v", err)
+	}
+	if want := []string{"api", "openid", "read_user", "write_repository"}; !reflect.DeepEqual(s.TokenScopes, want) {
+		t.Errorf("TokenScopes = %v, want %v", s.TokenScopes, want)
+	}
+	if len(s.MissingScopes) != 0 {
+		t.Errorf("MissingScopes = %v, want none", s.MissingScopes)
+	}
+}
+
+func TestVerifyScopes_Unauthorized(t *testing.T) {
+	t.Setenv("GITLAB_TOKEN", "")
+	testHost := "gitlab.bad-scopes.local"
+	writeTestHosts(t, config.HostsConfig{
+		testHost: &config.HostConfig{Token: "revoked", User: "u", AuthMethod: "oauth"},
+	})
+	t.Cleanup(func() { clearTestHosts(t) })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+	interceptTransport(t, testHost, srv)
+
+	s := &Status{Host: testHost, Source: testHost, AuthMethod: "oauth"}
+	if err := VerifyScopes(s); err == nil {
+		t.Fatal("expected an error for a rejected token")
+	}
+}
+
+func TestMissingScopes(t *testing.T) {
+	if got := MissingScopes([]string{"api", "read_user", "write_repository"}); len(got) != 0 {
+		t.Errorf("MissingScopes = %v, want none", got)
+	}
+	if got, want := MissingScopes([]string{"read_api"}), []string{"api", "read_user", "write_repository"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MissingScopes = %v, want %v", got, want)
+	}
+}
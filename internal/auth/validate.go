@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ValidateStatus checks the token of s against GitLab with a current user
+// request. It sets s.Validated when GitLab accepted the token and otherwise
+// marks s as failed with the reason: an expired token (with its expiry
+// time), an unreachable API, or a rejected token.
+func ValidateStatus(s *Status) {
+	if isTokenExpired(s.TokenExpiresAt) {
+		s.HasError = true
+		s.Active = false
+		s.Error = fmt.Sprintf("token expired at %s\n\n%s",
+			time.Unix(s.TokenExpiresAt, 0).Format(time.RFC1123), formatTokenExpiredError(s.Host, s.AuthMethod))
+		return
+	}
+
+	token := statusToken(s)
+	if token == "" {
+		s.HasError = true
+		s.Error = fmt.Sprintf("no token for %s. Run 'glab auth login --hostname %s' to authenticate", s.Host, s.Host)
+		return
+	}
+
+	client, err := gitlab.NewClient(token, gitlab.WithBaseURL(apiURL(s.Host)), gitlab.WithHTTPClient(httpClient()))
+	if err != nil {
+		s.HasError = true
+		s.Error = fmt.Sprintf("creating GitLab client: %v", err)
+		return
+	}
+
+	user, resp, err := client.Users.CurrentUser()
+	switch {
+	case err == nil:
+		s.User = user.Username
+		s.Validated = true
+		s.HasError = false
+		s.Error = ""
+	case resp == nil:
+		s.HasError = true
+		s.Error = fmt.Sprintf("unable to reach the API at %s: %v", apiURL(s.Host), err)
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		s.HasError = true
+		s.Error = fmt.Sprintf("token rejected by %s (HTTP %d). Run 'glab auth login --hostname %s' to re-authenticate", s.Host, resp.StatusCode, s.Host)
+	default:
+		s.HasError = true
+		s.Error = fmt.Sprintf("checking token with %s failed (HTTP %d): %v", s.Host, resp.StatusCode, err)
+	}
+}
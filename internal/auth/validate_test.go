@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PhilipKram/gitlab-cli/internal/config"
+)
+
+func TestValidateStatus_Valid(t *testing.T) {
+	t.Setenv("GITLAB_TOKEN", "")
+	testHost := "gitlab.validate-ok.local"
+	writeTestHosts(t, config.HostsConfig{
+		testHost: &config.HostConfig{Token: "good-token", User: "stale", AuthMethod: "pat"},
+	})
+	t.Cleanup(func() { clearTestHosts(t) })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v4/user" || r.Header.Get("PRIVATE-TOKEN") != "good-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":1,"username":"fresh"}`))
+	}))
+	defer srv.Close()
+	interceptTransport(t, testHost, srv)
+
+	s := &Status{Host: testHost, Source: testHost, AuthMethod: "pat", Active: true}
+	ValidateStatus(s)
+	if s.HasError || !s.Validated {
+		t.Fatalf("expected a validated status, got error %q", s.Error)
+	}
+	if s.User != "fresh" {
+		t.Errorf("User = %q, want %q", s.User, "fresh")
+	}
+}
+
+func TestValidateStatus_Rejected(t *testing.T) {
+	t.Setenv("GITLAB_TOKEN", "")
+	testHost := "gitlab.validate-bad.local"
+	writeTestHosts(t, config.HostsConfig{
+		testHost: &config.HostConfig{Token: "revoked", User: "u", AuthMethod: "pat"},
+	})
+	t.Cleanup(func() { clearTestHosts(t) })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"message":"401 Unauthorized"}`))
+	}))
+	defer srv.Close()
+	interceptTransport(t, testHost, srv)
+
+	s := &Status{Host: testHost, Source: testHost, AuthMethod: "pat", Active: true}
+	ValidateStatus(s)
+	if !s.HasError || s.Validated {
+		t.Fatal("expected a failed status")
+	}
+	if !strings.Contains(s.Error, "HTTP 401") {
+		t.Errorf("Error = %q, want it to mention HTTP 401", s.Error)
+	}
+}
+
+func TestValidateStatus_Unreachable(t *testing.T) {
+	t.Setenv("GITLAB_TOKEN", "")
+	srv := httptest.NewServer(http.NotFoundHandler())
+	testHost := strings.TrimPrefix(srv.URL, "http://")
+	srv.Close()
+	writeTestHosts(t, config.HostsConfig{
+		testHost: &config.HostConfig{Token: "token", User: "u", AuthMethod: "pat", APIProtocol: "http"},
+	})
+	t.Cleanup(func() { clearTestHosts(t) })
+
+	s := &Status{Host: testHost, Source: testHost, AuthMethod: "pat", Active: true}
+	ValidateStatus(s)
+	if !s.HasError || !strings.Contains(s.Error, "unable to reach") {
+		t.Errorf("Error = %q, want an unreachable API error", s.Error)
+	}
+}
+
+func TestValidateStatus_ExpiredOAuthToken(t *testing.T) {
+	expiresAt := time.Now().Add(-time.Hour).Unix()
+	s := &Status{Host: "gitlab.expired.local", Source: "gitlab.expired.local", AuthMethod: "oauth", TokenExpiresAt: expiresAt, Active: true}
+	ValidateStatus(s)
+	if !s.HasError || s.Active {
+		t.Fatal("expected an expired token to fail")
+	}
+	if want := time.Unix(expiresAt, 0).Format(time.RFC1123); !strings.Contains(s.Error, want) {
+		t.Errorf("Error = %q, want it to contain the expiry time %q", s.Error, want)
+	}
+}
@@ -0,0 +1,275 @@
+// Package ciconfig reads the jobs of a .gitlab-ci.yml file well enough to
+// run one of them locally: images, scripts, and variables, with "default"
+// and "extends" applied. Includes must already be merged in, as the CI Lint
+// API does.
+package ciconfig
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Job is a runnable job of a CI configuration.
+type Job struct {
+	Name         string
+	Stage        string
+	Image        string
+	Services     []string
+	Variables    map[string]string
+	BeforeScript []string
+	Script       []string
+	AfterScript  []string
+}
+
+// Config is a parsed CI configuration.
+type Config struct {
+	Stages []string
+	Jobs   map[string]*Job
+}
+
+// reserved lists the top-level keywords that are not jobs.
+var reserved = map[string]bool{
+	"default":       true,
+	"include":       true,
+	"stages":        true,
+	"variables":     true,
+	"workflow":      true,
+	"image":         true,
+	"services":      true,
+	"cache":         true,
+	"before_script": true,
+	"after_script":  true,
+	"types":         true,
+	"spec":          true,
+}
+
+// defaultStages are used when the configuration does not declare stages.
+var defaultStages = []string{".pre", "build", "test", "deploy", ".post"}
+
+// Parse reads a CI configuration.
+func Parse(data []byte) (*Config, error) {
+	var doc map[string]any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parsing CI configuration: %w", err)
+	}
+
+	cfg := &Config{Stages: stringList(doc["stages"]), Jobs: map[string]*Job{}}
+	if len(cfg.Stages) == 0 {
+		cfg.Stages = defaultStages
+	}
+
+	// Top-level image and scripts are the deprecated form of "default".
+	defaults := map[string]any{}
+	for _, key := range []string{"image", "services", "before_script", "after_script"} {
+		if v, ok := doc[key]; ok {
+			defaults[key] = v
+		}
+	}
+	if d, ok := doc["default"].(map[string]any); ok {
+		defaults = merge(defaults, d)
+	}
+	globalVars := variables(doc["variables"])
+
+	for name, raw := range doc {
+		if reserved[name] || strings.HasPrefix(name, ".") {
+			continue
+		}
+		def, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		def, err := resolveExtends(doc, name, def, nil)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := def["script"]; !ok {
+			// Only jobs with a script (or a trigger) are jobs; triggers
+			// cannot run locally.
+			continue
+		}
+
+		job := &Job{
+			Name:         name,
+			Stage:        "test",
+			Image:        imageName(defaults["image"]),
+			Services:     services(defaults["services"]),
+			Variables:    map[string]string{},
+			BeforeScript: script(defaults["before_script"]),
+			AfterScript:  script(defaults["after_script"]),
+			Script:       script(def["script"]),
+		}
+		if s, ok := def["stage"].(string); ok {
+			job.Stage = s
+		}
+		if v, ok := def["image"]; ok {
+			job.Image = imageName(v)
+		}
+		if v, ok := def["services"]; ok {
+			job.Services = services(v)
+		}
+		if v, ok := def["before_script"]; ok {
+			job.BeforeScript = script(v)
+		}
+		if v, ok := def["after_script"]; ok {
+			job.AfterScript = script(v)
+		}
+		for k, v := range globalVars {
+			job.Variables[k] = v
+		}
+		for k, v := range variables(def["variables"]) {
+			job.Variables[k] = v
+		}
+		cfg.Jobs[name] = job
+	}
+
+	return cfg, nil
+}
+
+// JobNames returns the names of the jobs in stage order, then by name.
+func (c *Config) JobNames() []string {
+	order := map[string]int{}
+	for i, s := range c.Stages {
+		order[s] = i
+	}
+	names := make([]string, 0, len(c.Jobs))
+	for name := range c.Jobs {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		si, sj := order[c.Jobs[names[i]].Stage], order[c.Jobs[names[j]].Stage]
+		if si != sj {
+			return si < sj
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
+
+// resolveExtends returns def with the jobs it extends merged underneath it.
+// seen guards against cycles.
+func resolveExtends(doc map[string]any, name string, def map[string]any, seen []string) (map[string]any, error) {
+	for _, s := range seen {
+		if s == name {
+			return nil, fmt.Errorf("circular extends in job %q", name)
+		}
+	}
+	seen = append(seen, name)
+
+	parents := stringList(def["extends"])
+	if len(parents) == 0 {
+		return def, nil
+	}
+	merged := map[string]any{}
+	for _, p := range parents {
+		pdef, ok := doc[p].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("job %q extends unknown job %q", name, p)
+		}
+		pdef, err := resolveExtends(doc, p, pdef, seen)
+		if err != nil {
+			return nil, err
+		}
+		merged = merge(merged, pdef)
+	}
+	merged = merge(merged, def)
+	delete(merged, "extends")
+	return merged, nil
+}
+
+// merge deep-merges override into base, as "extends" does: maps are merged
+// key by key and any other value in override replaces the one in base.
+func merge(base, override map[string]any) map[string]any {
+	out := make(map[string]any, len(base)+len(override))
+	for k, v := range base {
+		out[k] = v
+	}
+	for k, v := range override {
+		if bm, ok := out[k].(map[string]any); ok {
+			if om, ok := v.(map[string]any); ok {
+				out[k] = merge(bm, om)
+				continue
+			}
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// script flattens a script, which may be a single string or a list with
+// nested lists (from anchors or !reference).
+func script(v any) []string {
+	switch s := v.(type) {
+	case string:
+		return []string{s}
+	case []any:
+		var lines []string
+		for _, item := range s {
+			lines = append(lines, script(item)...)
+		}
+		return lines
+	}
+	return nil
+}
+
+// imageName returns the image of an "image" value, which is either a name
+// or a map with a name key.
+func imageName(v any) string {
+	switch img := v.(type) {
+	case string:
+		return img
+	case map[string]any:
+		name, _ := img["name"].(string)
+		return name
+	}
+	return ""
+}
+
+// services returns the image names of a "services" list.
+func services(v any) []string {
+	list, _ := v.([]any)
+	var names []string
+	for _, s := range list {
+		if name := imageName(s); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// variables returns a "variables" map. Values may be scalars or maps with a
+// value key.
+func variables(v any) map[string]string {
+	m, _ := v.(map[string]any)
+	vars := make(map[string]string, len(m))
+	for k, raw := range m {
+		if full, ok := raw.(map[string]any); ok {
+			raw = full["value"]
+		}
+		if raw == nil {
+			vars[k] = ""
+			continue
+		}
+		vars[k] = fmt.Sprint(raw)
+	}
+	return vars
+}
+
+// stringList returns a value that is either a string or a list of strings.
+func stringList(v any) []string {
+	switch s := v.(type) {
+	case string:
+		return []string{s}
+	case []any:
+		var list []string
+		for _, item := range s {
+			if str, ok := item.(string); ok {
+				list = append(list, str)
+			}
+		}
+		return list
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package ciconfig
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testConfig = `
+stages: [build, test]
+
+image: golang:1.22
+
+variables:
+  GLOBAL: one
+  DESCRIBED:
+    value: two
+    description: a variable with a description
+
+default:
+  before_script:
+    - echo default
+
+.base:
+  image:
+    name: alpine:3
+  variables:
+    GLOBAL: overridden
+  script:
+    - echo base
+
+compile:
+  stage: build
+  script: go build ./...
+
+unit:
+  extends: .base
+  services: [postgres:16]
+  script:
+    - echo first
+    - [echo nested]
+  after_script:
+    - echo cleanup
+
+bridge:
+  trigger: other/project
+`
+
+func TestParse(t *testing.T) {
+	cfg, err := Parse([]byte(testConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := cfg.JobNames(); !reflect.DeepEqual(got, []string{"compile", "unit"}) {
+		t.Errorf("JobNames() = %v", got)
+	}
+
+	compile := cfg.Jobs["compile"]
+	if compile.Image != "golang:1.22" || compile.Stage != "build" {
+		t.Errorf("compile = %+v", compile)
+	}
+	if !reflect.DeepEqual(compile.BeforeScript, []string{"echo default"}) || !reflect.DeepEqual(compile.Script, []string{"go build ./..."}) {
+		t.Errorf("compile scripts = %q, %q", compile.BeforeScript, compile.Script)
+	}
+	if compile.Variables["GLOBAL"] != "one" || compile.Variables["DESCRIBED"] != "two" {
+		t.Errorf("compile variables = %v", compile.Variables)
+	}
+
+	unit := cfg.Jobs["unit"]
+	if unit.Image != "alpine:3" || unit.Stage != "test" {
+		t.Errorf("unit = %+v", unit)
+	}
+	if !reflect.DeepEqual(unit.Script, []string{"echo first", "echo nested"}) {
+		t.Errorf("unit script = %q", unit.Script)
+	}
+	if !reflect.DeepEqual(unit.AfterScript, []string{"echo cleanup"}) || !reflect.DeepEqual(unit.Services, []string{"postgres:16"}) {
+		t.Errorf("unit = %+v", unit)
+	}
+	if unit.Variables["GLOBAL"] != "overridden" {
+		t.Errorf("unit variables = %v", unit.Variables)
+	}
+}
+
+func TestParse_CircularExtends(t *testing.T) {
+	_, err := Parse([]byte("a:\n  extends: b\n  script: x\nb:\n  extends: a\n  script: y\n"))
+	if err == nil {
+		t.Fatal("expected an error for circular extends")
+	}
+}
@@ -0,0 +1,49 @@
+// Package citemplate provides the starter .gitlab-ci.yml files written by
+// "glab ci init" and detects which one fits a project.
+package citemplate
+
+import (
+	"embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+//go:embed templates/*.yml
+var templates embed.FS
+
+// Languages lists the languages with a built-in template, in detection
+// order.
+var Languages = []string{"go", "node", "python", "docker"}
+
+// markers maps each language to the files whose presence identifies it.
+var markers = map[string][]string{
+	"go":     {"go.mod"},
+	"node":   {"package.json"},
+	"python": {"pyproject.toml", "requirements.txt", "setup.py"},
+	"docker": {"Dockerfile"},
+}
+
+// Template returns the built-in template for lang.
+func Template(lang string) (string, error) {
+	data, err := templates.ReadFile("templates/" + strings.ToLower(lang) + ".yml")
+	if err != nil {
+		return "", fmt.Errorf("no built-in template for %q (available: %s)", lang, strings.Join(Languages, ", "))
+	}
+	return string(data), nil
+}
+
+// Detect returns the language of the project in dir, or "" when none of the
+// known marker files are present. Languages take precedence over docker so a
+// Go project with a Dockerfile gets the Go template.
+func Detect(dir string) string {
+	for _, lang := range Languages {
+		for _, name := range markers[lang] {
+			if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+				return lang
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,48 @@
+package citemplate
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestTemplate(t *testing.T) {
+	for _, lang := range Languages {
+		content, err := Template(lang)
+		if err != nil {
+			t.Fatalf("Template(%q): %v", lang, err)
+		}
+		if !strings.Contains(content, "stages:") {
+			t.Errorf("Template(%q) has no stages", lang)
+		}
+	}
+
+	if _, err := Template("cobol"); err == nil {
+		t.Error("expected an error for an unknown language")
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		files []string
+		want  string
+	}{
+		{nil, ""},
+		{[]string{"go.mod", "Dockerfile"}, "go"},
+		{[]string{"package.json"}, "node"},
+		{[]string{"requirements.txt"}, "python"},
+		{[]string{"Dockerfile"}, "docker"},
+	}
+	for _, tt := range tests {
+		dir := t.TempDir()
+		for _, name := range tt.files {
+			if err := os.WriteFile(filepath.Join(dir, name), nil, 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if got := Detect(dir); got != tt.want {
+			t.Errorf("Detect(%v) = %q, want %q", tt.files, got, tt.want)
+		}
+	}
+}
@@ -102,6 +102,10 @@ func NewTestFactory(t *testing.T) *TestFactory {
 		return tf.Remote, nil
 	}
 
+	tf.Factory.Remotes = func() ([]git.Remote, error) {
+		return []git.Remote{*tf.Remote}, nil
+	}
+
 	tf.Version = "test-version"
 
 	return tf
@@ -0,0 +1,39 @@
+package cmdutil
+
+import (
+	"fmt"
+
+	"github.com/PhilipKram/gitlab-cli/internal/git"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// CheckoutMergeRequest fetches a merge request's head from the project remote
+// into a local branch and checks it out, returning the branch name.
+//
+// The branch defaults to the merge request's source branch, tracking that
+// branch on the remote. Merge requests from forks default to
+// "mr-<iid>-<source-branch>" and track the merge request ref instead, since
+// the source branch does not exist on the project remote.
+func CheckoutMergeRequest(f *Factory, mr *gitlab.BasicMergeRequest, branch string) (string, error) {
+	remote, err := f.Remote()
+	if err != nil {
+		return "", fmt.Errorf("could not determine git remote: %w", err)
+	}
+
+	fromFork := mr.SourceProjectID != 0 && mr.SourceProjectID != mr.TargetProjectID
+	mergeRef := "refs/heads/" + mr.SourceBranch
+	if fromFork {
+		mergeRef = git.MergeRequestRef(mr.IID)
+	}
+	if branch == "" {
+		branch = mr.SourceBranch
+		if fromFork {
+			branch = fmt.Sprintf("mr-%d-%s", mr.IID, mr.SourceBranch)
+		}
+	}
+
+	if err := git.CheckoutMergeRequest(remote.Name, mr.IID, branch, mergeRef); err != nil {
+		return "", err
+	}
+	return branch, nil
+}
@@ -0,0 +1,86 @@
+package cmdutil
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/git"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestCheckoutMergeRequest_BranchNaming(t *testing.T) {
+	run := func(dir string, args ...string) string {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=Test", "GIT_AUTHOR_EMAIL=test@test.com",
+			"GIT_COMMITTER_NAME=Test", "GIT_COMMITTER_EMAIL=test@test.com",
+		)
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v failed: %v\n%s", args, err, out)
+		}
+		return strings.TrimSpace(string(out))
+	}
+
+	server := t.TempDir()
+	run(server, "init", "-b", "main")
+	if err := os.WriteFile(filepath.Join(server, "README.md"), []byte("# Test\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	run(server, "add", "README.md")
+	run(server, "commit", "-m", "Initial commit")
+	head := run(server, "rev-parse", "HEAD")
+	run(server, "update-ref", "refs/merge-requests/3/head", head)
+	run(server, "update-ref", "refs/merge-requests/4/head", head)
+
+	local := t.TempDir()
+	run(local, "clone", "--quiet", server, ".")
+
+	origDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(local); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(origDir) })
+
+	f := &Factory{Remote: func() (*git.Remote, error) { return &git.Remote{Name: "origin"}, nil }}
+
+	sameProject := &gitlab.BasicMergeRequest{IID: 3, SourceBranch: "feature", SourceProjectID: 1, TargetProjectID: 1}
+	branch, err := CheckoutMergeRequest(f, sameProject, "")
+	if err != nil {
+		t.Fatalf("CheckoutMergeRequest: %v", err)
+	}
+	if branch != "feature" {
+		t.Errorf("branch = %q, want feature", branch)
+	}
+	if merge := run(local, "config", "branch.feature.merge"); merge != "refs/heads/feature" {
+		t.Errorf("branch.feature.merge = %q, want refs/heads/feature", merge)
+	}
+
+	fromFork := &gitlab.BasicMergeRequest{IID: 4, SourceBranch: "main", SourceProjectID: 2, TargetProjectID: 1}
+	branch, err = CheckoutMergeRequest(f, fromFork, "")
+	if err != nil {
+		t.Fatalf("CheckoutMergeRequest (fork): %v", err)
+	}
+	if branch != "mr-4-main" {
+		t.Errorf("branch = %q, want mr-4-main", branch)
+	}
+	if merge := run(local, "config", "branch.mr-4-main.merge"); merge != "refs/merge-requests/4/head" {
+		t.Errorf("branch.mr-4-main.merge = %q", merge)
+	}
+
+	branch, err = CheckoutMergeRequest(f, fromFork, "review")
+	if err != nil {
+		t.Fatalf("CheckoutMergeRequest (override): %v", err)
+	}
+	if branch != "review" {
+		t.Errorf("branch = %q, want review", branch)
+	}
+}
@@ -0,0 +1,94 @@
+package cmdutil
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// WriteFileAtomic copies r into path. The data is written to a temporary file
+// in the same directory and renamed into place only once the copy succeeds,
+// so an interrupted download never leaves a partial file behind.
+func WriteFileAtomic(path string, r io.Reader, perm os.FileMode) (int64, error) {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return 0, fmt.Errorf("creating output file: %w", err)
+	}
+	tmpPath := tmp.Name()
+
+	written, err := io.Copy(tmp, r)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmpPath, perm)
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return 0, err
+	}
+	return written, nil
+}
+
+// ProgressReader wraps a reader and reports how many bytes have been read to
+// a terminal, redrawing a single status line at most every 100ms. Call Done
+// once reading has finished to print the final count and end the line.
+type ProgressReader struct {
+	r     io.Reader
+	w     io.Writer
+	label string
+	total int64
+	read  int64
+	last  time.Time
+}
+
+// NewProgressReader returns a ProgressReader that reads from r and writes
+// progress prefixed by label to w. total is the expected size in bytes, or 0
+// if it is unknown.
+func NewProgressReader(r io.Reader, w io.Writer, label string, total int64) *ProgressReader {
+	return &ProgressReader{r: r, w: w, label: label, total: total}
+}
+
+func (p *ProgressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	p.read += int64(n)
+	if now := time.Now(); now.Sub(p.last) >= 100*time.Millisecond {
+		p.last = now
+		p.render()
+	}
+	return n, err
+}
+
+// Done prints the final byte count and terminates the progress line.
+func (p *ProgressReader) Done() {
+	p.render()
+	_, _ = fmt.Fprintln(p.w)
+}
+
+func (p *ProgressReader) render() {
+	if p.total > 0 {
+		pct := float64(p.read) / float64(p.total) * 100
+		_, _ = fmt.Fprintf(p.w, "\r\033[K%s %s / %s (%.0f%%)", p.label, FormatBytes(p.read), FormatBytes(p.total), pct)
+		return
+	}
+	_, _ = fmt.Fprintf(p.w, "\r\033[K%s %s", p.label, FormatBytes(p.read))
+}
+
+// FormatBytes renders a byte count using SI units.
+func FormatBytes(b int64) string {
+	const unit = 1000
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+	div, exp := int64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(b)/float64(div), "kMGTPE"[exp])
+}
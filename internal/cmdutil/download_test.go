@@ -0,0 +1,108 @@
+package cmdutil
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type failingReader struct{ n int }
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if r.n == 0 {
+		return 0, errors.New("connection reset")
+	}
+	r.n--
+	return copy(p, "partial"), nil
+}
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "out.txt")
+
+	n, err := WriteFileAtomic(path, strings.NewReader("hello"), 0o644)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != 5 {
+		t.Errorf("expected 5 bytes written, got %d", n)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "hello" {
+		t.Errorf("expected %q, got %q", "hello", data)
+	}
+}
+
+func TestWriteFileAtomic_ErrorLeavesNoFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "out.txt")
+
+	_, err := WriteFileAtomic(path, &failingReader{n: 2}, 0o644)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("expected no files left behind, found %d", len(entries))
+	}
+}
+
+func TestWriteFileAtomic_KeepsExistingFileOnError(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "out.txt")
+	if err := os.WriteFile(path, []byte("original"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := WriteFileAtomic(path, io.MultiReader(strings.NewReader("new"), &failingReader{}), 0o644); err == nil {
+		t.Fatal("expected error")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "original" {
+		t.Errorf("expected existing file to be untouched, got %q", data)
+	}
+}
+
+func TestProgressReader(t *testing.T) {
+	var progress strings.Builder
+	pr := NewProgressReader(strings.NewReader(strings.Repeat("x", 2500)), &progress, "Downloading", 0)
+
+	n, err := io.Copy(io.Discard, pr)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != 2500 {
+		t.Errorf("expected 2500 bytes, got %d", n)
+	}
+	pr.Done()
+
+	out := progress.String()
+	if !strings.HasSuffix(out, "Downloading 2.5 kB\n") {
+		t.Errorf("unexpected progress output: %q", out)
+	}
+}
+
+func TestProgressReader_WithTotal(t *testing.T) {
+	var progress strings.Builder
+	pr := NewProgressReader(strings.NewReader("hello"), &progress, "Downloading", 10)
+	_, _ = io.Copy(io.Discard, pr)
+	pr.Done()
+
+	if !strings.Contains(progress.String(), "5 B / 10 B (50%)") {
+		t.Errorf("unexpected progress output: %q", progress.String())
+	}
+}
@@ -0,0 +1,69 @@
+package cmdutil
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/config"
+)
+
+// DetermineEditor returns the editor command to use for composing text. The
+// "editor" config key takes precedence over the GIT_EDITOR, VISUAL, and
+// EDITOR environment variables.
+func DetermineEditor(cfg *config.Config) string {
+	if cfg != nil && cfg.Editor != "" {
+		return cfg.Editor
+	}
+	for _, env := range []string{"GIT_EDITOR", "VISUAL", "EDITOR"} {
+		if e := os.Getenv(env); e != "" {
+			return e
+		}
+	}
+	if runtime.GOOS == "windows" {
+		return "notepad"
+	}
+	return "vi"
+}
+
+// EditText opens editor on a temporary file pre-filled with initial and
+// returns the saved contents with trailing whitespace removed. The editor
+// command may include arguments, e.g. "code --wait".
+func EditText(editor, initial string, in io.Reader, out, errOut io.Writer) (string, error) {
+	args := strings.Fields(editor)
+	if len(args) == 0 {
+		return "", fmt.Errorf("no editor configured")
+	}
+
+	tmp, err := os.CreateTemp("", "glab-*.md")
+	if err != nil {
+		return "", fmt.Errorf("creating temporary file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	defer func() { _ = os.Remove(tmpPath) }()
+
+	if _, err := tmp.WriteString(initial); err != nil {
+		_ = tmp.Close()
+		return "", fmt.Errorf("writing temporary file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("writing temporary file: %w", err)
+	}
+
+	c := exec.Command(args[0], append(args[1:], tmpPath)...)
+	c.Stdin = in
+	c.Stdout = out
+	c.Stderr = errOut
+	if err := c.Run(); err != nil {
+		return "", fmt.Errorf("running editor %q: %w", editor, err)
+	}
+
+	data, err := os.ReadFile(tmpPath)
+	if err != nil {
+		return "", fmt.Errorf("reading edited file: %w", err)
+	}
+	return strings.TrimRight(string(data), " \t\r\n"), nil
+}
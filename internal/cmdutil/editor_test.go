@@ -0,0 +1,68 @@
+package cmdutil
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/config"
+)
+
+func TestDetermineEditor(t *testing.T) {
+	t.Setenv("GIT_EDITOR", "")
+	t.Setenv("VISUAL", "")
+	t.Setenv("EDITOR", "nano")
+
+	if got := DetermineEditor(&config.Config{Editor: "code --wait"}); got != "code --wait" {
+		t.Errorf("expected config editor, got %q", got)
+	}
+	if got := DetermineEditor(&config.Config{}); got != "nano" {
+		t.Errorf("expected $EDITOR, got %q", got)
+	}
+
+	t.Setenv("VISUAL", "emacs")
+	if got := DetermineEditor(nil); got != "emacs" {
+		t.Errorf("expected $VISUAL to take precedence over $EDITOR, got %q", got)
+	}
+
+	t.Setenv("GIT_EDITOR", "vim")
+	if got := DetermineEditor(nil); got != "vim" {
+		t.Errorf("expected $GIT_EDITOR to take precedence, got %q", got)
+	}
+}
+
+func TestEditText(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script as editor")
+	}
+
+	script := filepath.Join(t.TempDir(), "editor.sh")
+	content := "#!/bin/sh\nprintf 'added line\\n\\n' >> \"$1\"\n"
+	if err := os.WriteFile(script, []byte(content), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := EditText(script, "initial\n", strings.NewReader(""), &bytes.Buffer{}, &bytes.Buffer{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "initial\nadded line" {
+		t.Errorf("unexpected text %q", got)
+	}
+}
+
+func TestEditText_EditorFails(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses false as editor")
+	}
+
+	if _, err := EditText("false", "", strings.NewReader(""), &bytes.Buffer{}, &bytes.Buffer{}); err == nil {
+		t.Fatal("expected error when editor exits non-zero")
+	}
+	if _, err := EditText("", "", strings.NewReader(""), &bytes.Buffer{}, &bytes.Buffer{}); err == nil {
+		t.Fatal("expected error for empty editor")
+	}
+}
@@ -0,0 +1,199 @@
+package cmdutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/itchyny/gojq"
+	"github.com/spf13/cobra"
+)
+
+// AddExportFlags adds the --jq and --template flags to a command that prints
+// its results with FormatAndPrint or FormatAndStream. Either flag implies JSON
+// output, which is then filtered or rendered before it is written.
+func (f *Factory) AddExportFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&f.jqExpr, "jq", "q", "", "Filter JSON output using a jq expression")
+	cmd.Flags().StringVar(&f.template, "template", "", "Format JSON output using a Go template")
+}
+
+// ExportRequested reports whether --jq or --template was given, in which case
+// commands must hand their data to FormatAndPrint instead of rendering their
+// own table.
+func (f *Factory) ExportRequested() bool {
+	return f.jqExpr != "" || f.template != ""
+}
+
+// export writes data filtered through the --jq expression or rendered with
+// the --template template.
+func (f *Factory) export(data interface{}) error {
+	if f.jqExpr != "" && f.template != "" {
+		return fmt.Errorf("--jq and --template cannot be used together")
+	}
+
+	// Round-trip through JSON so expressions and templates see the same
+	// field names as --format json.
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return err
+	}
+
+	if f.jqExpr != "" {
+		return filterJSON(f.IOStreams.Out, value, f.jqExpr)
+	}
+	return executeTemplate(f.IOStreams.Out, value, f.template)
+}
+
+// filterJSON runs a jq expression against value. String results are printed
+// without quotes so they can be used directly in shell scripts.
+func filterJSON(out io.Writer, value interface{}, expr string) error {
+	query, err := gojq.Parse(expr)
+	if err != nil {
+		return fmt.Errorf("invalid --jq expression: %w", err)
+	}
+	code, err := gojq.Compile(query)
+	if err != nil {
+		return fmt.Errorf("invalid --jq expression: %w", err)
+	}
+
+	iter := code.Run(value)
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			return nil
+		}
+		if err, ok := v.(error); ok {
+			if haltErr, ok := err.(*gojq.HaltError); ok && haltErr.Value() == nil {
+				return nil
+			}
+			return err
+		}
+		if s, ok := v.(string); ok {
+			if _, err := fmt.Fprintln(out, s); err != nil {
+				return err
+			}
+			continue
+		}
+		line, err := gojq.Marshal(v)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintln(out, string(line)); err != nil {
+			return err
+		}
+	}
+}
+
+// executeTemplate renders value with a Go text/template.
+func executeTemplate(out io.Writer, value interface{}, text string) error {
+	tmpl, err := template.New("output").Funcs(templateFuncs()).Parse(text)
+	if err != nil {
+		return fmt.Errorf("invalid --template: %w", err)
+	}
+	if err := tmpl.Execute(out, value); err != nil {
+		return fmt.Errorf("executing --template: %w", err)
+	}
+	return nil
+}
+
+// templateFuncs returns the helper functions available to --template.
+func templateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"json": func(v interface{}) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+		"join": func(sep string, v interface{}) string {
+			var parts []string
+			for _, item := range toSlice(v) {
+				parts = append(parts, fmt.Sprint(item))
+			}
+			return strings.Join(parts, sep)
+		},
+		"pluck": func(field string, v interface{}) []interface{} {
+			var values []interface{}
+			for _, item := range toSlice(v) {
+				if m, ok := item.(map[string]interface{}); ok {
+					values = append(values, m[field])
+				}
+			}
+			return values
+		},
+		"truncate": func(width int, v interface{}) string {
+			s := fmt.Sprint(v)
+			r := []rune(s)
+			if width <= 0 || len(r) <= width {
+				return s
+			}
+			if width <= 3 {
+				return string(r[:width])
+			}
+			return string(r[:width-3]) + "..."
+		},
+		"timeago": func(v interface{}) string {
+			s, ok := v.(string)
+			if !ok {
+				return ""
+			}
+			t, err := time.Parse(time.RFC3339, s)
+			if err != nil {
+				return s
+			}
+			return relativeTime(time.Since(t))
+		},
+	}
+}
+
+// toSlice converts a decoded JSON array to a slice; any other value becomes a
+// single-element slice.
+func toSlice(v interface{}) []interface{} {
+	if v == nil {
+		return nil
+	}
+	if s, ok := v.([]interface{}); ok {
+		return s
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array {
+		items := make([]interface{}, rv.Len())
+		for i := range items {
+			items[i] = rv.Index(i).Interface()
+		}
+		return items
+	}
+	return []interface{}{v}
+}
+
+// relativeTime formats a duration in the same style as the table output,
+// e.g. "3 hours ago".
+func relativeTime(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return pluralAgo(int(d.Minutes()), "minute")
+	case d < 24*time.Hour:
+		return pluralAgo(int(d.Hours()), "hour")
+	case d < 30*24*time.Hour:
+		return pluralAgo(int(d.Hours()/24), "day")
+	case d < 365*24*time.Hour:
+		return pluralAgo(int(d.Hours()/24/30), "month")
+	default:
+		return pluralAgo(int(d.Hours()/24/365), "year")
+	}
+}
+
+func pluralAgo(n int, unit string) string {
+	if n == 1 {
+		return "1 " + unit + " ago"
+	}
+	return fmt.Sprintf("%d %ss ago", n, unit)
+}
@@ -0,0 +1,104 @@
+package cmdutil
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type exportItem struct {
+	ID     int      `json:"id"`
+	Title  string   `json:"title"`
+	Labels []string `json:"labels"`
+}
+
+func newExportFactory(t *testing.T, args ...string) (*Factory, *bytes.Buffer) {
+	t.Helper()
+	var out bytes.Buffer
+	f := &Factory{
+		IOStreams: &iostreams.IOStreams{Out: &out, ErrOut: &bytes.Buffer{}},
+	}
+	cmd := &cobra.Command{Use: "test"}
+	f.AddExportFlags(cmd)
+	if err := cmd.ParseFlags(args); err != nil {
+		t.Fatalf("parsing flags: %v", err)
+	}
+	return f, &out
+}
+
+func TestFormatAndPrint_JQ(t *testing.T) {
+	f, out := newExportFactory(t, "--jq", ".[] | select(.id > 1) | .title")
+	if !f.ExportRequested() {
+		t.Fatal("expected ExportRequested to be true")
+	}
+
+	items := []exportItem{{ID: 1, Title: "first"}, {ID: 2, Title: "second"}, {ID: 3, Title: "third"}}
+	if err := f.FormatAndPrint(items, "table", false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := out.String(); got != "second\nthird\n" {
+		t.Errorf("unexpected output: %q", got)
+	}
+}
+
+func TestFormatAndPrint_JQNonString(t *testing.T) {
+	f, out := newExportFactory(t, "-q", "{id, labels}")
+
+	if err := f.FormatAndPrint(exportItem{ID: 7, Labels: []string{"bug"}}, "", false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := out.String(); got != "{\"id\":7,\"labels\":[\"bug\"]}\n" {
+		t.Errorf("unexpected output: %q", got)
+	}
+}
+
+func TestFormatAndPrint_Template(t *testing.T) {
+	f, out := newExportFactory(t, "--template", `{{range .}}#{{.id}} {{truncate 8 .title}} [{{join ", " .labels}}]{{"\n"}}{{end}}`)
+
+	items := []exportItem{{ID: 1, Title: "a very long title", Labels: []string{"bug", "ui"}}}
+	if err := f.FormatAndPrint(items, "table", false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := out.String(); got != "#1 a ver... [bug, ui]\n" {
+		t.Errorf("unexpected output: %q", got)
+	}
+}
+
+func TestFormatAndPrint_ExportErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"invalid jq", []string{"--jq", ".[] |"}, "invalid --jq expression"},
+		{"invalid template", []string{"--template", "{{.id"}, "invalid --template"},
+		{"both", []string{"--jq", ".", "--template", "{{.}}"}, "cannot be used together"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _ := newExportFactory(t, tt.args...)
+			err := f.FormatAndPrint([]exportItem{}, "table", false)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestResolveFormat_Export(t *testing.T) {
+	f, _ := newExportFactory(t, "--jq", ".")
+	got, err := f.ResolveFormat("plain", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "json" {
+		t.Errorf("expected json format with --jq, got %q", got)
+	}
+}
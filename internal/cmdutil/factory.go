@@ -2,12 +2,13 @@ package cmdutil
 
 import (
 	"fmt"
-	"strings"
+	"sync"
 
 	"github.com/PhilipKram/gitlab-cli/internal/api"
 	"github.com/PhilipKram/gitlab-cli/internal/config"
 	"github.com/PhilipKram/gitlab-cli/internal/formatter"
 	"github.com/PhilipKram/gitlab-cli/internal/git"
+	"github.com/PhilipKram/gitlab-cli/internal/tableprinter"
 	"github.com/PhilipKram/gitlab-cli/pkg/iostreams"
 	"github.com/spf13/cobra"
 )
@@ -18,25 +19,47 @@ type Factory struct {
 	Config    func() (*config.Config, error)
 	Client    func() (*api.Client, error)
 	Remote    func() (*git.Remote, error)
+	Remotes   func() ([]git.Remote, error)
 	Version   string
 
-	// repoOverride is set via --repo flag (HOST/OWNER/REPO format)
+	// repoOverride is set via the --repo flag. overrideHost is empty when
+	// it does not name a host; overridePath is a project path or ID.
 	repoOverride string
 	overrideHost string
 	overridePath string
 
 	// outputFormat tracks the requested output format for error formatting
 	outputFormat string
+
+	// jqExpr and template are set by the --jq and --template flags added
+	// with AddExportFlags.
+	jqExpr   string
+	template string
+
+	// projectConfig caches the current repository's .glab.yml.
+	projectOnce   sync.Once
+	projectConfig *config.ProjectConfig
+	projectErr    error
 }
 
-// SetRepoOverride parses a HOST/OWNER/REPO string and stores it.
-func (f *Factory) SetRepoOverride(repo string) {
-	f.repoOverride = repo
-	parts := strings.SplitN(repo, "/", 2)
-	if len(parts) == 2 {
-		f.overrideHost = parts[0]
-		f.overridePath = parts[1]
+// SetRepoOverride selects the project commands operate on instead of the
+// one of the current git remote. repo is parsed with ParseRepo; without a
+// host, the project is looked up on the current remote's or default host.
+func (f *Factory) SetRepoOverride(repo string) error {
+	host, path, err := ParseRepo(repo)
+	if err != nil {
+		return err
 	}
+	f.repoOverride = repo
+	f.overrideHost = host
+	f.overridePath = path
+	return nil
+}
+
+// HasRepoOverride reports whether --repo, or the repo of a .glab.yml,
+// selects the project instead of the current git remote.
+func (f *Factory) HasRepoOverride() bool {
+	return f.repoOverride != ""
 }
 
 // NewFactory creates a Factory with default implementations.
@@ -46,7 +69,16 @@ func NewFactory() *Factory {
 	}
 
 	f.Config = func() (*config.Config, error) {
-		return config.Load()
+		cfg, err := config.Load()
+		if err != nil {
+			return nil, err
+		}
+		pc, err := f.ProjectConfig()
+		if err != nil {
+			return nil, err
+		}
+		cfg.MergeProject(pc)
+		return cfg, nil
 	}
 
 	f.Client = func() (*api.Client, error) {
@@ -73,22 +105,93 @@ func NewFactory() *Factory {
 	}
 
 	f.Remote = func() (*git.Remote, error) {
-		cfg, err := f.Config()
-		if err != nil {
-			cfg = &config.Config{GitRemote: "origin"}
-		}
-		remoteName := cfg.GitRemote
-		if remoteName == "" {
-			remoteName = "origin"
+		// A remote pinned with "repo set-default" wins over git_remote.
+		if pinned, err := git.DefaultRemote(); err == nil && pinned != "" {
+			return git.FindRemote(pinned, config.DefaultHost())
 		}
-		return git.FindRemote(remoteName, config.DefaultHost())
+		return git.FindRemote(f.gitRemoteName(), config.DefaultHost())
 	}
 
+	f.Remotes = git.Remotes
+
 	return f
 }
 
-// FullProjectPath returns the "owner/repo" path from the current git remote,
-// or from the --repo override if set.
+// gitRemoteName returns the name of the configured git_remote, which is
+// where branches are pushed.
+func (f *Factory) gitRemoteName() string {
+	if cfg, err := f.Config(); err == nil && cfg.GitRemote != "" {
+		return cfg.GitRemote
+	}
+	return "origin"
+}
+
+// ForkRemotes returns the remotes of a fork-based setup: head, the fork
+// branches are pushed to (the configured git_remote), and base, the project
+// merge requests are proposed to (the remote pinned with "repo set-default"
+// or, failing that, the "upstream" remote). ok is false when there is no
+// such pair of distinct GitLab projects, or when --repo selects the project.
+func (f *Factory) ForkRemotes() (head, base *git.Remote, ok bool) {
+	if f.repoOverride != "" || f.Remotes == nil {
+		return nil, nil, false
+	}
+	remotes, err := f.Remotes()
+	if err != nil {
+		return nil, nil, false
+	}
+
+	headName := f.gitRemoteName()
+	baseName := "upstream"
+	if pinned, err := git.DefaultRemote(); err == nil && pinned != "" {
+		baseName = pinned
+	}
+	for i := range remotes {
+		switch remotes[i].Name {
+		case headName:
+			head = &remotes[i]
+		case baseName:
+			base = &remotes[i]
+		}
+	}
+	if head == nil || base == nil || head.Owner == "" || base.Owner == "" || head.Host != base.Host {
+		return nil, nil, false
+	}
+	if head.Owner+"/"+head.Repo == base.Owner+"/"+base.Repo {
+		return nil, nil, false
+	}
+	return head, base, true
+}
+
+// ProjectConfig returns the .glab.yml at the root of the current git
+// repository, or nil when there is none or glab runs outside a repository.
+// The file is read once per process.
+func (f *Factory) ProjectConfig() (*config.ProjectConfig, error) {
+	f.projectOnce.Do(func() {
+		dir, err := git.TopLevelDir()
+		if err != nil {
+			return
+		}
+		f.projectConfig, f.projectErr = config.LoadProjectConfig(dir)
+	})
+	return f.projectConfig, f.projectErr
+}
+
+// Host returns the GitLab host commands talk to: the --repo override's host,
+// the current git remote's host, or the default host, without requiring
+// authentication.
+func (f *Factory) Host() string {
+	if f.overrideHost != "" {
+		return f.overrideHost
+	}
+	if remote, err := f.Remote(); err == nil && remote.Host != "" {
+		return remote.Host
+	}
+	return config.DefaultHost()
+}
+
+// FullProjectPath returns the project path, such as "group/sub/project",
+// from the current git remote, or the project path or ID of the --repo
+// override if set.
 func (f *Factory) FullProjectPath() (string, error) {
 	if f.overridePath != "" {
 		return f.overridePath, nil
@@ -113,6 +216,10 @@ func AddFormatFlag(cmd *cobra.Command, format *string, jsonFlag *bool) {
 // FormatAndPrint formats and prints data according to format flags.
 // It handles backward compatibility for the --json flag.
 func (f *Factory) FormatAndPrint(data interface{}, format string, jsonFlag bool) error {
+	if f.ExportRequested() {
+		return f.export(data)
+	}
+
 	outputFormat, err := f.ResolveFormat(format, jsonFlag)
 	if err != nil {
 		return err
@@ -122,28 +229,69 @@ func (f *Factory) FormatAndPrint(data interface{}, format string, jsonFlag bool)
 	if fmtr == nil {
 		return fmt.Errorf("invalid format: %s", format)
 	}
+	if tf, ok := fmtr.(*formatter.TableFormatter); ok {
+		tf.Hyperlinks = f.HyperlinksEnabled()
+		if f.IOStreams.IsTerminal() {
+			tf.Terminal = true
+			tf.Width = f.IOStreams.TerminalWidth()
+		}
+		tf.Color = f.IOStreams.ColorScheme()
+	}
 
 	return fmtr.Format(data)
 }
 
+// NewTablePrinter returns a table printer for stdout. When stdout is a
+// terminal it prints headers and fits rows to the terminal width; otherwise
+// it produces the tab-separated output scripts rely on.
+func (f *Factory) NewTablePrinter() *tableprinter.TablePrinter {
+	tp := tableprinter.New(f.IOStreams.Out)
+	if f.IOStreams.IsTerminal() {
+		tp.SetTerminal(f.IOStreams.TerminalWidth())
+	}
+	return tp
+}
+
 // ResolveFormat resolves the output format from the format string and deprecated --json flag.
 // It returns the validated OutputFormat and an error if the format is invalid.
-// If jsonFlag is true, a deprecation warning is printed to stderr.
+// If jsonFlag is true, a deprecation warning is printed to stderr. When --jq or
+// --template is set the format is always JSON.
 func (f *Factory) ResolveFormat(format string, jsonFlag bool) (formatter.OutputFormat, error) {
+	if f.ExportRequested() {
+		return formatter.JSONFormat, nil
+	}
 	if jsonFlag {
 		_, _ = fmt.Fprintf(f.IOStreams.ErrOut, "Warning: --json is deprecated, use --format=json instead\n")
 		format = "json"
 	}
 	outputFormat := formatter.OutputFormat(format)
-	if outputFormat != formatter.JSONFormat && outputFormat != formatter.TableFormat && outputFormat != formatter.PlainFormat {
-		return "", fmt.Errorf("invalid format: %s (must be json, table, or plain)", format)
+	switch outputFormat {
+	case formatter.JSONFormat, formatter.TableFormat, formatter.PlainFormat, formatter.YAMLFormat, formatter.CSVFormat:
+	default:
+		return "", fmt.Errorf("invalid format: %s (must be json, table, plain, yaml, or csv)", format)
 	}
 	return outputFormat, nil
 }
 
 // FormatAndStream handles the streaming output pattern common to list commands.
 // It converts a Result channel to the streaming formatter output.
+// With --jq or --template the results are collected first so the expression
+// sees a single array, as it would without streaming.
 func FormatAndStream[T any](f *Factory, results <-chan api.Result[T], outputFormat formatter.OutputFormat, limit int, entityName string) error {
+	if f.ExportRequested() {
+		items := []T{}
+		for result := range results {
+			if result.Error != nil {
+				return fmt.Errorf("fetching %s: %w", entityName, result.Error)
+			}
+			items = append(items, result.Item)
+			if limit > 0 && len(items) >= limit {
+				break
+			}
+		}
+		return f.export(items)
+	}
+
 	items := make(chan interface{}, 100)
 
 	go func() {
@@ -166,10 +314,30 @@ func FormatAndStream[T any](f *Factory, results <-chan api.Result[T], outputForm
 	if streamFmtr == nil {
 		return fmt.Errorf("invalid format: %s", string(outputFormat))
 	}
+	if tf, ok := streamFmtr.(*formatter.StreamingTableFormatter); ok {
+		tf.Hyperlinks = f.HyperlinksEnabled()
+	}
 
 	return streamFmtr.FormatStream(items)
 }
 
+// HyperlinksEnabled reports whether output should contain clickable terminal
+// links. The "hyperlinks" config key can force them on ("always") or off
+// ("never"); by default they are enabled for terminals known to support them.
+func (f *Factory) HyperlinksEnabled() bool {
+	if f.Config != nil {
+		if cfg, err := f.Config(); err == nil {
+			switch cfg.Hyperlinks {
+			case "always":
+				return true
+			case "never":
+				return false
+			}
+		}
+	}
+	return f.IOStreams.SupportsHyperlinks()
+}
+
 // SetOutputFormat sets the output format for the command execution.
 // This is used to determine how errors should be formatted.
 func (f *Factory) SetOutputFormat(format string) {
@@ -3,9 +3,13 @@ package cmdutil
 import (
 	"bytes"
 	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"testing"
 
 	"github.com/PhilipKram/gitlab-cli/internal/api"
+	"github.com/PhilipKram/gitlab-cli/internal/config"
 	"github.com/PhilipKram/gitlab-cli/internal/formatter"
 	"github.com/PhilipKram/gitlab-cli/internal/git"
 	"github.com/PhilipKram/gitlab-cli/pkg/iostreams"
@@ -39,6 +43,7 @@ func TestSetRepoOverride(t *testing.T) {
 		wantHost     string
 		wantPath     string
 		wantOverride string
+		wantErr      bool
 	}{
 		{
 			name:         "valid host/path",
@@ -55,18 +60,33 @@ func TestSetRepoOverride(t *testing.T) {
 			wantOverride: "gitlab.example.com/group/subgroup/project",
 		},
 		{
-			name:         "no slash - single segment",
-			repo:         "noslash",
+			name:         "relative path",
+			repo:         "group/subgroup/project",
 			wantHost:     "",
-			wantPath:     "",
-			wantOverride: "noslash",
+			wantPath:     "group/subgroup/project",
+			wantOverride: "group/subgroup/project",
+		},
+		{
+			name:         "web URL",
+			repo:         "https://gitlab.example.com/group/project",
+			wantHost:     "gitlab.example.com",
+			wantPath:     "group/project",
+			wantOverride: "https://gitlab.example.com/group/project",
+		},
+		{
+			name:    "no slash - single segment",
+			repo:    "noslash",
+			wantErr: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			f := &Factory{}
-			f.SetRepoOverride(tt.repo)
+			err := f.SetRepoOverride(tt.repo)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SetRepoOverride error = %v, wantErr %v", err, tt.wantErr)
+			}
 
 			if f.repoOverride != tt.wantOverride {
 				t.Errorf("repoOverride = %q, want %q", f.repoOverride, tt.wantOverride)
@@ -199,11 +219,11 @@ func TestAddFormatFlag(t *testing.T) {
 
 func TestResolveFormat(t *testing.T) {
 	tests := []struct {
-		name      string
-		format    string
-		jsonFlag  bool
-		want      formatter.OutputFormat
-		wantErr   bool
+		name       string
+		format     string
+		jsonFlag   bool
+		want       formatter.OutputFormat
+		wantErr    bool
 		wantStderr string
 	}{
 		{
@@ -403,3 +423,120 @@ func TestNewFactory_ClientFallback(t *testing.T) {
 	}
 }
 
+func TestHyperlinksEnabled(t *testing.T) {
+	t.Setenv("FORCE_HYPERLINK", "")
+	out := &bytes.Buffer{}
+	f := &Factory{IOStreams: &iostreams.IOStreams{Out: out, ErrOut: &bytes.Buffer{}}}
+
+	// Not a terminal and no config: disabled.
+	if f.HyperlinksEnabled() {
+		t.Error("expected hyperlinks to be disabled for non-terminal output")
+	}
+
+	f.Config = func() (*config.Config, error) { return &config.Config{Hyperlinks: "always"}, nil }
+	if !f.HyperlinksEnabled() {
+		t.Error("expected hyperlinks=always to enable hyperlinks")
+	}
+
+	t.Setenv("FORCE_HYPERLINK", "1")
+	f.Config = func() (*config.Config, error) { return &config.Config{Hyperlinks: "never"}, nil }
+	if f.HyperlinksEnabled() {
+		t.Error("expected hyperlinks=never to take precedence over FORCE_HYPERLINK")
+	}
+}
+
+func TestNewFactory_ProjectConfig(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Skipf("git init failed: %v: %s", err, out)
+	}
+	content := "repo: gitlab.example.com/team/app\nmr:\n  target_branch: develop\n  reviewers: [alice]\n"
+	if err := os.WriteFile(filepath.Join(dir, ".glab.yml"), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "sub"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(filepath.Join(dir, "sub"))
+
+	f := NewFactory()
+	pc, err := f.ProjectConfig()
+	if err != nil {
+		t.Fatalf("ProjectConfig: %v", err)
+	}
+	if pc == nil || pc.Repo != "gitlab.example.com/team/app" {
+		t.Fatalf("expected the repository's .glab.yml, got %+v", pc)
+	}
+
+	cfg, err := f.Config()
+	if err != nil {
+		t.Fatalf("Config: %v", err)
+	}
+	if cfg.Defaults.MRTargetBranch != "develop" || cfg.Defaults.MRReviewers != "alice" {
+		t.Errorf("expected project defaults to be merged, got %+v", cfg.Defaults)
+	}
+}
+
+func TestNewFactory_ProjectConfigInvalid(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Skipf("git init failed: %v: %s", err, out)
+	}
+	if err := os.WriteFile(filepath.Join(dir, ".glab.yml"), []byte("mr:\n  squash: maybe\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(dir)
+
+	if _, err := NewFactory().Config(); err == nil {
+		t.Error("expected an error for an invalid .glab.yml")
+	}
+}
+
+func TestNewFactory_ForkRemotes(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+	dir := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "-q", dir},
+		{"-C", dir, "remote", "add", "origin", "git@gitlab.com:me/app.git"},
+		{"-C", dir, "remote", "add", "upstream", "https://gitlab.com/team/app.git"},
+	} {
+		if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+			t.Skipf("git %v failed: %v: %s", args, err, out)
+		}
+	}
+	t.Chdir(dir)
+
+	f := NewFactory()
+	head, base, ok := f.ForkRemotes()
+	if !ok || head.Name != "origin" || base.Name != "upstream" {
+		t.Fatalf("ForkRemotes = %+v, %+v, %v; want origin and upstream", head, base, ok)
+	}
+	if remote, err := f.Remote(); err != nil || remote.Name != "origin" {
+		t.Errorf("Remote = %+v, %v; want origin", remote, err)
+	}
+
+	// Pinning a default makes it the remote commands operate on.
+	if err := git.SetDefaultRemote("upstream"); err != nil {
+		t.Fatalf("SetDefaultRemote: %v", err)
+	}
+	if remote, err := f.Remote(); err != nil || remote.Name != "upstream" {
+		t.Errorf("Remote = %+v, %v; want the pinned upstream", remote, err)
+	}
+	if err := git.SetDefaultRemote("origin"); err != nil {
+		t.Fatalf("SetDefaultRemote: %v", err)
+	}
+	if _, _, ok := f.ForkRemotes(); ok {
+		t.Error("expected no fork setup with origin pinned")
+	}
+
+	// --repo selects a single project.
+	f = NewFactory()
+	if err := f.SetRepoOverride("team/app"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, ok := f.ForkRemotes(); ok {
+		t.Error("expected no fork setup with --repo")
+	}
+}
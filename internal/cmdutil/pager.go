@@ -0,0 +1,20 @@
+package cmdutil
+
+import (
+	"os"
+
+	"github.com/PhilipKram/gitlab-cli/internal/config"
+)
+
+// DeterminePager returns the pager command for long output. GLAB_PAGER takes
+// precedence over the "pager" config key, which takes precedence over PAGER.
+// An empty result means output is not paged.
+func DeterminePager(cfg *config.Config) string {
+	if p, ok := os.LookupEnv("GLAB_PAGER"); ok {
+		return p
+	}
+	if cfg != nil && cfg.Pager != "" {
+		return cfg.Pager
+	}
+	return os.Getenv("PAGER")
+}
@@ -0,0 +1,27 @@
+package cmdutil
+
+import (
+	"os"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/config"
+)
+
+func TestDeterminePager(t *testing.T) {
+	t.Setenv("GLAB_PAGER", "")
+	_ = os.Unsetenv("GLAB_PAGER")
+	t.Setenv("PAGER", "more")
+
+	if got := DeterminePager(&config.Config{Pager: "less -S"}); got != "less -S" {
+		t.Errorf("expected config pager, got %q", got)
+	}
+	if got := DeterminePager(nil); got != "more" {
+		t.Errorf("expected $PAGER, got %q", got)
+	}
+
+	// An empty GLAB_PAGER turns paging off.
+	t.Setenv("GLAB_PAGER", "")
+	if got := DeterminePager(&config.Config{Pager: "less -S"}); got != "" {
+		t.Errorf("expected empty GLAB_PAGER to take precedence, got %q", got)
+	}
+}
@@ -0,0 +1,105 @@
+package cmdutil
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/PhilipKram/gitlab-cli/internal/api"
+)
+
+// ParseRepo parses a project reference as accepted by --repo:
+//
+//	HOST/GROUP/[SUBGROUP/...]PROJECT  gitlab.example.com/group/sub/project
+//	GROUP/[SUBGROUP/...]PROJECT       group/sub/project
+//	web URL                           https://gitlab.com/group/project
+//	SSH URL                           git@gitlab.com:group/project.git
+//	numeric project ID                12345
+//
+// It returns the host, which is empty when repo does not name one, and the
+// project path or ID. A first segment is taken as a host only when it looks
+// like one (it contains a dot or a port, or is "localhost") and is followed
+// by a group and a project; use a URL for a group whose name contains a dot.
+func ParseRepo(repo string) (host, path string, err error) {
+	repo = strings.TrimSpace(repo)
+	if repo == "" {
+		return "", "", fmt.Errorf("invalid repository: empty")
+	}
+	if isProjectID(repo) {
+		return "", repo, nil
+	}
+
+	switch {
+	case strings.Contains(repo, "://"):
+		u, err := url.Parse(repo)
+		if err != nil || u.Host == "" {
+			return "", "", fmt.Errorf("invalid repository URL: %s", repo)
+		}
+		// An HTTP(S) port is part of the instance's address; an SSH port is not.
+		host = u.Host
+		if u.Scheme != "http" && u.Scheme != "https" {
+			host = u.Hostname()
+		}
+		path = u.Path
+		// Web URLs of pages within a project, such as
+		// https://gitlab.com/group/project/-/issues/1, name the project.
+		path, _, _ = strings.Cut(path, "/-/")
+	case isSCPLike(repo):
+		_, rest, _ := strings.Cut(repo, "@")
+		host, path, _ = strings.Cut(rest, ":")
+	default:
+		path = repo
+		if first, rest, ok := strings.Cut(repo, "/"); ok && isHostLike(first) && strings.Contains(rest, "/") {
+			host, path = first, rest
+		}
+	}
+
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	if host == "" && strings.Contains(repo, "://") {
+		return "", "", fmt.Errorf("invalid repository URL: %s", repo)
+	}
+	if !strings.Contains(path, "/") || strings.Contains(path, "//") {
+		return "", "", fmt.Errorf("invalid repository: %q (use [HOST/]GROUP/PROJECT, a project URL, or a numeric project ID)", repo)
+	}
+	return host, path, nil
+}
+
+// RepoClient returns an API client and the project path or ID for repo,
+// which is parsed with ParseRepo. When repo does not name a host, the
+// Factory's client is used.
+func (f *Factory) RepoClient(repo string) (*api.Client, string, error) {
+	host, path, err := ParseRepo(repo)
+	if err != nil {
+		return nil, "", err
+	}
+	if host != "" {
+		client, err := api.NewClient(host)
+		return client, path, err
+	}
+	client, err := f.Client()
+	return client, path, err
+}
+
+// isProjectID reports whether s is a numeric project ID.
+func isProjectID(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// isSCPLike reports whether s is an scp-like SSH URL such as
+// git@gitlab.com:group/project.git.
+func isSCPLike(s string) bool {
+	at := strings.Index(s, "@")
+	colon := strings.Index(s, ":")
+	slash := strings.Index(s, "/")
+	return at > 0 && colon > at && (slash < 0 || colon < slash)
+}
+
+// isHostLike reports whether s looks like a hostname rather than a group.
+func isHostLike(s string) bool {
+	return strings.ContainsAny(s, ".:") || s == "localhost"
+}
@@ -0,0 +1,43 @@
+package cmdutil
+
+import "testing"
+
+func TestParseRepo(t *testing.T) {
+	tests := []struct {
+		repo     string
+		wantHost string
+		wantPath string
+		wantErr  bool
+	}{
+		{repo: "gitlab.com/owner/repo", wantHost: "gitlab.com", wantPath: "owner/repo"},
+		{repo: "gitlab.example.com/a/b/c/d", wantHost: "gitlab.example.com", wantPath: "a/b/c/d"},
+		{repo: "localhost:8080/group/project", wantHost: "localhost:8080", wantPath: "group/project"},
+		{repo: "owner/repo", wantPath: "owner/repo"},
+		{repo: "group/sub/project", wantPath: "group/sub/project"},
+		{repo: "gitlab.com/project", wantPath: "gitlab.com/project"},
+		{repo: "12345", wantPath: "12345"},
+		{repo: "https://gitlab.com/group/sub/repo", wantHost: "gitlab.com", wantPath: "group/sub/repo"},
+		{repo: "https://gitlab.com/group/repo.git", wantHost: "gitlab.com", wantPath: "group/repo"},
+		{repo: "https://gitlab.com/group/repo/-/merge_requests/4", wantHost: "gitlab.com", wantPath: "group/repo"},
+		{repo: "http://gitlab.local:8080/group/repo/", wantHost: "gitlab.local:8080", wantPath: "group/repo"},
+		{repo: "git@gitlab.com:group/sub/repo.git", wantHost: "gitlab.com", wantPath: "group/sub/repo"},
+		{repo: "ssh://git@gitlab.com:2222/group/repo.git", wantHost: "gitlab.com", wantPath: "group/repo"},
+		{repo: "", wantErr: true},
+		{repo: "project", wantErr: true},
+		{repo: "https://gitlab.com/group", wantErr: true},
+		{repo: "group//project", wantErr: true},
+		{repo: "https:///group/project", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.repo, func(t *testing.T) {
+			host, path, err := ParseRepo(tt.repo)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRepo(%q) error = %v, wantErr %v", tt.repo, err, tt.wantErr)
+			}
+			if host != tt.wantHost || path != tt.wantPath {
+				t.Errorf("ParseRepo(%q) = %q, %q; want %q, %q", tt.repo, host, path, tt.wantHost, tt.wantPath)
+			}
+		})
+	}
+}
@@ -3,8 +3,10 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 )
 
@@ -22,6 +24,46 @@ type Config struct {
 	Protocol    string `json:"protocol,omitempty"` // "https" or "ssh"
 	GitRemote   string `json:"git_remote,omitempty"`
 	DefaultHost string `json:"default_host,omitempty"`
+	Hyperlinks  string `json:"hyperlinks,omitempty"` // "auto", "always", or "never"
+
+	HTTPConcurrency string `json:"http_concurrency,omitempty"` // maximum requests in flight
+	Proxy           string `json:"proxy,omitempty"`            // used when HTTPS_PROXY/HTTP_PROXY are unset
+
+	CredentialBackend string `json:"credential_backend,omitempty"` // "file" or "keyring"
+
+	Defaults Defaults `json:"defaults,omitzero"`
+
+	// Aliases maps alias names to their expansions. An expansion starting
+	// with "!" is run by the shell.
+	Aliases map[string]string `json:"aliases,omitempty"`
+
+	// globalDefaults holds the defaults of config.json once a project's
+	// .glab.yml has been merged over Defaults; they are what Set changes and
+	// Save writes.
+	globalDefaults *Defaults
+}
+
+// Defaults holds the values mr create and issue create use when the
+// corresponding flags are not given. They are set with the "defaults."
+// config keys and by a project's .glab.yml.
+type Defaults struct {
+	MRTargetBranch string `json:"mr_target_branch,omitempty"`
+	MRLabels       string `json:"mr_labels,omitempty"`    // comma-separated
+	MRReviewers    string `json:"mr_reviewers,omitempty"` // comma-separated
+	MRSquash       string `json:"mr_squash,omitempty"`    // "true", "false", or unset
+	IssueLabels    string `json:"issue_labels,omitempty"` // comma-separated
+}
+
+// SplitList splits a comma-separated config value into its trimmed,
+// non-empty items.
+func SplitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
 }
 
 // HostConfig stores per-host authentication and settings.
@@ -33,16 +75,27 @@ type HostConfig struct {
 	User           string `json:"user,omitempty"`
 	Protocol       string `json:"protocol,omitempty"`
 	APIHost        string `json:"api_host,omitempty"`
-	AuthMethod     string `json:"auth_method,omitempty"` // "pat" or "oauth"
+	APIProtocol    string `json:"api_protocol,omitempty"` // "https" or "http"
+	AuthMethod     string `json:"auth_method,omitempty"`  // "pat" or "oauth"
 	ClientID       string `json:"client_id,omitempty"`
 	RedirectURI    string `json:"redirect_uri,omitempty"`
 	OAuthScopes    string `json:"oauth_scopes,omitempty"`
 	GitLabVersion  string `json:"gitlab_version,omitempty"`
+	CACert         string `json:"ca_cert,omitempty"` // PEM file trusted in addition to the system roots
+	SkipTLSVerify  bool   `json:"skip_tls_verify,omitempty"`
+
+	// CredentialStore is "keyring" when Token and RefreshToken are kept in
+	// the OS keyring rather than in hosts.json.
+	CredentialStore string `json:"credential_store,omitempty"`
+
+	// keyringErr is set when the keyring tokens of the host could not be
+	// read, so they are not overwritten by the empty values loaded instead.
+	keyringErr error
 }
 
 // HostKeys returns valid per-host config keys.
 func HostKeys() []string {
-	return []string{"client_id", "redirect_uri", "oauth_scopes", "protocol", "api_host"}
+	return []string{"client_id", "redirect_uri", "oauth_scopes", "protocol", "api_host", "api_protocol", "ca_cert", "skip_tls_verify"}
 }
 
 // GetHostValue returns a per-host config value by key.
@@ -66,6 +119,15 @@ func GetHostValue(host, key string) (string, error) {
 		return hc.Protocol, nil
 	case "api_host":
 		return hc.APIHost, nil
+	case "api_protocol":
+		return hc.APIProtocol, nil
+	case "ca_cert":
+		return hc.CACert, nil
+	case "skip_tls_verify":
+		if !hc.SkipTLSVerify {
+			return "", nil
+		}
+		return "true", nil
 	case "token":
 		return hc.Token, nil
 	case "user":
@@ -96,15 +158,57 @@ func SetHostValue(host, key, value string) error {
 	case "oauth_scopes":
 		hc.OAuthScopes = value
 	case "protocol":
+		if value != "" && value != "https" && value != "ssh" {
+			return fmt.Errorf("invalid value for protocol: %s (must be https or ssh)", value)
+		}
 		hc.Protocol = value
 	case "api_host":
 		hc.APIHost = value
+	case "api_protocol":
+		if value != "" && value != "https" && value != "http" {
+			return fmt.Errorf("invalid value for api_protocol: %s (must be https or http)", value)
+		}
+		hc.APIProtocol = value
+	case "ca_cert":
+		if value != "" {
+			path, err := filepath.Abs(value)
+			if err != nil {
+				return fmt.Errorf("invalid value for ca_cert: %w", err)
+			}
+			if _, err := os.Stat(path); err != nil {
+				return fmt.Errorf("invalid value for ca_cert: %w", err)
+			}
+			value = path
+		}
+		hc.CACert = value
+	case "skip_tls_verify":
+		skip, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for skip_tls_verify: %s (must be true or false)", value)
+		}
+		hc.SkipTLSVerify = skip
 	default:
 		return fmt.Errorf("unknown host config key: %s\nValid keys: %s", key, strings.Join(HostKeys(), ", "))
 	}
 	return SaveHosts(hosts)
 }
 
+// UnsetHostValue removes a per-host config value, so the host falls back to
+// the global or built-in default.
+func UnsetHostValue(host, key string) error {
+	hosts, err := LoadHosts()
+	if err != nil {
+		return err
+	}
+	if _, ok := hosts[host]; !ok {
+		return fmt.Errorf("no configuration for host: %s", host)
+	}
+	if key == "skip_tls_verify" {
+		return SetHostValue(host, key, "false")
+	}
+	return SetHostValue(host, key, "")
+}
+
 // HostsConfig maps hostnames to their configurations.
 type HostsConfig map[string]*HostConfig
 
@@ -120,12 +224,17 @@ func ConfigDir() string {
 	return filepath.Join(home, ".config", appName)
 }
 
-// Load reads the config file from disk.
-func Load() (*Config, error) {
-	cfg := &Config{
+// defaultConfig returns the configuration used for keys that are not set.
+func defaultConfig() *Config {
+	return &Config{
 		Protocol:  "https",
 		GitRemote: "origin",
 	}
+}
+
+// Load reads the config file from disk.
+func Load() (*Config, error) {
+	cfg := defaultConfig()
 	path := filepath.Join(ConfigDir(), configFile)
 	data, err := os.ReadFile(path)
 	if err != nil {
@@ -140,13 +249,23 @@ func Load() (*Config, error) {
 	return cfg, nil
 }
 
+// storedDefaults returns the defaults kept in config.json.
+func (c *Config) storedDefaults() *Defaults {
+	if c.globalDefaults != nil {
+		return c.globalDefaults
+	}
+	return &c.Defaults
+}
+
 // Save writes the config to disk.
 func (c *Config) Save() error {
 	dir := ConfigDir()
 	if err := os.MkdirAll(dir, 0o755); err != nil {
 		return fmt.Errorf("creating config directory: %w", err)
 	}
-	data, err := json.MarshalIndent(c, "", "  ")
+	stored := *c
+	stored.Defaults = *c.storedDefaults()
+	data, err := json.MarshalIndent(&stored, "", "  ")
 	if err != nil {
 		return fmt.Errorf("marshaling config: %w", err)
 	}
@@ -169,6 +288,24 @@ func (c *Config) Get(key string) (string, error) {
 		return c.GitRemote, nil
 	case "default_host":
 		return c.DefaultHost, nil
+	case "hyperlinks":
+		return c.Hyperlinks, nil
+	case "http_concurrency":
+		return c.HTTPConcurrency, nil
+	case "proxy":
+		return c.Proxy, nil
+	case "credential_backend":
+		return c.CredentialBackend, nil
+	case "defaults.mr_target_branch":
+		return c.Defaults.MRTargetBranch, nil
+	case "defaults.mr_labels":
+		return c.Defaults.MRLabels, nil
+	case "defaults.mr_reviewers":
+		return c.Defaults.MRReviewers, nil
+	case "defaults.mr_squash":
+		return c.Defaults.MRSquash, nil
+	case "defaults.issue_labels":
+		return c.Defaults.IssueLabels, nil
 	default:
 		return "", fmt.Errorf("unknown config key: %s", key)
 	}
@@ -184,24 +321,112 @@ func (c *Config) Set(key, value string) error {
 	case "browser":
 		c.Browser = value
 	case "protocol":
+		if value != "https" && value != "ssh" {
+			return fmt.Errorf("invalid value for protocol: %s (must be https or ssh)", value)
+		}
 		c.Protocol = value
 	case "git_remote":
 		c.GitRemote = value
 	case "default_host":
 		c.DefaultHost = value
+	case "hyperlinks":
+		if value != "auto" && value != "always" && value != "never" {
+			return fmt.Errorf("invalid value for hyperlinks: %s (must be auto, always, or never)", value)
+		}
+		c.Hyperlinks = value
+	case "http_concurrency":
+		if n, err := strconv.Atoi(value); err != nil || n < 1 {
+			return fmt.Errorf("invalid value for http_concurrency: %s (must be a positive integer)", value)
+		}
+		c.HTTPConcurrency = value
+	case "proxy":
+		if value != "" {
+			if u, err := url.Parse(value); err != nil || u.Scheme == "" || u.Host == "" {
+				return fmt.Errorf("invalid value for proxy: %s (must be a URL such as http://proxy.example.com:8080)", value)
+			}
+		}
+		c.Proxy = value
+	case "credential_backend":
+		if value != CredentialBackendFile && value != CredentialBackendKeyring {
+			return fmt.Errorf("invalid value for credential_backend: %s (must be file or keyring)", value)
+		}
+		c.CredentialBackend = value
+		if err := c.Save(); err != nil {
+			return err
+		}
+		// Move the stored tokens to the chosen backend right away.
+		return MigrateCredentials()
+	case "defaults.mr_target_branch":
+		c.storedDefaults().MRTargetBranch = value
+	case "defaults.mr_labels":
+		c.storedDefaults().MRLabels = strings.Join(SplitList(value), ",")
+	case "defaults.mr_reviewers":
+		c.storedDefaults().MRReviewers = strings.Join(SplitList(value), ",")
+	case "defaults.mr_squash":
+		if value != "" {
+			squash, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("invalid value for defaults.mr_squash: %s (must be true or false)", value)
+			}
+			value = strconv.FormatBool(squash)
+		}
+		c.storedDefaults().MRSquash = value
+	case "defaults.issue_labels":
+		c.storedDefaults().IssueLabels = strings.Join(SplitList(value), ",")
 	default:
 		return fmt.Errorf("unknown config key: %s", key)
 	}
 	return c.Save()
 }
 
+// Unset removes a config value and persists the change, so the key falls
+// back to its environment variable or built-in default.
+func (c *Config) Unset(key string) error {
+	if _, err := c.Get(key); err != nil {
+		return err
+	}
+	switch key {
+	case "protocol":
+		c.Protocol = defaultConfig().Protocol
+	case "git_remote":
+		c.GitRemote = defaultConfig().GitRemote
+	case "hyperlinks":
+		c.Hyperlinks = ""
+	case "http_concurrency":
+		c.HTTPConcurrency = ""
+	case "credential_backend":
+		c.CredentialBackend = ""
+		if err := c.Save(); err != nil {
+			return err
+		}
+		// Move the stored tokens back to hosts.json.
+		return MigrateCredentials()
+	default:
+		// The remaining keys accept any value, including none.
+		return c.Set(key, "")
+	}
+	return c.Save()
+}
+
 // Keys returns all valid config keys.
 func Keys() []string {
-	return []string{"editor", "pager", "browser", "protocol", "git_remote", "default_host"}
+	return []string{"editor", "pager", "browser", "protocol", "git_remote", "default_host", "hyperlinks", "http_concurrency", "proxy", "credential_backend",
+		"defaults.mr_target_branch", "defaults.mr_labels", "defaults.mr_reviewers", "defaults.mr_squash", "defaults.issue_labels"}
 }
 
-// LoadHosts reads the hosts configuration from disk.
+// LoadHosts reads the hosts configuration from disk, with the tokens kept
+// in the OS keyring filled in.
 func LoadHosts() (HostsConfig, error) {
+	hosts, err := readHostsFile()
+	if err != nil {
+		return nil, err
+	}
+	loadKeyringCredentials(hosts)
+	return hosts, nil
+}
+
+// readHostsFile reads hosts.json as stored on disk.
+func readHostsFile() (HostsConfig, error) {
 	hosts := make(HostsConfig)
 	path := filepath.Join(ConfigDir(), hostsFile)
 	data, err := os.ReadFile(path)
@@ -217,13 +442,15 @@ func LoadHosts() (HostsConfig, error) {
 	return hosts, nil
 }
 
-// SaveHosts writes the hosts configuration to disk.
+// SaveHosts writes the hosts configuration to disk. Tokens are stored by the
+// backend chosen with the credential_backend config key.
 func SaveHosts(hosts HostsConfig) error {
 	dir := ConfigDir()
 	if err := os.MkdirAll(dir, 0o755); err != nil {
 		return fmt.Errorf("creating config directory: %w", err)
 	}
-	data, err := json.MarshalIndent(hosts, "", "  ")
+	previous, _ := readHostsFile()
+	data, err := json.MarshalIndent(storeCredentials(hosts, previous), "", "  ")
 	if err != nil {
 		return fmt.Errorf("marshaling hosts config: %w", err)
 	}
@@ -240,7 +467,8 @@ func DefaultHost() string {
 	}
 	// Fall back to environment variable
 	if h := os.Getenv("GITLAB_HOST"); h != "" {
-		return h
+		host, _ := NormalizeHost(h)
+		return host
 	}
 	return "gitlab.com"
 }
@@ -280,6 +280,7 @@ func TestConfig_Get(t *testing.T) {
 		Protocol:    "ssh",
 		GitRemote:   "upstream",
 		DefaultHost: "gitlab.example.com",
+		Hyperlinks:  "never",
 	}
 
 	tests := []struct {
@@ -292,6 +293,7 @@ func TestConfig_Get(t *testing.T) {
 		{"protocol", "ssh"},
 		{"git_remote", "upstream"},
 		{"default_host", "gitlab.example.com"},
+		{"hyperlinks", "never"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.key, func(t *testing.T) {
@@ -331,6 +333,7 @@ func TestConfig_Set(t *testing.T) {
 		{"protocol", "ssh"},
 		{"git_remote", "upstream"},
 		{"default_host", "my.gitlab.com"},
+		{"hyperlinks", "always"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.key, func(t *testing.T) {
@@ -378,7 +381,8 @@ func TestConfig_SetPersists(t *testing.T) {
 
 func TestKeys(t *testing.T) {
 	keys := Keys()
-	expected := []string{"editor", "pager", "browser", "protocol", "git_remote", "default_host"}
+	expected := []string{"editor", "pager", "browser", "protocol", "git_remote", "default_host", "hyperlinks", "http_concurrency", "proxy", "credential_backend",
+		"defaults.mr_target_branch", "defaults.mr_labels", "defaults.mr_reviewers", "defaults.mr_squash", "defaults.issue_labels"}
 	if len(keys) != len(expected) {
 		t.Fatalf("Keys() returned %d keys, want %d", len(keys), len(expected))
 	}
@@ -549,6 +553,51 @@ func TestSetHostValue_NewHost(t *testing.T) {
 	}
 }
 
+func TestSetHostValue_TLS(t *testing.T) {
+	tmpDir := t.TempDir()
+	resetConfigDir(t, tmpDir)
+
+	caFile := filepath.Join(tmpDir, "ca.pem")
+	if err := os.WriteFile(caFile, []byte("pem"), 0o644); err != nil {
+		t.Fatalf("writing CA file: %v", err)
+	}
+	if err := SetHostValue("gitlab.corp", "ca_cert", caFile); err != nil {
+		t.Fatalf("SetHostValue(ca_cert): %v", err)
+	}
+	if got, _ := GetHostValue("gitlab.corp", "ca_cert"); got != caFile {
+		t.Errorf("ca_cert = %q, want %q", got, caFile)
+	}
+	if err := SetHostValue("gitlab.corp", "ca_cert", filepath.Join(tmpDir, "missing.pem")); err == nil {
+		t.Error("expected error for a missing CA file")
+	}
+
+	if err := SetHostValue("gitlab.corp", "skip_tls_verify", "true"); err != nil {
+		t.Fatalf("SetHostValue(skip_tls_verify): %v", err)
+	}
+	if got, _ := GetHostValue("gitlab.corp", "skip_tls_verify"); got != "true" {
+		t.Errorf("skip_tls_verify = %q, want %q", got, "true")
+	}
+	if err := SetHostValue("gitlab.corp", "skip_tls_verify", "maybe"); err == nil {
+		t.Error("expected error for an invalid boolean")
+	}
+}
+
+func TestConfigSet_Proxy(t *testing.T) {
+	tmpDir := t.TempDir()
+	resetConfigDir(t, tmpDir)
+
+	cfg := &Config{}
+	if err := cfg.Set("proxy", "http://proxy.corp:3128"); err != nil {
+		t.Fatalf("Set(proxy): %v", err)
+	}
+	if got, _ := cfg.Get("proxy"); got != "http://proxy.corp:3128" {
+		t.Errorf("proxy = %q", got)
+	}
+	if err := cfg.Set("proxy", "proxy.corp"); err == nil {
+		t.Error("expected error for a proxy without a scheme")
+	}
+}
+
 func TestLoadHosts_InvalidJSON(t *testing.T) {
 	tmpDir := t.TempDir()
 	resetConfigDir(t, tmpDir)
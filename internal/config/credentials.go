@@ -0,0 +1,175 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/PhilipKram/gitlab-cli/internal/keyring"
+)
+
+// Credential backends selected with the credential_backend config key.
+const (
+	CredentialBackendFile    = "file"
+	CredentialBackendKeyring = "keyring"
+)
+
+// keyringService returns the keyring service under which the tokens of
+// host are stored.
+func keyringService(host string) string {
+	return "glab:" + host
+}
+
+// keyringCache holds the secrets read from or written to the keyring by
+// this process, so hosts.json can be loaded repeatedly without asking the
+// OS credential store each time.
+var (
+	keyringCacheMu sync.Mutex
+	keyringCache   = map[string]string{}
+)
+
+func keyringGet(host, field string) (string, error) {
+	key := keyringService(host) + "\x00" + field
+	keyringCacheMu.Lock()
+	defer keyringCacheMu.Unlock()
+	if v, ok := keyringCache[key]; ok {
+		return v, nil
+	}
+	v, err := keyring.Get(keyringService(host), field)
+	if errors.Is(err, keyring.ErrNotFound) {
+		v, err = "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	keyringCache[key] = v
+	return v, nil
+}
+
+func keyringSet(host, field, value string) error {
+	key := keyringService(host) + "\x00" + field
+	keyringCacheMu.Lock()
+	defer keyringCacheMu.Unlock()
+	if v, ok := keyringCache[key]; ok && v == value {
+		return nil
+	}
+	var err error
+	if value == "" {
+		err = keyring.Delete(keyringService(host), field)
+	} else {
+		err = keyring.Set(keyringService(host), field, value)
+	}
+	if err != nil {
+		return err
+	}
+	keyringCache[key] = value
+	return nil
+}
+
+// credentialBackend returns the configured credential backend.
+func credentialBackend() string {
+	if cfg, err := Load(); err == nil && cfg.CredentialBackend == CredentialBackendKeyring {
+		return CredentialBackendKeyring
+	}
+	return CredentialBackendFile
+}
+
+// keyringReadWarned records the hosts whose keyring read failure has been
+// reported, so the warning is printed once per process.
+var keyringReadWarned sync.Map
+
+// loadKeyringCredentials fills in the tokens of hosts whose credentials are
+// stored in the keyring. A host whose tokens cannot be read is left without
+// them and marked, so that saving hosts.json leaves its keyring entries
+// alone; the failure is reported on stderr.
+func loadKeyringCredentials(hosts HostsConfig) {
+	for host, hc := range hosts {
+		if hc.CredentialStore != CredentialBackendKeyring {
+			continue
+		}
+		var err error
+		if hc.Token == "" {
+			hc.Token, err = keyringGet(host, "token")
+		}
+		if err == nil && hc.RefreshToken == "" {
+			hc.RefreshToken, err = keyringGet(host, "refresh_token")
+		}
+		if err != nil {
+			hc.Token, hc.RefreshToken = "", ""
+			hc.keyringErr = err
+			if _, warned := keyringReadWarned.LoadOrStore(host, true); !warned {
+				_, _ = fmt.Fprintf(os.Stderr, "Warning: could not read the credentials of %s from the OS keyring: %v\n", host, err)
+			}
+		}
+	}
+}
+
+// storeCredentials returns a copy of hosts ready to be written to
+// hosts.json. With the keyring backend the tokens are moved to the keyring,
+// falling back to the file when the keyring is unavailable. With the file
+// backend, tokens of hosts that used the keyring are written to the file
+// and removed from the keyring. Keyring entries of hosts that were removed
+// are deleted too.
+func storeCredentials(hosts HostsConfig, previous HostsConfig) HostsConfig {
+	backend := credentialBackend()
+	out := make(HostsConfig, len(hosts))
+	warned := false
+
+	for host, hc := range hosts {
+		c := *hc
+		out[host] = &c
+
+		// The keyring could not be read, so the empty tokens are not the
+		// stored ones: keep the keyring entries unless new tokens were set.
+		if c.keyringErr != nil && c.Token == "" && c.RefreshToken == "" {
+			continue
+		}
+
+		if backend != CredentialBackendKeyring {
+			if c.CredentialStore == CredentialBackendKeyring {
+				_ = keyringSet(host, "token", "")
+				_ = keyringSet(host, "refresh_token", "")
+			}
+			c.CredentialStore = ""
+			continue
+		}
+
+		if c.Token == "" && c.RefreshToken == "" && c.CredentialStore != CredentialBackendKeyring {
+			continue
+		}
+		err := keyringSet(host, "token", c.Token)
+		if err == nil {
+			err = keyringSet(host, "refresh_token", c.RefreshToken)
+		}
+		if err != nil {
+			if !warned {
+				_, _ = fmt.Fprintf(os.Stderr, "Warning: could not store credentials in the OS keyring, keeping them in %s: %v\n", hostsFile, err)
+				warned = true
+			}
+			c.CredentialStore = ""
+			continue
+		}
+		c.Token = ""
+		c.RefreshToken = ""
+		c.CredentialStore = CredentialBackendKeyring
+	}
+
+	for host, hc := range previous {
+		if _, ok := hosts[host]; !ok && hc.CredentialStore == CredentialBackendKeyring {
+			_ = keyringSet(host, "token", "")
+			_ = keyringSet(host, "refresh_token", "")
+		}
+	}
+	return out
+}
+
+// MigrateCredentials rewrites hosts.json so every stored token is kept by
+// the configured credential backend.
+func MigrateCredentials() error {
+	hosts, err := LoadHosts()
+	if err != nil {
+		return err
+	}
+	return SaveHosts(hosts)
+}
@@ -0,0 +1,137 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PhilipKram/gitlab-cli/internal/keyring"
+)
+
+func setupKeyring(t *testing.T) {
+	t.Helper()
+	keyring.MockInit()
+	keyringCache = map[string]string{}
+	t.Cleanup(func() { keyringCache = map[string]string{} })
+}
+
+func TestCredentialBackend_Keyring(t *testing.T) {
+	tmpDir := t.TempDir()
+	resetConfigDir(t, tmpDir)
+	setupKeyring(t)
+
+	if err := SaveHosts(HostsConfig{"gitlab.com": {Token: "plain-token", User: "alice"}}); err != nil {
+		t.Fatalf("SaveHosts: %v", err)
+	}
+
+	cfg := &Config{}
+	if err := cfg.Set("credential_backend", "keyring"); err != nil {
+		t.Fatalf("Set(credential_backend): %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(tmpDir, hostsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "plain-token") {
+		t.Errorf("expected the token to be moved out of %s, got:\n%s", hostsFile, data)
+	}
+	if secret, _ := keyring.Get("glab:gitlab.com", "token"); secret != "plain-token" {
+		t.Errorf("expected the token in the keyring, got %q", secret)
+	}
+
+	// Use a fresh cache so the token is read back from the keyring.
+	keyringCache = map[string]string{}
+	if token, _ := TokenForHost("gitlab.com"); token != "plain-token" {
+		t.Errorf("TokenForHost() = %q, want the keyring token", token)
+	}
+
+	if err := cfg.Set("credential_backend", "file"); err != nil {
+		t.Fatalf("Set(credential_backend): %v", err)
+	}
+	data, _ = os.ReadFile(filepath.Join(tmpDir, hostsFile))
+	if !strings.Contains(string(data), "plain-token") {
+		t.Errorf("expected the token back in %s, got:\n%s", hostsFile, data)
+	}
+	if _, err := keyring.Get("glab:gitlab.com", "token"); !errors.Is(err, keyring.ErrNotFound) {
+		t.Errorf("expected the keyring entry to be removed, got %v", err)
+	}
+}
+
+func TestCredentialBackend_RemovedHost(t *testing.T) {
+	resetConfigDir(t, t.TempDir())
+	setupKeyring(t)
+
+	cfg := &Config{}
+	if err := cfg.Set("credential_backend", "keyring"); err != nil {
+		t.Fatalf("Set(credential_backend): %v", err)
+	}
+	if err := SaveHosts(HostsConfig{"gitlab.example.com": {Token: "tok", RefreshToken: "refresh"}}); err != nil {
+		t.Fatalf("SaveHosts: %v", err)
+	}
+	if err := SaveHosts(HostsConfig{}); err != nil {
+		t.Fatalf("SaveHosts: %v", err)
+	}
+	if _, err := keyring.Get("glab:gitlab.example.com", "refresh_token"); !errors.Is(err, keyring.ErrNotFound) {
+		t.Errorf("expected the keyring entry of a removed host to be deleted, got %v", err)
+	}
+}
+
+func TestCredentialBackend_Invalid(t *testing.T) {
+	resetConfigDir(t, t.TempDir())
+
+	cfg := &Config{}
+	if err := cfg.Set("credential_backend", "vault"); err == nil {
+		t.Error("expected error for an unknown backend")
+	}
+}
+
+func TestCredentialBackend_KeyringReadError(t *testing.T) {
+	resetConfigDir(t, t.TempDir())
+	setupKeyring(t)
+	t.Cleanup(func() { keyring.MockReadError(nil) })
+
+	cfg := &Config{}
+	if err := cfg.Set("credential_backend", "keyring"); err != nil {
+		t.Fatalf("Set(credential_backend): %v", err)
+	}
+	if err := SaveHosts(HostsConfig{"gitlab.com": {Token: "tok", RefreshToken: "refresh"}}); err != nil {
+		t.Fatalf("SaveHosts: %v", err)
+	}
+
+	// A locked keyring fails the read; saving must not delete the entries,
+	// with either backend.
+	keyringCache = map[string]string{}
+	keyring.MockReadError(errors.New("keychain is locked"))
+	for _, backend := range []string{"keyring", "file"} {
+		hosts, err := LoadHosts()
+		if err != nil {
+			t.Fatalf("LoadHosts: %v", err)
+		}
+		if hosts["gitlab.com"].Token != "" {
+			t.Fatalf("expected no token while the keyring is locked, got %q", hosts["gitlab.com"].Token)
+		}
+		if err := cfg.Set("credential_backend", backend); err != nil {
+			t.Fatalf("Set(credential_backend): %v", err)
+		}
+		if err := SaveHosts(hosts); err != nil {
+			t.Fatalf("SaveHosts: %v", err)
+		}
+	}
+
+	keyring.MockReadError(nil)
+	for field, want := range map[string]string{"token": "tok", "refresh_token": "refresh"} {
+		if got, err := keyring.Get("glab:gitlab.com", field); err != nil || got != want {
+			t.Errorf("keyring %s = %q, %v; want %q", field, got, err, want)
+		}
+	}
+	hosts, err := readHostsFile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hosts["gitlab.com"].CredentialStore != CredentialBackendKeyring {
+		t.Errorf("expected hosts.json to still point at the keyring, got %+v", hosts["gitlab.com"])
+	}
+}
@@ -0,0 +1,84 @@
+package config
+
+import (
+	"os"
+	"strings"
+)
+
+// envOverrides lists, per config key, the environment variables that take
+// precedence over the key.
+var envOverrides = map[string][]string{
+	"pager":            {"GLAB_PAGER"},
+	"http_concurrency": {"GLAB_HTTP_CONCURRENCY"},
+	"proxy":            {"HTTPS_PROXY", "https_proxy", "HTTP_PROXY", "http_proxy"},
+}
+
+// envFallbacks lists, per config key, the environment variables used when
+// the key is not set.
+var envFallbacks = map[string][]string{
+	"editor":       {"GIT_EDITOR", "VISUAL", "EDITOR"},
+	"pager":        {"PAGER"},
+	"default_host": {"GITLAB_HOST"},
+}
+
+// Effective returns the value in effect for a config key and where it comes
+// from: the name of the environment variable that provides it,
+// ProjectConfigFile when a project's .glab.yml overrides it, or "" when it
+// is the configured (or built-in default) value.
+func (c *Config) Effective(key string) (value, source string, err error) {
+	value, err = c.Get(key)
+	if err != nil {
+		return "", "", err
+	}
+	if v, name := lookupEnv(envOverrides[key]); name != "" {
+		return v, name, nil
+	}
+	if c.globalDefaults != nil && strings.HasPrefix(key, "defaults.") {
+		global, _ := (&Config{Defaults: *c.globalDefaults}).Get(key)
+		if value != global {
+			return value, ProjectConfigFile, nil
+		}
+	}
+	if value == "" {
+		if v, name := lookupEnv(envFallbacks[key]); name != "" {
+			if key == "default_host" {
+				v, _ = NormalizeHost(v)
+			}
+			return v, name, nil
+		}
+	}
+	return value, "", nil
+}
+
+// EffectiveHostValue returns the value in effect for a per-host config key
+// and where it comes from: "" for the host's own value, an environment
+// variable name, or "global config" when the global key of the same name
+// applies.
+func EffectiveHostValue(host, key string, cfg *Config) (value, source string, err error) {
+	value, err = GetHostValue(host, key)
+	if err != nil || value != "" {
+		return value, "", err
+	}
+	switch key {
+	case "api_protocol":
+		if h, scheme := NormalizeHost(os.Getenv("GITLAB_HOST")); h == host && scheme != "" {
+			return scheme, "GITLAB_HOST", nil
+		}
+	case "protocol":
+		if cfg != nil && cfg.Protocol != "" {
+			return cfg.Protocol, "global config", nil
+		}
+	}
+	return "", "", nil
+}
+
+// lookupEnv returns the value and name of the first of names that is set
+// to a non-empty value.
+func lookupEnv(names []string) (string, string) {
+	for _, name := range names {
+		if v := os.Getenv(name); v != "" {
+			return v, name
+		}
+	}
+	return "", ""
+}
@@ -0,0 +1,107 @@
+package config
+
+import (
+	"testing"
+)
+
+func TestConfig_Effective(t *testing.T) {
+	resetConfigDir(t, t.TempDir())
+	for _, name := range []string{"GLAB_PAGER", "PAGER", "GIT_EDITOR", "VISUAL", "EDITOR", "HTTPS_PROXY", "https_proxy", "HTTP_PROXY", "http_proxy"} {
+		t.Setenv(name, "")
+	}
+
+	cfg := &Config{Pager: "more", Proxy: "http://config-proxy:3128"}
+	t.Setenv("GLAB_PAGER", "less")
+	t.Setenv("HTTPS_PROXY", "http://env-proxy:8080")
+	t.Setenv("EDITOR", "nano")
+	t.Setenv("GITLAB_HOST", "https://gitlab.example.com/")
+
+	tests := []struct {
+		key, value, source string
+	}{
+		{"pager", "less", "GLAB_PAGER"},
+		{"proxy", "http://env-proxy:8080", "HTTPS_PROXY"},
+		{"editor", "nano", "EDITOR"},
+		{"default_host", "gitlab.example.com", "GITLAB_HOST"},
+		{"browser", "", ""},
+	}
+	for _, tt := range tests {
+		value, source, err := cfg.Effective(tt.key)
+		if err != nil {
+			t.Fatalf("Effective(%q): %v", tt.key, err)
+		}
+		if value != tt.value || source != tt.source {
+			t.Errorf("Effective(%q) = %q, %q; want %q, %q", tt.key, value, source, tt.value, tt.source)
+		}
+	}
+
+	// A configured value wins over a fallback variable.
+	cfg.Editor = "vim"
+	if value, source, _ := cfg.Effective("editor"); value != "vim" || source != "" {
+		t.Errorf("Effective(editor) = %q, %q; want the configured value", value, source)
+	}
+
+	if _, _, err := cfg.Effective("unknown"); err == nil {
+		t.Error("expected error for an unknown key")
+	}
+}
+
+func TestConfig_Unset(t *testing.T) {
+	resetConfigDir(t, t.TempDir())
+
+	cfg := &Config{Editor: "vim", Protocol: "ssh", GitRemote: "upstream", Hyperlinks: "never"}
+	cfg.Defaults.MRLabels = "a,b"
+	for _, key := range []string{"editor", "protocol", "git_remote", "hyperlinks", "defaults.mr_labels"} {
+		if err := cfg.Unset(key); err != nil {
+			t.Fatalf("Unset(%q): %v", key, err)
+		}
+	}
+
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if loaded.Editor != "" || loaded.Hyperlinks != "" || loaded.Defaults.MRLabels != "" {
+		t.Errorf("expected values to be unset, got %+v", loaded)
+	}
+	if loaded.Protocol != "https" || loaded.GitRemote != "origin" {
+		t.Errorf("expected built-in defaults, got protocol %q and git_remote %q", loaded.Protocol, loaded.GitRemote)
+	}
+
+	if err := cfg.Unset("unknown"); err == nil {
+		t.Error("expected error for an unknown key")
+	}
+}
+
+func TestConfig_SetDefaults(t *testing.T) {
+	resetConfigDir(t, t.TempDir())
+
+	cfg := &Config{}
+	if err := cfg.Set("defaults.issue_labels", " bug , triage,,"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if got, _ := cfg.Get("defaults.issue_labels"); got != "bug,triage" {
+		t.Errorf("defaults.issue_labels = %q, want %q", got, "bug,triage")
+	}
+	if err := cfg.Set("defaults.mr_target_branch", "develop"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if loaded.Defaults.MRTargetBranch != "develop" || loaded.Defaults.IssueLabels != "bug,triage" {
+		t.Errorf("defaults not persisted: %+v", loaded.Defaults)
+	}
+}
+
+func TestSplitList(t *testing.T) {
+	got := SplitList(" a, b ,,c ")
+	if len(got) != 3 || got[0] != "a" || got[1] != "b" || got[2] != "c" {
+		t.Errorf("SplitList = %q, want [a b c]", got)
+	}
+	if got := SplitList(""); got != nil {
+		t.Errorf("SplitList(\"\") = %q, want nil", got)
+	}
+}
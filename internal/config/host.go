@@ -0,0 +1,59 @@
+package config
+
+import (
+	"net/url"
+	"os"
+	"strings"
+)
+
+// NormalizeHost splits a host given as a URL, such as
+// "http://gitlab.local:8080/", into the host name (with port) used as its
+// configuration key and its scheme. A host without a scheme is returned
+// unchanged with an empty scheme.
+func NormalizeHost(s string) (host, scheme string) {
+	s = strings.TrimSpace(s)
+	if !strings.Contains(s, "://") {
+		return strings.TrimSuffix(s, "/"), ""
+	}
+	u, err := url.Parse(s)
+	if err != nil || u.Host == "" {
+		return s, ""
+	}
+	return u.Host, strings.ToLower(u.Scheme)
+}
+
+// HostScheme returns the scheme used to reach host: its api_protocol, the
+// scheme of GITLAB_HOST when that names host, or "https".
+func HostScheme(host string) string {
+	if hosts, err := LoadHosts(); err == nil {
+		if hc, ok := hosts[host]; ok && hc.APIProtocol != "" {
+			return hc.APIProtocol
+		}
+	}
+	if h, scheme := NormalizeHost(os.Getenv("GITLAB_HOST")); h == host && scheme != "" {
+		return scheme
+	}
+	return "https"
+}
+
+// WebBaseURL returns the base URL of the web interface of host, such as
+// "https://gitlab.com" or "http://gitlab.local:8080".
+func WebBaseURL(host string) string {
+	return HostScheme(host) + "://" + host
+}
+
+// APIBaseURL returns the base URL requests to the API of host are sent to.
+// The api_host key overrides the host name; it may include a scheme and
+// port ("http://10.0.0.5:8080"), otherwise the host's scheme is used.
+func APIBaseURL(host string) string {
+	apiHost := host
+	if hosts, err := LoadHosts(); err == nil {
+		if hc, ok := hosts[host]; ok && hc.APIHost != "" {
+			apiHost = hc.APIHost
+		}
+	}
+	if strings.Contains(apiHost, "://") {
+		return strings.TrimSuffix(apiHost, "/")
+	}
+	return HostScheme(host) + "://" + apiHost
+}
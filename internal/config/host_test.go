@@ -0,0 +1,43 @@
+package config
+
+import "testing"
+
+func TestNormalizeHost(t *testing.T) {
+	tests := []struct {
+		in, host, scheme string
+	}{
+		{"gitlab.com", "gitlab.com", ""},
+		{"gitlab.local:8080", "gitlab.local:8080", ""},
+		{"http://gitlab.local:8080", "gitlab.local:8080", "http"},
+		{"https://gitlab.example.com/", "gitlab.example.com", "https"},
+		{"HTTP://gitlab.local", "gitlab.local", "http"},
+	}
+	for _, tt := range tests {
+		host, scheme := NormalizeHost(tt.in)
+		if host != tt.host || scheme != tt.scheme {
+			t.Errorf("NormalizeHost(%q) = %q, %q, want %q, %q", tt.in, host, scheme, tt.host, tt.scheme)
+		}
+	}
+}
+
+func TestHostScheme(t *testing.T) {
+	resetConfigDir(t, t.TempDir())
+	t.Setenv("GITLAB_HOST", "http://dev.gitlab.local:3000")
+
+	if err := SaveHosts(HostsConfig{"gitlab.local:8080": {APIProtocol: "http"}}); err != nil {
+		t.Fatalf("SaveHosts: %v", err)
+	}
+
+	if got := HostScheme("gitlab.local:8080"); got != "http" {
+		t.Errorf("HostScheme() from api_protocol = %q", got)
+	}
+	if got := HostScheme("dev.gitlab.local:3000"); got != "http" {
+		t.Errorf("HostScheme() from GITLAB_HOST = %q", got)
+	}
+	if got := HostScheme("gitlab.com"); got != "https" {
+		t.Errorf("HostScheme() default = %q", got)
+	}
+	if got := DefaultHost(); got != "dev.gitlab.local:3000" {
+		t.Errorf("DefaultHost() = %q, want the host without scheme", got)
+	}
+}
@@ -0,0 +1,92 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ProjectConfigFile is the name of the per-project configuration file, kept
+// at the root of a repository so a team can share and version its defaults.
+const ProjectConfigFile = ".glab.yml"
+
+// ProjectConfig holds the settings of a repository's .glab.yml:
+//
+//	repo: gitlab.example.com/group/project
+//	mr:
+//	  target_branch: develop
+//	  labels: [backend]
+//	  reviewers: [alice, bob]
+//	  squash: true
+//	issue:
+//	  labels: [triage]
+type ProjectConfig struct {
+	// Repo selects the project like --repo, in any of the forms it accepts.
+	Repo string `yaml:"repo"`
+
+	MR struct {
+		TargetBranch string   `yaml:"target_branch"`
+		Labels       []string `yaml:"labels"`
+		Reviewers    []string `yaml:"reviewers"`
+		Squash       *bool    `yaml:"squash"`
+	} `yaml:"mr"`
+
+	Issue struct {
+		Labels []string `yaml:"labels"`
+	} `yaml:"issue"`
+}
+
+// LoadProjectConfig reads the .glab.yml in dir. It returns nil without an
+// error when the file does not exist.
+func LoadProjectConfig(dir string) (*ProjectConfig, error) {
+	path := filepath.Join(dir, ProjectConfigFile)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	pc := &ProjectConfig{}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(pc); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return pc, nil
+}
+
+// MergeProject applies the defaults set in a project's .glab.yml over the
+// global ones. Save still writes only the global defaults.
+func (c *Config) MergeProject(pc *ProjectConfig) {
+	if pc == nil {
+		return
+	}
+	if c.globalDefaults == nil {
+		global := c.Defaults
+		c.globalDefaults = &global
+	}
+	if pc.MR.TargetBranch != "" {
+		c.Defaults.MRTargetBranch = pc.MR.TargetBranch
+	}
+	if len(pc.MR.Labels) > 0 {
+		c.Defaults.MRLabels = strings.Join(pc.MR.Labels, ",")
+	}
+	if len(pc.MR.Reviewers) > 0 {
+		c.Defaults.MRReviewers = strings.Join(pc.MR.Reviewers, ",")
+	}
+	if pc.MR.Squash != nil {
+		c.Defaults.MRSquash = strconv.FormatBool(*pc.MR.Squash)
+	}
+	if len(pc.Issue.Labels) > 0 {
+		c.Defaults.IssueLabels = strings.Join(pc.Issue.Labels, ",")
+	}
+}
@@ -0,0 +1,122 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeProjectConfig(t *testing.T, content string) string {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, ProjectConfigFile), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestLoadProjectConfig(t *testing.T) {
+	dir := writeProjectConfig(t, `repo: gitlab.example.com/group/project
+mr:
+  target_branch: develop
+  labels: [backend, review]
+  reviewers:
+    - alice
+    - bob
+  squash: true
+issue:
+  labels: [triage]
+`)
+
+	pc, err := LoadProjectConfig(dir)
+	if err != nil {
+		t.Fatalf("LoadProjectConfig: %v", err)
+	}
+	if pc.Repo != "gitlab.example.com/group/project" {
+		t.Errorf("Repo = %q", pc.Repo)
+	}
+	if pc.MR.TargetBranch != "develop" || len(pc.MR.Labels) != 2 || len(pc.MR.Reviewers) != 2 {
+		t.Errorf("unexpected mr section: %+v", pc.MR)
+	}
+	if pc.MR.Squash == nil || !*pc.MR.Squash {
+		t.Error("expected squash to be true")
+	}
+	if len(pc.Issue.Labels) != 1 || pc.Issue.Labels[0] != "triage" {
+		t.Errorf("unexpected issue labels: %v", pc.Issue.Labels)
+	}
+}
+
+func TestLoadProjectConfig_Missing(t *testing.T) {
+	pc, err := LoadProjectConfig(t.TempDir())
+	if err != nil || pc != nil {
+		t.Errorf("LoadProjectConfig = %v, %v; want nil, nil", pc, err)
+	}
+}
+
+func TestLoadProjectConfig_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"unknown key":  "mr:\n  target: main\n",
+		"bad squash":   "mr:\n  squash: sometimes\n",
+		"invalid yaml": "mr: [\n",
+	}
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := LoadProjectConfig(writeProjectConfig(t, content)); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+
+	if pc, err := LoadProjectConfig(writeProjectConfig(t, "")); err != nil || pc == nil {
+		t.Errorf("expected an empty file to load, got %v, %v", pc, err)
+	}
+}
+
+func TestMergeProject(t *testing.T) {
+	resetConfigDir(t, t.TempDir())
+
+	dir := writeProjectConfig(t, "mr:\n  labels: [backend]\n  squash: false\n")
+	pc, err := LoadProjectConfig(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &Config{Protocol: "https", GitRemote: "origin"}
+	cfg.Defaults.MRLabels = "global"
+	cfg.Defaults.MRTargetBranch = "main"
+	cfg.MergeProject(pc)
+
+	if cfg.Defaults.MRLabels != "backend" || cfg.Defaults.MRSquash != "false" {
+		t.Errorf("expected project defaults to win, got %+v", cfg.Defaults)
+	}
+	if cfg.Defaults.MRTargetBranch != "main" {
+		t.Errorf("expected unset project keys to keep global values, got %q", cfg.Defaults.MRTargetBranch)
+	}
+	if value, source, _ := cfg.Effective("defaults.mr_labels"); value != "backend" || source != ProjectConfigFile {
+		t.Errorf("Effective(defaults.mr_labels) = %q, %q; want backend from %s", value, source, ProjectConfigFile)
+	}
+	if _, source, _ := cfg.Effective("defaults.mr_target_branch"); source != "" {
+		t.Errorf("expected defaults.mr_target_branch to come from the global config, got %q", source)
+	}
+
+	// Saving writes the global defaults, not the project's.
+	if err := cfg.Set("defaults.issue_labels", "bug"); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(ConfigDir(), configFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var saved Config
+	if err := json.Unmarshal(data, &saved); err != nil {
+		t.Fatal(err)
+	}
+	if saved.Defaults.MRLabels != "global" || saved.Defaults.MRSquash != "" || saved.Defaults.IssueLabels != "bug" {
+		t.Errorf("unexpected saved defaults: %+v", saved.Defaults)
+	}
+	if strings.Contains(string(data), "backend") {
+		t.Errorf("project defaults leaked into config.json:\n%s", data)
+	}
+}
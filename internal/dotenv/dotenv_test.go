@@ -0,0 +1,78 @@
+package dotenv
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	input := `# database settings
+DB_HOST=localhost
+export DB_PORT=5432
+DB_PASSWORD="p@ss \"word\""
+GREETING='hello $USER'
+EMPTY=
+LOG_LEVEL=debug # verbose locally
+`
+	entries, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []Entry{
+		{Key: "DB_HOST", Value: "localhost", Line: 2},
+		{Key: "DB_PORT", Value: "5432", Line: 3},
+		{Key: "DB_PASSWORD", Value: `p@ss "word"`, Line: 4},
+		{Key: "GREETING", Value: "hello $USER", Line: 5},
+		{Key: "EMPTY", Value: "", Line: 6},
+		{Key: "LOG_LEVEL", Value: "debug", Line: 7},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("got %d entries, want %d: %+v", len(entries), len(want), entries)
+	}
+	for i := range want {
+		if entries[i] != want[i] {
+			t.Errorf("entries[%d] = %+v, want %+v", i, entries[i], want[i])
+		}
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	for _, input := range []string{
+		"NOEQUALS",
+		"1KEY=value",
+		`KEY="unterminated`,
+		"KEY='unterminated",
+	} {
+		if _, err := Parse(strings.NewReader(input)); err == nil {
+			t.Errorf("expected error for %q", input)
+		}
+	}
+}
+
+func TestKeys(t *testing.T) {
+	keys := Keys([]Entry{{Key: "A"}, {Key: "B"}, {Key: "A"}})
+	if strings.Join(keys, ",") != "A,B" {
+		t.Errorf("Keys() = %v, want [A B]", keys)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		key, value, want string
+	}{
+		{"TOKEN", "abc123", "export TOKEN='abc123'"},
+		{"GREETING", "hello $USER", "export GREETING='hello $USER'"},
+		{"QUOTE", "it's", `export QUOTE='it'\''s'`},
+	}
+	for _, tt := range tests {
+		if got := Format(tt.key, tt.value); got != tt.want {
+			t.Errorf("Format(%q, %q) = %s, want %s", tt.key, tt.value, got, tt.want)
+		}
+	}
+
+	entries, err := Parse(strings.NewReader(Format("DB_HOST", "localhost")))
+	if err != nil || len(entries) != 1 || entries[0].Value != "localhost" {
+		t.Errorf("Parse(Format()) = %+v, %v", entries, err)
+	}
+}
@@ -631,11 +631,15 @@ func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error)
 	return resp, nil
 }
 
+// NewLoggingTransport wraps base so requests and responses are logged in
+// verbose mode.
+func NewLoggingTransport(base http.RoundTripper) http.RoundTripper {
+	return &loggingTransport{transport: base}
+}
+
 // NewLoggingHTTPClient creates an HTTP client that logs requests/responses in verbose mode.
 func NewLoggingHTTPClient() *http.Client {
 	return &http.Client{
-		Transport: &loggingTransport{
-			transport: http.DefaultTransport,
-		},
+		Transport: NewLoggingTransport(http.DefaultTransport),
 	}
 }
@@ -5,8 +5,13 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/PhilipKram/gitlab-cli/internal/tableprinter"
+	"github.com/PhilipKram/gitlab-cli/pkg/iostreams"
+	"gopkg.in/yaml.v3"
 )
 
 // OutputFormat represents the output format type.
@@ -19,6 +24,10 @@ const (
 	TableFormat OutputFormat = "table"
 	// PlainFormat outputs data in a minimal format suitable for scripting.
 	PlainFormat OutputFormat = "plain"
+	// YAMLFormat outputs data as YAML.
+	YAMLFormat OutputFormat = "yaml"
+	// CSVFormat outputs data as comma-separated values with a header row.
+	CSVFormat OutputFormat = "csv"
 )
 
 // Formatter defines the interface for formatting output data.
@@ -45,11 +54,26 @@ func (f *JSONFormatter) Format(data interface{}) error {
 // TableFormatter formats output as an aligned table.
 type TableFormatter struct {
 	out io.Writer
+
+	// Hyperlinks renders ID and URL cells of items with a WebURL field as
+	// clickable terminal links.
+	Hyperlinks bool
+
+	// Terminal prints a header row and truncates cells to fit Width
+	// columns.
+	Terminal bool
+	Width    int
+
+	// Color, when set, colors State and Status cells.
+	Color *iostreams.ColorScheme
 }
 
 // Format converts data to table format and writes it to the output writer.
 func (f *TableFormatter) Format(data interface{}) error {
 	table := tableprinter.New(f.out)
+	if f.Terminal {
+		table.SetTerminal(f.Width)
+	}
 
 	// Use reflection to handle different data types
 	val := reflect.ValueOf(data)
@@ -60,31 +84,76 @@ func (f *TableFormatter) Format(data interface{}) error {
 	switch val.Kind() {
 	case reflect.Slice, reflect.Array:
 		// Handle slice/array of items
+		if f.Terminal && val.Len() > 0 {
+			table.SetHeader(formatHeader(val.Index(0))...)
+		}
 		for i := 0; i < val.Len(); i++ {
 			item := val.Index(i)
-			row := formatItem(item)
+			row := formatColoredItem(item, f.Hyperlinks, f.Color)
 			table.AddRow(row...)
 		}
 	default:
 		// Handle single item
-		row := formatItem(val)
+		if f.Terminal {
+			table.SetHeader(formatHeader(val)...)
+		}
+		row := formatColoredItem(val, f.Hyperlinks, f.Color)
 		table.AddRow(row...)
 	}
 
 	return table.Render()
 }
 
+// formatHeader returns the column names for the row formatItem produces for
+// a struct: the JSON names of its simple fields, upper-cased. Other kinds
+// have no header.
+func formatHeader(val reflect.Value) []string {
+	val = indirect(val)
+	if val.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var header []string
+	t := val.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || !isSimpleKind(field.Type.Kind()) {
+			continue
+		}
+		name := field.Name
+		if tagName, _, _ := strings.Cut(field.Tag.Get("json"), ","); tagName != "" && tagName != "-" {
+			name = tagName
+		}
+		header = append(header, strings.ToUpper(strings.ReplaceAll(name, "_", " ")))
+	}
+	return header
+}
+
 // formatItem converts a single item to a string slice for table row.
 // Only primitive fields (strings, numbers, bools) are included; complex
 // nested types (structs, slices, maps, pointers) are skipped to keep
-// table output readable.
-func formatItem(val reflect.Value) []string {
+// table output readable. When hyperlinks is set, the ID, IID, and WebURL
+// cells of structs with a WebURL field link to that URL.
+func formatItem(val reflect.Value, hyperlinks bool) []string {
+	return formatColoredItem(val, hyperlinks, nil)
+}
+
+// formatColoredItem is formatItem with State and Status cells colored by cs.
+// When colors are enabled, open drafts show "draft" as their state.
+func formatColoredItem(val reflect.Value, hyperlinks bool, cs *iostreams.ColorScheme) []string {
 	if val.Kind() == reflect.Ptr {
 		val = val.Elem()
 	}
 
 	switch val.Kind() {
 	case reflect.Struct:
+		var webURL string
+		if hyperlinks {
+			if u := val.FieldByName("WebURL"); u.IsValid() && u.Kind() == reflect.String {
+				webURL = u.String()
+			}
+		}
+
 		var row []string
 		for i := 0; i < val.NumField(); i++ {
 			field := val.Field(i)
@@ -92,7 +161,19 @@ func formatItem(val reflect.Value) []string {
 				continue
 			}
 			if isSimpleKind(field.Kind()) {
-				row = append(row, fmt.Sprintf("%v", field.Interface()))
+				cell := fmt.Sprintf("%v", field.Interface())
+				switch val.Type().Field(i).Name {
+				case "ID", "IID", "WebURL":
+					cell = iostreams.Hyperlink(webURL, cell)
+				case "State", "Status":
+					if cs != nil && cs.Enabled() && cell == "opened" && isDraft(val) {
+						cell = "draft"
+					}
+					if cs != nil {
+						cell = cs.ForState(cell, cell)
+					}
+				}
+				row = append(row, cell)
 			}
 		}
 		return row
@@ -110,6 +191,13 @@ func formatItem(val reflect.Value) []string {
 	}
 }
 
+// isDraft reports whether a struct has a true Draft field, as draft merge
+// requests do.
+func isDraft(val reflect.Value) bool {
+	d := val.FieldByName("Draft")
+	return d.IsValid() && d.Kind() == reflect.Bool && d.Bool()
+}
+
 // isSimpleKind returns true for kinds that render cleanly as a single table cell.
 func isSimpleKind(k reflect.Kind) bool {
 	switch k {
@@ -184,6 +272,200 @@ func getFirstValue(val reflect.Value) string {
 	}
 }
 
+// YAMLFormatter formats output as YAML.
+type YAMLFormatter struct {
+	out io.Writer
+}
+
+// Format converts data to YAML and writes it to the output writer. Field
+// names match the JSON output.
+func (f *YAMLFormatter) Format(data interface{}) error {
+	value, err := jsonValue(data)
+	if err != nil {
+		return err
+	}
+	return writeYAML(f.out, value)
+}
+
+// jsonValue round-trips data through JSON so other encodings use the same
+// field names and omit the same empty fields as the JSON output.
+func jsonValue(data interface{}) (interface{}, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func writeYAML(out io.Writer, value interface{}) error {
+	enc := yaml.NewEncoder(out)
+	enc.SetIndent(2)
+	if err := enc.Encode(value); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// CSVFormatter formats output as comma-separated values.
+type CSVFormatter struct {
+	out io.Writer
+}
+
+// Format writes a header row of JSON field names followed by one row per
+// item. Like the table output, only scalar fields are included, along with
+// timestamps and lists of strings.
+func (f *CSVFormatter) Format(data interface{}) error {
+	table := tableprinter.NewWithRenderer(f.out, tableprinter.CSVRenderer{})
+
+	val := reflect.ValueOf(data)
+	if val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+
+	var items []reflect.Value
+	switch val.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < val.Len(); i++ {
+			items = append(items, val.Index(i))
+		}
+	case reflect.Invalid:
+	default:
+		items = append(items, val)
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	columns := csvColumns(items[0])
+	header := make([]string, len(columns))
+	for i, c := range columns {
+		header[i] = c.name
+	}
+	table.SetHeader(header...)
+	for _, item := range items {
+		table.AddRow(csvRow(item, columns)...)
+	}
+	return table.Render()
+}
+
+// csvColumn is a CSV column: a struct field index or a map key.
+type csvColumn struct {
+	name  string
+	index int
+}
+
+// csvColumns returns the columns of item, using JSON field names for structs
+// and sorted keys for maps.
+func csvColumns(item reflect.Value) []csvColumn {
+	item = indirect(item)
+
+	var columns []csvColumn
+	switch item.Kind() {
+	case reflect.Struct:
+		t := item.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() || !isCSVType(field.Type) {
+				continue
+			}
+			name := field.Name
+			if tag := field.Tag.Get("json"); tag != "" {
+				tagName, _, _ := strings.Cut(tag, ",")
+				if tagName == "-" {
+					continue
+				}
+				if tagName != "" {
+					name = tagName
+				}
+			}
+			columns = append(columns, csvColumn{name: name, index: i})
+		}
+	case reflect.Map:
+		var keys []string
+		for _, key := range item.MapKeys() {
+			v := indirect(item.MapIndex(key))
+			if v.Kind() == reflect.Invalid || isSimpleKind(v.Kind()) {
+				keys = append(keys, fmt.Sprint(key.Interface()))
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			columns = append(columns, csvColumn{name: k, index: -1})
+		}
+	default:
+		columns = append(columns, csvColumn{name: "value", index: -1})
+	}
+	return columns
+}
+
+// csvRow returns the cells of item for the given columns.
+func csvRow(item reflect.Value, columns []csvColumn) []string {
+	item = indirect(item)
+
+	row := make([]string, len(columns))
+	for i, c := range columns {
+		switch item.Kind() {
+		case reflect.Struct:
+			row[i] = csvCell(item.Field(c.index))
+		case reflect.Map:
+			row[i] = csvCell(item.MapIndex(reflect.ValueOf(c.name)))
+		case reflect.Invalid:
+		default:
+			row[i] = csvCell(item)
+		}
+	}
+	return row
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// isCSVType reports whether values of t fit in a single CSV cell.
+func isCSVType(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == timeType || isSimpleKind(t.Kind()) {
+		return true
+	}
+	return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.String
+}
+
+// csvCell formats a single value for a CSV cell. Timestamps use RFC 3339 and
+// lists of strings are joined with commas.
+func csvCell(v reflect.Value) string {
+	v = indirect(v)
+	if !v.IsValid() {
+		return ""
+	}
+	if v.Type() == timeType {
+		return v.Interface().(time.Time).Format(time.RFC3339)
+	}
+	if v.Kind() == reflect.Slice {
+		parts := make([]string, v.Len())
+		for i := range parts {
+			parts[i] = fmt.Sprint(v.Index(i).Interface())
+		}
+		return strings.Join(parts, ",")
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// indirect dereferences pointers and interfaces, returning the zero Value
+// for nil.
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
 // ErrorResponse represents a structured error response.
 type ErrorResponse struct {
 	Error ErrorDetail `json:"error"`
@@ -269,6 +551,9 @@ func (f *StreamingJSONFormatter) FormatStream(items chan interface{}) error {
 // StreamingTableFormatter formats output as an aligned table with progressive rendering.
 type StreamingTableFormatter struct {
 	out io.Writer
+
+	// Hyperlinks renders ID and URL cells as clickable terminal links.
+	Hyperlinks bool
 }
 
 // FormatStream outputs items as table rows progressively using StreamingTablePrinter.
@@ -281,7 +566,7 @@ func (f *StreamingTableFormatter) FormatStream(items chan interface{}) error {
 			val = val.Elem()
 		}
 
-		row := formatItem(val)
+		row := formatItem(val, f.Hyperlinks)
 		if err := table.AddRow(row...); err != nil {
 			return err
 		}
@@ -311,6 +596,54 @@ func (f *StreamingPlainFormatter) FormatStream(items chan interface{}) error {
 	return nil
 }
 
+// StreamingYAMLFormatter formats output as a YAML list with progressive
+// rendering.
+type StreamingYAMLFormatter struct {
+	out io.Writer
+}
+
+// FormatStream writes each item as an element of a single YAML list.
+func (f *StreamingYAMLFormatter) FormatStream(items chan interface{}) error {
+	for item := range items {
+		value, err := jsonValue(item)
+		if err != nil {
+			return err
+		}
+		if err := writeYAML(f.out, []interface{}{value}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StreamingCSVFormatter formats output as comma-separated values with
+// progressive rendering. The header is taken from the first item.
+type StreamingCSVFormatter struct {
+	out io.Writer
+}
+
+// FormatStream writes the header row followed by one row per item.
+func (f *StreamingCSVFormatter) FormatStream(items chan interface{}) error {
+	var columns []csvColumn
+	for item := range items {
+		val := reflect.ValueOf(item)
+		if columns == nil {
+			columns = csvColumns(val)
+			header := make([]string, len(columns))
+			for i, c := range columns {
+				header[i] = c.name
+			}
+			if err := (tableprinter.CSVRenderer{}).Render(f.out, header, nil); err != nil {
+				return err
+			}
+		}
+		if err := (tableprinter.CSVRenderer{}).Render(f.out, nil, [][]string{csvRow(val, columns)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // NewStreaming creates a new StreamingFormatter for the specified format and output writer.
 func NewStreaming(format OutputFormat, out io.Writer) StreamingFormatter {
 	switch format {
@@ -320,6 +653,10 @@ func NewStreaming(format OutputFormat, out io.Writer) StreamingFormatter {
 		return &StreamingTableFormatter{out: out}
 	case PlainFormat:
 		return &StreamingPlainFormatter{out: out}
+	case YAMLFormat:
+		return &StreamingYAMLFormatter{out: out}
+	case CSVFormat:
+		return &StreamingCSVFormatter{out: out}
 	default:
 		// Return nil for unknown formats
 		return nil
@@ -335,6 +672,10 @@ func New(format OutputFormat, out io.Writer) Formatter {
 		return &TableFormatter{out: out}
 	case PlainFormat:
 		return &PlainFormatter{out: out}
+	case YAMLFormat:
+		return &YAMLFormatter{out: out}
+	case CSVFormat:
+		return &CSVFormatter{out: out}
 	default:
 		// Return nil for unknown formats
 		return nil
@@ -7,6 +7,9 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/PhilipKram/gitlab-cli/pkg/iostreams"
 )
 
 // Test data structures
@@ -396,7 +399,7 @@ func TestFormatItem_Struct(t *testing.T) {
 		Description: "desc",
 	}
 
-	row := formatItem(reflect.ValueOf(data))
+	row := formatItem(reflect.ValueOf(data), false)
 
 	if len(row) != 3 {
 		t.Fatalf("expected 3 fields, got %d", len(row))
@@ -413,9 +416,78 @@ func TestFormatItem_Struct(t *testing.T) {
 	}
 }
 
+func TestFormatItem_Hyperlinks(t *testing.T) {
+	data := struct {
+		IID    int
+		Title  string
+		WebURL string
+	}{IID: 7, Title: "Fix bug", WebURL: "https://gitlab.com/a/b/-/merge_requests/7"}
+
+	row := formatItem(reflect.ValueOf(data), true)
+	link := "\x1b]8;;https://gitlab.com/a/b/-/merge_requests/7\x1b\\"
+	if !strings.HasPrefix(row[0], link+"7") {
+		t.Errorf("row[0] = %q, want hyperlinked IID", row[0])
+	}
+	if row[1] != "Fix bug" {
+		t.Errorf("row[1] = %q, want plain title", row[1])
+	}
+	if !strings.HasPrefix(row[2], link) {
+		t.Errorf("row[2] = %q, want hyperlinked URL", row[2])
+	}
+
+	row = formatItem(reflect.ValueOf(data), false)
+	if row[0] != "7" {
+		t.Errorf("row[0] = %q, want plain IID without hyperlinks", row[0])
+	}
+}
+
+func TestTableFormatter_Terminal(t *testing.T) {
+	type mergeRequest struct {
+		IID       int    `json:"iid"`
+		Title     string `json:"title"`
+		State     string `json:"state"`
+		WebURL    string `json:"web_url"`
+		Reviewers []string
+	}
+
+	buf := &bytes.Buffer{}
+	formatter := &TableFormatter{out: buf, Terminal: true, Width: 80, Color: iostreams.NewColorScheme(true)}
+	data := []mergeRequest{{IID: 7, Title: "Fix bug", State: "merged", WebURL: "https://gitlab.com/a/b/-/merge_requests/7"}}
+	if err := formatter.Format(data); err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected header and one row, got %q", buf.String())
+	}
+	if !strings.HasPrefix(lines[0], "IID  TITLE    STATE   WEB URL") {
+		t.Errorf("unexpected header %q", lines[0])
+	}
+	if !strings.Contains(lines[1], "\x1b[35mmerged\x1b[0m") {
+		t.Errorf("expected colored state, got %q", lines[1])
+	}
+}
+
+func TestFormatColoredItem_Draft(t *testing.T) {
+	type mergeRequest struct {
+		IID   int
+		State string
+		Draft bool
+	}
+
+	item := reflect.ValueOf(mergeRequest{IID: 3, State: "opened", Draft: true})
+	if row := formatColoredItem(item, false, iostreams.NewColorScheme(true)); row[1] != "\x1b[90mdraft\x1b[0m" {
+		t.Errorf("expected draft state, got %q", row[1])
+	}
+	if row := formatColoredItem(item, false, iostreams.NewColorScheme(false)); row[1] != "opened" {
+		t.Errorf("expected raw state without color, got %q", row[1])
+	}
+}
+
 func TestFormatItem_Primitive(t *testing.T) {
 	data := "simple"
-	row := formatItem(reflect.ValueOf(data))
+	row := formatItem(reflect.ValueOf(data), false)
 
 	if len(row) != 1 {
 		t.Fatalf("expected 1 element, got %d", len(row))
@@ -1087,3 +1159,79 @@ func TestStreamingPlainFormatter_FormatStreamPrimitive(t *testing.T) {
 		t.Errorf("lines[1] = %q, want %q", lines[1], "string2")
 	}
 }
+
+type exportStruct struct {
+	ID        int         `json:"id"`
+	Title     string      `json:"title"`
+	Labels    []string    `json:"labels"`
+	CreatedAt *time.Time  `json:"created_at"`
+	Author    *testStruct `json:"author"`
+	Secret    string      `json:"-"`
+}
+
+func TestYAMLFormatter_FormatSlice(t *testing.T) {
+	buf := &bytes.Buffer{}
+	formatter := New(YAMLFormat, buf)
+
+	data := []exportStruct{{ID: 1, Title: "first", Labels: []string{"bug"}, Secret: "hidden"}}
+	if err := formatter.Format(data); err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+
+	want := "- author: null\n  created_at: null\n  id: 1\n  labels:\n    - bug\n  title: first\n"
+	if buf.String() != want {
+		t.Errorf("expected %q, got %q", want, buf.String())
+	}
+}
+
+func TestCSVFormatter_FormatSlice(t *testing.T) {
+	buf := &bytes.Buffer{}
+	formatter := New(CSVFormat, buf)
+
+	created := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	data := []*exportStruct{
+		{ID: 1, Title: "first, really", Labels: []string{"bug", "ui"}, CreatedAt: &created, Author: &testStruct{ID: 9}},
+		{ID: 2, Title: "second"},
+	}
+	if err := formatter.Format(data); err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+
+	want := "id,title,labels,created_at\n" +
+		"1,\"first, really\",\"bug,ui\",2024-03-01T12:00:00Z\n" +
+		"2,second,,\n"
+	if buf.String() != want {
+		t.Errorf("expected %q, got %q", want, buf.String())
+	}
+}
+
+func TestCSVFormatter_FormatMaps(t *testing.T) {
+	buf := &bytes.Buffer{}
+	formatter := New(CSVFormat, buf)
+
+	data := []map[string]interface{}{{"name": "a", "count": 2, "nested": map[string]int{"x": 1}}}
+	if err := formatter.Format(data); err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+
+	if buf.String() != "count,name\n2,a\n" {
+		t.Errorf("unexpected output %q", buf.String())
+	}
+}
+
+func TestStreamingCSVFormatter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	formatter := NewStreaming(CSVFormat, buf)
+
+	items := make(chan interface{}, 2)
+	items <- testStruct{ID: 1, Name: "a"}
+	items <- testStruct{ID: 2, Name: "b"}
+	close(items)
+	if err := formatter.FormatStream(items); err != nil {
+		t.Fatalf("FormatStream: %v", err)
+	}
+
+	if buf.String() != "ID,Name\n1,a\n2,b\n" {
+		t.Errorf("unexpected output %q", buf.String())
+	}
+}
@@ -1,7 +1,9 @@
 package git
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"os/exec"
 	"strings"
@@ -85,6 +87,17 @@ func TopLevelDir() (string, error) {
 	return strings.TrimSpace(output), nil
 }
 
+// PathPrefix returns the path of the current directory relative to the
+// top-level directory of the repository, with a trailing slash, or "" at
+// the top level.
+func PathPrefix() (string, error) {
+	output, err := runGit("rev-parse", "--show-prefix")
+	if err != nil {
+		return "", fmt.Errorf("determining path in repository: %w", err)
+	}
+	return strings.TrimSpace(output), nil
+}
+
 // DefaultBranch returns the default branch of the repository (usually main or master).
 func DefaultBranch(remote string) (string, error) {
 	output, err := runGit("symbolic-ref", fmt.Sprintf("refs/remotes/%s/HEAD", remote))
@@ -106,6 +119,24 @@ func DefaultBranch(remote string) (string, error) {
 	return "", fmt.Errorf("unexpected ref format: %s", ref)
 }
 
+// UpstreamBranch returns the upstream tracking ref of the current branch (e.g. origin/main).
+func UpstreamBranch() (string, error) {
+	output, err := runGit("rev-parse", "--abbrev-ref", "--symbolic-full-name", "@{upstream}")
+	if err != nil {
+		return "", fmt.Errorf("determining upstream branch: %w", err)
+	}
+	return strings.TrimSpace(output), nil
+}
+
+// Diff returns the unified diff of changes on head since it diverged from base.
+func Diff(base, head string) (string, error) {
+	output, err := runGit("diff", "--no-color", "--no-ext-diff", base+"..."+head)
+	if err != nil {
+		return "", fmt.Errorf("computing diff %s...%s: %w", base, head, err)
+	}
+	return output, nil
+}
+
 // CheckoutBranch checks out the given branch, creating it if necessary.
 func CheckoutBranch(branch string) error {
 	_, err := runGit("checkout", branch)
@@ -115,6 +146,213 @@ func CheckoutBranch(branch string) error {
 	return err
 }
 
+// MergeRequestRef returns the ref GitLab maintains for a merge request's
+// head commit. It exists for merge requests from forks as well.
+func MergeRequestRef(iid int64) string {
+	return fmt.Sprintf("refs/merge-requests/%d/head", iid)
+}
+
+// CheckoutMergeRequest fetches the head of merge request iid from remote into
+// branch and checks it out. A new branch is created from the fetched commit;
+// an existing branch is fast-forwarded to it. The branch is set up to track
+// mergeRef on remote so that git pull picks up new pushes.
+func CheckoutMergeRequest(remote string, iid int64, branch, mergeRef string) error {
+	ref := MergeRequestRef(iid)
+
+	if !BranchExists(branch) {
+		if _, err := runGit("fetch", remote, ref+":refs/heads/"+branch); err != nil {
+			return fmt.Errorf("fetching %s from %s: %w", ref, remote, err)
+		}
+		if _, err := runGit("checkout", branch); err != nil {
+			return fmt.Errorf("checking out %s: %w", branch, err)
+		}
+	} else {
+		if _, err := runGit("fetch", remote, ref); err != nil {
+			return fmt.Errorf("fetching %s from %s: %w", ref, remote, err)
+		}
+		if _, err := runGit("checkout", branch); err != nil {
+			return fmt.Errorf("checking out %s: %w", branch, err)
+		}
+		if _, err := runGit("merge", "--ff-only", "FETCH_HEAD"); err != nil {
+			return fmt.Errorf("branch %s has diverged from the merge request; fast-forward failed: %w", branch, err)
+		}
+	}
+
+	if _, err := runGit("config", "branch."+branch+".remote", remote); err != nil {
+		return fmt.Errorf("setting upstream of %s: %w", branch, err)
+	}
+	if _, err := runGit("config", "branch."+branch+".merge", mergeRef); err != nil {
+		return fmt.Errorf("setting upstream of %s: %w", branch, err)
+	}
+	return nil
+}
+
+// CheckoutRemoteBranch fetches branch from remote and checks it out as a
+// local branch that tracks it. An existing local branch is checked out as is.
+func CheckoutRemoteBranch(remote, branch string) error {
+	tracking := "refs/remotes/" + remote + "/" + branch
+	if _, err := runGit("fetch", remote, "+refs/heads/"+branch+":"+tracking); err != nil {
+		return fmt.Errorf("fetching %s from %s: %w", branch, remote, err)
+	}
+	args := []string{"checkout", branch}
+	if !BranchExists(branch) {
+		args = []string{"checkout", "-b", branch, "--track", remote + "/" + branch}
+	}
+	if _, err := runGit(args...); err != nil {
+		return fmt.Errorf("checking out %s: %w", branch, err)
+	}
+	return nil
+}
+
+// BranchExists reports whether a local branch with the given name exists.
+func BranchExists(branch string) bool {
+	_, err := runGit("rev-parse", "--verify", "--quiet", "refs/heads/"+branch)
+	return err == nil
+}
+
+// LocalBranches returns the names of all local branches.
+func LocalBranches() ([]string, error) {
+	output, err := runGit("for-each-ref", "--format=%(refname:short)", "refs/heads")
+	if err != nil {
+		return nil, fmt.Errorf("listing branches: %w", err)
+	}
+	var branches []string
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		if line != "" {
+			branches = append(branches, line)
+		}
+	}
+	return branches, nil
+}
+
+// BranchSHA returns the commit SHA a local branch points to.
+func BranchSHA(branch string) (string, error) {
+	output, err := runGit("rev-parse", "--verify", "refs/heads/"+branch)
+	if err != nil {
+		return "", fmt.Errorf("resolving branch %s: %w", branch, err)
+	}
+	return strings.TrimSpace(output), nil
+}
+
+// CommitSHA returns the SHA of the commit ref, such as a branch, tag, or
+// abbreviated SHA, names.
+func CommitSHA(ref string) (string, error) {
+	output, err := runGit("rev-parse", "--verify", "--end-of-options", ref+"^{commit}")
+	if err != nil {
+		return "", fmt.Errorf("resolving %s: %w", ref, err)
+	}
+	return strings.TrimSpace(output), nil
+}
+
+// HeadSHA returns the commit SHA HEAD points to.
+func HeadSHA() (string, error) {
+	output, err := runGit("rev-parse", "--verify", "HEAD")
+	if err != nil {
+		return "", fmt.Errorf("resolving HEAD: %w", err)
+	}
+	return strings.TrimSpace(output), nil
+}
+
+// SetCredentialHelper makes helper the only credential helper git uses for
+// URLs under url, in the user's global configuration. Helpers configured
+// for all URLs are reset for url by an empty entry first, as git requires.
+func SetCredentialHelper(url, helper string) error {
+	key := "credential." + url + ".helper"
+	if _, err := runGit("config", "--global", "--replace-all", key, ""); err != nil {
+		return fmt.Errorf("configuring %s: %w", key, err)
+	}
+	if _, err := runGit("config", "--global", "--add", key, helper); err != nil {
+		return fmt.Errorf("configuring %s: %w", key, err)
+	}
+	return nil
+}
+
+// Fetch fetches remote, pruning remote-tracking branches that no longer
+// exist on it.
+func Fetch(remote string) error {
+	if _, err := runGit("fetch", "--prune", remote); err != nil {
+		return fmt.Errorf("fetching %s: %w", remote, err)
+	}
+	return nil
+}
+
+// FastForwardBranch fast-forwards the local branch to its counterpart on
+// remote, creating the local branch if it does not exist. It fails instead
+// of rewriting a branch that has diverged.
+func FastForwardBranch(remote, branch string) error {
+	upstream := remote + "/" + branch
+	if current, err := CurrentBranch(); err == nil && current == branch {
+		if _, err := runGit("merge", "--ff-only", upstream); err != nil {
+			return fmt.Errorf("fast-forwarding %s to %s: %w", branch, upstream, err)
+		}
+		return nil
+	}
+	// Fetching from the local repository updates a branch that is not
+	// checked out, and refuses non-fast-forward updates.
+	if _, err := runGit("fetch", ".", "refs/remotes/"+upstream+":refs/heads/"+branch); err != nil {
+		return fmt.Errorf("fast-forwarding %s to %s: %w", branch, upstream, err)
+	}
+	return nil
+}
+
+// Push pushes the local branch to the branch of the same name on remote.
+func Push(remote, branch string) error {
+	if _, err := runGit("push", remote, "refs/heads/"+branch+":refs/heads/"+branch); err != nil {
+		return fmt.Errorf("pushing %s to %s: %w", branch, remote, err)
+	}
+	return nil
+}
+
+// DeleteBranch deletes a local branch, even if it is not merged into HEAD.
+func DeleteBranch(branch string) error {
+	if _, err := runGit("branch", "-D", branch); err != nil {
+		return fmt.Errorf("deleting branch %s: %w", branch, err)
+	}
+	return nil
+}
+
+// FetchRef fetches ref from remote without updating any local branch, so
+// its commits can be used through FETCH_HEAD or their SHAs.
+func FetchRef(remote, ref string) error {
+	if _, err := runGit("fetch", remote, ref); err != nil {
+		return fmt.Errorf("fetching %s from %s: %w", ref, remote, err)
+	}
+	return nil
+}
+
+// Apply applies a patch to the working tree with git apply, passing args
+// (e.g. "--index" or "--3way") through.
+func Apply(patch io.Reader, args ...string) error {
+	args = append(append([]string{"apply"}, args...), "-")
+	if _, err := runGitInput(patch, args...); err != nil {
+		return fmt.Errorf("applying patch: %w", err)
+	}
+	return nil
+}
+
+// CherryPick cherry-picks a commit onto the current branch. A merge commit
+// is picked relative to its first parent, which brings in the changes of
+// the merged branch.
+func CherryPick(commit string) error {
+	args := []string{"cherry-pick"}
+	if parents, err := runGit("rev-list", "--parents", "-n", "1", commit); err == nil && len(strings.Fields(parents)) > 2 {
+		args = append(args, "-m", "1")
+	}
+	if _, err := runGit(append(args, commit)...); err != nil {
+		return fmt.Errorf("cherry-picking %s: %w", commit, err)
+	}
+	return nil
+}
+
+// CherryPickRange cherry-picks the commits after base up to and including
+// head onto the current branch.
+func CherryPickRange(base, head string) error {
+	if _, err := runGit("cherry-pick", base+".."+head); err != nil {
+		return fmt.Errorf("cherry-picking %s..%s: %w", base, head, err)
+	}
+	return nil
+}
+
 // parseRemoteURL extracts host, owner, and repo from a git remote URL.
 func parseRemoteURL(rawURL string) (host, owner, repo string) {
 	// Handle SSH URLs: git@gitlab.com:owner/repo.git
@@ -133,13 +371,17 @@ func parseRemoteURL(rawURL string) (host, owner, repo string) {
 		return host, "", path
 	}
 
-	// Handle HTTPS URLs
+	// Handle HTTP(S) and ssh:// URLs
 	u, err := url.Parse(rawURL)
 	if err != nil {
 		return "", "", ""
 	}
 
+	// An HTTP(S) port is part of the instance's address; an SSH port is not.
 	host = u.Host
+	if u.Scheme != "http" && u.Scheme != "https" {
+		host = u.Hostname()
+	}
 	path := strings.TrimPrefix(u.Path, "/")
 	path = strings.TrimSuffix(path, ".git")
 	pathParts := strings.SplitN(path, "/", 2)
@@ -149,6 +391,59 @@ func parseRemoteURL(rawURL string) (host, owner, repo string) {
 	return host, "", path
 }
 
+// defaultRemoteKey is the key, under remote.<name> in the repository's git
+// config, that marks the remote pinned with "glab repo set-default".
+const defaultRemoteKey = "glab-resolved"
+
+// DefaultRemote returns the name of the remote pinned with "glab repo
+// set-default", or "" when none is pinned.
+func DefaultRemote() (string, error) {
+	output, err := runGit("config", "--get-regexp", `^remote\..*\.`+defaultRemoteKey+`$`)
+	if err != nil {
+		// git config exits with 1 when no key matches.
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+			return "", nil
+		}
+		return "", fmt.Errorf("reading the default remote: %w", err)
+	}
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		key, value, _ := strings.Cut(line, " ")
+		if value != "base" {
+			continue
+		}
+		name := strings.TrimSuffix(strings.TrimPrefix(key, "remote."), "."+defaultRemoteKey)
+		return name, nil
+	}
+	return "", nil
+}
+
+// SetDefaultRemote pins remote as the one whose project glab operates on,
+// replacing any previously pinned remote.
+func SetDefaultRemote(remote string) error {
+	if err := UnsetDefaultRemote(); err != nil {
+		return err
+	}
+	key := "remote." + remote + "." + defaultRemoteKey
+	if _, err := runGit("config", key, "base"); err != nil {
+		return fmt.Errorf("configuring %s: %w", key, err)
+	}
+	return nil
+}
+
+// UnsetDefaultRemote removes the remote pinned with SetDefaultRemote.
+func UnsetDefaultRemote() error {
+	name, err := DefaultRemote()
+	if err != nil || name == "" {
+		return err
+	}
+	key := "remote." + name + "." + defaultRemoteKey
+	if _, err := runGit("config", "--unset-all", key); err != nil {
+		return fmt.Errorf("removing %s: %w", key, err)
+	}
+	return nil
+}
+
 // FindRemote finds a remote matching the given host.
 func FindRemote(remoteName, host string) (*Remote, error) {
 	remotes, err := Remotes()
@@ -189,9 +484,21 @@ func FindRemote(remoteName, host string) (*Remote, error) {
 }
 
 func runGit(args ...string) (string, error) {
+	return runGitInput(nil, args...)
+}
+
+// runGitInput runs git with stdin connected to in.
+func runGitInput(in io.Reader, args ...string) (string, error) {
 	cmd := exec.Command("git", args...)
+	cmd.Stdin = in
 	out, err := cmd.Output()
 	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			if msg := strings.TrimSpace(string(exitErr.Stderr)); msg != "" {
+				return "", fmt.Errorf("%w: %s", err, msg)
+			}
+		}
 		return "", err
 	}
 	return string(out), nil
@@ -4,6 +4,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -338,3 +339,281 @@ func TestRunGit_Error(t *testing.T) {
 		t.Fatal("expected error for invalid git command")
 	}
 }
+
+func TestDiff_BranchChanges(t *testing.T) {
+	dir := setupTestGitRepo(t)
+
+	origDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(origDir) })
+
+	if err := CheckoutBranch("feature"); err != nil {
+		t.Fatalf("CheckoutBranch: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "new.txt"), []byte("hello\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for _, args := range [][]string{{"add", "new.txt"}, {"commit", "-m", "Add new file"}} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+
+	diff, err := Diff("main", "HEAD")
+	if err != nil {
+		t.Fatalf("Diff: %v", err)
+	}
+	if !strings.Contains(diff, "+++ b/new.txt") || !strings.Contains(diff, "+hello") {
+		t.Errorf("unexpected diff:\n%s", diff)
+	}
+}
+
+func TestUpstreamBranch_NoUpstream(t *testing.T) {
+	dir := setupTestGitRepo(t)
+
+	origDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(origDir) })
+
+	if _, err := UpstreamBranch(); err == nil {
+		t.Fatal("expected error when branch has no upstream")
+	}
+}
+
+func TestCheckoutMergeRequest(t *testing.T) {
+	server := setupTestGitRepo(t)
+
+	runIn := func(dir string, args ...string) string {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v failed: %v\n%s", args, err, out)
+		}
+		return strings.TrimSpace(string(out))
+	}
+
+	// Publish a merge request head that is not on any branch, as for a fork.
+	runIn(server, "checkout", "-b", "fork-work")
+	if err := os.WriteFile(filepath.Join(server, "fork.txt"), []byte("one\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	runIn(server, "add", "fork.txt")
+	runIn(server, "commit", "-m", "Fork change")
+	first := runIn(server, "rev-parse", "HEAD")
+	runIn(server, "update-ref", "refs/merge-requests/7/head", first)
+	runIn(server, "checkout", "main")
+	runIn(server, "branch", "-D", "fork-work")
+
+	local := t.TempDir()
+	runIn(local, "clone", "--quiet", server, ".")
+
+	origDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(local); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(origDir) })
+
+	if err := CheckoutMergeRequest("origin", 7, "mr-7", MergeRequestRef(7)); err != nil {
+		t.Fatalf("CheckoutMergeRequest: %v", err)
+	}
+	if branch, _ := CurrentBranch(); branch != "mr-7" {
+		t.Errorf("CurrentBranch = %q, want mr-7", branch)
+	}
+	if head := runIn(local, "rev-parse", "HEAD"); head != first {
+		t.Errorf("HEAD = %s, want %s", head, first)
+	}
+	if merge := runIn(local, "config", "branch.mr-7.merge"); merge != "refs/merge-requests/7/head" {
+		t.Errorf("branch.mr-7.merge = %q", merge)
+	}
+
+	// A new push to the merge request fast-forwards the existing branch.
+	runIn(server, "checkout", "--quiet", first)
+	if err := os.WriteFile(filepath.Join(server, "fork.txt"), []byte("two\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	runIn(server, "commit", "-am", "Follow-up")
+	second := runIn(server, "rev-parse", "HEAD")
+	runIn(server, "update-ref", "refs/merge-requests/7/head", second)
+	runIn(server, "checkout", "--quiet", "main")
+
+	runIn(local, "checkout", "--quiet", "main")
+	if err := CheckoutMergeRequest("origin", 7, "mr-7", MergeRequestRef(7)); err != nil {
+		t.Fatalf("CheckoutMergeRequest (update): %v", err)
+	}
+	if head := runIn(local, "rev-parse", "HEAD"); head != second {
+		t.Errorf("HEAD = %s, want %s after update", head, second)
+	}
+}
+
+func TestCheckoutMergeRequest_MissingRef(t *testing.T) {
+	server := setupTestGitRepo(t)
+	local := t.TempDir()
+
+	cmd := exec.Command("git", "clone", "--quiet", server, ".")
+	cmd.Dir = local
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("clone: %v\n%s", err, out)
+	}
+
+	origDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(local); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(origDir) })
+
+	err = CheckoutMergeRequest("origin", 99, "mr-99", MergeRequestRef(99))
+	if err == nil || !strings.Contains(err.Error(), "refs/merge-requests/99/head") {
+		t.Fatalf("expected fetch error naming the ref, got %v", err)
+	}
+	if BranchExists("mr-99") {
+		t.Error("branch should not be created when the fetch fails")
+	}
+}
+
+func TestLocalBranchesAndDeleteBranch(t *testing.T) {
+	dir := setupTestGitRepo(t)
+	t.Chdir(dir)
+
+	cmd := exec.Command("git", "branch", "feature")
+	cmd.Dir = dir
+	if err := cmd.Run(); err != nil {
+		t.Fatalf("creating branch: %v", err)
+	}
+
+	branches, err := LocalBranches()
+	if err != nil {
+		t.Fatalf("LocalBranches: %v", err)
+	}
+	if strings.Join(branches, ",") != "feature,main" {
+		t.Errorf("LocalBranches = %v, want [feature main]", branches)
+	}
+
+	mainSHA, err := BranchSHA("main")
+	if err != nil {
+		t.Fatalf("BranchSHA: %v", err)
+	}
+	if featureSHA, _ := BranchSHA("feature"); featureSHA != mainSHA {
+		t.Errorf("BranchSHA(feature) = %s, want %s", featureSHA, mainSHA)
+	}
+
+	if err := DeleteBranch("feature"); err != nil {
+		t.Fatalf("DeleteBranch: %v", err)
+	}
+	if BranchExists("feature") {
+		t.Error("expected feature to be deleted")
+	}
+	if _, err := BranchSHA("feature"); err == nil {
+		t.Error("expected error for deleted branch")
+	}
+}
+
+func TestFastForwardBranch_Diverged(t *testing.T) {
+	dir := setupTestGitRepo(t)
+	t.Chdir(dir)
+
+	run := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v\n%s", args, err, out)
+		}
+	}
+	// Point origin/main at a commit main does not contain, then give main
+	// a commit of its own.
+	run("checkout", "-b", "other")
+	run("commit", "--allow-empty", "-m", "other")
+	run("update-ref", "refs/remotes/origin/main", "other")
+	run("checkout", "main")
+	run("commit", "--allow-empty", "-m", "local")
+
+	if err := FastForwardBranch("origin", "main"); err == nil {
+		t.Error("expected error fast-forwarding a diverged branch")
+	}
+
+	run("checkout", "other")
+	if err := FastForwardBranch("origin", "main"); err == nil {
+		t.Error("expected error fast-forwarding a diverged branch that is not checked out")
+	}
+}
+
+func TestDefaultRemote(t *testing.T) {
+	t.Chdir(setupTestGitRepo(t))
+
+	if name, err := DefaultRemote(); err != nil || name != "" {
+		t.Fatalf("DefaultRemote = %q, %v; want none", name, err)
+	}
+	if err := SetDefaultRemote("origin"); err != nil {
+		t.Fatalf("SetDefaultRemote: %v", err)
+	}
+	if err := SetDefaultRemote("upstream"); err != nil {
+		t.Fatalf("SetDefaultRemote: %v", err)
+	}
+	if name, err := DefaultRemote(); err != nil || name != "upstream" {
+		t.Errorf("DefaultRemote = %q, %v; want upstream", name, err)
+	}
+	if out, _ := runGit("config", "--get-regexp", `glab-resolved`); strings.Count(out, "\n") != 1 {
+		t.Errorf("expected exactly one pinned remote, got %q", out)
+	}
+
+	if err := UnsetDefaultRemote(); err != nil {
+		t.Fatalf("UnsetDefaultRemote: %v", err)
+	}
+	if name, err := DefaultRemote(); err != nil || name != "" {
+		t.Errorf("DefaultRemote = %q, %v; want none after unset", name, err)
+	}
+}
+
+func TestCheckoutRemoteBranch(t *testing.T) {
+	server := setupTestGitRepo(t)
+	local := t.TempDir()
+	if out, err := exec.Command("git", "clone", "--quiet", server, local).CombinedOutput(); err != nil {
+		t.Fatalf("clone: %v\n%s", err, out)
+	}
+	// The branch is created on the server after the clone, as by the API.
+	if out, err := exec.Command("git", "-C", server, "branch", "42-fix-login").CombinedOutput(); err != nil {
+		t.Fatalf("branch: %v\n%s", err, out)
+	}
+	t.Chdir(local)
+
+	if err := CheckoutRemoteBranch("origin", "42-fix-login"); err != nil {
+		t.Fatalf("CheckoutRemoteBranch: %v", err)
+	}
+	if branch, _ := CurrentBranch(); branch != "42-fix-login" {
+		t.Errorf("current branch = %q, want 42-fix-login", branch)
+	}
+	if upstream, _ := runGit("rev-parse", "--abbrev-ref", "@{upstream}"); strings.TrimSpace(upstream) != "origin/42-fix-login" {
+		t.Errorf("upstream = %q, want origin/42-fix-login", upstream)
+	}
+
+	// Checking out an existing local branch again succeeds.
+	if _, err := runGit("checkout", "main"); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckoutRemoteBranch("origin", "42-fix-login"); err != nil {
+		t.Fatalf("CheckoutRemoteBranch again: %v", err)
+	}
+	if err := CheckoutRemoteBranch("origin", "missing"); err == nil {
+		t.Error("expected an error for a missing branch")
+	}
+}
@@ -75,6 +75,13 @@ func TestParseRemoteURL(t *testing.T) {
 			wantOwner: "owner",
 			wantRepo:  "repo",
 		},
+		{
+			name:      "ssh:// URL with port",
+			rawURL:    "ssh://git@gitlab.example.com:2222/owner/repo.git",
+			wantHost:  "gitlab.example.com",
+			wantOwner: "owner",
+			wantRepo:  "repo",
+		},
 		{
 			name:      "SSH URL with only repo no owner",
 			rawURL:    "git@gitlab.com:repo.git",
@@ -0,0 +1,81 @@
+package joblog
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// controlRe matches ANSI color codes and the section markers GitLab writes
+// around collapsible log sections (e.g. "section_start:1700000000:build\r").
+var controlRe = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]|section_(?:start|end):\d+:[^\r\n]*\r`)
+
+// sectionRe matches a section marker at the start of a line, with its
+// optional options (e.g. "[collapsed=true]") and the erase-line codes that
+// hide it in a terminal.
+var sectionRe = regexp.MustCompile(`^(?:\x1b\[0K)*section_(start|end):(\d+):([^\[\r\n]+)(?:\[[^\]\r\n]*\])?\r(?:\x1b\[0K)*`)
+
+// Section is the start or end marker of a collapsible log section.
+type Section struct {
+	Name string
+	End  bool
+	Time time.Time
+}
+
+// ParseSection parses the section marker at the start of line and returns
+// it along with the rest of the line. ok is false when line does not start
+// with a marker.
+func ParseSection(line string) (sec Section, rest string, ok bool) {
+	m := sectionRe.FindStringSubmatch(line)
+	if m == nil {
+		return Section{}, line, false
+	}
+	ts, err := strconv.ParseInt(m[2], 10, 64)
+	if err != nil {
+		return Section{}, line, false
+	}
+	sec = Section{Name: m[3], End: m[1] == "end", Time: time.Unix(ts, 0)}
+	return sec, line[len(m[0]):], true
+}
+
+// Clean removes terminal escape codes and section markers from a log line.
+func Clean(line string) string {
+	line = controlRe.ReplaceAllString(line, "")
+	// A carriage return redraws the line; only the last version is visible.
+	if i := strings.LastIndex(line, "\r"); i >= 0 {
+		line = line[i+1:]
+	}
+	return line
+}
+
+// Tail returns the last n cleaned lines of a job log, keeping only lines
+// that match pattern when it is not nil. Blank lines are dropped.
+func Tail(r io.Reader, n int, pattern *regexp.Regexp) ([]string, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+	ring := make([]string, 0, n)
+	start := 0
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(Clean(scanner.Text()), " \t")
+		if line == "" || (pattern != nil && !pattern.MatchString(line)) {
+			continue
+		}
+		if len(ring) < n {
+			ring = append(ring, line)
+			continue
+		}
+		ring[start] = line
+		start = (start + 1) % n
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return append(ring[start:], ring[:start]...), nil
+}
@@ -0,0 +1,69 @@
+package joblog
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestClean(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"\x1b[32;1mJob succeeded\x1b[0;m", "Job succeeded"},
+		{"section_start:1700000000:step_script\r\x1b[0K\x1b[0K\x1b[36;1mExecuting step\x1b[0;m", "Executing step"},
+		{"section_end:1700000001:step_script\r\x1b[0K", ""},
+		{"Downloading 10%\rDownloading 100%", "Downloading 100%"},
+	}
+	for _, tt := range tests {
+		if got := Clean(tt.in); got != tt.want {
+			t.Errorf("Clean(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestTail(t *testing.T) {
+	log := "one\n\x1b[31merror: two\x1b[0m\nthree\n\nerror: four\nfive\n"
+
+	lines, err := Tail(strings.NewReader(log), 2, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(lines, "|") != "error: four|five" {
+		t.Errorf("Tail() = %q", lines)
+	}
+
+	lines, err = Tail(strings.NewReader(log), 5, regexp.MustCompile(`^error`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(lines, "|") != "error: two|error: four" {
+		t.Errorf("Tail() with pattern = %q", lines)
+	}
+
+	if lines, _ := Tail(strings.NewReader(log), 0, nil); lines != nil {
+		t.Errorf("Tail() with n=0 = %q, want nil", lines)
+	}
+}
+
+func TestParseSection(t *testing.T) {
+	sec, rest, ok := ParseSection("section_start:1700000000:step_script[collapsed=true]\r\x1b[0K\x1b[36;1mExecuting step\x1b[0;m")
+	if !ok {
+		t.Fatal("expected a section start")
+	}
+	if sec.Name != "step_script" || sec.End || sec.Time.Unix() != 1700000000 {
+		t.Errorf("ParseSection() = %+v", sec)
+	}
+	if rest != "\x1b[36;1mExecuting step\x1b[0;m" {
+		t.Errorf("rest = %q", rest)
+	}
+
+	sec, rest, ok = ParseSection("\x1b[0Ksection_end:1700000042:step_script\r\x1b[0K")
+	if !ok || !sec.End || sec.Name != "step_script" || rest != "" {
+		t.Errorf("ParseSection() end = %+v, %q, %v", sec, rest, ok)
+	}
+
+	if _, rest, ok := ParseSection("plain output"); ok || rest != "plain output" {
+		t.Errorf("ParseSection() on plain line = %q, %v", rest, ok)
+	}
+}
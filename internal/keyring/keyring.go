@@ -0,0 +1,215 @@
+// Package keyring stores secrets in the operating system's credential
+// store: the macOS Keychain, the Windows Credential Manager, or the Secret
+// Service (GNOME Keyring, KWallet) through secret-tool on Linux and BSD.
+package keyring
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// ErrNotFound is returned by Get when no secret is stored.
+var ErrNotFound = errors.New("secret not found in keyring")
+
+// ErrUnsupported is returned when no credential store is available.
+var ErrUnsupported = errors.New("no OS keyring available")
+
+// provider is a credential store.
+type provider interface {
+	Set(service, user, secret string) error
+	Get(service, user string) (string, error)
+	Delete(service, user string) error
+}
+
+var current = systemProvider()
+
+// Set stores secret for the given service and user, replacing any previous
+// value.
+func Set(service, user, secret string) error {
+	return current.Set(service, user, secret)
+}
+
+// Get returns the secret stored for the given service and user, or
+// ErrNotFound.
+func Get(service, user string) (string, error) {
+	return current.Get(service, user)
+}
+
+// Delete removes the secret stored for the given service and user. Deleting
+// a missing secret is not an error.
+func Delete(service, user string) error {
+	return current.Delete(service, user)
+}
+
+// MockInit replaces the OS credential store with an in-memory one, for
+// tests.
+func MockInit() {
+	current = &mockProvider{secrets: map[string]string{}}
+}
+
+// MockReadError makes Get of the in-memory store set up by MockInit fail
+// with err, like a locked keychain, until it is called with nil.
+func MockReadError(err error) {
+	if m, ok := current.(*mockProvider); ok {
+		m.mu.Lock()
+		m.getErr = err
+		m.mu.Unlock()
+	}
+}
+
+func systemProvider() provider {
+	switch runtime.GOOS {
+	case "darwin":
+		return macOSProvider{}
+	case "windows":
+		return windowsProvider{}
+	case "linux", "freebsd", "openbsd", "netbsd", "dragonfly":
+		return secretToolProvider{}
+	default:
+		return unsupportedProvider{}
+	}
+}
+
+// macOSProvider uses the security command line tool. Secrets are passed on
+// standard input so they never appear in the process list.
+type macOSProvider struct{}
+
+// errSecItemNotFound is the exit status of security when nothing matches.
+const errSecItemNotFound = 44
+
+func (macOSProvider) Set(service, user, secret string) error {
+	cmd := exec.Command("security", "-i")
+	cmd.Stdin = strings.NewReader(fmt.Sprintf("add-generic-password -U -s %s -a %s -X %x\n",
+		quote(service), quote(user), secret))
+	return run(cmd)
+}
+
+func (macOSProvider) Get(service, user string) (string, error) {
+	out, err := exec.Command("security", "find-generic-password", "-s", service, "-a", user, "-w").Output()
+	if exitCode(err) == errSecItemNotFound {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", commandError(err)
+	}
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
+
+func (macOSProvider) Delete(service, user string) error {
+	err := exec.Command("security", "delete-generic-password", "-s", service, "-a", user).Run()
+	if exitCode(err) == errSecItemNotFound {
+		return nil
+	}
+	return commandError(err)
+}
+
+// secretToolProvider uses secret-tool from libsecret.
+type secretToolProvider struct{}
+
+func (secretToolProvider) Set(service, user, secret string) error {
+	cmd := exec.Command("secret-tool", "store", "--label", service+" ("+user+")", "service", service, "account", user)
+	cmd.Stdin = strings.NewReader(secret)
+	return run(cmd)
+}
+
+func (secretToolProvider) Get(service, user string) (string, error) {
+	out, err := exec.Command("secret-tool", "lookup", "service", service, "account", user).Output()
+	if exitCode(err) == 1 && len(out) == 0 {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", commandError(err)
+	}
+	return string(out), nil
+}
+
+func (secretToolProvider) Delete(service, user string) error {
+	err := exec.Command("secret-tool", "clear", "service", service, "account", user).Run()
+	if exitCode(err) == 1 {
+		return nil
+	}
+	return commandError(err)
+}
+
+type unsupportedProvider struct{}
+
+func (unsupportedProvider) Set(service, user, secret string) error { return ErrUnsupported }
+func (unsupportedProvider) Get(service, user string) (string, error) {
+	return "", ErrUnsupported
+}
+func (unsupportedProvider) Delete(service, user string) error { return ErrUnsupported }
+
+type mockProvider struct {
+	mu      sync.Mutex
+	secrets map[string]string
+	getErr  error
+}
+
+func (m *mockProvider) Set(service, user, secret string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.secrets[service+"\x00"+user] = secret
+	return nil
+}
+
+func (m *mockProvider) Get(service, user string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.getErr != nil {
+		return "", m.getErr
+	}
+	secret, ok := m.secrets[service+"\x00"+user]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return secret, nil
+}
+
+func (m *mockProvider) Delete(service, user string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.secrets, service+"\x00"+user)
+	return nil
+}
+
+// run runs cmd and includes its standard error in a failure.
+func run(cmd *exec.Cmd) error {
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("%s: %s", cmd.Args[0], msg)
+		}
+		return commandError(err)
+	}
+	return nil
+}
+
+// commandError reports a missing credential tool as ErrUnsupported.
+func commandError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, exec.ErrNotFound) {
+		return fmt.Errorf("%w: %v", ErrUnsupported, err)
+	}
+	return err
+}
+
+func exitCode(err error) int {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+	return 0
+}
+
+// quote quotes s for the command parser of "security -i".
+func quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
@@ -0,0 +1,6 @@
+//go:build !windows
+
+package keyring
+
+// windowsProvider is only available on Windows.
+type windowsProvider = unsupportedProvider
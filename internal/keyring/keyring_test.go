@@ -0,0 +1,35 @@
+package keyring
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMockProvider(t *testing.T) {
+	MockInit()
+
+	if _, err := Get("glab:gitlab.com", "token"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if err := Set("glab:gitlab.com", "token", "secret"); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := Get("glab:gitlab.com", "token"); err != nil || got != "secret" {
+		t.Errorf("Get() = %q, %v", got, err)
+	}
+	if err := Delete("glab:gitlab.com", "token"); err != nil {
+		t.Fatal(err)
+	}
+	if err := Delete("glab:gitlab.com", "token"); err != nil {
+		t.Errorf("deleting a missing secret should succeed, got %v", err)
+	}
+}
+
+func TestQuote(t *testing.T) {
+	if got := quote(`glab:gitlab.com`); got != `"glab:gitlab.com"` {
+		t.Errorf("quote() = %s", got)
+	}
+	if got := quote(`a"b\c`); got != `"a\"b\\c"` {
+		t.Errorf("quote() = %s", got)
+	}
+}
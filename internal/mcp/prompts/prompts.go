@@ -144,7 +144,7 @@ func registerExplainPipelineFailurePrompt(server *mcp.Server, f *cmdutil.Factory
 - Check the branch and commit that triggered the pipeline
 
 ## Log Analysis
-- Use pipeline_job_log or read the job log resource to retrieve failure logs
+- Use pipeline_failed_jobs to get the end of every failed job log at once, then pipeline_job_log or the job log resource for full logs
 - Identify the specific error messages or stack traces
 - Look for patterns: compilation errors, test failures, timeout, infrastructure issues
 - Note the timing: did it fail immediately or after running for a while?
@@ -62,13 +62,17 @@ func TestNewMCPServerRegistersToolsAndResources(t *testing.T) {
 	}
 
 	expectedTools := []string{
-		"mr_list", "mr_view", "mr_diff", "mr_comment", "mr_approve",
-		"mr_merge", "mr_close", "mr_reopen", "mr_create", "mr_edit",
-		"issue_list", "issue_view", "issue_create", "issue_close",
+		"mr_list", "mr_view", "mr_diff", "mr_notes", "mr_comment", "mr_approve",
+		"mr_checkout", "mr_merge", "mr_close", "mr_reopen", "mr_create", "mr_edit",
+		"mr_discussions", "mr_reply", "mr_resolve", "mr_unresolve",
+		"issue_list", "issue_view", "issue_notes", "issue_create", "issue_close",
 		"issue_reopen", "issue_comment", "issue_edit", "issue_delete",
+		"issue_subscribe", "issue_unsubscribe", "issue_todo",
+		"mr_subscribe", "mr_unsubscribe", "mr_todo",
 		"pipeline_list", "pipeline_view", "pipeline_run", "pipeline_cancel",
 		"pipeline_retry", "pipeline_delete", "pipeline_jobs", "pipeline_job_log",
-		"repo_list", "repo_view",
+		"pipeline_failed_jobs",
+		"repo_list", "repo_view", "repo_star", "repo_unstar", "repo_starred", "repo_notifications",
 		"release_list", "release_view", "release_create", "release_delete",
 		"label_list", "label_create", "label_delete",
 		"snippet_list", "snippet_view", "snippet_create", "snippet_delete",
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/PhilipKram/gitlab-cli/internal/api"
 	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
 )
 
 const (
@@ -46,8 +48,9 @@ func readLog(r io.Reader) (string, error) {
 	return string(data), nil
 }
 
-// resolveClientAndProject returns an authenticated API client and the OWNER/REPO
-// path. repo may be empty (falls back to git remote), OWNER/REPO, or HOST/OWNER/REPO.
+// resolveClientAndProject returns an authenticated API client and the project
+// path or ID. repo may be empty (falls back to git remote) or any form
+// accepted by --repo, such as GROUP/PROJECT, HOST/GROUP/PROJECT, or a URL.
 func resolveClientAndProject(f *cmdutil.Factory, repo string) (*api.Client, string, error) {
 	if repo == "" {
 		client, err := f.Client()
@@ -60,24 +63,7 @@ func resolveClientAndProject(f *cmdutil.Factory, repo string) (*api.Client, stri
 		}
 		return client, project, nil
 	}
-
-	parts := strings.SplitN(repo, "/", 3)
-	if len(parts) == 3 {
-		host := parts[0]
-		project := parts[1] + "/" + parts[2]
-		client, err := api.NewClient(host)
-		if err != nil {
-			return nil, "", err
-		}
-		return client, project, nil
-	}
-
-	// OWNER/REPO — use factory client
-	client, err := f.Client()
-	if err != nil {
-		return nil, "", err
-	}
-	return client, repo, nil
+	return f.RepoClient(repo)
 }
 
 // requireID validates that an ID field is positive and returns an error naming the field.
@@ -96,6 +82,35 @@ func requireString(val, name string) error {
 	return nil
 }
 
+// filterNotes drops system notes unless includeSystem is set, and notes
+// created before since when it is non-empty. since is an RFC 3339 timestamp or
+// a YYYY-MM-DD date.
+func filterNotes(notes []*gitlab.Note, includeSystem bool, since string) ([]*gitlab.Note, error) {
+	var cutoff time.Time
+	if since != "" {
+		var err error
+		cutoff, err = time.Parse(time.RFC3339, since)
+		if err != nil {
+			cutoff, err = time.Parse("2006-01-02", since)
+			if err != nil {
+				return nil, fmt.Errorf("since must be an RFC 3339 timestamp or YYYY-MM-DD date: %q", since)
+			}
+		}
+	}
+
+	filtered := notes[:0]
+	for _, n := range notes {
+		if n.System && !includeSystem {
+			continue
+		}
+		if !cutoff.IsZero() && (n.CreatedAt == nil || n.CreatedAt.Before(cutoff)) {
+			continue
+		}
+		filtered = append(filtered, n)
+	}
+	return filtered, nil
+}
+
 // textResult marshals v as indented JSON and wraps it in a CallToolResult.
 func textResult(v any) (*mcp.CallToolResult, any, error) {
 	data, err := json.MarshalIndent(v, "", "  ")
@@ -3,6 +3,7 @@ package tools
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
@@ -14,12 +15,16 @@ import (
 func RegisterIssueTools(server *mcp.Server, f *cmdutil.Factory) {
 	registerIssueList(server, f)
 	registerIssueView(server, f)
+	registerIssueNotes(server, f)
 	registerIssueCreate(server, f)
 	registerIssueClose(server, f)
 	registerIssueReopen(server, f)
 	registerIssueComment(server, f)
 	registerIssueEdit(server, f)
 	registerIssueDelete(server, f)
+	registerIssueSubscribe(server, f)
+	registerIssueUnsubscribe(server, f)
+	registerIssueTodo(server, f)
 }
 
 func registerIssueList(server *mcp.Server, f *cmdutil.Factory) {
@@ -93,6 +98,53 @@ func registerIssueView(server *mcp.Server, f *cmdutil.Factory) {
 	})
 }
 
+func registerIssueNotes(server *mcp.Server, f *cmdutil.Factory) {
+	type Input struct {
+		Issue         int64  `json:"issue"                    jsonschema:"issue IID"`
+		Repo          string `json:"repo,omitempty"           jsonschema:"repository in OWNER/REPO or HOST/OWNER/REPO format"`
+		Sort          string `json:"sort,omitempty"           jsonschema:"sort order: asc or desc (default asc)"`
+		IncludeSystem bool   `json:"include_system,omitempty" jsonschema:"include system notes (label changes, status transitions); default false"`
+		Since         string `json:"since,omitempty"          jsonschema:"only return notes created at or after this RFC 3339 timestamp or YYYY-MM-DD date"`
+		PerPage       int64  `json:"per_page,omitempty"       jsonschema:"notes per page (default 50, max 100)"`
+		Page          int64  `json:"page,omitempty"           jsonschema:"page number for pagination (1-indexed)"`
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "issue_notes",
+		Description: "List notes (comments) on an issue. System notes are excluded by default; set include_system=true to include label/status change events. Use since to fetch only new comments.",
+	}, func(_ context.Context, _ *mcp.CallToolRequest, in Input) (*mcp.CallToolResult, any, error) {
+		if err := requireID(in.Issue, "issue"); err != nil {
+			return nil, nil, err
+		}
+		client, project, err := resolveClientAndProject(f, in.Repo)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		opts := &gitlab.ListIssueNotesOptions{}
+		if in.Sort != "" {
+			opts.Sort = &in.Sort
+		}
+		opts.PerPage = in.PerPage
+		opts.Page = in.Page
+		if opts.PerPage == 0 {
+			opts.PerPage = 50
+		}
+
+		notes, _, err := client.Notes.ListIssueNotes(project, in.Issue, opts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("listing issue notes: %w", err)
+		}
+
+		notes, err = filterNotes(notes, in.IncludeSystem, in.Since)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return textResult(notes)
+	})
+}
+
 func registerIssueCreate(server *mcp.Server, f *cmdutil.Factory) {
 	type Input struct {
 		Title       string `json:"title"                 jsonschema:"issue title"`
@@ -282,3 +334,87 @@ func registerIssueDelete(server *mcp.Server, f *cmdutil.Factory) {
 		return plainResult(fmt.Sprintf("Deleted issue #%d", in.Issue)), nil, nil
 	})
 }
+
+func registerIssueSubscribe(server *mcp.Server, f *cmdutil.Factory) {
+	type Input struct {
+		Issue int64  `json:"issue"           jsonschema:"issue IID"`
+		Repo  string `json:"repo,omitempty"  jsonschema:"repository in OWNER/REPO or HOST/OWNER/REPO format"`
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "issue_subscribe",
+		Description: "Subscribe to notifications for an issue",
+	}, func(_ context.Context, _ *mcp.CallToolRequest, in Input) (*mcp.CallToolResult, any, error) {
+		if err := requireID(in.Issue, "issue"); err != nil {
+			return nil, nil, err
+		}
+		client, project, err := resolveClientAndProject(f, in.Repo)
+		if err != nil {
+			return nil, nil, err
+		}
+		_, resp, err := client.Issues.SubscribeToIssue(project, in.Issue)
+		if resp != nil && resp.StatusCode == http.StatusNotModified {
+			return plainResult(fmt.Sprintf("Already subscribed to issue #%d", in.Issue)), nil, nil
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("subscribing to issue: %w", err)
+		}
+		return plainResult(fmt.Sprintf("Subscribed to issue #%d", in.Issue)), nil, nil
+	})
+}
+
+func registerIssueUnsubscribe(server *mcp.Server, f *cmdutil.Factory) {
+	type Input struct {
+		Issue int64  `json:"issue"           jsonschema:"issue IID"`
+		Repo  string `json:"repo,omitempty"  jsonschema:"repository in OWNER/REPO or HOST/OWNER/REPO format"`
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "issue_unsubscribe",
+		Description: "Unsubscribe from notifications for an issue",
+	}, func(_ context.Context, _ *mcp.CallToolRequest, in Input) (*mcp.CallToolResult, any, error) {
+		if err := requireID(in.Issue, "issue"); err != nil {
+			return nil, nil, err
+		}
+		client, project, err := resolveClientAndProject(f, in.Repo)
+		if err != nil {
+			return nil, nil, err
+		}
+		_, resp, err := client.Issues.UnsubscribeFromIssue(project, in.Issue)
+		if resp != nil && resp.StatusCode == http.StatusNotModified {
+			return plainResult(fmt.Sprintf("Not subscribed to issue #%d", in.Issue)), nil, nil
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("unsubscribing from issue: %w", err)
+		}
+		return plainResult(fmt.Sprintf("Unsubscribed from issue #%d", in.Issue)), nil, nil
+	})
+}
+
+func registerIssueTodo(server *mcp.Server, f *cmdutil.Factory) {
+	type Input struct {
+		Issue int64  `json:"issue"           jsonschema:"issue IID"`
+		Repo  string `json:"repo,omitempty"  jsonschema:"repository in OWNER/REPO or HOST/OWNER/REPO format"`
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "issue_todo",
+		Description: "Add an issue to the authenticated user's to-do list",
+	}, func(_ context.Context, _ *mcp.CallToolRequest, in Input) (*mcp.CallToolResult, any, error) {
+		if err := requireID(in.Issue, "issue"); err != nil {
+			return nil, nil, err
+		}
+		client, project, err := resolveClientAndProject(f, in.Repo)
+		if err != nil {
+			return nil, nil, err
+		}
+		_, resp, err := client.Issues.CreateTodo(project, in.Issue)
+		if resp != nil && resp.StatusCode == http.StatusNotModified {
+			return plainResult(fmt.Sprintf("Issue #%d is already on the to-do list", in.Issue)), nil, nil
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("creating to-do item: %w", err)
+		}
+		return plainResult(fmt.Sprintf("Added issue #%d to the to-do list", in.Issue)), nil, nil
+	})
+}
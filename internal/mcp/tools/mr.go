@@ -3,6 +3,7 @@ package tools
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
@@ -18,14 +19,19 @@ func RegisterMRTools(server *mcp.Server, f *cmdutil.Factory) {
 	registerMRNotes(server, f)
 	registerMRComment(server, f)
 	registerMRApprove(server, f)
+	registerMRCheckout(server, f)
 	registerMRMerge(server, f)
 	registerMRClose(server, f)
 	registerMRReopen(server, f)
 	registerMRCreate(server, f)
 	registerMREdit(server, f)
 	registerMRDiscussions(server, f)
+	registerMRReply(server, f)
 	registerMRResolve(server, f)
 	registerMRUnresolve(server, f)
+	registerMRSubscribe(server, f)
+	registerMRUnsubscribe(server, f)
+	registerMRTodo(server, f)
 }
 
 func registerMRList(server *mcp.Server, f *cmdutil.Factory) {
@@ -137,13 +143,14 @@ func registerMRNotes(server *mcp.Server, f *cmdutil.Factory) {
 		Repo           string `json:"repo,omitempty"             jsonschema:"repository in OWNER/REPO or HOST/OWNER/REPO format"`
 		Sort           string `json:"sort,omitempty"             jsonschema:"sort order: asc or desc (default asc)"`
 		IncludeSystem  bool   `json:"include_system,omitempty"   jsonschema:"include system notes (label changes, status transitions); default false"`
+		Since          string `json:"since,omitempty"            jsonschema:"only return notes created at or after this RFC 3339 timestamp or YYYY-MM-DD date"`
 		PerPage        int64  `json:"per_page,omitempty"         jsonschema:"notes per page (default 50, max 100)"`
 		Page           int64  `json:"page,omitempty"             jsonschema:"page number for pagination (1-indexed)"`
 	}
 
 	mcp.AddTool(server, &mcp.Tool{
 		Name:        "mr_notes",
-		Description: "List notes (comments) on a merge request. System notes are excluded by default; set include_system=true to include label/status change events.",
+		Description: "List notes (comments) on a merge request, such as review feedback. System notes are excluded by default; set include_system=true to include label/status change events. Use since to fetch only new comments.",
 	}, func(_ context.Context, _ *mcp.CallToolRequest, in Input) (*mcp.CallToolResult, any, error) {
 		if err := requireID(in.MR, "mr"); err != nil {
 			return nil, nil, err
@@ -168,14 +175,9 @@ func registerMRNotes(server *mcp.Server, f *cmdutil.Factory) {
 			return nil, nil, fmt.Errorf("listing merge request notes: %w", err)
 		}
 
-		if !in.IncludeSystem {
-			filtered := notes[:0]
-			for _, n := range notes {
-				if !n.System {
-					filtered = append(filtered, n)
-				}
-			}
-			notes = filtered
+		notes, err = filterNotes(notes, in.IncludeSystem, in.Since)
+		if err != nil {
+			return nil, nil, err
 		}
 
 		return textResult(notes)
@@ -238,6 +240,35 @@ func registerMRApprove(server *mcp.Server, f *cmdutil.Factory) {
 	})
 }
 
+func registerMRCheckout(server *mcp.Server, f *cmdutil.Factory) {
+	type Input struct {
+		MR     int64  `json:"mr"               jsonschema:"merge request IID"`
+		Branch string `json:"branch,omitempty" jsonschema:"name of the local branch (default: source branch)"`
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "mr_checkout",
+		Description: "Check out a merge request in the local git repository by fetching refs/merge-requests/<iid>/head; works for merge requests from forks",
+	}, func(_ context.Context, _ *mcp.CallToolRequest, in Input) (*mcp.CallToolResult, any, error) {
+		if err := requireID(in.MR, "mr"); err != nil {
+			return nil, nil, err
+		}
+		client, project, err := resolveClientAndProject(f, "")
+		if err != nil {
+			return nil, nil, err
+		}
+		mr, _, err := client.MergeRequests.GetMergeRequest(project, in.MR, nil)
+		if err != nil {
+			return nil, nil, fmt.Errorf("getting merge request: %w", err)
+		}
+		branch, err := cmdutil.CheckoutMergeRequest(f, &mr.BasicMergeRequest, in.Branch)
+		if err != nil {
+			return nil, nil, fmt.Errorf("checking out merge request: %w", err)
+		}
+		return plainResult(fmt.Sprintf("Checked out !%d on branch %s", in.MR, branch)), nil, nil
+	})
+}
+
 func registerMRMerge(server *mcp.Server, f *cmdutil.Factory) {
 	type Input struct {
 		MR                 int64  `json:"mr"                            jsonschema:"merge request IID"`
@@ -411,8 +442,9 @@ func registerMREdit(server *mcp.Server, f *cmdutil.Factory) {
 
 func registerMRDiscussions(server *mcp.Server, f *cmdutil.Factory) {
 	type Input struct {
-		MR   int64  `json:"mr"              jsonschema:"merge request IID"`
-		Repo string `json:"repo,omitempty"  jsonschema:"repository in OWNER/REPO or HOST/OWNER/REPO format"`
+		MR             int64  `json:"mr"                        jsonschema:"merge request IID"`
+		Repo           string `json:"repo,omitempty"            jsonschema:"repository in OWNER/REPO or HOST/OWNER/REPO format"`
+		UnresolvedOnly bool   `json:"unresolved_only,omitempty" jsonschema:"only return threads that still have unresolved notes"`
 	}
 
 	mcp.AddTool(server, &mcp.Tool{
@@ -430,10 +462,68 @@ func registerMRDiscussions(server *mcp.Server, f *cmdutil.Factory) {
 		if err != nil {
 			return nil, nil, fmt.Errorf("listing merge request discussions: %w", err)
 		}
+		if in.UnresolvedOnly {
+			filtered := discussions[:0]
+			for _, d := range discussions {
+				for _, n := range d.Notes {
+					if n.Resolvable && !n.Resolved {
+						filtered = append(filtered, d)
+						break
+					}
+				}
+			}
+			discussions = filtered
+		}
 		return textResult(discussions)
 	})
 }
 
+func registerMRReply(server *mcp.Server, f *cmdutil.Factory) {
+	type Input struct {
+		MR         int64  `json:"mr"                 jsonschema:"merge request IID"`
+		Discussion string `json:"discussion"         jsonschema:"discussion thread ID (from mr_discussions)"`
+		Message    string `json:"message"            jsonschema:"reply body text"`
+		Resolve    bool   `json:"resolve,omitempty"  jsonschema:"resolve the thread after replying"`
+		Repo       string `json:"repo,omitempty"     jsonschema:"repository in OWNER/REPO or HOST/OWNER/REPO format"`
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "mr_reply",
+		Description: "Reply to a discussion thread on a merge request, optionally resolving it. Use mr_discussions to find thread IDs.",
+	}, func(_ context.Context, _ *mcp.CallToolRequest, in Input) (*mcp.CallToolResult, any, error) {
+		if err := requireID(in.MR, "mr"); err != nil {
+			return nil, nil, err
+		}
+		if err := requireString(in.Discussion, "discussion"); err != nil {
+			return nil, nil, err
+		}
+		if err := requireString(in.Message, "message"); err != nil {
+			return nil, nil, err
+		}
+		client, project, err := resolveClientAndProject(f, in.Repo)
+		if err != nil {
+			return nil, nil, err
+		}
+		note, _, err := client.Discussions.AddMergeRequestDiscussionNote(project, in.MR, in.Discussion, &gitlab.AddMergeRequestDiscussionNoteOptions{
+			Body: &in.Message,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("replying to discussion: %w", err)
+		}
+		msg := fmt.Sprintf("Replied to discussion %s on !%d (note %d)", in.Discussion, in.MR, note.ID)
+		if in.Resolve {
+			_, _, err := client.Discussions.ResolveMergeRequestDiscussion(project, in.MR, in.Discussion, &gitlab.ResolveMergeRequestDiscussionOptions{
+				Resolved: gitlab.Ptr(true),
+			})
+			if err != nil {
+				return nil, nil, fmt.Errorf("resolving discussion: %w", err)
+			}
+			msg += " and resolved it"
+		}
+		return plainResult(msg), nil, nil
+	})
+}
+
 func registerMRResolve(server *mcp.Server, f *cmdutil.Factory) {
 	type Input struct {
 		MR         int64  `json:"mr"             jsonschema:"merge request IID"`
@@ -497,3 +587,87 @@ func registerMRUnresolve(server *mcp.Server, f *cmdutil.Factory) {
 		return plainResult(fmt.Sprintf("Unresolved discussion %s on !%d", discussion.ID, in.MR)), nil, nil
 	})
 }
+
+func registerMRSubscribe(server *mcp.Server, f *cmdutil.Factory) {
+	type Input struct {
+		MR   int64  `json:"mr"              jsonschema:"merge request IID"`
+		Repo string `json:"repo,omitempty"  jsonschema:"repository in OWNER/REPO or HOST/OWNER/REPO format"`
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "mr_subscribe",
+		Description: "Subscribe to notifications for a merge request",
+	}, func(_ context.Context, _ *mcp.CallToolRequest, in Input) (*mcp.CallToolResult, any, error) {
+		if err := requireID(in.MR, "mr"); err != nil {
+			return nil, nil, err
+		}
+		client, project, err := resolveClientAndProject(f, in.Repo)
+		if err != nil {
+			return nil, nil, err
+		}
+		_, resp, err := client.MergeRequests.SubscribeToMergeRequest(project, in.MR)
+		if resp != nil && resp.StatusCode == http.StatusNotModified {
+			return plainResult(fmt.Sprintf("Already subscribed to merge request !%d", in.MR)), nil, nil
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("subscribing to merge request: %w", err)
+		}
+		return plainResult(fmt.Sprintf("Subscribed to merge request !%d", in.MR)), nil, nil
+	})
+}
+
+func registerMRUnsubscribe(server *mcp.Server, f *cmdutil.Factory) {
+	type Input struct {
+		MR   int64  `json:"mr"              jsonschema:"merge request IID"`
+		Repo string `json:"repo,omitempty"  jsonschema:"repository in OWNER/REPO or HOST/OWNER/REPO format"`
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "mr_unsubscribe",
+		Description: "Unsubscribe from notifications for a merge request",
+	}, func(_ context.Context, _ *mcp.CallToolRequest, in Input) (*mcp.CallToolResult, any, error) {
+		if err := requireID(in.MR, "mr"); err != nil {
+			return nil, nil, err
+		}
+		client, project, err := resolveClientAndProject(f, in.Repo)
+		if err != nil {
+			return nil, nil, err
+		}
+		_, resp, err := client.MergeRequests.UnsubscribeFromMergeRequest(project, in.MR)
+		if resp != nil && resp.StatusCode == http.StatusNotModified {
+			return plainResult(fmt.Sprintf("Not subscribed to merge request !%d", in.MR)), nil, nil
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("unsubscribing from merge request: %w", err)
+		}
+		return plainResult(fmt.Sprintf("Unsubscribed from merge request !%d", in.MR)), nil, nil
+	})
+}
+
+func registerMRTodo(server *mcp.Server, f *cmdutil.Factory) {
+	type Input struct {
+		MR   int64  `json:"mr"              jsonschema:"merge request IID"`
+		Repo string `json:"repo,omitempty"  jsonschema:"repository in OWNER/REPO or HOST/OWNER/REPO format"`
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "mr_todo",
+		Description: "Add a merge request to the authenticated user's to-do list",
+	}, func(_ context.Context, _ *mcp.CallToolRequest, in Input) (*mcp.CallToolResult, any, error) {
+		if err := requireID(in.MR, "mr"); err != nil {
+			return nil, nil, err
+		}
+		client, project, err := resolveClientAndProject(f, in.Repo)
+		if err != nil {
+			return nil, nil, err
+		}
+		_, resp, err := client.MergeRequests.CreateTodo(project, in.MR)
+		if resp != nil && resp.StatusCode == http.StatusNotModified {
+			return plainResult(fmt.Sprintf("Merge request !%d is already on the to-do list", in.MR)), nil, nil
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("creating to-do item: %w", err)
+		}
+		return plainResult(fmt.Sprintf("Added merge request !%d to the to-do list", in.MR)), nil, nil
+	})
+}
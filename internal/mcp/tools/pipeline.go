@@ -3,10 +3,12 @@ package tools
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/PhilipKram/gitlab-cli/internal/api"
 	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
+	"github.com/PhilipKram/gitlab-cli/internal/joblog"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 )
@@ -21,6 +23,7 @@ func RegisterPipelineTools(server *mcp.Server, f *cmdutil.Factory) {
 	registerPipelineDelete(server, f)
 	registerPipelineJobs(server, f)
 	registerPipelineJobLog(server, f)
+	registerPipelineFailedJobs(server, f)
 }
 
 func registerPipelineList(server *mcp.Server, f *cmdutil.Factory) {
@@ -301,3 +304,86 @@ func registerPipelineJobLog(server *mcp.Server, f *cmdutil.Factory) {
 		return plainResult(log), nil, nil
 	})
 }
+
+// maxExcerptLines caps the log lines returned per job by pipeline_failed_jobs.
+const maxExcerptLines = 500
+
+func registerPipelineFailedJobs(server *mcp.Server, f *cmdutil.Factory) {
+	type Input struct {
+		Pipeline int64  `json:"pipeline"        jsonschema:"pipeline ID"`
+		Repo     string `json:"repo,omitempty"  jsonschema:"repository in OWNER/REPO or HOST/OWNER/REPO format"`
+		Lines    int64  `json:"lines,omitempty" jsonschema:"number of log lines to return per job (default 30, max 500)"`
+		Grep     string `json:"grep,omitempty"  jsonschema:"only return log lines matching this regular expression, e.g. error|FAIL"`
+	}
+
+	type failedJob struct {
+		ID            int64    `json:"id"`
+		Name          string   `json:"name"`
+		Stage         string   `json:"stage"`
+		FailureReason string   `json:"failure_reason"`
+		AllowFailure  bool     `json:"allow_failure"`
+		WebURL        string   `json:"web_url"`
+		Log           []string `json:"log"`
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "pipeline_failed_jobs",
+		Description: "List the failed jobs of a pipeline with the last lines of each job log (color codes removed), optionally filtered by a regular expression",
+	}, func(_ context.Context, _ *mcp.CallToolRequest, in Input) (*mcp.CallToolResult, any, error) {
+		if err := requireID(in.Pipeline, "pipeline"); err != nil {
+			return nil, nil, err
+		}
+		var pattern *regexp.Regexp
+		if in.Grep != "" {
+			var err error
+			pattern, err = regexp.Compile(in.Grep)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid grep pattern: %w", err)
+			}
+		}
+		lines := in.Lines
+		if lines <= 0 {
+			lines = 30
+		}
+		lines = min(lines, maxExcerptLines)
+
+		client, project, err := resolveClientAndProject(f, in.Repo)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		scope := []gitlab.BuildStateValue{gitlab.Failed}
+		jobs, _, err := client.Jobs.ListPipelineJobs(project, in.Pipeline, &gitlab.ListJobsOptions{
+			ListOptions: gitlab.ListOptions{PerPage: maxPerPage},
+			Scope:       &scope,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("listing jobs: %w", err)
+		}
+		if len(jobs) == 0 {
+			return plainResult(fmt.Sprintf("No failed jobs in pipeline #%d", in.Pipeline)), nil, nil
+		}
+
+		result := make([]failedJob, 0, len(jobs))
+		for _, j := range jobs {
+			reader, _, err := client.Jobs.GetTraceFile(project, j.ID)
+			if err != nil {
+				return nil, nil, fmt.Errorf("getting log of job #%d: %w", j.ID, err)
+			}
+			log, err := joblog.Tail(reader, int(lines), pattern)
+			if err != nil {
+				return nil, nil, fmt.Errorf("reading log of job #%d: %w", j.ID, err)
+			}
+			result = append(result, failedJob{
+				ID:            j.ID,
+				Name:          j.Name,
+				Stage:         j.Stage,
+				FailureReason: j.FailureReason,
+				AllowFailure:  j.AllowFailure,
+				WebURL:        j.WebURL,
+				Log:           log,
+			})
+		}
+		return textResult(result)
+	})
+}
@@ -3,6 +3,7 @@ package tools
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/PhilipKram/gitlab-cli/internal/cmdutil"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -13,6 +14,10 @@ import (
 func RegisterRepoTools(server *mcp.Server, f *cmdutil.Factory) {
 	registerRepoList(server, f)
 	registerRepoView(server, f)
+	registerRepoStar(server, f)
+	registerRepoUnstar(server, f)
+	registerRepoStarred(server, f)
+	registerRepoNotifications(server, f)
 }
 
 func registerRepoList(server *mcp.Server, f *cmdutil.Factory) {
@@ -78,3 +83,125 @@ func registerRepoView(server *mcp.Server, f *cmdutil.Factory) {
 		return textResult(p)
 	})
 }
+
+func registerRepoStar(server *mcp.Server, f *cmdutil.Factory) {
+	type Input struct {
+		Repo string `json:"repo,omitempty" jsonschema:"repository in OWNER/REPO or HOST/OWNER/REPO format"`
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "repo_star",
+		Description: "Star a GitLab repository",
+	}, func(_ context.Context, _ *mcp.CallToolRequest, in Input) (*mcp.CallToolResult, any, error) {
+		client, project, err := resolveClientAndProject(f, in.Repo)
+		if err != nil {
+			return nil, nil, err
+		}
+		_, resp, err := client.Projects.StarProject(project)
+		if resp != nil && resp.StatusCode == http.StatusNotModified {
+			return plainResult(fmt.Sprintf("Already starred %s", project)), nil, nil
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("starring project: %w", err)
+		}
+		return plainResult(fmt.Sprintf("Starred %s", project)), nil, nil
+	})
+}
+
+func registerRepoUnstar(server *mcp.Server, f *cmdutil.Factory) {
+	type Input struct {
+		Repo string `json:"repo,omitempty" jsonschema:"repository in OWNER/REPO or HOST/OWNER/REPO format"`
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "repo_unstar",
+		Description: "Remove the star from a GitLab repository",
+	}, func(_ context.Context, _ *mcp.CallToolRequest, in Input) (*mcp.CallToolResult, any, error) {
+		client, project, err := resolveClientAndProject(f, in.Repo)
+		if err != nil {
+			return nil, nil, err
+		}
+		_, resp, err := client.Projects.UnstarProject(project)
+		if resp != nil && resp.StatusCode == http.StatusNotModified {
+			return plainResult(fmt.Sprintf("%s is not starred", project)), nil, nil
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("unstarring project: %w", err)
+		}
+		return plainResult(fmt.Sprintf("Unstarred %s", project)), nil, nil
+	})
+}
+
+func registerRepoStarred(server *mcp.Server, f *cmdutil.Factory) {
+	type Input struct {
+		User  string `json:"user,omitempty"  jsonschema:"username whose starred repositories to list (default: the authenticated user)"`
+		Limit int64  `json:"limit,omitempty" jsonschema:"maximum number of results (default 30)"`
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "repo_starred",
+		Description: "List starred GitLab repositories",
+	}, func(_ context.Context, _ *mcp.CallToolRequest, in Input) (*mcp.CallToolResult, any, error) {
+		client, err := f.Client()
+		if err != nil {
+			return nil, nil, err
+		}
+		opts := &gitlab.ListProjectsOptions{
+			ListOptions: gitlab.ListOptions{PerPage: clampPerPage(in.Limit)},
+		}
+		var projects []*gitlab.Project
+		if in.User != "" {
+			projects, _, err = client.Projects.ListUserStarredProjects(in.User, opts)
+		} else {
+			opts.Starred = gitlab.Ptr(true)
+			projects, _, err = client.Projects.ListProjects(opts)
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("listing starred projects: %w", err)
+		}
+		return textResult(projects)
+	})
+}
+
+func registerRepoNotifications(server *mcp.Server, f *cmdutil.Factory) {
+	type Input struct {
+		Repo  string `json:"repo,omitempty"  jsonschema:"repository in OWNER/REPO or HOST/OWNER/REPO format"`
+		Level string `json:"level,omitempty" jsonschema:"new notification level: global, watch, participating, mention, or disabled; omit to get the current level"`
+	}
+
+	levels := map[string]gitlab.NotificationLevelValue{
+		"global":        gitlab.GlobalNotificationLevel,
+		"watch":         gitlab.WatchNotificationLevel,
+		"participating": gitlab.ParticipatingNotificationLevel,
+		"mention":       gitlab.MentionNotificationLevel,
+		"disabled":      gitlab.DisabledNotificationLevel,
+	}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "repo_notifications",
+		Description: "Get or set your notification level for a GitLab repository",
+	}, func(_ context.Context, _ *mcp.CallToolRequest, in Input) (*mcp.CallToolResult, any, error) {
+		client, project, err := resolveClientAndProject(f, in.Repo)
+		if err != nil {
+			return nil, nil, err
+		}
+		if in.Level == "" {
+			settings, _, err := client.NotificationSettings.GetSettingsForProject(project)
+			if err != nil {
+				return nil, nil, fmt.Errorf("getting notification settings: %w", err)
+			}
+			return textResult(settings)
+		}
+		level, ok := levels[in.Level]
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid level %q: use global, watch, participating, mention, or disabled", in.Level)
+		}
+		settings, _, err := client.NotificationSettings.UpdateSettingsForProject(project, &gitlab.NotificationSettingsOptions{
+			Level: &level,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("updating notification settings: %w", err)
+		}
+		return textResult(settings)
+	})
+}
@@ -2,6 +2,7 @@ package tools
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
@@ -528,6 +529,44 @@ func TestIssueDelete(t *testing.T) {
 	}
 }
 
+func TestIssueSubscribe(t *testing.T) {
+	mux := cmdtest.NewRouterMux()
+	mux.HandleFunc("/api/v4/projects/test-owner/test-repo/issues/9/subscribe", func(w http.ResponseWriter, r *http.Request) {
+		cmdtest.JSONResponse(w, http.StatusCreated, cmdtest.MockIssue(9, "Bug", "opened"))
+	})
+
+	cs := setupServer(t, mux)
+	text, err := callTool(t, cs, "issue_subscribe", map[string]any{
+		"repo":  "test-owner/test-repo",
+		"issue": 9,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(text, "Subscribed to issue #9") {
+		t.Errorf("expected subscribe confirmation, got: %s", text)
+	}
+}
+
+func TestIssueTodo_AlreadyExists(t *testing.T) {
+	mux := cmdtest.NewRouterMux()
+	mux.HandleFunc("/api/v4/projects/test-owner/test-repo/issues/9/todo", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotModified)
+	})
+
+	cs := setupServer(t, mux)
+	text, err := callTool(t, cs, "issue_todo", map[string]any{
+		"repo":  "test-owner/test-repo",
+		"issue": 9,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(text, "already on the to-do list") {
+		t.Errorf("expected already-exists message, got: %s", text)
+	}
+}
+
 // --- MR tool tests ---
 
 func TestMRList(t *testing.T) {
@@ -779,6 +818,39 @@ func TestMRResolveRequiresDiscussion(t *testing.T) {
 	}
 }
 
+func TestMRReply(t *testing.T) {
+	mux := cmdtest.NewRouterMux()
+	mux.HandleFunc("/api/v4/projects/test-owner/test-repo/merge_requests/1/discussions/abc123/notes", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		cmdtest.JSONResponse(w, http.StatusCreated, map[string]interface{}{"id": 42, "body": "fixed"})
+	})
+	resolved := false
+	mux.HandleFunc("/api/v4/projects/test-owner/test-repo/merge_requests/1/discussions/abc123", func(w http.ResponseWriter, r *http.Request) {
+		resolved = r.Method == http.MethodPut
+		cmdtest.JSONResponse(w, http.StatusOK, map[string]interface{}{"id": "abc123"})
+	})
+
+	cs := setupServer(t, mux)
+	text, err := callTool(t, cs, "mr_reply", map[string]any{
+		"repo":       "test-owner/test-repo",
+		"mr":         1,
+		"discussion": "abc123",
+		"message":    "fixed",
+		"resolve":    true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(text, "Replied to discussion abc123 on !1 (note 42) and resolved it") {
+		t.Errorf("expected reply confirmation, got: %s", text)
+	}
+	if !resolved {
+		t.Error("expected discussion to be resolved")
+	}
+}
+
 func TestMRUnresolve(t *testing.T) {
 	mux := cmdtest.NewRouterMux()
 	mux.HandleFunc("/api/v4/projects/test-owner/test-repo/merge_requests/1/discussions/abc123", func(w http.ResponseWriter, r *http.Request) {
@@ -1231,6 +1303,60 @@ func TestRepoView(t *testing.T) {
 	}
 }
 
+func TestRepoStar(t *testing.T) {
+	mux := cmdtest.NewRouterMux()
+	mux.HandleFunc("/api/v4/projects/test-owner/test-repo/star", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		w.WriteHeader(http.StatusNotModified)
+	})
+
+	cs := setupServer(t, mux)
+	text, err := callTool(t, cs, "repo_star", map[string]any{
+		"repo": "test-owner/test-repo",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text != "Already starred test-owner/test-repo" {
+		t.Errorf("unexpected output: %s", text)
+	}
+}
+
+func TestRepoNotifications(t *testing.T) {
+	mux := cmdtest.NewRouterMux()
+	var level any
+	mux.HandleFunc("/api/v4/projects/test-owner/test-repo/notification_settings", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		level = body["level"]
+		cmdtest.JSONResponse(w, http.StatusOK, map[string]any{"level": "watch"})
+	})
+
+	cs := setupServer(t, mux)
+	text, err := callTool(t, cs, "repo_notifications", map[string]any{
+		"repo":  "test-owner/test-repo",
+		"level": "watch",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if level != "watch" {
+		t.Errorf("level = %v, want watch", level)
+	}
+	if !strings.Contains(text, "watch") {
+		t.Errorf("expected level in output, got: %s", text)
+	}
+
+	if _, err := callTool(t, cs, "repo_notifications", map[string]any{"level": "loud"}); err == nil {
+		t.Error("expected error for invalid level")
+	}
+}
+
 func TestRepoViewRequiresRepo(t *testing.T) {
 	mux := cmdtest.NewRouterMux()
 	cs := setupServer(t, mux)
@@ -2346,3 +2472,114 @@ func TestPipelineFlaky(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestMRUnsubscribe(t *testing.T) {
+	mux := cmdtest.NewRouterMux()
+	mux.HandleFunc("/api/v4/projects/test-owner/test-repo/merge_requests/1/unsubscribe", func(w http.ResponseWriter, r *http.Request) {
+		cmdtest.JSONResponse(w, http.StatusCreated, cmdtest.MockMergeRequest(1, "Fix bug", "opened"))
+	})
+
+	cs := setupServer(t, mux)
+	text, err := callTool(t, cs, "mr_unsubscribe", map[string]any{
+		"repo": "test-owner/test-repo",
+		"mr":   1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(text, "Unsubscribed from merge request !1") {
+		t.Errorf("expected unsubscribe confirmation, got: %s", text)
+	}
+}
+
+func TestMRTodo(t *testing.T) {
+	mux := cmdtest.NewRouterMux()
+	mux.HandleFunc("/api/v4/projects/test-owner/test-repo/merge_requests/1/todo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			cmdtest.ErrorResponse(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+		cmdtest.JSONResponse(w, http.StatusCreated, map[string]any{"id": 5, "action_name": "marked"})
+	})
+
+	cs := setupServer(t, mux)
+	text, err := callTool(t, cs, "mr_todo", map[string]any{
+		"repo": "test-owner/test-repo",
+		"mr":   1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(text, "Added merge request !1 to the to-do list") {
+		t.Errorf("expected to-do confirmation, got: %s", text)
+	}
+}
+
+func TestIssueNotes(t *testing.T) {
+	mux := cmdtest.NewRouterMux()
+	mux.HandleFunc("/api/v4/projects/test-owner/test-repo/issues/4/notes", func(w http.ResponseWriter, r *http.Request) {
+		cmdtest.JSONResponse(w, http.StatusOK, []map[string]interface{}{
+			{"id": 1, "body": "can reproduce", "created_at": "2024-01-01T09:00:00Z", "author": map[string]any{"username": "alice"}},
+			{"id": 2, "body": "closed via merge request", "system": true, "created_at": "2024-02-01T09:00:00Z", "author": map[string]any{"username": "bob"}},
+			{"id": 3, "body": "fix verified", "created_at": "2024-02-02T09:00:00Z", "author": map[string]any{"username": "carol"}},
+		})
+	})
+
+	cs := setupServer(t, mux)
+	text, err := callTool(t, cs, "issue_notes", map[string]any{
+		"repo":  "test-owner/test-repo",
+		"issue": 4,
+		"since": "2024-01-15",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(text, "fix verified") {
+		t.Errorf("expected recent note, got: %s", text)
+	}
+	if strings.Contains(text, "can reproduce") || strings.Contains(text, "closed via merge request") {
+		t.Errorf("expected old and system notes to be filtered out, got: %s", text)
+	}
+
+	_, err = callTool(t, cs, "issue_notes", map[string]any{
+		"repo":  "test-owner/test-repo",
+		"issue": 4,
+		"since": "last week",
+	})
+	if err == nil || !strings.Contains(err.Error(), "since must be") {
+		t.Errorf("expected invalid since error, got %v", err)
+	}
+}
+
+func TestPipelineFailedJobs(t *testing.T) {
+	mux := cmdtest.NewRouterMux()
+	mux.HandleFunc("/api/v4/projects/test-owner/test-repo/pipelines/100/jobs", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("scope[]") != "failed" {
+			t.Errorf("expected failed scope, got %s", r.URL.RawQuery)
+		}
+		cmdtest.JSONResponse(w, http.StatusOK, []map[string]interface{}{
+			{"id": 501, "name": "test", "stage": "test", "status": "failed", "failure_reason": "script_failure"},
+		})
+	})
+	mux.HandleFunc("/api/v4/projects/test-owner/test-repo/jobs/501/trace", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("Running tests...\n\x1b[31mFAIL: TestLogin\x1b[0m\nok  pkg/util\nexit status 1\n"))
+	})
+
+	cs := setupServer(t, mux)
+	text, err := callTool(t, cs, "pipeline_failed_jobs", map[string]any{
+		"repo":     "test-owner/test-repo",
+		"pipeline": 100,
+		"grep":     "FAIL|exit",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"failure_reason": "script_failure"`, `"FAIL: TestLogin"`, `"exit status 1"`} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected %s in output, got: %s", want, text)
+		}
+	}
+	if strings.Contains(text, "pkg/util") {
+		t.Errorf("expected non-matching lines to be dropped, got: %s", text)
+	}
+}
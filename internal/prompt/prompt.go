@@ -2,15 +2,42 @@ package prompt
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"strconv"
 	"strings"
+	"sync/atomic"
 
 	"golang.org/x/term"
 )
 
+// ErrDisabled is returned by every prompt while prompts are disabled.
+var ErrDisabled = errors.New("prompts are disabled")
+
+var disabled atomic.Bool
+
+// SetDisabled disables or enables all prompts. While disabled, prompts
+// return an error wrapping ErrDisabled instead of waiting for input.
+func SetDisabled(v bool) {
+	disabled.Store(v)
+}
+
+// Disabled reports whether prompts are disabled.
+func Disabled() bool {
+	return disabled.Load()
+}
+
+// checkDisabled returns the error a prompt fails with while prompts are
+// disabled, or nil.
+func checkDisabled(prompt string) error {
+	if !disabled.Load() {
+		return nil
+	}
+	return fmt.Errorf("cannot prompt for %q: %w (--prompt=disabled, GLAB_PROMPT_DISABLED, or a CI environment); pass the value with flags instead", strings.TrimSuffix(prompt, ":"), ErrDisabled)
+}
+
 // Prompter provides interactive terminal prompts.
 type Prompter struct {
 	in  io.Reader
@@ -29,6 +56,9 @@ func New(in io.Reader, out io.Writer) *Prompter {
 //	> GitHub.com
 //	  GitHub Enterprise Server
 func Select(in io.Reader, out io.Writer, prompt string, options []string) (int, error) {
+	if err := checkDisabled(prompt); err != nil {
+		return 0, err
+	}
 	_, _ = fmt.Fprintf(out, "? %s\n", prompt)
 	for i, o := range options {
 		_, _ = fmt.Fprintf(out, "  [%d] %s\n", i+1, o)
@@ -49,6 +79,9 @@ func Select(in io.Reader, out io.Writer, prompt string, options []string) (int,
 
 // Input reads a line of text from the user.
 func Input(in io.Reader, out io.Writer, prompt string) (string, error) {
+	if err := checkDisabled(prompt); err != nil {
+		return "", err
+	}
 	_, _ = fmt.Fprintf(out, "? %s ", prompt)
 	scanner := bufio.NewScanner(in)
 	if !scanner.Scan() {
@@ -60,6 +93,9 @@ func Input(in io.Reader, out io.Writer, prompt string) (string, error) {
 // Password reads a line of input with echo disabled (masked).
 // Falls back to regular input if the reader is not a terminal.
 func Password(out io.Writer, prompt string) (string, error) {
+	if err := checkDisabled(prompt); err != nil {
+		return "", err
+	}
 	_, _ = fmt.Fprintf(out, "? %s ", prompt)
 	fd := int(os.Stdin.Fd())
 	if term.IsTerminal(fd) {
@@ -81,6 +117,9 @@ func Password(out io.Writer, prompt string) (string, error) {
 // Confirm asks a yes/no question. defaultYes controls the default when the
 // user just presses Enter.
 func Confirm(in io.Reader, out io.Writer, prompt string, defaultYes bool) (bool, error) {
+	if err := checkDisabled(prompt); err != nil {
+		return false, err
+	}
 	suffix := " (y/N): "
 	if defaultYes {
 		suffix = " (Y/n): "
@@ -97,3 +136,40 @@ func Confirm(in io.Reader, out io.Writer, prompt string, defaultYes bool) (bool,
 	}
 	return text == "y" || text == "yes", nil
 }
+
+// MultiSelect presents a list of options and returns the indices of the
+// chosen ones. The user enters a comma-separated list of numbers; an empty
+// answer selects nothing.
+func MultiSelect(in io.Reader, out io.Writer, prompt string, options []string) ([]int, error) {
+	if err := checkDisabled(prompt); err != nil {
+		return nil, err
+	}
+	_, _ = fmt.Fprintf(out, "? %s\n", prompt)
+	for i, o := range options {
+		_, _ = fmt.Fprintf(out, "  [%d] %s\n", i+1, o)
+	}
+	_, _ = fmt.Fprint(out, "  Choices (comma-separated, Enter to skip): ")
+
+	scanner := bufio.NewScanner(in)
+	if !scanner.Scan() {
+		return nil, nil
+	}
+
+	var selected []int
+	seen := make(map[int]bool)
+	for _, field := range strings.Split(scanner.Text(), ",") {
+		text := strings.TrimSpace(field)
+		if text == "" {
+			continue
+		}
+		n, err := strconv.Atoi(text)
+		if err != nil || n < 1 || n > len(options) {
+			return nil, fmt.Errorf("invalid choice: %s", text)
+		}
+		if !seen[n-1] {
+			seen[n-1] = true
+			selected = append(selected, n-1)
+		}
+	}
+	return selected, nil
+}
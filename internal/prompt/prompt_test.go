@@ -2,6 +2,7 @@ package prompt
 
 import (
 	"bytes"
+	"errors"
 	"strings"
 	"testing"
 )
@@ -274,3 +275,76 @@ func TestConfirm_OutputFormat(t *testing.T) {
 		}
 	})
 }
+
+func TestMultiSelect(t *testing.T) {
+	options := []string{"bug", "feature", "docs"}
+
+	tests := []struct {
+		name    string
+		input   string
+		want    []int
+		wantErr bool
+	}{
+		{name: "single choice", input: "2\n", want: []int{1}},
+		{name: "multiple choices", input: "3, 1\n", want: []int{2, 0}},
+		{name: "duplicates ignored", input: "1,1,2\n", want: []int{0, 1}},
+		{name: "skip", input: "\n", want: nil},
+		{name: "no input", input: "", want: nil},
+		{name: "out of range", input: "4\n", wantErr: true},
+		{name: "not a number", input: "bug\n", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			got, err := MultiSelect(strings.NewReader(tt.input), out, "Labels", options)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("got %v, want %v", got, tt.want)
+				}
+			}
+			if !strings.Contains(out.String(), "[3] docs") {
+				t.Errorf("expected options in output, got %q", out.String())
+			}
+		})
+	}
+}
+
+func TestDisabled(t *testing.T) {
+	SetDisabled(true)
+	t.Cleanup(func() { SetDisabled(false) })
+
+	out := &bytes.Buffer{}
+	if _, err := Select(strings.NewReader("1\n"), out, "Pick one:", []string{"a"}); !errors.Is(err, ErrDisabled) {
+		t.Errorf("Select error = %v, want ErrDisabled", err)
+	}
+	if _, err := Input(strings.NewReader("x\n"), out, "Title:"); !errors.Is(err, ErrDisabled) {
+		t.Errorf("Input error = %v, want ErrDisabled", err)
+	} else if !strings.Contains(err.Error(), `"Title"`) {
+		t.Errorf("expected the error to name the prompt, got %v", err)
+	}
+	if _, err := Confirm(strings.NewReader("y\n"), out, "Sure?", true); !errors.Is(err, ErrDisabled) {
+		t.Errorf("Confirm error = %v, want ErrDisabled", err)
+	}
+	if _, err := MultiSelect(strings.NewReader("1\n"), out, "Labels:", []string{"a"}); !errors.Is(err, ErrDisabled) {
+		t.Errorf("MultiSelect error = %v, want ErrDisabled", err)
+	}
+	if _, err := Password(out, "Token:"); !errors.Is(err, ErrDisabled) {
+		t.Errorf("Password error = %v, want ErrDisabled", err)
+	}
+	if out.Len() != 0 {
+		t.Errorf("expected nothing to be printed, got %q", out.String())
+	}
+}
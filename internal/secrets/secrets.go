@@ -0,0 +1,164 @@
+package secrets
+
+import (
+	"bufio"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// AllowMarker can be placed on a line to suppress findings for it.
+const AllowMarker = "glab:allow-secret"
+
+// Finding describes a potential secret found on an added diff line.
+type Finding struct {
+	File  string `json:"file"`
+	Line  int    `json:"line"`
+	Rule  string `json:"rule"`
+	Match string `json:"match"`
+}
+
+type rule struct {
+	name string
+	re   *regexp.Regexp
+}
+
+// rules are well-known token formats that are reported regardless of entropy.
+var rules = []rule{
+	{"GitLab personal access token", regexp.MustCompile(`glpat-[0-9A-Za-z_\-]{20,}`)},
+	{"GitLab pipeline trigger token", regexp.MustCompile(`glptt-[0-9a-f]{40}`)},
+	{"GitLab runner token", regexp.MustCompile(`glrt-[0-9A-Za-z_\-]{20,}`)},
+	{"GitLab deploy token", regexp.MustCompile(`gldt-[0-9A-Za-z_\-]{20,}`)},
+	{"GitHub token", regexp.MustCompile(`gh[pousr]_[0-9A-Za-z]{36,}`)},
+	{"AWS access key ID", regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{"Slack token", regexp.MustCompile(`xox[baprs]-[0-9A-Za-z\-]{10,}`)},
+	{"Stripe secret key", regexp.MustCompile(`sk_live_[0-9A-Za-z]{24,}`)},
+	{"Google API key", regexp.MustCompile(`AIza[0-9A-Za-z_\-]{35}`)},
+	{"Private key", regexp.MustCompile(`-----BEGIN (?:RSA |EC |DSA |OPENSSH |PGP )?PRIVATE KEY(?: BLOCK)?-----`)},
+	{"JSON Web Token", regexp.MustCompile(`eyJ[0-9A-Za-z_\-]{10,}\.eyJ[0-9A-Za-z_\-]{10,}\.[0-9A-Za-z_\-]{10,}`)},
+}
+
+var (
+	// assignmentRe matches values assigned to secret-looking keys.
+	assignmentRe = regexp.MustCompile(`(?i)(?:secret|token|passw(?:or)?d|api[_\-]?key|access[_\-]?key|private[_\-]?key|credential)s?["']?\s*[:=]+\s*["']?([^\s"'` + "`" + `,;]{16,})`)
+	// candidateRe matches long base64/hex-like strings anywhere on a line.
+	candidateRe = regexp.MustCompile(`[A-Za-z0-9+/_\-]{32,}={0,2}`)
+	// hunkRe extracts the starting line number of the new file in a hunk header.
+	hunkRe = regexp.MustCompile(`^@@ -\d+(?:,\d+)? \+(\d+)(?:,\d+)? @@`)
+)
+
+const (
+	// assignmentEntropy is the minimum entropy for values assigned to secret-looking keys.
+	assignmentEntropy = 3.5
+	// candidateEntropy is the minimum entropy for free-standing strings. It is
+	// above the maximum entropy of hex (4.0) so commit SHAs and checksums are ignored.
+	candidateEntropy = 4.5
+)
+
+// Scan inspects the added lines of a unified diff and returns potential secrets.
+func Scan(diff string) []Finding {
+	var findings []Finding
+	var file string
+	line := 0
+
+	scanner := bufio.NewScanner(strings.NewReader(diff))
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		text := scanner.Text()
+		switch {
+		case strings.HasPrefix(text, "+++ "):
+			file = strings.TrimPrefix(strings.TrimPrefix(text, "+++ "), "b/")
+			continue
+		case strings.HasPrefix(text, "--- "), strings.HasPrefix(text, "diff --git "):
+			continue
+		case strings.HasPrefix(text, "@@"):
+			if m := hunkRe.FindStringSubmatch(text); m != nil {
+				n, _ := strconv.Atoi(m[1])
+				line = n
+			}
+			continue
+		case strings.HasPrefix(text, "+"):
+			findings = append(findings, ScanLine(file, line, text[1:])...)
+			line++
+		case strings.HasPrefix(text, "-"), strings.HasPrefix(text, "\\"):
+			// Removed lines and "\ No newline" markers don't advance the new file.
+		default:
+			line++
+		}
+	}
+	return findings
+}
+
+// ScanLine inspects a single line of content and returns potential secrets.
+func ScanLine(file string, line int, text string) []Finding {
+	if strings.Contains(text, AllowMarker) {
+		return nil
+	}
+
+	var findings []Finding
+	seen := map[string]bool{}
+	add := func(name, match string) {
+		if seen[match] {
+			return
+		}
+		seen[match] = true
+		findings = append(findings, Finding{File: file, Line: line, Rule: name, Match: Redact(match)})
+	}
+
+	for _, r := range rules {
+		for _, m := range r.re.FindAllString(text, -1) {
+			add(r.name, m)
+		}
+	}
+
+	for _, m := range assignmentRe.FindAllStringSubmatch(text, -1) {
+		if Entropy(m[1]) >= assignmentEntropy && !isPlaceholder(m[1]) {
+			add("High-entropy secret assignment", m[1])
+		}
+	}
+
+	for _, m := range candidateRe.FindAllString(text, -1) {
+		if Entropy(m) >= candidateEntropy {
+			add("High-entropy string", m)
+		}
+	}
+
+	return findings
+}
+
+// Entropy returns the Shannon entropy of s in bits per character.
+func Entropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+	counts := map[rune]int{}
+	for _, r := range s {
+		counts[r]++
+	}
+	n := float64(len([]rune(s)))
+	var h float64
+	for _, c := range counts {
+		p := float64(c) / n
+		h -= p * math.Log2(p)
+	}
+	return h
+}
+
+// Redact keeps the first four characters of a match and masks the rest.
+func Redact(s string) string {
+	if len(s) <= 4 {
+		return strings.Repeat("*", len(s))
+	}
+	return s[:4] + strings.Repeat("*", 8)
+}
+
+// isPlaceholder reports whether a value is an obvious template or variable
+// reference rather than a literal secret.
+func isPlaceholder(s string) bool {
+	return strings.HasPrefix(s, "$") ||
+		strings.Contains(s, "${") ||
+		strings.Contains(s, "{{") ||
+		strings.Contains(strings.ToLower(s), "example") ||
+		strings.Contains(strings.ToLower(s), "changeme")
+}
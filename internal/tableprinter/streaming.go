@@ -59,8 +59,8 @@ func (s *StreamingTablePrinter) AddRow(fields ...string) error {
 			s.widths = newWidths
 		}
 		for i, field := range fields {
-			if len(field) > s.widths[i] {
-				s.widths[i] = len(field)
+			if w := displayWidth(field); w > s.widths[i] {
+				s.widths[i] = w
 			}
 		}
 
@@ -1,16 +1,38 @@
 package tableprinter
 
 import (
+	"encoding/csv"
 	"fmt"
 	"io"
+	"regexp"
 	"strings"
+	"unicode/utf8"
 )
 
-// TablePrinter formats data as aligned columns.
+// escapeRe matches OSC 8 hyperlink and SGR color escape sequences, which take
+// up no space when rendered.
+var escapeRe = regexp.MustCompile(`\x1b\]8;[^\x1b]*\x1b\\|\x1b\[[0-9;]*m`)
+
+// Renderer writes the header and rows collected by a TablePrinter. The header
+// is nil when none was set.
+type Renderer interface {
+	Render(out io.Writer, header []string, rows [][]string) error
+}
+
+// minColumnWidth is the narrowest a column is truncated to when fitting a
+// table to the terminal.
+const minColumnWidth = 8
+
+// TablePrinter formats data as aligned columns, or with a custom Renderer.
 type TablePrinter struct {
-	out     io.Writer
-	rows    [][]string
-	maxCols int
+	out      io.Writer
+	renderer Renderer
+	header   []string
+	rows     [][]string
+	maxCols  int
+
+	terminal bool
+	width    int
 }
 
 // New creates a new TablePrinter.
@@ -18,6 +40,29 @@ func New(out io.Writer) *TablePrinter {
 	return &TablePrinter{out: out}
 }
 
+// NewWithRenderer creates a TablePrinter that writes its rows with r.
+func NewWithRenderer(out io.Writer, r Renderer) *TablePrinter {
+	return &TablePrinter{out: out, renderer: r}
+}
+
+// SetHeader sets the column names. The aligned output prints them only in
+// terminal mode; renderers such as CSV always do.
+func (t *TablePrinter) SetHeader(fields ...string) {
+	t.header = fields
+	if len(fields) > t.maxCols {
+		t.maxCols = len(fields)
+	}
+}
+
+// SetTerminal switches to output meant for a person at a terminal: the header
+// is printed, columns are separated by spaces instead of a tab, and cells are
+// truncated so each row fits in width columns. A width of 0 disables
+// truncation.
+func (t *TablePrinter) SetTerminal(width int) {
+	t.terminal = true
+	t.width = width
+}
+
 // AddRow adds a row of fields to the table.
 func (t *TablePrinter) AddRow(fields ...string) {
 	t.rows = append(t.rows, fields)
@@ -28,31 +73,49 @@ func (t *TablePrinter) AddRow(fields ...string) {
 
 // Render outputs the formatted table.
 func (t *TablePrinter) Render() error {
+	if t.renderer != nil {
+		return t.renderer.Render(t.out, t.header, t.rows)
+	}
 	if len(t.rows) == 0 {
 		return nil
 	}
 
+	rows := t.rows
+	sep := "\t"
+	if t.terminal {
+		sep = "  "
+		if t.header != nil {
+			rows = append([][]string{t.header}, rows...)
+		}
+	}
+
 	// Calculate column widths
 	widths := make([]int, t.maxCols)
-	for _, row := range t.rows {
+	for _, row := range rows {
 		for i, field := range row {
-			if len(field) > widths[i] {
-				widths[i] = len(field)
+			if w := displayWidth(field); w > widths[i] {
+				widths[i] = w
 			}
 		}
 	}
+	if t.terminal && t.width > 0 {
+		widths = t.fitWidths(widths, t.width-len(sep)*(len(widths)-1))
+	}
 
 	// Print rows
-	for _, row := range t.rows {
+	for _, row := range rows {
 		var parts []string
 		for i, field := range row {
+			if t.terminal {
+				field = truncate(field, widths[i])
+			}
 			if i < len(row)-1 {
 				parts = append(parts, padRight(field, widths[i]))
 			} else {
 				parts = append(parts, field)
 			}
 		}
-		_, err := fmt.Fprintln(t.out, strings.Join(parts, "\t"))
+		_, err := fmt.Fprintln(t.out, strings.Join(parts, sep))
 		if err != nil {
 			return err
 		}
@@ -60,9 +123,86 @@ func (t *TablePrinter) Render() error {
 	return nil
 }
 
+// fitWidths shrinks the widest columns until their total fits in available.
+// Columns containing escape sequences, such as colored or hyperlinked cells,
+// are left alone because they cannot be truncated safely.
+func (t *TablePrinter) fitWidths(widths []int, available int) []int {
+	fixed := make([]bool, len(widths))
+	for _, row := range t.rows {
+		for i, field := range row {
+			if strings.IndexByte(field, 0x1b) >= 0 {
+				fixed[i] = true
+			}
+		}
+	}
+
+	total := 0
+	for _, w := range widths {
+		total += w
+	}
+	for total > available {
+		widest := -1
+		for i, w := range widths {
+			if !fixed[i] && w > minColumnWidth && (widest < 0 || w > widths[widest]) {
+				widest = i
+			}
+		}
+		if widest < 0 {
+			break
+		}
+		widths[widest]--
+		total--
+	}
+	return widths
+}
+
+// truncate shortens s to width characters, ending it with an ellipsis. Cells
+// containing escape sequences are returned unchanged.
+func truncate(s string, width int) string {
+	if displayWidth(s) <= width || strings.IndexByte(s, 0x1b) >= 0 {
+		return s
+	}
+	r := []rune(s)
+	if width <= 1 {
+		return string(r[:width])
+	}
+	return string(r[:width-1]) + "…"
+}
+
+// CSVRenderer writes rows as comma-separated values, preceded by the header
+// when one is set.
+type CSVRenderer struct{}
+
+// Render implements Renderer.
+func (CSVRenderer) Render(out io.Writer, header []string, rows [][]string) error {
+	w := csv.NewWriter(out)
+	if header != nil {
+		if err := w.Write(header); err != nil {
+			return err
+		}
+	}
+	for _, row := range rows {
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
 func padRight(s string, length int) string {
-	if len(s) >= length {
+	w := displayWidth(s)
+	if w >= length {
 		return s
 	}
-	return s + strings.Repeat(" ", length-len(s))
+	return s + strings.Repeat(" ", length-w)
+}
+
+// displayWidth returns the number of characters s occupies on screen,
+// ignoring escape sequences.
+func displayWidth(s string) int {
+	if strings.IndexByte(s, 0x1b) >= 0 {
+		s = escapeRe.ReplaceAllString(s, "")
+	}
+	return utf8.RuneCountInString(s)
 }
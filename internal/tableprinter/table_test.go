@@ -212,3 +212,79 @@ func TestRender_ManyRows(t *testing.T) {
 		t.Errorf("expected 50 lines, got %d", len(lines))
 	}
 }
+
+func TestRender_IgnoresEscapeSequencesInWidth(t *testing.T) {
+	var buf bytes.Buffer
+	tp := New(&buf)
+
+	link := "\x1b]8;;https://gitlab.com/a/b/-/issues/1\x1b\\#1\x1b]8;;\x1b\\"
+	tp.AddRow(link, "first")
+	tp.AddRow("#22", "second")
+	if err := tp.Render(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if lines[0] != link+" \tfirst" {
+		t.Errorf("expected hyperlink cell padded to visible width, got %q", lines[0])
+	}
+	if lines[1] != "#22\tsecond" {
+		t.Errorf("unexpected second row %q", lines[1])
+	}
+}
+
+func TestRender_HeaderIgnoredByDefault(t *testing.T) {
+	var buf bytes.Buffer
+	tp := New(&buf)
+	tp.SetHeader("ID", "NAME")
+	tp.AddRow("1", "first")
+	if err := tp.Render(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if buf.String() != "1\tfirst\n" {
+		t.Errorf("unexpected output %q", buf.String())
+	}
+}
+
+func TestCSVRenderer(t *testing.T) {
+	var buf bytes.Buffer
+	tp := NewWithRenderer(&buf, CSVRenderer{})
+	tp.SetHeader("id", "title")
+	tp.AddRow("1", "Fix login, again")
+	tp.AddRow("2", `Say "hi"`)
+	if err := tp.Render(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "id,title\n1,\"Fix login, again\"\n2,\"Say \"\"hi\"\"\"\n"
+	if buf.String() != want {
+		t.Errorf("expected %q, got %q", want, buf.String())
+	}
+}
+
+func TestRender_Terminal(t *testing.T) {
+	var buf bytes.Buffer
+	tp := New(&buf)
+	tp.SetTerminal(30)
+	tp.SetHeader("ID", "TITLE", "STATE")
+	tp.AddRow("1", "A title that is much too long for the terminal", "opened")
+	tp.AddRow("22", "Short", "\x1b[31mclosed\x1b[0m")
+	if err := tp.Render(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected header and 2 rows, got %q", buf.String())
+	}
+	if lines[0] != "ID  TITLE"+strings.Repeat(" ", 13)+"  STATE" {
+		t.Errorf("unexpected header %q", lines[0])
+	}
+	if lines[1] != "1   A title that is m…  opened" {
+		t.Errorf("unexpected first row %q", lines[1])
+	}
+	if displayWidth(lines[2]) > 30 {
+		t.Errorf("expected row to fit 30 columns, got %q", lines[2])
+	}
+}
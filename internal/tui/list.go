@@ -0,0 +1,494 @@
+// Package tui provides a full-screen, keyboard-driven list for browsing the
+// results of a list command in a terminal and acting on them.
+package tui
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/term"
+)
+
+// Item is a row of a List.
+type Item struct {
+	// Columns are the cells of the row. Filtering matches against them.
+	Columns []string
+	// Value identifies the item to actions, such as the IID of a merge
+	// request.
+	Value string
+}
+
+// Action is a command bound to a key that acts on the selected item. The
+// terminal is restored while it runs, so it may print and prompt.
+type Action struct {
+	Key  rune
+	Name string
+	Run  func(item Item) error
+	// Quit ends the list once Run succeeds, as after checking out a branch.
+	Quit bool
+	// Wait keeps the output of Run on screen until Enter is pressed.
+	Wait bool
+}
+
+// List is an interactive list of items. The first action also runs on
+// Enter.
+type List struct {
+	Title   string
+	Header  []string
+	Items   []Item
+	Actions []Action
+}
+
+// Run shows the list on the terminal until it is quit or an action with
+// Quit set succeeds. in and out must be a terminal.
+func (l *List) Run(in io.Reader, out io.Writer) error {
+	inFile, ok := in.(*os.File)
+	if !ok || !term.IsTerminal(int(inFile.Fd())) {
+		return fmt.Errorf("interactive mode requires a terminal")
+	}
+	outFile, ok := out.(*os.File)
+	if !ok || !term.IsTerminal(int(outFile.Fd())) {
+		return fmt.Errorf("interactive mode requires a terminal")
+	}
+
+	m := newModel(l)
+	keys := bufio.NewReader(inFile)
+	for {
+		state, err := term.MakeRaw(int(inFile.Fd()))
+		if err != nil {
+			return fmt.Errorf("setting up terminal: %w", err)
+		}
+		_, _ = io.WriteString(out, enterScreen)
+
+		action, err := m.loop(keys, outFile)
+
+		_, _ = io.WriteString(out, leaveScreen)
+		_ = term.Restore(int(inFile.Fd()), state)
+		if err != nil || action == nil {
+			return err
+		}
+
+		item := m.selected()
+		if err := action.Run(item); err != nil {
+			if !action.Wait {
+				m.message = fmt.Sprintf("%s: %v", action.Name, err)
+				continue
+			}
+			_, _ = fmt.Fprintf(out, "%v\n", err)
+		} else if action.Quit {
+			return nil
+		}
+		if action.Wait {
+			_, _ = io.WriteString(out, "\nPress Enter to return to the list")
+			_, _ = keys.ReadString('\n')
+		}
+	}
+}
+
+const (
+	enterScreen = "\x1b[?1049h\x1b[?25l"
+	leaveScreen = "\x1b[?25h\x1b[?1049l"
+	clearScreen = "\x1b[H\x1b[2J"
+	reverse     = "\x1b[7m"
+	bold        = "\x1b[1m"
+	gray        = "\x1b[90m"
+	reset       = "\x1b[0m"
+)
+
+// loop draws the list and handles keys until the list is quit, which yields
+// a nil action, or an action is chosen.
+func (m *model) loop(keys *bufio.Reader, out *os.File) (*Action, error) {
+	for {
+		width, height, err := term.GetSize(int(out.Fd()))
+		if err != nil {
+			width, height = 80, 24
+		}
+		// Raw mode turns off the translation of "\n" to "\r\n".
+		view := strings.ReplaceAll(m.view(width, height), "\n", "\r\n")
+		if _, err := io.WriteString(out, clearScreen+view); err != nil {
+			return nil, err
+		}
+
+		k, err := readKey(keys)
+		if err != nil {
+			return nil, err
+		}
+		action, quit := m.update(k)
+		if quit {
+			return nil, nil
+		}
+		if action != nil {
+			return action, nil
+		}
+	}
+}
+
+// keyKind is the kind of a key press.
+type keyKind int
+
+const (
+	keyRune keyKind = iota
+	keyUp
+	keyDown
+	keyPageUp
+	keyPageDown
+	keyHome
+	keyEnd
+	keyEnter
+	keyBackspace
+	keyEscape
+	keyInterrupt
+)
+
+type key struct {
+	kind keyKind
+	r    rune
+}
+
+// readKey reads a key press from a terminal in raw mode.
+func readKey(r *bufio.Reader) (key, error) {
+	c, _, err := r.ReadRune()
+	if err != nil {
+		return key{}, err
+	}
+	switch c {
+	case '\r', '\n':
+		return key{kind: keyEnter}, nil
+	case 0x7f, 0x08:
+		return key{kind: keyBackspace}, nil
+	case 0x03, 0x04:
+		return key{kind: keyInterrupt}, nil
+	case 0x0e: // Ctrl-N
+		return key{kind: keyDown}, nil
+	case 0x10: // Ctrl-P
+		return key{kind: keyUp}, nil
+	case 0x1b:
+		// A lone escape is the Escape key; otherwise it starts the
+		// sequence of a special key, which arrives in the same read.
+		if r.Buffered() == 0 {
+			return key{kind: keyEscape}, nil
+		}
+		return readEscapeSequence(r)
+	}
+	return key{kind: keyRune, r: c}, nil
+}
+
+func readEscapeSequence(r *bufio.Reader) (key, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return key{}, err
+	}
+	if b != '[' && b != 'O' {
+		return key{kind: keyEscape}, nil
+	}
+	var seq []byte
+	for r.Buffered() > 0 {
+		c, err := r.ReadByte()
+		if err != nil {
+			return key{}, err
+		}
+		seq = append(seq, c)
+		if c >= 0x40 && c <= 0x7e {
+			break
+		}
+	}
+	switch string(seq) {
+	case "A":
+		return key{kind: keyUp}, nil
+	case "B":
+		return key{kind: keyDown}, nil
+	case "H", "1~":
+		return key{kind: keyHome}, nil
+	case "F", "4~":
+		return key{kind: keyEnd}, nil
+	case "5~":
+		return key{kind: keyPageUp}, nil
+	case "6~":
+		return key{kind: keyPageDown}, nil
+	}
+	// Ignore other special keys.
+	return key{kind: keyRune}, nil
+}
+
+// model is the state of a running List.
+type model struct {
+	list      *List
+	filter    string
+	filtering bool
+	matches   []int // indices of the items matching filter, best first
+	cursor    int   // index into matches
+	offset    int   // index into matches of the first row shown
+	pageSize  int
+	message   string
+}
+
+func newModel(l *List) *model {
+	m := &model{list: l, pageSize: 10}
+	m.applyFilter()
+	return m
+}
+
+// selected returns the item under the cursor.
+func (m *model) selected() Item {
+	if len(m.matches) == 0 {
+		return Item{}
+	}
+	return m.list.Items[m.matches[m.cursor]]
+}
+
+// update applies a key press. It returns the action to run, if any, and
+// whether to quit.
+func (m *model) update(k key) (*Action, bool) {
+	m.message = ""
+	switch k.kind {
+	case keyInterrupt:
+		return nil, true
+	case keyUp:
+		m.move(-1)
+	case keyDown:
+		m.move(1)
+	case keyPageUp:
+		m.move(-m.pageSize)
+	case keyPageDown:
+		m.move(m.pageSize)
+	case keyHome:
+		m.move(-len(m.matches))
+	case keyEnd:
+		m.move(len(m.matches))
+	case keyEscape:
+		if m.filtering || m.filter != "" {
+			m.filtering = false
+			m.filter = ""
+			m.applyFilter()
+			return nil, false
+		}
+		return nil, true
+	case keyBackspace:
+		if m.filtering && m.filter != "" {
+			_, size := utf8.DecodeLastRuneInString(m.filter)
+			m.filter = m.filter[:len(m.filter)-size]
+			m.applyFilter()
+		}
+	case keyEnter:
+		if m.filtering {
+			m.filtering = false
+			return nil, false
+		}
+		if len(m.list.Actions) > 0 {
+			return m.action(&m.list.Actions[0])
+		}
+	case keyRune:
+		if m.filtering {
+			if unicode.IsPrint(k.r) {
+				m.filter += string(k.r)
+				m.applyFilter()
+			}
+			return nil, false
+		}
+		switch k.r {
+		case 'q':
+			return nil, true
+		case '/':
+			m.filtering = true
+			return nil, false
+		case 'j':
+			m.move(1)
+			return nil, false
+		case 'k':
+			m.move(-1)
+			return nil, false
+		}
+		for i := range m.list.Actions {
+			if m.list.Actions[i].Key == k.r {
+				return m.action(&m.list.Actions[i])
+			}
+		}
+	}
+	return nil, false
+}
+
+func (m *model) action(a *Action) (*Action, bool) {
+	if len(m.matches) == 0 {
+		m.message = "No item selected"
+		return nil, false
+	}
+	return a, false
+}
+
+func (m *model) move(delta int) {
+	m.cursor = max(0, min(m.cursor+delta, len(m.matches)-1))
+}
+
+// applyFilter recomputes the items matching the filter and resets the
+// cursor.
+func (m *model) applyFilter() {
+	type match struct {
+		index int
+		score int
+	}
+	var found []match
+	for i, item := range m.list.Items {
+		if score, ok := fuzzyMatch(m.filter, strings.Join(item.Columns, " ")); ok {
+			found = append(found, match{i, score})
+		}
+	}
+	sort.SliceStable(found, func(a, b int) bool { return found[a].score > found[b].score })
+
+	m.matches = m.matches[:0]
+	for _, f := range found {
+		m.matches = append(m.matches, f.index)
+	}
+	m.cursor, m.offset = 0, 0
+}
+
+// fuzzyMatch reports whether the characters of pattern appear in text in
+// order, ignoring case, and scores the match: consecutive characters and
+// characters at the start of a word score higher.
+func fuzzyMatch(pattern, text string) (int, bool) {
+	if pattern == "" {
+		return 0, true
+	}
+	p := []rune(strings.ToLower(pattern))
+	t := []rune(strings.ToLower(text))
+	score, pi, last := 0, 0, -2
+	for ti := 0; ti < len(t) && pi < len(p); ti++ {
+		if t[ti] != p[pi] {
+			continue
+		}
+		score++
+		if ti == last+1 {
+			score += 2
+		}
+		if ti == 0 || !unicode.IsLetter(t[ti-1]) && !unicode.IsDigit(t[ti-1]) {
+			score++
+		}
+		last = ti
+		pi++
+	}
+	return score, pi == len(p)
+}
+
+// view renders the list for a terminal of the given size.
+func (m *model) view(width, height int) string {
+	var b strings.Builder
+
+	b.WriteString(bold + fit(m.list.Title, width) + reset + "\n")
+	switch {
+	case m.filtering:
+		b.WriteString(fit("Filter: "+m.filter+"█", width) + "\n")
+	case m.filter != "":
+		b.WriteString(fit(fmt.Sprintf("Filter: %s (%d of %d)", m.filter, len(m.matches), len(m.list.Items)), width) + "\n")
+	default:
+		b.WriteString(gray + fit(fmt.Sprintf("%d items", len(m.list.Items)), width) + reset + "\n")
+	}
+
+	widths := m.columnWidths()
+	if m.list.Header != nil {
+		b.WriteString(gray + fit(formatRow(m.list.Header, widths), width) + reset + "\n")
+	}
+
+	// The title, filter, and header lines above, and the message and help
+	// lines below.
+	rows := height - 4
+	if m.list.Header != nil {
+		rows--
+	}
+	m.pageSize = max(rows, 1)
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	}
+	if m.cursor >= m.offset+m.pageSize {
+		m.offset = m.cursor - m.pageSize + 1
+	}
+
+	shown := 0
+	for i := m.offset; i < len(m.matches) && shown < m.pageSize; i++ {
+		line := fit(formatRow(m.list.Items[m.matches[i]].Columns, widths), width)
+		if i == m.cursor {
+			line = reverse + padRight(line, width) + reset
+		}
+		b.WriteString(line + "\n")
+		shown++
+	}
+	if len(m.matches) == 0 {
+		b.WriteString(gray + "No matching items" + reset + "\n")
+		shown++
+	}
+	for ; shown < m.pageSize; shown++ {
+		b.WriteString("\n")
+	}
+
+	b.WriteString(fit(m.message, width) + "\n")
+	b.WriteString(gray + fit(m.help(), width) + reset)
+	return b.String()
+}
+
+// help describes the key bindings.
+func (m *model) help() string {
+	if m.filtering {
+		return "type to filter • enter done • esc clear"
+	}
+	parts := []string{"↑/↓ move", "/ filter"}
+	for i, a := range m.list.Actions {
+		k := string(a.Key)
+		if i == 0 {
+			k = "enter/" + k
+		}
+		parts = append(parts, k+" "+a.Name)
+	}
+	parts = append(parts, "q quit")
+	return strings.Join(parts, " • ")
+}
+
+func (m *model) columnWidths() []int {
+	var widths []int
+	grow := func(columns []string) {
+		for i, c := range columns {
+			if i >= len(widths) {
+				widths = append(widths, 0)
+			}
+			widths[i] = max(widths[i], utf8.RuneCountInString(c))
+		}
+	}
+	grow(m.list.Header)
+	for _, item := range m.list.Items {
+		grow(item.Columns)
+	}
+	return widths
+}
+
+func formatRow(columns []string, widths []int) string {
+	parts := make([]string, len(columns))
+	for i, c := range columns {
+		if i < len(columns)-1 {
+			c = padRight(c, widths[i])
+		}
+		parts[i] = c
+	}
+	return strings.Join(parts, "  ")
+}
+
+// fit truncates s to width characters.
+func fit(s string, width int) string {
+	if utf8.RuneCountInString(s) <= width {
+		return s
+	}
+	r := []rune(s)
+	if width <= 1 {
+		return string(r[:max(width, 0)])
+	}
+	return string(r[:width-1]) + "…"
+}
+
+func padRight(s string, width int) string {
+	if n := utf8.RuneCountInString(s); n < width {
+		return s + strings.Repeat(" ", width-n)
+	}
+	return s
+}
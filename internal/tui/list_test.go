@@ -0,0 +1,165 @@
+package tui
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	tests := []struct {
+		pattern, text string
+		ok            bool
+	}{
+		{"", "anything", true},
+		{"lgn", "Add login page", true},
+		{"LOGIN", "Add login page", true},
+		{"nigol", "Add login page", false},
+		{"pagex", "Add login page", false},
+	}
+	for _, tt := range tests {
+		if _, ok := fuzzyMatch(tt.pattern, tt.text); ok != tt.ok {
+			t.Errorf("fuzzyMatch(%q, %q) = %v, want %v", tt.pattern, tt.text, ok, tt.ok)
+		}
+	}
+
+	contiguous, _ := fuzzyMatch("fix", "!3 Fix typo")
+	scattered, _ := fuzzyMatch("fix", "!4 Refactor index")
+	if contiguous <= scattered {
+		t.Errorf("expected a contiguous match to score higher: %d <= %d", contiguous, scattered)
+	}
+}
+
+func testList() *List {
+	return &List{
+		Title:  "Merge requests",
+		Header: []string{"ID", "TITLE"},
+		Items: []Item{
+			{Columns: []string{"!1", "Refactor index"}, Value: "1"},
+			{Columns: []string{"!2", "Fix typo"}, Value: "2"},
+			{Columns: []string{"!3", "Add login page"}, Value: "3"},
+		},
+		Actions: []Action{
+			{Key: 'v', Name: "view", Run: func(Item) error { return nil }},
+			{Key: 'o', Name: "open", Run: func(Item) error { return nil }},
+		},
+	}
+}
+
+func runes(s string) []key {
+	var keys []key
+	for _, r := range s {
+		keys = append(keys, key{kind: keyRune, r: r})
+	}
+	return keys
+}
+
+func TestModel_Update(t *testing.T) {
+	m := newModel(testList())
+
+	press := func(keys ...key) *Action {
+		t.Helper()
+		var action *Action
+		for _, k := range keys {
+			var quit bool
+			action, quit = m.update(k)
+			if quit {
+				t.Fatalf("unexpected quit on %v", k)
+			}
+		}
+		return action
+	}
+
+	if a := press(key{kind: keyDown}, key{kind: keyRune, r: 'o'}); a == nil || a.Name != "open" || m.selected().Value != "2" {
+		t.Errorf("expected open on item 2, got %v on %q", a, m.selected().Value)
+	}
+
+	// Filtering narrows the items, and keys are typed into the filter.
+	press(append([]key{{kind: keyRune, r: '/'}}, runes("login")...)...)
+	if len(m.matches) != 1 || m.selected().Value != "3" {
+		t.Fatalf("expected only item 3 to match, got %v", m.matches)
+	}
+	if a := press(key{kind: keyEnter}); a != nil || m.filtering {
+		t.Errorf("expected Enter to end filtering, got %v", a)
+	}
+	if a := press(key{kind: keyEnter}); a == nil || a.Name != "view" {
+		t.Errorf("expected Enter to choose the first action, got %v", a)
+	}
+
+	// Escape clears the filter, and then quits.
+	press(key{kind: keyEscape})
+	if len(m.matches) != 3 {
+		t.Errorf("expected the filter to be cleared, got %v", m.matches)
+	}
+	if _, quit := m.update(key{kind: keyEscape}); !quit {
+		t.Error("expected Escape without a filter to quit")
+	}
+
+	press(append([]key{{kind: keyRune, r: '/'}}, runes("zzz")...)...)
+	press(key{kind: keyEnter})
+	if a := press(key{kind: keyRune, r: 'o'}); a != nil || m.message == "" {
+		t.Errorf("expected no action without a selected item, got %v", a)
+	}
+}
+
+func TestModel_View(t *testing.T) {
+	m := newModel(testList())
+	m.update(key{kind: keyDown})
+
+	view := m.view(60, 8)
+	lines := strings.Split(view, "\n")
+	if len(lines) != 8 {
+		t.Fatalf("expected 8 lines, got %d:\n%s", len(lines), view)
+	}
+	if !strings.Contains(lines[2], "ID  TITLE") {
+		t.Errorf("expected the header, got %q", lines[2])
+	}
+	if !strings.Contains(lines[4], reverse+"!2  Fix typo") {
+		t.Errorf("expected the selected row to be highlighted, got %q", lines[4])
+	}
+	if !strings.Contains(lines[7], "enter/v view • o open • q quit") {
+		t.Errorf("expected the key bindings, got %q", lines[7])
+	}
+
+	// The list scrolls to keep the cursor visible.
+	m.update(key{kind: keyEnd})
+	view = m.view(40, 6)
+	if strings.Contains(view, "!1  Refactor") || !strings.Contains(view, "Add login page") {
+		t.Errorf("expected the view to scroll to the last item:\n%s", view)
+	}
+}
+
+func TestReadKey(t *testing.T) {
+	r := bufio.NewReader(bytes.NewReader([]byte("a\x1b[A\x1b[B\x1b[6~\r\x7f/")))
+	want := []key{
+		{kind: keyRune, r: 'a'},
+		{kind: keyUp},
+		{kind: keyDown},
+		{kind: keyPageDown},
+		{kind: keyEnter},
+		{kind: keyBackspace},
+		{kind: keyRune, r: '/'},
+	}
+	for i, w := range want {
+		got, err := readKey(r)
+		if err != nil {
+			t.Fatalf("key %d: %v", i, err)
+		}
+		if got != w {
+			t.Errorf("key %d = %+v, want %+v", i, got, w)
+		}
+	}
+
+	esc := bufio.NewReader(bytes.NewReader([]byte("\x1b")))
+	if got, _ := readKey(esc); got.kind != keyEscape {
+		t.Errorf("expected a lone escape to be the Escape key, got %+v", got)
+	}
+}
+
+func TestRun_RequiresTerminal(t *testing.T) {
+	l := &List{}
+	if err := l.Run(strings.NewReader(""), &bytes.Buffer{}); err == nil {
+		t.Error("expected an error without a terminal")
+	}
+}
@@ -1,8 +1,12 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/PhilipKram/gitlab-cli/cmd"
 )
@@ -11,8 +15,21 @@ import (
 var version = "dev"
 
 func main() {
+	// Cancel the command context on Ctrl-C so in-flight requests are aborted
+	// and commands can clean up before exiting.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+
 	rootCmd := cmd.NewRootCmd(version)
-	if err := rootCmd.Execute(); err != nil {
+	err := cmd.Execute(ctx, rootCmd, os.Args[1:])
+	stop()
+	if err != nil {
+		if errors.Is(err, context.Canceled) && ctx.Err() != nil {
+			os.Exit(130)
+		}
+		var aliasErr *cmd.ShellAliasError
+		if errors.As(err, &aliasErr) {
+			os.Exit(aliasErr.ExitCode)
+		}
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
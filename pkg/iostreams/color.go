@@ -0,0 +1,127 @@
+package iostreams
+
+import (
+	"os"
+)
+
+// DisableColor turns off colored output, as requested with --no-color.
+func (s *IOStreams) DisableColor() {
+	s.colorDisabled = true
+}
+
+// ColorEnabled reports whether output to stdout may contain ANSI colors.
+// --no-color and NO_COLOR disable color, CLICOLOR_FORCE=1 enables it, and
+// otherwise color is used when stdout is a terminal that is not "dumb".
+func (s *IOStreams) ColorEnabled() bool {
+	if s.colorDisabled || os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	if v := os.Getenv("CLICOLOR_FORCE"); v != "" && v != "0" {
+		return true
+	}
+	return s.IsTerminal() && os.Getenv("TERM") != "dumb"
+}
+
+// ColorScheme returns a ColorScheme for stdout.
+func (s *IOStreams) ColorScheme() *ColorScheme {
+	return NewColorScheme(s.ColorEnabled())
+}
+
+// ColorScheme wraps text in ANSI colors when enabled and returns it
+// unchanged otherwise.
+type ColorScheme struct {
+	enabled bool
+}
+
+// NewColorScheme creates a ColorScheme.
+func NewColorScheme(enabled bool) *ColorScheme {
+	return &ColorScheme{enabled: enabled}
+}
+
+// Enabled reports whether the scheme applies colors.
+func (c *ColorScheme) Enabled() bool {
+	return c.enabled
+}
+
+func (c *ColorScheme) wrap(code, s string) string {
+	if !c.enabled || s == "" {
+		return s
+	}
+	return "\x1b[" + code + "m" + s + "\x1b[0m"
+}
+
+// Bold renders s in bold.
+func (c *ColorScheme) Bold(s string) string { return c.wrap("1", s) }
+
+// Red renders s in red.
+func (c *ColorScheme) Red(s string) string { return c.wrap("31", s) }
+
+// Green renders s in green.
+func (c *ColorScheme) Green(s string) string { return c.wrap("32", s) }
+
+// Yellow renders s in yellow.
+func (c *ColorScheme) Yellow(s string) string { return c.wrap("33", s) }
+
+// Magenta renders s in magenta.
+func (c *ColorScheme) Magenta(s string) string { return c.wrap("35", s) }
+
+// Cyan renders s in cyan.
+func (c *ColorScheme) Cyan(s string) string { return c.wrap("36", s) }
+
+// Gray renders s in gray.
+func (c *ColorScheme) Gray(s string) string { return c.wrap("90", s) }
+
+// ForState renders s in the color of an issue, merge request, pipeline, or
+// job state: green for open and successful, magenta for merged, red for
+// closed and failed, yellow for in progress, and gray for canceled,
+// skipped, or draft. Other states are returned unchanged.
+func (c *ColorScheme) ForState(state, s string) string {
+	switch state {
+	case "opened", "active", "success", "passed":
+		return c.Green(s)
+	case "merged":
+		return c.Magenta(s)
+	case "closed", "failed", "locked":
+		return c.Red(s)
+	case "running", "pending", "created", "preparing", "waiting_for_resource", "scheduled":
+		return c.Yellow(s)
+	case "canceled", "cancelled", "skipped", "manual", "draft":
+		return c.Gray(s)
+	default:
+		return s
+	}
+}
+
+// State renders a state name in its color, preceded by its icon when color
+// is enabled, e.g. "✓ merged".
+func (c *ColorScheme) State(state string) string {
+	if !c.enabled {
+		return state
+	}
+	if icon := stateIcon(state); icon != "" {
+		return c.ForState(state, icon+" "+state)
+	}
+	return c.ForState(state, state)
+}
+
+// stateIcon returns the symbol shown next to a state in terminal output.
+func stateIcon(state string) string {
+	switch state {
+	case "opened", "active":
+		return "●"
+	case "merged", "success", "passed":
+		return "✓"
+	case "closed", "failed":
+		return "✗"
+	case "locked":
+		return "⊘"
+	case "running", "pending", "created", "preparing", "waiting_for_resource", "scheduled":
+		return "◐"
+	case "canceled", "cancelled", "skipped", "manual":
+		return "○"
+	case "draft":
+		return "◌"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,59 @@
+package iostreams
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestColorEnabled(t *testing.T) {
+	newStreams := func() *IOStreams {
+		return &IOStreams{In: strings.NewReader(""), Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}}
+	}
+
+	t.Setenv("NO_COLOR", "")
+	t.Setenv("CLICOLOR_FORCE", "")
+	if newStreams().ColorEnabled() {
+		t.Error("expected no color when stdout is not a terminal")
+	}
+
+	t.Setenv("CLICOLOR_FORCE", "1")
+	if !newStreams().ColorEnabled() {
+		t.Error("expected CLICOLOR_FORCE=1 to enable color")
+	}
+
+	s := newStreams()
+	s.DisableColor()
+	if s.ColorEnabled() {
+		t.Error("expected DisableColor to override CLICOLOR_FORCE")
+	}
+
+	t.Setenv("NO_COLOR", "1")
+	if newStreams().ColorEnabled() {
+		t.Error("expected NO_COLOR to disable color")
+	}
+}
+
+func TestColorScheme_State(t *testing.T) {
+	cs := NewColorScheme(true)
+	tests := []struct {
+		state string
+		want  string
+	}{
+		{"merged", "\x1b[35m✓ merged\x1b[0m"},
+		{"success", "\x1b[32m✓ success\x1b[0m"},
+		{"failed", "\x1b[31m✗ failed\x1b[0m"},
+		{"closed", "\x1b[31m✗ closed\x1b[0m"},
+		{"running", "\x1b[33m◐ running\x1b[0m"},
+		{"unknown", "unknown"},
+	}
+	for _, tt := range tests {
+		if got := cs.State(tt.state); got != tt.want {
+			t.Errorf("State(%q) = %q, want %q", tt.state, got, tt.want)
+		}
+	}
+
+	if got := NewColorScheme(false).State("merged"); got != "merged" {
+		t.Errorf("expected plain state without color, got %q", got)
+	}
+}
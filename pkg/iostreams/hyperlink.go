@@ -0,0 +1,54 @@
+package iostreams
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Hyperlink wraps text in an OSC 8 escape sequence so that supporting
+// terminals render it as a clickable link to url.
+func Hyperlink(url, text string) string {
+	if url == "" {
+		return text
+	}
+	return "\x1b]8;;" + url + "\x1b\\" + text + "\x1b]8;;\x1b\\"
+}
+
+// SupportsHyperlinks reports whether stdout is a terminal that is known to
+// render OSC 8 hyperlinks. FORCE_HYPERLINK=1 enables links unconditionally and
+// FORCE_HYPERLINK=0 disables them.
+func (s *IOStreams) SupportsHyperlinks() bool {
+	if v := os.Getenv("FORCE_HYPERLINK"); v != "" {
+		return v != "0" && v != "false"
+	}
+	if !s.IsTerminal() {
+		return false
+	}
+	return terminalSupportsHyperlinks()
+}
+
+// terminalSupportsHyperlinks inspects the environment for terminal emulators
+// with OSC 8 support.
+func terminalSupportsHyperlinks() bool {
+	if os.Getenv("CI") != "" || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	if os.Getenv("WT_SESSION") != "" || os.Getenv("KONSOLE_VERSION") != "" || os.Getenv("DOMTERM") != "" {
+		return true
+	}
+	if v, err := strconv.Atoi(os.Getenv("VTE_VERSION")); err == nil && v >= 5000 {
+		return true
+	}
+	switch os.Getenv("TERM_PROGRAM") {
+	case "iTerm.app", "WezTerm", "vscode", "Hyper", "ghostty", "Tabby":
+		return true
+	}
+	term := os.Getenv("TERM")
+	for _, name := range []string{"kitty", "alacritty", "foot", "wezterm", "ghostty"} {
+		if strings.Contains(term, name) {
+			return true
+		}
+	}
+	return false
+}
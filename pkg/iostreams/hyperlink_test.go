@@ -0,0 +1,41 @@
+package iostreams
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestHyperlink(t *testing.T) {
+	got := Hyperlink("https://gitlab.com/a/b/-/issues/1", "#1")
+	want := "\x1b]8;;https://gitlab.com/a/b/-/issues/1\x1b\\#1\x1b]8;;\x1b\\"
+	if got != want {
+		t.Errorf("Hyperlink() = %q, want %q", got, want)
+	}
+	if got := Hyperlink("", "#1"); got != "#1" {
+		t.Errorf("Hyperlink with empty URL = %q, want plain text", got)
+	}
+}
+
+func TestSupportsHyperlinks(t *testing.T) {
+	s := &IOStreams{In: strings.NewReader(""), Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}}
+
+	t.Setenv("FORCE_HYPERLINK", "1")
+	if !s.SupportsHyperlinks() {
+		t.Error("expected FORCE_HYPERLINK=1 to enable hyperlinks")
+	}
+
+	t.Setenv("FORCE_HYPERLINK", "0")
+	if s.SupportsHyperlinks() {
+		t.Error("expected FORCE_HYPERLINK=0 to disable hyperlinks")
+	}
+}
+
+func TestSupportsHyperlinks_NonTerminal(t *testing.T) {
+	t.Setenv("FORCE_HYPERLINK", "")
+	t.Setenv("TERM_PROGRAM", "iTerm.app")
+	s := &IOStreams{In: strings.NewReader(""), Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}}
+	if s.SupportsHyperlinks() {
+		t.Error("expected no hyperlinks when stdout is not a terminal")
+	}
+}
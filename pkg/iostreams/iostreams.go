@@ -3,6 +3,7 @@ package iostreams
 import (
 	"io"
 	"os"
+	"os/exec"
 
 	"golang.org/x/term"
 )
@@ -12,6 +13,18 @@ type IOStreams struct {
 	In     io.Reader
 	Out    io.Writer
 	ErrOut io.Writer
+
+	colorDisabled bool
+
+	// promptSet records that --prompt was given, overriding the
+	// environment; promptDisabled is its value.
+	promptSet      bool
+	promptDisabled bool
+
+	// pager is the running pager process while output is paged, and
+	// pagedOut the stdout it writes to.
+	pager    *exec.Cmd
+	pagedOut io.Writer
 }
 
 // System returns IOStreams connected to standard OS streams.
@@ -23,9 +36,10 @@ func System() *IOStreams {
 	}
 }
 
-// IsTerminal returns true if stdout is connected to a terminal.
+// IsTerminal returns true if stdout is connected to a terminal, including
+// when output goes to the terminal through a pager.
 func (s *IOStreams) IsTerminal() bool {
-	if f, ok := s.Out.(*os.File); ok {
+	if f, ok := s.terminalOut().(*os.File); ok {
 		return term.IsTerminal(int(f.Fd()))
 	}
 	return false
@@ -41,7 +55,7 @@ func (s *IOStreams) IsStdinTTY() bool {
 
 // TerminalWidth returns the width of the terminal, defaulting to 80 if it cannot be determined.
 func (s *IOStreams) TerminalWidth() int {
-	if f, ok := s.Out.(*os.File); ok {
+	if f, ok := s.terminalOut().(*os.File); ok {
 		width, _, err := term.GetSize(int(f.Fd()))
 		if err == nil {
 			return width
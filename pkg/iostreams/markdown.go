@@ -0,0 +1,176 @@
+package iostreams
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	mdCommentRe  = regexp.MustCompile(`(?s)<!--.*?-->`)
+	mdFenceRe    = regexp.MustCompile("^\\s*(```|~~~)")
+	mdHeadingRe  = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	mdRuleRe     = regexp.MustCompile(`^\s*([-*_])(\s*([-*_])){2,}\s*$`)
+	mdQuoteRe    = regexp.MustCompile(`^\s*>\s?(.*)$`)
+	mdTaskRe     = regexp.MustCompile(`^(\s*)[-*+]\s+\[([ xX])\]\s+(.*)$`)
+	mdBulletRe   = regexp.MustCompile(`^(\s*)[-*+]\s+(.*)$`)
+	mdOrderedRe  = regexp.MustCompile(`^(\s*)(\d+)[.)]\s+(.*)$`)
+	mdCodeSpanRe = regexp.MustCompile("`([^`]+)`")
+	mdImageRe    = regexp.MustCompile(`!\[([^\]]*)\]\(([^)\s]+)[^)]*\)`)
+	mdLinkRe     = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)[^)]*\)`)
+	mdBoldRe     = regexp.MustCompile(`\*\*([^*]+)\*\*|__([^_]+)__`)
+	mdEscapeRe   = regexp.MustCompile(`\x1b\]8;[^\x1b]*\x1b\\|\x1b\[[0-9;]*m`)
+)
+
+// RenderMarkdown formats GitLab Flavored Markdown for a terminal that is width
+// columns wide. Headings and bold text are emphasized, list items get
+// bullets, code blocks are indented, HTML comments are dropped, and long lines
+// are wrapped. Colors come from cs, so the result is plain text when color is
+// disabled.
+func RenderMarkdown(text string, width int, cs *ColorScheme) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = mdCommentRe.ReplaceAllString(text, "")
+	if width <= 0 {
+		width = 80
+	}
+
+	var out []string
+	inCode := false
+	for _, line := range strings.Split(text, "\n") {
+		if mdFenceRe.MatchString(line) {
+			inCode = !inCode
+			continue
+		}
+		if inCode {
+			out = append(out, "    "+cs.Gray(line))
+			continue
+		}
+
+		if m := mdHeadingRe.FindStringSubmatch(line); m != nil {
+			out = append(out, "", cs.Bold(renderInline(m[2], cs)))
+			continue
+		}
+		if mdRuleRe.MatchString(line) {
+			out = append(out, cs.Gray(strings.Repeat("─", min(width, 40))))
+			continue
+		}
+		if m := mdQuoteRe.FindStringSubmatch(line); m != nil {
+			bar := cs.Gray("│ ")
+			out = append(out, wrapLine(renderInline(m[1], cs), width, bar, bar)...)
+			continue
+		}
+		if m := mdTaskRe.FindStringSubmatch(line); m != nil {
+			box := "☐ "
+			if m[2] != " " {
+				box = "☑ "
+			}
+			indent := listIndent(m[1])
+			out = append(out, wrapLine(renderInline(m[3], cs), width, indent+box, indent+"  ")...)
+			continue
+		}
+		if m := mdBulletRe.FindStringSubmatch(line); m != nil {
+			indent := listIndent(m[1])
+			out = append(out, wrapLine(renderInline(m[2], cs), width, indent+"• ", indent+"  ")...)
+			continue
+		}
+		if m := mdOrderedRe.FindStringSubmatch(line); m != nil {
+			indent := listIndent(m[1])
+			marker := m[2] + ". "
+			out = append(out, wrapLine(renderInline(m[3], cs), width, indent+marker, indent+strings.Repeat(" ", len(marker)))...)
+			continue
+		}
+		if strings.HasPrefix(strings.TrimSpace(line), "|") {
+			// Tables are already aligned by their authors; wrapping would
+			// only break them.
+			out = append(out, line)
+			continue
+		}
+		if strings.TrimSpace(line) == "" {
+			out = append(out, "")
+			continue
+		}
+		out = append(out, wrapLine(renderInline(strings.TrimSpace(line), cs), width, "", "")...)
+	}
+
+	// Collapse runs of blank lines left by headings and removed comments.
+	var lines []string
+	for _, line := range out {
+		if line == "" && (len(lines) == 0 || lines[len(lines)-1] == "") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n")
+}
+
+// listIndent converts the leading whitespace of a nested list item to two
+// spaces per level.
+func listIndent(ws string) string {
+	level := len(strings.ReplaceAll(ws, "\t", "  ")) / 2
+	return strings.Repeat("  ", level)
+}
+
+// renderInline applies inline styles: code spans, images, links, and bold
+// text. Code spans are left untouched by the other rules.
+func renderInline(s string, cs *ColorScheme) string {
+	var b strings.Builder
+	last := 0
+	for _, m := range mdCodeSpanRe.FindAllStringSubmatchIndex(s, -1) {
+		b.WriteString(renderSpans(s[last:m[0]], cs))
+		b.WriteString(cs.Yellow(s[m[2]:m[3]]))
+		last = m[1]
+	}
+	b.WriteString(renderSpans(s[last:], cs))
+	return b.String()
+}
+
+func renderSpans(s string, cs *ColorScheme) string {
+	s = mdImageRe.ReplaceAllStringFunc(s, func(match string) string {
+		m := mdImageRe.FindStringSubmatch(match)
+		alt := m[1]
+		if alt == "" {
+			alt = "image"
+		}
+		return "[" + alt + "] (" + m[2] + ")"
+	})
+	s = mdLinkRe.ReplaceAllStringFunc(s, func(match string) string {
+		m := mdLinkRe.FindStringSubmatch(match)
+		if m[1] == m[2] {
+			return m[2]
+		}
+		return m[1] + " (" + cs.Gray(m[2]) + ")"
+	})
+	return mdBoldRe.ReplaceAllStringFunc(s, func(match string) string {
+		m := mdBoldRe.FindStringSubmatch(match)
+		return cs.Bold(m[1] + m[2])
+	})
+}
+
+// wrapLine breaks s into lines no wider than width, starting the first line
+// with prefix and the rest with indent. Escape sequences take up no width.
+func wrapLine(s string, width int, prefix, indent string) []string {
+	words := strings.Fields(s)
+	if len(words) == 0 {
+		return []string{strings.TrimRight(prefix, " ")}
+	}
+
+	var lines []string
+	line := prefix + words[0]
+	lineWidth := visibleWidth(line)
+	for _, word := range words[1:] {
+		w := visibleWidth(word)
+		if lineWidth+1+w > width {
+			lines = append(lines, line)
+			line = indent + word
+			lineWidth = visibleWidth(indent) + w
+			continue
+		}
+		line += " " + word
+		lineWidth += 1 + w
+	}
+	return append(lines, line)
+}
+
+func visibleWidth(s string) int {
+	return utf8.RuneCountInString(mdEscapeRe.ReplaceAllString(s, ""))
+}
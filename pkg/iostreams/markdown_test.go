@@ -0,0 +1,46 @@
+package iostreams
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderMarkdown_Plain(t *testing.T) {
+	input := "## Summary\n\nFixes the **login** crash, see [the docs](https://docs.example.com).\n\n<!-- template hint -->\n- [x] Tests added\n- Uses `go test`\n  - nested\n1. first\n\n```go\nfunc main() {}\n```\n> quoted\n---\n| a | b |"
+	got := RenderMarkdown(input, 80, NewColorScheme(false))
+
+	want := strings.Join([]string{
+		"Summary",
+		"",
+		"Fixes the login crash, see the docs (https://docs.example.com).",
+		"",
+		"☑ Tests added",
+		"• Uses go test",
+		"  • nested",
+		"1. first",
+		"",
+		"    func main() {}",
+		"│ quoted",
+		strings.Repeat("─", 40),
+		"| a | b |",
+	}, "\n")
+	if got != want {
+		t.Errorf("RenderMarkdown() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestRenderMarkdown_Wrap(t *testing.T) {
+	got := RenderMarkdown("- one two three four five six", 12, NewColorScheme(false))
+	want := "• one two\n  three four\n  five six"
+	if got != want {
+		t.Errorf("RenderMarkdown() = %q, want %q", got, want)
+	}
+}
+
+func TestRenderMarkdown_Color(t *testing.T) {
+	got := RenderMarkdown("# Title\nrun `make` **now**", 80, NewColorScheme(true))
+	want := "\x1b[1mTitle\x1b[0m\nrun \x1b[33mmake\x1b[0m \x1b[1mnow\x1b[0m"
+	if got != want {
+		t.Errorf("RenderMarkdown() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,65 @@
+package iostreams
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// StartPager sends stdout through the pager command until StopPager is
+// called. It does nothing when stdout is not a terminal or the pager is
+// empty or "cat". The command may include arguments, e.g. "less -S".
+func (s *IOStreams) StartPager(pager string) error {
+	args := strings.Fields(pager)
+	if len(args) == 0 || args[0] == "cat" || s.pager != nil || !s.IsTerminal() {
+		return nil
+	}
+
+	c := exec.Command(args[0], args[1:]...)
+	c.Env = os.Environ()
+	// Keep colors and quit when the output fits on one screen, unless the
+	// user configured less themselves.
+	if _, ok := os.LookupEnv("LESS"); !ok {
+		c.Env = append(c.Env, "LESS=FRX")
+	}
+	c.Stdout = s.Out
+	c.Stderr = s.ErrOut
+	in, err := c.StdinPipe()
+	if err != nil {
+		return err
+	}
+	if err := c.Start(); err != nil {
+		return fmt.Errorf("starting pager %q: %w", pager, err)
+	}
+
+	s.pager = c
+	s.pagedOut = s.Out
+	s.Out = in
+	return nil
+}
+
+// StopPager closes the pager's input, waits for the user to quit it, and
+// restores stdout.
+func (s *IOStreams) StopPager() {
+	if s.pager == nil {
+		return
+	}
+	if closer, ok := s.Out.(io.Closer); ok {
+		_ = closer.Close()
+	}
+	_ = s.pager.Wait()
+	s.Out = s.pagedOut
+	s.pager = nil
+	s.pagedOut = nil
+}
+
+// terminalOut returns the writer that reaches the terminal: stdout, or the
+// stdout the pager writes to while output is paged.
+func (s *IOStreams) terminalOut() io.Writer {
+	if s.pager != nil {
+		return s.pagedOut
+	}
+	return s.Out
+}
@@ -0,0 +1,52 @@
+package iostreams
+
+import (
+	"os"
+)
+
+// ciEnvVars are set by common CI systems.
+var ciEnvVars = []string{
+	"CI",
+	"GITLAB_CI",
+	"BUILD_NUMBER",
+	"RUN_ID",
+	"JENKINS_URL",
+	"TF_BUILD",
+	"CODEBUILD_BUILD_ID",
+	"TEAMCITY_VERSION",
+}
+
+// IsCI reports whether glab runs in a CI environment.
+func IsCI() bool {
+	for _, name := range ciEnvVars {
+		if v := os.Getenv(name); v != "" && v != "0" && v != "false" {
+			return true
+		}
+	}
+	return false
+}
+
+// SetPromptDisabled disables or enables interactive prompts, as requested
+// with --prompt, overriding GLAB_PROMPT_DISABLED and CI detection.
+func (s *IOStreams) SetPromptDisabled(disabled bool) {
+	s.promptSet = true
+	s.promptDisabled = disabled
+}
+
+// PromptDisabled reports whether interactive prompts are disabled: by
+// --prompt=disabled, by GLAB_PROMPT_DISABLED, or because glab runs in CI.
+func (s *IOStreams) PromptDisabled() bool {
+	if s.promptSet {
+		return s.promptDisabled
+	}
+	if v := os.Getenv("GLAB_PROMPT_DISABLED"); v != "" && v != "0" && v != "false" {
+		return true
+	}
+	return IsCI()
+}
+
+// CanPrompt reports whether the user may be prompted: prompts are not
+// disabled and stdin is a terminal.
+func (s *IOStreams) CanPrompt() bool {
+	return !s.PromptDisabled() && s.IsStdinTTY()
+}
@@ -0,0 +1,68 @@
+package iostreams
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func clearPromptEnv(t *testing.T) {
+	t.Helper()
+	t.Setenv("GLAB_PROMPT_DISABLED", "")
+	for _, name := range ciEnvVars {
+		t.Setenv(name, "")
+	}
+}
+
+func TestIsCI(t *testing.T) {
+	clearPromptEnv(t)
+	if IsCI() {
+		t.Error("expected no CI without CI variables")
+	}
+
+	t.Setenv("CI", "false")
+	if IsCI() {
+		t.Error("expected CI=false not to count as CI")
+	}
+
+	t.Setenv("GITLAB_CI", "true")
+	if !IsCI() {
+		t.Error("expected GITLAB_CI=true to be detected")
+	}
+}
+
+func TestPromptDisabled(t *testing.T) {
+	clearPromptEnv(t)
+	s := &IOStreams{In: strings.NewReader(""), Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}}
+	if s.PromptDisabled() {
+		t.Error("expected prompts to be enabled by default")
+	}
+
+	t.Setenv("GLAB_PROMPT_DISABLED", "1")
+	if !s.PromptDisabled() {
+		t.Error("expected GLAB_PROMPT_DISABLED=1 to disable prompts")
+	}
+
+	t.Setenv("GLAB_PROMPT_DISABLED", "")
+	t.Setenv("CI", "true")
+	if !s.PromptDisabled() {
+		t.Error("expected prompts to be disabled in CI")
+	}
+
+	s.SetPromptDisabled(false)
+	if s.PromptDisabled() {
+		t.Error("expected --prompt=enabled to override CI detection")
+	}
+	s.SetPromptDisabled(true)
+	if !s.PromptDisabled() {
+		t.Error("expected --prompt=disabled to disable prompts")
+	}
+}
+
+func TestCanPrompt_NonTerminal(t *testing.T) {
+	clearPromptEnv(t)
+	s := &IOStreams{In: strings.NewReader(""), Out: &bytes.Buffer{}, ErrOut: &bytes.Buffer{}}
+	if s.CanPrompt() {
+		t.Error("expected no prompting when stdin is not a terminal")
+	}
+}